@@ -20,7 +20,9 @@ import (
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+	"github.com/zjrosen/perles/internal/orchestration/orchestrator"
 	"github.com/zjrosen/perles/internal/orchestration/session"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/paths"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
@@ -113,6 +115,7 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 
 	// Create registry service for template instructions with community and user-defined workflows
 	// Community workflows are loaded from communityworkflows.RegistryFS(), filtered by config
+	// Overlay workflows are loaded from orchestration.templates.overlay_dir, if configured
 	// User workflows are loaded from ~/.perles/workflows/*/template.yaml
 	var communitySource *appreg.CommunitySource
 	if len(cfg.Orchestration.CommunityWorkflows) > 0 {
@@ -126,6 +129,7 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		templates.RegistryFS(),
 		communitySource,
 		appreg.UserRegistryBaseDir(),
+		appreg.WithOverlayDir(cfg.Orchestration.Templates.OverlayDir),
 	)
 	if err != nil {
 		log.Error(log.CatConfig, "Failed to create registry service", "error", err)
@@ -139,11 +143,21 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 	}
 
 	// Create control plane
-	cp, err := createDaemonControlPlane(&cfg, workDir)
+	cp, capacity, err := createDaemonControlPlane(&cfg, workDir)
 	if err != nil {
 		return fmt.Errorf("creating control plane: %w", err)
 	}
 
+	// Shutdown sequence across the worker pool, coordinators, control plane and git
+	orchCfg := orchestrator.Config{Pool: capacity, ControlPlane: cp}
+	if cfg.Orchestration.RemoveWorktreesOnShutdown {
+		orchCfg.Worktrees = cp
+	}
+	orch, err := orchestrator.New(orchCfg)
+	if err != nil {
+		return fmt.Errorf("creating orchestrator: %w", err)
+	}
+
 	// Determine API server address
 	// Priority: --port flag > config api_port > auto-assign (port 0)
 	port := daemonPort
@@ -199,16 +213,16 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		log.Error(log.CatOrch, "Error stopping API server", "error", err)
 	}
 
-	// Shutdown control plane (stops all workflows)
-	if err := cp.Shutdown(shutdownCtx); err != nil {
-		log.Error(log.CatOrch, "Error shutting down control plane", "error", err)
+	// Pause assignments, persist state, drain workers, stop workflows and clean up worktrees
+	if err := orch.Shutdown(shutdownCtx); err != nil {
+		log.Error(log.CatOrch, "Error shutting down orchestrator", "error", err)
 	}
 
 	fmt.Println("Daemon stopped")
 	return nil
 }
 
-func createDaemonControlPlane(cfg *config.Config, _ string) (controlplane.ControlPlane, error) {
+func createDaemonControlPlane(cfg *config.Config, workDir string) (controlplane.ControlPlane, *controlplane.CapacityAllocator, error) {
 	orchConfig := cfg.Orchestration
 
 	// Create workflow registry
@@ -219,25 +233,52 @@ func createDaemonControlPlane(cfg *config.Config, _ string) (controlplane.Contro
 	eventBus := controlplane.NewCrossWorkflowEventBus()
 
 	sessionFactory := session.NewFactory(session.FactoryConfig{
-		BaseDir: orchConfig.SessionStorage.BaseDir,
+		BaseDir:             orchConfig.SessionStorage.BaseDir,
+		MessageContentLimit: orchConfig.MessageContentLimit,
 		// Note: GitExecutor not available in daemon mode without git context
 	})
 
 	soundService := sound.NewSystemSoundService(cfg.Sound.Events)
 
+	// Worker slots shared across workflows; higher-priority workflows are served first
+	capacity := controlplane.NewCapacityAllocator(orchConfig.MaxWorkers)
+
 	supervisor, err := controlplane.NewSupervisor(controlplane.SupervisorConfig{
-		AgentProviders:   orchConfig.AgentProviders(),
-		WorkflowRegistry: workflowRegistry,
-		WorktreeTimeout:  orchConfig.Timeouts.WorktreeCreation,
-		SessionFactory:   sessionFactory,
-		SoundService:     soundService,
-		BeadsDir:         cfg.ResolvedBeadsDir,
+		AgentProviders:            orchConfig.AgentProviders(),
+		WorkflowRegistry:          workflowRegistry,
+		WorktreeTimeout:           orchConfig.Timeouts.WorktreeCreation,
+		SessionFactory:            sessionFactory,
+		SoundService:              soundService,
+		BeadsDir:                  cfg.ResolvedBeadsDir,
+		CapacityAllocator:         capacity,
+		MinReadyWorkers:           orchConfig.MinReadyWorkers,
+		MaxConcurrentReviews:      orchConfig.MaxConcurrentReviews,
+		ConfirmDestructiveActions: orchConfig.ConfirmDestructiveActions,
+		SensitivePaths:            orchConfig.SensitivePaths,
+		WorkerToolReminder:        orchConfig.WorkerToolReminder,
+		SyncBeadsStatus:           orchConfig.SyncBeadsStatus,
+		HandoffThreshold:          orchConfig.HandoffThreshold,
+		RequirePassingTests:       orchConfig.RequirePassingTests,
+		TaskPromptLimit: prompt.PromptLimit{
+			MaxBytes: orchConfig.TaskPromptLimit.MaxBytes,
+			Strategy: prompt.TruncationStrategy(orchConfig.TaskPromptLimit.Strategy),
+		},
+		Diagnostics:             orchConfig.Diagnostics,
+		WorkerKeepaliveInterval: orchConfig.WorkerKeepaliveInterval,
+		WorkerKeepaliveMax:      orchConfig.WorkerKeepaliveMax,
+		ReconcileInterval:       orchConfig.ReconcileInterval,
+		WorkerReadyTimeout:      orchConfig.WorkerReadyTimeout,
+		RespawnUnreadyWorkers:   orchConfig.RespawnUnreadyWorkers,
+		ReconcilePolicy:         orchConfig.ReconcilePolicy,
+		StreamBufferSize:        orchConfig.StreamBufferSize,
+		InstanceRegistry:        registry,
+		WorkerProviderFactory:   orchConfig.WorkerAgentProvider,
 		GitExecutorFactory: func(path string) appgit.GitExecutor {
 			return infragit.NewRealExecutor(path)
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("creating supervisor: %w", err)
+		return nil, nil, fmt.Errorf("creating supervisor: %w", err)
 	}
 
 	// Create health monitor
@@ -254,19 +295,21 @@ func createDaemonControlPlane(cfg *config.Config, _ string) (controlplane.Contro
 
 	// Create control plane
 	cp, err := controlplane.NewControlPlane(controlplane.ControlPlaneConfig{
-		Registry:      registry,
-		Supervisor:    supervisor,
-		EventBus:      eventBus,
-		HealthMonitor: healthMonitor,
+		Registry:          registry,
+		Supervisor:        supervisor,
+		EventBus:          eventBus,
+		HealthMonitor:     healthMonitor,
+		CapacityAllocator: capacity,
+		GitExecutor:       infragit.NewRealExecutor(workDir),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("creating control plane: %w", err)
+		return nil, nil, fmt.Errorf("creating control plane: %w", err)
 	}
 
 	// Start health monitor
 	if err := healthMonitor.Start(context.Background()); err != nil {
-		return nil, fmt.Errorf("starting health monitor: %w", err)
+		return nil, nil, fmt.Errorf("starting health monitor: %w", err)
 	}
 
-	return cp, nil
+	return cp, capacity, nil
 }
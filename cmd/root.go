@@ -133,6 +133,7 @@ func initServices() {
 	// Initialize registry service with embedded templates, community, and user-defined workflows
 	// templates.RegistryFS() contains template.yaml, workflow templates, and coordinator instructions
 	// Community workflows are loaded from communityworkflows.RegistryFS(), filtered by config
+	// Overlay workflows are loaded from orchestration.templates.overlay_dir, if configured
 	// User workflows are loaded from ~/.perles/workflows/*/template.yaml
 	var communitySource *appreg.CommunitySource
 	if len(cfg.Orchestration.CommunityWorkflows) > 0 {
@@ -147,6 +148,7 @@ func initServices() {
 		templates.RegistryFS(),
 		communitySource,
 		appreg.UserRegistryBaseDir(),
+		appreg.WithOverlayDir(cfg.Orchestration.Templates.OverlayDir),
 	)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Error initializing registry service:", err)
@@ -30,8 +30,10 @@ import (
 	"github.com/zjrosen/perles/internal/mode/shared"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+	"github.com/zjrosen/perles/internal/orchestration/orchestrator"
 	"github.com/zjrosen/perles/internal/orchestration/session"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/pubsub"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
@@ -59,6 +61,8 @@ type Model struct {
 
 	// ControlPlane for multi-workflow management (lazy initialized on dashboard entry)
 	controlPlane controlplane.ControlPlane
+	// orchestrator runs the shutdown sequence across the worker pool, control plane and git
+	orchestrator *orchestrator.Orchestrator
 
 	// Shared services (passed to mode controllers)
 	services mode.Services
@@ -487,7 +491,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Lazy initialize ControlPlane if needed
 		if m.controlPlane == nil {
-			m.controlPlane = m.createControlPlane()
+			m.controlPlane, m.orchestrator = m.createControlPlane()
 		}
 
 		// Start API server if not already running
@@ -1171,13 +1175,13 @@ func (m *Model) Close() error {
 	// Clean up chat panel infrastructure
 	m.chatPanel.Cleanup()
 
-	// Shutdown ControlPlane (stops all workflows, releases resources)
-	// Must happen before closing DB since it may persist final state
-	if m.controlPlane != nil {
+	// Pause assignments, persist coordinator state, drain workers, stop workflows and
+	// clean up worktrees. Must happen before closing DB since it may persist final state
+	if m.orchestrator != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
-		if err := m.controlPlane.Shutdown(ctx); err != nil {
-			log.Error(log.CatOrch, "Error shutting down control plane", "error", err)
+		if err := m.orchestrator.Shutdown(ctx); err != nil {
+			log.Error(log.CatOrch, "Error shutting down orchestrator", "error", err)
 		}
 	}
 
@@ -1218,10 +1222,11 @@ func (m *Model) Close() error {
 	return nil
 }
 
-// createControlPlane creates a ControlPlane for the dashboard.
+// createControlPlane creates a ControlPlane for the dashboard, along with the
+// Orchestrator that shuts it down.
 // Uses DurableRegistry for SQLite-backed persistence when database is available,
 // falling back to in-memory registry when not.
-func (m *Model) createControlPlane() controlplane.ControlPlane {
+func (m *Model) createControlPlane() (controlplane.ControlPlane, *orchestrator.Orchestrator) {
 	eventBus := controlplane.NewCrossWorkflowEventBus()
 
 	// Derive project name for registry (matches session factory pattern)
@@ -1246,24 +1251,52 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 
 	// Create session factory for workflow session tracking
 	sessionFactory := session.NewFactory(session.FactoryConfig{
-		BaseDir:     orchConfig.SessionStorage.BaseDir,
-		GitExecutor: m.services.GitExecutorFactory(m.services.WorkDir),
+		BaseDir:             orchConfig.SessionStorage.BaseDir,
+		GitExecutor:         m.services.GitExecutorFactory(m.services.WorkDir),
+		MessageContentLimit: orchConfig.MessageContentLimit,
 	})
 
+	// Worker slots shared across workflows; higher-priority workflows are served first
+	capacity := controlplane.NewCapacityAllocator(orchConfig.MaxWorkers)
+
 	// Create supervisor with full configuration
 	supervisor, err := controlplane.NewSupervisor(controlplane.SupervisorConfig{
-		AgentProviders:     orchConfig.AgentProviders(),
-		WorkflowRegistry:   m.workflowRegistry,
-		GitExecutorFactory: m.services.GitExecutorFactory,
-		WorktreeTimeout:    orchConfig.Timeouts.WorktreeCreation,
-		Flags:              m.services.Flags,
-		SessionFactory:     sessionFactory,
-		SoundService:       m.services.Sounds,
-		BeadsDir:           m.services.Config.ResolvedBeadsDir,
+		AgentProviders:            orchConfig.AgentProviders(),
+		WorkflowRegistry:          m.workflowRegistry,
+		GitExecutorFactory:        m.services.GitExecutorFactory,
+		WorktreeTimeout:           orchConfig.Timeouts.WorktreeCreation,
+		Flags:                     m.services.Flags,
+		SessionFactory:            sessionFactory,
+		SoundService:              m.services.Sounds,
+		BeadsDir:                  m.services.Config.ResolvedBeadsDir,
+		Tracker:                   m.services.Executor,
+		CapacityAllocator:         capacity,
+		MinReadyWorkers:           orchConfig.MinReadyWorkers,
+		MaxConcurrentReviews:      orchConfig.MaxConcurrentReviews,
+		ConfirmDestructiveActions: orchConfig.ConfirmDestructiveActions,
+		SensitivePaths:            orchConfig.SensitivePaths,
+		WorkerToolReminder:        orchConfig.WorkerToolReminder,
+		SyncBeadsStatus:           orchConfig.SyncBeadsStatus,
+		HandoffThreshold:          orchConfig.HandoffThreshold,
+		RequirePassingTests:       orchConfig.RequirePassingTests,
+		TaskPromptLimit: prompt.PromptLimit{
+			MaxBytes: orchConfig.TaskPromptLimit.MaxBytes,
+			Strategy: prompt.TruncationStrategy(orchConfig.TaskPromptLimit.Strategy),
+		},
+		Diagnostics:             orchConfig.Diagnostics,
+		WorkerKeepaliveInterval: orchConfig.WorkerKeepaliveInterval,
+		WorkerKeepaliveMax:      orchConfig.WorkerKeepaliveMax,
+		ReconcileInterval:       orchConfig.ReconcileInterval,
+		WorkerReadyTimeout:      orchConfig.WorkerReadyTimeout,
+		RespawnUnreadyWorkers:   orchConfig.RespawnUnreadyWorkers,
+		ReconcilePolicy:         orchConfig.ReconcilePolicy,
+		StreamBufferSize:        orchConfig.StreamBufferSize,
+		InstanceRegistry:        registry,
+		WorkerProviderFactory:   orchConfig.WorkerAgentProvider,
 	})
 	if err != nil {
 		log.Error(log.CatMode, "Failed to create Supervisor", "error", err)
-		return nil
+		return nil, nil
 	}
 
 	// Create recovery executor for automatic recovery actions
@@ -1279,7 +1312,7 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 	})
 	if err != nil {
 		log.Error(log.CatOrch, "Failed to create RecoveryExecutor", "error", err)
-		return nil
+		return nil, nil
 	}
 
 	// Create health monitor for workflow health tracking
@@ -1312,15 +1345,27 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 	}()
 
 	cp, err := controlplane.NewControlPlane(controlplane.ControlPlaneConfig{
-		Registry:      registry,
-		Supervisor:    supervisor,
-		EventBus:      eventBus,
-		HealthMonitor: healthMonitor,
+		Registry:          registry,
+		Supervisor:        supervisor,
+		EventBus:          eventBus,
+		HealthMonitor:     healthMonitor,
+		CapacityAllocator: capacity,
+		GitExecutor:       m.services.GitExecutorFactory(m.services.WorkDir),
 	})
 	if err != nil {
 		log.Error(log.CatMode, "Failed to create ControlPlane", "error", err)
-		return nil
+		return nil, nil
+	}
+
+	orchCfg := orchestrator.Config{Pool: capacity, ControlPlane: cp}
+	if orchConfig.RemoveWorktreesOnShutdown {
+		orchCfg.Worktrees = cp
+	}
+	orch, err := orchestrator.New(orchCfg)
+	if err != nil {
+		log.Error(log.CatMode, "Failed to create orchestrator", "error", err)
+		return nil, nil
 	}
 
-	return cp
+	return cp, orch
 }
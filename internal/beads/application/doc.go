@@ -19,13 +19,19 @@
 // The package defines several port interfaces:
 //   - VersionReader: reads database version
 //   - CommentReader: reads issue comments
+//   - CommentWriter: adds issue comments via CLI
 //   - IssueReader: reads issue details
 //   - IssueWriter: mutates issues via CLI
 //
 // # Infrastructure Adapters
 //
 // SQLiteClient implements the read ports (VersionReader, CommentReader).
-// BDExecutor implements both IssueReader and IssueWriter via the bd CLI.
+// BDExecutor implements both IssueReader and IssueWriter (including CommentWriter) via the bd CLI.
+//
+// # Service
+//
+// Service wraps a bql.BQLExecutor with typed queries, such as GetSubtree for an
+// epic and all its descendants, so callers don't build raw BQL strings.
 //
 // # Import Aliasing
 //
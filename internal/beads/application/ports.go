@@ -12,6 +12,11 @@ type CommentReader interface {
 	GetComments(issueID string) ([]domain.Comment, error)
 }
 
+// CommentWriter adds comments to issues.
+type CommentWriter interface {
+	AddComment(issueID, author, text string) error
+}
+
 // IssueReader reads issue details.
 type IssueReader interface {
 	ShowIssue(issueID string) (*domain.Issue, error)
@@ -19,7 +24,9 @@ type IssueReader interface {
 
 // IssueWriter provides write operations for issues.
 type IssueWriter interface {
+	CommentWriter
 	UpdateStatus(issueID string, status domain.Status) error
+	BulkUpdateStatus(issueIDs []string, status domain.Status) error
 	UpdatePriority(issueID string, priority domain.Priority) error
 	UpdateType(issueID string, issueType domain.IssueType) error
 	UpdateTitle(issueID, title string) error
@@ -28,8 +35,9 @@ type IssueWriter interface {
 	CloseIssue(issueID, reason string) error
 	ReopenIssue(issueID string) error
 	SetLabels(issueID string, labels []string) error
-	AddComment(issueID, author, text string) error
+	SetAssignee(issueID, assignee string) error
 	CreateEpic(title, description string, labels []string) (domain.CreateResult, error)
+	CreateEpicWithPrefix(prefix, title, description string, labels []string) (domain.CreateResult, error)
 	CreateTask(title, description, parentID, assignee string, labels []string) (domain.CreateResult, error)
 	DeleteIssues(issueIDs []string) error
 	AddDependency(taskID, dependsOnID string) error
@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
+)
+
+// Service provides typed, high-level issue queries so callers don't build raw BQL.
+type Service struct {
+	executor bql.BQLExecutor
+}
+
+// NewService creates a Service that runs its queries with executor.
+func NewService(executor bql.BQLExecutor) *Service {
+	return &Service{executor: executor}
+}
+
+// GetSubtree returns rootID and every issue below it, at any depth, in a single query.
+// The result is empty if rootID does not exist.
+func (s *Service) GetSubtree(ctx context.Context, rootID string) ([]domain.Issue, error) {
+	if rootID == "" {
+		return nil, errors.New("root issue ID is required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	issues, err := s.executor.Execute(bql.BuildSubtreeQuery(rootID))
+	if err != nil {
+		return nil, fmt.Errorf("loading subtree of %s: %w", rootID, err)
+	}
+	return issues, nil
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+func TestService_GetSubtree(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+	subtree := []domain.Issue{
+		{ID: "perles-abc", Type: domain.TypeEpic},
+		{ID: "perles-abc.1", ParentID: "perles-abc"},
+		{ID: "perles-abc.1.1", ParentID: "perles-abc.1"},
+	}
+	executor.EXPECT().Execute(`id = "perles-abc" expand down depth *`).Return(subtree, nil)
+
+	issues, err := NewService(executor).GetSubtree(context.Background(), "perles-abc")
+	require.NoError(t, err)
+	require.Equal(t, subtree, issues)
+}
+
+func TestService_GetSubtree_WrapsExecutorError(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+	dbErr := errors.New("database locked")
+	executor.EXPECT().Execute(`id = "perles-abc" expand down depth *`).Return(nil, dbErr)
+
+	_, err := NewService(executor).GetSubtree(context.Background(), "perles-abc")
+	require.ErrorIs(t, err, dbErr)
+	require.ErrorContains(t, err, "loading subtree of perles-abc")
+}
+
+func TestService_GetSubtree_RejectsEmptyRoot(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+
+	_, err := NewService(executor).GetSubtree(context.Background(), "")
+	require.ErrorContains(t, err, "root issue ID is required")
+}
+
+func TestService_GetSubtree_CanceledContext(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewService(executor).GetSubtree(ctx, "perles-abc")
+	require.ErrorIs(t, err, context.Canceled)
+}
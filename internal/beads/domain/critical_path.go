@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CriticalPath is the longest dependency chain through a set of issues.
+type CriticalPath struct {
+	// IssueIDs lists the issues on the path, each blocking the next.
+	IssueIDs []string
+	// Length is the summed weight of the path: estimated minutes when Estimated is set,
+	// otherwise the number of issues on it.
+	Length int
+	// Estimated is true when every issue had an estimate and estimates were used as weights.
+	Estimated bool
+}
+
+// DependencyCycleError reports issues whose blocking dependencies form a cycle.
+type DependencyCycleError struct {
+	IssueIDs []string // Issues on or between cycles, sorted
+}
+
+// Error implements the error interface.
+func (e *DependencyCycleError) Error() string {
+	return fmt.Sprintf("dependency cycle among issues: %s", strings.Join(e.IssueIDs, ", "))
+}
+
+// FindCriticalPath returns the longest chain of blocking dependencies through issues.
+// Only dependencies between the given issues are considered, from both BlockedBy and Blocks.
+// Issues are weighted by EstimatedMinutes when every issue has an estimate, and by one
+// otherwise. Ties are broken by issue ID so the result is deterministic.
+// Returns a *DependencyCycleError if the dependencies contain a cycle.
+func FindCriticalPath(issues []Issue) (CriticalPath, error) {
+	if len(issues) == 0 {
+		return CriticalPath{}, nil
+	}
+
+	ids := make([]string, 0, len(issues))
+	estimated := true
+	weight := make(map[string]int, len(issues))
+	for _, issue := range issues {
+		if _, dup := weight[issue.ID]; dup {
+			continue
+		}
+		ids = append(ids, issue.ID)
+		weight[issue.ID] = issue.EstimatedMinutes
+		if issue.EstimatedMinutes <= 0 {
+			estimated = false
+		}
+	}
+	slices.Sort(ids)
+	if !estimated {
+		for id := range weight {
+			weight[id] = 1
+		}
+	}
+
+	// Build blocker -> blocked edges between known issues
+	blocks := make(map[string][]string, len(ids))
+	indegree := make(map[string]int, len(ids))
+	seen := make(map[[2]string]bool)
+	addEdge := func(from, to string) {
+		if _, ok := weight[from]; !ok {
+			return
+		}
+		if _, ok := weight[to]; !ok {
+			return
+		}
+		edge := [2]string{from, to}
+		if seen[edge] {
+			return
+		}
+		seen[edge] = true
+		blocks[from] = append(blocks[from], to)
+		indegree[to]++
+	}
+	for _, issue := range issues {
+		for _, blocker := range issue.BlockedBy {
+			addEdge(blocker, issue.ID)
+		}
+		for _, blocked := range issue.Blocks {
+			addEdge(issue.ID, blocked)
+		}
+	}
+
+	// Visit issues in topological order, tracking the heaviest chain ending at each
+	var queue []string
+	for _, id := range ids {
+		if indegree[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+	dist := make(map[string]int, len(ids))
+	prev := make(map[string]string, len(ids))
+	visited := 0
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		visited++
+		dist[id] += weight[id]
+
+		next := blocks[id]
+		slices.Sort(next)
+		for _, to := range next {
+			if dist[id] > dist[to] || (dist[id] == dist[to] && prev[to] != "" && id < prev[to]) {
+				dist[to] = dist[id]
+				prev[to] = id
+			}
+			indegree[to]--
+			if indegree[to] == 0 {
+				queue = append(queue, to)
+			}
+		}
+	}
+
+	if visited < len(ids) {
+		return CriticalPath{}, &DependencyCycleError{IssueIDs: cycleMembers(ids, blocks, indegree)}
+	}
+
+	end := ids[0]
+	for _, id := range ids[1:] {
+		if dist[id] > dist[end] {
+			end = id
+		}
+	}
+	var path []string
+	for id := end; id != ""; id = prev[id] {
+		path = append(path, id)
+	}
+	slices.Reverse(path)
+
+	return CriticalPath{IssueIDs: path, Length: dist[end], Estimated: estimated}, nil
+}
+
+// cycleMembers returns the issues left unvisited by the topological sort that still
+// lead back into a cycle. Issues that are only downstream of a cycle are trimmed by
+// repeatedly removing issues that block nothing else left over.
+func cycleMembers(ids []string, blocks map[string][]string, indegree map[string]int) []string {
+	remaining := make(map[string]bool)
+	for _, id := range ids {
+		if indegree[id] > 0 {
+			remaining[id] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for id := range remaining {
+			if !slices.ContainsFunc(blocks[id], func(to string) bool { return remaining[to] }) {
+				delete(remaining, id)
+				changed = true
+			}
+		}
+	}
+
+	members := make([]string, 0, len(remaining))
+	for id := range remaining {
+		members = append(members, id)
+	}
+	slices.Sort(members)
+	return members
+}
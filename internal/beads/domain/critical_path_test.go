@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCriticalPath_KnownDAG(t *testing.T) {
+	// a -> b -> d -> f
+	// a -> c -> f
+	// e (isolated)
+	issues := []Issue{
+		{ID: "a"},
+		{ID: "b", BlockedBy: []string{"a"}},
+		{ID: "c", BlockedBy: []string{"a"}},
+		{ID: "d", BlockedBy: []string{"b"}},
+		{ID: "e"},
+		{ID: "f", BlockedBy: []string{"c", "d"}},
+	}
+
+	path, err := FindCriticalPath(issues)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "d", "f"}, path.IssueIDs)
+	require.Equal(t, 4, path.Length)
+	require.False(t, path.Estimated)
+}
+
+func TestFindCriticalPath_UsesEstimatesWhenAllPresent(t *testing.T) {
+	// The short branch through c outweighs the longer chain through b and d
+	issues := []Issue{
+		{ID: "a", EstimatedMinutes: 10},
+		{ID: "b", EstimatedMinutes: 5, BlockedBy: []string{"a"}},
+		{ID: "c", EstimatedMinutes: 120, BlockedBy: []string{"a"}},
+		{ID: "d", EstimatedMinutes: 5, BlockedBy: []string{"b"}},
+		{ID: "f", EstimatedMinutes: 15, BlockedBy: []string{"c", "d"}},
+	}
+
+	path, err := FindCriticalPath(issues)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c", "f"}, path.IssueIDs)
+	require.Equal(t, 145, path.Length)
+	require.True(t, path.Estimated)
+}
+
+func TestFindCriticalPath_MissingEstimateFallsBackToUnitWeights(t *testing.T) {
+	issues := []Issue{
+		{ID: "a", EstimatedMinutes: 10},
+		{ID: "b", BlockedBy: []string{"a"}},
+		{ID: "c", EstimatedMinutes: 500},
+	}
+
+	path, err := FindCriticalPath(issues)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, path.IssueIDs)
+	require.Equal(t, 2, path.Length)
+	require.False(t, path.Estimated)
+}
+
+func TestFindCriticalPath_UsesBlocksAndIgnoresExternalIssues(t *testing.T) {
+	issues := []Issue{
+		{ID: "a", Blocks: []string{"b"}, BlockedBy: []string{"outside"}},
+		{ID: "b", Blocks: []string{"elsewhere"}},
+	}
+
+	path, err := FindCriticalPath(issues)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, path.IssueIDs)
+}
+
+func TestFindCriticalPath_TiesBrokenByID(t *testing.T) {
+	issues := []Issue{
+		{ID: "z"},
+		{ID: "y", BlockedBy: []string{"z"}},
+		{ID: "b"},
+		{ID: "c", BlockedBy: []string{"b"}},
+	}
+
+	path, err := FindCriticalPath(issues)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c"}, path.IssueIDs)
+}
+
+func TestFindCriticalPath_Empty(t *testing.T) {
+	path, err := FindCriticalPath(nil)
+	require.NoError(t, err)
+	require.Empty(t, path.IssueIDs)
+	require.Zero(t, path.Length)
+}
+
+func TestFindCriticalPath_CycleReportsOffendingIssues(t *testing.T) {
+	// b -> c -> d -> b is a cycle; a feeds it and e hangs off it
+	issues := []Issue{
+		{ID: "a"},
+		{ID: "b", BlockedBy: []string{"a", "d"}},
+		{ID: "c", BlockedBy: []string{"b"}},
+		{ID: "d", BlockedBy: []string{"c"}},
+		{ID: "e", BlockedBy: []string{"d"}},
+	}
+
+	_, err := FindCriticalPath(issues)
+	var cycleErr *DependencyCycleError
+	require.True(t, errors.As(err, &cycleErr))
+	require.Equal(t, []string{"b", "c", "d"}, cycleErr.IssueIDs)
+	require.EqualError(t, err, "dependency cycle among issues: b, c, d")
+}
+
+func TestFindCriticalPath_SelfBlockingIsCycle(t *testing.T) {
+	_, err := FindCriticalPath([]Issue{{ID: "a", BlockedBy: []string{"a"}}})
+
+	var cycleErr *DependencyCycleError
+	require.True(t, errors.As(err, &cycleErr))
+	require.Equal(t, []string{"a"}, cycleErr.IssueIDs)
+}
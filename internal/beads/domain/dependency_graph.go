@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// DependencyEdgeKind identifies what a DependencyEdge records.
+type DependencyEdgeKind string
+
+const (
+	// EdgeBlockedBy means From cannot start until To is closed.
+	EdgeBlockedBy DependencyEdgeKind = "blocked_by"
+	// EdgeParent means To is the parent (e.g. the epic) of From.
+	EdgeParent DependencyEdgeKind = "parent"
+)
+
+// DependencyNode is an issue in a DependencyGraph.
+type DependencyNode struct {
+	ID     string
+	Title  string
+	Status Status
+	// InCycle is true if the issue is on a cycle of blocking dependencies.
+	InCycle bool
+}
+
+// DependencyEdge is a relationship between two issues in a DependencyGraph.
+type DependencyEdge struct {
+	From string
+	To   string
+	Kind DependencyEdgeKind
+	// InCycle is true for blocked_by edges between issues on the same cycle.
+	InCycle bool
+}
+
+// DependencyGraph is the raw dependency structure of a set of issues.
+type DependencyGraph struct {
+	Nodes []DependencyNode // Sorted by ID
+	Edges []DependencyEdge // Sorted by From, then To, then Kind
+}
+
+// BuildDependencyGraph returns the graph of blocking and parent relationships between
+// issues. Only relationships between the given issues become edges, from BlockedBy,
+// Blocks and ParentID; duplicates are dropped. Cycles are kept as-is, with their issues
+// and blocking edges flagged InCycle.
+func BuildDependencyGraph(issues []Issue) DependencyGraph {
+	nodes := make(map[string]DependencyNode, len(issues))
+	for _, issue := range issues {
+		if _, dup := nodes[issue.ID]; !dup {
+			nodes[issue.ID] = DependencyNode{ID: issue.ID, Title: issue.TitleText, Status: issue.Status}
+		}
+	}
+
+	seen := make(map[DependencyEdge]bool)
+	var edges []DependencyEdge
+	addEdge := func(from, to string, kind DependencyEdgeKind) {
+		if _, ok := nodes[from]; !ok {
+			return
+		}
+		if _, ok := nodes[to]; !ok {
+			return
+		}
+		edge := DependencyEdge{From: from, To: to, Kind: kind}
+		if seen[edge] {
+			return
+		}
+		seen[edge] = true
+		edges = append(edges, edge)
+	}
+	for _, issue := range issues {
+		for _, blocker := range issue.BlockedBy {
+			addEdge(issue.ID, blocker, EdgeBlockedBy)
+		}
+		for _, blocked := range issue.Blocks {
+			addEdge(blocked, issue.ID, EdgeBlockedBy)
+		}
+		if issue.ParentID != "" {
+			addEdge(issue.ID, issue.ParentID, EdgeParent)
+		}
+	}
+
+	cycles := blockingCycles(nodes, edges)
+	graph := DependencyGraph{
+		Nodes: make([]DependencyNode, 0, len(nodes)),
+		Edges: make([]DependencyEdge, 0, len(edges)),
+	}
+	for id, node := range nodes {
+		_, node.InCycle = cycles[id]
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	for _, edge := range edges {
+		if edge.Kind == EdgeBlockedBy {
+			from, fromOK := cycles[edge.From]
+			to, toOK := cycles[edge.To]
+			edge.InCycle = fromOK && toOK && from == to
+		}
+		graph.Edges = append(graph.Edges, edge)
+	}
+
+	slices.SortFunc(graph.Nodes, func(a, b DependencyNode) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	slices.SortFunc(graph.Edges, func(a, b DependencyEdge) int {
+		return cmp.Or(
+			cmp.Compare(a.From, b.From),
+			cmp.Compare(a.To, b.To),
+			cmp.Compare(a.Kind, b.Kind),
+		)
+	})
+	return graph
+}
+
+// blockingCycles finds the strongly connected components of the blocked_by edges and
+// returns, for each issue on a cycle, the index of its component. Issues not on a cycle
+// are absent. A component is a cycle if it has more than one issue or a self-loop.
+func blockingCycles(nodes map[string]DependencyNode, edges []DependencyEdge) map[string]int {
+	next := make(map[string][]string, len(nodes))
+	selfLoop := make(map[string]bool)
+	for _, edge := range edges {
+		if edge.Kind != EdgeBlockedBy {
+			continue
+		}
+		next[edge.From] = append(next[edge.From], edge.To)
+		if edge.From == edge.To {
+			selfLoop[edge.From] = true
+		}
+	}
+
+	ids := make([]string, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	// Tarjan's algorithm
+	index := make(map[string]int, len(ids))
+	lowlink := make(map[string]int, len(ids))
+	onStack := make(map[string]bool)
+	var stack []string
+	cycles := make(map[string]int)
+	component := 0
+
+	var visit func(id string)
+	visit = func(id string) {
+		index[id] = len(index)
+		lowlink[id] = index[id]
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, to := range next[id] {
+			if _, visited := index[to]; !visited {
+				visit(to)
+				lowlink[id] = min(lowlink[id], lowlink[to])
+			} else if onStack[to] {
+				lowlink[id] = min(lowlink[id], index[to])
+			}
+		}
+
+		if lowlink[id] != index[id] {
+			return
+		}
+		var members []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			members = append(members, top)
+			if top == id {
+				break
+			}
+		}
+		if len(members) > 1 || selfLoop[id] {
+			for _, m := range members {
+				cycles[m] = component
+			}
+		}
+		component++
+	}
+
+	for _, id := range ids {
+		if _, visited := index[id]; !visited {
+			visit(id)
+		}
+	}
+	return cycles
+}
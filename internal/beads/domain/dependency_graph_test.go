@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDependencyGraph_NodesAndEdges(t *testing.T) {
+	// epic <- a, b, c (parent); b blocked by a; c blocked by b (via Blocks); x is outside
+	issues := []Issue{
+		{ID: "epic", TitleText: "Epic", Status: StatusOpen, Children: []string{"a", "b", "c"}},
+		{ID: "a", TitleText: "First", Status: StatusClosed, ParentID: "epic", Blocks: []string{"b"}},
+		{ID: "b", TitleText: "Second", Status: StatusInProgress, ParentID: "epic", BlockedBy: []string{"a", "x"}, Blocks: []string{"c"}},
+		{ID: "c", TitleText: "Third", Status: StatusOpen, ParentID: "epic"},
+	}
+
+	graph := BuildDependencyGraph(issues)
+
+	require.Equal(t, []DependencyNode{
+		{ID: "a", Title: "First", Status: StatusClosed},
+		{ID: "b", Title: "Second", Status: StatusInProgress},
+		{ID: "c", Title: "Third", Status: StatusOpen},
+		{ID: "epic", Title: "Epic", Status: StatusOpen},
+	}, graph.Nodes)
+	require.Equal(t, []DependencyEdge{
+		{From: "a", To: "epic", Kind: EdgeParent},
+		{From: "b", To: "a", Kind: EdgeBlockedBy},
+		{From: "b", To: "epic", Kind: EdgeParent},
+		{From: "c", To: "b", Kind: EdgeBlockedBy},
+		{From: "c", To: "epic", Kind: EdgeParent},
+	}, graph.Edges, "a blocker recorded on both issues yields one edge; x is not in the graph")
+}
+
+func TestBuildDependencyGraph_FlagsCycles(t *testing.T) {
+	// a <-> b is a cycle; c is blocked by the cycle but not on it; d blocks itself
+	issues := []Issue{
+		{ID: "a", BlockedBy: []string{"b"}},
+		{ID: "b", BlockedBy: []string{"a"}},
+		{ID: "c", BlockedBy: []string{"b"}},
+		{ID: "d", BlockedBy: []string{"d"}},
+	}
+
+	graph := BuildDependencyGraph(issues)
+
+	require.Equal(t, []DependencyNode{
+		{ID: "a", InCycle: true},
+		{ID: "b", InCycle: true},
+		{ID: "c"},
+		{ID: "d", InCycle: true},
+	}, graph.Nodes)
+	require.Equal(t, []DependencyEdge{
+		{From: "a", To: "b", Kind: EdgeBlockedBy, InCycle: true},
+		{From: "b", To: "a", Kind: EdgeBlockedBy, InCycle: true},
+		{From: "c", To: "b", Kind: EdgeBlockedBy},
+		{From: "d", To: "d", Kind: EdgeBlockedBy, InCycle: true},
+	}, graph.Edges)
+}
+
+func TestBuildDependencyGraph_Empty(t *testing.T) {
+	graph := BuildDependencyGraph(nil)
+	require.Empty(t, graph.Nodes)
+	require.Empty(t, graph.Edges)
+}
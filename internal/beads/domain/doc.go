@@ -21,6 +21,11 @@
 // The package provides version comparison utilities for ensuring compatibility
 // with the beads database format.
 //
+// # Dependency Graphs
+//
+// FindCriticalPath computes the longest chain of blocking dependencies through a set
+// of issues, weighted by effort estimates when available.
+//
 // # Import Aliasing
 //
 // Note: There is also an application beads package for service orchestration.
@@ -58,6 +58,7 @@ type Issue struct {
 	Priority           Priority  `json:"priority"`
 	Type               IssueType `json:"type"`
 	Assignee           string    `json:"assignee"`
+	EstimatedMinutes   int       `json:"estimated_minutes,omitempty"` // Optional effort estimate
 	Sender             string    `json:"sender,omitempty"`
 	Ephemeral          bool      `json:"ephemeral,omitempty"`
 	Pinned             *bool     `json:"pinned,omitempty"`
@@ -109,6 +110,6 @@ type UpdateIssueOptions struct {
 	Priority    *Priority
 	Status      *Status
 	Labels      *[]string  // nil = unchanged, &[]string{} = clear all
-	Assignee    *string    // proactive; not used by current editor
+	Assignee    *string    // &"" = unassign
 	Type        *IssueType // proactive; not used by current editor
 }
@@ -14,8 +14,11 @@ import (
 	"github.com/zjrosen/perles/internal/log"
 )
 
-// Compile-time check that BDExecutor implements IssueExecutor.
-var _ appbeads.IssueExecutor = (*BDExecutor)(nil)
+// Compile-time checks that BDExecutor implements IssueExecutor and CommentWriter.
+var (
+	_ appbeads.IssueExecutor = (*BDExecutor)(nil)
+	_ appbeads.CommentWriter = (*BDExecutor)(nil)
+)
 
 // BDExecutor implements IssueExecutor by executing actual BD CLI commands.
 type BDExecutor struct {
@@ -74,6 +77,31 @@ func (e *BDExecutor) UpdateStatus(issueID string, status domain.Status) error {
 	return nil
 }
 
+// BulkUpdateStatus changes the status of several issues in a single bd CLI call.
+// An empty issueIDs slice is a no-op.
+func (e *BDExecutor) BulkUpdateStatus(issueIDs []string, status domain.Status) error {
+	if len(issueIDs) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "BulkUpdateStatus completed",
+			"count", len(issueIDs),
+			"status", status,
+			"duration", time.Since(start))
+	}()
+
+	args := append([]string{"update"}, issueIDs...)
+	args = append(args, "--status", string(status), "--json")
+
+	if _, err := e.runBeads(args...); err != nil {
+		log.Error(log.CatBeads, "BulkUpdateStatus failed", "count", len(issueIDs), "error", err)
+		return err
+	}
+	return nil
+}
+
 // UpdatePriority changes an issue's priority via bd CLI.
 func (e *BDExecutor) UpdatePriority(issueID string, priority domain.Priority) error {
 	start := time.Now()
@@ -116,6 +144,21 @@ func (e *BDExecutor) UpdateTitle(issueID, title string) error {
 	return nil
 }
 
+// SetAssignee changes an issue's assignee via bd CLI.
+// An empty assignee clears the assignment.
+func (e *BDExecutor) SetAssignee(issueID, assignee string) error {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "SetAssignee completed", "issueID", issueID, "assignee", assignee, "duration", time.Since(start))
+	}()
+
+	if _, err := e.runBeads("update", issueID, "--assignee", assignee, "--json"); err != nil {
+		log.Error(log.CatBeads, "SetAssignee failed", "issueID", issueID, "error", err)
+		return err
+	}
+	return nil
+}
+
 // UpdateDescription changes an issue's description via bd CLI.
 func (e *BDExecutor) UpdateDescription(issueID, description string) error {
 	start := time.Now()
@@ -336,12 +379,22 @@ func (e *BDExecutor) AddComment(issueID, author, text string) error {
 
 // CreateEpic creates a new epic via bd CLI.
 func (e *BDExecutor) CreateEpic(title, description string, labels []string) (domain.CreateResult, error) {
+	return e.CreateEpicWithPrefix("", title, description, labels)
+}
+
+// CreateEpicWithPrefix creates a new epic via bd CLI with its ID under the given prefix
+// (e.g. "feat1-abc"). Tasks created under the epic inherit the prefix through their
+// hierarchical IDs. An empty prefix uses the database's default prefix.
+func (e *BDExecutor) CreateEpicWithPrefix(prefix, title, description string, labels []string) (domain.CreateResult, error) {
 	start := time.Now()
 	defer func() {
-		log.Debug(log.CatBeads, "CreateEpic completed", "title", title, "duration", time.Since(start))
+		log.Debug(log.CatBeads, "CreateEpic completed", "title", title, "prefix", prefix, "duration", time.Since(start))
 	}()
 
 	args := []string{"create", title, "-t", "epic", "-d", description, "--json"}
+	if prefix != "" {
+		args = append(args, "--prefix", prefix)
+	}
 	for _, l := range labels {
 		args = append(args, "--label", l)
 	}
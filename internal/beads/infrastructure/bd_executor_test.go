@@ -47,6 +47,15 @@ func TestBDExecutor_UpdateDescription_MethodExists(t *testing.T) {
 	require.NotNil(t, updateDescFunc, "UpdateDescription method should exist")
 }
 
+// TestBDExecutor_SetAssignee_MethodExists verifies SetAssignee exists with correct signature.
+func TestBDExecutor_SetAssignee_MethodExists(t *testing.T) {
+	executor := NewBDExecutor("", "")
+
+	var setAssigneeFunc func(issueID, assignee string) error = executor.SetAssignee
+
+	require.NotNil(t, setAssigneeFunc, "SetAssignee method should exist")
+}
+
 // TestBDExecutor_MethodSignatureConsistency verifies UpdateTitle has same signature as UpdateDescription.
 func TestBDExecutor_MethodSignatureConsistency(t *testing.T) {
 	executor := NewBDExecutor("", "")
@@ -390,3 +399,70 @@ func TestUpdateIssueOptions_ZeroValue(t *testing.T) {
 	require.Nil(t, opts.Assignee)
 	require.Nil(t, opts.Type)
 }
+
+// TestBDExecutor_ImplementsCommentWriter verifies BDExecutor implements CommentWriter.
+func TestBDExecutor_ImplementsCommentWriter(t *testing.T) {
+	var _ appbeads.CommentWriter = (*BDExecutor)(nil)
+}
+
+// TestBDExecutor_AddComment_Args verifies the bd comment invocation.
+func TestBDExecutor_AddComment_Args(t *testing.T) {
+	var captured []string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		captured = args
+		return "", nil
+	})
+
+	err := executor.AddComment("PROJ-1", "coordinator", "Review verdict: APPROVED")
+	require.NoError(t, err)
+	require.Equal(t, []string{"comment", "PROJ-1", "--author", "coordinator", "--", "Review verdict: APPROVED"}, captured)
+}
+
+// TestBDExecutor_AddComment_TextStartingWithDash verifies text is passed after "--" so it isn't parsed as a flag.
+func TestBDExecutor_AddComment_TextStartingWithDash(t *testing.T) {
+	var captured []string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		captured = args
+		return "", nil
+	})
+
+	err := executor.AddComment("PROJ-1", "worker-1", "--status closed")
+	require.NoError(t, err)
+	require.Equal(t, "--", captured[len(captured)-2])
+	require.Equal(t, "--status closed", captured[len(captured)-1])
+}
+
+// TestBDExecutor_AddComment_ErrorPropagation verifies bd failures are returned.
+func TestBDExecutor_AddComment_ErrorPropagation(t *testing.T) {
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		return "", errors.New("bd comment failed: issue not found")
+	})
+
+	err := executor.AddComment("PROJ-404", "coordinator", "hello")
+	require.EqualError(t, err, "bd comment failed: issue not found")
+}
+
+// TestBDExecutor_BulkUpdateStatus_Args verifies all issue IDs are passed to a single bd update.
+func TestBDExecutor_BulkUpdateStatus_Args(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		return "", nil
+	})
+
+	err := executor.BulkUpdateStatus([]string{"PROJ-1", "PROJ-2"}, domain.StatusClosed)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"update", "PROJ-1", "PROJ-2", "--status", "closed", "--json"}}, calls)
+}
+
+// TestBDExecutor_BulkUpdateStatus_Empty verifies no bd call is made without issue IDs.
+func TestBDExecutor_BulkUpdateStatus_Empty(t *testing.T) {
+	called := false
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		called = true
+		return "", nil
+	})
+
+	require.NoError(t, executor.BulkUpdateStatus(nil, domain.StatusClosed))
+	require.False(t, called)
+}
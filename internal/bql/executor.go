@@ -649,16 +649,32 @@ func BuildIDQuery(ids []string) string {
 		return ""
 	}
 	if len(ids) == 1 {
-		return fmt.Sprintf(`id = %q`, ids[0])
+		return "id = " + quoteString(ids[0])
 	}
 
 	quoted := make([]string, len(ids))
 	for i, id := range ids {
-		quoted[i] = fmt.Sprintf("%q", id)
+		quoted[i] = quoteString(id)
 	}
 	return fmt.Sprintf("id in (%s)", strings.Join(quoted, ", "))
 }
 
+// BuildSubtreeQuery constructs a BQL query that fetches rootID and every issue
+// below it, at any depth.
+func BuildSubtreeQuery(rootID string) string {
+	return "id = " + quoteString(rootID) + " expand down depth *"
+}
+
+// quoteString quotes s as a BQL string literal. BQL strings have no escape
+// sequences: the lexer reads up to the next matching quote, so s is wrapped in
+// double quotes unless it contains one, in which case single quotes are used.
+func quoteString(s string) string {
+	if strings.Contains(s, `"`) {
+		return "'" + s + "'"
+	}
+	return `"` + s + `"`
+}
+
 // IsBQLQuery returns true if the input looks like a BQL query.
 // This is used to determine whether to use BQL or simple text search.
 func IsBQLQuery(input string) bool {
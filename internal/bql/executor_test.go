@@ -1023,6 +1023,25 @@ func TestBuildIDQuery_SpecialCharacters(t *testing.T) {
 	require.Equal(t, `id in ("ms-8tn.1", "pd-j39")`, result)
 }
 
+func TestBuildSubtreeQuery(t *testing.T) {
+	result := BuildSubtreeQuery("ms-8tn")
+	require.Equal(t, `id = "ms-8tn" expand down depth *`, result)
+
+	query, err := NewParser(result).Parse()
+	require.NoError(t, err, "subtree query must be valid BQL")
+	require.True(t, query.HasExpand())
+}
+
+func TestQuoteString_FollowsLexerRules(t *testing.T) {
+	for _, value := range []string{`ms-8tn`, `C:\path`, `say "hi"`} {
+		query, err := NewParser("title = " + quoteString(value)).Parse()
+		require.NoError(t, err)
+		cmp, ok := query.Filter.(*CompareExpr)
+		require.True(t, ok)
+		require.Equal(t, value, cmp.Value.String, "backslashes and quotes are kept literally")
+	}
+}
+
 func TestExecutor_IDIn_NonExistent(t *testing.T) {
 	db := setupDB(t, (*testutil.Builder).WithStandardTestData)
 	defer func() { _ = db.Close() }()
@@ -145,13 +145,19 @@ type SessionStorageConfig struct {
 	ApplicationName string `mapstructure:"application_name"`
 }
 
-// TemplatesConfig holds user-configurable template variables.
-// These values are injected into template rendering context as {{.Config.key}}.
+// TemplatesConfig holds user-configurable template settings.
+// Variables are injected into template rendering context as {{.Config.key}}.
 type TemplatesConfig struct {
 	// DocumentPath is the base path for generated workflow documents.
 	// Used in templates as {{.Config.document_path}}
 	// Default: "docs/proposals" (applied by ToTemplateConfig() when empty)
 	DocumentPath string `mapstructure:"document_path"`
+
+	// OverlayDir is a templates directory merged over the built-in workflows.
+	// It uses the same layout as ~/.perles (a "workflows" subdirectory), and a
+	// workflow with the same key as a built-in replaces it. Not a template variable.
+	// Default: "" (no overlay)
+	OverlayDir string `mapstructure:"overlay_dir"`
 }
 
 // ToTemplateConfig converts TemplatesConfig to a map for template injection.
@@ -176,6 +182,18 @@ type TimeoutsConfig struct {
 	WorktreeCreation time.Duration `mapstructure:"worktree_creation"`
 }
 
+// TaskPromptLimitConfig bounds the size of task assignment prompts sent to workers.
+type TaskPromptLimitConfig struct {
+	// MaxBytes is the largest task assignment prompt sent to a worker.
+	// Default: 0 (unlimited)
+	MaxBytes int `mapstructure:"max_bytes"`
+
+	// Strategy reduces a prompt over MaxBytes.
+	// Options: "truncate_description", "drop_guidelines", "error"
+	// Default: "truncate_description"
+	Strategy string `mapstructure:"strategy"`
+}
+
 // DefaultTimeoutsConfig returns the default timeout configuration.
 func DefaultTimeoutsConfig() TimeoutsConfig {
 	return TimeoutsConfig{
@@ -188,9 +206,30 @@ type OrchestrationConfig struct {
 	Client            string               `mapstructure:"client"`             // "claude" (default), "amp", "codex", or "gemini" - backward compat
 	CoordinatorClient string               `mapstructure:"coordinator_client"` // Client for coordinator (overrides Client)
 	WorkerClient      string               `mapstructure:"worker_client"`      // Client for workers (overrides Client)
+	WorkerAlternateClient string           `mapstructure:"worker_alternate_client"` // Client workers rotate to while the worker client is rate limited (optional)
 	ObserverClient    string               `mapstructure:"observer_client"`    // Client for observer (default: "claude" with haiku model)
 	ObserverEnabled   bool                 `mapstructure:"observer_enabled"`   // Enable observer agent (default: false)
 	APIPort           int                  `mapstructure:"api_port"`           // HTTP API port (0 = auto-assign, default: 0)
+	MaxWorkers        int                  `mapstructure:"max_workers"`        // Worker slots shared across workflows, granted by priority (0 = unlimited)
+	MinReadyWorkers   int                  `mapstructure:"min_ready_workers"`  // Workers each workflow keeps ready ahead of demand, bounded by max_workers (0 = disabled)
+	MaxConcurrentReviews int               `mapstructure:"max_concurrent_reviews"` // Tasks each workflow may have in review at once (0 = unlimited)
+	RemoveWorktreesOnShutdown bool         `mapstructure:"remove_worktrees_on_shutdown"` // Remove worktrees created for workflows when perles shuts down (default: false)
+	ConfirmDestructiveActions bool         `mapstructure:"confirm_destructive_actions"`  // Require a confirmation token before destructive coordinator tools run (default: false)
+	SensitivePaths    []string             `mapstructure:"sensitive_paths"`    // Globs (e.g. "auth/", "billing/**") whose changes are reviewed even when a workflow skips review
+	WorkerToolReminder bool                `mapstructure:"worker_tool_reminder"` // End each task prompt with a list of the worker's MCP tools (default: false)
+	SyncBeadsStatus   bool                 `mapstructure:"sync_beads_status"` // Mark tasks in_progress in beads when a transfer, replacement or import starts them (default: false)
+	WorkerKeepaliveInterval time.Duration  `mapstructure:"worker_keepalive_interval"` // Idle time after which a ready worker gets a no-op prompt to keep its session warm (0 = disabled)
+	WorkerKeepaliveMax int                 `mapstructure:"worker_keepalive_max"` // Keepalive prompts an idle worker receives between tasks (0 = default of 6)
+	ReconcileInterval time.Duration        `mapstructure:"reconcile_interval"` // How often each workflow checks for orphaned tasks and stuck workers (0 = default of 30s, negative = disabled)
+	WorkerReadyTimeout time.Duration       `mapstructure:"worker_ready_timeout"` // How long a spawned worker may take to signal ready before it is retired (0 = default of 5m)
+	RespawnUnreadyWorkers bool             `mapstructure:"respawn_unready_workers"` // Replace workers retired for never signaling ready with a fresh worker (default: false)
+	ReconcilePolicy   string               `mapstructure:"reconcile_policy"` // Action on reconcile findings: "log" (default) or "notify_coordinator"
+	StreamBufferSize  int                  `mapstructure:"stream_buffer_size"` // Max line size in bytes when parsing agent CLI output (0 = default of 16MB)
+	MessageContentLimit int                `mapstructure:"message_content_limit"` // Bytes of each message kept in the message log and returned by fabric tools; longer content is truncated, with the full text retrievable (0 = no limit)
+	HandoffThreshold  int                  `mapstructure:"handoff_threshold"` // Coordinator context size in tokens at which a handoff summary is posted automatically (0 = disabled)
+	TaskPromptLimit   TaskPromptLimitConfig `mapstructure:"task_prompt_limit"` // Size limit for task assignment prompts sent to workers (default: unlimited)
+	RequirePassingTests bool               `mapstructure:"require_passing_tests"` // Refuse approve_commit when the task's reported tests fail, unless overridden (default: false)
+	Diagnostics       bool                 `mapstructure:"diagnostics"`       // Register MCP diagnostic tools such as get_instructions, which can expose prompts (default: false)
 	Claude            ClaudeClientConfig   `mapstructure:"claude"`
 	ClaudeWorker      ClaudeClientConfig   `mapstructure:"claude_worker"`   // Worker-specific Claude config (uses claude config if empty)
 	ClaudeObserver    ClaudeClientConfig   `mapstructure:"claude_observer"` // Observer-specific Claude config (uses claude config if empty)
@@ -236,7 +275,11 @@ type OpenCodeClientConfig struct {
 
 // CursorClientConfig holds Cursor-specific settings.
 type CursorClientConfig struct {
-	Model string `mapstructure:"model"` // Model selection (uses Cursor's default if empty)
+	Model         string   `mapstructure:"model"`          // Model selection (uses Cursor's default if empty)
+	ExtraArgs     []string `mapstructure:"extra_args"`     // Extra CLI flags appended after the managed flags
+	MaxConcurrent int      `mapstructure:"max_concurrent"` // Max cursor-agent processes running at once (0 = unlimited)
+	TranscriptDir string   `mapstructure:"transcript_dir"` // Directory full session transcripts are written to (empty = disabled)
+	OutputFormat  string   `mapstructure:"output_format"`  // cursor-agent --output-format: stream-json (default), json or text (not resumable, so rejected when any orchestration process runs on cursor)
 }
 
 // CoordinatorClientType returns the client type for the coordinator.
@@ -291,6 +334,11 @@ func (o OrchestrationConfig) AgentProviders() client.AgentProviders {
 		client.RoleWorker:      client.NewAgentProvider(workerType, o.extensionsForClient(workerType, true)),
 	}
 
+	// The alternate is only useful when it differs from the worker provider
+	if alt := client.ClientType(o.WorkerAlternateClient); alt != "" && alt != workerType {
+		providers[client.RoleWorkerAlternate] = client.NewAgentProvider(alt, o.extensionsForClient(alt, true))
+	}
+
 	if o.IsObserverEnabled() {
 		observerType := o.ObserverClientType()
 		providers[client.RoleObserver] = client.NewAgentProvider(observerType, o.extensionsForObserver(observerType))
@@ -299,6 +347,13 @@ func (o OrchestrationConfig) AgentProviders() client.AgentProviders {
 	return providers
 }
 
+// WorkerAgentProvider returns a worker provider of the given client type configured with
+// that client's worker extensions. Used for workflows that put workers of some agent
+// types on a different provider than the configured worker client.
+func (o OrchestrationConfig) WorkerAgentProvider(clientType client.ClientType) client.AgentProvider {
+	return client.NewAgentProvider(clientType, o.extensionsForClient(clientType, true))
+}
+
 // extensionsForObserver builds extensions for the observer client.
 // Observer defaults to claude.model, with claude_observer.model as override.
 func (o OrchestrationConfig) extensionsForObserver(clientType client.ClientType) map[string]any {
@@ -336,6 +391,18 @@ func (o OrchestrationConfig) extensionsForObserver(clientType client.ClientType)
 		if o.Cursor.Model != "" {
 			extensions[client.ExtCursorModel] = o.Cursor.Model
 		}
+		if len(o.Cursor.ExtraArgs) > 0 {
+			extensions[client.ExtCursorExtraArgs] = o.Cursor.ExtraArgs
+		}
+		if o.Cursor.MaxConcurrent > 0 {
+			extensions[client.ExtCursorMaxConcurrent] = o.Cursor.MaxConcurrent
+		}
+		if o.Cursor.TranscriptDir != "" {
+			extensions[client.ExtCursorTranscriptDir] = o.Cursor.TranscriptDir
+		}
+		if o.Cursor.OutputFormat != "" {
+			extensions[client.ExtCursorOutputFormat] = o.Cursor.OutputFormat
+		}
 	}
 
 	return extensions
@@ -388,6 +455,18 @@ func (o OrchestrationConfig) extensionsForClient(clientType client.ClientType, i
 		if o.Cursor.Model != "" {
 			extensions[client.ExtCursorModel] = o.Cursor.Model
 		}
+		if len(o.Cursor.ExtraArgs) > 0 {
+			extensions[client.ExtCursorExtraArgs] = o.Cursor.ExtraArgs
+		}
+		if o.Cursor.MaxConcurrent > 0 {
+			extensions[client.ExtCursorMaxConcurrent] = o.Cursor.MaxConcurrent
+		}
+		if o.Cursor.TranscriptDir != "" {
+			extensions[client.ExtCursorTranscriptDir] = o.Cursor.TranscriptDir
+		}
+		if o.Cursor.OutputFormat != "" {
+			extensions[client.ExtCursorOutputFormat] = o.Cursor.OutputFormat
+		}
 	}
 
 	return extensions
@@ -598,11 +677,29 @@ func ValidateOrchestration(orch OrchestrationConfig) error {
 		return fmt.Errorf("orchestration.worker_client must be one of %v, got %q", allowedClients, orch.WorkerClient)
 	}
 
+	// Validate worker_alternate_client
+	if orch.WorkerAlternateClient != "" && !isAllowedClient(orch.WorkerAlternateClient) {
+		return fmt.Errorf("orchestration.worker_alternate_client must be one of %v, got %q", allowedClients, orch.WorkerAlternateClient)
+	}
+
 	// Validate observer_client
 	if orch.ObserverClient != "" && !isAllowedClient(orch.ObserverClient) {
 		return fmt.Errorf("orchestration.observer_client must be one of %v, got %q", allowedClients, orch.ObserverClient)
 	}
 
+	// Text output reports no session ID, and every orchestration process is resumed between turns
+	if orch.Cursor.OutputFormat == "text" {
+		clientTypes := []client.ClientType{
+			orch.CoordinatorClientType(), orch.WorkerClientType(), client.ClientType(orch.WorkerAlternateClient),
+		}
+		if orch.ObserverEnabled {
+			clientTypes = append(clientTypes, orch.ObserverClientType())
+		}
+		if slices.Contains(clientTypes, client.ClientCursor) {
+			return fmt.Errorf("orchestration.cursor.output_format \"text\" reports no session ID, so cursor processes cannot be resumed; use \"stream-json\" or \"json\"")
+		}
+	}
+
 	// Validate Amp mode
 	if orch.Amp.Mode != "" {
 		switch orch.Amp.Mode {
@@ -613,6 +710,31 @@ func ValidateOrchestration(orch OrchestrationConfig) error {
 		}
 	}
 
+	// Validate task prompt limit
+	if orch.TaskPromptLimit.MaxBytes < 0 {
+		return fmt.Errorf("orchestration.task_prompt_limit.max_bytes must not be negative, got %d", orch.TaskPromptLimit.MaxBytes)
+	}
+	switch orch.TaskPromptLimit.Strategy {
+	case "", "truncate_description", "drop_guidelines", "error":
+		// Valid
+	default:
+		return fmt.Errorf("orchestration.task_prompt_limit.strategy must be \"truncate_description\", \"drop_guidelines\", or \"error\", got %q", orch.TaskPromptLimit.Strategy)
+	}
+
+	// Validate reconcile loop settings
+	switch orch.ReconcilePolicy {
+	case "", "log", "notify_coordinator":
+		// Valid
+	default:
+		return fmt.Errorf("orchestration.reconcile_policy must be \"log\" or \"notify_coordinator\", got %q", orch.ReconcilePolicy)
+	}
+	if orch.WorkerReadyTimeout < 0 {
+		return fmt.Errorf("orchestration.worker_ready_timeout must not be negative, got %s", orch.WorkerReadyTimeout)
+	}
+	if orch.StreamBufferSize < 0 {
+		return fmt.Errorf("orchestration.stream_buffer_size must not be negative, got %d", orch.StreamBufferSize)
+	}
+
 	// Validate workflows
 	if err := ValidateWorkflows(orch.Workflows); err != nil {
 		return err
@@ -1142,6 +1264,9 @@ orchestration:
   # AI client provider for the workers: "claude" (default), "amp", "codex", "opencode", or "cursor"
   worker_client: claude
 
+  # Provider a worker spawn rotates to when worker_client is rate limited (optional)
+  # worker_alternate_client: codex
+
   # Claude-specific settings (only used when client: claude)
   claude:
     model: opus  # sonnet (default), opus, or haiku
@@ -1162,6 +1287,8 @@ orchestration:
   # Cursor-specific settings (only used when client: cursor)
   # cursor:
   #   model: composer-1  # Model selection (uses Cursor's default if empty)
+  #   max_concurrent: 4  # Max cursor-agent processes running at once; extra spawns wait (0 = unlimited)
+  #   transcript_dir: /tmp/perles-transcripts  # Write each session's full stream-json to {dir}/{worker}-{session}.jsonl
 
   # Workflow templates (Ctrl+P to open picker in orchestration mode)
   # User workflows are loaded from ~/.perles/workflows/*.md
@@ -375,6 +375,67 @@ func TestValidateOrchestration_ValidAmp(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestValidateOrchestration_TaskPromptLimit(t *testing.T) {
+	cfg := OrchestrationConfig{
+		TaskPromptLimit: TaskPromptLimitConfig{MaxBytes: 16384, Strategy: "drop_guidelines"},
+	}
+	require.NoError(t, ValidateOrchestration(cfg))
+
+	cfg.TaskPromptLimit.Strategy = "summarize"
+	err := ValidateOrchestration(cfg)
+	require.ErrorContains(t, err, "orchestration.task_prompt_limit.strategy")
+
+	cfg.TaskPromptLimit = TaskPromptLimitConfig{MaxBytes: -1}
+	err = ValidateOrchestration(cfg)
+	require.ErrorContains(t, err, "orchestration.task_prompt_limit.max_bytes")
+}
+
+func TestValidateOrchestration_ReconcileSettings(t *testing.T) {
+	cfg := OrchestrationConfig{
+		ReconcileInterval:  -1,
+		WorkerReadyTimeout: 2 * time.Minute,
+		ReconcilePolicy:    "notify_coordinator",
+		StreamBufferSize:   1 << 20,
+	}
+	require.NoError(t, ValidateOrchestration(cfg))
+
+	cfg.ReconcilePolicy = "reassign"
+	require.ErrorContains(t, ValidateOrchestration(cfg), "orchestration.reconcile_policy")
+
+	cfg.ReconcilePolicy = ""
+	cfg.WorkerReadyTimeout = -time.Second
+	require.ErrorContains(t, ValidateOrchestration(cfg), "orchestration.worker_ready_timeout")
+
+	cfg.WorkerReadyTimeout = 0
+	cfg.StreamBufferSize = -1
+	require.ErrorContains(t, ValidateOrchestration(cfg), "orchestration.stream_buffer_size")
+}
+
+func TestValidateOrchestration_CursorTextOutputNotResumable(t *testing.T) {
+	// Text output is accepted while no orchestration process runs on cursor
+	cfg := OrchestrationConfig{
+		Client: "claude",
+		Cursor: CursorClientConfig{OutputFormat: "text"},
+	}
+	require.NoError(t, ValidateOrchestration(cfg))
+
+	for name, mutate := range map[string]func(*OrchestrationConfig){
+		"coordinator":      func(c *OrchestrationConfig) { c.CoordinatorClient = "cursor" },
+		"worker":           func(c *OrchestrationConfig) { c.WorkerClient = "cursor" },
+		"worker_alternate": func(c *OrchestrationConfig) { c.WorkerAlternateClient = "cursor" },
+		"observer":         func(c *OrchestrationConfig) { c.ObserverEnabled, c.ObserverClient = true, "cursor" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			cursorCfg := cfg
+			mutate(&cursorCfg)
+			require.ErrorContains(t, ValidateOrchestration(cursorCfg), "cannot be resumed")
+
+			cursorCfg.Cursor.OutputFormat = "stream-json"
+			require.NoError(t, ValidateOrchestration(cursorCfg))
+		})
+	}
+}
+
 func TestValidateOrchestration_InvalidClient(t *testing.T) {
 	cfg := OrchestrationConfig{
 		Client: "invalid",
@@ -486,6 +547,14 @@ func TestValidateOrchestration_InvalidWorkerClient(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid")
 }
 
+func TestValidateOrchestration_InvalidWorkerAlternateClient(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{WorkerAlternateClient: "codex"}))
+
+	err := ValidateOrchestration(OrchestrationConfig{WorkerAlternateClient: "invalid"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "orchestration.worker_alternate_client must be one of")
+}
+
 func TestValidateOrchestration_ValidObserverClient(t *testing.T) {
 	clients := []string{"claude", "amp", "codex", "gemini", "opencode", "cursor"}
 	for _, c := range clients {
@@ -1198,7 +1267,24 @@ func TestConfig_OrchestrationTemplates_Struct(t *testing.T) {
 	require.True(t, ok, "TemplatesConfig should have DocumentPath field")
 	require.Equal(t, reflect.String, field.Type.Kind(), "DocumentPath should be a string")
 	require.Equal(t, "document_path", field.Tag.Get("mapstructure"))
-	require.Equal(t, 1, cfgType.NumField(), "TemplatesConfig should only have one field")
+
+	field, ok = cfgType.FieldByName("OverlayDir")
+	require.True(t, ok, "TemplatesConfig should have OverlayDir field")
+	require.Equal(t, reflect.String, field.Type.Kind(), "OverlayDir should be a string")
+	require.Equal(t, "overlay_dir", field.Tag.Get("mapstructure"))
+	require.Equal(t, 2, cfgType.NumField(), "TemplatesConfig should only have two fields")
+}
+
+func TestConfig_OrchestrationTemplates_OverlayDir(t *testing.T) {
+	cfg := loadConfigFromYAML(t, `
+orchestration:
+  templates:
+    overlay_dir: ".perles-team"
+`)
+
+	require.Equal(t, ".perles-team", cfg.Orchestration.Templates.OverlayDir)
+	require.Equal(t, map[string]string{"document_path": "docs/proposals"},
+		cfg.Orchestration.Templates.ToTemplateConfig(), "overlay_dir is not a template variable")
 }
 
 func TestToTemplateConfig_Default(t *testing.T) {
@@ -1950,6 +2036,25 @@ func TestAgentProviders_DifferentClients(t *testing.T) {
 	require.Equal(t, client.ClientType("amp"), providers[client.RoleWorker].Type())
 }
 
+func TestAgentProviders_WorkerAlternate(t *testing.T) {
+	cfg := OrchestrationConfig{
+		WorkerClient:          "claude",
+		WorkerAlternateClient: "codex",
+		Codex:                 CodexClientConfig{Model: "o4-mini"},
+	}
+	providers := cfg.AgentProviders()
+
+	alt, ok := providers.WorkerAlternate()
+	require.True(t, ok)
+	require.Equal(t, client.ClientCodex, alt.Type())
+	require.Equal(t, "o4-mini", alt.Extensions()[client.ExtCodexModel])
+
+	// An alternate matching the worker provider is ignored
+	cfg.WorkerAlternateClient = "claude"
+	_, ok = cfg.AgentProviders().WorkerAlternate()
+	require.False(t, ok)
+}
+
 func TestAgentProviders_IncludesExtensions(t *testing.T) {
 	cfg := OrchestrationConfig{
 		CoordinatorClient: "claude",
@@ -2000,6 +2105,19 @@ func TestAgentProviders_FallbackToClient(t *testing.T) {
 	require.Equal(t, "gemini-2.5-flash", providers[client.RoleWorker].Extensions()["gemini.model"])
 }
 
+func TestWorkerAgentProvider_UsesWorkerExtensions(t *testing.T) {
+	cfg := OrchestrationConfig{
+		WorkerClient: "cursor",
+		Claude:       ClaudeClientConfig{Model: "opus"},
+		ClaudeWorker: ClaudeClientConfig{Env: map[string]string{"WORKER_KEY": "value"}},
+	}
+	provider := cfg.WorkerAgentProvider(client.ClientClaude)
+
+	require.Equal(t, client.ClientClaude, provider.Type())
+	require.Equal(t, "opus", provider.Extensions()["claude.model"])
+	require.Equal(t, map[string]string{"WORKER_KEY": "value"}, provider.Extensions()["claude.env"])
+}
+
 // ============================================================================
 // extensionsForClient Tests
 // ============================================================================
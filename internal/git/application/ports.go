@@ -21,6 +21,8 @@ type GitExecutor interface {
 	ListWorktrees() ([]domain.WorktreeInfo, error)
 	ListBranches() ([]domain.BranchInfo, error)
 	BranchExists(name string) bool
+	// DeleteBranch force-deletes a local branch, even if it is not merged.
+	DeleteBranch(name string) error
 	// ValidateBranchName validates a branch name using git check-ref-format --branch.
 	// Returns nil if valid, ErrInvalidBranchName if invalid.
 	ValidateBranchName(name string) error
@@ -33,6 +35,25 @@ type GitExecutor interface {
 	IsOnMainBranch() (bool, error)
 	GetRepoRoot() (string, error)
 	HasUncommittedChanges() (bool, error)
+	// StashPush stashes all uncommitted changes, including untracked files, under message.
+	// Returns the stash commit hash, which restores the changes via `git stash apply <hash>`.
+	// Only call when HasUncommittedChanges reports true; otherwise no stash is created.
+	StashPush(message string) (string, error)
+	// StashApply applies the stash commit ref (as returned by StashPush) to the working
+	// tree. The stash entry is kept, so the changes can be applied again if needed.
+	StashApply(ref string) error
+	// SnapshotWorktree records all uncommitted changes, including untracked files, as a
+	// commit whose parent is HEAD, stored under ref (e.g. refs/perles/checkpoints/<task>/1).
+	// HEAD, the index and the working tree are not changed, so diffs against HEAD still
+	// show the changes. A zero author leaves attribution to git's configuration.
+	// Returns the snapshot commit hash.
+	SnapshotWorktree(ref, message string, author domain.Author) (string, error)
+	// WorktreeTree records the working tree, including untracked files, as a tree object
+	// and returns its hash. HEAD, the index and the working tree are not changed.
+	WorktreeTree() (string, error)
+	// ChangedPaths returns the paths that differ between two trees (or commits), such as
+	// two WorktreeTree hashes.
+	ChangedPaths(from, to string) ([]string, error)
 	DetermineWorktreePath(sessionID string) (string, error)
 
 	// Diff operations for viewing git diffs
@@ -46,6 +67,8 @@ type GitExecutor interface {
 	GetWorkingDirDiff() (string, error)
 	// GetUntrackedFiles returns the list of untracked files (new files not yet staged).
 	GetUntrackedFiles() ([]string, error)
+	// GetStatus returns the staged, unstaged and untracked files in the working tree.
+	GetStatus() ([]domain.FileStatus, error)
 	// GetCommitDiff returns the diff for a specific commit (what changed in that commit).
 	GetCommitDiff(hash string) (string, error)
 	// GetFileContent returns the content of a file in the working directory.
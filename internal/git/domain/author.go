@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Author identifies who a commit is attributed to.
+type Author struct {
+	Name  string
+	Email string
+}
+
+// ParseAuthor parses an author in git's "Name <email>" format.
+// Returns ErrInvalidAuthor if the name or email is missing or malformed.
+func ParseAuthor(s string) (Author, error) {
+	s = strings.TrimSpace(s)
+	open := strings.LastIndex(s, "<")
+	if open <= 0 || !strings.HasSuffix(s, ">") {
+		return Author{}, fmt.Errorf("%w: %q must be \"Name <email>\"", ErrInvalidAuthor, s)
+	}
+
+	name := strings.TrimSpace(s[:open])
+	email := strings.TrimSpace(s[open+1 : len(s)-1])
+	if name == "" || strings.ContainsAny(name, "<>\n") {
+		return Author{}, fmt.Errorf("%w: %q has an invalid name", ErrInvalidAuthor, s)
+	}
+	if email == "" || strings.ContainsAny(email, "<> \n") || !strings.Contains(email, "@") {
+		return Author{}, fmt.Errorf("%w: %q has an invalid email", ErrInvalidAuthor, s)
+	}
+
+	return Author{Name: name, Email: email}, nil
+}
+
+// String returns the author in "Name <email>" format.
+func (a Author) String() string {
+	return a.Name + " <" + a.Email + ">"
+}
+
+// Env returns environment variables that attribute commits to this author.
+// Both author and committer are set so the commit is fully attributed.
+func (a Author) Env() []string {
+	return []string{
+		"GIT_AUTHOR_NAME=" + a.Name,
+		"GIT_AUTHOR_EMAIL=" + a.Email,
+		"GIT_COMMITTER_NAME=" + a.Name,
+		"GIT_COMMITTER_EMAIL=" + a.Email,
+	}
+}
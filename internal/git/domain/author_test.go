@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuthor(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Author
+		wantErr bool
+	}{
+		{name: "valid", input: "perles-worker-1 <workflow@bot>", want: Author{Name: "perles-worker-1", Email: "workflow@bot"}},
+		{name: "surrounding whitespace", input: "  Perles Bot  <  bot@example.com >  ", want: Author{Name: "Perles Bot", Email: "bot@example.com"}},
+		{name: "empty", input: "", wantErr: true},
+		{name: "missing email", input: "perles-worker-1", wantErr: true},
+		{name: "missing name", input: "<workflow@bot>", wantErr: true},
+		{name: "empty email", input: "perles <>", wantErr: true},
+		{name: "email without at", input: "perles <workflow>", wantErr: true},
+		{name: "email with space", input: "perles <work flow@bot>", wantErr: true},
+		{name: "unterminated", input: "perles <workflow@bot", wantErr: true},
+		{name: "name with bracket", input: "per>les <workflow@bot>", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAuthor(tt.input)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidAuthor)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthor_StringAndEnv(t *testing.T) {
+	a := Author{Name: "perles-worker-1", Email: "workflow@bot"}
+
+	require.Equal(t, "perles-worker-1 <workflow@bot>", a.String())
+	require.Equal(t, []string{
+		"GIT_AUTHOR_NAME=perles-worker-1",
+		"GIT_AUTHOR_EMAIL=workflow@bot",
+		"GIT_COMMITTER_NAME=perles-worker-1",
+		"GIT_COMMITTER_EMAIL=workflow@bot",
+	}, a.Env())
+}
@@ -25,9 +25,18 @@ var (
 	// ErrInvalidBranchName indicates the branch name format is invalid per git check-ref-format.
 	ErrInvalidBranchName = errors.New("invalid branch name format")
 
+	// ErrBranchAlreadyExists indicates a branch with the requested name already exists.
+	ErrBranchAlreadyExists = errors.New("branch already exists")
+
 	// ErrWorktreeTimeout is returned when a git worktree operation times out.
 	ErrWorktreeTimeout = errors.New("git worktree timed out")
 
+	// ErrWorktreeCancelled is returned when a git worktree operation is cancelled via its context.
+	ErrWorktreeCancelled = errors.New("git worktree cancelled")
+
 	// ErrDiffTimeout is returned when a git diff operation times out.
 	ErrDiffTimeout = errors.New("git diff timed out")
+
+	// ErrInvalidAuthor indicates a commit author is not in "Name <email>" format.
+	ErrInvalidAuthor = errors.New("invalid commit author format")
 )
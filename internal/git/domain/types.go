@@ -25,3 +25,11 @@ type WorktreeInfo struct {
 	Branch string
 	HEAD   string
 }
+
+// FileStatus holds the working tree status of a changed file.
+type FileStatus struct {
+	Path      string // Path relative to the repository root (the new path for renames)
+	Staged    bool   // True if the file has changes in the index
+	Unstaged  bool   // True if the file has working tree changes not yet staged
+	Untracked bool   // True if the file is not tracked by git
+}
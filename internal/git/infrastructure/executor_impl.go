@@ -39,11 +39,20 @@ func (e *RealExecutor) runGit(args ...string) error {
 
 // runGitOutput executes a git command and returns stdout and any error.
 func (e *RealExecutor) runGitOutput(args ...string) (string, error) {
+	return e.runGitOutputEnv(nil, args...)
+}
+
+// runGitOutputEnv executes a git command with env added to the process environment
+// and returns stdout and any error.
+func (e *RealExecutor) runGitOutputEnv(env []string, args ...string) (string, error) {
 	//nolint:gosec // G204: args come from controlled sources
 	cmd := exec.Command("git", args...)
 	if e.workDir != "" {
 		cmd.Dir = e.workDir
 	}
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -236,6 +245,81 @@ func (e *RealExecutor) HasUncommittedChanges() (bool, error) {
 	return output != "", nil
 }
 
+// StashPush stashes all uncommitted changes, including untracked files, and returns
+// the stash commit hash. The hash stays valid even as later stashes shift stash@{n}.
+func (e *RealExecutor) StashPush(message string) (string, error) {
+	if err := e.runGit("stash", "push", "--include-untracked", "-m", message); err != nil {
+		return "", err
+	}
+	return e.runGitOutput("rev-parse", "stash@{0}")
+}
+
+// StashApply applies the stash commit ref to the working tree, keeping the stash entry.
+func (e *RealExecutor) StashApply(ref string) error {
+	return e.runGit("stash", "apply", ref)
+}
+
+// SnapshotWorktree records all uncommitted changes, including untracked files, as a
+// commit on top of HEAD and points ref at it. The changes are staged into a temporary
+// index, so HEAD, the real index and the working tree are left untouched.
+func (e *RealExecutor) SnapshotWorktree(ref, message string, author domain.Author) (string, error) {
+	tree, err := e.WorktreeTree()
+	if err != nil {
+		return "", err
+	}
+
+	var env []string
+	if author != (domain.Author{}) {
+		env = author.Env()
+	}
+	hash, err := e.runGitOutputEnv(env, "commit-tree", tree, "-p", "HEAD", "-m", message)
+	if err != nil {
+		return "", err
+	}
+	if err := e.runGit("update-ref", ref, hash); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// WorktreeTree writes the working tree, including untracked files, as a tree object and
+// returns its hash. The files are staged into a temporary index, so HEAD, the real index
+// and the working tree are left untouched.
+func (e *RealExecutor) WorktreeTree() (string, error) {
+	indexDir, err := os.MkdirTemp("", "perles-snapshot-")
+	if err != nil {
+		return "", fmt.Errorf("creating temporary index: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(indexDir) }()
+
+	env := []string{"GIT_INDEX_FILE=" + filepath.Join(indexDir, "index")}
+	if _, err := e.runGitOutputEnv(env, "read-tree", "HEAD"); err != nil {
+		return "", err
+	}
+	if _, err := e.runGitOutputEnv(env, "add", "--all"); err != nil {
+		return "", err
+	}
+	return e.runGitOutputEnv(env, "write-tree")
+}
+
+// ChangedPaths returns the paths that differ between the trees from and to.
+func (e *RealExecutor) ChangedPaths(from, to string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), diffTimeout)
+	defer cancel()
+
+	output, err := e.runGitOutputWithContext(ctx, "diff-tree", "-r", "--name-only", "-z", from, to)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, p := range strings.Split(output, "\x00") {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths, nil
+}
+
 // unsafeParentDirs lists directories that should never be used as worktree parents.
 var unsafeParentDirs = map[string]bool{
 	"/":        true,
@@ -313,7 +397,9 @@ func isWritable(dir string) bool {
 // CreateWorktreeWithContext creates a new worktree at the specified path with context support.
 // If branch is empty, creates a new branch based on HEAD.
 // The context can be used to cancel/timeout the operation.
-// Returns domain.ErrWorktreeTimeout if the context deadline is exceeded.
+// Returns domain.ErrWorktreeTimeout if the context deadline is exceeded, or
+// domain.ErrWorktreeCancelled if the context is cancelled; in both cases the git
+// process is killed and the caller is responsible for removing any partial worktree.
 func (e *RealExecutor) CreateWorktreeWithContext(ctx context.Context, path, newBranch, baseBranch string) error {
 	// git worktree add -b <new-branch> <path> [<start-point>]
 	// -b creates a new branch; baseBranch is the starting point
@@ -331,6 +417,9 @@ func (e *RealExecutor) CreateWorktreeWithContext(ctx context.Context, path, newB
 		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
 			return fmt.Errorf("%w: git %s", domain.ErrWorktreeTimeout, strings.Join(args, " "))
 		}
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return fmt.Errorf("%w: git %s", domain.ErrWorktreeCancelled, strings.Join(args, " "))
+		}
 		return err
 	}
 	return nil
@@ -485,6 +574,11 @@ func (e *RealExecutor) BranchExists(name string) bool {
 	return err == nil
 }
 
+// DeleteBranch force-deletes a local branch, even if it is not merged.
+func (e *RealExecutor) DeleteBranch(name string) error {
+	return e.runGit("branch", "-D", name)
+}
+
 // ValidateBranchName validates a branch name using git check-ref-format --branch.
 // Returns nil if valid, domain.ErrInvalidBranchName if invalid.
 func (e *RealExecutor) ValidateBranchName(name string) error {
@@ -498,6 +592,9 @@ func (e *RealExecutor) ValidateBranchName(name string) error {
 // diffTimeout is the maximum time allowed for diff operations to prevent hanging.
 const diffTimeout = 5 * time.Second
 
+// gitWaitDelay bounds how long a cancelled git command may wait for its output pipes to close.
+const gitWaitDelay = 2 * time.Second
+
 // runGitOutputWithContext executes a git command with a context for timeout support.
 func (e *RealExecutor) runGitOutputWithContext(ctx context.Context, args ...string) (string, error) {
 	//nolint:gosec // G204: args come from controlled sources
@@ -505,6 +602,9 @@ func (e *RealExecutor) runGitOutputWithContext(ctx context.Context, args ...stri
 	if e.workDir != "" {
 		cmd.Dir = e.workDir
 	}
+	// Children spawned by git (e.g. ssh during a fetch) can hold the output pipes open
+	// after git is killed; don't let them keep Run from returning.
+	cmd.WaitDelay = gitWaitDelay
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -586,6 +686,68 @@ func (e *RealExecutor) GetUntrackedFiles() ([]string, error) {
 	return files, nil
 }
 
+// GetStatus returns the staged, unstaged and untracked files in the working tree.
+// Files inside untracked directories are listed individually.
+// Uses a 5-second timeout to prevent hanging on large repos.
+func (e *RealExecutor) GetStatus() ([]domain.FileStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), diffTimeout)
+	defer cancel()
+
+	output, err := e.runGitOutputWithContext(ctx, "status", "--porcelain=v2", "-z", "--untracked-files=all")
+	if err != nil {
+		return nil, err
+	}
+	return parseStatusPorcelain(output), nil
+}
+
+// parseStatusPorcelain parses the NUL-separated output of git status --porcelain=v2 -z.
+// Formats:
+//
+//	1 XY sub mH mI mW hH hI path                 (changed)
+//	2 XY sub mH mI mW hH hI Xscore path<NUL>orig (renamed or copied)
+//	u XY sub m1 m2 m3 mW h1 h2 h3 path           (unmerged)
+//	? path                                       (untracked)
+//
+// X is the index status and Y the working tree status, with '.' meaning unmodified.
+// Ignored entries are skipped.
+func parseStatusPorcelain(output string) []domain.FileStatus {
+	var files []domain.FileStatus
+	entries := strings.Split(output, "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 3 {
+			continue
+		}
+
+		var n int
+		switch entry[0] {
+		case '?':
+			files = append(files, domain.FileStatus{Path: entry[2:], Untracked: true})
+			continue
+		case '1':
+			n = 9
+		case '2':
+			n = 10
+			i++ // The next entry is the original path
+		case 'u':
+			n = 11
+		default:
+			continue
+		}
+		fields := strings.SplitN(entry, " ", n)
+		if len(fields) != n || len(fields[1]) != 2 {
+			continue
+		}
+
+		files = append(files, domain.FileStatus{
+			Path:     fields[len(fields)-1],
+			Staged:   fields[1][0] != '.',
+			Unstaged: fields[1][1] != '.',
+		})
+	}
+	return files
+}
+
 // GetCommitDiff returns the diff for a specific commit (what changed in that commit).
 // Uses git show to get the commit's patch.
 // Uses a 5-second timeout to prevent hanging on large repos.
@@ -324,6 +324,91 @@ branch refs/heads/main`,
 	}
 }
 
+// TestParseStatusPorcelain tests parsing of git status --porcelain=v2 -z output.
+func TestParseStatusPorcelain(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []domain.FileStatus
+	}{
+		{
+			name: "staged, unstaged and untracked",
+			input: "1 M. N... 100644 100644 100644 abc123 def456 staged.go\x00" +
+				"1 .M N... 100644 100644 100644 abc123 abc123 unstaged.go\x00" +
+				"1 MM N... 100644 100644 100644 abc123 def456 both.go\x00" +
+				"? new dir/file.go\x00",
+			want: []domain.FileStatus{
+				{Path: "staged.go", Staged: true},
+				{Path: "unstaged.go", Unstaged: true},
+				{Path: "both.go", Staged: true, Unstaged: true},
+				{Path: "new dir/file.go", Untracked: true},
+			},
+		},
+		{
+			name:  "rename skips the original path",
+			input: "2 R. N... 100644 100644 100644 abc123 abc123 R100 new.go\x00old.go\x00? other.go\x00",
+			want: []domain.FileStatus{
+				{Path: "new.go", Staged: true},
+				{Path: "other.go", Untracked: true},
+			},
+		},
+		{
+			name:  "unmerged",
+			input: "u UU N... 100644 100644 100644 100644 abc123 def456 fed789 conflict.go\x00",
+			want: []domain.FileStatus{
+				{Path: "conflict.go", Staged: true, Unstaged: true},
+			},
+		},
+		{
+			name:  "ignored and malformed entries are skipped",
+			input: "! build/out.bin\x001 M. truncated\x00",
+			want:  nil,
+		},
+		{
+			name:  "empty input",
+			input: "",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, parseStatusPorcelain(tc.input))
+		})
+	}
+}
+
+// TestRealExecutor_GetStatus tests GetStatus against a temporary repository.
+func TestRealExecutor_GetStatus(t *testing.T) {
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		require.NoError(t, cmd.Run(), "git %v", args)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "tracked.txt"), []byte("one\n"), 0o600))
+	for _, args := range [][]string{{"add", "."}, {"commit", "-m", "initial"}} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		require.NoError(t, cmd.Run(), "git %v", args)
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "tracked.txt"), []byte("two\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "sub"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "sub", "new.txt"), []byte("new\n"), 0o600))
+
+	files, err := NewRealExecutor(repoDir).GetStatus()
+	require.NoError(t, err)
+	require.Equal(t, []domain.FileStatus{
+		{Path: "tracked.txt", Unstaged: true},
+		{Path: "sub/new.txt", Untracked: true},
+	}, files)
+}
+
 // TestParseGitError tests git error parsing.
 func TestParseGitError(t *testing.T) {
 	originalErr := errors.New("exit status 128")
@@ -484,6 +569,36 @@ func TestRealExecutor_CreateWorktreeWithContext_Timeout(t *testing.T) {
 	require.True(t, os.IsNotExist(statErr), "Worktree directory should not exist after timeout")
 }
 
+// TestRealExecutor_CreateWorktreeWithContext_Cancelled tests that a cancelled context is
+// reported as domain.ErrWorktreeCancelled rather than a timeout.
+func TestRealExecutor_CreateWorktreeWithContext_Cancelled(t *testing.T) {
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"git", "init"},
+		{"git", "config", "user.email", "test@test.com"},
+		{"git", "config", "user.name", "Test User"},
+		{"git", "commit", "--allow-empty", "-m", "Initial commit"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+
+	executor := NewRealExecutor(repoDir)
+	worktreePath := filepath.Join(t.TempDir(), "test-worktree-cancelled")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := executor.CreateWorktreeWithContext(ctx, worktreePath, "test-worktree-cancelled-branch", "")
+	require.ErrorIs(t, err, domain.ErrWorktreeCancelled)
+	require.NotErrorIs(t, err, domain.ErrWorktreeTimeout)
+
+	_, statErr := os.Stat(worktreePath)
+	require.True(t, os.IsNotExist(statErr), "Worktree directory should not exist after cancellation")
+}
+
 // TestErrWorktreeTimeout tests the timeout error type.
 func TestErrWorktreeTimeout(t *testing.T) {
 	// Verify the error is defined and usable
@@ -1051,3 +1166,185 @@ func TestParseGitError_InvalidBranchName(t *testing.T) {
 	err := parseGitError("fatal: 'my branch' is not a valid branch name", originalErr)
 	require.ErrorIs(t, err, domain.ErrInvalidBranchName, "parseGitError should return domain.ErrInvalidBranchName for invalid branch name stderr")
 }
+
+// TestRealExecutor_StashPush tests StashPush saves tracked and untracked changes under a restorable hash.
+func TestRealExecutor_StashPush(t *testing.T) {
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"git", "init"},
+		{"git", "config", "user.email", "test@test.com"},
+		{"git", "config", "user.name", "Test User"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+	readme := filepath.Join(repoDir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("# Test\n"), 0644))
+	for _, args := range [][]string{
+		{"git", "add", "."},
+		{"git", "commit", "-m", "Initial commit"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+
+	// Dirty the worktree with a tracked edit and an untracked file
+	require.NoError(t, os.WriteFile(readme, []byte("# Edited\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "notes.txt"), []byte("wip\n"), 0644))
+
+	executor := NewRealExecutor(repoDir)
+	ref, err := executor.StashPush("perles: test stash")
+	require.NoError(t, err)
+	require.Len(t, ref, 40, "StashPush should return the stash commit hash")
+
+	dirty, err := executor.HasUncommittedChanges()
+	require.NoError(t, err)
+	require.False(t, dirty, "worktree should be clean after stashing")
+
+	// The hash restores the changes
+	require.NoError(t, executor.StashApply(ref))
+	content, err := os.ReadFile(readme)
+	require.NoError(t, err)
+	require.Equal(t, "# Edited\n", string(content))
+	require.FileExists(t, filepath.Join(repoDir, "notes.txt"))
+}
+
+// TestRealExecutor_SnapshotWorktree tests SnapshotWorktree records tracked and untracked
+// changes under a ref without touching HEAD, the index or the working tree.
+func TestRealExecutor_SnapshotWorktree(t *testing.T) {
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"git", "init"},
+		{"git", "config", "user.email", "test@test.com"},
+		{"git", "config", "user.name", "Test User"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+	readme := filepath.Join(repoDir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("# Test\n"), 0644))
+	for _, args := range [][]string{
+		{"git", "add", "."},
+		{"git", "commit", "-m", "Initial commit"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+
+	// Dirty the worktree with a tracked edit and an untracked file
+	require.NoError(t, os.WriteFile(readme, []byte("# Edited\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "notes.txt"), []byte("wip\n"), 0644))
+
+	executor := NewRealExecutor(repoDir)
+	head, err := executor.GetCommitLog(1)
+	require.NoError(t, err)
+
+	author := domain.Author{Name: "perles-worker", Email: "workflow@bot"}
+	hash, err := executor.SnapshotWorktree("refs/perles/checkpoints/perles-abc.1/1", "[WIP] checkpoint", author)
+	require.NoError(t, err)
+	require.Len(t, hash, 40, "SnapshotWorktree should return the commit hash")
+
+	// HEAD and the uncommitted changes are untouched
+	after, err := executor.GetCommitLog(1)
+	require.NoError(t, err)
+	require.Equal(t, head[0].Hash, after[0].Hash)
+	dirty, err := executor.HasUncommittedChanges()
+	require.NoError(t, err)
+	require.True(t, dirty, "changes should still be uncommitted")
+	untracked, err := executor.GetUntrackedFiles()
+	require.NoError(t, err)
+	require.Contains(t, untracked, "notes.txt", "untracked file should not be staged")
+
+	// The ref holds both changes, attributed to author
+	commits, err := executor.GetCommitLogForRef("refs/perles/checkpoints/perles-abc.1/1", 1)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, hash, commits[0].Hash)
+	require.Equal(t, "[WIP] checkpoint", commits[0].Subject)
+	require.Equal(t, "perles-worker", commits[0].Author)
+	for path, want := range map[string]string{"README.md": "# Edited\n", "notes.txt": "wip\n"} {
+		cmd := exec.Command("git", "show", hash+":"+path)
+		cmd.Dir = repoDir
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		require.Equal(t, want, string(out))
+	}
+}
+
+// TestRealExecutor_ChangedPathsBetweenWorktreeTrees tests that ChangedPaths between two
+// WorktreeTree hashes lists only the files changed in between, tracked or untracked.
+func TestRealExecutor_ChangedPathsBetweenWorktreeTrees(t *testing.T) {
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"git", "init"},
+		{"git", "config", "user.email", "test@test.com"},
+		{"git", "config", "user.name", "Test User"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+	readme := filepath.Join(repoDir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("# Test\n"), 0644))
+	for _, args := range [][]string{
+		{"git", "add", "."},
+		{"git", "commit", "-m", "Initial commit"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+
+	// An edit made before the base tree is not reported
+	require.NoError(t, os.WriteFile(readme, []byte("# Edited\n"), 0644))
+	executor := NewRealExecutor(repoDir)
+	base, err := executor.WorktreeTree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "auth"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "auth", "token.go"), []byte("package auth\n"), 0644))
+	current, err := executor.WorktreeTree()
+	require.NoError(t, err)
+
+	paths, err := executor.ChangedPaths(base, current)
+	require.NoError(t, err)
+	require.Equal(t, []string{"auth/token.go"}, paths)
+
+	// The worktree itself is untouched
+	untracked, err := executor.GetUntrackedFiles()
+	require.NoError(t, err)
+	require.Contains(t, untracked, "auth/token.go")
+}
+
+// TestRealExecutor_DeleteBranch tests that DeleteBranch removes an unmerged local branch.
+func TestRealExecutor_DeleteBranch(t *testing.T) {
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"git", "init"},
+		{"git", "config", "user.email", "test@test.com"},
+		{"git", "config", "user.name", "Test User"},
+		{"git", "commit", "--allow-empty", "-m", "Initial commit"},
+		{"git", "branch", "doomed"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+
+	executor := NewRealExecutor(repoDir)
+	require.True(t, executor.BranchExists("doomed"))
+	require.NoError(t, executor.DeleteBranch("doomed"))
+	require.False(t, executor.BranchExists("doomed"))
+	require.Error(t, executor.DeleteBranch("doomed"), "deleting a missing branch should fail")
+}
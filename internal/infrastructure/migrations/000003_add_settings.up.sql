@@ -0,0 +1,2 @@
+-- Add settings column for workflow options that must survive a restart (JSON encoded)
+ALTER TABLE sessions ADD COLUMN settings TEXT;
@@ -33,6 +33,9 @@ type SessionModel struct {
 	// Session storage path
 	SessionDir *string // nullable
 
+	// Workflow options
+	Settings *string // nullable, JSON encoded
+
 	// Ownership
 	OwnerCreatedPID *int64 // nullable
 	OwnerCurrentPID *int64 // nullable
@@ -55,6 +58,19 @@ type SessionModel struct {
 	DeletedAt   *int64 // Unix timestamp, nullable
 }
 
+// settingsJSON is the stored form of domain.WorkflowSettings.
+type settingsJSON struct {
+	BeadsPrefix         string            `json:"beads_prefix,omitempty"`
+	MaxWorkflowDuration time.Duration     `json:"max_workflow_duration,omitempty"`
+	MaxWorkers          int               `json:"max_workers,omitempty"`
+	Priority            int               `json:"priority,omitempty"`
+	CommitAuthor        string            `json:"commit_author,omitempty"`
+	DirtyWorktreePolicy string            `json:"dirty_worktree_policy,omitempty"`
+	WorktreeStashRef    string            `json:"worktree_stash_ref,omitempty"`
+	SkipReview          bool              `json:"skip_review,omitempty"`
+	WorkerProviders     map[string]string `json:"worker_providers,omitempty"`
+}
+
 // toSessionModel converts a domain Session entity to a database SessionModel.
 func toSessionModel(s *domain.Session) *SessionModel {
 	m := &SessionModel{
@@ -112,6 +128,11 @@ func toSessionModel(s *domain.Session) *SessionModel {
 		sessionDir := s.SessionDir()
 		m.SessionDir = &sessionDir
 	}
+	settingsData, err := json.Marshal(settingsJSON(s.Settings()))
+	if err == nil && string(settingsData) != "{}" {
+		settings := string(settingsData)
+		m.Settings = &settings
+	}
 	if s.OwnerCreatedPID() != nil {
 		pid := int64(*s.OwnerCreatedPID())
 		m.OwnerCreatedPID = &pid
@@ -187,6 +208,10 @@ func (m *SessionModel) toDomain() *domain.Session {
 	if m.SessionDir != nil {
 		sessionDir = *m.SessionDir
 	}
+	var settings settingsJSON
+	if m.Settings != nil {
+		_ = json.Unmarshal([]byte(*m.Settings), &settings)
+	}
 	var ownerCreatedPID *int
 	if m.OwnerCreatedPID != nil {
 		pid := int(*m.OwnerCreatedPID)
@@ -249,6 +274,7 @@ func (m *SessionModel) toDomain() *domain.Session {
 		worktreePath,
 		worktreeBranch,
 		sessionDir,
+		domain.WorkflowSettings(settings),
 		ownerCreatedPID,
 		ownerCurrentPID,
 		m.TokensUsed,
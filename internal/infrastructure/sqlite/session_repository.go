@@ -11,7 +11,7 @@ import (
 
 // sessionColumns is the list of columns to select for session queries.
 const sessionColumns = `id, guid, project, name, state, template_id, epic_id, work_dir, labels,
-	worktree_enabled, worktree_mode, worktree_base_branch, worktree_branch_name, worktree_path, worktree_branch, session_dir,
+	worktree_enabled, worktree_mode, worktree_base_branch, worktree_branch_name, worktree_path, worktree_branch, session_dir, settings,
 	owner_created_pid, owner_current_pid, tokens_used, active_workers, last_heartbeat_at, last_progress_at,
 	created_at, started_at, paused_at, completed_at, updated_at, archived_at, deleted_at`
 
@@ -35,7 +35,7 @@ func scanSession(scanner interface{ Scan(...any) error }) (*SessionModel, error)
 		&model.ID, &model.GUID, &model.Project, &model.Name, &model.State,
 		&model.TemplateID, &model.EpicID, &model.WorkDir, &model.Labels,
 		&model.WorktreeEnabled, &model.WorktreeMode, &model.WorktreeBaseBranch, &model.WorktreeBranchName,
-		&model.WorktreePath, &model.WorktreeBranch, &model.SessionDir,
+		&model.WorktreePath, &model.WorktreeBranch, &model.SessionDir, &model.Settings,
 		&model.OwnerCreatedPID, &model.OwnerCurrentPID,
 		&model.TokensUsed, &model.ActiveWorkers,
 		&model.LastHeartbeatAt, &model.LastProgressAt,
@@ -56,14 +56,14 @@ func (r *sessionRepository) Save(session *domain.Session) error {
 		result, err := r.db.Exec(
 			`INSERT INTO sessions (
 				guid, project, name, state, template_id, epic_id, work_dir, labels,
-				worktree_enabled, worktree_mode, worktree_base_branch, worktree_branch_name, worktree_path, worktree_branch, session_dir,
+				worktree_enabled, worktree_mode, worktree_base_branch, worktree_branch_name, worktree_path, worktree_branch, session_dir, settings,
 				owner_created_pid, owner_current_pid, tokens_used, active_workers, last_heartbeat_at, last_progress_at,
 				created_at, started_at, paused_at, completed_at, updated_at, archived_at, deleted_at
-			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 			model.GUID, model.Project, model.Name, model.State, model.TemplateID, model.EpicID,
 			model.WorkDir, model.Labels,
 			model.WorktreeEnabled, model.WorktreeMode, model.WorktreeBaseBranch, model.WorktreeBranchName,
-			model.WorktreePath, model.WorktreeBranch, model.SessionDir,
+			model.WorktreePath, model.WorktreeBranch, model.SessionDir, model.Settings,
 			model.OwnerCreatedPID, model.OwnerCurrentPID,
 			model.TokensUsed, model.ActiveWorkers, model.LastHeartbeatAt, model.LastProgressAt,
 			model.CreatedAt, model.StartedAt, model.PausedAt, model.CompletedAt, model.UpdatedAt, model.ArchivedAt, model.DeletedAt,
@@ -83,13 +83,13 @@ func (r *sessionRepository) Save(session *domain.Session) error {
 	_, err := r.db.Exec(
 		`UPDATE sessions SET
 			name = ?, state = ?, template_id = ?, epic_id = ?, work_dir = ?, labels = ?,
-			worktree_enabled = ?, worktree_mode = ?, worktree_base_branch = ?, worktree_branch_name = ?, worktree_path = ?, worktree_branch = ?, session_dir = ?,
+			worktree_enabled = ?, worktree_mode = ?, worktree_base_branch = ?, worktree_branch_name = ?, worktree_path = ?, worktree_branch = ?, session_dir = ?, settings = ?,
 			owner_created_pid = ?, owner_current_pid = ?, tokens_used = ?, active_workers = ?,
 			last_heartbeat_at = ?, last_progress_at = ?,
 			started_at = ?, paused_at = ?, completed_at = ?, updated_at = ?, archived_at = ?, deleted_at = ?
 		WHERE id = ?`,
 		model.Name, model.State, model.TemplateID, model.EpicID, model.WorkDir, model.Labels,
-		model.WorktreeEnabled, model.WorktreeMode, model.WorktreeBaseBranch, model.WorktreeBranchName, model.WorktreePath, model.WorktreeBranch, model.SessionDir,
+		model.WorktreeEnabled, model.WorktreeMode, model.WorktreeBaseBranch, model.WorktreeBranchName, model.WorktreePath, model.WorktreeBranch, model.SessionDir, model.Settings,
 		model.OwnerCreatedPID, model.OwnerCurrentPID, model.TokensUsed, model.ActiveWorkers,
 		model.LastHeartbeatAt, model.LastProgressAt,
 		model.StartedAt, model.PausedAt, model.CompletedAt, model.UpdatedAt, model.ArchivedAt, model.DeletedAt,
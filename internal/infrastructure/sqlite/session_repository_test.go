@@ -330,6 +330,7 @@ func TestSessionRepository_ListWithFilter_OrderByCreatedAtDesc(t *testing.T) {
 		nil, false, "",
 		"", "", "", "",
 		"", // sessionDir
+		domain.WorkflowSettings{},
 		nil, nil, 0, 0, nil, nil,
 		baseTime.Add(-3*time.Second), nil, nil, nil, baseTime.Add(-3*time.Second), nil, nil)
 	err := repo.Save(s1)
@@ -339,6 +340,7 @@ func TestSessionRepository_ListWithFilter_OrderByCreatedAtDesc(t *testing.T) {
 		nil, false, "",
 		"", "", "", "",
 		"", // sessionDir
+		domain.WorkflowSettings{},
 		nil, nil, 0, 0, nil, nil,
 		baseTime.Add(-2*time.Second), nil, nil, nil, baseTime.Add(-2*time.Second), nil, nil)
 	err = repo.Save(s2)
@@ -348,6 +350,7 @@ func TestSessionRepository_ListWithFilter_OrderByCreatedAtDesc(t *testing.T) {
 		nil, false, "",
 		"", "", "", "",
 		"", // sessionDir
+		domain.WorkflowSettings{},
 		nil, nil, 0, 0, nil, nil,
 		baseTime.Add(-1*time.Second), nil, nil, nil, baseTime.Add(-1*time.Second), nil, nil)
 	err = repo.Save(s3)
@@ -517,6 +520,13 @@ func TestSessionModel_RoundTrip(t *testing.T) {
 	deletedAt := now.Add(-time.Hour)
 	ownerCreatedPID := 12345
 	ownerCurrentPID := 67890
+	settings := domain.WorkflowSettings{
+		BeadsPrefix:         "feat1",
+		MaxWorkflowDuration: 90 * time.Minute,
+		Priority:            5,
+		SkipReview:          true,
+		WorkerProviders:     map[string]string{"reviewer": "codex"},
+	}
 	original := domain.ReconstituteSession(
 		123,
 		"test-guid",
@@ -533,6 +543,7 @@ func TestSessionModel_RoundTrip(t *testing.T) {
 		"/worktree/path",
 		"feature/branch",
 		"", // sessionDir
+		settings,
 		&ownerCreatedPID,
 		&ownerCurrentPID,
 		0,
@@ -590,6 +601,7 @@ func TestSessionModel_RoundTrip(t *testing.T) {
 	require.Equal(t, original.WorkDir(), restored.WorkDir())
 	require.Equal(t, original.WorktreePath(), restored.WorktreePath())
 	require.Equal(t, original.WorktreeBranch(), restored.WorktreeBranch())
+	require.Equal(t, settings, restored.Settings())
 	require.NotNil(t, restored.OwnerCreatedPID())
 	require.Equal(t, *original.OwnerCreatedPID(), *restored.OwnerCreatedPID())
 	require.NotNil(t, restored.OwnerCurrentPID())
@@ -622,6 +634,7 @@ func TestSessionModel_RoundTrip_NilDeletedAt(t *testing.T) {
 		"", "",
 		"", "",
 		"", // sessionDir
+		domain.WorkflowSettings{},
 		nil, nil,
 		0,
 		0,
@@ -674,6 +687,7 @@ func TestSessionModel_RoundTrip_WorktreeMode(t *testing.T) {
 		"main", "feature/test",
 		"/worktree/path", "feature/test",
 		"", // sessionDir
+		domain.WorkflowSettings{},
 		nil, nil, 0, 0, nil, nil,
 		now, nil, nil, nil, now, nil, nil,
 	)
@@ -694,6 +708,7 @@ func TestSessionModel_RoundTrip_WorktreeMode_Empty(t *testing.T) {
 		nil, false, "",
 		"", "", "", "",
 		"", // sessionDir
+		domain.WorkflowSettings{},
 		nil, nil, 0, 0, nil, nil,
 		now, nil, nil, nil, now, nil, nil,
 	)
@@ -283,6 +283,7 @@ var Component = struct {
 	ModeToggle key.Binding // Mode toggle (m)
 	Close      key.Binding // Close overlay (ctrl+x)
 	Save       key.Binding // Save action (ctrl+s)
+	Refresh    key.Binding // Refresh action (ctrl+r)
 }{
 	Confirm: key.NewBinding(
 		key.WithKeys("enter"),
@@ -348,6 +349,10 @@ var Component = struct {
 		key.WithKeys("ctrl+s"),
 		key.WithHelp("ctrl+s", "save"),
 	),
+	Refresh: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "refresh"),
+	),
 }
 
 // LogOverlay contains keybindings specific to the log overlay.
@@ -527,7 +532,9 @@ var Dashboard = struct {
 	Enter           key.Binding
 	Start           key.Binding
 	Stop            key.Binding
+	Kill            key.Binding
 	New             key.Binding
+	NewChildIssue   key.Binding
 	Rename          key.Binding
 	Filter          key.Binding
 	ClearFilter     key.Binding
@@ -535,6 +542,7 @@ var Dashboard = struct {
 	Quit            key.Binding
 	CoordinatorChat key.Binding
 	OpenInBrowser   key.Binding
+	TaskBoard       key.Binding
 }{
 	Up: key.NewBinding(
 		key.WithKeys("k", "up"),
@@ -576,10 +584,18 @@ var Dashboard = struct {
 		key.WithKeys("x"),
 		key.WithHelp("x", "pause workflow"),
 	),
+	Kill: key.NewBinding(
+		key.WithKeys("X"),
+		key.WithHelp("X", "kill workflow"),
+	),
 	New: key.NewBinding(
 		key.WithKeys("n", "N"),
 		key.WithHelp("n", "new workflow"),
 	),
+	NewChildIssue: key.NewBinding(
+		key.WithKeys("ctrl+n"),
+		key.WithHelp("ctrl+n", "new child issue"),
+	),
 	Rename: key.NewBinding(
 		key.WithKeys("r"),
 		key.WithHelp("r", "rename workflow"),
@@ -608,6 +624,10 @@ var Dashboard = struct {
 		key.WithKeys("o"),
 		key.WithHelp("o", "open in browser"),
 	),
+	TaskBoard: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "task board"),
+	),
 }
 
 // DiffViewerShortHelp returns keybindings for the short help view (diff viewer).
@@ -68,6 +68,65 @@ func (_c *MockGitExecutor_BranchExists_Call) RunAndReturn(run func(string) bool)
 	return _c
 }
 
+// ChangedPaths provides a mock function with given fields: from, to
+func (_m *MockGitExecutor) ChangedPaths(from string, to string) ([]string, error) {
+	ret := _m.Called(from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangedPaths")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]string, error)); ok {
+		return rf(from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = rf(from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitExecutor_ChangedPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangedPaths'
+type MockGitExecutor_ChangedPaths_Call struct {
+	*mock.Call
+}
+
+// ChangedPaths is a helper method to define mock.On call
+//   - from string
+//   - to string
+func (_e *MockGitExecutor_Expecter) ChangedPaths(from interface{}, to interface{}) *MockGitExecutor_ChangedPaths_Call {
+	return &MockGitExecutor_ChangedPaths_Call{Call: _e.mock.On("ChangedPaths", from, to)}
+}
+
+func (_c *MockGitExecutor_ChangedPaths_Call) Run(run func(from string, to string)) *MockGitExecutor_ChangedPaths_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_ChangedPaths_Call) Return(_a0 []string, _a1 error) *MockGitExecutor_ChangedPaths_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitExecutor_ChangedPaths_Call) RunAndReturn(run func(string, string) ([]string, error)) *MockGitExecutor_ChangedPaths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWorktreeWithContext provides a mock function with given fields: ctx, path, newBranch, baseBranch
 func (_m *MockGitExecutor) CreateWorktreeWithContext(ctx context.Context, path string, newBranch string, baseBranch string) error {
 	ret := _m.Called(ctx, path, newBranch, baseBranch)
@@ -117,6 +176,52 @@ func (_c *MockGitExecutor_CreateWorktreeWithContext_Call) RunAndReturn(run func(
 	return _c
 }
 
+// DeleteBranch provides a mock function with given fields: name
+func (_m *MockGitExecutor) DeleteBranch(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockGitExecutor_DeleteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteBranch is a helper method to define mock.On call
+//   - name string
+func (_e *MockGitExecutor_Expecter) DeleteBranch(name interface{}) *MockGitExecutor_DeleteBranch_Call {
+	return &MockGitExecutor_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch", name)}
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) Run(run func(name string)) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) Return(_a0 error) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) RunAndReturn(run func(string) error) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DetermineWorktreePath provides a mock function with given fields: sessionID
 func (_m *MockGitExecutor) DetermineWorktreePath(sessionID string) (string, error) {
 	ret := _m.Called(sessionID)
@@ -792,6 +897,63 @@ func (_c *MockGitExecutor_GetRepoRoot_Call) RunAndReturn(run func() (string, err
 	return _c
 }
 
+// GetStatus provides a mock function with no fields
+func (_m *MockGitExecutor) GetStatus() ([]domain.FileStatus, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 []domain.FileStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.FileStatus, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.FileStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.FileStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitExecutor_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type MockGitExecutor_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+func (_e *MockGitExecutor_Expecter) GetStatus() *MockGitExecutor_GetStatus_Call {
+	return &MockGitExecutor_GetStatus_Call{Call: _e.mock.On("GetStatus")}
+}
+
+func (_c *MockGitExecutor_GetStatus_Call) Run(run func()) *MockGitExecutor_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_GetStatus_Call) Return(_a0 []domain.FileStatus, _a1 error) *MockGitExecutor_GetStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitExecutor_GetStatus_Call) RunAndReturn(run func() ([]domain.FileStatus, error)) *MockGitExecutor_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUntrackedFiles provides a mock function with no fields
 func (_m *MockGitExecutor) GetUntrackedFiles() ([]string, error) {
 	ret := _m.Called()
@@ -1429,6 +1591,166 @@ func (_c *MockGitExecutor_RemoveWorktree_Call) RunAndReturn(run func(string) err
 	return _c
 }
 
+// SnapshotWorktree provides a mock function with given fields: ref, message, author
+func (_m *MockGitExecutor) SnapshotWorktree(ref string, message string, author domain.Author) (string, error) {
+	ret := _m.Called(ref, message, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnapshotWorktree")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, domain.Author) (string, error)); ok {
+		return rf(ref, message, author)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, domain.Author) string); ok {
+		r0 = rf(ref, message, author)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, domain.Author) error); ok {
+		r1 = rf(ref, message, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitExecutor_SnapshotWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnapshotWorktree'
+type MockGitExecutor_SnapshotWorktree_Call struct {
+	*mock.Call
+}
+
+// SnapshotWorktree is a helper method to define mock.On call
+//   - ref string
+//   - message string
+//   - author domain.Author
+func (_e *MockGitExecutor_Expecter) SnapshotWorktree(ref interface{}, message interface{}, author interface{}) *MockGitExecutor_SnapshotWorktree_Call {
+	return &MockGitExecutor_SnapshotWorktree_Call{Call: _e.mock.On("SnapshotWorktree", ref, message, author)}
+}
+
+func (_c *MockGitExecutor_SnapshotWorktree_Call) Run(run func(ref string, message string, author domain.Author)) *MockGitExecutor_SnapshotWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(domain.Author))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_SnapshotWorktree_Call) Return(_a0 string, _a1 error) *MockGitExecutor_SnapshotWorktree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitExecutor_SnapshotWorktree_Call) RunAndReturn(run func(string, string, domain.Author) (string, error)) *MockGitExecutor_SnapshotWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StashApply provides a mock function with given fields: ref
+func (_m *MockGitExecutor) StashApply(ref string) error {
+	ret := _m.Called(ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StashApply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(ref)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_StashApply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashApply'
+type MockGitExecutor_StashApply_Call struct {
+	*mock.Call
+}
+
+// StashApply is a helper method to define mock.On call
+//   - ref string
+func (_e *MockGitExecutor_Expecter) StashApply(ref interface{}) *MockGitExecutor_StashApply_Call {
+	return &MockGitExecutor_StashApply_Call{Call: _e.mock.On("StashApply", ref)}
+}
+
+func (_c *MockGitExecutor_StashApply_Call) Run(run func(ref string)) *MockGitExecutor_StashApply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_StashApply_Call) Return(_a0 error) *MockGitExecutor_StashApply_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_StashApply_Call) RunAndReturn(run func(string) error) *MockGitExecutor_StashApply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StashPush provides a mock function with given fields: message
+func (_m *MockGitExecutor) StashPush(message string) (string, error) {
+	ret := _m.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StashPush")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(message)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitExecutor_StashPush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashPush'
+type MockGitExecutor_StashPush_Call struct {
+	*mock.Call
+}
+
+// StashPush is a helper method to define mock.On call
+//   - message string
+func (_e *MockGitExecutor_Expecter) StashPush(message interface{}) *MockGitExecutor_StashPush_Call {
+	return &MockGitExecutor_StashPush_Call{Call: _e.mock.On("StashPush", message)}
+}
+
+func (_c *MockGitExecutor_StashPush_Call) Run(run func(message string)) *MockGitExecutor_StashPush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_StashPush_Call) Return(_a0 string, _a1 error) *MockGitExecutor_StashPush_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitExecutor_StashPush_Call) RunAndReturn(run func(string) (string, error)) *MockGitExecutor_StashPush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateBranchName provides a mock function with given fields: name
 func (_m *MockGitExecutor) ValidateBranchName(name string) error {
 	ret := _m.Called(name)
@@ -1475,6 +1797,61 @@ func (_c *MockGitExecutor_ValidateBranchName_Call) RunAndReturn(run func(string)
 	return _c
 }
 
+// WorktreeTree provides a mock function with no fields
+func (_m *MockGitExecutor) WorktreeTree() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorktreeTree")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitExecutor_WorktreeTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeTree'
+type MockGitExecutor_WorktreeTree_Call struct {
+	*mock.Call
+}
+
+// WorktreeTree is a helper method to define mock.On call
+func (_e *MockGitExecutor_Expecter) WorktreeTree() *MockGitExecutor_WorktreeTree_Call {
+	return &MockGitExecutor_WorktreeTree_Call{Call: _e.mock.On("WorktreeTree")}
+}
+
+func (_c *MockGitExecutor_WorktreeTree_Call) Run(run func()) *MockGitExecutor_WorktreeTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_WorktreeTree_Call) Return(_a0 string, _a1 error) *MockGitExecutor_WorktreeTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitExecutor_WorktreeTree_Call) RunAndReturn(run func() (string, error)) *MockGitExecutor_WorktreeTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockGitExecutor creates a new instance of MockGitExecutor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGitExecutor(t interface {
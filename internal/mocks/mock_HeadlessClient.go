@@ -23,6 +23,51 @@ func (_m *MockHeadlessClient) EXPECT() *MockHeadlessClient_Expecter {
 	return &MockHeadlessClient_Expecter{mock: &_m.Mock}
 }
 
+// Capabilities provides a mock function with no fields
+func (_m *MockHeadlessClient) Capabilities() client.Capabilities {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capabilities")
+	}
+
+	var r0 client.Capabilities
+	if rf, ok := ret.Get(0).(func() client.Capabilities); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(client.Capabilities)
+	}
+
+	return r0
+}
+
+// MockHeadlessClient_Capabilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Capabilities'
+type MockHeadlessClient_Capabilities_Call struct {
+	*mock.Call
+}
+
+// Capabilities is a helper method to define mock.On call
+func (_e *MockHeadlessClient_Expecter) Capabilities() *MockHeadlessClient_Capabilities_Call {
+	return &MockHeadlessClient_Capabilities_Call{Call: _e.mock.On("Capabilities")}
+}
+
+func (_c *MockHeadlessClient_Capabilities_Call) Run(run func()) *MockHeadlessClient_Capabilities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHeadlessClient_Capabilities_Call) Return(_a0 client.Capabilities) *MockHeadlessClient_Capabilities_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHeadlessClient_Capabilities_Call) RunAndReturn(run func() client.Capabilities) *MockHeadlessClient_Capabilities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Spawn provides a mock function with given fields: ctx, cfg
 func (_m *MockHeadlessClient) Spawn(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
 	ret := _m.Called(ctx, cfg)
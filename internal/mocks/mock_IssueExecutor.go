@@ -115,6 +115,53 @@ func (_c *MockIssueExecutor_AddDependency_Call) RunAndReturn(run func(string, st
 	return _c
 }
 
+// BulkUpdateStatus provides a mock function with given fields: issueIDs, status
+func (_m *MockIssueExecutor) BulkUpdateStatus(issueIDs []string, status domain.Status) error {
+	ret := _m.Called(issueIDs, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, domain.Status) error); ok {
+		r0 = rf(issueIDs, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueExecutor_BulkUpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateStatus'
+type MockIssueExecutor_BulkUpdateStatus_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateStatus is a helper method to define mock.On call
+//   - issueIDs []string
+//   - status domain.Status
+func (_e *MockIssueExecutor_Expecter) BulkUpdateStatus(issueIDs interface{}, status interface{}) *MockIssueExecutor_BulkUpdateStatus_Call {
+	return &MockIssueExecutor_BulkUpdateStatus_Call{Call: _e.mock.On("BulkUpdateStatus", issueIDs, status)}
+}
+
+func (_c *MockIssueExecutor_BulkUpdateStatus_Call) Run(run func(issueIDs []string, status domain.Status)) *MockIssueExecutor_BulkUpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(domain.Status))
+	})
+	return _c
+}
+
+func (_c *MockIssueExecutor_BulkUpdateStatus_Call) Return(_a0 error) *MockIssueExecutor_BulkUpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueExecutor_BulkUpdateStatus_Call) RunAndReturn(run func([]string, domain.Status) error) *MockIssueExecutor_BulkUpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CloseIssue provides a mock function with given fields: issueID, reason
 func (_m *MockIssueExecutor) CloseIssue(issueID string, reason string) error {
 	ret := _m.Called(issueID, reason)
@@ -220,6 +267,65 @@ func (_c *MockIssueExecutor_CreateEpic_Call) RunAndReturn(run func(string, strin
 	return _c
 }
 
+// CreateEpicWithPrefix provides a mock function with given fields: prefix, title, description, labels
+func (_m *MockIssueExecutor) CreateEpicWithPrefix(prefix string, title string, description string, labels []string) (domain.CreateResult, error) {
+	ret := _m.Called(prefix, title, description, labels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEpicWithPrefix")
+	}
+
+	var r0 domain.CreateResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) (domain.CreateResult, error)); ok {
+		return rf(prefix, title, description, labels)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) domain.CreateResult); ok {
+		r0 = rf(prefix, title, description, labels)
+	} else {
+		r0 = ret.Get(0).(domain.CreateResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []string) error); ok {
+		r1 = rf(prefix, title, description, labels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueExecutor_CreateEpicWithPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEpicWithPrefix'
+type MockIssueExecutor_CreateEpicWithPrefix_Call struct {
+	*mock.Call
+}
+
+// CreateEpicWithPrefix is a helper method to define mock.On call
+//   - prefix string
+//   - title string
+//   - description string
+//   - labels []string
+func (_e *MockIssueExecutor_Expecter) CreateEpicWithPrefix(prefix interface{}, title interface{}, description interface{}, labels interface{}) *MockIssueExecutor_CreateEpicWithPrefix_Call {
+	return &MockIssueExecutor_CreateEpicWithPrefix_Call{Call: _e.mock.On("CreateEpicWithPrefix", prefix, title, description, labels)}
+}
+
+func (_c *MockIssueExecutor_CreateEpicWithPrefix_Call) Run(run func(prefix string, title string, description string, labels []string)) *MockIssueExecutor_CreateEpicWithPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockIssueExecutor_CreateEpicWithPrefix_Call) Return(_a0 domain.CreateResult, _a1 error) *MockIssueExecutor_CreateEpicWithPrefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueExecutor_CreateEpicWithPrefix_Call) RunAndReturn(run func(string, string, string, []string) (domain.CreateResult, error)) *MockIssueExecutor_CreateEpicWithPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTask provides a mock function with given fields: title, description, parentID, assignee, labels
 func (_m *MockIssueExecutor) CreateTask(title string, description string, parentID string, assignee string, labels []string) (domain.CreateResult, error) {
 	ret := _m.Called(title, description, parentID, assignee, labels)
@@ -372,6 +478,53 @@ func (_c *MockIssueExecutor_ReopenIssue_Call) RunAndReturn(run func(string) erro
 	return _c
 }
 
+// SetAssignee provides a mock function with given fields: issueID, assignee
+func (_m *MockIssueExecutor) SetAssignee(issueID string, assignee string) error {
+	ret := _m.Called(issueID, assignee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(issueID, assignee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueExecutor_SetAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAssignee'
+type MockIssueExecutor_SetAssignee_Call struct {
+	*mock.Call
+}
+
+// SetAssignee is a helper method to define mock.On call
+//   - issueID string
+//   - assignee string
+func (_e *MockIssueExecutor_Expecter) SetAssignee(issueID interface{}, assignee interface{}) *MockIssueExecutor_SetAssignee_Call {
+	return &MockIssueExecutor_SetAssignee_Call{Call: _e.mock.On("SetAssignee", issueID, assignee)}
+}
+
+func (_c *MockIssueExecutor_SetAssignee_Call) Run(run func(issueID string, assignee string)) *MockIssueExecutor_SetAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssueExecutor_SetAssignee_Call) Return(_a0 error) *MockIssueExecutor_SetAssignee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueExecutor_SetAssignee_Call) RunAndReturn(run func(string, string) error) *MockIssueExecutor_SetAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetLabels provides a mock function with given fields: issueID, labels
 func (_m *MockIssueExecutor) SetLabels(issueID string, labels []string) error {
 	ret := _m.Called(issueID, labels)
@@ -115,6 +115,53 @@ func (_c *MockIssueWriter_AddDependency_Call) RunAndReturn(run func(string, stri
 	return _c
 }
 
+// BulkUpdateStatus provides a mock function with given fields: issueIDs, status
+func (_m *MockIssueWriter) BulkUpdateStatus(issueIDs []string, status domain.Status) error {
+	ret := _m.Called(issueIDs, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, domain.Status) error); ok {
+		r0 = rf(issueIDs, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueWriter_BulkUpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateStatus'
+type MockIssueWriter_BulkUpdateStatus_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateStatus is a helper method to define mock.On call
+//   - issueIDs []string
+//   - status domain.Status
+func (_e *MockIssueWriter_Expecter) BulkUpdateStatus(issueIDs interface{}, status interface{}) *MockIssueWriter_BulkUpdateStatus_Call {
+	return &MockIssueWriter_BulkUpdateStatus_Call{Call: _e.mock.On("BulkUpdateStatus", issueIDs, status)}
+}
+
+func (_c *MockIssueWriter_BulkUpdateStatus_Call) Run(run func(issueIDs []string, status domain.Status)) *MockIssueWriter_BulkUpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(domain.Status))
+	})
+	return _c
+}
+
+func (_c *MockIssueWriter_BulkUpdateStatus_Call) Return(_a0 error) *MockIssueWriter_BulkUpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueWriter_BulkUpdateStatus_Call) RunAndReturn(run func([]string, domain.Status) error) *MockIssueWriter_BulkUpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CloseIssue provides a mock function with given fields: issueID, reason
 func (_m *MockIssueWriter) CloseIssue(issueID string, reason string) error {
 	ret := _m.Called(issueID, reason)
@@ -220,6 +267,65 @@ func (_c *MockIssueWriter_CreateEpic_Call) RunAndReturn(run func(string, string,
 	return _c
 }
 
+// CreateEpicWithPrefix provides a mock function with given fields: prefix, title, description, labels
+func (_m *MockIssueWriter) CreateEpicWithPrefix(prefix string, title string, description string, labels []string) (domain.CreateResult, error) {
+	ret := _m.Called(prefix, title, description, labels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEpicWithPrefix")
+	}
+
+	var r0 domain.CreateResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) (domain.CreateResult, error)); ok {
+		return rf(prefix, title, description, labels)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) domain.CreateResult); ok {
+		r0 = rf(prefix, title, description, labels)
+	} else {
+		r0 = ret.Get(0).(domain.CreateResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []string) error); ok {
+		r1 = rf(prefix, title, description, labels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueWriter_CreateEpicWithPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEpicWithPrefix'
+type MockIssueWriter_CreateEpicWithPrefix_Call struct {
+	*mock.Call
+}
+
+// CreateEpicWithPrefix is a helper method to define mock.On call
+//   - prefix string
+//   - title string
+//   - description string
+//   - labels []string
+func (_e *MockIssueWriter_Expecter) CreateEpicWithPrefix(prefix interface{}, title interface{}, description interface{}, labels interface{}) *MockIssueWriter_CreateEpicWithPrefix_Call {
+	return &MockIssueWriter_CreateEpicWithPrefix_Call{Call: _e.mock.On("CreateEpicWithPrefix", prefix, title, description, labels)}
+}
+
+func (_c *MockIssueWriter_CreateEpicWithPrefix_Call) Run(run func(prefix string, title string, description string, labels []string)) *MockIssueWriter_CreateEpicWithPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockIssueWriter_CreateEpicWithPrefix_Call) Return(_a0 domain.CreateResult, _a1 error) *MockIssueWriter_CreateEpicWithPrefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueWriter_CreateEpicWithPrefix_Call) RunAndReturn(run func(string, string, string, []string) (domain.CreateResult, error)) *MockIssueWriter_CreateEpicWithPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTask provides a mock function with given fields: title, description, parentID, assignee, labels
 func (_m *MockIssueWriter) CreateTask(title string, description string, parentID string, assignee string, labels []string) (domain.CreateResult, error) {
 	ret := _m.Called(title, description, parentID, assignee, labels)
@@ -372,6 +478,53 @@ func (_c *MockIssueWriter_ReopenIssue_Call) RunAndReturn(run func(string) error)
 	return _c
 }
 
+// SetAssignee provides a mock function with given fields: issueID, assignee
+func (_m *MockIssueWriter) SetAssignee(issueID string, assignee string) error {
+	ret := _m.Called(issueID, assignee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(issueID, assignee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueWriter_SetAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAssignee'
+type MockIssueWriter_SetAssignee_Call struct {
+	*mock.Call
+}
+
+// SetAssignee is a helper method to define mock.On call
+//   - issueID string
+//   - assignee string
+func (_e *MockIssueWriter_Expecter) SetAssignee(issueID interface{}, assignee interface{}) *MockIssueWriter_SetAssignee_Call {
+	return &MockIssueWriter_SetAssignee_Call{Call: _e.mock.On("SetAssignee", issueID, assignee)}
+}
+
+func (_c *MockIssueWriter_SetAssignee_Call) Run(run func(issueID string, assignee string)) *MockIssueWriter_SetAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssueWriter_SetAssignee_Call) Return(_a0 error) *MockIssueWriter_SetAssignee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueWriter_SetAssignee_Call) RunAndReturn(run func(string, string) error) *MockIssueWriter_SetAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetLabels provides a mock function with given fields: issueID, labels
 func (_m *MockIssueWriter) SetLabels(issueID string, labels []string) error {
 	ret := _m.Called(issueID, labels)
@@ -1,11 +1,12 @@
 package dashboard
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/keys"
@@ -18,16 +19,16 @@ import (
 )
 
 // loadEpicTree creates a command to load the epic tree data for the given epic ID.
-// It executes a BQL query to fetch the epic and all its children using expand down depth *.
+// It fetches the epic and all its descendants with the beads service's subtree query.
 func loadEpicTree(epicID string, executor bql.BQLExecutor) tea.Cmd {
 	if epicID == "" || executor == nil {
 		return nil
 	}
 
-	query := fmt.Sprintf(`id = "%s" expand down depth *`, epicID)
+	service := appbeads.NewService(executor)
 
 	return func() tea.Msg {
-		issues, err := executor.Execute(query)
+		issues, err := service.GetSubtree(context.Background(), epicID)
 		return epicTreeLoadedMsg{
 			Issues: issues,
 			RootID: epicID,
@@ -85,19 +86,29 @@ func (m Model) handleEpicTreeLoaded(msg epicTreeLoadedMsg) (mode.Controller, tea
 	}
 
 	// Preserve existing state if tree already exists (user may have changed within session)
+	var collapsedGroups []string
 	if m.epicTree != nil {
 		dir = m.epicTree.Direction()
 		treeMode = m.epicTree.Mode()
+		collapsedGroups = m.epicTree.CollapsedGroups()
 		// Preserve current selection over cached state
 		if node := m.epicTree.SelectedNode(); node != nil {
 			selectedID = node.Issue.ID
 		}
 	}
 
+	// Looking up from the epic shows nothing, so an upward tree stays rooted at the
+	// selected issue to keep showing its ancestors
+	rootID := msg.RootID
+	if _, ok := issueMap[selectedID]; ok && dir == tree.DirectionUp {
+		rootID = selectedID
+	}
+
 	// Initialize tree model
 	clock := m.services.Clock
-	m.epicTree = tree.New(msg.RootID, issueMap, dir, treeMode, clock)
+	m.epicTree = tree.New(rootID, issueMap, dir, treeMode, clock)
 	m.epicTree.SetZonePrefix(zoneEpicIssuePrefix)
+	m.epicTree.SetCollapsedGroups(collapsedGroups)
 
 	// Restore selection if we have a saved ID
 	if selectedID != "" {
@@ -205,6 +216,9 @@ func (m Model) handleEpicTreeKeysFocusTree(msg tea.KeyMsg) (mode.Controller, tea
 	case "y": // Yank (copy) issue ID to clipboard
 		return m.yankTreeIssueID()
 
+	case "Y": // Yank (copy) the whole tree as a text outline
+		return m.yankTreeOutline()
+
 	case "j", "down":
 		if m.epicTree != nil {
 			m.epicTree.MoveCursor(1)
@@ -239,6 +253,20 @@ func (m Model) handleEpicTreeKeysFocusTree(msg tea.KeyMsg) (mode.Controller, tea
 		}
 		return m, nil
 
+	case "d":
+		// Toggle direction (descendants/ancestors of the selected issue)
+		if m.epicTree != nil {
+			m.toggleEpicTreeDirection()
+		}
+		return m, nil
+
+	case "f":
+		// Expand/collapse the fan-out group at the cursor
+		if m.epicTree != nil && m.epicTree.ToggleFanOut() {
+			m.updateEpicDetail()
+		}
+		return m, nil
+
 	case "l", "right":
 		// Switch to details pane
 		m.epicViewFocus = EpicFocusDetails
@@ -266,6 +294,24 @@ func (m Model) handleEpicTreeKeysFocusTree(msg tea.KeyMsg) (mode.Controller, tea
 	return m, nil
 }
 
+// toggleEpicTreeDirection flips the tree between descendants (down) and ancestors (up)
+// and re-roots it at the selected issue, which stays selected. Both directions are built
+// from the already loaded epic, so ancestors outside the epic are not shown.
+func (m *Model) toggleEpicTreeDirection() {
+	newDir := tree.DirectionDown
+	if m.epicTree.Direction() == tree.DirectionDown {
+		newDir = tree.DirectionUp
+	}
+	m.epicTree.SetDirection(newDir)
+
+	if node := m.epicTree.SelectedNode(); node != nil {
+		_ = m.epicTree.Refocus(node.Issue.ID)
+	} else {
+		_ = m.epicTree.Rebuild()
+	}
+	m.updateEpicDetail()
+}
+
 // handleEpicTreeKeysFocusDetails handles key events when the details pane has focus within the epic view.
 func (m Model) handleEpicTreeKeysFocusDetails(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 	switch msg.String() {
@@ -367,6 +413,32 @@ func (m Model) yankTreeIssueID() (mode.Controller, tea.Cmd) {
 	}
 }
 
+// yankTreeOutline copies a plain-text outline of the whole tree to clipboard,
+// following the tree's current mode and direction.
+func (m Model) yankTreeOutline() (mode.Controller, tea.Cmd) {
+	if m.epicTree == nil || m.epicTree.Root() == nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "No tree loaded", Style: toaster.StyleError}
+		}
+	}
+
+	if m.services.Clipboard == nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Clipboard unavailable", Style: toaster.StyleError}
+		}
+	}
+
+	if err := m.services.Clipboard.Copy(m.epicTree.Outline()); err != nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Clipboard error: " + err.Error(), Style: toaster.StyleError}
+		}
+	}
+
+	return m, func() tea.Msg {
+		return mode.ShowToastMsg{Message: "Copied tree: " + m.epicTree.Root().Issue.ID, Style: toaster.StyleSuccess}
+	}
+}
+
 // yankIssueDescription copies the selected issue's description to clipboard.
 func (m Model) yankIssueDescription() (mode.Controller, tea.Cmd) {
 	if m.epicTree == nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/zjrosen/perles/internal/mode"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/ui/modals/issueeditor"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
 	"github.com/zjrosen/perles/internal/ui/shared/toaster"
 	"github.com/zjrosen/perles/internal/ui/tree"
 )
@@ -565,6 +566,67 @@ func TestTreeModeToggle(t *testing.T) {
 	require.Equal(t, tree.ModeDeps, m.epicTree.Mode(), "'m' should toggle mode back to deps")
 }
 
+func TestTreeDirectionToggle(t *testing.T) {
+	// Verify 'd' key toggles direction and re-roots the tree at the selected issue
+	m := createEpicTreeTestModelWithTree(t)
+	require.Equal(t, tree.DirectionDown, m.epicTree.Direction(), "initial direction should be down")
+
+	// Select task-2 and press 'd' to view its ancestors
+	require.True(t, m.epicTree.SelectByIssueID("task-2"))
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = result.(Model)
+
+	require.Equal(t, tree.DirectionUp, m.epicTree.Direction(), "'d' should toggle direction to up")
+	require.Equal(t, "task-2", m.epicTree.Root().Issue.ID, "up tree should be rooted at the selected issue")
+	require.Equal(t, "task-2", m.epicTree.SelectedNode().Issue.ID, "selection should be preserved")
+	require.Equal(t, []string{"task-2", "epic-123"}, m.epicTree.VisibleIssueIDs(), "up tree should show the parent epic")
+	require.True(t, m.hasEpicDetail)
+
+	// Select the epic and press 'd' again to view its descendants
+	require.True(t, m.epicTree.SelectByIssueID("epic-123"))
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = result.(Model)
+
+	require.Equal(t, tree.DirectionDown, m.epicTree.Direction(), "'d' should toggle direction back to down")
+	require.Equal(t, "epic-123", m.epicTree.Root().Issue.ID)
+	require.Equal(t, "epic-123", m.epicTree.SelectedNode().Issue.ID)
+	require.Len(t, m.epicTree.VisibleIssueIDs(), 4, "down tree should show all tasks again")
+}
+
+func TestTreeDirectionToggle_NoTree(t *testing.T) {
+	m := createEpicTreeTestModel(t)
+	m.focus = FocusEpicView
+	m.epicViewFocus = EpicFocusTree
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = result.(Model)
+
+	require.Nil(t, m.epicTree)
+	require.Nil(t, cmd)
+}
+
+func TestHandleEpicTreeLoadedKeepsUpwardTreeRootedAtSelection(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+	m.lastLoadedEpicID = "epic-123"
+	require.True(t, m.epicTree.SelectByIssueID("task-1"))
+	m.toggleEpicTreeDirection()
+
+	// Reload the epic (e.g., after an issue was edited)
+	msg := epicTreeLoadedMsg{
+		Issues: []beads.Issue{
+			{ID: "epic-123", TitleText: "Test Epic", Type: beads.TypeEpic, Children: []string{"task-1"}},
+			{ID: "task-1", TitleText: "Task 1", ParentID: "epic-123"},
+		},
+		RootID: "epic-123",
+	}
+	result, _ := m.handleEpicTreeLoaded(msg)
+	m = result.(Model)
+
+	require.Equal(t, tree.DirectionUp, m.epicTree.Direction())
+	require.Equal(t, "task-1", m.epicTree.Root().Issue.ID, "upward tree should stay rooted at the selected issue")
+	require.Equal(t, []string{"task-1", "epic-123"}, m.epicTree.VisibleIssueIDs())
+}
+
 func TestCursorMoveTriggersDetailUpdate(t *testing.T) {
 	// Verify that j/k cursor movement triggers details panel update
 	m := createEpicTreeTestModelWithTree(t)
@@ -654,6 +716,106 @@ func TestYankTreeIssueID_ClipboardError(t *testing.T) {
 	require.Contains(t, toastMsg.Message, "Clipboard error")
 }
 
+func TestYankTreeOutline_CopiesOutlineToClipboard(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+
+	// Setup mock clipboard
+	mockClipboard := mocks.NewMockClipboard(t)
+	mockClipboard.EXPECT().Copy("epic-123 Test Epic [open]\n" +
+		"├─ task-1 Task 1 [open]\n" +
+		"├─ task-2 Task 2 [open]\n" +
+		"└─ task-3 Task 3 [open]\n").Return(nil).Once()
+	m.services.Clipboard = mockClipboard
+
+	// Press 'Y' in tree focus
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+	_ = result.(Model)
+
+	// Execute command to get the toast message
+	require.NotNil(t, cmd, "should return command for toast")
+	msg := cmd()
+	toastMsg, ok := msg.(mode.ShowToastMsg)
+	require.True(t, ok, "command should return ShowToastMsg")
+	require.Contains(t, toastMsg.Message, "epic-123", "toast should contain tree root ID")
+}
+
+func TestYankTreeOutline_FollowsDirection(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+
+	// Select task-2 and flip the tree to show its ancestors
+	for _, key := range []rune{'j', 'j', 'd'} {
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+		m = result.(Model)
+	}
+	require.Equal(t, tree.DirectionUp, m.epicTree.Direction())
+
+	mockClipboard := mocks.NewMockClipboard(t)
+	mockClipboard.EXPECT().Copy("task-2 Task 2 [open]\n" +
+		"└─ epic-123 Test Epic [open]\n").Return(nil).Once()
+	m.services.Clipboard = mockClipboard
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+
+	require.NotNil(t, cmd, "should return command for toast")
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "command should return ShowToastMsg")
+	require.Contains(t, toastMsg.Message, "task-2")
+}
+
+func TestYankTreeOutline_NoTreeLoaded(t *testing.T) {
+	m := createEpicTreeTestModel(t)
+	m.epicTree = nil
+	m.focus = FocusEpicView
+	m.epicViewFocus = EpicFocusTree
+
+	// Press 'Y' with no tree
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+	_ = result.(Model)
+
+	// Execute command to get the toast message
+	require.NotNil(t, cmd, "should return command for error toast")
+	msg := cmd()
+	toastMsg, ok := msg.(mode.ShowToastMsg)
+	require.True(t, ok, "command should return ShowToastMsg")
+	require.Contains(t, toastMsg.Message, "No tree loaded")
+}
+
+func TestYankTreeOutline_NoClipboard(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+	m.services.Clipboard = nil
+
+	// Press 'Y' without clipboard
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+	_ = result.(Model)
+
+	// Execute command to get the toast message
+	require.NotNil(t, cmd, "should return command for error toast")
+	msg := cmd()
+	toastMsg, ok := msg.(mode.ShowToastMsg)
+	require.True(t, ok, "command should return ShowToastMsg")
+	require.Contains(t, toastMsg.Message, "Clipboard unavailable")
+}
+
+func TestYankTreeOutline_ClipboardError(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+
+	// Setup mock clipboard that returns error
+	mockClipboard := mocks.NewMockClipboard(t)
+	mockClipboard.EXPECT().Copy(mock.Anything).Return(errors.New("clipboard failed")).Once()
+	m.services.Clipboard = mockClipboard
+
+	// Press 'Y'
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+	_ = result.(Model)
+
+	// Execute command to get the toast message
+	require.NotNil(t, cmd, "should return command for error toast")
+	msg := cmd()
+	toastMsg, ok := msg.(mode.ShowToastMsg)
+	require.True(t, ok, "command should return ShowToastMsg")
+	require.Contains(t, toastMsg.Message, "Clipboard error")
+}
+
 func TestYankIssueDescription_CopiesDescriptionToClipboard(t *testing.T) {
 	m := createEpicTreeTestModel(t)
 
@@ -1003,6 +1165,124 @@ func TestDashboard_SaveMsg_UnchangedFields(t *testing.T) {
 	require.True(t, ok, "command should return issueSavedMsg")
 }
 
+// === Unit Tests: Issue Reassignment ===
+
+func TestDashboard_UpdateIssueAssigneeCmd_CallsSetAssignee(t *testing.T) {
+	m := createIssueEditorTestModel(t)
+
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().SetAssignee("issue-456", "alice").Return(nil)
+	m.services.BeadsExecutor = mockExecutor
+
+	cmd := m.updateIssueAssigneeCmd("issue-456", "alice")
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	changedMsg, ok := msg.(issueAssigneeChangedMsg)
+	require.True(t, ok, "command should return issueAssigneeChangedMsg")
+	require.Equal(t, "issue-456", changedMsg.issueID)
+	require.Equal(t, "alice", changedMsg.assignee)
+	require.NoError(t, changedMsg.err)
+}
+
+func TestDashboard_UpdateIssueAssigneeCmd_PropagatesErrors(t *testing.T) {
+	m := createIssueEditorTestModel(t)
+
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().SetAssignee("issue-456", "alice").Return(errors.New("bd locked"))
+	m.services.BeadsExecutor = mockExecutor
+
+	msg := m.updateIssueAssigneeCmd("issue-456", "alice")()
+	changedMsg, ok := msg.(issueAssigneeChangedMsg)
+	require.True(t, ok, "command should return issueAssigneeChangedMsg")
+	require.Error(t, changedMsg.err)
+	require.Contains(t, changedMsg.err.Error(), "bd locked")
+}
+
+func TestDashboard_UpdateIssueAssigneeCmd_NilExecutor(t *testing.T) {
+	m := createIssueEditorTestModel(t)
+	m.services.BeadsExecutor = nil
+
+	msg := m.updateIssueAssigneeCmd("issue-456", "alice")()
+	changedMsg, ok := msg.(issueAssigneeChangedMsg)
+	require.True(t, ok, "command should return issueAssigneeChangedMsg")
+	require.Error(t, changedMsg.err)
+	require.Contains(t, changedMsg.err.Error(), "beads executor not available")
+}
+
+func TestDashboard_HandleIssueAssigneeChanged_Success(t *testing.T) {
+	m := createIssueEditorTestModel(t)
+	m.lastLoadedEpicID = "epic-123"
+
+	_, cmd := m.handleIssueAssigneeChanged(issueAssigneeChangedMsg{issueID: "issue-456", assignee: "alice"})
+
+	require.NotNil(t, cmd, "should reload the tree and show a toast on success")
+}
+
+func TestDashboard_HandleIssueAssigneeChanged_Error(t *testing.T) {
+	m := createIssueEditorTestModel(t)
+
+	_, cmd := m.handleIssueAssigneeChanged(issueAssigneeChangedMsg{
+		issueID:  "issue-456",
+		assignee: "alice",
+		err:      errors.New("database error"),
+	})
+
+	require.NotNil(t, cmd, "expected toast command on error")
+	showToast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "expected ShowToastMsg")
+	require.Contains(t, showToast.Message, "Assign failed")
+	require.Contains(t, showToast.Message, "database error")
+	require.Equal(t, toaster.StyleError, showToast.Style)
+}
+
+func TestDashboard_SaveMsg_AssigneeChangeDispatchesSetAssignee(t *testing.T) {
+	m := createIssueEditorTestModel(t)
+
+	originalIssue := beads.Issue{
+		ID:       "issue-456",
+		Priority: beads.PriorityMedium,
+		Status:   beads.StatusOpen,
+		Labels:   []string{"test"},
+		Assignee: "alice",
+	}
+	m.editingIssue = &originalIssue
+
+	// The assignee is saved via SetAssignee, not as part of UpdateIssue
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().UpdateIssue("issue-456", mock.MatchedBy(func(opts beads.UpdateIssueOptions) bool {
+		return opts.Assignee == nil
+	})).Return(nil)
+	mockExecutor.EXPECT().SetAssignee("issue-456", "bob").Return(nil)
+	m.services.BeadsExecutor = mockExecutor
+
+	result, cmd := m.Update(issueeditor.SaveMsg{
+		IssueID:  "issue-456",
+		Priority: beads.PriorityMedium,
+		Status:   beads.StatusOpen,
+		Labels:   []string{"test"},
+		Assignee: "bob",
+	})
+	m = result.(Model)
+
+	require.Nil(t, m.issueEditor, "issue editor should be closed after SaveMsg")
+	require.NotNil(t, cmd)
+
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok, "expected batched save and reassign commands")
+	var sawSaved, sawAssigned bool
+	for _, c := range batch {
+		switch c().(type) {
+		case issueSavedMsg:
+			sawSaved = true
+		case issueAssigneeChangedMsg:
+			sawAssigned = true
+		}
+	}
+	require.True(t, sawSaved, "expected issueSavedMsg")
+	require.True(t, sawAssigned, "expected issueAssigneeChangedMsg")
+}
+
 // === Unit Tests: ctrl+e Key Handling (perles-56ved.4) ===
 
 func TestEditIssue_OpensFromTreeFocus(t *testing.T) {
@@ -1132,3 +1412,143 @@ func TestEditIssue_SetSizeHandlesNilEditor(t *testing.T) {
 	require.Equal(t, 150, m.width, "model width should be updated")
 	require.Equal(t, 60, m.height, "model height should be updated")
 }
+
+// === Unit Tests: Create Child Issue ===
+
+func TestCreateIssue_CtrlNOpensModalWithSelectedParent(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+
+	// Move to task-1 and open the create modal
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m = result.(Model)
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = result.(Model)
+
+	require.NotNil(t, m.createIssueModal, "ctrl+n in tree focus should open create modal")
+	require.Equal(t, "task-1", m.createIssueParentID)
+	require.Equal(t, FocusEpicView, m.focus, "ctrl+n in tree focus should not cycle focus")
+	require.NotNil(t, cmd, "should return modal init command")
+}
+
+func TestCreateIssue_CtrlNOutsideTreeCyclesFocus(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+	m.epicViewFocus = EpicFocusDetails
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = result.(Model)
+
+	require.Nil(t, m.createIssueModal, "ctrl+n outside the tree pane should not open create modal")
+}
+
+func TestCreateIssue_SubmitCallsCreateTaskWithParent(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+	m.lastLoadedEpicID = "epic-123"
+
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().CreateTask("New task", "Some details", "epic-123", "", []string(nil)).
+		Return(beads.CreateResult{ID: "task-4", Title: "New task"}, nil).Once()
+	m.services.BeadsExecutor = mockExecutor
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = result.(Model)
+	require.NotNil(t, m.createIssueModal)
+
+	result, cmd := m.Update(formmodal.SubmitMsg{Values: map[string]any{
+		"title":       "  New task ",
+		"description": "Some details",
+	}})
+	m = result.(Model)
+
+	require.Nil(t, m.createIssueModal, "modal should close on submit")
+	require.Empty(t, m.createIssueParentID)
+	require.NotNil(t, cmd)
+
+	createdMsg, ok := cmd().(issueCreatedMsg)
+	require.True(t, ok, "command should return issueCreatedMsg")
+	require.Equal(t, "epic-123", createdMsg.parentID)
+	require.Equal(t, "task-4", createdMsg.result.ID)
+	require.NoError(t, createdMsg.err)
+}
+
+func TestCreateIssue_CancelClosesModal(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = result.(Model)
+	require.NotNil(t, m.createIssueModal)
+
+	result, cmd := m.Update(formmodal.CancelMsg{})
+	m = result.(Model)
+
+	require.Nil(t, m.createIssueModal)
+	require.Empty(t, m.createIssueParentID)
+	require.Nil(t, cmd)
+}
+
+func TestDashboard_HandleIssueCreated_ReloadsTree(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+	m.lastLoadedEpicID = "epic-123"
+
+	m, cmd := m.handleIssueCreated(issueCreatedMsg{
+		parentID: "epic-123",
+		result:   beads.CreateResult{ID: "task-4"},
+	})
+	require.NotNil(t, cmd)
+
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok, "expected batch of reload and toast")
+
+	var reloaded, toasted bool
+	for _, c := range batch {
+		switch msg := c().(type) {
+		case epicTreeLoadedMsg:
+			reloaded = true
+			require.Equal(t, "epic-123", msg.RootID)
+		case mode.ShowToastMsg:
+			toasted = true
+			require.Contains(t, msg.Message, "task-4")
+			require.Equal(t, toaster.StyleSuccess, msg.Style)
+		}
+	}
+	require.True(t, reloaded, "should reload the epic tree")
+	require.True(t, toasted, "should show success toast")
+}
+
+func TestDashboard_HandleIssueCreated_Error(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+
+	m, cmd := m.handleIssueCreated(issueCreatedMsg{
+		parentID: "epic-123",
+		err:      errors.New("bd create failed"),
+	})
+
+	require.NotNil(t, cmd)
+	showToast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "expected ShowToastMsg")
+	require.Contains(t, showToast.Message, "Create failed")
+	require.Contains(t, showToast.Message, "bd create failed")
+	require.Equal(t, toaster.StyleError, showToast.Style)
+}
+
+func TestCreateIssue_ValidateRequiresTitle(t *testing.T) {
+	m := createEpicTreeTestModelWithTree(t)
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = result.(Model)
+	require.NotNil(t, m.createIssueModal)
+
+	// Tab to the Create button and press enter with an empty title
+	for range 2 {
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+		m = result.(Model)
+	}
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	require.NotNil(t, m.createIssueModal, "modal should stay open when title is empty")
+	if cmd != nil {
+		_, submitted := cmd().(formmodal.SubmitMsg)
+		require.False(t, submitted, "empty title should not submit")
+	}
+	require.Contains(t, m.createIssueModal.View(), "title is required")
+}
@@ -63,6 +63,8 @@ const (
 	FocusEpicView
 	// FocusCoordinator indicates the coordinator chat panel has focus.
 	FocusCoordinator
+	// FocusTaskBoard indicates the cross-workflow task board has focus.
+	FocusTaskBoard
 )
 
 // EpicViewFocus represents which pane within the epic view has focus.
@@ -118,6 +120,11 @@ type Model struct {
 	archiveModalWfID   controlplane.WorkflowID // Workflow ID to archive on confirm
 	archiveModalWfName string                  // Workflow name for display/toast
 
+	// Kill confirmation modal state
+	killModal       *modal.Model            // nil when not showing
+	killModalWfID   controlplane.WorkflowID // Workflow ID to kill on confirm
+	killModalWfName string                  // Workflow name for display/toast
+
 	// Rename modal state
 	renameModal     *formmodal.Model        // nil when not showing
 	renameModalWfID controlplane.WorkflowID // Workflow ID to rename on confirm
@@ -126,6 +133,10 @@ type Model struct {
 	issueEditor  *issueeditor.Model
 	editingIssue *beads.Issue // Original issue being edited (for change detection)
 
+	// Create child issue modal state (nil when not showing)
+	createIssueModal    *formmodal.Model
+	createIssueParentID string // Parent issue ID for the issue being created
+
 	// Filter state
 	filter FilterState
 
@@ -142,7 +153,11 @@ type Model struct {
 	hasEpicDetail    bool           // Whether epicDetails has valid content
 	epicViewFocus    EpicViewFocus  // Which pane within epic view has focus
 	lastLoadedEpicID string         // ID of the last loaded epic (for stale response detection)
-	focus            DashboardFocus // Which zone has focus (table, epic, coordinator)
+	focus            DashboardFocus // Which zone has focus (table, epic, coordinator, task board)
+
+	// Cross-workflow task board (replaces the epic section when shown)
+	taskBoard     TaskBoard
+	showTaskBoard bool
 
 	// Event subscription (global - all workflows)
 	eventCh     <-chan controlplane.ControlPlaneEvent
@@ -225,6 +240,7 @@ func New(cfg Config) Model {
 		filter:             NewFilterState(),
 		workflowUIState:    make(map[controlplane.WorkflowID]*WorkflowUIState),
 		focus:              FocusTable,
+		taskBoard:          NewTaskBoard(),
 		ctx:                ctx,
 		cancel:             cancel,
 		gitExecutorFactory: cfg.GitExecutorFactory,
@@ -342,6 +358,36 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 		}
 	}
 
+	// Handle kill confirmation modal when visible
+	if m.killModal != nil {
+		switch msg := msg.(type) {
+		case modal.SubmitMsg:
+			m.killModal = nil
+			return m.doKillWorkflow()
+		case modal.CancelMsg:
+			m.killModal = nil
+			m.killModalWfID = ""
+			m.killModalWfName = ""
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			m.killModal.SetSize(msg.Width, msg.Height)
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			// Handle control plane events even when modal is open to maintain event subscription.
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		default:
+			var cmd tea.Cmd
+			*m.killModal, cmd = m.killModal.Update(msg)
+			return m, cmd
+		}
+	}
+
 	// Handle rename modal when visible
 	if m.renameModal != nil {
 		switch msg := msg.(type) {
@@ -407,6 +453,39 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 		}
 	}
 
+	// Handle create child issue modal when visible
+	if m.createIssueModal != nil {
+		switch msg := msg.(type) {
+		case formmodal.SubmitMsg:
+			title := strings.TrimSpace(msg.Values["title"].(string))
+			description, _ := msg.Values["description"].(string)
+			parentID := m.createIssueParentID
+			m.createIssueModal = nil
+			m.createIssueParentID = ""
+			return m, m.createIssueCmd(parentID, title, strings.TrimSpace(description))
+		case formmodal.CancelMsg:
+			m.createIssueModal = nil
+			m.createIssueParentID = ""
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			*m.createIssueModal = m.createIssueModal.SetSize(msg.Width, msg.Height)
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			// Handle control plane events even when modal is open to maintain event subscription.
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		default:
+			var cmd tea.Cmd
+			*m.createIssueModal, cmd = m.createIssueModal.Update(msg)
+			return m, cmd
+		}
+	}
+
 	// Handle issue editor modal when visible
 	if m.issueEditor != nil {
 		switch msg := msg.(type) {
@@ -419,7 +498,15 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 			m.issueEditor = nil
 			opts := msg.BuildUpdateOptions(m.editingIssue)
 			m.editingIssue = nil
-			return m, m.saveIssueCmd(msg.IssueID, opts)
+			// Reassignment is saved separately so it gets its own confirmation toast
+			if opts.Assignee == nil {
+				return m, m.saveIssueCmd(msg.IssueID, opts)
+			}
+			opts.Assignee = nil
+			return m, tea.Batch(
+				m.saveIssueCmd(msg.IssueID, opts),
+				m.updateIssueAssigneeCmd(msg.IssueID, msg.Assignee),
+			)
 		case issueeditor.CancelMsg:
 			m.issueEditor = nil
 			m.editingIssue = nil // Clear on cancel too
@@ -439,6 +526,8 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 			return m, m.listenForEvents()
 		case issueSavedMsg:
 			return m.handleIssueSaved(msg)
+		case issueAssigneeChangedMsg:
+			return m.handleIssueAssigneeChanged(msg)
 		}
 		var cmd tea.Cmd
 		newEditor, cmd := m.issueEditor.Update(msg)
@@ -530,8 +619,15 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 
 		// Trigger epic tree load for the selected workflow
 		cmd := m.triggerEpicTreeLoad()
+		if m.showTaskBoard {
+			cmd = tea.Batch(cmd, m.loadTaskBoard())
+		}
 		return m, cmd
 
+	case taskBoardLoadedMsg:
+		m.taskBoard = m.taskBoard.SetRows(msg.rows, msg.err)
+		return m, nil
+
 	case eventSubscriptionReadyMsg:
 		m.eventCh = msg.eventCh
 		m.unsubscribe = msg.unsubscribe
@@ -543,6 +639,18 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 	case StartWorkflowFailedMsg:
 		return m.handleStartWorkflowFailed(msg)
 
+	case workflowKilledMsg:
+		// Reload workflows after killing and show toast
+		return m, tea.Batch(
+			m.loadWorkflows(),
+			func() tea.Msg {
+				return mode.ShowToastMsg{
+					Message: "💀 Killed: " + msg.name,
+					Style:   toaster.StyleSuccess,
+				}
+			},
+		)
+
 	case workflowArchivedMsg:
 		// Reload workflows after archiving and show toast
 		return m, tea.Batch(
@@ -558,6 +666,12 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 	case issueSavedMsg:
 		return m.handleIssueSaved(msg)
 
+	case issueAssigneeChangedMsg:
+		return m.handleIssueAssigneeChanged(msg)
+
+	case issueCreatedMsg:
+		return m.handleIssueCreated(msg)
+
 	case CoordinatorPanelSubmitMsg:
 		// Check for slash commands first
 		if strings.HasPrefix(msg.Content, "/") {
@@ -657,6 +771,12 @@ func (m Model) View() string {
 		return m.issueEditor.Overlay(dashboardView)
 	}
 
+	// Create child issue modal overlay
+	// Note: formmodal already calls zone.Scan() internally, so we don't scan here
+	if m.createIssueModal != nil {
+		return m.createIssueModal.Overlay(dashboardView)
+	}
+
 	// If help modal is showing, render it as an overlay
 	if m.showHelp {
 		return zone.Scan(m.helpModal.Overlay(dashboardView))
@@ -668,6 +788,11 @@ func (m Model) View() string {
 		return m.renameModal.Overlay(dashboardView)
 	}
 
+	// If kill confirmation modal is showing, render it as an overlay
+	if m.killModal != nil {
+		return zone.Scan(m.killModal.Overlay(dashboardView))
+	}
+
 	// If archive confirmation modal is showing, render it as an overlay
 	if m.archiveModal != nil {
 		return zone.Scan(m.archiveModal.Overlay(dashboardView))
@@ -694,6 +819,9 @@ func (m Model) SetSize(width, height int) mode.Controller {
 		editor := m.issueEditor.SetSize(width, height)
 		m.issueEditor = &editor
 	}
+	if m.createIssueModal != nil {
+		*m.createIssueModal = m.createIssueModal.SetSize(width, height)
+	}
 
 	// Recalculate tree and details dimensions
 	if m.epicTree != nil {
@@ -768,6 +896,12 @@ type workflowsLoadedMsg struct {
 	err       error
 }
 
+// taskBoardLoadedMsg contains the aggregated rows for the task board.
+type taskBoardLoadedMsg struct {
+	rows []TaskBoardRow
+	err  error
+}
+
 // === Command generators ===
 
 // eventSubscriptionReadyMsg indicates the event subscription is ready.
@@ -798,6 +932,30 @@ func (m Model) loadWorkflows() tea.Cmd {
 	}
 }
 
+// loadTaskBoard returns a command that collects the in-flight tasks of every loaded workflow.
+// Workflows that fail to report their tasks are skipped; the first error is kept for display.
+func (m Model) loadTaskBoard() tea.Cmd {
+	workflows := m.workflows
+	return func() tea.Msg {
+		if m.controlPlane == nil {
+			return taskBoardLoadedMsg{rows: make([]TaskBoardRow, 0)}
+		}
+		var firstErr error
+		tasks := make(map[controlplane.WorkflowID][]controlplane.TaskSummary, len(workflows))
+		for _, wf := range workflows {
+			summaries, err := m.controlPlane.WorkflowTasks(context.Background(), wf.ID)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			tasks[wf.ID] = summaries
+		}
+		return taskBoardLoadedMsg{rows: buildTaskBoardRows(workflows, tasks), err: firstErr}
+	}
+}
+
 // listenForEvents returns a command that waits for the next ControlPlane event.
 func (m Model) listenForEvents() tea.Cmd {
 	if m.eventCh == nil {
@@ -847,7 +1005,10 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 		m.cycleFocusForward()
 		return m, nil
 
-	case "ctrl+n": // Cycle focus forward (always)
+	case "ctrl+n": // Cycle focus forward, except in the epic tree where it creates a child issue
+		if m.focus == FocusEpicView && m.epicViewFocus == EpicFocusTree && m.epicTree != nil {
+			return m.openCreateIssueModal()
+		}
 		m.cycleFocusForward()
 		return m, nil
 
@@ -864,6 +1025,8 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 		return m.handleEpicTreeKeys(msg)
 	case FocusCoordinator:
 		return m.handleCoordinatorKeys(msg)
+	case FocusTaskBoard:
+		return m.handleTaskBoardKeys(msg)
 	}
 
 	return m, nil
@@ -907,6 +1070,10 @@ func (m Model) handleTableKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 	switch {
 	case key.Matches(msg, keys.Dashboard.Rename):
 		return m.renameSelectedWorkflow()
+	case key.Matches(msg, keys.Dashboard.Kill):
+		return m.killSelectedWorkflow()
+	case key.Matches(msg, keys.Dashboard.TaskBoard):
+		return m.toggleTaskBoard()
 	}
 
 	switch msg.String() {
@@ -995,6 +1162,59 @@ func (m Model) handleTableKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 	return m, nil
 }
 
+// handleTaskBoardKeys handles key events when the task board is focused.
+func (m Model) handleTaskBoardKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Dashboard.Down):
+		m.taskBoard = m.taskBoard.MoveDown()
+	case key.Matches(msg, keys.Dashboard.Up):
+		m.taskBoard = m.taskBoard.MoveUp()
+	case key.Matches(msg, keys.Dashboard.GotoTop):
+		m.taskBoard = m.taskBoard.GotoTop()
+	case key.Matches(msg, keys.Dashboard.GotoBottom):
+		m.taskBoard = m.taskBoard.GotoBottom()
+	case key.Matches(msg, keys.Dashboard.TaskBoard):
+		return m.toggleTaskBoard()
+	}
+
+	switch msg.String() {
+	case "f": // Cycle status filter
+		m.taskBoard = m.taskBoard.CycleStatusFilter()
+	case "p": // Sort by phase
+		m.taskBoard = m.taskBoard.ToggleSort(TaskSortByPhase)
+	case "a": // Sort by age
+		m.taskBoard = m.taskBoard.ToggleSort(TaskSortByAge)
+	case "w": // Sort by workflow
+		m.taskBoard = m.taskBoard.ToggleSort(TaskSortByWorkflow)
+	case "R": // Refresh
+		return m, m.loadTaskBoard()
+	case "esc":
+		return m.toggleTaskBoard()
+	case "?":
+		m.showHelp = !m.showHelp
+		m.helpModal = m.helpModal.SetSize(m.width, m.height)
+	case "q", "ctrl+c":
+		return m, func() tea.Msg { return QuitMsg{} }
+	}
+	return m, nil
+}
+
+// toggleTaskBoard shows or hides the cross-workflow task board.
+// Showing the board focuses it and loads its rows; hiding it returns focus to the table.
+func (m Model) toggleTaskBoard() (mode.Controller, tea.Cmd) {
+	m.showTaskBoard = !m.showTaskBoard
+	if !m.showTaskBoard {
+		if m.focus == FocusTaskBoard {
+			m.focus = FocusTable
+			m.updateComponentFocusStates()
+		}
+		return m, nil
+	}
+	m.focus = FocusTaskBoard
+	m.updateComponentFocusStates()
+	return m, m.loadTaskBoard()
+}
+
 // handleEpicTreeKeys handles key events when the epic tree/details section is focused.
 // Dispatches to tree pane or details pane handler based on epicViewFocus.
 func (m Model) handleEpicTreeKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
@@ -1296,6 +1516,14 @@ func (m Model) handleControlPlaneEvent(event controlplane.ControlPlaneEvent) (mo
 		}
 	}
 
+	// Refresh the task board when task assignments change
+	if m.showTaskBoard && event.Type.IsTaskEvent() {
+		return m, tea.Batch(
+			m.loadTaskBoard(),
+			m.listenForEvents(),
+		)
+	}
+
 	// For other events, just continue listening
 	return m, m.listenForEvents()
 }
@@ -1767,6 +1995,47 @@ func (m Model) pauseSelectedWorkflow() (mode.Controller, tea.Cmd) {
 	}
 }
 
+// killSelectedWorkflow shows the kill confirmation modal after validating the workflow.
+// Unlike pause, kill is a hard stop: processes are terminated and the workflow fails.
+func (m Model) killSelectedWorkflow() (mode.Controller, tea.Cmd) {
+	workflow := m.SelectedWorkflow()
+	if workflow == nil {
+		return m, nil
+	}
+
+	// Check if workflow is locked by another process
+	if workflow.IsLocked {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "🔒 Workflow is owned by another Perles process",
+				Style:   toaster.StyleWarn,
+			}
+		}
+	}
+
+	if !workflow.IsActive() {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "Workflow has already finished",
+				Style:   toaster.StyleWarn,
+			}
+		}
+	}
+
+	// Show confirmation modal
+	m.killModalWfID = workflow.ID
+	m.killModalWfName = workflow.Name
+	killModal := modal.New(modal.Config{
+		Title:          "Kill Workflow",
+		Message:        "Immediately terminate all processes and mark this workflow failed?\n\n\"" + workflow.Name + "\"",
+		ConfirmVariant: modal.ButtonDanger,
+		ConfirmText:    "Kill",
+	})
+	killModal.SetSize(m.width, m.height)
+	m.killModal = &killModal
+	return m, nil
+}
+
 // archiveSelectedWorkflow shows the archive confirmation modal after validating the workflow.
 // This is only available when session persistence is enabled.
 func (m Model) archiveSelectedWorkflow() (mode.Controller, tea.Cmd) {
@@ -1915,6 +2184,34 @@ func (m Model) doArchiveWorkflow() (mode.Controller, tea.Cmd) {
 	}
 }
 
+// doKillWorkflow performs the actual kill operation after user confirms.
+func (m Model) doKillWorkflow() (mode.Controller, tea.Cmd) {
+	workflowID := m.killModalWfID
+	workflowName := m.killModalWfName
+
+	// Clear modal state
+	m.killModalWfID = ""
+	m.killModalWfName = ""
+
+	return m, func() tea.Msg {
+		if m.controlPlane == nil {
+			return nil
+		}
+		if err := m.controlPlane.Kill(context.Background(), workflowID); err != nil {
+			return mode.ShowToastMsg{
+				Message: "Failed to kill workflow: " + err.Error(),
+				Style:   toaster.StyleError,
+			}
+		}
+		return workflowKilledMsg{name: workflowName}
+	}
+}
+
+// workflowKilledMsg is sent when a workflow has been successfully killed.
+type workflowKilledMsg struct {
+	name string
+}
+
 // workflowArchivedMsg is sent when a workflow has been successfully archived.
 type workflowArchivedMsg struct {
 	name string
@@ -1927,6 +2224,13 @@ type issueSavedMsg struct {
 	err     error
 }
 
+// issueAssigneeChangedMsg signals completion of an issue reassignment.
+type issueAssigneeChangedMsg struct {
+	issueID  string
+	assignee string // Empty when the issue was unassigned
+	err      error
+}
+
 // SelectedWorkflow returns the currently selected workflow, or nil if none.
 // This uses the filtered workflow list when a filter is active.
 func (m Model) SelectedWorkflow() *controlplane.WorkflowInstance {
@@ -1983,6 +2287,81 @@ func (m Model) saveIssueCmd(issueID string, opts beads.UpdateIssueOptions) tea.C
 	}
 }
 
+// updateIssueAssigneeCmd creates a command to reassign an issue via SetAssignee.
+// An empty assignee unassigns the issue.
+func (m Model) updateIssueAssigneeCmd(issueID, assignee string) tea.Cmd {
+	return func() tea.Msg {
+		if m.services.BeadsExecutor == nil {
+			return issueAssigneeChangedMsg{issueID: issueID, assignee: assignee, err: errors.New("beads executor not available")}
+		}
+		err := m.services.BeadsExecutor.SetAssignee(issueID, assignee)
+		return issueAssigneeChangedMsg{issueID: issueID, assignee: assignee, err: err}
+	}
+}
+
+// issueCreatedMsg signals completion of a child issue creation.
+type issueCreatedMsg struct {
+	parentID string
+	result   beads.CreateResult
+	err      error
+}
+
+// openCreateIssueModal opens the create issue modal with the selected tree node as parent.
+func (m Model) openCreateIssueModal() (mode.Controller, tea.Cmd) {
+	node := m.epicTree.SelectedNode()
+	if node == nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "No issue selected", Style: toaster.StyleError}
+		}
+	}
+
+	m.createIssueParentID = node.Issue.ID
+	createModal := formmodal.New(formmodal.FormConfig{
+		Title: "New Child Issue",
+		Fields: []formmodal.FieldConfig{
+			{Key: "title", Label: "Title", Hint: "required", Type: formmodal.FieldTypeText, Placeholder: "Issue title"},
+			{Key: "description", Label: "Description", Hint: "optional", Type: formmodal.FieldTypeTextArea, MaxHeight: 5},
+		},
+		SubmitLabel: "Create",
+		HeaderContent: func(int) string {
+			return "Parent: " + node.Issue.ID
+		},
+		Validate: func(values map[string]any) error {
+			title, _ := values["title"].(string)
+			if strings.TrimSpace(title) == "" {
+				return errors.New("title is required")
+			}
+			return nil
+		},
+	}).SetSize(m.width, m.height)
+	m.createIssueModal = &createModal
+	return m, createModal.Init()
+}
+
+// createIssueCmd creates a command to create a child task under parentID.
+func (m Model) createIssueCmd(parentID, title, description string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.services.BeadsExecutor.CreateTask(title, description, parentID, "", nil)
+		return issueCreatedMsg{parentID: parentID, result: result, err: err}
+	}
+}
+
+// handleIssueCreated processes the result of a child issue creation.
+func (m Model) handleIssueCreated(msg issueCreatedMsg) (Model, tea.Cmd) {
+	if msg.err != nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Create failed: " + msg.err.Error(), Style: toaster.StyleError}
+		}
+	}
+
+	return m, tea.Batch(
+		loadEpicTree(m.lastLoadedEpicID, m.services.Executor),
+		func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Created " + msg.result.ID, Style: toaster.StyleSuccess}
+		},
+	)
+}
+
 // handleIssueSaved processes the result of a consolidated issue save.
 func (m Model) handleIssueSaved(msg issueSavedMsg) (Model, tea.Cmd) {
 	if msg.err != nil {
@@ -2007,6 +2386,27 @@ func (m Model) handleIssueSaved(msg issueSavedMsg) (Model, tea.Cmd) {
 	return m, loadEpicTree(m.lastLoadedEpicID, m.services.Executor)
 }
 
+// handleIssueAssigneeChanged processes the result of an issue reassignment.
+func (m Model) handleIssueAssigneeChanged(msg issueAssigneeChangedMsg) (Model, tea.Cmd) {
+	if msg.err != nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Assign failed: " + msg.err.Error(), Style: toaster.StyleError}
+		}
+	}
+
+	message := msg.issueID + " assigned to " + msg.assignee
+	if msg.assignee == "" {
+		message = msg.issueID + " unassigned"
+	}
+
+	return m, tea.Batch(
+		loadEpicTree(m.lastLoadedEpicID, m.services.Executor),
+		func() tea.Msg {
+			return mode.ShowToastMsg{Message: message, Style: toaster.StyleSuccess}
+		},
+	)
+}
+
 // InNewWorkflowModal returns true if the new workflow modal is showing.
 func (m Model) InNewWorkflowModal() bool {
 	return m.newWorkflowModal != nil
@@ -2060,6 +2460,8 @@ func (m *Model) handleWorkflowSelectionChange(newIndex int) tea.Cmd {
 
 	// Close issue editor if open when switching workflows (prevents stale issue references)
 	m.issueEditor = nil
+	m.createIssueModal = nil
+	m.createIssueParentID = ""
 
 	// Load cached state for the new selection
 	m.loadSelectedWorkflowState()
@@ -2157,11 +2559,22 @@ func (m Model) HandleDBChanged() (Model, tea.Cmd) {
 
 // cycleFocusForward cycles focus to the next zone and updates component focus states.
 // Order: Table → EpicTree → EpicDetails → Coordinator → Table
+// When the task board is shown it replaces the epic view: Table → TaskBoard → Coordinator → Table
 func (m *Model) cycleFocusForward() {
 	switch m.focus {
 	case FocusTable:
-		m.focus = FocusEpicView
-		m.epicViewFocus = EpicFocusTree
+		if m.showTaskBoard {
+			m.focus = FocusTaskBoard
+		} else {
+			m.focus = FocusEpicView
+			m.epicViewFocus = EpicFocusTree
+		}
+	case FocusTaskBoard:
+		if m.showCoordinatorPanel && m.coordinatorPanel != nil {
+			m.focus = FocusCoordinator
+		} else {
+			m.focus = FocusTable
+		}
 	case FocusEpicView:
 		if m.epicViewFocus == EpicFocusTree {
 			// Tree → Details
@@ -2185,13 +2598,17 @@ func (m *Model) cycleFocusForward() {
 func (m *Model) cycleFocusBackward() {
 	switch m.focus {
 	case FocusTable:
-		// Table → Coordinator (or Details if no coordinator)
+		// Table → Coordinator (or Details/TaskBoard if no coordinator)
 		if m.showCoordinatorPanel && m.coordinatorPanel != nil {
 			m.focus = FocusCoordinator
+		} else if m.showTaskBoard {
+			m.focus = FocusTaskBoard
 		} else {
 			m.focus = FocusEpicView
 			m.epicViewFocus = EpicFocusDetails
 		}
+	case FocusTaskBoard:
+		m.focus = FocusTable
 	case FocusEpicView:
 		if m.epicViewFocus == EpicFocusDetails {
 			// Details → Tree
@@ -2201,8 +2618,12 @@ func (m *Model) cycleFocusBackward() {
 			m.focus = FocusTable
 		}
 	case FocusCoordinator:
-		m.focus = FocusEpicView
-		m.epicViewFocus = EpicFocusDetails
+		if m.showTaskBoard {
+			m.focus = FocusTaskBoard
+		} else {
+			m.focus = FocusEpicView
+			m.epicViewFocus = EpicFocusDetails
+		}
 	}
 	m.updateComponentFocusStates()
 }
@@ -6,13 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/zjrosen/perles/internal/bql"
 	appgit "github.com/zjrosen/perles/internal/git/application"
+	"github.com/zjrosen/perles/internal/keys"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
 	"github.com/zjrosen/perles/internal/registry/domain"
@@ -31,6 +34,7 @@ type NewWorkflowModal struct {
 	workflowCreator *appreg.WorkflowCreator
 	bqlExecutor     bql.BQLExecutor // BQL executor for epic search fields
 	worktreeEnabled bool            // track if worktree options are available
+	hasWorktreeUI   bool            // whether worktree fields were added to the form
 	workDir         string          // application root directory (for filtering worktrees)
 	vimEnabled      bool            // whether vim mode is enabled for textarea fields
 
@@ -132,14 +136,20 @@ func NewNewWorkflowModal(
 
 	// Add worktree fields if git support is available
 	if worktreeAvailable {
-		// Helper closures for conditional visibility
+		m.hasWorktreeUI = true
+
+		// Helper closures for conditional visibility. All worktree fields are
+		// hidden if git becomes unavailable on a later refresh.
+		worktreeEnabled := func(map[string]any) bool {
+			return m.worktreeEnabled
+		}
 		newWorktreeMode := func(values map[string]any) bool {
 			v, _ := values["worktree_mode"].(string)
-			return v == "new"
+			return m.worktreeEnabled && v == "new"
 		}
 		existingWorktreeMode := func(values map[string]any) bool {
 			v, _ := values["worktree_mode"].(string)
-			return v == "existing"
+			return m.worktreeEnabled && v == "existing"
 		}
 
 		worktreeFields := []formmodal.FieldConfig{
@@ -153,6 +163,7 @@ func NewNewWorkflowModal(
 					{Label: "Existing Worktree", Subtext: "Use a worktree you already created", Value: "existing"},
 					{Label: "New Worktree", Subtext: "Create a new worktree with a fresh branch", Value: "new"},
 				},
+				VisibleWhen: worktreeEnabled,
 			},
 			{
 				Key:               "existing_worktree",
@@ -164,6 +175,18 @@ func NewNewWorkflowModal(
 				MaxVisibleItems:   5,
 				VisibleWhen:       existingWorktreeMode,
 			},
+			{
+				Key:   "dirty_worktree_policy",
+				Type:  formmodal.FieldTypeSelect,
+				Label: "Uncommitted Changes",
+				Hint:  "if the worktree is dirty",
+				Options: []formmodal.ListOption{
+					{Label: "Refuse", Subtext: "Don't start until the changes are committed or stashed", Value: "refuse", Selected: true},
+					{Label: "Stash", Subtext: "Stash the changes before workers start", Value: string(controlplane.DirtyWorktreeStash)},
+					{Label: "Proceed", Subtext: "Use the worktree with the changes in place", Value: string(controlplane.DirtyWorktreeProceed)},
+				},
+				VisibleWhen: existingWorktreeMode,
+			},
 			{
 				Key:               "base_branch",
 				Type:              formmodal.FieldTypeSearchSelect,
@@ -186,6 +209,19 @@ func NewNewWorkflowModal(
 		fields = append(fields, worktreeFields...)
 	}
 
+	// Priority decides which workflow gets worker slots first when the shared pool is full
+	fields = append(fields, formmodal.FieldConfig{
+		Key:   "priority",
+		Type:  formmodal.FieldTypeSelect,
+		Label: "Priority",
+		Hint:  "optional",
+		Options: []formmodal.ListOption{
+			{Label: "Normal", Value: "0", Selected: true},
+			{Label: "High", Subtext: "Served first when workers are scarce", Value: "1"},
+			{Label: "Low", Subtext: "Yields workers to other workflows", Value: "-1"},
+		},
+	})
+
 	cfg := formmodal.FormConfig{
 		Title:       "New Workflow",
 		Fields:      fields,
@@ -345,6 +381,27 @@ func buildWorktreeOptions(gitExecutor appgit.GitExecutor, workDir string) []form
 	return options
 }
 
+// refreshWorktreeOptions re-reads branches and worktrees from git and rebuilds
+// the worktree option lists in place, preserving the current selections where
+// they still exist. If git now fails, the worktree fields are hidden and
+// worktree settings are ignored on submit until a later refresh succeeds.
+// Does nothing if the modal was opened without worktree support.
+func (m *NewWorkflowModal) refreshWorktreeOptions() {
+	if !m.hasWorktreeUI {
+		return
+	}
+
+	branchOptions, available := buildBranchOptions(m.gitExecutor)
+	m.worktreeEnabled = available
+	if available {
+		m.form = m.form.
+			SetFieldOptions("base_branch", branchOptions).
+			SetFieldOptions("existing_worktree", buildWorktreeOptions(m.gitExecutor, m.workDir))
+	}
+
+	m.form = m.form.EnsureFocusVisible()
+}
+
 // buildSelectOptions converts argument options to formmodal ListOptions.
 // If defaultValue matches an option, that option is marked as selected.
 func buildSelectOptions(options []string, defaultValue string) []formmodal.ListOption {
@@ -395,6 +452,10 @@ func (m *NewWorkflowModal) validate(values map[string]any) error {
 		if _, err := m.registryService.GetByKey("workflow", templateKey); err != nil {
 			return errors.New("selected template not found")
 		}
+		// Block creation when the template's required tools are not installed
+		if missing := m.registryService.CheckRequirements(templateKey); len(missing) > 0 {
+			return fmt.Errorf("template requires tools not found on PATH: %s", strings.Join(missing, ", "))
+		}
 	}
 
 	// Validate required arguments for the selected template
@@ -493,9 +554,13 @@ func (m *NewWorkflowModal) createWorkflowAsync(values map[string]any) tea.Cmd {
 
 		// Check if this is an epic-driven workflow (uses existing epic from tracker)
 		isEpicDriven := false
+		skipReview := false
+		var workerProviders map[string]string
 		if m.registryService != nil {
 			if reg, err := m.registryService.GetByKey("workflow", templateID); err == nil {
 				isEpicDriven = reg.IsEpicDriven()
+				skipReview = !reg.RequireReview()
+				workerProviders = reg.WorkerProviders()
 			}
 		}
 
@@ -523,10 +588,15 @@ func (m *NewWorkflowModal) createWorkflowAsync(values map[string]any) tea.Cmd {
 
 		// Build WorkflowSpec
 		spec := controlplane.WorkflowSpec{
-			TemplateID:    templateID,
-			InitialPrompt: initialPrompt,
-			Name:          name,
-			EpicID:        epicID,
+			TemplateID:      templateID,
+			InitialPrompt:   initialPrompt,
+			Name:            name,
+			EpicID:          epicID,
+			SkipReview:      skipReview,
+			WorkerProviders: controlplane.ParseWorkerProviders(workerProviders),
+		}
+		if v, ok := values["priority"].(string); ok {
+			spec.Priority, _ = strconv.Atoi(v)
 		}
 
 		// Set worktree fields based on selected mode
@@ -536,6 +606,9 @@ func (m *NewWorkflowModal) createWorkflowAsync(values map[string]any) tea.Cmd {
 			case "existing":
 				spec.WorktreeMode = controlplane.WorktreeModeExisting
 				spec.WorktreePath, _ = values["existing_worktree"].(string)
+				if policy, _ := values["dirty_worktree_policy"].(string); policy != "refuse" {
+					spec.DirtyWorktreePolicy = controlplane.DirtyWorktreePolicy(policy)
+				}
 				spec.WorktreeEnabled = true
 			case "new":
 				spec.WorktreeMode = controlplane.WorktreeModeNew
@@ -680,6 +753,15 @@ func (m *NewWorkflowModal) Update(msg tea.Msg) (*NewWorkflowModal, tea.Cmd) {
 		// Clear loading state on success (message will bubble up)
 		m.form = m.form.SetLoading("")
 		return m, nil
+
+	case tea.KeyMsg:
+		// Re-read worktrees and branches without reopening the modal.
+		// Textareas keep ctrl+r for vim redo.
+		fieldType, _ := m.form.FocusedFieldType()
+		if key.Matches(msg, keys.Component.Refresh) && !m.form.IsLoading() && fieldType != formmodal.FieldTypeTextArea {
+			m.refreshWorktreeOptions()
+			return m, nil
+		}
 	}
 
 	var cmd tea.Cmd
@@ -2,6 +2,8 @@ package dashboard
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"testing/fstest"
@@ -533,6 +535,44 @@ func TestBuildTemplateOptions_CreatesCorrectOptions(t *testing.T) {
 	require.True(t, hasQuickPlan)
 }
 
+func TestNewWorkflowModal_ValidationBlocksMissingRequiredTools(t *testing.T) {
+	binDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "present-tool"), []byte("#!/bin/sh\n"), 0o755))
+	t.Setenv("PATH", binDir)
+
+	template := func(key, requires string) string {
+		return `
+registry:
+  - namespace: "workflow"
+    key: "` + key + `"
+    version: "v1"
+    name: "` + key + `"
+    description: "Tool requirements"
+    requires: ` + requires + `
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+`
+	}
+	fs := fstest.MapFS{
+		"workflows/present/template.yaml": &fstest.MapFile{Data: []byte(template("present", "[present-tool]"))},
+		"workflows/present/step1.md":      &fstest.MapFile{Data: []byte("# Step 1")},
+		"workflows/absent/template.yaml":  &fstest.MapFile{Data: []byte(template("absent", "[present-tool, absent-tool]"))},
+		"workflows/absent/step1.md":       &fstest.MapFile{Data: []byte("# Step 1")},
+	}
+	registryService, err := appreg.NewRegistryService(fs, nil, "")
+	require.NoError(t, err)
+
+	modal := NewNewWorkflowModal(registryService, nil, nil, nil, nil, false, "")
+
+	require.NoError(t, modal.validate(map[string]any{"template": "present"}))
+
+	err = modal.validate(map[string]any{"template": "absent"})
+	require.Error(t, err)
+	require.Equal(t, "template requires tools not found on PATH: absent-tool", err.Error())
+}
+
 // Test that buildTemplateOptions handles nil registry
 func TestBuildTemplateOptions_NilRegistry(t *testing.T) {
 	options := buildTemplateOptions(nil)
@@ -605,6 +645,58 @@ func TestNewWorkflowModal_PopulatesBranchOptionsFromListBranches(t *testing.T) {
 	require.Contains(t, view, "Branch Name")
 }
 
+func TestNewWorkflowModal_BaseBranchSearchFiltersAndSubmitsSelection(t *testing.T) {
+	registryService := createTestRegistryService(t)
+	mockGit := createMockGitExecutorWithBranches(t)
+	workflowCreator := createTestWorkflowCreator(t, registryService)
+
+	mockCP := newMockControlPlane(t)
+	mockCP.On("Create", mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+		return spec.WorktreeEnabled && spec.WorktreeBaseBranch == "feature/auth"
+	})).Return(controlplane.WorkflowID("new-workflow-id"), nil).Once()
+
+	modal := NewNewWorkflowModal(registryService, mockCP, mockGit, workflowCreator, nil, false, "").SetSize(100, 40)
+
+	// Tab through: Template -> Name -> Git Worktree, then pick "New Worktree"
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyTab})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyTab})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyDown})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyDown})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+
+	// Move to Base Branch and expand the search; all branches are listed
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyTab})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view := modal.View()
+	require.Contains(t, view, "develop")
+	require.Contains(t, view, "feature/auth")
+
+	// Typing narrows the list to branches containing the query
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("AUTH")})
+	view = modal.View()
+	require.Contains(t, view, "feature/auth")
+	require.NotContains(t, view, "develop")
+
+	// Selecting the filtered branch replaces the pre-selected current branch
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd := modal.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	var submit startSubmitMsg
+	found := false
+	for _, msg := range extractBatchMessages(cmd) {
+		if m, ok := msg.(startSubmitMsg); ok {
+			submit, found = m, true
+		}
+	}
+	require.True(t, found, "ctrl+s should submit the form")
+	require.Equal(t, "new", submit.values["worktree_mode"])
+	require.Equal(t, "feature/auth", submit.values["base_branch"])
+
+	msg := simulateAsyncSubmit(t, modal, submit.values)
+	require.IsType(t, CreateWorkflowMsg{}, msg)
+	mockCP.AssertExpectations(t)
+}
+
 func TestNewWorkflowModal_DisablesWorktreeFieldsWhenListBranchesFails(t *testing.T) {
 	registryService := createTestRegistryService(t)
 	mockGit := mocks.NewMockGitExecutor(t)
@@ -664,6 +756,31 @@ func TestNewWorkflowModal_OnSubmitSetsWorktreeEnabledCorrectly(t *testing.T) {
 	mockCP.AssertExpectations(t)
 }
 
+func TestNewWorkflowModal_OnSubmitSetsPriority(t *testing.T) {
+	registryService := createTestRegistryService(t)
+	mockGit := createMockGitExecutorWithBranches(t)
+	workflowCreator := createTestWorkflowCreator(t, registryService)
+
+	mockCP := newMockControlPlane(t)
+	mockCP.On("Create", mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+		return spec.Priority == 1
+	})).Return(controlplane.WorkflowID("new-workflow-id"), nil).Once()
+
+	modal := NewNewWorkflowModal(registryService, mockCP, mockGit, workflowCreator, nil, false, "")
+
+	values := map[string]any{
+		"template": "quick-plan",
+		"name":     "",
+		"priority": "1",
+	}
+
+	msg := simulateAsyncSubmit(t, modal, values)
+	_, ok := msg.(CreateWorkflowMsg)
+	require.True(t, ok)
+
+	mockCP.AssertExpectations(t)
+}
+
 func TestNewWorkflowModal_OnSubmitSetsWorktreeBaseBranchFromSearchSelect(t *testing.T) {
 	registryService := createTestRegistryService(t)
 	mockGit := createMockGitExecutorWithBranches(t)
@@ -1791,6 +1908,128 @@ func TestNewWorkflowModal_ExistingWorktreeModeSetsFieldsOnSpec(t *testing.T) {
 	mockCP.AssertExpectations(t)
 }
 
+func TestNewWorkflowModal_ExistingWorktreeModeSetsDirtyWorktreePolicy(t *testing.T) {
+	registryService := createTestRegistryService(t)
+	mockGit := createMockGitExecutorWithBranches(t)
+	workflowCreator := createTestWorkflowCreator(t, registryService)
+
+	mockCP := newMockControlPlane(t)
+	mockCP.On("Create", mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+		return spec.WorktreeMode == controlplane.WorktreeModeExisting &&
+			spec.DirtyWorktreePolicy == controlplane.DirtyWorktreeStash
+	})).Return(controlplane.WorkflowID("wf-stash"), nil).Once()
+	mockCP.On("Create", mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+		return spec.WorktreeMode == controlplane.WorktreeModeExisting &&
+			spec.DirtyWorktreePolicy == controlplane.DirtyWorktreeRefuse
+	})).Return(controlplane.WorkflowID("wf-refuse"), nil).Once()
+
+	modal := NewNewWorkflowModal(registryService, mockCP, mockGit, workflowCreator, nil, false, "")
+
+	values := map[string]any{
+		"template":              "quick-plan",
+		"name":                  "",
+		"worktree_mode":         "existing",
+		"existing_worktree":     "/repo-worktree-1",
+		"dirty_worktree_policy": "stash",
+	}
+	createMsg, ok := simulateAsyncSubmit(t, modal, values).(CreateWorkflowMsg)
+	require.True(t, ok)
+	require.Equal(t, controlplane.WorkflowID("wf-stash"), createMsg.WorkflowID)
+
+	// A fresh modal, since the first one is still showing its submit state
+	modal = NewNewWorkflowModal(registryService, mockCP, mockGit, workflowCreator, nil, false, "")
+	values["dirty_worktree_policy"] = "refuse"
+	createMsg, ok = simulateAsyncSubmit(t, modal, values).(CreateWorkflowMsg)
+	require.True(t, ok)
+	require.Equal(t, controlplane.WorkflowID("wf-refuse"), createMsg.WorkflowID)
+	mockCP.AssertExpectations(t)
+}
+
+func TestNewWorkflowModal_RefreshUpdatesWorktreeAndBranchOptions(t *testing.T) {
+	registryService := createTestRegistryService(t)
+	mockGit := mocks.NewMockGitExecutor(t)
+	mockGit.EXPECT().ListBranches().Return([]domaingit.BranchInfo{
+		{Name: "main", IsCurrent: true},
+	}, nil).Once()
+	mockGit.EXPECT().ListWorktrees().Return([]domaingit.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+		{Path: "/repo-wt1", Branch: "feature/wt1"},
+	}, nil).Once()
+
+	modal := NewNewWorkflowModal(registryService, nil, mockGit, nil, nil, false, "/repo")
+
+	// Switch to "Existing Worktree" mode: Template -> Name -> Git Worktree
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyTab})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyTab})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyDown})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyTab})
+	require.NotContains(t, modal.SetSize(100, 40).View(), "feature/wt2")
+
+	// A worktree is created in another terminal
+	mockGit.EXPECT().ListBranches().Return([]domaingit.BranchInfo{
+		{Name: "main", IsCurrent: true},
+		{Name: "feature/wt2", IsCurrent: false},
+	}, nil).Once()
+	mockGit.EXPECT().ListWorktrees().Return([]domaingit.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+		{Path: "/repo-wt2", Branch: "feature/wt2"},
+		{Path: "/repo-wt1", Branch: "feature/wt1"},
+	}, nil).Once()
+
+	modal, cmd := modal.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.Nil(t, cmd)
+	require.True(t, modal.worktreeEnabled)
+
+	// The previously selected worktree is still selected even though it moved
+	view := modal.View()
+	require.Contains(t, view, "feature/wt1")
+
+	// Expanding the picker shows the newly created worktree
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = modal.View()
+	require.Contains(t, view, "feature/wt2")
+}
+
+func TestNewWorkflowModal_RefreshHidesWorktreeFieldsWhenGitNowFails(t *testing.T) {
+	registryService := createTestRegistryService(t)
+	mockGit := mocks.NewMockGitExecutor(t)
+	mockGit.EXPECT().ListBranches().Return([]domaingit.BranchInfo{
+		{Name: "main", IsCurrent: true},
+	}, nil).Once()
+	mockGit.EXPECT().ListWorktrees().Return(nil, nil).Once()
+
+	modal := NewNewWorkflowModal(registryService, nil, mockGit, nil, nil, false, "")
+	require.True(t, modal.worktreeEnabled)
+	require.Contains(t, modal.SetSize(100, 40).View(), "Git Worktree")
+
+	mockGit.EXPECT().ListBranches().Return(nil, errors.New("not a git repo")).Once()
+
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.False(t, modal.worktreeEnabled)
+
+	view := modal.View()
+	require.NotContains(t, view, "Git Worktree")
+	require.NotContains(t, view, "Base Branch")
+
+	// Worktree values are ignored by validation once disabled
+	err := modal.validate(map[string]any{
+		"template":      "quick-plan",
+		"worktree_mode": "new",
+		"base_branch":   "",
+	})
+	require.NoError(t, err)
+}
+
+func TestNewWorkflowModal_RefreshWithoutWorktreeSupportIsNoOp(t *testing.T) {
+	registryService := createTestRegistryService(t)
+
+	modal := NewNewWorkflowModal(registryService, nil, nil, nil, nil, false, "")
+	modal, cmd := modal.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.Nil(t, cmd)
+	require.False(t, modal.worktreeEnabled)
+}
+
 func TestBuildWorktreeOptions_PopulatesFromListWorktreesMinusMainRepo(t *testing.T) {
 	mockGit := mocks.NewMockGitExecutor(t)
 	mockGit.EXPECT().ListWorktrees().Return([]domaingit.WorktreeInfo{
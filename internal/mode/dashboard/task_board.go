@@ -0,0 +1,261 @@
+package dashboard
+
+import (
+	"sort"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// TaskBoardRow is a single in-flight task on the cross-workflow task board.
+type TaskBoardRow struct {
+	WorkflowID   controlplane.WorkflowID
+	WorkflowName string
+	Task         controlplane.TaskSummary
+}
+
+// TaskBoardSortField defines which field to sort task board rows by.
+type TaskBoardSortField int
+
+const (
+	// TaskSortByWorkflow keeps rows grouped by workflow in aggregation order.
+	TaskSortByWorkflow TaskBoardSortField = iota
+	// TaskSortByPhase orders rows by how far along the task lifecycle they are.
+	TaskSortByPhase
+	// TaskSortByAge orders rows by when the task was started, oldest first.
+	TaskSortByAge
+)
+
+// taskBoardStatusFilters is the cycle of status filters applied with the filter key.
+// The empty status shows all tasks.
+var taskBoardStatusFilters = []repository.TaskStatus{
+	"",
+	repository.TaskImplementing,
+	repository.TaskInReview,
+	repository.TaskApproved,
+	repository.TaskDenied,
+	repository.TaskCommitting,
+}
+
+// buildTaskBoardRows aggregates the tasks of each workflow into task board rows.
+// Rows follow the order of workflows; workflows without an entry in tasks contribute no rows.
+func buildTaskBoardRows(workflows []*controlplane.WorkflowInstance, tasks map[controlplane.WorkflowID][]controlplane.TaskSummary) []TaskBoardRow {
+	rows := make([]TaskBoardRow, 0)
+	for _, wf := range workflows {
+		for _, task := range tasks[wf.ID] {
+			rows = append(rows, TaskBoardRow{
+				WorkflowID:   wf.ID,
+				WorkflowName: wf.Name,
+				Task:         task,
+			})
+		}
+	}
+	return rows
+}
+
+// TaskBoard manages the cross-workflow task board display state.
+type TaskBoard struct {
+	rows          []TaskBoardRow // All rows in aggregation order
+	visible       []TaskBoardRow // Filtered and sorted rows
+	statusFilter  repository.TaskStatus
+	sortField     TaskBoardSortField
+	sortOrder     SortOrder
+	selectedIndex int
+	err           error
+}
+
+// NewTaskBoard creates an empty task board showing all statuses.
+func NewTaskBoard() TaskBoard {
+	return TaskBoard{
+		rows:      make([]TaskBoardRow, 0),
+		visible:   make([]TaskBoardRow, 0),
+		sortField: TaskSortByWorkflow,
+		sortOrder: SortAscending,
+	}
+}
+
+// SetRows replaces the board rows and reapplies the current filter and sort.
+// The selection is clamped to the new row count.
+func (b TaskBoard) SetRows(rows []TaskBoardRow, err error) TaskBoard {
+	b.rows = make([]TaskBoardRow, len(rows))
+	copy(b.rows, rows)
+	b.err = err
+	b.refresh()
+	return b
+}
+
+// Rows returns the filtered and sorted rows.
+func (b TaskBoard) Rows() []TaskBoardRow {
+	return b.visible
+}
+
+// Err returns the error from the last load, if any.
+func (b TaskBoard) Err() error {
+	return b.err
+}
+
+// SelectedIndex returns the index of the selected row within Rows.
+func (b TaskBoard) SelectedIndex() int {
+	return b.selectedIndex
+}
+
+// Selected returns the selected row, or nil when the board is empty.
+func (b TaskBoard) Selected() *TaskBoardRow {
+	if b.selectedIndex < 0 || b.selectedIndex >= len(b.visible) {
+		return nil
+	}
+	return &b.visible[b.selectedIndex]
+}
+
+// StatusFilter returns the status rows are filtered by (empty for all).
+func (b TaskBoard) StatusFilter() repository.TaskStatus {
+	return b.statusFilter
+}
+
+// SortField returns the current sort field.
+func (b TaskBoard) SortField() TaskBoardSortField {
+	return b.sortField
+}
+
+// SortOrder returns the current sort order.
+func (b TaskBoard) SortOrder() SortOrder {
+	return b.sortOrder
+}
+
+// CycleStatusFilter advances to the next status filter, wrapping back to all.
+func (b TaskBoard) CycleStatusFilter() TaskBoard {
+	next := 0
+	for i, status := range taskBoardStatusFilters {
+		if status == b.statusFilter {
+			next = (i + 1) % len(taskBoardStatusFilters)
+			break
+		}
+	}
+	b.statusFilter = taskBoardStatusFilters[next]
+	b.selectedIndex = 0
+	b.refresh()
+	return b
+}
+
+// ToggleSort toggles sort order if same field, or sets new field with ascending order.
+func (b TaskBoard) ToggleSort(field TaskBoardSortField) TaskBoard {
+	if b.sortField == field {
+		if b.sortOrder == SortAscending {
+			b.sortOrder = SortDescending
+		} else {
+			b.sortOrder = SortAscending
+		}
+	} else {
+		b.sortField = field
+		b.sortOrder = SortAscending
+	}
+	b.refresh()
+	return b
+}
+
+// MoveDown moves the selection down, stopping at the last row.
+func (b TaskBoard) MoveDown() TaskBoard {
+	if b.selectedIndex < len(b.visible)-1 {
+		b.selectedIndex++
+	}
+	return b
+}
+
+// MoveUp moves the selection up, stopping at the first row.
+func (b TaskBoard) MoveUp() TaskBoard {
+	if b.selectedIndex > 0 {
+		b.selectedIndex--
+	}
+	return b
+}
+
+// GotoTop selects the first row.
+func (b TaskBoard) GotoTop() TaskBoard {
+	b.selectedIndex = 0
+	return b
+}
+
+// GotoBottom selects the last row.
+func (b TaskBoard) GotoBottom() TaskBoard {
+	b.selectedIndex = max(0, len(b.visible)-1)
+	return b
+}
+
+// refresh rebuilds the visible rows from the current filter and sort, clamping the selection.
+func (b *TaskBoard) refresh() {
+	b.visible = make([]TaskBoardRow, 0, len(b.rows))
+	for _, row := range b.rows {
+		if b.statusFilter == "" || row.Task.Status == string(b.statusFilter) {
+			b.visible = append(b.visible, row)
+		}
+	}
+
+	if b.sortField != TaskSortByWorkflow {
+		sort.SliceStable(b.visible, func(i, j int) bool {
+			less := b.compareLess(b.visible[i], b.visible[j])
+			if b.sortOrder == SortDescending {
+				return !less
+			}
+			return less
+		})
+	} else if b.sortOrder == SortDescending {
+		for i, j := 0, len(b.visible)-1; i < j; i, j = i+1, j-1 {
+			b.visible[i], b.visible[j] = b.visible[j], b.visible[i]
+		}
+	}
+
+	if b.selectedIndex >= len(b.visible) {
+		b.selectedIndex = max(0, len(b.visible)-1)
+	}
+}
+
+// compareLess returns true if x should come before y in ascending order.
+func (b *TaskBoard) compareLess(x, y TaskBoardRow) bool {
+	switch b.sortField {
+	case TaskSortByPhase:
+		return taskPhaseOrder(x.Task) < taskPhaseOrder(y.Task)
+	case TaskSortByAge:
+		return x.Task.StartedAt.Before(y.Task.StartedAt)
+	default:
+		return false
+	}
+}
+
+// taskPhaseOrder returns a numeric order for a task's position in its lifecycle.
+// The implementer's process phase is used when known, falling back to the task status.
+func taskPhaseOrder(task controlplane.TaskSummary) int {
+	switch events.ProcessPhase(task.Phase) {
+	case events.ProcessPhaseImplementing:
+		return 0
+	case events.ProcessPhaseAddressingFeedback:
+		return 1
+	case events.ProcessPhaseAwaitingReview:
+		return 2
+	case events.ProcessPhaseCommitting:
+		return 4
+	}
+	switch repository.TaskStatus(task.Status) {
+	case repository.TaskImplementing:
+		return 0
+	case repository.TaskDenied:
+		return 1
+	case repository.TaskInReview:
+		return 2
+	case repository.TaskApproved:
+		return 3
+	case repository.TaskCommitting:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// taskAge returns how long ago the task was started, or zero if unknown.
+func taskAge(task controlplane.TaskSummary, now time.Time) time.Duration {
+	if task.StartedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(task.StartedAt)
+}
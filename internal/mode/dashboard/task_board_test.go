@@ -0,0 +1,272 @@
+package dashboard
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// createTestTaskBoardRows returns rows spanning two workflows with distinct phases and ages.
+func createTestTaskBoardRows() []TaskBoardRow {
+	now := time.Now()
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+		createTestWorkflow("wf-2", "Workflow 2", controlplane.WorkflowRunning),
+	}
+	tasks := map[controlplane.WorkflowID][]controlplane.TaskSummary{
+		"wf-1": {
+			{TaskID: "task-a", Status: string(repository.TaskInReview), Implementer: "worker-1",
+				Phase: string(events.ProcessPhaseAwaitingReview), StartedAt: now.Add(-10 * time.Minute)},
+			{TaskID: "task-b", Status: string(repository.TaskImplementing), Implementer: "worker-2",
+				Phase: string(events.ProcessPhaseImplementing), StartedAt: now.Add(-5 * time.Minute)},
+		},
+		"wf-2": {
+			{TaskID: "task-c", Status: string(repository.TaskCommitting), Implementer: "worker-1",
+				Phase: string(events.ProcessPhaseCommitting), StartedAt: now.Add(-30 * time.Minute)},
+		},
+	}
+	return buildTaskBoardRows(workflows, tasks)
+}
+
+// taskIDs returns the task IDs of rows in order.
+func taskIDs(rows []TaskBoardRow) []string {
+	ids := make([]string, len(rows))
+	for i, r := range rows {
+		ids[i] = r.Task.TaskID
+	}
+	return ids
+}
+
+// === Unit Tests: Aggregation ===
+
+func TestBuildTaskBoardRows_AggregatesAcrossWorkflows(t *testing.T) {
+	rows := createTestTaskBoardRows()
+
+	require.Equal(t, []string{"task-a", "task-b", "task-c"}, taskIDs(rows))
+	require.Equal(t, controlplane.WorkflowID("wf-1"), rows[0].WorkflowID)
+	require.Equal(t, "Workflow 1", rows[0].WorkflowName)
+	require.Equal(t, controlplane.WorkflowID("wf-2"), rows[2].WorkflowID)
+	require.Equal(t, "Workflow 2", rows[2].WorkflowName)
+	require.Equal(t, "worker-1", rows[2].Task.Implementer)
+}
+
+func TestBuildTaskBoardRows_SkipsWorkflowsWithoutTasks(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowPending),
+		createTestWorkflow("wf-2", "Workflow 2", controlplane.WorkflowRunning),
+	}
+	tasks := map[controlplane.WorkflowID][]controlplane.TaskSummary{
+		"wf-2":    {{TaskID: "task-a"}},
+		"missing": {{TaskID: "task-z"}},
+	}
+
+	rows := buildTaskBoardRows(workflows, tasks)
+
+	require.Equal(t, []string{"task-a"}, taskIDs(rows), "only tasks of listed workflows are included")
+}
+
+func TestBuildTaskBoardRows_EmptyReturnsNonNil(t *testing.T) {
+	rows := buildTaskBoardRows(nil, nil)
+	require.NotNil(t, rows)
+	require.Empty(t, rows)
+}
+
+// === Unit Tests: Filtering and Sorting ===
+
+func TestTaskBoard_CycleStatusFilter(t *testing.T) {
+	b := NewTaskBoard().SetRows(createTestTaskBoardRows(), nil)
+	require.Len(t, b.Rows(), 3)
+
+	b = b.CycleStatusFilter()
+	require.Equal(t, repository.TaskImplementing, b.StatusFilter())
+	require.Equal(t, []string{"task-b"}, taskIDs(b.Rows()))
+
+	b = b.CycleStatusFilter()
+	require.Equal(t, repository.TaskInReview, b.StatusFilter())
+	require.Equal(t, []string{"task-a"}, taskIDs(b.Rows()))
+
+	// Cycle all the way around back to showing every task
+	for range len(taskBoardStatusFilters) - 2 {
+		b = b.CycleStatusFilter()
+	}
+	require.Empty(t, b.StatusFilter())
+	require.Len(t, b.Rows(), 3)
+}
+
+func TestTaskBoard_SortByPhase(t *testing.T) {
+	b := NewTaskBoard().SetRows(createTestTaskBoardRows(), nil)
+
+	b = b.ToggleSort(TaskSortByPhase)
+	require.Equal(t, []string{"task-b", "task-a", "task-c"}, taskIDs(b.Rows()))
+
+	b = b.ToggleSort(TaskSortByPhase)
+	require.Equal(t, SortDescending, b.SortOrder())
+	require.Equal(t, []string{"task-c", "task-a", "task-b"}, taskIDs(b.Rows()))
+}
+
+func TestTaskBoard_SortByAge(t *testing.T) {
+	b := NewTaskBoard().SetRows(createTestTaskBoardRows(), nil)
+
+	b = b.ToggleSort(TaskSortByAge)
+	require.Equal(t, []string{"task-c", "task-a", "task-b"}, taskIDs(b.Rows()), "oldest first")
+
+	b = b.ToggleSort(TaskSortByAge)
+	require.Equal(t, []string{"task-b", "task-a", "task-c"}, taskIDs(b.Rows()), "newest first")
+}
+
+func TestTaskBoard_SetRowsKeepsFilterAndSort(t *testing.T) {
+	b := NewTaskBoard().ToggleSort(TaskSortByAge).CycleStatusFilter()
+
+	b = b.SetRows(createTestTaskBoardRows(), nil)
+
+	require.Equal(t, TaskSortByAge, b.SortField())
+	require.Equal(t, []string{"task-b"}, taskIDs(b.Rows()))
+}
+
+func TestTaskBoard_SetRowsRecordsError(t *testing.T) {
+	loadErr := errors.New("boom")
+	b := NewTaskBoard().SetRows(nil, loadErr)
+	require.ErrorIs(t, b.Err(), loadErr)
+
+	b = b.SetRows(createTestTaskBoardRows(), nil)
+	require.NoError(t, b.Err())
+}
+
+// === Unit Tests: Navigation ===
+
+func TestTaskBoard_Navigation(t *testing.T) {
+	b := NewTaskBoard().SetRows(createTestTaskBoardRows(), nil)
+	require.Equal(t, 0, b.SelectedIndex())
+
+	b = b.MoveUp()
+	require.Equal(t, 0, b.SelectedIndex(), "should not move above first row")
+
+	b = b.MoveDown().MoveDown().MoveDown()
+	require.Equal(t, 2, b.SelectedIndex(), "should not move past last row")
+	require.Equal(t, "task-c", b.Selected().Task.TaskID)
+
+	b = b.GotoTop()
+	require.Equal(t, 0, b.SelectedIndex())
+
+	b = b.GotoBottom()
+	require.Equal(t, 2, b.SelectedIndex())
+}
+
+func TestTaskBoard_SelectionClampedWhenRowsShrink(t *testing.T) {
+	rows := createTestTaskBoardRows()
+	b := NewTaskBoard().SetRows(rows, nil).GotoBottom()
+
+	b = b.SetRows(rows[:1], nil)
+	require.Equal(t, 0, b.SelectedIndex())
+
+	b = b.SetRows(nil, nil)
+	require.Equal(t, 0, b.SelectedIndex())
+	require.Nil(t, b.Selected())
+}
+
+// === Unit Tests: Model Integration ===
+
+func TestModel_TaskBoard_ToggleLoadsAndFocuses(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+		createTestWorkflow("wf-2", "Workflow 2", controlplane.WorkflowPending),
+	}
+	m, mockCP := createTestModel(t, workflows)
+	mockCP.EXPECT().WorkflowTasks(mock.Anything, controlplane.WorkflowID("wf-1")).Return([]controlplane.TaskSummary{
+		{TaskID: "task-a", Status: string(repository.TaskImplementing), Implementer: "worker-1"},
+	}, nil)
+	mockCP.EXPECT().WorkflowTasks(mock.Anything, controlplane.WorkflowID("wf-2")).Return([]controlplane.TaskSummary{}, nil)
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	m = result.(Model)
+	require.True(t, m.showTaskBoard)
+	require.Equal(t, FocusTaskBoard, m.focus)
+	require.NotNil(t, cmd)
+
+	result, _ = m.Update(cmd())
+	m = result.(Model)
+	require.Equal(t, []string{"task-a"}, taskIDs(m.taskBoard.Rows()))
+	require.Equal(t, "Workflow 1", m.taskBoard.Rows()[0].WorkflowName)
+
+	// Esc hides the board and returns focus to the table
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	require.False(t, m.showTaskBoard)
+	require.Equal(t, FocusTable, m.focus)
+}
+
+func TestModel_TaskBoard_KeysNavigateAndSort(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+	m.showTaskBoard = true
+	m.focus = FocusTaskBoard
+	m.taskBoard = m.taskBoard.SetRows(createTestTaskBoardRows(), nil)
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m = result.(Model)
+	require.Equal(t, 1, m.taskBoard.SelectedIndex())
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	m = result.(Model)
+	require.Equal(t, 2, m.taskBoard.SelectedIndex())
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
+	m = result.(Model)
+	require.Equal(t, 1, m.taskBoard.SelectedIndex())
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	m = result.(Model)
+	require.Equal(t, TaskSortByAge, m.taskBoard.SortField())
+	require.Equal(t, []string{"task-c", "task-a", "task-b"}, taskIDs(m.taskBoard.Rows()))
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	m = result.(Model)
+	require.Equal(t, TaskSortByPhase, m.taskBoard.SortField())
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m = result.(Model)
+	require.Equal(t, repository.TaskImplementing, m.taskBoard.StatusFilter())
+}
+
+func TestModel_TaskBoard_FocusCycleReplacesEpicView(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+	m, _ := createTestModel(t, workflows)
+	m.showTaskBoard = true
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = result.(Model)
+	require.Equal(t, FocusTaskBoard, m.focus, "tab from Table should go to the task board")
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = result.(Model)
+	require.Equal(t, FocusTable, m.focus, "tab from task board should go to Table (Coordinator not open)")
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	m = result.(Model)
+	require.Equal(t, FocusTaskBoard, m.focus, "shift+tab from Table should go to the task board")
+}
+
+func TestModel_TaskBoard_RendersRows(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+	m, _ := createTestModel(t, workflows)
+	m.showTaskBoard = true
+	m.focus = FocusTaskBoard
+	m.taskBoard = m.taskBoard.SetRows(createTestTaskBoardRows(), nil)
+
+	view := m.View()
+
+	require.Contains(t, view, "Tasks (3)")
+	require.Contains(t, view, "task-a")
+	require.Contains(t, view, "worker-2")
+}
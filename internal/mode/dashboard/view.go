@@ -15,6 +15,7 @@ import (
 	"github.com/zjrosen/perles/internal/ui/shared/panes"
 	"github.com/zjrosen/perles/internal/ui/shared/table"
 	"github.com/zjrosen/perles/internal/ui/styles"
+	"github.com/zjrosen/perles/internal/ui/tree"
 )
 
 // Color constants for status and health indicators.
@@ -332,10 +333,10 @@ func (m Model) renderView() string {
 		m.coordinatorPanel.SetScreenYOffset(headerHeight)
 		panelView := m.coordinatorPanel.View()
 
-		// Build left column: table + epic section
+		// Build left column: table + epic section (or task board)
 		var leftColumn string
 		if epicSectionHeight > 0 {
-			epicSection := m.renderEpicSection(tableWidth, epicSectionHeight)
+			epicSection := m.renderLowerSection(tableWidth, epicSectionHeight)
 			leftColumn = lipgloss.JoinVertical(lipgloss.Left, tableView, epicSection)
 		} else {
 			leftColumn = tableView
@@ -348,7 +349,7 @@ func (m Model) renderView() string {
 		tableView := m.renderBorderedWorkflowTable(m.width, tableHeight)
 
 		if epicSectionHeight > 0 {
-			epicSection := m.renderEpicSection(m.width, epicSectionHeight)
+			epicSection := m.renderLowerSection(m.width, epicSectionHeight)
 			mainContent = lipgloss.JoinVertical(lipgloss.Left, tableView, epicSection)
 		} else {
 			mainContent = tableView
@@ -506,10 +507,7 @@ func (m Model) getStartedDisplay(wf *controlplane.WorkflowInstance) string {
 	return wf.StartedAt.Format("01/02 03:04PM")
 }
 
-// phaseShortName returns a short display name for a workflow phase.
-// Currently unused because WorkflowInstance doesn't expose Phase yet.
-//
-//nolint:unused // Retained for future use when Phase is exposed.
+// phaseShortName returns a short display name for a worker phase.
 func phaseShortName(phase events.ProcessPhase) string {
 	switch phase {
 	case events.ProcessPhaseImplementing:
@@ -548,6 +546,160 @@ const minEpicSectionWidth = 50
 // This ensures the table remains usable even when the epic section is visible.
 const minWorkflowTableRows = 6
 
+// renderLowerSection renders the section below the workflow table: the task board
+// when it is shown, otherwise the epic tree+details section.
+func (m Model) renderLowerSection(width, height int) string {
+	if m.showTaskBoard {
+		return m.renderTaskBoard(width, height)
+	}
+	return m.renderEpicSection(width, height)
+}
+
+// createTaskBoardTableConfig creates the table config for the cross-workflow task board.
+func (m Model) createTaskBoardTableConfig() table.TableConfig {
+	now := time.Now()
+	return table.TableConfig{
+		Columns: []table.ColumnConfig{
+			{
+				Key:      "workflow",
+				Header:   "Workflow",
+				MinWidth: 10,
+				Type:     table.ColumnTypeText,
+				Render: func(row any, _ string, w int, _ bool) string {
+					r := row.(TaskBoardRow)
+					name := r.WorkflowName
+					if name == "" {
+						name = string(r.WorkflowID)
+					}
+					if lipgloss.Width(name) > w {
+						name = styles.TruncateString(name, w)
+					}
+					return name
+				},
+			},
+			{
+				Key:    "task",
+				Header: "Task",
+				Width:  16,
+				Type:   table.ColumnTypeText,
+				Render: func(row any, _ string, w int, _ bool) string {
+					r := row.(TaskBoardRow)
+					if lipgloss.Width(r.Task.TaskID) > w {
+						return styles.TruncateString(r.Task.TaskID, w)
+					}
+					return r.Task.TaskID
+				},
+			},
+			{
+				Key:    "worker",
+				Header: "Worker",
+				Width:  10,
+				Type:   table.ColumnTypeText,
+				Render: func(row any, _ string, _ int, _ bool) string {
+					r := row.(TaskBoardRow)
+					if r.Task.Implementer == "" {
+						return "-"
+					}
+					return r.Task.Implementer
+				},
+			},
+			{
+				Key:    "phase",
+				Header: "Phase",
+				Width:  9,
+				Type:   table.ColumnTypeText,
+				Render: func(row any, _ string, _ int, _ bool) string {
+					r := row.(TaskBoardRow)
+					if short := phaseShortName(events.ProcessPhase(r.Task.Phase)); short != "" {
+						return short
+					}
+					return "-"
+				},
+			},
+			{
+				Key:    "status",
+				Header: "Status",
+				Width:  13,
+				Type:   table.ColumnTypeText,
+				Render: func(row any, _ string, _ int, _ bool) string {
+					r := row.(TaskBoardRow)
+					return r.Task.Status
+				},
+			},
+			{
+				Key:    "age",
+				Header: "Age",
+				Width:  6,
+				Type:   table.ColumnTypeText,
+				Render: func(row any, _ string, _ int, _ bool) string {
+					r := row.(TaskBoardRow)
+					if r.Task.StartedAt.IsZero() {
+						return "-"
+					}
+					return formatDuration(taskAge(r.Task, now))
+				},
+			},
+		},
+		ShowHeader:         true,
+		ShowBorder:         true,
+		Scrollable:         true,
+		EmptyMessage:       m.getTaskBoardEmptyMessage(),
+		BorderColor:        styles.BorderDefaultColor,
+		Focused:            m.focus == FocusTaskBoard,
+		FocusedBorderColor: styles.BorderHighlightFocusColor,
+		Title:              m.getTaskBoardTitle(),
+	}
+}
+
+// getTaskBoardEmptyMessage returns the empty state message for the task board.
+func (m Model) getTaskBoardEmptyMessage() string {
+	if err := m.taskBoard.Err(); err != nil {
+		return "Failed to load tasks: " + err.Error()
+	}
+	if m.taskBoard.StatusFilter() != "" {
+		return "No tasks match the status filter. Press 'f' to change it."
+	}
+	return "No tasks in flight."
+}
+
+// getTaskBoardTitle returns the task board title including the active filter and sort.
+func (m Model) getTaskBoardTitle() string {
+	title := fmt.Sprintf("Tasks (%d)", len(m.taskBoard.Rows()))
+	if status := m.taskBoard.StatusFilter(); status != "" {
+		title += " · " + string(status)
+	}
+	switch m.taskBoard.SortField() {
+	case TaskSortByPhase:
+		title += " · by phase"
+	case TaskSortByAge:
+		title += " · by age"
+	}
+	if m.taskBoard.SortOrder() == SortDescending {
+		title += " ↓"
+	}
+	return title
+}
+
+// renderTaskBoard renders the cross-workflow task board table.
+// This is a pure render function - the scroll offset is derived from the selection.
+func (m Model) renderTaskBoard(width, height int) string {
+	boardRows := m.taskBoard.Rows()
+	rows := make([]any, len(boardRows))
+	for i, r := range boardRows {
+		rows[i] = r
+	}
+
+	// Keep the selection in view: visible rows = height - borders - header
+	selected := m.taskBoard.SelectedIndex()
+	offset := max(0, selected-(height-3)+1)
+
+	return table.New(m.createTaskBoardTableConfig()).
+		SetRows(rows).
+		SetSize(width, height).
+		SetYOffset(offset).
+		ViewWithSelection(selected)
+}
+
 // renderEpicSection renders the epic tree+details section below the workflow table.
 // It handles empty states (no epic, empty tree, loading) and the 40%/60% horizontal split.
 func (m Model) renderEpicSection(width, height int) string {
@@ -581,7 +733,8 @@ func (m Model) renderEpicSection(width, height int) string {
 
 	// Check if tree has only root with no children
 	root := m.epicTree.Root()
-	if len(root.Children) == 0 {
+	upward := m.epicTree.Direction() == tree.DirectionUp
+	if len(root.Children) == 0 && !upward {
 		emptyStyle := lipgloss.NewStyle().
 			Foreground(colorDimmed).
 			Italic(true).
@@ -597,7 +750,11 @@ func (m Model) renderEpicSection(width, height int) string {
 
 	// Render tree pane with border
 	treeContent := m.epicTree.View()
-	treePaneStyle := m.getEpicPaneBorderConfig(EpicFocusTree, layout.treeWidth, height, "Epic")
+	treeTitle := "Epic"
+	if upward {
+		treeTitle = "Epic ↑ ancestors of " + root.Issue.ID
+	}
+	treePaneStyle := m.getEpicPaneBorderConfig(EpicFocusTree, layout.treeWidth, height, treeTitle)
 
 	// Calculate progress bar for tree pane
 	var progressBar string
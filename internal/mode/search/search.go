@@ -1001,6 +1001,11 @@ func (m Model) handleKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 			return m.toggleTreeDirection()
 		case msg.String() == "m":
 			return m.toggleTreeMode()
+		case msg.String() == "f":
+			if m.tree != nil && m.tree.ToggleFanOut() {
+				m.updateDetailFromTree()
+			}
+			return m, nil
 		case key.Matches(msg, keys.Search.SaveColumn):
 			// Save current tree as column
 			if m.tree == nil || m.treeRoot == nil {
@@ -1723,9 +1728,11 @@ func (m Model) handleTreeLoaded(msg treeLoadedMsg) (Model, tea.Cmd) {
 	dir := tree.DirectionDown
 	treeMode := tree.ModeDeps
 	var previousSelectedID string
+	var collapsedGroups []string
 	if m.tree != nil {
 		dir = m.tree.Direction()
 		treeMode = m.tree.Mode()
+		collapsedGroups = m.tree.CollapsedGroups()
 		// Save selected issue ID to restore cursor position after rebuild
 		if node := m.tree.SelectedNode(); node != nil {
 			previousSelectedID = node.Issue.ID
@@ -1736,6 +1743,7 @@ func (m Model) handleTreeLoaded(msg treeLoadedMsg) (Model, tea.Cmd) {
 	m.treeRoot = root
 	clock := m.services.Clock
 	m.tree = tree.New(msg.RootID, issueMap, dir, treeMode, clock)
+	m.tree.SetCollapsedGroups(collapsedGroups)
 
 	// Enable zone marking for mouse click support
 	m.tree.SetZonePrefix(zoneSearchTreePrefix)
@@ -2141,6 +2149,9 @@ func (m Model) handleIssueSaved(msg issueSavedMsg) (Model, tea.Cmd) {
 			if msg.opts.Status != nil {
 				m.results[i].Status = *msg.opts.Status
 			}
+			if msg.opts.Assignee != nil {
+				m.results[i].Assignee = *msg.opts.Assignee
+			}
 			if msg.opts.Labels != nil {
 				m.results[i].Labels = *msg.opts.Labels
 			}
@@ -3,6 +3,7 @@ package client
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -18,6 +19,14 @@ import (
 // ErrTimeout is returned when a process exceeds its configured timeout.
 var ErrTimeout = fmt.Errorf("process timed out")
 
+// DefaultStreamBufferSize is the default maximum size of a single stream-json line.
+// Tool results (large file reads, diffs) can produce very long lines, so this is
+// well above bufio.Scanner's 64KB default.
+const DefaultStreamBufferSize = 16 * 1024 * 1024
+
+// streamReadBufferSize is the initial read buffer size for stdout.
+const streamReadBufferSize = 64 * 1024
+
 // ParseEventFunc parses a JSON line from stdout into an OutputEvent.
 // Each provider implements this to handle their specific JSON format.
 type ParseEventFunc func(line []byte) (OutputEvent, error)
@@ -70,6 +79,30 @@ func WithProviderName(name string) BaseProcessOption {
 	}
 }
 
+// WithStreamBufferSize sets the maximum size of a single stdout line.
+// Lines longer than this are skipped with a warning instead of aborting the read loop.
+// Zero or negative uses DefaultStreamBufferSize.
+func WithStreamBufferSize(size int) BaseProcessOption {
+	return func(bp *BaseProcess) {
+		if size > 0 {
+			bp.streamBufferSize = size
+		}
+	}
+}
+
+// WithTranscript tees every raw stdout line to {dir}/{processID}-{sessionRef}.jsonl,
+// keeping the full stream-json history of the session on disk. Writes are buffered and
+// the file is closed when stdout ends. An empty dir disables transcript capture.
+func WithTranscript(dir, processID string) BaseProcessOption {
+	return func(bp *BaseProcess) {
+		if dir == "" {
+			bp.transcript = nil
+			return
+		}
+		bp.transcript = newTranscriptWriter(dir, processID)
+	}
+}
+
 // WithEventParser sets the event parsing function from an EventParser interface.
 // This enables incremental migration from function hooks to the EventParser interface.
 // It sets bp.parseEventFn to parser.ParseEvent and bp.extractSessionFn to parser.ExtractSessionRef.
@@ -110,6 +143,12 @@ type BaseProcess struct {
 	// Provider identification (for logging/errors)
 	providerName string
 
+	// streamBufferSize is the maximum size of a single stdout line
+	streamBufferSize int
+
+	// transcript tees raw stdout lines to disk (nil when capture is disabled)
+	transcript *transcriptWriter
+
 	// Hook functions (set via functional options)
 	parseEventFn     ParseEventFunc
 	extractSessionFn SessionExtractorFunc
@@ -140,6 +179,8 @@ func NewBaseProcess(
 		providerName: "base", // default, should be overridden
 		stderrDone:   make(chan struct{}),
 		stdoutDone:   make(chan struct{}),
+
+		streamBufferSize: DefaultStreamBufferSize,
 	}
 
 	// Apply functional options
@@ -260,6 +301,11 @@ func (bp *BaseProcess) ProviderName() string {
 	return bp.providerName
 }
 
+// StreamBufferSize returns the maximum size of a single stdout line.
+func (bp *BaseProcess) StreamBufferSize() int {
+	return bp.streamBufferSize
+}
+
 // ParseEventFn returns the configured parse event function.
 func (bp *BaseProcess) ParseEventFn() ParseEventFunc {
 	return bp.parseEventFn
@@ -345,22 +391,32 @@ func (bp *BaseProcess) StartGoroutines() {
 // parseOutput reads stdout and parses stream-json events.
 // It calls parseEventFn for each line and extractSessionFn for EVERY event
 // to support OpenCode's pattern of capturing session ID from any event.
+// Lines longer than streamBufferSize are skipped with a warning so one oversized
+// tool output doesn't end the session.
 func (bp *BaseProcess) parseOutput() {
 	defer bp.wg.Done()
 	defer close(bp.stdoutDone)
 	defer close(bp.events)
+	defer bp.closeTranscript()
 
-	scanner := bufio.NewScanner(bp.stdout)
-	// Increase buffer size for large outputs (64KB initial, 1MB max)
-	buf := make([]byte, 0, 64*1024)
-	scanner.Buffer(buf, 1024*1024)
+	reader := bufio.NewReaderSize(bp.stdout, streamReadBufferSize)
 
-	for scanner.Scan() {
-		line := scanner.Bytes()
+	for {
+		line, skipped, readErr := readStreamLine(reader, bp.streamBufferSize)
+		if skipped > 0 {
+			log.Warn(log.CatOrch, "skipped oversized stream-json line",
+				"subsystem", bp.providerName, "bytes", skipped, "limit", bp.streamBufferSize)
+		}
+		if readErr != nil && len(line) == 0 {
+			bp.handleStdoutReadError(readErr)
+			return
+		}
 		if len(line) == 0 {
 			continue
 		}
 
+		bp.writeTranscript(line)
+
 		// If no parse function configured, skip parsing
 		if bp.parseEventFn == nil {
 			continue
@@ -381,6 +437,9 @@ func (bp *BaseProcess) parseOutput() {
 		copy(event.Raw, line)
 		event.Timestamp = time.Now()
 
+		// Flag credential failures so callers stop instead of retrying
+		classifyAuthError(&event)
+
 		// Call extractSessionFn for EVERY event (not just init)
 		// This supports OpenCode's pattern of capturing session ID from any event
 		if bp.extractSessionFn != nil {
@@ -407,19 +466,76 @@ func (bp *BaseProcess) parseOutput() {
 		case <-bp.ctx.Done():
 			return
 		}
+
+		if readErr != nil {
+			bp.handleStdoutReadError(readErr)
+			return
+		}
 	}
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Debug(log.CatOrch, "scanner error",
+// writeTranscript tees a raw stdout line to the transcript, if enabled.
+// Transcript errors are logged and stop capture without affecting the session.
+func (bp *BaseProcess) writeTranscript(line []byte) {
+	if bp.transcript == nil {
+		return
+	}
+	if err := bp.transcript.write(line, bp.SessionRef()); err != nil {
+		log.Warn(log.CatOrch, "transcript capture failed",
 			"subsystem", bp.providerName, "error", err)
-		// Don't send scanner errors for expected conditions:
-		// - Context done (timeout/cancellation killed the process)
-		// - Pipe closed errors (process exited normally)
-		// These are normal consequences of process termination, not real errors.
-		// The actual exit status will be reported by waitForCompletion.
-		if bp.ctx.Err() == nil && !isPipeClosedError(err) {
-			bp.SendError(fmt.Errorf("stdout scanner error: %w", err))
+	}
+}
+
+// closeTranscript flushes and closes the transcript, if enabled.
+func (bp *BaseProcess) closeTranscript() {
+	if bp.transcript == nil {
+		return
+	}
+	if err := bp.transcript.close(bp.SessionRef()); err != nil {
+		log.Warn(log.CatOrch, "transcript capture failed",
+			"subsystem", bp.providerName, "error", err)
+	}
+}
+
+// handleStdoutReadError reports a stdout read error unless it is an expected
+// consequence of process termination.
+func (bp *BaseProcess) handleStdoutReadError(err error) {
+	if errors.Is(err, io.EOF) {
+		return
+	}
+	log.Debug(log.CatOrch, "scanner error",
+		"subsystem", bp.providerName, "error", err)
+	// Don't send scanner errors for expected conditions:
+	// - Context done (timeout/cancellation killed the process)
+	// - Pipe closed errors (process exited normally)
+	// These are normal consequences of process termination, not real errors.
+	// The actual exit status will be reported by waitForCompletion.
+	if bp.ctx.Err() == nil && !isPipeClosedError(err) {
+		bp.SendError(fmt.Errorf("stdout scanner error: %w", err))
+	}
+}
+
+// readStreamLine reads one newline-terminated line without the trailing newline
+// (or carriage return). If the line exceeds maxSize, the rest of it is discarded
+// and skipped reports its total length; line is nil in that case.
+// A non-nil err may be returned together with a final unterminated line.
+func readStreamLine(r *bufio.Reader, maxSize int) (line []byte, skipped int, err error) {
+	for {
+		fragment, readErr := r.ReadSlice('\n')
+		if readErr == nil {
+			fragment = fragment[:len(fragment)-1]
 		}
+		if skipped > 0 || len(line)+len(fragment) > maxSize {
+			skipped += len(line) + len(fragment)
+			line = nil
+		} else {
+			line = append(line, fragment...)
+		}
+
+		if errors.Is(readErr, bufio.ErrBufferFull) {
+			continue
+		}
+		return bytes.TrimSuffix(line, []byte("\r")), skipped, readErr
 	}
 }
 
@@ -503,6 +619,9 @@ func (bp *BaseProcess) waitForCompletion() {
 		} else {
 			errToSend = fmt.Errorf("%s process exited: %w", bp.providerName, err)
 		}
+		if IsAuthErrorMessage(errToSend.Error()) {
+			errToSend = fmt.Errorf("%w: %w", ErrProviderAuth, errToSend)
+		}
 	} else {
 		bp.status = StatusCompleted
 	}
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"io"
 	"os/exec"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -42,10 +44,29 @@ func TestNewBaseProcess_Defaults(t *testing.T) {
 	require.Equal(t, "/tmp/workdir", bp.WorkDir())
 	require.Equal(t, "base", bp.ProviderName())
 	require.False(t, bp.CaptureStderr())
+	require.Equal(t, DefaultStreamBufferSize, bp.StreamBufferSize())
 	require.NotNil(t, bp.Events())
 	require.NotNil(t, bp.Errors())
 }
 
+func TestNewBaseProcess_WithStreamBufferSize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cmd := exec.Command("echo", "test")
+	stdout, _ := cmd.StdoutPipe()
+	stderr, _ := cmd.StderrPipe()
+
+	bp := NewBaseProcess(ctx, cancel, cmd, stdout, stderr, "/tmp",
+		WithStreamBufferSize(4096))
+	require.Equal(t, 4096, bp.StreamBufferSize())
+
+	// Zero keeps the default
+	bp = NewBaseProcess(ctx, cancel, cmd, stdout, stderr, "/tmp",
+		WithStreamBufferSize(0))
+	require.Equal(t, DefaultStreamBufferSize, bp.StreamBufferSize())
+}
+
 func TestNewBaseProcess_WithProviderName(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -1015,6 +1036,73 @@ func TestBaseProcess_waitForCompletion_IncludesStderrInError(t *testing.T) {
 	}
 }
 
+func TestBaseProcess_waitForCompletion_WrapsAuthFailures(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses python3 for stderr output")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cmd := exec.Command("python3", "-c",
+		"import sys; sys.stderr.write('Invalid API key - Please run /login\\n'); sys.stderr.flush(); sys.exit(1)")
+	stdout, _ := cmd.StdoutPipe()
+	stderr, _ := cmd.StderrPipe()
+
+	bp := NewBaseProcess(ctx, cancel, cmd, stdout, stderr, "/tmp",
+		WithProviderName("test"),
+		WithStderrCapture(true))
+	bp.SetStatus(StatusRunning)
+	require.NoError(t, cmd.Start())
+
+	bp.StartGoroutines()
+	bp.Wait()
+
+	select {
+	case err := <-bp.Errors():
+		require.ErrorIs(t, err, ErrProviderAuth)
+		require.Contains(t, err.Error(), "Invalid API key")
+	default:
+		t.Fatal("Expected error to be sent")
+	}
+}
+
+func TestBaseProcess_parseOutput_ClassifiesAuthErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stdout := newMockReadCloser("1\n2\n3\n4\n")
+	parsed := []OutputEvent{
+		{Type: EventResult, IsErrorResult: true, Result: "Invalid API key · Please run /login"},
+		{Type: EventError, Error: &ErrorInfo{Message: "OAuth token has expired"}},
+		{Type: EventError, Error: &ErrorInfo{Message: "invalid x-api-key", Reason: ErrReasonInvalidRequest}},
+		{Type: EventError, Error: &ErrorInfo{Message: "connection refused"}},
+	}
+	next := 0
+	parseFunc := func(_ []byte) (OutputEvent, error) {
+		event := parsed[next]
+		next++
+		return event, nil
+	}
+
+	bp := NewBaseProcess(ctx, cancel, exec.Command("echo"), stdout, newMockReadCloser(""), "/tmp",
+		WithParseEventFunc(parseFunc))
+	bp.wg.Add(1)
+	go bp.parseOutput()
+
+	var got []*ErrorInfo
+	for event := range bp.Events() {
+		got = append(got, event.Error)
+	}
+	bp.wg.Wait()
+
+	require.Len(t, got, 4)
+	require.True(t, got[0].IsAuthFailed(), "error results carry the failure in their result text")
+	require.Equal(t, "Invalid API key · Please run /login", got[0].Message)
+	require.True(t, got[1].IsAuthFailed())
+	require.Equal(t, ErrReasonInvalidRequest, got[2].Reason, "errors already classified are left alone")
+	require.False(t, got[3].IsAuthFailed())
+}
+
 func TestBaseProcess_waitForCompletion_DetectsTimeout(t *testing.T) {
 	// Create a context that will time out quickly
 	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
@@ -1164,3 +1252,98 @@ func TestErrTimeout(t *testing.T) {
 	require.NotNil(t, ErrTimeout)
 	require.Contains(t, ErrTimeout.Error(), "timed out")
 }
+
+// collectParsedLines runs parseOutput over stdout and returns every line passed to the parser.
+func collectParsedLines(t *testing.T, stdout string, opts ...BaseProcessOption) ([]string, *BaseProcess) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var parsed []string
+	opts = append(opts,
+		WithProviderName("test"),
+		WithParseEventFunc(func(line []byte) (OutputEvent, error) {
+			parsed = append(parsed, string(line))
+			return OutputEvent{Type: EventAssistant}, nil
+		}))
+
+	cmd := exec.Command("echo", "test")
+	bp := NewBaseProcess(ctx, cancel, cmd, newMockReadCloser(stdout), newMockReadCloser(""), "/tmp", opts...)
+
+	bp.wg.Add(1)
+	go bp.parseOutput()
+	for range bp.Events() {
+	}
+	bp.wg.Wait()
+
+	return parsed, bp
+}
+
+func TestBaseProcess_parseOutput_HandlesLineLargerThanScannerDefault(t *testing.T) {
+	// bufio.Scanner fails with "token too long" past 64KB; the default buffer must handle it
+	large := `{"type":"user","content":"` + strings.Repeat("x", 2*1024*1024) + `"}`
+
+	parsed, bp := collectParsedLines(t, large+"\n"+`{"type":"result"}`+"\n")
+
+	require.Len(t, parsed, 2)
+	require.Equal(t, large, parsed[0])
+	require.Equal(t, `{"type":"result"}`, parsed[1])
+	require.Empty(t, bp.Errors(), "oversized line should not produce an error")
+}
+
+func TestBaseProcess_parseOutput_SkipsLinesOverConfiguredLimit(t *testing.T) {
+	oversized := strings.Repeat("y", 200*1024)
+	stdout := `{"type":"init"}` + "\n" + oversized + "\n" + `{"type":"result"}` + "\n"
+
+	parsed, bp := collectParsedLines(t, stdout, WithStreamBufferSize(128*1024))
+
+	// The oversized line is dropped, but reading continues with the next line
+	require.Equal(t, []string{`{"type":"init"}`, `{"type":"result"}`}, parsed)
+	require.Empty(t, bp.Errors(), "oversized line should not abort with an error")
+}
+
+func TestReadStreamLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		maxSize int
+		lines   []string
+		skipped []int
+	}{
+		{
+			name:    "newline terminated",
+			input:   "a\nbb\n",
+			maxSize: 10,
+			lines:   []string{"a", "bb"},
+			skipped: []int{0, 0},
+		},
+		{
+			name:    "crlf and unterminated final line",
+			input:   "a\r\nbb",
+			maxSize: 10,
+			lines:   []string{"a", "bb"},
+			skipped: []int{0, 0},
+		},
+		{
+			name:    "line exactly at limit is kept",
+			input:   "abcd\nabcde\nok\n",
+			maxSize: 4,
+			lines:   []string{"abcd", "", "ok"},
+			skipped: []int{0, 5, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Use the minimum reader size so long lines span several ReadSlice calls
+			r := bufio.NewReaderSize(strings.NewReader(tt.input), 16)
+			for i := range tt.lines {
+				line, skipped, _ := readStreamLine(r, tt.maxSize)
+				require.Equal(t, tt.lines[i], string(line), "line %d", i)
+				require.Equal(t, tt.skipped[i], skipped, "skipped %d", i)
+			}
+			_, _, err := readStreamLine(r, tt.maxSize)
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package client
+
+import "time"
+
+// MCPConfigMethod describes how a provider receives its MCP server configuration.
+type MCPConfigMethod string
+
+const (
+	// MCPConfigFlag passes MCP configuration on the command line (e.g., --mcp-config, -c).
+	MCPConfigFlag MCPConfigMethod = "flag"
+	// MCPConfigFile writes MCP configuration to a file in the work directory, so
+	// processes sharing a WorkDir also share one config file.
+	MCPConfigFile MCPConfigMethod = "file"
+	// MCPConfigEnv passes MCP configuration through an environment variable.
+	MCPConfigEnv MCPConfigMethod = "env"
+)
+
+// MCPStartupGracePeriod is the ReadyGracePeriod of providers that load their MCP
+// configuration from a file or the environment and connect to the servers in the
+// background, so a worker's tools may not be available on its first task turn.
+const MCPStartupGracePeriod = 2 * time.Second
+
+// Capabilities describes which optional features a provider supports.
+// The orchestration layer can branch on these instead of hardcoding
+// per-provider behavior.
+type Capabilities struct {
+	// SupportsResume reports whether a session can be continued via Config.SessionID.
+	SupportsResume bool
+
+	// SupportsSystemPromptFlag reports whether Config.SystemPrompt is passed through a
+	// dedicated CLI flag. When false, the system prompt is prepended to the user prompt.
+	SupportsSystemPromptFlag bool
+
+	// SupportsToolFiltering reports whether Config.AllowedTools and
+	// Config.DisallowedTools are honored.
+	SupportsToolFiltering bool
+
+	// MCPConfigMethod is how Config.MCPConfig reaches the provider CLI.
+	MCPConfigMethod MCPConfigMethod
+
+	// ReadyGracePeriod is how long a newly spawned worker stays unassignable after its
+	// first turn completes, for providers whose MCP connections finish initializing after
+	// the process reports ready. Zero makes workers assignable immediately.
+	ReadyGracePeriod time.Duration
+}
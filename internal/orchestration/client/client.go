@@ -2,7 +2,11 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 )
 
 // ClientType identifies the headless client provider.
@@ -25,6 +29,19 @@ const (
 	ClientMock ClientType = "mock"
 )
 
+// knownClientTypes are the providers perles ships, excluding ClientMock.
+var knownClientTypes = []ClientType{ClientClaude, ClientAmp, ClientCodex, ClientGemini, ClientOpenCode, ClientCursor}
+
+// KnownClientTypes returns the providers perles ships, excluding ClientMock.
+func KnownClientTypes() []ClientType {
+	return slices.Clone(knownClientTypes)
+}
+
+// IsKnown reports whether t is one of KnownClientTypes.
+func (t ClientType) IsKnown() bool {
+	return slices.Contains(knownClientTypes, t)
+}
+
 // HeadlessClient is a factory for spawning headless AI processes.
 // Implementations handle the provider-specific details of process creation
 // and configuration.
@@ -32,6 +49,9 @@ type HeadlessClient interface {
 	// Type returns the client type identifier.
 	Type() ClientType
 
+	// Capabilities describes which optional features this provider supports.
+	Capabilities() Capabilities
+
 	// Spawn creates and starts a headless process.
 	// If cfg.SessionID is set, resumes an existing session.
 	// If cfg.SessionID is empty, creates a new session.
@@ -42,6 +62,76 @@ type HeadlessClient interface {
 // ErrUnknownClientType is returned when an unknown client type is requested.
 var ErrUnknownClientType = fmt.Errorf("unknown client type")
 
+// ErrRateLimited is returned by Spawn when the provider rejects the request because
+// its rate limit was hit. Providers should wrap it so callers can rotate to another provider.
+var ErrRateLimited = errors.New("provider rate limited")
+
+// IsRateLimitError reports whether err indicates the provider's rate limit was hit.
+// Besides ErrRateLimited it recognizes the rate-limit wording CLIs print when they
+// exit before producing any events.
+func IsRateLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "rate limit") ||
+		strings.Contains(msg, "rate_limit") ||
+		strings.Contains(msg, "too many requests")
+}
+
+// ErrProviderAuth is returned when the provider rejects its credentials because they are
+// missing, invalid or expired. Retrying cannot succeed until the user fixes the credentials,
+// so callers should stop the workflow rather than respawn.
+var ErrProviderAuth = errors.New("provider authentication failed")
+
+// authErrorPatterns are lowercase fragments of the messages each provider reports when
+// its credentials are missing, invalid or expired. They are kept specific to the
+// providers' own wording so task output such as a git "Authentication failed" or an
+// "unauthorized access" log line is not mistaken for a provider failure.
+var authErrorPatterns = []string{
+	// Claude Code and the Anthropic API
+	"authentication_error",
+	"authentication_failed",
+	"invalid x-api-key",
+	"oauth token has expired",
+	"please run /login",
+	// Gemini CLI
+	"api key not valid",
+	// Amp
+	"run `amp login`",
+	// Cursor
+	"cursor-agent login",
+	// Codex and OpenCode surface the HTTP status of the rejected request
+	"401 unauthorized",
+}
+
+// IsAuthErrorMessage reports whether msg is a provider authentication failure.
+func IsAuthErrorMessage(msg string) bool {
+	if msg == "" {
+		return false
+	}
+	lower := strings.ToLower(msg)
+	for _, pattern := range authErrorPatterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAuthError reports whether err indicates the provider rejected its credentials.
+// Besides ErrProviderAuth it recognizes the authentication wording CLIs print when they
+// exit before producing any events.
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, ErrProviderAuth) || IsAuthErrorMessage(err.Error())
+}
+
 // ClientRegistry holds registered client factories.
 // Use RegisterClient to add new client types.
 var clientRegistry = make(map[ClientType]func() HeadlessClient)
@@ -62,6 +152,48 @@ func NewClient(clientType ClientType) (HeadlessClient, error) {
 	return factory(), nil
 }
 
+// EnvProvider is the environment variable NewDefaultClient reads the provider from.
+const EnvProvider = "PERLES_PROVIDER"
+
+// defaultClientType is the provider NewDefaultClient uses when EnvProvider is unset.
+var defaultClientType = ClientClaude
+
+// SetDefaultClientType sets the provider NewDefaultClient falls back to when
+// PERLES_PROVIDER is unset or empty. The type is validated when the client is created.
+func SetDefaultClientType(clientType ClientType) {
+	defaultClientType = clientType
+}
+
+// DefaultClientType returns the provider NewDefaultClient would create:
+// PERLES_PROVIDER if set, otherwise the default from SetDefaultClientType (claude).
+func DefaultClientType() ClientType {
+	if env := strings.TrimSpace(os.Getenv(EnvProvider)); env != "" {
+		return ClientType(strings.ToLower(env))
+	}
+	return defaultClientType
+}
+
+// NewDefaultClient creates a HeadlessClient for the provider named by PERLES_PROVIDER,
+// falling back to the configured default. Returns ErrUnknownClientType, listing the
+// registered providers, if the chosen provider is not registered.
+func NewDefaultClient() (HeadlessClient, error) {
+	clientType := DefaultClientType()
+	if !IsRegistered(clientType) {
+		source := "default provider"
+		if os.Getenv(EnvProvider) != "" {
+			source = EnvProvider
+		}
+		registered := make([]string, 0, len(clientRegistry))
+		for _, t := range RegisteredClients() {
+			registered = append(registered, string(t))
+		}
+		slices.Sort(registered)
+		return nil, fmt.Errorf("%w: %q from %s (registered: %s)",
+			ErrUnknownClientType, clientType, source, strings.Join(registered, ", "))
+	}
+	return NewClient(clientType)
+}
+
 // RegisteredClients returns a slice of all registered client types.
 func RegisteredClients() []ClientType {
 	types := make([]ClientType, 0, len(clientRegistry))
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,6 +22,51 @@ func TestClientType_AllConstants(t *testing.T) {
 	require.Equal(t, ClientType("mock"), ClientMock)
 }
 
+func TestClientType_IsKnown(t *testing.T) {
+	for _, clientType := range KnownClientTypes() {
+		require.True(t, clientType.IsKnown(), clientType)
+	}
+	require.Len(t, KnownClientTypes(), 6)
+	require.False(t, ClientMock.IsKnown())
+	require.False(t, ClientType("claud").IsKnown())
+	require.False(t, ClientType("").IsKnown())
+}
+
 func TestClientOpenCode_Constant(t *testing.T) {
 	require.Equal(t, ClientType("opencode"), ClientOpenCode)
 }
+
+func TestIsRateLimitError(t *testing.T) {
+	require.False(t, IsRateLimitError(nil))
+	require.True(t, IsRateLimitError(ErrRateLimited))
+	require.True(t, IsRateLimitError(fmt.Errorf("spawn failed: %w", ErrRateLimited)))
+	require.True(t, IsRateLimitError(errors.New("API error: Rate limit exceeded")))
+	require.True(t, IsRateLimitError(errors.New("status 429: Too Many Requests")))
+	require.False(t, IsRateLimitError(errors.New("executable not found")))
+}
+
+func TestIsAuthError(t *testing.T) {
+	require.False(t, IsAuthError(nil))
+	require.True(t, IsAuthError(ErrProviderAuth))
+	require.True(t, IsAuthError(fmt.Errorf("failed to spawn AI process: %w", ErrProviderAuth)))
+	for _, output := range []string{
+		"Invalid API key · Please run /login",
+		`API Error: 401 {"type":"error","error":{"type":"authentication_error","message":"invalid x-api-key"}}`,
+		"OAuth token has expired. Please obtain a new token or refresh your existing token.",
+		"Error: Not logged in. Run `amp login` to authenticate.",
+		"stream error: 401 Unauthorized",
+		"API key not valid. Please pass a valid API key.",
+	} {
+		require.True(t, IsAuthError(errors.New(output)), output)
+	}
+	require.False(t, IsAuthError(errors.New("status 429: Too Many Requests")))
+	require.False(t, IsAuthError(errors.New("prompt is too long")))
+	for _, output := range []string{
+		"fatal: Authentication failed for 'https://github.com/example/repo.git/'",
+		"test failed: expected unauthorized access to be rejected",
+		"user is not logged in to the dashboard",
+		"invalid credentials returned by the fixture server",
+	} {
+		require.False(t, IsAuthError(errors.New(output)), output)
+	}
+}
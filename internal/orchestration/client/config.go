@@ -14,6 +14,10 @@ type Config struct {
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
 
+	// CommitAuthor attributes git commits made by the process, in "Name <email>" format.
+	// When set, spawned processes receive GIT_AUTHOR_* and GIT_COMMITTER_* variables.
+	CommitAuthor string
+
 	// Prompt is the initial prompt to send to the AI.
 	Prompt string
 
@@ -25,6 +29,10 @@ type Config struct {
 	// Used to configure MCP servers for the AI process.
 	MCPConfig string
 
+	// ProcessID identifies the orchestration process (e.g., "worker-1") being spawned.
+	// Providers use it to name per-process artifacts such as transcripts.
+	ProcessID string
+
 	// SessionID is the session identifier for resume operations.
 	// How this is interpreted is provider-specific.
 	SessionID string
@@ -43,6 +51,10 @@ type Config struct {
 	// Use with caution.
 	SkipPermissions bool
 
+	// StreamBufferSize is the maximum size in bytes of a single stream-json output line.
+	// Longer lines are skipped with a warning. Zero uses DefaultStreamBufferSize.
+	StreamBufferSize int
+
 	// Extensions holds provider-specific configuration.
 	// Use the Ext* constants for standard keys.
 	Extensions map[string]any
@@ -72,6 +84,14 @@ const (
 
 	// ExtCursorModel specifies the Cursor model (string: "composer-1").
 	ExtCursorModel = "cursor.model"
+	// ExtCursorExtraArgs specifies extra CLI flags appended after Cursor's managed flags ([]string).
+	ExtCursorExtraArgs = "cursor.extra_args"
+	// ExtCursorMaxConcurrent caps concurrently running cursor-agent processes (int, 0 = unlimited).
+	ExtCursorMaxConcurrent = "cursor.max_concurrent"
+	// ExtCursorTranscriptDir is the directory full session transcripts are written to (string).
+	ExtCursorTranscriptDir = "cursor.transcript_dir"
+	// ExtCursorOutputFormat selects cursor-agent's --output-format (string: "stream-json", "json" or "text").
+	ExtCursorOutputFormat = "cursor.output_format"
 )
 
 // ClaudeModel returns the Claude model from Extensions, or "opus" as default.
@@ -175,6 +195,68 @@ func (c *Config) CursorModel() string {
 	return ""
 }
 
+// CursorExtraArgs returns extra CLI flags for Cursor from Extensions.
+// Returns nil if not set.
+func (c *Config) CursorExtraArgs() []string {
+	if c.Extensions == nil {
+		return nil
+	}
+	if args, ok := c.Extensions[ExtCursorExtraArgs].([]string); ok {
+		return args
+	}
+	// Handle []any from YAML unmarshaling
+	if args, ok := c.Extensions[ExtCursorExtraArgs].([]any); ok {
+		result := make([]string, 0, len(args))
+		for _, v := range args {
+			if s, ok := v.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// CursorMaxConcurrent returns the maximum number of concurrently running Cursor
+// processes from Extensions, or 0 (unlimited) if not set.
+func (c *Config) CursorMaxConcurrent() int {
+	if c.Extensions == nil {
+		return 0
+	}
+	switch v := c.Extensions[ExtCursorMaxConcurrent].(type) {
+	case int:
+		return max(v, 0)
+	case float64:
+		// Handle numbers decoded from JSON
+		return max(int(v), 0)
+	}
+	return 0
+}
+
+// CursorTranscriptDir returns the directory for Cursor session transcripts from
+// Extensions, or "" (transcripts disabled) if not set.
+func (c *Config) CursorTranscriptDir() string {
+	if c.Extensions == nil {
+		return ""
+	}
+	if v, ok := c.Extensions[ExtCursorTranscriptDir].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// CursorOutputFormat returns the Cursor output format from Extensions, or ""
+// (the provider default, stream-json) if not set.
+func (c *Config) CursorOutputFormat() string {
+	if c.Extensions == nil {
+		return ""
+	}
+	if v, ok := c.Extensions[ExtCursorOutputFormat].(string); ok {
+		return v
+	}
+	return ""
+}
+
 // SetExtension sets a provider-specific extension value.
 // Creates the Extensions map if nil.
 func (c *Config) SetExtension(key string, value any) {
@@ -436,3 +436,94 @@ func TestConfig_SetExtension_PreservesOtherKeys(t *testing.T) {
 	require.Equal(t, "existing-value", cfg.Extensions["existing.key"])
 	require.Equal(t, "new-value", cfg.Extensions["new.key"])
 }
+
+// ============================================================================
+// CursorExtraArgs Tests
+// ============================================================================
+
+func TestConfig_CursorExtraArgs_NilExtensions(t *testing.T) {
+	cfg := Config{Extensions: nil}
+	require.Nil(t, cfg.CursorExtraArgs())
+}
+
+func TestConfig_CursorExtraArgs_StringSlice(t *testing.T) {
+	cfg := Config{Extensions: map[string]any{
+		ExtCursorExtraArgs: []string{"--sandbox", "disabled"},
+	}}
+	require.Equal(t, []string{"--sandbox", "disabled"}, cfg.CursorExtraArgs())
+}
+
+func TestConfig_CursorExtraArgs_AnySliceFromYAML(t *testing.T) {
+	cfg := Config{Extensions: map[string]any{
+		ExtCursorExtraArgs: []any{"--sandbox", 42, "disabled"},
+	}}
+	// Non-string entries are skipped
+	require.Equal(t, []string{"--sandbox", "disabled"}, cfg.CursorExtraArgs())
+}
+
+func TestConfig_CursorExtraArgs_WrongType(t *testing.T) {
+	cfg := Config{Extensions: map[string]any{
+		ExtCursorExtraArgs: "--sandbox disabled",
+	}}
+	require.Nil(t, cfg.CursorExtraArgs())
+}
+
+// ============================================================================
+// CursorMaxConcurrent Tests
+// ============================================================================
+
+func TestConfig_CursorMaxConcurrent(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  int
+	}{
+		{name: "unset", value: nil, want: 0},
+		{name: "int", value: 2, want: 2},
+		{name: "float from JSON", value: float64(3), want: 3},
+		{name: "negative treated as unlimited", value: -1, want: 0},
+		{name: "wrong type", value: "2", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Extensions: map[string]any{}}
+			if tt.value != nil {
+				cfg.Extensions[ExtCursorMaxConcurrent] = tt.value
+			}
+			require.Equal(t, tt.want, cfg.CursorMaxConcurrent())
+		})
+	}
+
+	require.Zero(t, (&Config{}).CursorMaxConcurrent())
+}
+
+// ============================================================================
+// CursorTranscriptDir Tests
+// ============================================================================
+
+func TestConfig_CursorTranscriptDir(t *testing.T) {
+	require.Empty(t, (&Config{}).CursorTranscriptDir())
+
+	cfg := Config{}
+	cfg.SetExtension(ExtCursorTranscriptDir, "/var/log/transcripts")
+	require.Equal(t, "/var/log/transcripts", cfg.CursorTranscriptDir())
+
+	cfg.SetExtension(ExtCursorTranscriptDir, 42)
+	require.Empty(t, cfg.CursorTranscriptDir(), "wrong type is ignored")
+}
+
+// ============================================================================
+// CursorOutputFormat Tests
+// ============================================================================
+
+func TestConfig_CursorOutputFormat(t *testing.T) {
+	require.Empty(t, (&Config{}).CursorOutputFormat())
+
+	cfg := Config{}
+	cfg.SetExtension(ExtCursorOutputFormat, "text")
+	require.Equal(t, "text", cfg.CursorOutputFormat())
+
+	cfg.SetExtension(ExtCursorOutputFormat, 42)
+	require.Empty(t, cfg.CursorOutputFormat(), "wrong type is ignored")
+}
@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	_ "github.com/zjrosen/perles/internal/orchestration/client/providers/amp"
+	_ "github.com/zjrosen/perles/internal/orchestration/client/providers/claude"
+	_ "github.com/zjrosen/perles/internal/orchestration/client/providers/codex"
+	_ "github.com/zjrosen/perles/internal/orchestration/client/providers/cursor"
+	_ "github.com/zjrosen/perles/internal/orchestration/client/providers/gemini"
+	_ "github.com/zjrosen/perles/internal/orchestration/client/providers/opencode"
+)
+
+// setDefaultClientType sets the fallback provider for the duration of a test.
+func setDefaultClientType(t *testing.T, clientType client.ClientType) {
+	t.Helper()
+	t.Setenv(client.EnvProvider, "")
+	orig := client.DefaultClientType()
+	client.SetDefaultClientType(clientType)
+	t.Cleanup(func() { client.SetDefaultClientType(orig) })
+}
+
+func TestNewDefaultClient_UsesEnvProvider(t *testing.T) {
+	registered := client.RegisteredClients()
+	require.NotEmpty(t, registered)
+
+	for _, clientType := range registered {
+		t.Run(string(clientType), func(t *testing.T) {
+			t.Setenv(client.EnvProvider, string(clientType))
+
+			c, err := client.NewDefaultClient()
+			require.NoError(t, err)
+			require.Equal(t, clientType, c.Type())
+		})
+	}
+}
+
+func TestNewDefaultClient_EnvProviderIsCaseInsensitive(t *testing.T) {
+	t.Setenv(client.EnvProvider, " Codex ")
+
+	c, err := client.NewDefaultClient()
+	require.NoError(t, err)
+	require.Equal(t, client.ClientCodex, c.Type())
+}
+
+func TestNewDefaultClient_UnregisteredEnvProvider(t *testing.T) {
+	t.Setenv(client.EnvProvider, "not-a-provider")
+
+	c, err := client.NewDefaultClient()
+	require.ErrorIs(t, err, client.ErrUnknownClientType)
+	require.Nil(t, c)
+	require.Contains(t, err.Error(), `"not-a-provider" from PERLES_PROVIDER`)
+	require.Contains(t, err.Error(), "amp, claude, codex, cursor, gemini, opencode")
+}
+
+func TestNewDefaultClient_FallsBackToConfiguredDefault(t *testing.T) {
+	setDefaultClientType(t, client.ClientGemini)
+
+	c, err := client.NewDefaultClient()
+	require.NoError(t, err)
+	require.Equal(t, client.ClientGemini, c.Type())
+}
+
+func TestNewDefaultClient_UnregisteredDefault(t *testing.T) {
+	setDefaultClientType(t, client.ClientMock)
+
+	_, err := client.NewDefaultClient()
+	require.ErrorIs(t, err, client.ErrUnknownClientType)
+	require.Contains(t, err.Error(), `"mock" from default provider`)
+}
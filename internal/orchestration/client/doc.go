@@ -18,6 +18,9 @@
 //	    return err
 //	}
 //
+//	// Or pick the provider from PERLES_PROVIDER (default: claude)
+//	client, err = client.NewDefaultClient()
+//
 //	// Spawn a new process
 //	cfg := client.Config{
 //	    WorkDir: "/path/to/work",
@@ -1,5 +1,10 @@
 package client
 
+import (
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/log"
+)
+
 // BuildEnvVars creates common environment variables for agent processes.
 // Returns a slice of environment variables in "KEY=VALUE" format.
 // These are added to the process environment via SpawnBuilder.WithEnv().
@@ -8,5 +13,13 @@ func BuildEnvVars(cfg Config) []string {
 	if cfg.BeadsDir != "" {
 		env = append(env, "BEADS_DIR="+cfg.BeadsDir)
 	}
+	if cfg.CommitAuthor != "" {
+		author, err := domaingit.ParseAuthor(cfg.CommitAuthor)
+		if err != nil {
+			log.Warn(log.CatOrch, "ignoring invalid commit author", "error", err)
+		} else {
+			env = append(env, author.Env()...)
+		}
+	}
 	return env
 }
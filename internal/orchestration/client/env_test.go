@@ -35,3 +35,30 @@ func TestBuildEnvVars_WithWorkDirOnly(t *testing.T) {
 
 	require.Empty(t, env, "WorkDir should not affect BuildEnvVars")
 }
+
+func TestBuildEnvVars_WithCommitAuthor(t *testing.T) {
+	cfg := Config{
+		BeadsDir:     "/path/to/project",
+		CommitAuthor: "perles-worker-1 <workflow@bot>",
+	}
+
+	env := BuildEnvVars(cfg)
+
+	require.Equal(t, []string{
+		"BEADS_DIR=/path/to/project",
+		"GIT_AUTHOR_NAME=perles-worker-1",
+		"GIT_AUTHOR_EMAIL=workflow@bot",
+		"GIT_COMMITTER_NAME=perles-worker-1",
+		"GIT_COMMITTER_EMAIL=workflow@bot",
+	}, env)
+}
+
+func TestBuildEnvVars_InvalidCommitAuthorIgnored(t *testing.T) {
+	cfg := Config{
+		CommitAuthor: "not-an-author",
+	}
+
+	env := BuildEnvVars(cfg)
+
+	require.Empty(t, env)
+}
@@ -232,6 +232,8 @@ const (
 	ErrReasonRateLimited ErrorReason = "rate_limited"
 	// ErrReasonInvalidRequest indicates a malformed or invalid request.
 	ErrReasonInvalidRequest ErrorReason = "invalid_request"
+	// ErrReasonAuthFailed indicates the provider rejected missing, invalid or expired credentials.
+	ErrReasonAuthFailed ErrorReason = "auth_failed"
 )
 
 // ErrorInfo holds error details.
@@ -245,3 +247,13 @@ type ErrorInfo struct {
 func (e *ErrorInfo) IsContextExceeded() bool {
 	return e != nil && e.Reason == ErrReasonContextExceeded
 }
+
+// IsRateLimited returns true if this error indicates the provider's rate limit was hit.
+func (e *ErrorInfo) IsRateLimited() bool {
+	return e != nil && e.Reason == ErrReasonRateLimited
+}
+
+// IsAuthFailed returns true if this error indicates the provider rejected its credentials.
+func (e *ErrorInfo) IsAuthFailed() bool {
+	return e != nil && e.Reason == ErrReasonAuthFailed
+}
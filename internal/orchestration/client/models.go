@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultModelCacheTTL is how long a ModelCatalog reuses a model listing.
+const DefaultModelCacheTTL = 5 * time.Minute
+
+// ModelLister is implemented by clients whose CLI can list the models it supports.
+type ModelLister interface {
+	// ListModels asks the CLI for the model names it accepts.
+	ListModels(ctx context.Context) ([]string, error)
+}
+
+// ModelSource records where a model listing came from.
+type ModelSource string
+
+const (
+	// ModelSourceCLI means the provider's CLI listed the models.
+	ModelSourceCLI ModelSource = "cli"
+	// ModelSourceStatic means the models are the known set for the provider, used when
+	// its CLI cannot list models or listing failed.
+	ModelSourceStatic ModelSource = "static"
+)
+
+// knownModels are the models offered for providers whose CLI cannot list them. They are
+// the models perles is known to work with, not everything the provider accepts.
+var knownModels = map[ClientType][]string{
+	ClientClaude:   {"opus", "sonnet", "haiku"},
+	ClientAmp:      {"opus"},
+	ClientCodex:    {"gpt-5.2-codex"},
+	ClientGemini:   {"gemini-3-pro-preview", "gemini-2.5-flash"},
+	ClientOpenCode: {"anthropic/claude-opus-4-5", "opencode/glm-4.7-free"},
+	ClientCursor:   {"composer-1"},
+}
+
+// KnownModels returns the static set of models for a provider, or nil if none is known.
+func KnownModels(clientType ClientType) []string {
+	return slices.Clone(knownModels[clientType])
+}
+
+// ModelList is the set of models a provider accepts.
+type ModelList struct {
+	Provider ClientType
+	Models   []string
+	// Default is the model configured for the provider, empty for the provider's own default.
+	Default string
+	Source  ModelSource
+}
+
+// ModelCatalog lists the models of one provider. It asks the provider's CLI when the
+// client implements ModelLister, falls back to KnownModels otherwise, and caches the
+// result for its TTL.
+type ModelCatalog struct {
+	provider AgentProvider
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	cached    *ModelList
+	fetchedAt time.Time
+}
+
+// NewModelCatalog creates a catalog for provider whose listings are reused for ttl.
+func NewModelCatalog(provider AgentProvider, ttl time.Duration) *ModelCatalog {
+	return &ModelCatalog{provider: provider, ttl: ttl}
+}
+
+// Models returns the provider's models, from cache if the last listing is fresh at now.
+// A CLI listing that fails or comes back empty falls back to the static set, which is
+// cached too so a broken CLI is not re-run on every call.
+func (c *ModelCatalog) Models(ctx context.Context, now time.Time) (ModelList, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cached != nil && now.Sub(c.fetchedAt) < c.ttl {
+		return cloneModelList(*c.cached), nil
+	}
+
+	clientType := c.provider.Type()
+	list := ModelList{Provider: clientType, Source: ModelSourceStatic}
+	if model, ok := c.provider.Extensions()[string(clientType)+".model"].(string); ok {
+		list.Default = model
+	}
+
+	headless, err := c.provider.Client()
+	if err != nil {
+		return ModelList{}, err
+	}
+	if lister, ok := headless.(ModelLister); ok {
+		if models, err := lister.ListModels(ctx); err == nil && len(models) > 0 {
+			list.Models = models
+			list.Source = ModelSourceCLI
+		}
+	}
+	if list.Source == ModelSourceStatic {
+		list.Models = KnownModels(clientType)
+		if list.Default != "" && !slices.Contains(list.Models, list.Default) {
+			list.Models = append(list.Models, list.Default)
+		}
+	}
+
+	c.cached = &list
+	c.fetchedAt = now
+	return cloneModelList(list), nil
+}
+
+func cloneModelList(list ModelList) ModelList {
+	list.Models = slices.Clone(list.Models)
+	return list
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stubProvider is an AgentProvider serving a fixed client.
+type stubProvider struct {
+	clientType ClientType
+	client     HeadlessClient
+	extensions map[string]any
+}
+
+func (p *stubProvider) Type() ClientType                { return p.clientType }
+func (p *stubProvider) Client() (HeadlessClient, error) { return p.client, nil }
+func (p *stubProvider) Extensions() map[string]any      { return p.extensions }
+
+// listingClient is a HeadlessClient whose CLI can list models.
+type listingClient struct {
+	mockHeadlessClient
+	models []string
+	err    error
+	calls  int
+}
+
+func (c *listingClient) ListModels(context.Context) ([]string, error) {
+	c.calls++
+	return c.models, c.err
+}
+
+func TestModelCatalog_ListsModelsFromCLI(t *testing.T) {
+	lister := &listingClient{models: []string{"auto", "composer-1", "gpt-5"}}
+	catalog := NewModelCatalog(&stubProvider{
+		clientType: ClientCursor,
+		client:     lister,
+		extensions: map[string]any{ExtCursorModel: "composer-1"},
+	}, time.Minute)
+
+	list, err := catalog.Models(context.Background(), time.Now())
+
+	require.NoError(t, err)
+	require.Equal(t, ModelList{
+		Provider: ClientCursor,
+		Models:   []string{"auto", "composer-1", "gpt-5"},
+		Default:  "composer-1",
+		Source:   ModelSourceCLI,
+	}, list)
+}
+
+func TestModelCatalog_StaticFallbackWithoutLister(t *testing.T) {
+	catalog := NewModelCatalog(&stubProvider{
+		clientType: ClientClaude,
+		client:     &mockHeadlessClient{},
+		extensions: map[string]any{ExtClaudeModel: "claude-opus-4-1"},
+	}, time.Minute)
+
+	list, err := catalog.Models(context.Background(), time.Now())
+
+	require.NoError(t, err)
+	require.Equal(t, ModelSourceStatic, list.Source)
+	require.Equal(t, []string{"opus", "sonnet", "haiku", "claude-opus-4-1"}, list.Models,
+		"a configured model outside the known set is still offered")
+	require.Equal(t, "claude-opus-4-1", list.Default)
+}
+
+func TestModelCatalog_StaticFallbackWhenListingFails(t *testing.T) {
+	lister := &listingClient{err: errors.New("cursor-agent: unknown command")}
+	catalog := NewModelCatalog(&stubProvider{clientType: ClientCursor, client: lister}, time.Minute)
+
+	list, err := catalog.Models(context.Background(), time.Now())
+
+	require.NoError(t, err)
+	require.Equal(t, ModelSourceStatic, list.Source)
+	require.Equal(t, KnownModels(ClientCursor), list.Models)
+	require.Empty(t, list.Default)
+}
+
+func TestModelCatalog_CachesForTTL(t *testing.T) {
+	lister := &listingClient{models: []string{"auto"}}
+	catalog := NewModelCatalog(&stubProvider{clientType: ClientCursor, client: lister}, time.Minute)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := catalog.Models(context.Background(), now)
+	require.NoError(t, err)
+	now = now.Add(30 * time.Second)
+	list, err := catalog.Models(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, 1, lister.calls)
+
+	// Callers cannot alter the cached listing
+	list.Models[0] = "changed"
+	list, err = catalog.Models(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"auto"}, list.Models)
+
+	now = now.Add(time.Minute)
+	_, err = catalog.Models(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, 2, lister.calls)
+}
@@ -77,6 +77,23 @@ func isContextExhaustedMessage(msg string) bool {
 		strings.Contains(lower, "maximum context length")
 }
 
+// classifyAuthError marks an event error as ErrReasonAuthFailed when its message or code
+// matches a known authentication failure. Error results carrying the failure only in their
+// result text (e.g. Claude's "Invalid API key · Please run /login") get an ErrorInfo.
+// Errors a provider parser already classified are left alone.
+func classifyAuthError(event *OutputEvent) {
+	if event.Error != nil {
+		if event.Error.Reason == ErrReasonUnknown &&
+			(IsAuthErrorMessage(event.Error.Message) || IsAuthErrorMessage(event.Error.Code)) {
+			event.Error.Reason = ErrReasonAuthFailed
+		}
+		return
+	}
+	if event.IsErrorResult && IsAuthErrorMessage(event.Result) {
+		event.Error = &ErrorInfo{Message: event.Result, Reason: ErrReasonAuthFailed}
+	}
+}
+
 // ParsePolymorphicError handles the polymorphic error field from provider CLI outputs.
 // It can be:
 //   - A string: "error message" or "Connection refused"
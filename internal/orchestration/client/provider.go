@@ -14,6 +14,8 @@ const (
 	RoleWorker = AgentProviderRole("WORKER")
 	// RoleObserver is the observer role.
 	RoleObserver = AgentProviderRole("OBSERVER")
+	// RoleWorkerAlternate is the provider workers rotate to when the worker provider is rate limited.
+	RoleWorkerAlternate = AgentProviderRole("WORKER_ALTERNATE")
 )
 
 // AgentProviders maps roles to their providers.
@@ -45,6 +47,13 @@ func (p AgentProviders) Observer() AgentProvider {
 	return p.Worker()
 }
 
+// WorkerAlternate returns the alternate worker provider, if one is configured.
+// Unlike the other roles it has no fallback.
+func (p AgentProviders) WorkerAlternate() (AgentProvider, bool) {
+	provider, ok := p[RoleWorkerAlternate]
+	return provider, ok
+}
+
 // AgentProvider creates and configures AI agent processes.
 // It combines the client factory with provider-specific configuration,
 // providing a single object that can be passed through the orchestration
@@ -28,6 +28,21 @@ func TestNewAgentProvider(t *testing.T) {
 	})
 }
 
+func TestAgentProviders_WorkerAlternate(t *testing.T) {
+	providers := AgentProviders{
+		RoleCoordinator: NewAgentProvider(ClientClaude, nil),
+		RoleWorker:      NewAgentProvider(ClientCodex, nil),
+	}
+
+	_, ok := providers.WorkerAlternate()
+	assert.False(t, ok, "alternate does not fall back to another role")
+
+	providers[RoleWorkerAlternate] = NewAgentProvider(ClientGemini, nil)
+	alt, ok := providers.WorkerAlternate()
+	require.True(t, ok)
+	assert.Equal(t, ClientGemini, alt.Type())
+}
+
 func TestAgentProvider_Client(t *testing.T) {
 	t.Run("returns error for unregistered client type", func(t *testing.T) {
 		p := NewAgentProvider("unknown", nil)
@@ -86,6 +101,10 @@ func (m *mockHeadlessClient) Type() ClientType {
 	return ClientMock
 }
 
+func (m *mockHeadlessClient) Capabilities() Capabilities {
+	return Capabilities{SupportsResume: true}
+}
+
 func (m *mockHeadlessClient) Spawn(ctx context.Context, cfg Config) (HeadlessProcess, error) {
 	if m.spawnFunc != nil {
 		return m.spawnFunc(ctx, cfg)
@@ -25,6 +25,17 @@ func (c *AmpClient) Type() client.ClientType {
 	return client.ClientAmp
 }
 
+// Capabilities returns the features supported by the Amp CLI.
+// Threads can be continued, but system prompts are prepended to the user prompt.
+func (c *AmpClient) Capabilities() client.Capabilities {
+	return client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: false,
+		SupportsToolFiltering:    false,
+		MCPConfigMethod:          client.MCPConfigFlag,
+	}
+}
+
 // Spawn creates and starts a headless Amp process.
 // If cfg.SessionID is set, resumes an existing thread.
 // If cfg.SessionID is empty, creates a new thread.
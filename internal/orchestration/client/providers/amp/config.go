@@ -8,16 +8,18 @@ import (
 
 // Config holds configuration for spawning an Amp process.
 type Config struct {
-	WorkDir         string
-	BeadsDir        string // Path to beads database directory for BEADS_DIR env var
-	Prompt          string
-	ThreadID        string // For resume (Amp uses "threads" instead of "sessions")
-	Model           string // "opus" or "sonnet" (default: opus)
-	Mode            string // Agent mode: "free", "rush", "smart"
-	SkipPermissions bool
-	Timeout         time.Duration
-	MCPConfig       string // JSON string for --mcp-config flag
-	DisableIDE      bool   // Disable IDE integration
+	WorkDir          string
+	BeadsDir         string // Path to beads database directory for BEADS_DIR env var
+	CommitAuthor     string // Git author for commits, in "Name <email>" format
+	Prompt           string
+	ThreadID         string // For resume (Amp uses "threads" instead of "sessions")
+	Model            string // "opus" or "sonnet" (default: opus)
+	Mode             string // Agent mode: "free", "rush", "smart"
+	SkipPermissions  bool
+	Timeout          time.Duration
+	StreamBufferSize int    // Max stream-json line size (0 = client.DefaultStreamBufferSize)
+	MCPConfig        string // JSON string for --mcp-config flag
+	DisableIDE       bool   // Disable IDE integration
 }
 
 // configFromClient converts a client.Config to an amp.Config.
@@ -29,16 +31,18 @@ func configFromClient(cfg client.Config) Config {
 	}
 
 	return Config{
-		WorkDir:         cfg.WorkDir,
-		BeadsDir:        cfg.BeadsDir,
-		Prompt:          prompt,
-		ThreadID:        cfg.SessionID, // Map session to thread
-		Model:           cfg.AmpModel(),
-		Mode:            cfg.GetExtensionString(ExtAmpMode),
-		SkipPermissions: cfg.SkipPermissions,
-		Timeout:         cfg.Timeout,
-		MCPConfig:       cfg.MCPConfig,
-		DisableIDE:      true, // Always disable IDE in headless mode
+		WorkDir:          cfg.WorkDir,
+		BeadsDir:         cfg.BeadsDir,
+		CommitAuthor:     cfg.CommitAuthor,
+		Prompt:           prompt,
+		ThreadID:         cfg.SessionID, // Map session to thread
+		Model:            cfg.AmpModel(),
+		Mode:             cfg.GetExtensionString(ExtAmpMode),
+		SkipPermissions:  cfg.SkipPermissions,
+		Timeout:          cfg.Timeout,
+		StreamBufferSize: cfg.StreamBufferSize,
+		MCPConfig:        cfg.MCPConfig,
+		DisableIDE:       true, // Always disable IDE in headless mode
 	}
 }
 
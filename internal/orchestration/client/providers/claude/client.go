@@ -25,6 +25,17 @@ func (c *ClaudeClient) Type() client.ClientType {
 	return client.ClientClaude
 }
 
+// Capabilities returns the features supported by the Claude CLI.
+// --append-system-prompt and --mcp-config are passed as flags.
+func (c *ClaudeClient) Capabilities() client.Capabilities {
+	return client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: true,
+		SupportsToolFiltering:    true,
+		MCPConfigMethod:          client.MCPConfigFlag,
+	}
+}
+
 // Spawn creates and starts a headless Claude process.
 // If cfg.SessionID is set, resumes an existing session.
 // If cfg.SessionID is empty, creates a new session.
@@ -41,6 +52,7 @@ func configFromClient(cfg client.Config) Config {
 	return Config{
 		WorkDir:            cfg.WorkDir,
 		BeadsDir:           cfg.BeadsDir,
+		CommitAuthor:       cfg.CommitAuthor,
 		Prompt:             cfg.Prompt,
 		SessionID:          cfg.SessionID,
 		Model:              cfg.ClaudeModel(),
@@ -49,6 +61,7 @@ func configFromClient(cfg client.Config) Config {
 		DisallowedTools:    cfg.DisallowedTools,
 		SkipPermissions:    cfg.SkipPermissions,
 		Timeout:            cfg.Timeout,
+		StreamBufferSize:   cfg.StreamBufferSize,
 		MCPConfig:          cfg.MCPConfig,
 		Env:                cfg.ClaudeEnv(),
 	}
@@ -87,6 +87,8 @@ func classifyErrorCode(code string) client.ErrorReason {
 		return client.ErrReasonInvalidRequest
 	case "rate_limit_exceeded", "rate_limited":
 		return client.ErrReasonRateLimited
+	case "authentication_failed", "authentication_error":
+		return client.ErrReasonAuthFailed
 	default:
 		return client.ErrReasonUnknown
 	}
@@ -27,6 +27,7 @@ var defaultKnownPaths = []string{
 type Config struct {
 	WorkDir            string
 	BeadsDir           string // Path to beads database directory for BEADS_DIR env var
+	CommitAuthor       string // Git author for commits, in "Name <email>" format
 	Prompt             string
 	SessionID          string // For --resume
 	Model              string // sonnet, opus, haiku
@@ -35,6 +36,7 @@ type Config struct {
 	DisallowedTools    []string
 	SkipPermissions    bool
 	Timeout            time.Duration
+	StreamBufferSize   int               // Max stream-json line size (0 = client.DefaultStreamBufferSize)
 	MCPConfig          string            // JSON string for --mcp-config flag
 	Env                map[string]string // Custom environment variables (supports ${VAR} expansion)
 }
@@ -163,8 +165,8 @@ func Spawn(ctx context.Context, cfg Config) (*Process, error) {
 
 	args := buildArgs(cfg)
 
-	// Build environment variables (BEADS_DIR if set)
-	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir})
+	// Build environment variables (BEADS_DIR and git author if set)
+	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir, CommitAuthor: cfg.CommitAuthor})
 
 	// Add custom env vars from config, expanding ${VAR} references
 	for k, v := range cfg.Env {
@@ -189,6 +191,7 @@ func Spawn(ctx context.Context, cfg Config) (*Process, error) {
 		WithWorkDir(cfg.WorkDir).
 		WithSessionRef(cfg.SessionID).
 		WithTimeout(cfg.Timeout).
+		WithStreamBufferSize(cfg.StreamBufferSize).
 		WithParser(NewParser()).
 		WithSessionExtractor(extractSession).
 		WithOnInitEvent(p.extractMainModel).
@@ -340,6 +340,15 @@ func TestOutputEventParsing(t *testing.T) {
 				require.Equal(t, client.ErrReasonRateLimited, e.Error.Reason)
 			},
 		},
+		{
+			name: "authentication failure as string code",
+			json: `{"type":"assistant","message":{"content":[{"type":"text","text":"Invalid API key · Please run /login"}]},"error":"authentication_failed"}`,
+			validate: func(t *testing.T, e client.OutputEvent) {
+				require.NotNil(t, e.Error)
+				require.Equal(t, "authentication_failed", e.Error.Code)
+				require.True(t, e.Error.IsAuthFailed())
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -25,6 +25,17 @@ func (c *CodexClient) Type() client.ClientType {
 	return client.ClientCodex
 }
 
+// Capabilities returns the features supported by the Codex CLI.
+// MCP servers are passed with -c; there is no system prompt flag or tool filtering.
+func (c *CodexClient) Capabilities() client.Capabilities {
+	return client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: false,
+		SupportsToolFiltering:    false,
+		MCPConfigMethod:          client.MCPConfigFlag,
+	}
+}
+
 // Spawn creates and starts a headless Codex process.
 // If cfg.SessionID is set, resumes an existing session.
 // If cfg.SessionID is empty, creates a new session.
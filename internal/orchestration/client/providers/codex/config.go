@@ -8,15 +8,17 @@ import (
 
 // Config holds configuration for spawning a Codex process.
 type Config struct {
-	WorkDir         string
-	BeadsDir        string // Path to beads database directory for BEADS_DIR env var
-	Prompt          string
-	SessionID       string // For resume (Codex uses "sessions")
-	Model           string // e.g., "gpt-5.2-codex", "o4-mini" (default: gpt-5.2-codex)
-	SandboxMode     string // "read-only", "workspace-write", "danger-full-access"
-	SkipPermissions bool
-	Timeout         time.Duration
-	MCPConfig       string // JSON string for -c flag TOML conversion
+	WorkDir          string
+	BeadsDir         string // Path to beads database directory for BEADS_DIR env var
+	CommitAuthor     string // Git author for commits, in "Name <email>" format
+	Prompt           string
+	SessionID        string // For resume (Codex uses "sessions")
+	Model            string // e.g., "gpt-5.2-codex", "o4-mini" (default: gpt-5.2-codex)
+	SandboxMode      string // "read-only", "workspace-write", "danger-full-access"
+	SkipPermissions  bool
+	Timeout          time.Duration
+	StreamBufferSize int    // Max stream-json line size (0 = client.DefaultStreamBufferSize)
+	MCPConfig        string // JSON string for -c flag TOML conversion
 }
 
 // configFromClient converts a client.Config to a codex.Config.
@@ -37,14 +39,16 @@ func configFromClient(cfg client.Config) Config {
 	}
 
 	return Config{
-		WorkDir:         cfg.WorkDir,
-		BeadsDir:        cfg.BeadsDir,
-		Prompt:          prompt,
-		SessionID:       cfg.SessionID,
-		Model:           cfg.CodexModel(),
-		SandboxMode:     sandboxMode,
-		SkipPermissions: cfg.SkipPermissions,
-		Timeout:         cfg.Timeout,
-		MCPConfig:       cfg.MCPConfig,
+		WorkDir:          cfg.WorkDir,
+		BeadsDir:         cfg.BeadsDir,
+		CommitAuthor:     cfg.CommitAuthor,
+		Prompt:           prompt,
+		SessionID:        cfg.SessionID,
+		Model:            cfg.CodexModel(),
+		SandboxMode:      sandboxMode,
+		SkipPermissions:  cfg.SkipPermissions,
+		Timeout:          cfg.Timeout,
+		StreamBufferSize: cfg.StreamBufferSize,
+		MCPConfig:        cfg.MCPConfig,
 	}
 }
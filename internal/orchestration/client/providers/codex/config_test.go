@@ -247,3 +247,13 @@ func TestConfigFromClient_SandboxModeLogic(t *testing.T) {
 		assert.Equal(t, "danger-full-access", result.SandboxMode)
 	})
 }
+
+func TestConfigFromClient_CommitAuthor(t *testing.T) {
+	cfg := client.Config{
+		CommitAuthor: "perles-worker-1 <workflow@bot>",
+	}
+
+	result := configFromClient(cfg)
+
+	assert.Equal(t, "perles-worker-1 <workflow@bot>", result.CommitAuthor)
+}
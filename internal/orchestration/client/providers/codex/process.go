@@ -39,14 +39,15 @@ func spawnProcess(ctx context.Context, cfg Config, isResume bool) (*Process, err
 
 	args := buildArgs(cfg, isResume)
 
-	// Build environment variables (BEADS_DIR if set)
-	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir})
+	// Build environment variables (BEADS_DIR and git author if set)
+	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir, CommitAuthor: cfg.CommitAuthor})
 
 	base, err := client.NewSpawnBuilder(ctx).
 		WithExecutable(execPath, args).
 		WithWorkDir(cfg.WorkDir).
 		WithSessionRef(cfg.SessionID).
 		WithTimeout(cfg.Timeout).
+		WithStreamBufferSize(cfg.StreamBufferSize).
 		WithParser(NewParser()).
 		WithProviderName("codex").
 		WithEnv(env).
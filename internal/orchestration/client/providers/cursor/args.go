@@ -1,5 +1,23 @@
 package cursor
 
+import (
+	"fmt"
+	"strings"
+)
+
+// managedFlags are flags buildArgs controls; ExtraArgs may not repeat them.
+var managedFlags = map[string]bool{
+	"-p":              true,
+	"--print":         true,
+	"--output-format": true,
+	"--resume":        true,
+	"--model":         true,
+	"-m":              true,
+	"--force":         true,
+	"-f":              true,
+	"--approve-mcps":  true,
+}
+
 // buildArgs constructs command line arguments for the Cursor Agent CLI.
 //
 // For new sessions:
@@ -14,10 +32,17 @@ package cursor
 // --disallowed-tools, or --mcp-config. System prompt is prepended to the
 // main prompt in configFromClient instead. MCP config is written to
 // .cursor/mcp.json in the work directory before spawning.
+//
+// The output format is cfg.OutputFormat, stream-json when unset.
+// ExtraArgs are appended after the managed flags and before the prompt.
 func buildArgs(cfg Config) []string {
+	format := cfg.OutputFormat
+	if format == "" {
+		format = OutputFormatStreamJSON
+	}
 	args := []string{
 		"--print",
-		"--output-format", "stream-json",
+		"--output-format", string(format),
 	}
 
 	// Session resume flag
@@ -41,6 +66,9 @@ func buildArgs(cfg Config) []string {
 		args = append(args, "--approve-mcps")
 	}
 
+	// User-supplied flags go after managed flags so the prompt stays last
+	args = append(args, cfg.ExtraArgs...)
+
 	// Prompt as final positional argument
 	if cfg.Prompt != "" {
 		args = append(args, cfg.Prompt)
@@ -48,3 +76,16 @@ func buildArgs(cfg Config) []string {
 
 	return args
 }
+
+// validateExtraArgs rejects extra args that would conflict with managed flags,
+// e.g. a second --output-format that would not match the configured parser.
+// Both "--flag value" and "--flag=value" forms are checked.
+func validateExtraArgs(extraArgs []string) error {
+	for _, arg := range extraArgs {
+		flag, _, _ := strings.Cut(arg, "=")
+		if managedFlags[flag] {
+			return fmt.Errorf("extra arg %q conflicts with managed flag %s", arg, flag)
+		}
+	}
+	return nil
+}
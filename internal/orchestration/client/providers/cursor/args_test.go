@@ -1,6 +1,7 @@
 package cursor
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -83,6 +84,20 @@ func TestBuildArgs(t *testing.T) {
 				"build it",
 			},
 		},
+		{
+			name: "extra args appended after managed flags and before prompt",
+			cfg: Config{
+				Prompt:    "build it",
+				Model:     "composer-1",
+				ExtraArgs: []string{"--sandbox", "disabled", "--stream-partial-output"},
+			},
+			want: []string{
+				"--print", "--output-format", "stream-json",
+				"--model", "composer-1",
+				"--sandbox", "disabled", "--stream-partial-output",
+				"build it",
+			},
+		},
 		{
 			name: "prompt with special characters is preserved",
 			cfg: Config{
@@ -99,3 +114,66 @@ func TestBuildArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildArgs_OutputFormat(t *testing.T) {
+	for _, format := range []OutputFormat{OutputFormatStreamJSON, OutputFormatJSON, OutputFormatText} {
+		got := buildArgs(Config{Prompt: "hi", OutputFormat: format})
+		require.Equal(t, []string{"--print", "--output-format", string(format), "hi"}, got)
+	}
+}
+
+func TestResolveOutputFormat(t *testing.T) {
+	format, err := resolveOutputFormat("")
+	require.NoError(t, err)
+	require.Equal(t, OutputFormatStreamJSON, format, "empty defaults to stream-json")
+
+	for _, want := range []OutputFormat{OutputFormatStreamJSON, OutputFormatJSON, OutputFormatText} {
+		format, err := resolveOutputFormat(want)
+		require.NoError(t, err)
+		require.Equal(t, want, format)
+	}
+
+	_, err = resolveOutputFormat("yaml")
+	require.ErrorContains(t, err, `unsupported output format "yaml"`)
+}
+
+func TestValidateExtraArgs(t *testing.T) {
+	valid := [][]string{
+		nil,
+		{},
+		{"--sandbox", "disabled"},
+		{"--stream-partial-output"},
+		{"--browser=true"},
+	}
+	for _, args := range valid {
+		require.NoError(t, validateExtraArgs(args), "args %v should be accepted", args)
+	}
+
+	conflicting := []struct {
+		args []string
+		flag string
+	}{
+		{[]string{"--output-format", "json"}, "--output-format"},
+		{[]string{"--output-format=text"}, "--output-format"},
+		{[]string{"--sandbox", "disabled", "--print"}, "--print"},
+		{[]string{"-p"}, "-p"},
+		{[]string{"--model", "gpt-5"}, "--model"},
+		{[]string{"--resume=ses_123"}, "--resume"},
+		{[]string{"--force"}, "--force"},
+		{[]string{"--approve-mcps"}, "--approve-mcps"},
+	}
+	for _, tt := range conflicting {
+		err := validateExtraArgs(tt.args)
+		require.Error(t, err, "args %v should be rejected", tt.args)
+		require.Contains(t, err.Error(), "conflicts with managed flag "+tt.flag)
+	}
+}
+
+func TestSpawn_RejectsConflictingExtraArgs(t *testing.T) {
+	_, err := Spawn(context.Background(), Config{
+		WorkDir:   t.TempDir(),
+		Prompt:    "hello",
+		ExtraArgs: []string{"--output-format", "json"},
+	})
+	require.ErrorContains(t, err, "cursor: extra arg")
+}
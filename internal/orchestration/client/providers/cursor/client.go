@@ -25,6 +25,19 @@ func (c *CursorClient) Type() client.ClientType {
 	return client.ClientCursor
 }
 
+// Capabilities returns the features supported by the Cursor CLI.
+// Cursor has no --append-system-prompt or --mcp-config flag; MCP servers
+// are merged into .cursor/mcp.json in the work directory.
+func (c *CursorClient) Capabilities() client.Capabilities {
+	return client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: false,
+		SupportsToolFiltering:    false,
+		MCPConfigMethod:          client.MCPConfigFile,
+		ReadyGracePeriod:         client.MCPStartupGracePeriod,
+	}
+}
+
 // Spawn creates and starts a headless Cursor process.
 // If cfg.SessionID is set, resumes an existing session.
 // If cfg.SessionID is empty, creates a new session.
@@ -22,3 +22,14 @@ func TestCursorClient_Registration(t *testing.T) {
 	require.NotNil(t, c)
 	require.Equal(t, client.ClientCursor, c.Type())
 }
+
+func TestCursorClient_Capabilities(t *testing.T) {
+	c := NewClient()
+	require.Equal(t, client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: false,
+		SupportsToolFiltering:    false,
+		MCPConfigMethod:          client.MCPConfigFile,
+		ReadyGracePeriod:         client.MCPStartupGracePeriod,
+	}, c.Capabilities())
+}
@@ -8,14 +8,21 @@ import (
 
 // Config holds configuration for spawning a Cursor process.
 type Config struct {
-	WorkDir         string
-	BeadsDir        string        // Path to beads database directory for BEADS_DIR env var
-	Prompt          string        // Includes prepended system prompt (Cursor has no --append-system-prompt)
-	Model           string        // e.g., "composer-1"
-	SessionID       string        // For --resume to continue existing session
-	SkipPermissions bool          // Maps to --force flag
-	Timeout         time.Duration
-	MCPConfig       string        // MCP config JSON; written to .cursor/mcp.json before spawn
+	WorkDir          string
+	BeadsDir         string // Path to beads database directory for BEADS_DIR env var
+	CommitAuthor     string // Git author for commits, in "Name <email>" format
+	Prompt           string // Includes prepended system prompt (Cursor has no --append-system-prompt)
+	Model            string // e.g., "composer-1"
+	SessionID        string // For --resume to continue existing session
+	SkipPermissions  bool   // Maps to --force flag
+	Timeout          time.Duration
+	OutputFormat     OutputFormat // --output-format value (empty = stream-json)
+	StreamBufferSize int          // Max stream-json line size (0 = client.DefaultStreamBufferSize)
+	MCPConfig        string       // MCP config JSON; written to .cursor/mcp.json before spawn
+	ExtraArgs        []string     // Extra flags appended after the managed flags; see validateExtraArgs
+	MaxConcurrent    int          // Max cursor-agent processes running at once (0 = unlimited)
+	ProcessID        string       // Orchestration process ID (e.g., "worker-1"), used to name transcripts
+	TranscriptDir    string       // When set, raw stream-json is teed to {dir}/{ProcessID}-{sessionID}.jsonl
 }
 
 // configFromClient converts a client.Config to a cursor.Config.
@@ -30,13 +37,20 @@ func configFromClient(cfg client.Config) Config {
 	}
 
 	return Config{
-		WorkDir:         cfg.WorkDir,
-		BeadsDir:        cfg.BeadsDir,
-		Prompt:          prompt,
-		Model:           cfg.CursorModel(),
-		SessionID:       cfg.SessionID,
-		SkipPermissions: cfg.SkipPermissions,
-		Timeout:         cfg.Timeout,
-		MCPConfig:       cfg.MCPConfig,
+		WorkDir:          cfg.WorkDir,
+		BeadsDir:         cfg.BeadsDir,
+		CommitAuthor:     cfg.CommitAuthor,
+		Prompt:           prompt,
+		Model:            cfg.CursorModel(),
+		SessionID:        cfg.SessionID,
+		SkipPermissions:  cfg.SkipPermissions,
+		Timeout:          cfg.Timeout,
+		OutputFormat:     OutputFormat(cfg.CursorOutputFormat()),
+		StreamBufferSize: cfg.StreamBufferSize,
+		MCPConfig:        cfg.MCPConfig,
+		ExtraArgs:        cfg.CursorExtraArgs(),
+		MaxConcurrent:    cfg.CursorMaxConcurrent(),
+		ProcessID:        cfg.ProcessID,
+		TranscriptDir:    cfg.CursorTranscriptDir(),
 	}
 }
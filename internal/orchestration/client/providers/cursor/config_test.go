@@ -88,6 +88,30 @@ func TestConfigFromClient(t *testing.T) {
 				Model: "composer-1",
 			},
 		},
+		{
+			name: "ExtCursorExtraArgs is extracted",
+			input: client.Config{
+				Extensions: map[string]any{
+					client.ExtCursorExtraArgs: []any{"--sandbox", "disabled"},
+				},
+			},
+			expected: Config{
+				ExtraArgs: []string{"--sandbox", "disabled"},
+			},
+		},
+		{
+			name: "ExtCursorTranscriptDir and ProcessID are extracted",
+			input: client.Config{
+				ProcessID: "worker-1",
+				Extensions: map[string]any{
+					client.ExtCursorTranscriptDir: "/var/log/transcripts",
+				},
+			},
+			expected: Config{
+				ProcessID:     "worker-1",
+				TranscriptDir: "/var/log/transcripts",
+			},
+		},
 		{
 			name:  "empty config handled gracefully",
 			input: client.Config{},
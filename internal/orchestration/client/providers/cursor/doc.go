@@ -28,11 +28,22 @@
 //
 // Key flags:
 //   - --print: Non-interactive mode for scripting/automation
-//   - --output-format stream-json: Structured JSONL output for parsing
+//   - --output-format stream-json: Structured JSONL output for parsing (see Output Format)
 //   - --model: Model selection (e.g., composer-1)
 //   - --resume: Resume existing session by ID
 //   - --force: Allow direct file modifications without confirmation
 //
+// # Output Format
+//
+// Config.OutputFormat (orchestration.cursor.output_format) selects the
+// --output-format value. stream-json is the default and the only format that
+// reports tool calls and token usage as they happen. json prints a single
+// result object when the session ends and is parsed by the same event parser.
+// text prints plain assistant text, which is read line by line into assistant
+// events; it reports no session ID, so text sessions cannot be resumed. Text is
+// meant for one-shot diagnostic runs, and orchestration config validation rejects
+// it when any orchestration process runs on cursor.
+//
 // # System Prompt
 //
 // Cursor does not support --append-system-prompt. System prompts are
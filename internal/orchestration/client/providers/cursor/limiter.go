@@ -0,0 +1,72 @@
+package cursor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// processLimiter caps how many cursor-agent processes run at once.
+// All processes share the same binary and its config/cache directory, which does
+// not tolerate many concurrent invocations, so the limit applies across every spawn
+// in this process rather than per client.
+type processLimiter struct {
+	mu       sync.Mutex
+	active   int
+	released chan struct{} // closed and replaced each time a slot is released
+}
+
+// spawnLimiter is the limiter shared by all Cursor spawns.
+var spawnLimiter = newProcessLimiter()
+
+// spawnSlotTimeout bounds how long Spawn waits for a free slot. Spawns run on the
+// command processor, so an unbounded wait would stall every other command while
+// the limit is reached. A var so tests can shorten it.
+var spawnSlotTimeout = 10 * time.Second
+
+// newProcessLimiter creates a processLimiter with no active processes.
+func newProcessLimiter() *processLimiter {
+	return &processLimiter{released: make(chan struct{})}
+}
+
+// acquire blocks until fewer than limit processes hold a slot, then takes one.
+// A limit of 0 or less means unlimited. The returned release func frees the slot
+// and is safe to call more than once. Returns an error if ctx is done first.
+func (l *processLimiter) acquire(ctx context.Context, limit int) (func(), error) {
+	if limit <= 0 {
+		return func() {}, nil
+	}
+	for {
+		l.mu.Lock()
+		if l.active < limit {
+			l.active++
+			l.mu.Unlock()
+			return sync.OnceFunc(l.release), nil
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for a free cursor-agent slot (limit %d): %w", limit, ctx.Err())
+		}
+	}
+}
+
+// release frees a slot and wakes any spawns waiting for one.
+func (l *processLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active--
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// inUse returns the number of slots currently held.
+func (l *processLimiter) inUse() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.active
+}
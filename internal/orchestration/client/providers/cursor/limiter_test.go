@@ -0,0 +1,152 @@
+package cursor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessLimiter_Unlimited(t *testing.T) {
+	l := newProcessLimiter()
+	for range 5 {
+		release, err := l.acquire(context.Background(), 0)
+		require.NoError(t, err)
+		defer release()
+	}
+	require.Zero(t, l.inUse(), "unlimited acquires should not hold slots")
+}
+
+func TestProcessLimiter_ExtraAcquireWaitsForSlot(t *testing.T) {
+	l := newProcessLimiter()
+
+	release1, err := l.acquire(context.Background(), 2)
+	require.NoError(t, err)
+	release2, err := l.acquire(context.Background(), 2)
+	require.NoError(t, err)
+	defer release2()
+
+	acquired := make(chan func(), 1)
+	go func() {
+		release, err := l.acquire(context.Background(), 2)
+		if err == nil {
+			acquired <- release
+		}
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("third acquire should wait while both slots are held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release1()
+	release1() // releasing twice must not free a second slot
+
+	select {
+	case release3 := <-acquired:
+		release3()
+	case <-time.After(time.Second):
+		t.Fatal("third acquire should proceed once a slot is released")
+	}
+	require.Equal(t, 1, l.inUse())
+}
+
+func TestProcessLimiter_ContextCancelledWhileWaiting(t *testing.T) {
+	l := newProcessLimiter()
+	release, err := l.acquire(context.Background(), 1)
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = l.acquire(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Contains(t, err.Error(), "limit 1")
+	require.Equal(t, 1, l.inUse())
+}
+
+func TestSpawn_WaitsForSlotUntilRunningProcessExits(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake cursor-agent script requires a POSIX shell")
+	}
+
+	tempDir := t.TempDir()
+	localBinDir := filepath.Join(tempDir, ".local", "bin")
+	require.NoError(t, os.MkdirAll(localBinDir, 0755))
+	// Stays running until killed so the first process holds its slot
+	script := "#!/bin/sh\nexec sleep 30\n"
+	require.NoError(t, os.WriteFile(filepath.Join(localBinDir, "cursor-agent"), []byte(script), 0755))
+	t.Setenv("HOME", tempDir)
+
+	orig := spawnLimiter
+	spawnLimiter = newProcessLimiter()
+	t.Cleanup(func() { spawnLimiter = orig })
+
+	cfg := Config{WorkDir: tempDir, Prompt: "hi", MaxConcurrent: 1}
+
+	first, err := Spawn(context.Background(), cfg)
+	require.NoError(t, err)
+
+	spawned := make(chan *Process, 1)
+	go func() {
+		proc, err := Spawn(context.Background(), cfg)
+		if err == nil {
+			spawned <- proc
+		}
+	}()
+
+	select {
+	case <-spawned:
+		t.Fatal("second spawn should wait while the first process holds the only slot")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, first.Cancel())
+
+	select {
+	case second := <-spawned:
+		require.NoError(t, second.Cancel())
+		_ = second.Wait()
+	case <-time.After(5 * time.Second):
+		t.Fatal("second spawn should start once the first process exits")
+	}
+}
+
+func TestSpawn_FailsWhenNoSlotFreesInTime(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake cursor-agent script requires a POSIX shell")
+	}
+
+	tempDir := t.TempDir()
+	localBinDir := filepath.Join(tempDir, ".local", "bin")
+	require.NoError(t, os.MkdirAll(localBinDir, 0755))
+	script := "#!/bin/sh\nexec sleep 30\n"
+	require.NoError(t, os.WriteFile(filepath.Join(localBinDir, "cursor-agent"), []byte(script), 0755))
+	t.Setenv("HOME", tempDir)
+
+	origLimiter, origTimeout := spawnLimiter, spawnSlotTimeout
+	spawnLimiter = newProcessLimiter()
+	spawnSlotTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { spawnLimiter, spawnSlotTimeout = origLimiter, origTimeout })
+
+	cfg := Config{WorkDir: tempDir, Prompt: "hi", MaxConcurrent: 1}
+
+	first, err := Spawn(context.Background(), cfg)
+	require.NoError(t, err)
+	defer func() {
+		_ = first.Cancel()
+		_ = first.Wait()
+	}()
+
+	// The caller's context never ends, but the wait for a slot does
+	_, err = Spawn(context.Background(), cfg)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Contains(t, err.Error(), "cursor-agent slot")
+	require.Equal(t, 1, spawnLimiter.inUse())
+}
@@ -2,12 +2,18 @@ package cursor
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"maps"
 	"os"
 	"path/filepath"
 )
 
+// ErrMCPConfigNotWritten is returned by RenderedMCPConfig when the work directory
+// has no .cursor/mcp.json, usually because no Cursor process was spawned there.
+var ErrMCPConfigNotWritten = errors.New("cursor MCP config has not been written")
+
 // mcpFileConfig mirrors the mcp.MCPConfig structure for reading/writing .cursor/mcp.json.
 // We use a local type to avoid import cycles with the mcp package.
 type mcpFileConfig struct {
@@ -29,8 +35,8 @@ func writeMCPConfigFile(workDir, mcpConfigJSON string) error {
 		return fmt.Errorf("parsing MCP config: %w", err)
 	}
 
-	cursorDir := filepath.Join(workDir, ".cursor")
-	mcpPath := filepath.Join(cursorDir, "mcp.json")
+	mcpPath := mcpConfigPath(workDir)
+	cursorDir := filepath.Dir(mcpPath)
 
 	// Read existing config if it exists, so we can merge
 	existing := mcpFileConfig{MCPServers: make(map[string]json.RawMessage)}
@@ -61,3 +67,27 @@ func writeMCPConfigFile(workDir, mcpConfigJSON string) error {
 
 	return nil
 }
+
+// mcpConfigPath returns the path of .cursor/mcp.json in the given work directory.
+func mcpConfigPath(workDir string) string {
+	return filepath.Join(workDir, ".cursor", "mcp.json")
+}
+
+// RenderedMCPConfig returns the .cursor/mcp.json currently on disk in workDir,
+// i.e. the user-defined servers merged with the perles-managed ones by the last spawn.
+// Returns ErrMCPConfigNotWritten if the file does not exist.
+func (c *CursorClient) RenderedMCPConfig(workDir string) ([]byte, error) {
+	if workDir == "" {
+		return nil, fmt.Errorf("work directory is required")
+	}
+
+	mcpPath := mcpConfigPath(workDir)
+	data, err := os.ReadFile(mcpPath) //nolint:gosec // G304: mcpPath is constructed from trusted workDir parameter
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s not found", ErrMCPConfigNotWritten, mcpPath)
+		}
+		return nil, fmt.Errorf("reading .cursor/mcp.json: %w", err)
+	}
+	return data, nil
+}
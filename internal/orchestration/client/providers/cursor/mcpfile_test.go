@@ -120,3 +120,40 @@ func TestWriteMCPConfigFile(t *testing.T) {
 		assert.Contains(t, parsed.MCPServers, "perles-worker")
 	})
 }
+
+func TestRenderedMCPConfig(t *testing.T) {
+	t.Run("returns merged config after a write", func(t *testing.T) {
+		workDir := t.TempDir()
+		cursorDir := filepath.Join(workDir, ".cursor")
+		require.NoError(t, os.MkdirAll(cursorDir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(cursorDir, "mcp.json"),
+			[]byte(`{"mcpServers":{"user-server":{"command":"my-server"}}}`), 0o644))
+
+		mcpJSON := `{"mcpServers":{"perles-orchestrator":{"url":"http://localhost:9000/mcp"},"perles-worker":{"url":"http://localhost:9000/worker/worker-1"}}}`
+		require.NoError(t, writeMCPConfigFile(workDir, mcpJSON))
+
+		data, err := NewClient().RenderedMCPConfig(workDir)
+		require.NoError(t, err)
+
+		var parsed mcpFileConfig
+		require.NoError(t, json.Unmarshal(data, &parsed))
+		assert.Contains(t, parsed.MCPServers, "perles-orchestrator")
+		assert.Contains(t, parsed.MCPServers, "perles-worker")
+		assert.Contains(t, parsed.MCPServers, "user-server")
+		assert.JSONEq(t, `{"url":"http://localhost:9000/mcp"}`, string(parsed.MCPServers["perles-orchestrator"]))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		workDir := t.TempDir()
+
+		data, err := NewClient().RenderedMCPConfig(workDir)
+		require.ErrorIs(t, err, ErrMCPConfigNotWritten)
+		assert.Contains(t, err.Error(), filepath.Join(workDir, ".cursor", "mcp.json"))
+		assert.Nil(t, data)
+	})
+
+	t.Run("empty work dir", func(t *testing.T) {
+		_, err := NewClient().RenderedMCPConfig("")
+		require.ErrorContains(t, err, "work directory is required")
+	})
+}
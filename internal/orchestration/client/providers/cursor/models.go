@@ -0,0 +1,54 @@
+package cursor
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+)
+
+// listModelsTimeout bounds how long `cursor-agent models` may run.
+const listModelsTimeout = 15 * time.Second
+
+// ansiEscape matches terminal color sequences cursor-agent may print.
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// ListModels runs `cursor-agent models` and returns the model IDs it lists.
+func (c *CursorClient) ListModels(ctx context.Context) ([]string, error) {
+	execPath, err := client.NewExecutableFinder("cursor-agent",
+		client.WithKnownPaths(defaultKnownPaths...),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, listModelsTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, execPath, "models").Output() //nolint:gosec // fixed arguments
+	if err != nil {
+		return nil, fmt.Errorf("cursor: listing models: %w", err)
+	}
+	return parseModels(string(out)), nil
+}
+
+// parseModels extracts model IDs from `cursor-agent models` output, where each model
+// is printed as "<id> - <display name>". Headings and hints are skipped.
+func parseModels(output string) []string {
+	var models []string
+	for line := range strings.SplitSeq(ansiEscape.ReplaceAllString(output, ""), "\n") {
+		id, _, ok := strings.Cut(strings.TrimSpace(line), " - ")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" || strings.ContainsAny(id, " \t") {
+			continue
+		}
+		models = append(models, id)
+	}
+	return models
+}
+
+// Ensure CursorClient can list its models at compile time.
+var _ client.ModelLister = (*CursorClient)(nil)
@@ -0,0 +1,22 @@
+package cursor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModels(t *testing.T) {
+	output := "\x1b[1mAvailable models\x1b[0m\n\n" +
+		"auto - Auto\n" +
+		"composer-1 - Composer 1  (current, default)\n" +
+		"sonnet-4.5-thinking - Claude 4.5 Sonnet Thinking\n" +
+		"gpt-5 - GPT-5\n\n" +
+		"Tip: use --model <id> to switch models.\n"
+
+	require.Equal(t, []string{"auto", "composer-1", "sonnet-4.5-thinking", "gpt-5"}, parseModels(output))
+}
+
+func TestParseModels_NoModels(t *testing.T) {
+	require.Empty(t, parseModels("No models available for this account.\n"))
+}
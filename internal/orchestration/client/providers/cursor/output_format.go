@@ -0,0 +1,77 @@
+package cursor
+
+import (
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+)
+
+// OutputFormat is the value passed to cursor-agent's --output-format flag.
+type OutputFormat string
+
+const (
+	// OutputFormatStreamJSON streams one JSON event per line as the session runs (default).
+	OutputFormatStreamJSON OutputFormat = "stream-json"
+	// OutputFormatJSON prints a single result object when the session ends.
+	OutputFormatJSON OutputFormat = "json"
+	// OutputFormatText prints only the final assistant text, with no structured events.
+	// It reports no session ID, so it is for one-shot diagnostic runs only: sessions
+	// started with it cannot be resumed, and orchestration config rejects it for
+	// coordinators, workers and observers on cursor.
+	OutputFormatText OutputFormat = "text"
+)
+
+// resolveOutputFormat returns the output format to use, defaulting to stream-json
+// when none is set, or an error if the format is not one cursor-agent supports.
+func resolveOutputFormat(format OutputFormat) (OutputFormat, error) {
+	switch format {
+	case "":
+		return OutputFormatStreamJSON, nil
+	case OutputFormatStreamJSON, OutputFormatJSON, OutputFormatText:
+		return format, nil
+	}
+	return "", fmt.Errorf("unsupported output format %q (want stream-json, json or text)", format)
+}
+
+// parserFor returns the EventParser that decodes stdout in the given format.
+// Both JSON formats emit Cursor's event objects one per line, so they share the
+// stream parser; text output has no structure and is read line by line.
+func parserFor(format OutputFormat) client.EventParser {
+	if format == OutputFormatText {
+		return newTextParser()
+	}
+	return NewParser()
+}
+
+// textParser implements client.EventParser for --output-format text.
+// Each non-empty stdout line becomes an assistant text event. Text output carries
+// no session ID, usage or result event, so the turn ends when the process exits.
+type textParser struct {
+	client.BaseParser
+}
+
+// newTextParser creates a textParser with the default context window size.
+func newTextParser() *textParser {
+	return &textParser{
+		BaseParser: client.NewBaseParser(CursorContextWindowSize),
+	}
+}
+
+// ParseEvent wraps a line of plain text output in an assistant event.
+func (p *textParser) ParseEvent(data []byte) (client.OutputEvent, error) {
+	return client.OutputEvent{
+		Type: client.EventAssistant,
+		Message: &client.MessageContent{
+			Role:    "assistant",
+			Content: []client.ContentBlock{{Type: "text", Text: string(data)}},
+		},
+	}, nil
+}
+
+// ExtractSessionRef always returns "": text output does not report a session ID.
+func (p *textParser) ExtractSessionRef(_ client.OutputEvent, _ []byte) string {
+	return ""
+}
+
+// Ensure textParser implements client.EventParser at compile time.
+var _ client.EventParser = (*textParser)(nil)
@@ -23,8 +23,12 @@ type Process struct {
 	*client.BaseProcess
 }
 
-// extractSession extracts the session ID from an init event.
+// extractSession extracts the session ID from an init event, or from the
+// result event when --output-format json prints only the final result.
 func extractSession(event client.OutputEvent, rawLine []byte) string {
+	if event.Type == client.EventResult && event.SessionID != "" {
+		return event.SessionID
+	}
 	if event.Type == client.EventSystem && event.SubType == "init" {
 		var initData struct {
 			SessionID string `json:"session_id"`
@@ -50,6 +54,15 @@ func Resume(ctx context.Context, sessionID string, cfg Config) (*Process, error)
 
 // spawnProcess is the internal implementation for both Spawn and Resume.
 func spawnProcess(ctx context.Context, cfg Config) (*Process, error) {
+	if err := validateExtraArgs(cfg.ExtraArgs); err != nil {
+		return nil, fmt.Errorf("cursor: %w", err)
+	}
+	format, err := resolveOutputFormat(cfg.OutputFormat)
+	if err != nil {
+		return nil, fmt.Errorf("cursor: %w", err)
+	}
+	cfg.OutputFormat = format
+
 	// Write .cursor/mcp.json if MCP config is provided.
 	// Cursor CLI reads MCP server configuration from this file (not from CLI flags).
 	if cfg.MCPConfig != "" {
@@ -72,31 +85,57 @@ func spawnProcess(ctx context.Context, cfg Config) (*Process, error) {
 
 	args := buildArgs(cfg)
 
-	// Build environment variables (BEADS_DIR if set)
-	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir})
+	// Wait for a free slot so the shared cursor-agent binary is not overloaded.
+	// The slot is held until the process exits.
+	waitCtx, cancel := context.WithTimeout(ctx, spawnSlotTimeout)
+	release, err := spawnLimiter.acquire(waitCtx, cfg.MaxConcurrent)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("cursor: %w", err)
+	}
+
+	// Build environment variables (BEADS_DIR and git author if set)
+	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir, CommitAuthor: cfg.CommitAuthor})
 
 	log.Debug(log.CatOrch, "spawning cursor-agent process",
 		"subsystem", "cursor", "workDir", cfg.WorkDir,
-		"model", cfg.Model, "sessionID", cfg.SessionID)
+		"model", cfg.Model, "sessionID", cfg.SessionID, "outputFormat", cfg.OutputFormat)
 
 	base, err := client.NewSpawnBuilder(ctx).
 		WithExecutable(execPath, args).
 		WithWorkDir(cfg.WorkDir).
 		WithSessionRef(cfg.SessionID).
 		WithTimeout(cfg.Timeout).
-		WithParser(NewParser()).
+		WithStreamBufferSize(cfg.StreamBufferSize).
+		WithParser(parserFor(cfg.OutputFormat)).
 		WithSessionExtractor(extractSession).
 		WithStderrCapture(true).
+		WithTranscript(cfg.TranscriptDir, transcriptProcessID(cfg.ProcessID)).
 		WithProviderName("cursor").
 		WithEnv(env).
 		Build()
 	if err != nil {
+		release()
 		return nil, fmt.Errorf("cursor: %w", err)
 	}
 
+	go func() {
+		_ = base.Wait()
+		release()
+	}()
+
 	return &Process{BaseProcess: base}, nil
 }
 
+// transcriptProcessID returns the process ID used in transcript file names,
+// falling back to "cursor" when the spawner did not provide one.
+func transcriptProcessID(processID string) string {
+	if processID == "" {
+		return "cursor"
+	}
+	return processID
+}
+
 // SessionID returns the session ID (may be empty until init event is received).
 // This is a convenience method that wraps SessionRef for backwards compatibility.
 func (p *Process) SessionID() string {
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -584,3 +586,130 @@ func TestProcess_ProviderName_IsCursor(t *testing.T) {
 
 	require.Equal(t, "cursor", bp.ProviderName())
 }
+
+// =============================================================================
+// Transcript Tests
+// =============================================================================
+
+func TestSpawn_TranscriptDir_CapturesStreamedLines(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake cursor-agent is a shell script")
+	}
+
+	lines := []string{
+		`{"type":"system","subtype":"init","session_id":"sess-abc","model":"composer-1"}`,
+		`{"type":"assistant","message":{"role":"assistant","content":[{"type":"text","text":"hello"}]}}`,
+		`{"type":"result","subtype":"success","result":"done"}`,
+	}
+
+	installFakeCursorAgent(t, lines)
+
+	transcriptDir := filepath.Join(t.TempDir(), "transcripts")
+	proc, err := Spawn(context.Background(), Config{
+		WorkDir:       t.TempDir(),
+		Prompt:        "test",
+		ProcessID:     "worker-1",
+		TranscriptDir: transcriptDir,
+	})
+	require.NoError(t, err)
+
+	for range proc.Events() {
+	}
+	require.NoError(t, proc.Wait())
+	require.Equal(t, "sess-abc", proc.SessionID())
+
+	data, err := os.ReadFile(filepath.Join(transcriptDir, "worker-1-sess-abc.jsonl"))
+	require.NoError(t, err)
+	require.Equal(t, strings.Join(lines, "\n")+"\n", string(data))
+}
+
+// installFakeCursorAgent installs a fake cursor-agent that prints fixed stdout lines.
+// It lingers briefly after writing so stdout is drained before the process is reaped.
+func installFakeCursorAgent(t *testing.T, lines []string) {
+	t.Helper()
+	homeDir := t.TempDir()
+	localBinDir := filepath.Join(homeDir, ".local", "bin")
+	require.NoError(t, os.MkdirAll(localBinDir, 0755))
+	script := "#!/bin/sh\ncat <<'EOF'\n" + strings.Join(lines, "\n") + "\nEOF\nsleep 0.2\n"
+	require.NoError(t, os.WriteFile(filepath.Join(localBinDir, "cursor-agent"), []byte(script), 0755))
+	t.Setenv("HOME", homeDir)
+	t.Setenv("USERPROFILE", homeDir)
+}
+
+// collectEvents spawns the fake cursor-agent with format and returns every event it emits.
+func collectEvents(t *testing.T, format OutputFormat) (*Process, []client.OutputEvent) {
+	t.Helper()
+	proc, err := Spawn(context.Background(), Config{WorkDir: t.TempDir(), Prompt: "test", OutputFormat: format})
+	require.NoError(t, err)
+
+	var events []client.OutputEvent
+	for event := range proc.Events() {
+		events = append(events, event)
+	}
+	require.NoError(t, proc.Wait())
+	return proc, events
+}
+
+func TestSpawn_OutputFormatStreamJSON_DecodesEvents(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake cursor-agent is a shell script")
+	}
+	installFakeCursorAgent(t, []string{
+		`{"type":"system","subtype":"init","session_id":"sess-stream"}`,
+		`{"type":"assistant","message":{"role":"assistant","content":[{"type":"text","text":"hello"}]}}`,
+		`{"type":"result","subtype":"success","result":"done"}`,
+	})
+
+	proc, events := collectEvents(t, "")
+	require.Len(t, events, 3)
+	require.Equal(t, "hello", events[1].Message.GetText())
+	require.True(t, events[2].IsResult())
+	require.Equal(t, "sess-stream", proc.SessionID())
+}
+
+func TestSpawn_OutputFormatJSON_DecodesResult(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake cursor-agent is a shell script")
+	}
+	installFakeCursorAgent(t, []string{
+		`{"type":"result","subtype":"success","is_error":false,"duration_ms":1200,"result":"all done","session_id":"sess-json"}`,
+	})
+
+	proc, events := collectEvents(t, OutputFormatJSON)
+	require.Len(t, events, 1)
+	require.True(t, events[0].IsResult())
+	require.Equal(t, "all done", events[0].Result)
+	require.Equal(t, int64(1200), events[0].DurationMs)
+	require.Equal(t, "sess-json", proc.SessionID(), "json output reports the session on its result")
+}
+
+func TestSpawn_OutputFormatText_ReadsPlainLines(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake cursor-agent is a shell script")
+	}
+	installFakeCursorAgent(t, []string{"First line of the answer.", "", `{"not":"parsed"}`})
+
+	proc, events := collectEvents(t, OutputFormatText)
+	require.Len(t, events, 2, "blank lines are skipped")
+	for _, event := range events {
+		require.True(t, event.IsAssistant())
+	}
+	require.Equal(t, "First line of the answer.", events[0].Message.GetText())
+	require.Equal(t, `{"not":"parsed"}`, events[1].Message.GetText(), "text output is never decoded as JSON")
+	require.Empty(t, proc.SessionID())
+}
+
+func TestSpawn_RejectsUnknownOutputFormat(t *testing.T) {
+	_, err := Spawn(context.Background(), Config{WorkDir: t.TempDir(), Prompt: "test", OutputFormat: "yaml"})
+	require.ErrorContains(t, err, `cursor: unsupported output format "yaml"`)
+}
+
+func TestExtractSession_FromResultEvent(t *testing.T) {
+	event := client.OutputEvent{Type: client.EventResult, SessionID: "ses_json"}
+	require.Equal(t, "ses_json", extractSession(event, []byte(`{"type":"result","session_id":"ses_json"}`)))
+}
+
+func TestTranscriptProcessID_DefaultsToCursor(t *testing.T) {
+	require.Equal(t, "cursor", transcriptProcessID(""))
+	require.Equal(t, "worker-3", transcriptProcessID("worker-3"))
+}
@@ -25,6 +25,18 @@ func (c *GeminiClient) Type() client.ClientType {
 	return client.ClientGemini
 }
 
+// Capabilities returns the features supported by the Gemini CLI.
+// MCP servers are merged into .gemini/settings.json in the work directory.
+func (c *GeminiClient) Capabilities() client.Capabilities {
+	return client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: false,
+		SupportsToolFiltering:    false,
+		MCPConfigMethod:          client.MCPConfigFile,
+		ReadyGracePeriod:         client.MCPStartupGracePeriod,
+	}
+}
+
 // Spawn creates and starts a headless Gemini process.
 // If cfg.SessionID is set, resumes an existing session.
 // If cfg.SessionID is empty, creates a new session.
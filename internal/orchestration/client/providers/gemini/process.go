@@ -22,7 +22,8 @@ type Process struct {
 var ErrTimeout = fmt.Errorf("gemini process timed out")
 
 // ErrNoAuth is returned when no valid authentication is found.
-var ErrNoAuth = fmt.Errorf("gemini: no authentication found - set GEMINI_API_KEY, GOOGLE_API_KEY, or run 'gemini auth' for OAuth")
+// It wraps client.ErrProviderAuth so callers can tell it apart from other spawn failures.
+var ErrNoAuth = fmt.Errorf("gemini: no authentication found - set GEMINI_API_KEY, GOOGLE_API_KEY, or run 'gemini auth' for OAuth: %w", client.ErrProviderAuth)
 
 // ErrNotFound is returned when the gemini executable cannot be found.
 var ErrNotFound = fmt.Errorf("gemini: executable not found - install with 'npm install -g @anthropic-ai/claude-code-gemini' or ensure 'gemini' is in PATH")
@@ -178,8 +179,8 @@ func spawnProcess(ctx context.Context, cfg Config, _ bool) (*Process, error) {
 	args := buildArgs(cfg)
 	parser := NewParser()
 
-	// Build environment variables (BEADS_DIR if set)
-	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir})
+	// Build environment variables (BEADS_DIR and git author if set)
+	env := client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir, CommitAuthor: cfg.CommitAuthor})
 
 	// SpawnBuilder handles spawn mechanics only - all pre-spawn validation
 	// has already completed above
@@ -188,6 +189,7 @@ func spawnProcess(ctx context.Context, cfg Config, _ bool) (*Process, error) {
 		WithWorkDir(cfg.WorkDir).
 		WithSessionRef(cfg.SessionID).
 		WithTimeout(cfg.Timeout).
+		WithStreamBufferSize(cfg.StreamBufferSize).
 		WithParser(parser).
 		WithSessionExtractor(parser.ExtractSessionRef).
 		WithStderrCapture(true).
@@ -103,6 +103,7 @@ func TestValidateAuth_NoAuth(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, ErrNoAuth, err)
 	require.Contains(t, err.Error(), "no authentication found")
+	require.ErrorIs(t, err, client.ErrProviderAuth, "spawn failures from missing credentials should classify as auth errors")
 }
 
 // =============================================================================
@@ -25,6 +25,18 @@ func (c *OpenCodeClient) Type() client.ClientType {
 	return client.ClientOpenCode
 }
 
+// Capabilities returns the features supported by the OpenCode CLI.
+// MCP servers are passed via OPENCODE_CONFIG_CONTENT for per-process isolation.
+func (c *OpenCodeClient) Capabilities() client.Capabilities {
+	return client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: false,
+		SupportsToolFiltering:    false,
+		MCPConfigMethod:          client.MCPConfigEnv,
+		ReadyGracePeriod:         client.MCPStartupGracePeriod,
+	}
+}
+
 // Spawn creates and starts a headless OpenCode process.
 // If cfg.SessionID is set, resumes an existing session.
 // If cfg.SessionID is empty, creates a new session.
@@ -8,14 +8,16 @@ import (
 
 // Config holds configuration for spawning an OpenCode process.
 type Config struct {
-	WorkDir         string
-	BeadsDir        string // Path to beads database directory for BEADS_DIR env var
-	Prompt          string // Includes prefixed system prompt
-	Model           string // e.g., "anthropic/claude-opus-4-5"
-	SessionID       string // For --session to continue existing session
-	SkipPermissions bool   // Future: if OpenCode supports --yolo equivalent
-	Timeout         time.Duration
-	MCPConfig       string // JSON for opencode.jsonc
+	WorkDir          string
+	BeadsDir         string // Path to beads database directory for BEADS_DIR env var
+	CommitAuthor     string // Git author for commits, in "Name <email>" format
+	Prompt           string // Includes prefixed system prompt
+	Model            string // e.g., "anthropic/claude-opus-4-5"
+	SessionID        string // For --session to continue existing session
+	SkipPermissions  bool   // Future: if OpenCode supports --yolo equivalent
+	Timeout          time.Duration
+	StreamBufferSize int    // Max stream-json line size (0 = client.DefaultStreamBufferSize)
+	MCPConfig        string // JSON for opencode.jsonc
 }
 
 // configFromClient converts a client.Config to an opencode.Config.
@@ -27,13 +29,15 @@ func configFromClient(cfg client.Config) Config {
 	}
 
 	return Config{
-		WorkDir:         cfg.WorkDir,
-		BeadsDir:        cfg.BeadsDir,
-		Prompt:          prompt,
-		Model:           cfg.OpenCodeModel(),
-		SessionID:       cfg.SessionID,
-		SkipPermissions: cfg.SkipPermissions,
-		Timeout:         cfg.Timeout,
-		MCPConfig:       cfg.MCPConfig,
+		WorkDir:          cfg.WorkDir,
+		BeadsDir:         cfg.BeadsDir,
+		CommitAuthor:     cfg.CommitAuthor,
+		Prompt:           prompt,
+		Model:            cfg.OpenCodeModel(),
+		SessionID:        cfg.SessionID,
+		SkipPermissions:  cfg.SkipPermissions,
+		Timeout:          cfg.Timeout,
+		StreamBufferSize: cfg.StreamBufferSize,
+		MCPConfig:        cfg.MCPConfig,
 	}
 }
@@ -55,14 +55,15 @@ func spawnProcess(ctx context.Context, cfg Config, isResume bool) (*Process, err
 	if cfg.MCPConfig != "" {
 		env = append(env, "OPENCODE_CONFIG_CONTENT="+cfg.MCPConfig)
 	}
-	// Append common environment variables (BEADS_DIR if set)
-	env = append(env, client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir})...)
+	// Append common environment variables (BEADS_DIR and git author if set)
+	env = append(env, client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir, CommitAuthor: cfg.CommitAuthor})...)
 
 	base, err := client.NewSpawnBuilder(ctx).
 		WithExecutable(execPath, args).
 		WithWorkDir(cfg.WorkDir).
 		WithSessionRef(cfg.SessionID).
 		WithTimeout(cfg.Timeout).
+		WithStreamBufferSize(cfg.StreamBufferSize).
 		WithParser(NewParser()).
 		WithEnv(env).
 		WithProviderName("opencode").
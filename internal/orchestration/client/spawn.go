@@ -34,6 +34,9 @@ type SpawnBuilder struct {
 	onInitEventFn    OnInitEventFunc
 	sessionExtractor SessionExtractorFunc
 	commandFactory   CommandFactoryFunc
+	streamBufferSize int
+	transcriptDir    string
+	transcriptID     string
 }
 
 // NewSpawnBuilder creates a new SpawnBuilder with the given context.
@@ -118,6 +121,21 @@ func (b *SpawnBuilder) WithSessionExtractor(fn SessionExtractorFunc) *SpawnBuild
 	return b
 }
 
+// WithStreamBufferSize sets the maximum size of a single stdout line.
+// Zero uses DefaultStreamBufferSize.
+func (b *SpawnBuilder) WithStreamBufferSize(size int) *SpawnBuilder {
+	b.streamBufferSize = size
+	return b
+}
+
+// WithTranscript enables full transcript capture of the raw stdout stream to
+// {dir}/{processID}-{sessionRef}.jsonl. An empty dir disables capture.
+func (b *SpawnBuilder) WithTranscript(dir, processID string) *SpawnBuilder {
+	b.transcriptDir = dir
+	b.transcriptID = processID
+	return b
+}
+
 // WithCommandFactory sets a custom command factory for testing.
 // This allows unit tests to mock exec.Command without spawning real processes.
 func (b *SpawnBuilder) WithCommandFactory(fn CommandFactoryFunc) *SpawnBuilder {
@@ -218,6 +236,10 @@ func (b *SpawnBuilder) Build() (*BaseProcess, error) {
 		WithEventParser(b.parser),
 		WithStderrCapture(b.captureStderr),
 		WithProviderName(b.providerName),
+		WithStreamBufferSize(b.streamBufferSize),
+	}
+	if b.transcriptDir != "" {
+		opts = append(opts, WithTranscript(b.transcriptDir, b.transcriptID))
 	}
 	if b.onInitEventFn != nil {
 		opts = append(opts, WithOnInitEvent(b.onInitEventFn))
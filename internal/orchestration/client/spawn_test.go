@@ -413,6 +413,28 @@ func TestSpawnBuilder_WithStderrCapture_EnablesCapture(t *testing.T) {
 	bp.Wait()
 }
 
+// TestSpawnBuilder_WithStreamBufferSize_SetsLimit verifies that
+// WithStreamBufferSize is passed through to the BaseProcess.
+func TestSpawnBuilder_WithStreamBufferSize_SetsLimit(t *testing.T) {
+	ctx := context.Background()
+	exe, args := echoCommand()
+
+	bp, err := NewSpawnBuilder(ctx).
+		WithExecutable(exe, args).
+		WithParser(newMockParser()).
+		WithStreamBufferSize(256 * 1024).
+		WithProviderName("test").
+		Build()
+
+	require.NoError(t, err)
+	require.NotNil(t, bp)
+	require.Equal(t, 256*1024, bp.StreamBufferSize())
+
+	// Clean up
+	bp.Cancel()
+	bp.Wait()
+}
+
 // TestSpawnBuilder_WithOnInitEvent_SetsCallback verifies that
 // WithOnInitEvent sets the init event callback on the BaseProcess.
 func TestSpawnBuilder_WithOnInitEvent_SetsCallback(t *testing.T) {
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// transcriptBufferSize is the write buffer size for transcript files.
+const transcriptBufferSize = 64 * 1024
+
+// unknownTranscriptSession names transcripts of processes that exit before
+// reporting a session reference.
+const unknownTranscriptSession = "unknown"
+
+// transcriptWriter tees raw stream-json lines to {dir}/{processID}-{sessionRef}.jsonl.
+// The file is opened lazily because the session reference is usually only known once
+// the init event arrives; lines read before that are held in memory until then.
+// A transcriptWriter is not safe for concurrent use; only parseOutput writes to it.
+type transcriptWriter struct {
+	dir       string
+	processID string
+	file      *os.File
+	w         *bufio.Writer
+	pending   [][]byte
+	stopped   bool // set once capture ends, after close or a write error
+}
+
+// newTranscriptWriter creates a transcript writer for the given directory and process ID.
+func newTranscriptWriter(dir, processID string) *transcriptWriter {
+	return &transcriptWriter{dir: dir, processID: processID}
+}
+
+// transcriptPath returns the transcript file path for a process and session.
+func transcriptPath(dir, processID, sessionRef string) string {
+	name := fmt.Sprintf("%s-%s.jsonl", processID, sessionRef)
+	return filepath.Join(dir, filepath.Base(name))
+}
+
+// write appends a line to the transcript. sessionRef is the current session reference;
+// while it is empty the line is buffered in memory.
+func (t *transcriptWriter) write(line []byte, sessionRef string) error {
+	if t.stopped {
+		return nil
+	}
+	if t.file == nil {
+		if sessionRef == "" {
+			t.pending = append(t.pending, append([]byte(nil), line...))
+			return nil
+		}
+		if err := t.open(sessionRef); err != nil {
+			return err
+		}
+	}
+	return t.writeLine(line)
+}
+
+// open creates the transcript file and flushes lines buffered before the session was known.
+func (t *transcriptWriter) open(sessionRef string) error {
+	if err := os.MkdirAll(t.dir, 0750); err != nil {
+		t.fail()
+		return fmt.Errorf("creating transcript dir: %w", err)
+	}
+	path := transcriptPath(t.dir, t.processID, sessionRef)
+	// Append so a resumed session continues its existing transcript.
+	// #nosec G304 -- path is built from the configured transcript dir
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.fail()
+		return fmt.Errorf("opening transcript: %w", err)
+	}
+	t.file = f
+	t.w = bufio.NewWriterSize(f, transcriptBufferSize)
+
+	pending := t.pending
+	t.pending = nil
+	for _, line := range pending {
+		if err := t.writeLine(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeLine writes one newline-terminated line to the buffered writer.
+func (t *transcriptWriter) writeLine(line []byte) error {
+	if _, err := t.w.Write(line); err != nil {
+		t.fail()
+		return fmt.Errorf("writing transcript: %w", err)
+	}
+	if err := t.w.WriteByte('\n'); err != nil {
+		t.fail()
+		return fmt.Errorf("writing transcript: %w", err)
+	}
+	return nil
+}
+
+// close flushes and closes the transcript file. If the file was never opened,
+// any buffered lines are written under sessionRef, or "unknown" if it is empty.
+func (t *transcriptWriter) close(sessionRef string) error {
+	if t.stopped {
+		return nil
+	}
+	if t.file == nil {
+		if len(t.pending) == 0 {
+			return nil
+		}
+		if sessionRef == "" {
+			sessionRef = unknownTranscriptSession
+		}
+		if err := t.open(sessionRef); err != nil {
+			return err
+		}
+	}
+	flushErr := t.w.Flush()
+	closeErr := t.file.Close()
+	t.file = nil
+	t.w = nil
+	t.stopped = true
+	if flushErr != nil {
+		return fmt.Errorf("flushing transcript: %w", flushErr)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("closing transcript: %w", closeErr)
+	}
+	return nil
+}
+
+// fail stops transcript capture after an error. The session itself keeps running.
+func (t *transcriptWriter) fail() {
+	t.stopped = true
+	t.pending = nil
+	if t.file != nil {
+		_ = t.file.Close()
+		t.file = nil
+		t.w = nil
+	}
+}
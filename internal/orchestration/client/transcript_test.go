@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readTranscript(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestTranscriptWriter_BuffersLinesUntilSessionKnown(t *testing.T) {
+	dir := t.TempDir()
+	tw := newTranscriptWriter(dir, "worker-1")
+
+	require.NoError(t, tw.write([]byte(`{"type":"system","subtype":"init"}`), ""))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries, "file should not be created before the session is known")
+
+	require.NoError(t, tw.write([]byte(`{"type":"assistant"}`), "sess-1"))
+	require.NoError(t, tw.close("sess-1"))
+
+	require.Equal(t, "{\"type\":\"system\",\"subtype\":\"init\"}\n{\"type\":\"assistant\"}\n",
+		readTranscript(t, filepath.Join(dir, "worker-1-sess-1.jsonl")))
+}
+
+func TestTranscriptWriter_CloseWithoutSessionUsesUnknown(t *testing.T) {
+	dir := t.TempDir()
+	tw := newTranscriptWriter(dir, "worker-1")
+
+	require.NoError(t, tw.write([]byte(`{"type":"error"}`), ""))
+	require.NoError(t, tw.close(""))
+
+	require.Equal(t, "{\"type\":\"error\"}\n",
+		readTranscript(t, filepath.Join(dir, "worker-1-unknown.jsonl")))
+}
+
+func TestTranscriptWriter_CloseWithoutLinesCreatesNoFile(t *testing.T) {
+	dir := t.TempDir()
+	tw := newTranscriptWriter(dir, "worker-1")
+
+	require.NoError(t, tw.close("sess-1"))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestTranscriptWriter_ResumedSessionAppends(t *testing.T) {
+	dir := t.TempDir()
+
+	first := newTranscriptWriter(dir, "worker-1")
+	require.NoError(t, first.write([]byte(`{"turn":1}`), "sess-1"))
+	require.NoError(t, first.close("sess-1"))
+
+	second := newTranscriptWriter(dir, "worker-1")
+	require.NoError(t, second.write([]byte(`{"turn":2}`), "sess-1"))
+	require.NoError(t, second.close("sess-1"))
+
+	require.Equal(t, "{\"turn\":1}\n{\"turn\":2}\n",
+		readTranscript(t, filepath.Join(dir, "worker-1-sess-1.jsonl")))
+}
+
+func TestTranscriptWriter_CreatesDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested", "transcripts")
+	tw := newTranscriptWriter(dir, "worker-1")
+
+	require.NoError(t, tw.write([]byte(`{}`), "sess-1"))
+	require.NoError(t, tw.close("sess-1"))
+
+	require.FileExists(t, filepath.Join(dir, "worker-1-sess-1.jsonl"))
+}
+
+func TestTranscriptWriter_DropsLinesAfterClose(t *testing.T) {
+	dir := t.TempDir()
+	tw := newTranscriptWriter(dir, "worker-1")
+
+	require.NoError(t, tw.write([]byte(`{"n":1}`), "sess-1"))
+	require.NoError(t, tw.close("sess-1"))
+	require.NoError(t, tw.write([]byte(`{"n":2}`), "sess-1"))
+
+	require.Equal(t, "{\"n\":1}\n", readTranscript(t, filepath.Join(dir, "worker-1-sess-1.jsonl")))
+}
+
+func TestBaseProcess_parseOutput_WritesTranscript(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	lines := `{"type":"system","subtype":"init","session_id":"abc123"}
+{"type":"assistant","message":"hello"}
+not json
+{"type":"result"}`
+	stdout := newMockReadCloser(lines + "\n")
+	stderr := newMockReadCloser("")
+
+	parseFunc := func(line []byte) (OutputEvent, error) {
+		return OutputEvent{Type: EventSystem, SubType: "init"}, nil
+	}
+	extractFunc := func(event OutputEvent, rawLine []byte) string {
+		return "abc123"
+	}
+
+	cmd := exec.Command("echo", "test")
+	bp := NewBaseProcess(ctx, cancel, cmd, stdout, stderr, "/tmp",
+		WithProviderName("test"),
+		WithParseEventFunc(parseFunc),
+		WithSessionExtractor(extractFunc),
+		WithTranscript(dir, "worker-7"))
+
+	bp.wg.Add(1)
+	go bp.parseOutput()
+
+	for range bp.Events() {
+	}
+	bp.wg.Wait()
+
+	// Every raw line is captured, including ones the parser can't use
+	require.Equal(t, lines+"\n", readTranscript(t, filepath.Join(dir, "worker-7-abc123.jsonl")))
+}
+
+func TestBaseProcess_WithTranscript_EmptyDirDisables(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bp := NewBaseProcess(ctx, cancel, nil, nil, nil, "/tmp",
+		WithTranscript("", "worker-1"))
+
+	require.Nil(t, bp.transcript)
+}
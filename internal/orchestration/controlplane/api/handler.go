@@ -17,6 +17,7 @@ import (
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/session"
+	"github.com/zjrosen/perles/internal/orchestration/validation"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
 )
 
@@ -64,6 +65,7 @@ func (h *Handler) Routes() http.Handler {
 	mux.HandleFunc("POST /workflows", h.Create)
 	mux.HandleFunc("GET /workflows", h.List)
 	mux.HandleFunc("GET /workflows/{id}", h.Get)
+	mux.HandleFunc("GET /workflows/{id}/config", h.GetConfig)
 	mux.HandleFunc("POST /workflows/{id}/start", h.Start)
 	mux.HandleFunc("POST /workflows/{id}/pause", h.Pause)
 	mux.HandleFunc("POST /workflows/{id}/resume", h.Resume)
@@ -97,6 +99,16 @@ type CreateWorkflowRequest struct {
 	WorktreeBaseBranch string `json:"worktree_base_branch,omitempty"`
 	// BranchName is an optional custom branch name for the worktree.
 	BranchName string `json:"branch_name,omitempty"`
+	// Priority ranks the workflow for shared worker capacity (optional, higher first).
+	Priority int `json:"priority,omitempty"`
+	// CommitAuthor attributes worker commits, in "Name <email>" format (optional).
+	CommitAuthor string `json:"commit_author,omitempty"`
+	// BeadsPrefix namespaces the workflow's task IDs in the tracker (optional, e.g. "feat1").
+	BeadsPrefix string `json:"beads_prefix,omitempty"`
+	// MaxDuration fails the workflow once it has run this long, e.g. "4h" (optional, unlimited if empty).
+	MaxDuration string `json:"max_duration,omitempty"`
+	// WorkflowMaxWorkers caps the workers this workflow may hold within the shared pool (optional, 0 = no cap).
+	WorkflowMaxWorkers int `json:"workflow_max_workers,omitempty"`
 }
 
 // CreateWorkflowResponse is the response body for creating a workflow.
@@ -117,8 +129,9 @@ type WorkflowResponse struct {
 	EndedAt       *time.Time        `json:"ended_at,omitempty"`
 	Port          int               `json:"port,omitempty"`
 	// Worktree fields
-	WorktreeEnabled bool   `json:"worktree_enabled,omitempty"`
-	WorktreePath    string `json:"worktree_path,omitempty"`
+	WorktreeEnabled  bool   `json:"worktree_enabled,omitempty"`
+	WorktreePath     string `json:"worktree_path,omitempty"`
+	WorktreeStashRef string `json:"worktree_stash_ref,omitempty"`
 	// Health fields
 	IsHealthy       bool       `json:"is_healthy"`
 	LastHeartbeatAt *time.Time `json:"last_heartbeat_at,omitempty"`
@@ -126,6 +139,63 @@ type WorkflowResponse struct {
 	RecoveryCount   int        `json:"recovery_count,omitempty"`
 }
 
+// ProviderResponse is the provider and model an agent role runs on.
+type ProviderResponse struct {
+	Provider string `json:"provider"`
+	Model    string `json:"model,omitempty"`
+}
+
+// WorkflowConfigResponse is the response body for a workflow's effective configuration.
+type WorkflowConfigResponse struct {
+	ID    string `json:"id"`
+	State string `json:"state"`
+	// Spec fields, with defaults applied
+	TemplateID          string            `json:"template_id"`
+	Name                string            `json:"name"`
+	WorkDir             string            `json:"work_dir,omitempty"`
+	EpicID              string            `json:"epic_id,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	Priority            int               `json:"priority"`
+	CommitAuthor        string            `json:"commit_author,omitempty"`
+	BeadsPrefix         string            `json:"beads_prefix,omitempty"`
+	RequireReview       bool              `json:"require_review"`
+	WorktreeMode        string            `json:"worktree_mode"`
+	WorktreeBaseBranch  string            `json:"worktree_base_branch,omitempty"`
+	WorktreeBranchName  string            `json:"worktree_branch_name,omitempty"`
+	DirtyWorktreePolicy string            `json:"dirty_worktree_policy,omitempty"`
+	MaxDuration         string            `json:"max_duration,omitempty"`
+	WorkflowMaxWorkers  int               `json:"workflow_max_workers,omitempty"`
+	// Runtime settings
+	Coordinator               ProviderResponse  `json:"coordinator"`
+	Worker                    ProviderResponse  `json:"worker"`
+	WorkerAlternate           *ProviderResponse `json:"worker_alternate,omitempty"`
+	WorkerProviders           map[string]string `json:"worker_providers,omitempty"`
+	MaxWorkers                int               `json:"max_workers"`
+	MinReadyWorkers           int               `json:"min_ready_workers"`
+	MaxConcurrentReviews      int               `json:"max_concurrent_reviews"`
+	ConfirmDestructiveActions bool              `json:"confirm_destructive_actions"`
+	SensitivePaths            []string          `json:"sensitive_paths,omitempty"`
+	WorkerToolReminder        bool              `json:"worker_tool_reminder"`
+	SyncBeadsStatus           bool              `json:"sync_beads_status"`
+	WorkerKeepaliveInterval   string            `json:"worker_keepalive_interval,omitempty"`
+	WorkerKeepaliveMax        int               `json:"worker_keepalive_max,omitempty"`
+	ReconcileInterval         string            `json:"reconcile_interval"`
+	WorkerReadyTimeout        string            `json:"worker_ready_timeout"`
+	RespawnUnreadyWorkers     bool              `json:"respawn_unready_workers"`
+	ReconcilePolicy           string            `json:"reconcile_policy"`
+	StreamBufferSize          int               `json:"stream_buffer_size"`
+	HandoffThreshold          int               `json:"handoff_threshold,omitempty"`
+	RequirePassingTests       bool              `json:"require_passing_tests"`
+	TaskPromptMaxBytes        int               `json:"task_prompt_max_bytes,omitempty"`
+	TaskPromptStrategy        string            `json:"task_prompt_strategy,omitempty"`
+	Diagnostics               bool              `json:"diagnostics"`
+	RunDir                    string            `json:"run_dir,omitempty"`
+	WorktreePath              string            `json:"worktree_path,omitempty"`
+	WorktreeBranch            string            `json:"worktree_branch,omitempty"`
+	Port                      int               `json:"port,omitempty"`
+	SessionDir                string            `json:"session_dir,omitempty"`
+}
+
 // ListWorkflowsResponse is the response body for listing workflows.
 type ListWorkflowsResponse struct {
 	Workflows []WorkflowResponse `json:"workflows"`
@@ -182,6 +252,23 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		h.writeError(w, http.StatusBadRequest, "validation_error", "template_id is required", "")
 		return
 	}
+	if req.BeadsPrefix != "" && !validation.IsValidTaskIDPrefix(req.BeadsPrefix) {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "invalid beads_prefix", req.BeadsPrefix)
+		return
+	}
+	var maxDuration time.Duration
+	if req.MaxDuration != "" {
+		d, err := time.ParseDuration(req.MaxDuration)
+		if err != nil || d < 0 {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "invalid max_duration", req.MaxDuration)
+			return
+		}
+		maxDuration = d
+	}
+	if req.WorkflowMaxWorkers < 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "workflow_max_workers must not be negative", "")
+		return
+	}
 
 	// Validate required template arguments if registry service is available
 	if h.registryService != nil {
@@ -196,9 +283,13 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	// Check if this is an epic-driven workflow (uses existing epic from tracker)
 	isEpicDriven := false
+	skipReview := false
+	var workerProviders map[string]string
 	if h.registryService != nil {
 		if reg, err := h.registryService.GetByKey("workflow", req.TemplateID); err == nil {
 			isEpicDriven = reg.IsEpicDriven()
+			skipReview = !reg.RequireReview()
+			workerProviders = reg.WorkerProviders()
 		}
 	}
 
@@ -213,7 +304,7 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 			feature = req.TemplateID
 		}
 
-		result, err := h.workflowCreator.CreateWithArgs(feature, req.TemplateID, req.Args)
+		result, err := h.workflowCreator.CreateWithPrefix(feature, req.TemplateID, req.BeadsPrefix, req.Args)
 		if err != nil {
 			h.writeError(w, http.StatusInternalServerError, "epic_creation_failed", "Failed to create epic", err.Error())
 			return
@@ -226,14 +317,21 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	initialPrompt = h.buildCoordinatorPrompt(req.TemplateID, epicID, req.Args)
 
 	spec := controlplane.WorkflowSpec{
-		TemplateID:         req.TemplateID,
-		Name:               req.Name,
-		InitialPrompt:      initialPrompt,
-		Labels:             req.Labels,
-		WorktreeEnabled:    req.WorktreeEnabled,
-		WorktreeBaseBranch: req.WorktreeBaseBranch,
-		WorktreeBranchName: req.BranchName,
-		EpicID:             epicID,
+		TemplateID:          req.TemplateID,
+		Name:                req.Name,
+		InitialPrompt:       initialPrompt,
+		Labels:              req.Labels,
+		WorktreeEnabled:     req.WorktreeEnabled,
+		WorktreeBaseBranch:  req.WorktreeBaseBranch,
+		WorktreeBranchName:  req.BranchName,
+		EpicID:              epicID,
+		Priority:            req.Priority,
+		CommitAuthor:        req.CommitAuthor,
+		SkipReview:          skipReview,
+		WorkerProviders:     controlplane.ParseWorkerProviders(workerProviders),
+		BeadsPrefix:         req.BeadsPrefix,
+		MaxWorkflowDuration: maxDuration,
+		MaxWorkers:          req.WorkflowMaxWorkers,
 	}
 
 	id, err := h.cp.Create(r.Context(), spec)
@@ -396,6 +494,24 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, h.workflowToResponse(wf))
 }
 
+// GetConfig returns the fully-resolved configuration a workflow runs with.
+// GET /workflows/{id}/config
+func (h *Handler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	cfg, err := h.cp.WorkflowConfig(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, controlplane.ErrWorkflowNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Workflow not found", "")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "get_config_failed", "Failed to get workflow config", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, configToResponse(cfg))
+}
+
 // Start transitions a workflow from Pending to Running.
 // POST /workflows/{id}/start
 func (h *Handler) Start(w http.ResponseWriter, r *http.Request) {
@@ -595,16 +711,17 @@ func (h *Handler) streamEvents(w http.ResponseWriter, r *http.Request, events <-
 
 func (h *Handler) workflowToResponse(wf *controlplane.WorkflowInstance) WorkflowResponse {
 	resp := WorkflowResponse{
-		ID:              string(wf.ID),
-		TemplateID:      wf.TemplateID,
-		Name:            wf.Name,
-		State:           string(wf.State),
-		InitialPrompt:   wf.InitialPrompt,
-		Labels:          wf.Labels,
-		CreatedAt:       wf.CreatedAt,
-		Port:            wf.MCPPort,
-		WorktreeEnabled: wf.WorktreeEnabled,
-		WorktreePath:    wf.WorktreePath,
+		ID:               string(wf.ID),
+		TemplateID:       wf.TemplateID,
+		Name:             wf.Name,
+		State:            string(wf.State),
+		InitialPrompt:    wf.InitialPrompt,
+		Labels:           wf.Labels,
+		CreatedAt:        wf.CreatedAt,
+		Port:             wf.MCPPort,
+		WorktreeEnabled:  wf.WorktreeEnabled,
+		WorktreePath:     wf.WorktreePath,
+		WorktreeStashRef: wf.WorktreeStashRef,
 	}
 
 	if wf.StartedAt != nil {
@@ -629,6 +746,81 @@ func (h *Handler) workflowToResponse(wf *controlplane.WorkflowInstance) Workflow
 	return resp
 }
 
+func configToResponse(cfg *controlplane.EffectiveConfig) WorkflowConfigResponse {
+	spec, rt := cfg.Spec, cfg.Runtime
+	worktreeMode := string(spec.WorktreeMode)
+	if worktreeMode == "" {
+		worktreeMode = "none"
+	}
+	resp := WorkflowConfigResponse{
+		ID:                        string(cfg.WorkflowID),
+		State:                     string(cfg.State),
+		TemplateID:                spec.TemplateID,
+		Name:                      spec.Name,
+		WorkDir:                   spec.WorkDir,
+		EpicID:                    spec.EpicID,
+		Labels:                    spec.Labels,
+		Priority:                  spec.Priority,
+		CommitAuthor:              spec.CommitAuthor,
+		BeadsPrefix:               spec.BeadsPrefix,
+		RequireReview:             rt.RequireReview,
+		WorktreeMode:              worktreeMode,
+		WorktreeBaseBranch:        spec.WorktreeBaseBranch,
+		WorktreeBranchName:        spec.WorktreeBranchName,
+		DirtyWorktreePolicy:       string(spec.DirtyWorktreePolicy),
+		WorkflowMaxWorkers:        spec.MaxWorkers,
+		Coordinator:               providerToResponse(rt.Coordinator),
+		Worker:                    providerToResponse(rt.Worker),
+		MaxWorkers:                rt.MaxWorkers,
+		MinReadyWorkers:           rt.MinReadyWorkers,
+		MaxConcurrentReviews:      rt.MaxConcurrentReviews,
+		ConfirmDestructiveActions: rt.ConfirmDestructiveActions,
+		SensitivePaths:            rt.SensitivePaths,
+		WorkerToolReminder:        rt.WorkerToolReminder,
+		SyncBeadsStatus:           rt.SyncBeadsStatus,
+		ReconcileInterval:         rt.ReconcileInterval.String(),
+		WorkerReadyTimeout:        rt.WorkerReadyTimeout.String(),
+		RespawnUnreadyWorkers:     rt.RespawnUnreadyWorkers,
+		ReconcilePolicy:           rt.ReconcilePolicy,
+		StreamBufferSize:          rt.StreamBufferSize,
+		HandoffThreshold:          rt.HandoffThreshold,
+		RequirePassingTests:       rt.RequirePassingTests,
+		TaskPromptMaxBytes:        rt.TaskPromptLimit.MaxBytes,
+		TaskPromptStrategy:        string(rt.TaskPromptLimit.Strategy),
+		Diagnostics:               rt.Diagnostics,
+		RunDir:                    rt.WorkDir,
+		WorktreePath:              rt.WorktreePath,
+		WorktreeBranch:            rt.WorktreeBranch,
+		Port:                      rt.MCPPort,
+		SessionDir:                rt.SessionDir,
+	}
+	if spec.MaxWorkflowDuration > 0 {
+		resp.MaxDuration = spec.MaxWorkflowDuration.String()
+	}
+	if rt.ReconcileInterval < 0 {
+		resp.ReconcileInterval = "disabled"
+	}
+	if rt.WorkerKeepaliveInterval > 0 {
+		resp.WorkerKeepaliveInterval = rt.WorkerKeepaliveInterval.String()
+		resp.WorkerKeepaliveMax = rt.WorkerKeepaliveMax
+	}
+	if rt.WorkerAlternate != nil {
+		alt := providerToResponse(*rt.WorkerAlternate)
+		resp.WorkerAlternate = &alt
+	}
+	if len(rt.WorkerProviders) > 0 {
+		resp.WorkerProviders = make(map[string]string, len(rt.WorkerProviders))
+		for agentType, provider := range rt.WorkerProviders {
+			resp.WorkerProviders[agentType.String()] = string(provider)
+		}
+	}
+	return resp
+}
+
+func providerToResponse(p controlplane.ProviderSettings) ProviderResponse {
+	return ProviderResponse{Provider: string(p.Provider), Model: p.Model}
+}
+
 func (h *Handler) eventToJSON(event controlplane.ControlPlaneEvent) map[string]any {
 	result := map[string]any{
 		"type":          string(event.Type),
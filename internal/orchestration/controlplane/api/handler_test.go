@@ -7,13 +7,16 @@ import (
 	"net/http/httptest"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
 )
 
@@ -46,6 +49,126 @@ func TestHandler_Create(t *testing.T) {
 	assert.Equal(t, "wf-123", resp.ID)
 }
 
+func TestHandler_Create_PassesPriority(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.TemplateID == "cook" && spec.Priority == 5
+		})).
+		Return(controlplane.WorkflowID("wf-123"), nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "cook", "priority": 5}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_Create_PassesBeadsPrefix(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.TemplateID == "cook" && spec.BeadsPrefix == "feat1"
+		})).
+		Return(controlplane.WorkflowID("wf-123"), nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "cook", "beads_prefix": "feat1"}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_Create_PassesMaxDuration(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.MaxWorkflowDuration == 90*time.Minute
+		})).
+		Return(controlplane.WorkflowID("wf-123"), nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "cook", "max_duration": "1h30m"}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_Create_InvalidMaxDuration(t *testing.T) {
+	h := NewHandler(mocks.NewMockControlPlane(t))
+
+	body := `{"template_id": "cook", "max_duration": "soon"}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Create_PassesWorkflowMaxWorkers(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.MaxWorkers == 3
+		})).
+		Return(controlplane.WorkflowID("wf-123"), nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "cook", "workflow_max_workers": 3}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_Create_NegativeWorkflowMaxWorkers(t *testing.T) {
+	h := NewHandler(mocks.NewMockControlPlane(t))
+
+	body := `{"template_id": "cook", "workflow_max_workers": -1}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Create_InvalidBeadsPrefix(t *testing.T) {
+	h := NewHandler(mocks.NewMockControlPlane(t))
+
+	body := `{"template_id": "cook", "beads_prefix": "feat 1"}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "validation_error", resp.Code)
+}
+
 func TestHandler_Create_InvalidJSON(t *testing.T) {
 	mockCP := mocks.NewMockControlPlane(t)
 
@@ -119,6 +242,73 @@ func TestHandler_Get_NotFound(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestHandler_GetConfig(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		WorkflowConfig(mock.Anything, controlplane.WorkflowID("wf-123")).
+		Return(&controlplane.EffectiveConfig{
+			WorkflowID: "wf-123",
+			State:      controlplane.WorkflowRunning,
+			Spec: controlplane.WorkflowSpec{
+				TemplateID:      "cook",
+				Name:            "cook",
+				EpicID:          "perles-epic1",
+				WorkerProviders: map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientClaude},
+			},
+			Runtime: controlplane.RuntimeSettings{
+				Coordinator:     controlplane.ProviderSettings{Provider: client.ClientClaude, Model: "opus"},
+				Worker:          controlplane.ProviderSettings{Provider: client.ClientCursor},
+				WorkerProviders: map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientClaude},
+				MaxWorkers:      4,
+				RequireReview:   true,
+				WorkDir:         "/repo",
+				MCPPort:         19001,
+			},
+		}, nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	req := httptest.NewRequest(http.MethodGet, "/workflows/wf-123/config", nil)
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp WorkflowConfigResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "wf-123", resp.ID)
+	assert.Equal(t, "running", resp.State)
+	assert.Equal(t, "perles-epic1", resp.EpicID)
+	assert.Equal(t, "none", resp.WorktreeMode)
+	assert.Equal(t, ProviderResponse{Provider: "claude", Model: "opus"}, resp.Coordinator)
+	assert.Equal(t, ProviderResponse{Provider: "cursor"}, resp.Worker)
+	assert.Nil(t, resp.WorkerAlternate)
+	assert.Equal(t, map[string]string{"reviewer": "claude"}, resp.WorkerProviders)
+	assert.Equal(t, 4, resp.MaxWorkers)
+	assert.True(t, resp.RequireReview)
+	assert.Equal(t, "/repo", resp.RunDir)
+	assert.Equal(t, 19001, resp.Port)
+}
+
+func TestHandler_GetConfig_NotFound(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		WorkflowConfig(mock.Anything, controlplane.WorkflowID("unknown")).
+		Return(nil, controlplane.ErrWorkflowNotFound).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	req := httptest.NewRequest(http.MethodGet, "/workflows/unknown/config", nil)
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
 func TestHandler_Start(t *testing.T) {
 	mockCP := mocks.NewMockControlPlane(t)
 	mockCP.EXPECT().
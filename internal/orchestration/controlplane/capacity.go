@@ -0,0 +1,290 @@
+package controlplane
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrAssignmentsPaused is returned by Acquire once the allocator stops handing out worker slots.
+var ErrAssignmentsPaused = errors.New("worker assignments are paused")
+
+// ErrWorkflowWorkerLimit is returned by WorkflowCapacity.Acquire when the workflow already
+// holds its own maximum number of worker slots.
+var ErrWorkflowWorkerLimit = errors.New("workflow is at its max_workers limit, retire an idle worker first")
+
+// CapacityAllocator shares a fixed number of worker slots across all workflows.
+// When slots are scarce, waiting requests are served by workflow priority
+// (higher first), then in arrival order among equal priorities.
+//
+// A capacity of zero or less means unlimited: Acquire never blocks.
+type CapacityAllocator struct {
+	mu       sync.Mutex
+	capacity int
+	held     map[WorkflowID]int
+	inUse    int
+	waiters  waiterQueue
+	seq      uint64
+	paused   bool
+}
+
+// NewCapacityAllocator creates a CapacityAllocator with the given number of worker slots.
+func NewCapacityAllocator(capacity int) *CapacityAllocator {
+	return &CapacityAllocator{
+		capacity: capacity,
+		held:     make(map[WorkflowID]int),
+	}
+}
+
+// Acquire blocks until a worker slot is granted to the workflow or ctx is done.
+// Returns ctx.Err() if the context ends before a slot is granted, or
+// ErrAssignmentsPaused once PauseAssignments has been called.
+func (a *CapacityAllocator) Acquire(ctx context.Context, id WorkflowID, priority int) error {
+	return a.acquire(ctx, id, priority, 0)
+}
+
+// acquire is Acquire with a per-workflow limit on held and pending slots (0 = no limit).
+// Returns ErrWorkflowWorkerLimit without waiting when the workflow is at its limit.
+func (a *CapacityAllocator) acquire(ctx context.Context, id WorkflowID, priority, limit int) error {
+	a.mu.Lock()
+	if a.paused {
+		a.mu.Unlock()
+		return ErrAssignmentsPaused
+	}
+	if limit > 0 && a.held[id]+a.waitingLocked(id) >= limit {
+		a.mu.Unlock()
+		return ErrWorkflowWorkerLimit
+	}
+	if a.capacity <= 0 || (a.inUse < a.capacity && len(a.waiters) == 0) {
+		a.grantLocked(id)
+		a.mu.Unlock()
+		return nil
+	}
+
+	w := &capacityWaiter{
+		workflowID: id,
+		priority:   priority,
+		seq:        a.seq,
+		ready:      make(chan struct{}),
+	}
+	a.seq++
+	heap.Push(&a.waiters, w)
+	a.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return w.err
+	case <-ctx.Done():
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		select {
+		case <-w.ready:
+			// Granted concurrently with cancellation; hand the slot back.
+			if w.err == nil {
+				a.releaseLocked(id, 1)
+			}
+		default:
+			heap.Remove(&a.waiters, w.index)
+		}
+		return ctx.Err()
+	}
+}
+
+// Release returns one worker slot held by the workflow.
+// Releasing a workflow that holds no slots is a no-op.
+func (a *CapacityAllocator) Release(id WorkflowID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.releaseLocked(id, 1)
+}
+
+// ReleaseAll returns every worker slot held by the workflow.
+// Called when a workflow stops so its slots go to waiting workflows.
+func (a *CapacityAllocator) ReleaseAll(id WorkflowID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.releaseLocked(id, a.held[id])
+}
+
+// PauseAssignments stops the allocator from granting worker slots, so no new workers
+// are spawned while the orchestrator shuts down. Blocked Acquire calls and any later
+// ones return ErrAssignmentsPaused. Slots already granted stay held until released.
+func (a *CapacityAllocator) PauseAssignments() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.paused = true
+	for len(a.waiters) > 0 {
+		w := heap.Pop(&a.waiters).(*capacityWaiter)
+		w.err = ErrAssignmentsPaused
+		close(w.ready)
+	}
+}
+
+// Capacity returns the number of worker slots shared across workflows (0 or less = unlimited).
+func (a *CapacityAllocator) Capacity() int {
+	return a.capacity
+}
+
+// InUse returns the number of slots currently granted.
+func (a *CapacityAllocator) InUse() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.inUse
+}
+
+// Free returns the number of slots a new request would be granted without waiting,
+// or -1 if capacity is unlimited. Slots freed while requests are queued go to those
+// requests first, so waiters count against the free slots.
+func (a *CapacityAllocator) Free() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.capacity <= 0 {
+		return -1
+	}
+	return max(a.capacity-a.inUse-len(a.waiters), 0)
+}
+
+// Held returns the number of slots currently granted to the workflow.
+func (a *CapacityAllocator) Held(id WorkflowID) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.held[id]
+}
+
+// Waiting returns the number of requests blocked waiting for a slot.
+func (a *CapacityAllocator) Waiting() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.waiters)
+}
+
+// ForWorkflow returns a WorkflowCapacity bound to a single workflow and priority.
+// maxWorkers caps the slots the workflow may hold at once (0 = only the shared pool applies).
+func (a *CapacityAllocator) ForWorkflow(id WorkflowID, priority, maxWorkers int) *WorkflowCapacity {
+	return &WorkflowCapacity{allocator: a, workflowID: id, priority: priority, maxWorkers: maxWorkers}
+}
+
+// workflowFree returns the slots the workflow could acquire without waiting under both the
+// shared pool and its own limit, or -1 if neither is limited.
+func (a *CapacityAllocator) workflowFree(id WorkflowID, limit int) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	free := -1
+	if a.capacity > 0 {
+		free = max(a.capacity-a.inUse-len(a.waiters), 0)
+	}
+	if limit > 0 {
+		room := max(limit-a.held[id]-a.waitingLocked(id), 0)
+		if free < 0 || room < free {
+			free = room
+		}
+	}
+	return free
+}
+
+// waitingLocked returns the number of the workflow's requests waiting for a slot.
+func (a *CapacityAllocator) waitingLocked(id WorkflowID) int {
+	n := 0
+	for _, w := range a.waiters {
+		if w.workflowID == id {
+			n++
+		}
+	}
+	return n
+}
+
+func (a *CapacityAllocator) grantLocked(id WorkflowID) {
+	a.inUse++
+	a.held[id]++
+}
+
+func (a *CapacityAllocator) releaseLocked(id WorkflowID, n int) {
+	if n > a.held[id] {
+		n = a.held[id]
+	}
+	if n <= 0 {
+		return
+	}
+	a.held[id] -= n
+	if a.held[id] == 0 {
+		delete(a.held, id)
+	}
+	a.inUse -= n
+
+	// Hand freed slots to the highest-priority waiters
+	for len(a.waiters) > 0 && (a.capacity <= 0 || a.inUse < a.capacity) {
+		w := heap.Pop(&a.waiters).(*capacityWaiter)
+		a.grantLocked(w.workflowID)
+		close(w.ready)
+	}
+}
+
+// WorkflowCapacity is a CapacityAllocator handle for one workflow.
+// It satisfies adapter.WorkerCapacity so the v2 adapter can gate spawn_worker.
+type WorkflowCapacity struct {
+	allocator  *CapacityAllocator
+	workflowID WorkflowID
+	priority   int
+	maxWorkers int // 0 = no per-workflow limit
+}
+
+// Acquire blocks until a worker slot is available for this workflow.
+// Returns ErrWorkflowWorkerLimit without waiting if the workflow holds maxWorkers slots.
+func (c *WorkflowCapacity) Acquire(ctx context.Context) error {
+	return c.allocator.acquire(ctx, c.workflowID, c.priority, c.maxWorkers)
+}
+
+// Release returns a worker slot held by this workflow.
+func (c *WorkflowCapacity) Release() {
+	c.allocator.Release(c.workflowID)
+}
+
+// Free returns the number of worker slots this workflow could acquire without waiting,
+// bounded by both the shared pool and the workflow's maxWorkers, or -1 if neither is limited.
+func (c *WorkflowCapacity) Free() int {
+	return c.allocator.workflowFree(c.workflowID, c.maxWorkers)
+}
+
+// capacityWaiter is a blocked Acquire call.
+type capacityWaiter struct {
+	workflowID WorkflowID
+	priority   int
+	seq        uint64
+	ready      chan struct{}
+	err        error // set instead of granting a slot when assignments are paused
+	index      int
+}
+
+// waiterQueue orders waiters by priority (descending), then arrival (ascending).
+type waiterQueue []*capacityWaiter
+
+func (q waiterQueue) Len() int { return len(q) }
+
+func (q waiterQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q waiterQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waiterQueue) Push(x any) {
+	w := x.(*capacityWaiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waiterQueue) Pop() any {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return w
+}
@@ -0,0 +1,287 @@
+package controlplane
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// acquireAsync starts a blocking Acquire and reports the workflow ID on order once granted.
+func acquireAsync(t *testing.T, a *CapacityAllocator, id WorkflowID, priority int, order chan<- WorkflowID) {
+	t.Helper()
+	go func() {
+		if err := a.Acquire(context.Background(), id, priority); err == nil {
+			order <- id
+		}
+	}()
+}
+
+// waitForWaiters blocks until n Acquire calls are queued.
+func waitForWaiters(t *testing.T, a *CapacityAllocator, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return a.Waiting() == n }, time.Second, time.Millisecond)
+}
+
+func TestCapacityAllocator_UnlimitedNeverBlocks(t *testing.T) {
+	a := NewCapacityAllocator(0)
+
+	for range 10 {
+		require.NoError(t, a.Acquire(context.Background(), "wf-1", 0))
+	}
+
+	require.Equal(t, 10, a.InUse())
+	require.Equal(t, 10, a.Held("wf-1"))
+	require.Equal(t, 0, a.Waiting())
+}
+
+func TestCapacityAllocator_AcquireAndRelease(t *testing.T) {
+	a := NewCapacityAllocator(2)
+
+	require.NoError(t, a.Acquire(context.Background(), "wf-1", 0))
+	require.NoError(t, a.Acquire(context.Background(), "wf-2", 0))
+	require.Equal(t, 2, a.InUse())
+
+	a.Release("wf-1")
+	require.Equal(t, 1, a.InUse())
+	require.Equal(t, 0, a.Held("wf-1"))
+
+	// Releasing a workflow with no slots is a no-op
+	a.Release("wf-1")
+	require.Equal(t, 1, a.InUse())
+}
+
+func TestCapacityAllocator_Free(t *testing.T) {
+	require.Equal(t, -1, NewCapacityAllocator(0).Free(), "unlimited capacity")
+
+	a := NewCapacityAllocator(2)
+	require.Equal(t, 2, a.Free())
+
+	require.NoError(t, a.Acquire(context.Background(), "wf-1", 0))
+	require.Equal(t, 1, a.Free())
+	require.NoError(t, a.Acquire(context.Background(), "wf-1", 0))
+	require.Equal(t, 0, a.Free())
+
+	// A queued request does not drive free slots negative
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = a.Acquire(ctx, "wf-2", 0) }()
+	waitForWaiters(t, a, 1)
+	require.Equal(t, 0, a.Free())
+
+	require.Equal(t, 0, a.ForWorkflow("wf-2", 0, 0).Free())
+}
+
+func TestCapacityAllocator_HighPriorityServedFirstUnderContention(t *testing.T) {
+	a := NewCapacityAllocator(1)
+	require.NoError(t, a.Acquire(context.Background(), "wf-busy", 0))
+
+	order := make(chan WorkflowID, 2)
+
+	// Low priority workflow queues first...
+	acquireAsync(t, a, "wf-low", 0, order)
+	waitForWaiters(t, a, 1)
+	// ...but the high priority workflow arriving later wins the next slot
+	acquireAsync(t, a, "wf-high", 10, order)
+	waitForWaiters(t, a, 2)
+
+	a.Release("wf-busy")
+	require.Equal(t, WorkflowID("wf-high"), <-order)
+	require.Equal(t, 1, a.Waiting())
+
+	a.Release("wf-high")
+	require.Equal(t, WorkflowID("wf-low"), <-order)
+	require.Equal(t, 0, a.Waiting())
+}
+
+func TestCapacityAllocator_EqualPriorityIsFIFO(t *testing.T) {
+	a := NewCapacityAllocator(1)
+	require.NoError(t, a.Acquire(context.Background(), "wf-busy", 0))
+
+	order := make(chan WorkflowID, 3)
+	for i, id := range []WorkflowID{"wf-a", "wf-b", "wf-c"} {
+		acquireAsync(t, a, id, 5, order)
+		waitForWaiters(t, a, i+1)
+	}
+
+	held := WorkflowID("wf-busy")
+	for _, want := range []WorkflowID{"wf-a", "wf-b", "wf-c"} {
+		a.Release(held)
+		held = <-order
+		require.Equal(t, want, held)
+	}
+}
+
+func TestCapacityAllocator_NewRequestsDoNotJumpQueue(t *testing.T) {
+	a := NewCapacityAllocator(1)
+	require.NoError(t, a.Acquire(context.Background(), "wf-busy", 0))
+
+	order := make(chan WorkflowID, 1)
+	acquireAsync(t, a, "wf-waiting", 0, order)
+	waitForWaiters(t, a, 1)
+
+	// The slot freed by Release is handed to the waiter, not left open for newcomers
+	a.Release("wf-busy")
+	require.Equal(t, WorkflowID("wf-waiting"), <-order)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, a.Acquire(ctx, "wf-new", 100), context.DeadlineExceeded)
+}
+
+func TestCapacityAllocator_CancelledWaiterIsRemoved(t *testing.T) {
+	a := NewCapacityAllocator(1)
+	require.NoError(t, a.Acquire(context.Background(), "wf-busy", 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- a.Acquire(ctx, "wf-cancelled", 10) }()
+	waitForWaiters(t, a, 1)
+
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	require.Equal(t, 0, a.Waiting())
+
+	// The freed slot is not granted to the cancelled workflow
+	a.Release("wf-busy")
+	require.Equal(t, 0, a.InUse())
+	require.Equal(t, 0, a.Held("wf-cancelled"))
+}
+
+func TestCapacityAllocator_ReleaseAllServesWaiters(t *testing.T) {
+	a := NewCapacityAllocator(2)
+	require.NoError(t, a.Acquire(context.Background(), "wf-1", 0))
+	require.NoError(t, a.Acquire(context.Background(), "wf-1", 0))
+
+	order := make(chan WorkflowID, 2)
+	acquireAsync(t, a, "wf-2", 0, order)
+	acquireAsync(t, a, "wf-3", 0, order)
+	waitForWaiters(t, a, 2)
+
+	a.ReleaseAll("wf-1")
+
+	got := []WorkflowID{<-order, <-order}
+	require.ElementsMatch(t, []WorkflowID{"wf-2", "wf-3"}, got)
+	require.Equal(t, 0, a.Held("wf-1"))
+	require.Equal(t, 2, a.InUse())
+}
+
+func TestCapacityAllocator_PauseAssignmentsRejectsWaitersAndNewRequests(t *testing.T) {
+	a := NewCapacityAllocator(1)
+	require.NoError(t, a.Acquire(context.Background(), "wf-busy", 0))
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- a.Acquire(context.Background(), "wf-waiting", 0) }()
+	waitForWaiters(t, a, 1)
+
+	a.PauseAssignments()
+
+	select {
+	case err := <-errCh:
+		require.ErrorIs(t, err, ErrAssignmentsPaused)
+	case <-time.After(time.Second):
+		t.Fatal("waiter was not released by PauseAssignments")
+	}
+	require.Equal(t, 0, a.Waiting())
+
+	// Held slots are kept; freed slots are not granted to anyone
+	require.Equal(t, 1, a.Held("wf-busy"))
+	a.Release("wf-busy")
+	require.Equal(t, 0, a.InUse())
+	require.ErrorIs(t, a.Acquire(context.Background(), "wf-new", 0), ErrAssignmentsPaused)
+}
+
+func TestCapacityAllocator_ConcurrentUseStaysWithinCapacity(t *testing.T) {
+	const capacity = 3
+	a := NewCapacityAllocator(capacity)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		current int
+		peak    int
+	)
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id := WorkflowID(string(rune('a' + i%5)))
+			assert.NoError(t, a.Acquire(context.Background(), id, i%3))
+			mu.Lock()
+			current++
+			peak = max(peak, current)
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			current--
+			mu.Unlock()
+			a.Release(id)
+		}()
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, peak, capacity)
+	require.Equal(t, 0, a.InUse())
+}
+
+func TestWorkflowCapacity_BindsWorkflowAndPriority(t *testing.T) {
+	a := NewCapacityAllocator(1)
+	require.NoError(t, a.Acquire(context.Background(), "wf-busy", 0))
+
+	low := a.ForWorkflow("wf-low", 0, 0)
+	high := a.ForWorkflow("wf-high", 1, 0)
+
+	order := make(chan WorkflowID, 2)
+	go func() {
+		if low.Acquire(context.Background()) == nil {
+			order <- "wf-low"
+		}
+	}()
+	waitForWaiters(t, a, 1)
+	go func() {
+		if high.Acquire(context.Background()) == nil {
+			order <- "wf-high"
+		}
+	}()
+	waitForWaiters(t, a, 2)
+
+	a.Release("wf-busy")
+	require.Equal(t, WorkflowID("wf-high"), <-order)
+
+	high.Release()
+	require.Equal(t, WorkflowID("wf-low"), <-order)
+	require.Equal(t, 1, a.Held("wf-low"))
+}
+
+func TestWorkflowCapacity_MaxWorkersCapsSlotsAndFree(t *testing.T) {
+	a := NewCapacityAllocator(5)
+	wf := a.ForWorkflow("wf-1", 0, 2)
+	require.Equal(t, 2, wf.Free(), "the workflow limit is below the pool's free slots")
+
+	require.NoError(t, wf.Acquire(context.Background()))
+	require.Equal(t, 1, wf.Free())
+	require.NoError(t, wf.Acquire(context.Background()))
+	require.Equal(t, 0, wf.Free())
+
+	// At its limit the workflow is refused without waiting, and the pool is untouched
+	require.ErrorIs(t, wf.Acquire(context.Background()), ErrWorkflowWorkerLimit)
+	require.Equal(t, 2, a.InUse())
+	require.Equal(t, 3, a.ForWorkflow("wf-2", 0, 0).Free())
+
+	wf.Release()
+	require.Equal(t, 1, wf.Free())
+}
+
+func TestWorkflowCapacity_FreeIsBoundedByPool(t *testing.T) {
+	a := NewCapacityAllocator(2)
+	require.NoError(t, a.Acquire(context.Background(), "wf-other", 0))
+	require.Equal(t, 1, a.ForWorkflow("wf-1", 0, 4).Free())
+
+	// An unlimited pool leaves only the workflow's own limit
+	unlimited := NewCapacityAllocator(0)
+	require.Equal(t, 3, unlimited.ForWorkflow("wf-1", 0, 3).Free())
+	require.Equal(t, -1, unlimited.ForWorkflow("wf-1", 0, 0).Free())
+}
@@ -4,17 +4,26 @@ package controlplane
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
+	"sync"
 	"time"
 
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
 // ErrWorkflowNotFound is returned when a workflow is not found in the registry.
 var ErrWorkflowNotFound = fmt.Errorf("workflow not found")
 
+// ErrGitUnavailable is returned by git-backed checks when no GitExecutor is configured.
+var ErrGitUnavailable = fmt.Errorf("git executor not configured")
+
 // ControlPlane is the main entry point for managing workflows.
 // It coordinates the Registry and Supervisor to provide a unified API
 // for workflow lifecycle management.
@@ -47,6 +56,12 @@ type ControlPlane interface {
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
 	Fail(ctx context.Context, id WorkflowID) error
 
+	// Kill immediately terminates a workflow, distinct from a graceful stop.
+	// All processes are force-killed, task assignments are cleared, and the
+	// workflow is marked failed. Use for runaway workflows.
+	// Returns ErrWorkflowNotFound if the workflow does not exist.
+	Kill(ctx context.Context, id WorkflowID) error
+
 	// Get retrieves a workflow by ID.
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
 	Get(ctx context.Context, id WorkflowID) (*WorkflowInstance, error)
@@ -54,6 +69,26 @@ type ControlPlane interface {
 	// List returns workflows matching the query.
 	List(ctx context.Context, q ListQuery) ([]*WorkflowInstance, error)
 
+	// WorkflowTasks returns the in-flight task assignments of a workflow, sorted by
+	// task ID. Completed and failed tasks are omitted. Workflows without infrastructure (not yet
+	// started) return an empty slice.
+	// Returns ErrWorkflowNotFound if the workflow does not exist.
+	WorkflowTasks(ctx context.Context, id WorkflowID) ([]TaskSummary, error)
+
+	// WorkflowConfig returns the fully-resolved configuration a workflow runs with:
+	// its spec with defaults applied plus runtime settings such as providers and
+	// worker limits, so callers can confirm overrides took effect.
+	// Returns ErrWorkflowNotFound if the workflow does not exist.
+	WorkflowConfig(ctx context.Context, id WorkflowID) (*EffectiveConfig, error)
+
+	// ValidateBranchName checks that name can be used as the branch of a new worktree,
+	// so callers creating workflows without the TUI can pre-check it before Create.
+	// Returns an error wrapping domain.ErrInvalidBranchName if the format is invalid,
+	// domain.ErrBranchAlreadyCheckedOut if a worktree already uses the branch, or
+	// domain.ErrBranchAlreadyExists if the branch exists.
+	// Returns ErrGitUnavailable if the control plane has no GitExecutor.
+	ValidateBranchName(name string) error
+
 	// Registry returns the underlying workflow registry.
 	// This enables direct registry updates for dashboard operations.
 	Registry() Registry
@@ -98,6 +133,23 @@ type ControlPlane interface {
 	// If the context is cancelled or times out, remaining workflows are force-stopped.
 	// Returns an error if any workflow fails to stop cleanly (aggregated).
 	Shutdown(ctx context.Context) error
+
+	// DrainWorkers pauses every running workflow owned by this process, interrupting
+	// its coordinator and workers while keeping state for a cold resume.
+	DrainWorkers(ctx context.Context) error
+
+	// FlushLogs writes the buffered session and message logs of every active
+	// workflow owned by this process to disk.
+	FlushLogs(ctx context.Context) error
+
+	// PersistCoordinatorState saves a snapshot of each active workflow's coordinator
+	// state (worker and task assignments) to its session directory.
+	PersistCoordinatorState(ctx context.Context) error
+
+	// RemoveEphemeralWorktrees removes the worktrees created for stopped workflows
+	// owned by this process. Worktrees with uncommitted changes are kept.
+	// Returns an error if any worktree could not be removed (aggregated).
+	RemoveEphemeralWorktrees(ctx context.Context) error
 }
 
 // ControlPlaneConfig configures the ControlPlane.
@@ -113,6 +165,12 @@ type ControlPlaneConfig struct {
 	// HealthMonitor monitors workflow health (optional).
 	// If provided, it will be stopped during Shutdown.
 	HealthMonitor HealthMonitor
+	// CapacityAllocator is the worker pool shared with the Supervisor (optional).
+	// If provided, a workflow's worker slots are released when it completes or fails.
+	CapacityAllocator *CapacityAllocator
+	// GitExecutor runs git commands in the main repository (optional).
+	// Required by ValidateBranchName.
+	GitExecutor appgit.GitExecutor
 }
 
 // Validate checks that all required fields are provided.
@@ -132,6 +190,12 @@ type defaultControlPlane struct {
 	supervisor    Supervisor
 	eventBus      *CrossWorkflowEventBus
 	healthMonitor HealthMonitor
+	capacity      *CapacityAllocator
+	gitExecutor   appgit.GitExecutor
+
+	// deadlines holds the MaxWorkflowDuration timer of each running workflow.
+	deadlinesMu sync.Mutex
+	deadlines   map[WorkflowID]*time.Timer
 }
 
 // NewControlPlane creates a new ControlPlane with the given configuration.
@@ -150,6 +214,9 @@ func NewControlPlane(cfg ControlPlaneConfig) (ControlPlane, error) {
 		supervisor:    cfg.Supervisor,
 		eventBus:      eventBus,
 		healthMonitor: cfg.HealthMonitor,
+		capacity:      cfg.CapacityAllocator,
+		gitExecutor:   cfg.GitExecutor,
+		deadlines:     make(map[WorkflowID]*time.Timer),
 	}
 
 	// Set up lifecycle callback to handle workflow state transitions
@@ -214,7 +281,7 @@ func (cp *defaultControlPlane) Start(ctx context.Context, id WorkflowID) error {
 	}
 
 	// Persist the running state and resource allocations to registry (for SQLite-backed registries).
-	// AllocateResources modifies: WorkDir, WorktreePath, WorktreeBranch, SessionDir, MCPPort.
+	// AllocateResources modifies: WorkDir, WorktreePath, WorktreeBranch, WorktreeStashRef, SessionDir, MCPPort.
 	// SpawnCoordinator modifies: State, StartedAt, ActiveWorkers.
 	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
 	if err := cp.registry.Update(id, func(w *WorkflowInstance) {
@@ -225,6 +292,7 @@ func (cp *defaultControlPlane) Start(ctx context.Context, id WorkflowID) error {
 		w.WorkDir = inst.WorkDir
 		w.WorktreePath = inst.WorktreePath
 		w.WorktreeBranch = inst.WorktreeBranch
+		w.WorktreeStashRef = inst.WorktreeStashRef
 		w.SessionDir = inst.SessionDir
 		w.MCPPort = inst.MCPPort
 		w.ActiveWorkers = inst.ActiveWorkers
@@ -232,6 +300,8 @@ func (cp *defaultControlPlane) Start(ctx context.Context, id WorkflowID) error {
 		// Log but don't fail - the in-memory state is already updated
 	}
 
+	cp.armDeadline(inst)
+
 	return nil
 }
 
@@ -319,6 +389,9 @@ func (cp *defaultControlPlane) Resume(ctx context.Context, id WorkflowID) error
 		return err
 	}
 
+	// A cold resumed workflow has no deadline timer yet
+	cp.armDeadline(inst)
+
 	// Persist the resumed state to registry (for SQLite-backed registries)
 	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
 	if err := cp.registry.Update(id, func(w *WorkflowInstance) {
@@ -354,6 +427,16 @@ func (cp *defaultControlPlane) Complete(ctx context.Context, id WorkflowID) erro
 		return fmt.Errorf("transitioning to completed: %w", err)
 	}
 	inst.CompletedAt = &now
+	cp.disarmDeadline(id)
+
+	// Changes stashed at start are left in place while the workflow's processes may still
+	// be writing to the worktree; tell the user how to restore them
+	reportWorktreeStash(inst, nil)
+
+	// Finished workflows no longer need their worker slots
+	if cp.capacity != nil {
+		cp.capacity.ReleaseAll(id)
+	}
 
 	// Persist the completed state to registry (for SQLite-backed registries)
 	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
@@ -393,6 +476,62 @@ func (cp *defaultControlPlane) Fail(ctx context.Context, id WorkflowID) error {
 		return fmt.Errorf("transitioning to failed: %w", err)
 	}
 	inst.CompletedAt = &now
+	cp.disarmDeadline(id)
+
+	// Changes stashed at start are left in place while the workflow's processes may still
+	// be writing to the worktree; tell the user how to restore them
+	reportWorktreeStash(inst, nil)
+
+	// Finished workflows no longer need their worker slots
+	if cp.capacity != nil {
+		cp.capacity.ReleaseAll(id)
+	}
+
+	// Persist the failed state to registry (for SQLite-backed registries)
+	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
+	if err := cp.registry.Update(id, func(w *WorkflowInstance) {
+		w.State = inst.State
+		w.CompletedAt = inst.CompletedAt
+		w.TokensUsed = inst.TokensUsed
+		w.ActiveWorkers = inst.ActiveWorkers
+	}); err != nil {
+		// Log but don't fail - the in-memory state is already updated
+	}
+
+	// Emit workflow failed event
+	cp.eventBus.Publish(ControlPlaneEvent{
+		Type:         EventWorkflowFailed,
+		WorkflowID:   inst.ID,
+		WorkflowName: inst.Name,
+		TemplateID:   inst.TemplateID,
+		State:        inst.State,
+		Timestamp:    now,
+	})
+
+	return nil
+}
+
+// Kill immediately terminates a workflow and marks it failed.
+func (cp *defaultControlPlane) Kill(ctx context.Context, id WorkflowID) error {
+	// Get workflow from registry
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return ErrWorkflowNotFound
+	}
+
+	// Stop forwarding events from the workflow before tearing it down
+	cp.eventBus.DetachWorkflow(id)
+
+	// Delegate to supervisor for forced termination (transitions state to Failed)
+	if err := cp.supervisor.Kill(ctx, inst); err != nil {
+		return fmt.Errorf("killing workflow: %w", err)
+	}
+
+	cp.disarmDeadline(id)
+
+	now := time.Now()
+	inst.CompletedAt = &now
+	inst.ActiveWorkers = 0
 
 	// Persist the failed state to registry (for SQLite-backed registries)
 	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
@@ -447,6 +586,7 @@ func (cp *defaultControlPlane) stopWorkflow(ctx context.Context, id WorkflowID,
 	if err := cp.supervisor.Shutdown(ctx, inst, opts); err != nil {
 		return fmt.Errorf("stopping workflow: %w", err)
 	}
+	cp.disarmDeadline(id)
 
 	return nil
 }
@@ -495,6 +635,81 @@ func (cp *defaultControlPlane) List(ctx context.Context, q ListQuery) ([]*Workfl
 	return cp.registry.List(q), nil
 }
 
+// WorkflowTasks returns the in-flight task assignments of a workflow.
+func (cp *defaultControlPlane) WorkflowTasks(ctx context.Context, id WorkflowID) ([]TaskSummary, error) {
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return nil, ErrWorkflowNotFound
+	}
+	summaries := []TaskSummary{}
+	if inst.Infrastructure == nil || inst.Infrastructure.Repositories.TaskRepo == nil {
+		return summaries, nil
+	}
+
+	repos := inst.Infrastructure.Repositories
+	for _, task := range repos.TaskRepo.All() {
+		if task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed {
+			continue
+		}
+		summary := TaskSummary{
+			TaskID:      task.TaskID,
+			Status:      string(task.Status),
+			Implementer: task.Implementer,
+			Reviewer:    task.Reviewer,
+			StartedAt:   task.StartedAt,
+		}
+		if repos.ProcessRepo != nil && task.Implementer != "" {
+			if proc, err := repos.ProcessRepo.Get(task.Implementer); err == nil && proc.Phase != nil {
+				summary.Phase = string(*proc.Phase)
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].TaskID < summaries[j].TaskID
+	})
+	return summaries, nil
+}
+
+// WorkflowConfig returns the fully-resolved configuration a workflow runs with.
+func (cp *defaultControlPlane) WorkflowConfig(ctx context.Context, id WorkflowID) (*EffectiveConfig, error) {
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return nil, ErrWorkflowNotFound
+	}
+	return &EffectiveConfig{
+		WorkflowID: inst.ID,
+		State:      inst.State,
+		Spec:       inst.Spec(),
+		Runtime:    cp.supervisor.RuntimeSettings(inst),
+	}, nil
+}
+
+// ValidateBranchName checks that name is a valid branch name not used by any branch or worktree.
+func (cp *defaultControlPlane) ValidateBranchName(name string) error {
+	if cp.gitExecutor == nil {
+		return ErrGitUnavailable
+	}
+	if err := cp.gitExecutor.ValidateBranchName(name); err != nil {
+		return fmt.Errorf("branch %q: %w", name, err)
+	}
+
+	worktrees, err := cp.gitExecutor.ListWorktrees()
+	if err != nil {
+		return fmt.Errorf("listing worktrees: %w", err)
+	}
+	for _, wt := range worktrees {
+		if wt.Branch == name {
+			return fmt.Errorf("branch %q is checked out at %s: %w", name, wt.Path, domaingit.ErrBranchAlreadyCheckedOut)
+		}
+	}
+
+	if cp.gitExecutor.BranchExists(name) {
+		return fmt.Errorf("branch %q: %w", name, domaingit.ErrBranchAlreadyExists)
+	}
+	return nil
+}
+
 // Registry returns the underlying workflow registry.
 func (cp *defaultControlPlane) Registry() Registry {
 	return cp.registry
@@ -650,3 +865,81 @@ func (cp *defaultControlPlane) Shutdown(ctx context.Context) error {
 
 	return nil
 }
+
+// ownedWorkflows returns the workflows owned by this process in the given states.
+func (cp *defaultControlPlane) ownedWorkflows(states ...WorkflowState) []*WorkflowInstance {
+	currentPID := os.Getpid()
+	return cp.registry.List(ListQuery{
+		States:   states,
+		OwnerPID: &currentPID,
+	})
+}
+
+// DrainWorkers pauses every running workflow owned by this process.
+// Pausing stops each coordinator and worker through the command processor, so the
+// workflows can be cold resumed after a restart.
+func (cp *defaultControlPlane) DrainWorkers(ctx context.Context) error {
+	var errs []error
+	for _, inst := range cp.ownedWorkflows(WorkflowRunning) {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if err := cp.Pause(ctx, inst.ID); err != nil {
+			errs = append(errs, fmt.Errorf("workflow %s: %w", inst.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FlushLogs writes buffered session logs and syncs the Fabric message log of every
+// active workflow owned by this process.
+func (cp *defaultControlPlane) FlushLogs(_ context.Context) error {
+	var errs []error
+	for _, inst := range cp.ownedWorkflows(WorkflowRunning, WorkflowPaused) {
+		if inst.Session != nil {
+			if err := inst.Session.Flush(); err != nil {
+				errs = append(errs, fmt.Errorf("workflow %s session: %w", inst.ID, err))
+			}
+		}
+		if inst.FabricLogger != nil {
+			if err := inst.FabricLogger.Sync(); err != nil {
+				errs = append(errs, fmt.Errorf("workflow %s fabric log: %w", inst.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PersistCoordinatorState writes each active workflow's exported coordinator state
+// to its session directory. Workflows without infrastructure or a session are skipped.
+func (cp *defaultControlPlane) PersistCoordinatorState(_ context.Context) error {
+	var errs []error
+	for _, inst := range cp.ownedWorkflows(WorkflowRunning, WorkflowPaused) {
+		if inst.Infrastructure == nil || inst.Infrastructure.Core.Adapter == nil || inst.Session == nil {
+			continue
+		}
+		data, err := json.MarshalIndent(inst.Infrastructure.Core.Adapter.ExportState(), "", "  ")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("workflow %s: marshaling coordinator state: %w", inst.ID, err))
+			continue
+		}
+		if _, err := inst.Session.WriteCoordinatorState(data); err != nil {
+			errs = append(errs, fmt.Errorf("workflow %s: %w", inst.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RemoveEphemeralWorktrees removes the worktrees of stopped workflows owned by this process.
+func (cp *defaultControlPlane) RemoveEphemeralWorktrees(ctx context.Context) error {
+	var errs []error
+	for _, inst := range cp.ownedWorkflows(WorkflowCompleted, WorkflowFailed) {
+		if err := cp.supervisor.RemoveWorktree(ctx, inst); err != nil {
+			log.Warn(log.CatOrch, "Keeping workflow worktree", "workflowID", inst.ID,
+				"path", inst.WorktreePath, "error", err)
+			errs = append(errs, fmt.Errorf("workflow %s: %w", inst.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
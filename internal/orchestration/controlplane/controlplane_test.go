@@ -4,20 +4,25 @@ import (
 	"context"
 	"errors"
 	"net"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/session"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
 
@@ -347,6 +352,80 @@ func TestControlPlane_Start_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
 	require.ErrorIs(t, err, ErrWorkflowNotFound)
 }
 
+// === Unit Tests: Kill ===
+
+func TestControlPlane_Kill_TransitionsToFailedAndEmitsEvent(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	id, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Build a feature",
+		Name:          "Runaway Workflow",
+	})
+	require.NoError(t, err)
+
+	// Simulate a running workflow with live coordinator state
+	dcp := cp.(*defaultControlPlane)
+	inst, _ := dcp.registry.Get(id)
+	require.NoError(t, inst.TransitionTo(WorkflowRunning))
+	infra := createMinimalInfrastructure(t)
+	seedKillableInfrastructure(t, infra)
+	inst.Infrastructure = infra
+	inst.ActiveWorkers = 1
+
+	eventCh, unsubscribe := cp.Subscribe(ctx)
+	defer unsubscribe()
+
+	err = cp.Kill(ctx, id)
+	require.NoError(t, err)
+
+	got, err := cp.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowFailed, got.State)
+	require.NotNil(t, got.CompletedAt)
+	require.Equal(t, 0, got.ActiveWorkers)
+	require.Nil(t, got.Infrastructure)
+	require.Empty(t, infra.Repositories.TaskRepo.All(), "task assignments should be cleared")
+
+	select {
+	case received := <-eventCh:
+		require.Equal(t, EventWorkflowFailed, received.Type)
+		require.Equal(t, id, received.WorkflowID)
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout waiting for EventWorkflowFailed event")
+	}
+}
+
+func TestControlPlane_Kill_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
+	cp, _, _ := newTestControlPlane(t)
+
+	err := cp.Kill(context.Background(), NewWorkflowID())
+
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+}
+
+func TestControlPlane_Kill_RejectsTerminalWorkflow(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+
+	id, err := cp.Create(context.Background(), WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Build a feature",
+	})
+	require.NoError(t, err)
+
+	dcp := cp.(*defaultControlPlane)
+	inst, _ := dcp.registry.Get(id)
+	require.NoError(t, inst.TransitionTo(WorkflowRunning))
+	require.NoError(t, inst.TransitionTo(WorkflowCompleted))
+
+	err = cp.Kill(context.Background(), id)
+
+	require.ErrorIs(t, err, ErrInvalidState)
+}
+
 // === Unit Tests: Pause ===
 
 func TestControlPlane_Pause_DelegatesToSupervisor(t *testing.T) {
@@ -634,6 +713,35 @@ func TestControlPlane_Complete_TransitionsToCompletedState(t *testing.T) {
 	require.NotNil(t, inst.CompletedAt)
 }
 
+func TestControlPlane_CompleteAndFail_ReleaseWorkerCapacity(t *testing.T) {
+	for _, finish := range []func(ControlPlane, context.Context, WorkflowID) error{
+		ControlPlane.Complete,
+		ControlPlane.Fail,
+	} {
+		allocator := NewCapacityAllocator(1)
+		supervisorCfg, _, _ := newTestSupervisorConfig(t)
+		supervisor, err := NewSupervisor(supervisorCfg)
+		require.NoError(t, err)
+		cp, err := NewControlPlane(ControlPlaneConfig{
+			Registry:          NewInMemoryRegistry(),
+			Supervisor:        supervisor,
+			CapacityAllocator: allocator,
+		})
+		require.NoError(t, err)
+		ctx := context.Background()
+
+		id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build a feature"})
+		require.NoError(t, err)
+		inst, _ := cp.(*defaultControlPlane).registry.Get(id)
+		require.NoError(t, inst.TransitionTo(WorkflowRunning))
+		require.NoError(t, allocator.Acquire(ctx, id, 0))
+
+		require.NoError(t, finish(cp, ctx, id))
+
+		require.Equal(t, 0, allocator.InUse(), "finished workflow should return its worker slots")
+	}
+}
+
 func TestControlPlane_Complete_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
 	cp, _, _ := newTestControlPlane(t)
 
@@ -957,6 +1065,260 @@ func TestControlPlane_Get_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
 	require.ErrorIs(t, err, ErrWorkflowNotFound)
 }
 
+// === Unit Tests: WorkflowTasks ===
+
+func TestControlPlane_WorkflowTasks_ReturnsInFlightTasksWithPhase(t *testing.T) {
+	cp, _, _ := newTestControlPlane(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build a feature"})
+	require.NoError(t, err)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	processRepo := repository.NewMemoryProcessRepository()
+	started := time.Now().Add(-time.Hour)
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-b", Implementer: "worker-2", Reviewer: "worker-1",
+		Status: repository.TaskInReview, StartedAt: started,
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-a", Implementer: "worker-1",
+		Status: repository.TaskImplementing, StartedAt: started,
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-c", Implementer: "worker-3", Status: repository.TaskCompleted,
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-d", Implementer: "worker-4", Status: repository.TaskFailed,
+	}))
+	implementing := events.ProcessPhaseImplementing
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Phase: &implementing,
+	}))
+
+	inst, err := cp.Get(ctx, id)
+	require.NoError(t, err)
+	inst.Infrastructure = &v2.Infrastructure{
+		Repositories: v2.RepositoryComponents{TaskRepo: taskRepo, ProcessRepo: processRepo},
+	}
+
+	tasks, err := cp.WorkflowTasks(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2, "completed and failed tasks are omitted")
+
+	require.Equal(t, "perles-a", tasks[0].TaskID)
+	require.Equal(t, string(repository.TaskImplementing), tasks[0].Status)
+	require.Equal(t, "worker-1", tasks[0].Implementer)
+	require.Equal(t, string(events.ProcessPhaseImplementing), tasks[0].Phase)
+	require.Equal(t, started, tasks[0].StartedAt)
+
+	require.Equal(t, "perles-b", tasks[1].TaskID)
+	require.Equal(t, "worker-1", tasks[1].Reviewer)
+	require.Empty(t, tasks[1].Phase, "unknown implementer has no phase")
+}
+
+func TestControlPlane_WorkflowTasks_EmptyWithoutInfrastructure(t *testing.T) {
+	cp, _, _ := newTestControlPlane(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build a feature"})
+	require.NoError(t, err)
+
+	tasks, err := cp.WorkflowTasks(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, tasks)
+	require.Empty(t, tasks)
+}
+
+func TestControlPlane_WorkflowTasks_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
+	cp, _, _ := newTestControlPlane(t)
+
+	tasks, err := cp.WorkflowTasks(context.Background(), NewWorkflowID())
+
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+	require.Nil(t, tasks)
+}
+
+func TestControlPlane_WorkflowConfig_ReflectsSpecAndDefaults(t *testing.T) {
+	supervisor, err := NewSupervisor(SupervisorConfig{
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator:     client.NewAgentProvider(client.ClientClaude, map[string]any{client.ExtClaudeModel: "opus"}),
+			client.RoleWorker:          client.NewAgentProvider(client.ClientCursor, map[string]any{client.ExtCursorModel: "composer-1"}),
+			client.RoleWorkerAlternate: client.NewAgentProvider(client.ClientCodex, nil),
+		},
+		InfrastructureFactory:     &mockInfrastructureFactory{},
+		ListenerFactory:           &testListenerFactory{},
+		SessionFactory:            session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		CapacityAllocator:         NewCapacityAllocator(4),
+		MinReadyWorkers:           1,
+		MaxConcurrentReviews:      2,
+		ConfirmDestructiveActions: true,
+	})
+	require.NoError(t, err)
+	cp, err := NewControlPlane(ControlPlaneConfig{Registry: NewInMemoryRegistry(), Supervisor: supervisor})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	workDir := t.TempDir()
+	id, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID:      "cook",
+		InitialPrompt:   "Build a feature",
+		WorkDir:         workDir,
+		EpicID:          "feat1-epic1",
+		Priority:        3,
+		BeadsPrefix:     "feat1",
+		Labels:          map[string]string{"team": "core"},
+		WorkerProviders: map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientClaude},
+	})
+	require.NoError(t, err)
+
+	cfg, err := cp.WorkflowConfig(ctx, id)
+	require.NoError(t, err)
+
+	// Spec values, with the name defaulted from the template
+	require.Equal(t, id, cfg.WorkflowID)
+	require.Equal(t, WorkflowPending, cfg.State)
+	require.Equal(t, "cook", cfg.Spec.Name)
+	require.Equal(t, workDir, cfg.Spec.WorkDir)
+	require.Equal(t, "feat1-epic1", cfg.Spec.EpicID)
+	require.Equal(t, 3, cfg.Spec.Priority)
+	require.Equal(t, "feat1", cfg.Spec.BeadsPrefix)
+	require.Equal(t, map[string]string{"team": "core"}, cfg.Spec.Labels)
+	require.Equal(t, WorktreeModeNone, cfg.Spec.WorktreeMode)
+
+	// Runtime settings from the supervisor's configuration
+	rt := cfg.Runtime
+	require.Equal(t, ProviderSettings{Provider: client.ClientClaude, Model: "opus"}, rt.Coordinator)
+	require.Equal(t, ProviderSettings{Provider: client.ClientCursor, Model: "composer-1"}, rt.Worker)
+	require.Equal(t, &ProviderSettings{Provider: client.ClientCodex}, rt.WorkerAlternate)
+	require.Equal(t, map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientClaude}, rt.WorkerProviders)
+	require.Equal(t, 4, rt.MaxWorkers)
+	require.Equal(t, 1, rt.MinReadyWorkers)
+	require.Equal(t, 2, rt.MaxConcurrentReviews)
+	require.True(t, rt.RequireReview)
+	require.True(t, rt.ConfirmDestructiveActions)
+	require.Equal(t, workDir, rt.WorkDir)
+}
+
+func TestControlPlane_WorkflowConfig_AppliesDefaults(t *testing.T) {
+	cp, _, mockProvider := newTestControlPlane(t)
+	mockProvider.On("Type").Return(client.ClientClaude)
+	mockProvider.On("Extensions").Return(map[string]any{})
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build a feature", SkipReview: true})
+	require.NoError(t, err)
+
+	cfg, err := cp.WorkflowConfig(ctx, id)
+	require.NoError(t, err)
+
+	require.Equal(t, "test-template", cfg.Spec.Name)
+	// Workers fall back to the coordinator's provider
+	require.Equal(t, ProviderSettings{Provider: client.ClientClaude}, cfg.Runtime.Coordinator)
+	require.Equal(t, cfg.Runtime.Coordinator, cfg.Runtime.Worker)
+	require.Nil(t, cfg.Runtime.WorkerAlternate)
+	require.Zero(t, cfg.Runtime.MaxWorkers, "no shared pool means unlimited workers")
+	require.False(t, cfg.Runtime.RequireReview)
+	require.False(t, cfg.Runtime.ConfirmDestructiveActions)
+	// An unset workdir resolves to the current directory
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.Equal(t, wd, cfg.Runtime.WorkDir)
+}
+
+func TestControlPlane_WorkflowConfig_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
+	cp, _, _ := newTestControlPlane(t)
+
+	cfg, err := cp.WorkflowConfig(context.Background(), NewWorkflowID())
+
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+	require.Nil(t, cfg)
+}
+
+// === Unit Tests: ValidateBranchName ===
+
+func newBranchValidationControlPlane(t *testing.T, gitExec *mocks.MockGitExecutor) ControlPlane {
+	t.Helper()
+	supervisor, err := NewSupervisor(SupervisorConfig{
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: mocks.NewMockAgentProvider(t),
+		},
+		SessionFactory: session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+	})
+	require.NoError(t, err)
+
+	cp, err := NewControlPlane(ControlPlaneConfig{
+		Registry:    NewInMemoryRegistry(),
+		Supervisor:  supervisor,
+		GitExecutor: gitExec,
+	})
+	require.NoError(t, err)
+	return cp
+}
+
+func TestControlPlane_ValidateBranchName_Valid(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().ValidateBranchName("feature/auth").Return(nil)
+	gitExec.EXPECT().ListWorktrees().Return([]domaingit.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+	}, nil)
+	gitExec.EXPECT().BranchExists("feature/auth").Return(false)
+	cp := newBranchValidationControlPlane(t, gitExec)
+
+	require.NoError(t, cp.ValidateBranchName("feature/auth"))
+}
+
+func TestControlPlane_ValidateBranchName_InvalidFormat(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().ValidateBranchName("bad..name").Return(domaingit.ErrInvalidBranchName)
+	cp := newBranchValidationControlPlane(t, gitExec)
+
+	err := cp.ValidateBranchName("bad..name")
+	require.ErrorIs(t, err, domaingit.ErrInvalidBranchName)
+	require.Contains(t, err.Error(), "bad..name")
+}
+
+func TestControlPlane_ValidateBranchName_AlreadyExists(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().ValidateBranchName("feature/auth").Return(nil)
+	gitExec.EXPECT().ListWorktrees().Return(nil, nil)
+	gitExec.EXPECT().BranchExists("feature/auth").Return(true)
+	cp := newBranchValidationControlPlane(t, gitExec)
+
+	err := cp.ValidateBranchName("feature/auth")
+	require.ErrorIs(t, err, domaingit.ErrBranchAlreadyExists)
+}
+
+func TestControlPlane_ValidateBranchName_CheckedOutInWorktree(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().ValidateBranchName("perles-workflow-1").Return(nil)
+	gitExec.EXPECT().ListWorktrees().Return([]domaingit.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+		{Path: "/worktrees/wf-1", Branch: "perles-workflow-1"},
+	}, nil)
+	cp := newBranchValidationControlPlane(t, gitExec)
+
+	err := cp.ValidateBranchName("perles-workflow-1")
+	require.ErrorIs(t, err, domaingit.ErrBranchAlreadyCheckedOut)
+	require.Contains(t, err.Error(), "/worktrees/wf-1")
+}
+
+func TestControlPlane_ValidateBranchName_ListWorktreesError(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().ValidateBranchName("feature/auth").Return(nil)
+	gitExec.EXPECT().ListWorktrees().Return(nil, errors.New("git failed"))
+	cp := newBranchValidationControlPlane(t, gitExec)
+
+	err := cp.ValidateBranchName("feature/auth")
+	require.ErrorContains(t, err, "listing worktrees")
+}
+
+func TestControlPlane_ValidateBranchName_WithoutGitExecutor(t *testing.T) {
+	cp, _, _ := newTestControlPlane(t)
+
+	require.ErrorIs(t, cp.ValidateBranchName("feature/auth"), ErrGitUnavailable)
+}
+
 // === Unit Tests: List ===
 
 func TestControlPlane_List_FiltersWorkflowsCorrectly(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"slices"
 	"sync"
 
+	"github.com/zjrosen/perles/internal/orchestration/osproc"
 	"github.com/zjrosen/perles/internal/sessions/domain"
 )
 
@@ -179,7 +180,7 @@ func (r *DurableRegistry) List(q ListQuery) []*WorkflowInstance {
 		// Check ownership and claim orphaned sessions
 		if ownerPID := session.OwnerCurrentPID(); ownerPID != nil {
 			if *ownerPID != currentPID {
-				if isProcessAlive(*ownerPID) {
+				if osproc.IsAlive(*ownerPID) {
 					// Another live process owns this workflow
 					inst.IsLocked = true
 				} else {
@@ -340,6 +341,7 @@ func (r *DurableRegistry) updateSessionFromWorkflow(session *domain.Session, ins
 	session.SetWorktreePath(inst.WorktreePath)
 	session.SetWorktreeBranch(inst.WorktreeBranch)
 	session.SetSessionDir(inst.SessionDir)
+	session.SetSettings(workflowSettings(inst))
 	session.SetTokensUsed(inst.TokensUsed)
 	session.SetActiveWorkers(inst.ActiveWorkers)
 
@@ -360,6 +362,27 @@ func (r *DurableRegistry) updateSessionFromWorkflow(session *domain.Session, ins
 	}
 }
 
+// workflowSettings collects the WorkflowSpec options a resumed workflow must keep.
+func workflowSettings(inst *WorkflowInstance) domain.WorkflowSettings {
+	settings := domain.WorkflowSettings{
+		BeadsPrefix:         inst.BeadsPrefix,
+		MaxWorkflowDuration: inst.MaxWorkflowDuration,
+		MaxWorkers:          inst.MaxWorkers,
+		Priority:            inst.Priority,
+		CommitAuthor:        inst.CommitAuthor,
+		DirtyWorktreePolicy: string(inst.DirtyWorktreePolicy),
+		WorktreeStashRef:    inst.WorktreeStashRef,
+		SkipReview:          inst.SkipReview,
+	}
+	if len(inst.WorkerProviders) > 0 {
+		settings.WorkerProviders = make(map[string]string, len(inst.WorkerProviders))
+		for agentType, provider := range inst.WorkerProviders {
+			settings.WorkerProviders[string(agentType)] = string(provider)
+		}
+	}
+	return settings
+}
+
 // sessionToWorkflow converts a Session entity to a WorkflowInstance.
 // Note: This creates a workflow without runtime resources (Infrastructure, HTTPServer, etc.)
 func (r *DurableRegistry) sessionToWorkflow(session *domain.Session) *WorkflowInstance {
@@ -386,6 +409,17 @@ func (r *DurableRegistry) sessionToWorkflow(session *domain.Session) *WorkflowIn
 		ActiveWorkers:      session.ActiveWorkers(),
 	}
 
+	settings := session.Settings()
+	inst.BeadsPrefix = settings.BeadsPrefix
+	inst.MaxWorkflowDuration = settings.MaxWorkflowDuration
+	inst.MaxWorkers = settings.MaxWorkers
+	inst.Priority = settings.Priority
+	inst.CommitAuthor = settings.CommitAuthor
+	inst.DirtyWorktreePolicy = DirtyWorktreePolicy(settings.DirtyWorktreePolicy)
+	inst.WorktreeStashRef = settings.WorktreeStashRef
+	inst.SkipReview = settings.SkipReview
+	inst.WorkerProviders = ParseWorkerProviders(settings.WorkerProviders)
+
 	// Copy labels to avoid external mutation
 	if session.Labels() != nil {
 		inst.Labels = make(map[string]string, len(session.Labels()))
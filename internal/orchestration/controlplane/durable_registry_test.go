@@ -3,10 +3,13 @@ package controlplane
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/infrastructure/sqlite"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 )
 
 func TestDurableRegistry_Put_Get(t *testing.T) {
@@ -127,6 +130,47 @@ func TestDurableRegistry_Update_NotInRuntime(t *testing.T) {
 	require.Equal(t, "Renamed After Restart", retrieved.Name)
 }
 
+func TestDurableRegistry_PersistsWorkflowSettings(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	registry := NewDurableRegistry("test-project", db.SessionRepository())
+
+	spec := &WorkflowSpec{
+		TemplateID:          "test-template",
+		InitialPrompt:       "Test prompt",
+		WorkDir:             "/tmp/test",
+		WorktreeMode:        WorktreeModeExisting,
+		DirtyWorktreePolicy: DirtyWorktreeStash,
+		Priority:            5,
+		CommitAuthor:        "perles-worker <workflow@bot>",
+		SkipReview:          true,
+		WorkerProviders:     map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientCodex},
+		BeadsPrefix:         "feat1",
+		MaxWorkflowDuration: 90 * time.Minute,
+		MaxWorkers:          4,
+	}
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	require.NoError(t, registry.Put(inst))
+	require.NoError(t, registry.Update(inst.ID, func(w *WorkflowInstance) {
+		w.WorktreeStashRef = "abc123"
+	}))
+
+	// A new registry reads the workflow back from the database, as after a restart
+	restored, found := NewDurableRegistry("test-project", db.SessionRepository()).Get(inst.ID)
+	require.True(t, found)
+	require.Equal(t, "feat1", restored.BeadsPrefix)
+	require.Equal(t, 90*time.Minute, restored.MaxWorkflowDuration)
+	require.Equal(t, 4, restored.MaxWorkers)
+	require.Equal(t, 5, restored.Priority)
+	require.Equal(t, "perles-worker <workflow@bot>", restored.CommitAuthor)
+	require.Equal(t, DirtyWorktreeStash, restored.DirtyWorktreePolicy)
+	require.Equal(t, "abc123", restored.WorktreeStashRef)
+	require.True(t, restored.SkipReview)
+	require.Equal(t, map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientCodex}, restored.WorkerProviders)
+}
+
 func TestDurableRegistry_List(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -0,0 +1,120 @@
+package controlplane
+
+import (
+	"maps"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+)
+
+// EffectiveConfig is the fully-resolved configuration a workflow runs with:
+// its spec with defaults applied, plus settings derived by the control plane.
+type EffectiveConfig struct {
+	WorkflowID WorkflowID
+	State      WorkflowState
+	// Spec is the workflow's spec as resolved at creation (e.g. Name defaults to the template).
+	Spec WorkflowSpec
+	// Runtime holds settings that come from the daemon's configuration or are set
+	// when the workflow is started.
+	Runtime RuntimeSettings
+}
+
+// ProviderSettings identifies the provider and model an agent role runs on.
+type ProviderSettings struct {
+	Provider client.ClientType
+	// Model is the model configured for the provider, or empty for the provider's default.
+	Model string
+}
+
+// RuntimeSettings are the settings a workflow runs with that are not part of its spec.
+type RuntimeSettings struct {
+	Coordinator ProviderSettings
+	Worker      ProviderSettings
+	// WorkerAlternate is the provider workers rotate to when rate limited (nil if none).
+	WorkerAlternate *ProviderSettings
+	// WorkerProviders maps agent types to the provider their workers use, overriding Worker.
+	WorkerProviders map[roles.AgentType]client.ClientType
+
+	// MaxWorkers is the worker pool shared across workflows (0 = unlimited).
+	MaxWorkers           int
+	MinReadyWorkers      int
+	MaxConcurrentReviews int // 0 = unlimited
+	RequireReview        bool
+	// ConfirmDestructiveActions is true when destructive coordinator tools need a confirm token.
+	ConfirmDestructiveActions bool
+	// SensitivePaths are globs whose changes are reviewed even when RequireReview is false.
+	SensitivePaths []string
+	// WorkerToolReminder is true when task prompts end with a list of the worker's tools.
+	WorkerToolReminder bool
+	// SyncBeadsStatus is true when worker phase transitions are mirrored into beads statuses.
+	SyncBeadsStatus bool
+	// WorkerKeepaliveInterval is the idle time after which ready workers get a keepalive
+	// prompt (0 = disabled), at most WorkerKeepaliveMax times between tasks.
+	WorkerKeepaliveInterval time.Duration
+	WorkerKeepaliveMax      int
+	// ReconcileInterval is how often the reconcile loop runs (negative = disabled).
+	ReconcileInterval time.Duration
+	// WorkerReadyTimeout is how long a spawned worker may take to signal ready before it
+	// is retired, or replaced when RespawnUnreadyWorkers is true.
+	WorkerReadyTimeout    time.Duration
+	RespawnUnreadyWorkers bool
+	// ReconcilePolicy names the action taken on reconcile findings.
+	ReconcilePolicy string
+	// StreamBufferSize is the max line size in bytes for parsing agent CLI output.
+	StreamBufferSize int
+	// HandoffThreshold is the coordinator context size (in tokens) that triggers an
+	// automatic handoff summary (0 = disabled).
+	HandoffThreshold int
+	// RequirePassingTests is true when approve_commit refuses tasks with failing test results.
+	RequirePassingTests bool
+	// TaskPromptLimit bounds task assignment prompts (zero MaxBytes = unlimited).
+	TaskPromptLimit prompt.PromptLimit
+	// Diagnostics is true when MCP diagnostic tools such as get_instructions are registered.
+	Diagnostics bool
+
+	// WorkDir is the directory the workflow's processes run in (the worktree when one is used).
+	WorkDir        string
+	WorktreePath   string
+	WorktreeBranch string
+	MCPPort        int
+	SessionDir     string
+}
+
+// Spec returns the WorkflowSpec the instance was created from, with defaults applied.
+func (w *WorkflowInstance) Spec() WorkflowSpec {
+	spec := WorkflowSpec{
+		TemplateID:          w.TemplateID,
+		InitialPrompt:       w.InitialPrompt,
+		Name:                w.Name,
+		WorkDir:             w.WorkDir,
+		Labels:              maps.Clone(w.Labels),
+		EpicID:              w.EpicID,
+		WorktreeEnabled:     w.WorktreeEnabled,
+		WorktreeMode:        w.WorktreeMode,
+		DirtyWorktreePolicy: w.DirtyWorktreePolicy,
+		WorktreeBaseBranch:  w.WorktreeBaseBranch,
+		WorktreeBranchName:  w.WorktreeBranchName,
+		Priority:            w.Priority,
+		CommitAuthor:        w.CommitAuthor,
+		SkipReview:          w.SkipReview,
+		WorkerProviders:     maps.Clone(w.WorkerProviders),
+		BeadsPrefix:         w.BeadsPrefix,
+		MaxWorkflowDuration: w.MaxWorkflowDuration,
+		MaxWorkers:          w.MaxWorkers,
+	}
+	if w.WorktreeMode == WorktreeModeExisting {
+		spec.WorktreePath = w.WorktreePath
+	}
+	return spec
+}
+
+// providerSettings reads the model from the provider's "<type>.model" extension.
+func providerSettings(provider client.AgentProvider) ProviderSettings {
+	settings := ProviderSettings{Provider: provider.Type()}
+	if model, ok := provider.Extensions()[string(provider.Type())+".model"].(string); ok {
+		settings.Model = model
+	}
+	return settings
+}
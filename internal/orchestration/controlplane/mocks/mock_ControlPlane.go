@@ -173,6 +173,52 @@ func (_c *MockControlPlane_Create_Call) RunAndReturn(run func(context.Context, c
 	return _c
 }
 
+// DrainWorkers provides a mock function with given fields: ctx
+func (_m *MockControlPlane) DrainWorkers(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainWorkers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_DrainWorkers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainWorkers'
+type MockControlPlane_DrainWorkers_Call struct {
+	*mock.Call
+}
+
+// DrainWorkers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockControlPlane_Expecter) DrainWorkers(ctx interface{}) *MockControlPlane_DrainWorkers_Call {
+	return &MockControlPlane_DrainWorkers_Call{Call: _e.mock.On("DrainWorkers", ctx)}
+}
+
+func (_c *MockControlPlane_DrainWorkers_Call) Run(run func(ctx context.Context)) *MockControlPlane_DrainWorkers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_DrainWorkers_Call) Return(_a0 error) *MockControlPlane_DrainWorkers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_DrainWorkers_Call) RunAndReturn(run func(context.Context) error) *MockControlPlane_DrainWorkers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fail provides a mock function with given fields: ctx, id
 func (_m *MockControlPlane) Fail(ctx context.Context, id controlplane.WorkflowID) error {
 	ret := _m.Called(ctx, id)
@@ -220,6 +266,52 @@ func (_c *MockControlPlane_Fail_Call) RunAndReturn(run func(context.Context, con
 	return _c
 }
 
+// FlushLogs provides a mock function with given fields: ctx
+func (_m *MockControlPlane) FlushLogs(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_FlushLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushLogs'
+type MockControlPlane_FlushLogs_Call struct {
+	*mock.Call
+}
+
+// FlushLogs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockControlPlane_Expecter) FlushLogs(ctx interface{}) *MockControlPlane_FlushLogs_Call {
+	return &MockControlPlane_FlushLogs_Call{Call: _e.mock.On("FlushLogs", ctx)}
+}
+
+func (_c *MockControlPlane_FlushLogs_Call) Run(run func(ctx context.Context)) *MockControlPlane_FlushLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_FlushLogs_Call) Return(_a0 error) *MockControlPlane_FlushLogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_FlushLogs_Call) RunAndReturn(run func(context.Context) error) *MockControlPlane_FlushLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *MockControlPlane) Get(ctx context.Context, id controlplane.WorkflowID) (*controlplane.WorkflowInstance, error) {
 	ret := _m.Called(ctx, id)
@@ -335,6 +427,53 @@ func (_c *MockControlPlane_GetHealthStatus_Call) RunAndReturn(run func(controlpl
 	return _c
 }
 
+// Kill provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) Kill(ctx context.Context, id controlplane.WorkflowID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Kill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_Kill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Kill'
+type MockControlPlane_Kill_Call struct {
+	*mock.Call
+}
+
+// Kill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) Kill(ctx interface{}, id interface{}) *MockControlPlane_Kill_Call {
+	return &MockControlPlane_Kill_Call{Call: _e.mock.On("Kill", ctx, id)}
+}
+
+func (_c *MockControlPlane_Kill_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_Kill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Kill_Call) Return(_a0 error) *MockControlPlane_Kill_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_Kill_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) error) *MockControlPlane_Kill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, q
 func (_m *MockControlPlane) List(ctx context.Context, q controlplane.ListQuery) ([]*controlplane.WorkflowInstance, error) {
 	ret := _m.Called(ctx, q)
@@ -441,6 +580,52 @@ func (_c *MockControlPlane_Pause_Call) RunAndReturn(run func(context.Context, co
 	return _c
 }
 
+// PersistCoordinatorState provides a mock function with given fields: ctx
+func (_m *MockControlPlane) PersistCoordinatorState(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersistCoordinatorState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_PersistCoordinatorState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersistCoordinatorState'
+type MockControlPlane_PersistCoordinatorState_Call struct {
+	*mock.Call
+}
+
+// PersistCoordinatorState is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockControlPlane_Expecter) PersistCoordinatorState(ctx interface{}) *MockControlPlane_PersistCoordinatorState_Call {
+	return &MockControlPlane_PersistCoordinatorState_Call{Call: _e.mock.On("PersistCoordinatorState", ctx)}
+}
+
+func (_c *MockControlPlane_PersistCoordinatorState_Call) Run(run func(ctx context.Context)) *MockControlPlane_PersistCoordinatorState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_PersistCoordinatorState_Call) Return(_a0 error) *MockControlPlane_PersistCoordinatorState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_PersistCoordinatorState_Call) RunAndReturn(run func(context.Context) error) *MockControlPlane_PersistCoordinatorState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Registry provides a mock function with no fields
 func (_m *MockControlPlane) Registry() controlplane.Registry {
 	ret := _m.Called()
@@ -488,6 +673,52 @@ func (_c *MockControlPlane_Registry_Call) RunAndReturn(run func() controlplane.R
 	return _c
 }
 
+// RemoveEphemeralWorktrees provides a mock function with given fields: ctx
+func (_m *MockControlPlane) RemoveEphemeralWorktrees(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveEphemeralWorktrees")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_RemoveEphemeralWorktrees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveEphemeralWorktrees'
+type MockControlPlane_RemoveEphemeralWorktrees_Call struct {
+	*mock.Call
+}
+
+// RemoveEphemeralWorktrees is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockControlPlane_Expecter) RemoveEphemeralWorktrees(ctx interface{}) *MockControlPlane_RemoveEphemeralWorktrees_Call {
+	return &MockControlPlane_RemoveEphemeralWorktrees_Call{Call: _e.mock.On("RemoveEphemeralWorktrees", ctx)}
+}
+
+func (_c *MockControlPlane_RemoveEphemeralWorktrees_Call) Run(run func(ctx context.Context)) *MockControlPlane_RemoveEphemeralWorktrees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_RemoveEphemeralWorktrees_Call) Return(_a0 error) *MockControlPlane_RemoveEphemeralWorktrees_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_RemoveEphemeralWorktrees_Call) RunAndReturn(run func(context.Context) error) *MockControlPlane_RemoveEphemeralWorktrees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Resume provides a mock function with given fields: ctx, id
 func (_m *MockControlPlane) Resume(ctx context.Context, id controlplane.WorkflowID) error {
 	ret := _m.Called(ctx, id)
@@ -810,6 +1041,170 @@ func (_c *MockControlPlane_SubscribeWorkflow_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ValidateBranchName provides a mock function with given fields: name
+func (_m *MockControlPlane) ValidateBranchName(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateBranchName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_ValidateBranchName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateBranchName'
+type MockControlPlane_ValidateBranchName_Call struct {
+	*mock.Call
+}
+
+// ValidateBranchName is a helper method to define mock.On call
+//   - name string
+func (_e *MockControlPlane_Expecter) ValidateBranchName(name interface{}) *MockControlPlane_ValidateBranchName_Call {
+	return &MockControlPlane_ValidateBranchName_Call{Call: _e.mock.On("ValidateBranchName", name)}
+}
+
+func (_c *MockControlPlane_ValidateBranchName_Call) Run(run func(name string)) *MockControlPlane_ValidateBranchName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_ValidateBranchName_Call) Return(_a0 error) *MockControlPlane_ValidateBranchName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_ValidateBranchName_Call) RunAndReturn(run func(string) error) *MockControlPlane_ValidateBranchName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowConfig provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) WorkflowConfig(ctx context.Context, id controlplane.WorkflowID) (*controlplane.EffectiveConfig, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowConfig")
+	}
+
+	var r0 *controlplane.EffectiveConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) (*controlplane.EffectiveConfig, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) *controlplane.EffectiveConfig); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*controlplane.EffectiveConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockControlPlane_WorkflowConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowConfig'
+type MockControlPlane_WorkflowConfig_Call struct {
+	*mock.Call
+}
+
+// WorkflowConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) WorkflowConfig(ctx interface{}, id interface{}) *MockControlPlane_WorkflowConfig_Call {
+	return &MockControlPlane_WorkflowConfig_Call{Call: _e.mock.On("WorkflowConfig", ctx, id)}
+}
+
+func (_c *MockControlPlane_WorkflowConfig_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_WorkflowConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_WorkflowConfig_Call) Return(_a0 *controlplane.EffectiveConfig, _a1 error) *MockControlPlane_WorkflowConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockControlPlane_WorkflowConfig_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) (*controlplane.EffectiveConfig, error)) *MockControlPlane_WorkflowConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowTasks provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) WorkflowTasks(ctx context.Context, id controlplane.WorkflowID) ([]controlplane.TaskSummary, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowTasks")
+	}
+
+	var r0 []controlplane.TaskSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) ([]controlplane.TaskSummary, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) []controlplane.TaskSummary); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]controlplane.TaskSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockControlPlane_WorkflowTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowTasks'
+type MockControlPlane_WorkflowTasks_Call struct {
+	*mock.Call
+}
+
+// WorkflowTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) WorkflowTasks(ctx interface{}, id interface{}) *MockControlPlane_WorkflowTasks_Call {
+	return &MockControlPlane_WorkflowTasks_Call{Call: _e.mock.On("WorkflowTasks", ctx, id)}
+}
+
+func (_c *MockControlPlane_WorkflowTasks_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_WorkflowTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_WorkflowTasks_Call) Return(_a0 []controlplane.TaskSummary, _a1 error) *MockControlPlane_WorkflowTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockControlPlane_WorkflowTasks_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) ([]controlplane.TaskSummary, error)) *MockControlPlane_WorkflowTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockControlPlane creates a new instance of MockControlPlane. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockControlPlane(t interface {
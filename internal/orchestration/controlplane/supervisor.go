@@ -3,16 +3,20 @@ package controlplane
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/flags"
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	domaingit "github.com/zjrosen/perles/internal/git/domain"
@@ -21,6 +25,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricpersist "github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
 	"github.com/zjrosen/perles/internal/orchestration/mcp"
+	"github.com/zjrosen/perles/internal/orchestration/osproc"
 	"github.com/zjrosen/perles/internal/orchestration/session"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
@@ -28,6 +33,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
@@ -75,7 +81,23 @@ type Supervisor interface {
 	// Shutdown terminates a workflow and releases all resources.
 	// Drains the command processor, finalizes the session, and releases leases.
 	// Can be called on any active workflow (Running, Paused, Pending).
+	// A graceful stop restores changes stashed from an existing worktree at start.
 	Shutdown(ctx context.Context, inst *WorkflowInstance, opts StopOptions) error
+
+	// Kill immediately terminates a workflow without a graceful stop.
+	// Force-stops every process (SIGKILL), clears task assignments and queues,
+	// then releases resources as a forced Shutdown. Transitions to Failed.
+	// Can be called on any active workflow (Running, Paused, Pending).
+	Kill(ctx context.Context, inst *WorkflowInstance) error
+
+	// RemoveWorktree removes the git worktree created for a stopped workflow.
+	// Only worktrees perles created (WorktreeModeNew) are removed; user-owned worktrees
+	// are never touched. Returns ErrUncommittedChanges and keeps the worktree if it is dirty.
+	RemoveWorktree(ctx context.Context, inst *WorkflowInstance) error
+
+	// RuntimeSettings returns the settings the workflow runs with that are not part of
+	// its spec: agent providers, worker limits, and where it runs.
+	RuntimeSettings(inst *WorkflowInstance) RuntimeSettings
 }
 
 // InfrastructureFactory creates v2.Infrastructure instances.
@@ -154,6 +176,89 @@ type SupervisorConfig struct {
 	// BeadsDir is the resolved path to the beads database directory.
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
+
+	// Tracker runs BQL queries against beads for tools that look up related issues.
+	// If nil, complete_epic_tasks and get_critical_path are unavailable.
+	Tracker bql.BQLExecutor
+
+	// CapacityAllocator shares worker slots across workflows by priority.
+	// Optional - if nil, each workflow may spawn workers without limit.
+	CapacityAllocator *CapacityAllocator
+
+	// MinReadyWorkers is the number of workers each workflow keeps ready or starting up
+	// ahead of demand, bounded by CapacityAllocator. Zero disables the floor.
+	MinReadyWorkers int
+
+	// MaxConcurrentReviews caps how many tasks each workflow may have in review at once.
+	// Zero means no limit.
+	MaxConcurrentReviews int
+
+	// InstanceRegistry holds the workflow instances, so each coordinator can list the
+	// workflows running alongside its own. Optional - if nil, list_workflows is unavailable.
+	InstanceRegistry Registry
+
+	// WorkerProviderFactory creates the provider for a workflow's per-agent-type worker
+	// providers (WorkflowSpec.WorkerProviders), so they pick up the configured extensions.
+	// If nil, providers are created without extensions.
+	WorkerProviderFactory func(client.ClientType) client.AgentProvider
+
+	// ConfirmDestructiveActions makes each coordinator confirm destructive tools such as
+	// complete_epic_tasks with a token before they run.
+	ConfirmDestructiveActions bool
+
+	// SensitivePaths are globs whose changes are reviewed even in workflows that
+	// do not require review.
+	SensitivePaths []string
+
+	// WorkerToolReminder appends a list of the worker's MCP tools to every task
+	// assignment prompt.
+	WorkerToolReminder bool
+
+	// SyncBeadsStatus marks tasks in_progress in beads when a worker starts implementing
+	// them by a path other than assign_task (transfer, replacement, import), keeping the
+	// tracker current for external observers.
+	SyncBeadsStatus bool
+
+	// WorkerKeepaliveInterval is how long a ready worker may sit idle without a task
+	// before it is sent a no-op prompt to keep its session warm (0 = disabled).
+	WorkerKeepaliveInterval time.Duration
+	// WorkerKeepaliveMax caps the keepalive prompts an idle worker receives between
+	// tasks (0 = v2.DefaultMaxWorkerKeepalives).
+	WorkerKeepaliveMax int
+
+	// ReconcileInterval is how often each workflow checks for orphaned tasks and stuck
+	// workers (0 = v2.DefaultReconcileInterval, negative disables the reconcile loop).
+	ReconcileInterval time.Duration
+	// WorkerReadyTimeout is how long a spawned worker may take to signal ready before
+	// it is retired (0 = v2.DefaultReadyTimeout).
+	WorkerReadyTimeout time.Duration
+	// RespawnUnreadyWorkers replaces workers retired for never signaling ready with a
+	// fresh worker instead of only retiring them.
+	RespawnUnreadyWorkers bool
+	// ReconcilePolicy names the action taken on reconcile findings: v2.ReconcilePolicyLog
+	// (the default) or v2.ReconcilePolicyNotifyCoordinator.
+	ReconcilePolicy string
+
+	// StreamBufferSize is the max line size in bytes for parsing agent CLI output
+	// (0 = client.DefaultStreamBufferSize).
+	StreamBufferSize int
+
+	// HandoffThreshold is the coordinator context size (in tokens) at which a handoff
+	// summary is posted automatically. Zero disables it.
+	HandoffThreshold int
+
+	// RequirePassingTests makes approve_commit refuse tasks whose reported test results
+	// include failures, unless the coordinator explicitly overrides it.
+	RequirePassingTests bool
+
+	// TaskPromptLimit bounds the size of task assignment prompts sent to workers.
+	// Zero MaxBytes leaves prompts unbounded; an empty Strategy defaults to
+	// prompt.TruncateDescription.
+	TaskPromptLimit prompt.PromptLimit
+
+	// Diagnostics registers read-only debugging tools such as get_instructions on the
+	// coordinator and worker MCP servers. Off by default: they can expose prompt contents.
+	Diagnostics bool
 }
 
 // defaultSupervisor is the default implementation of Supervisor.
@@ -168,6 +273,27 @@ type defaultSupervisor struct {
 	sessionFactory        *session.Factory
 	soundService          sound.SoundService
 	beadsDir              string
+	capacity              *CapacityAllocator
+	tracker               bql.BQLExecutor
+	minReadyWorkers       int
+	maxConcurrentReviews  int
+	instanceRegistry      Registry
+	workerProviderFactory func(client.ClientType) client.AgentProvider
+	confirmDestructive    bool
+	sensitivePaths        []string
+	workerToolReminder    bool
+	syncBeadsStatus       bool
+	keepaliveInterval     time.Duration
+	keepaliveMax          int
+	reconcileInterval     time.Duration
+	readyTimeout          time.Duration
+	respawnUnready        bool
+	reconcilePolicy       string
+	streamBufferSize      int
+	handoffThreshold      int
+	requirePassingTests   bool
+	taskPromptLimit       prompt.PromptLimit
+	diagnostics           bool
 }
 
 // NewSupervisor creates a new Supervisor with the given configuration.
@@ -193,6 +319,31 @@ func NewSupervisor(cfg SupervisorConfig) (Supervisor, error) {
 		listenerFactory = &DefaultListenerFactory{}
 	}
 
+	workerProviderFactory := cfg.WorkerProviderFactory
+	if workerProviderFactory == nil {
+		workerProviderFactory = func(clientType client.ClientType) client.AgentProvider {
+			return client.NewAgentProvider(clientType, nil)
+		}
+	}
+
+	taskPromptLimit := cfg.TaskPromptLimit
+	if taskPromptLimit.Enabled() && taskPromptLimit.Strategy == "" {
+		taskPromptLimit.Strategy = prompt.TruncateDescription
+	}
+	if err := taskPromptLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("TaskPromptLimit: %w", err)
+	}
+
+	if err := v2.ValidateReconcilePolicyName(cfg.ReconcilePolicy); err != nil {
+		return nil, fmt.Errorf("ReconcilePolicy: %w", err)
+	}
+	if cfg.WorkerReadyTimeout < 0 {
+		return nil, fmt.Errorf("WorkerReadyTimeout must not be negative")
+	}
+	if cfg.StreamBufferSize < 0 {
+		return nil, fmt.Errorf("StreamBufferSize must not be negative")
+	}
+
 	// Apply default values for worktree configuration
 	worktreeTimeout := cfg.WorktreeTimeout
 	if worktreeTimeout == 0 {
@@ -210,6 +361,27 @@ func NewSupervisor(cfg SupervisorConfig) (Supervisor, error) {
 		sessionFactory:        cfg.SessionFactory,
 		soundService:          cfg.SoundService,
 		beadsDir:              cfg.BeadsDir,
+		capacity:              cfg.CapacityAllocator,
+		tracker:               cfg.Tracker,
+		minReadyWorkers:       cfg.MinReadyWorkers,
+		maxConcurrentReviews:  cfg.MaxConcurrentReviews,
+		instanceRegistry:      cfg.InstanceRegistry,
+		workerProviderFactory: workerProviderFactory,
+		confirmDestructive:    cfg.ConfirmDestructiveActions,
+		sensitivePaths:        cfg.SensitivePaths,
+		workerToolReminder:    cfg.WorkerToolReminder,
+		syncBeadsStatus:       cfg.SyncBeadsStatus,
+		keepaliveInterval:     cfg.WorkerKeepaliveInterval,
+		keepaliveMax:          cfg.WorkerKeepaliveMax,
+		reconcileInterval:     cfg.ReconcileInterval,
+		readyTimeout:          cfg.WorkerReadyTimeout,
+		respawnUnready:        cfg.RespawnUnreadyWorkers,
+		reconcilePolicy:       cfg.ReconcilePolicy,
+		streamBufferSize:      cfg.StreamBufferSize,
+		handoffThreshold:      cfg.HandoffThreshold,
+		requirePassingTests:   cfg.RequirePassingTests,
+		taskPromptLimit:       taskPromptLimit,
+		diagnostics:           cfg.Diagnostics,
 	}, nil
 }
 
@@ -300,16 +472,13 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		// Set WorkDir to the existing worktree path
 		inst.WorkDir = inst.WorktreePath
 
-		// Check for uncommitted changes (warning only, do not block)
-		if s.gitExecutorFactory != nil {
+		// Guard the user's uncommitted changes before workers touch the worktree.
+		// Skipped on cold resume: the changes then belong to this workflow's workers.
+		if s.gitExecutorFactory != nil && !coldResume {
 			gitExec = s.gitExecutorFactory(inst.WorktreePath)
-			hasUncommitted, err := gitExec.HasUncommittedChanges()
-			if err != nil {
-				log.Warn(log.CatOrch, "Failed to check uncommitted changes in existing worktree",
-					"subsystem", "supervisor", "workflowID", inst.ID, "path", inst.WorktreePath, "error", err)
-			} else if hasUncommitted {
-				log.Warn(log.CatOrch, "Existing worktree has uncommitted changes",
-					"subsystem", "supervisor", "workflowID", inst.ID, "path", inst.WorktreePath)
+			if err := guardDirtyWorktree(inst, gitExec); err != nil {
+				cancel()
+				return err
 			}
 		}
 
@@ -361,7 +530,13 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 					branchName = fmt.Sprintf("perles-workflow-%s", shortID)
 				}
 
-				// Create worktree with timeout context
+				// Note what exists before git runs, so an aborted attempt removes only what it created
+				_, statErr := os.Stat(path)
+				createdDir := statErr != nil
+				createdBranch := !gitExec.BranchExists(branchName)
+
+				// Create worktree with timeout context derived from the caller's context,
+				// so cancelling the workflow start aborts a slow git command
 				worktreeCtx, worktreeCancel := context.WithTimeout(ctx, s.worktreeTimeout)
 				err = gitExec.CreateWorktreeWithContext(worktreeCtx, path, branchName, inst.WorktreeBaseBranch)
 				worktreeCancel()
@@ -371,6 +546,16 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 					log.ErrorErr(log.CatOrch, "failed to create worktree", err, "subsystem", "supervisor",
 						"workflowID", inst.ID, "path", path, "branch", branchName)
 
+					// An aborted git command can leave a half-populated worktree behind
+					cancelled := ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, domaingit.ErrWorktreeCancelled)
+					timedOut := errors.Is(err, context.DeadlineExceeded) || errors.Is(err, domaingit.ErrWorktreeTimeout)
+					if cancelled || timedOut {
+						removePartialWorktree(gitExec, path, branchName, createdDir, createdBranch)
+					}
+					if cancelled {
+						return fmt.Errorf("creating worktree: workflow start cancelled before worktree '%s' was ready: %w", path, err)
+					}
+
 					// Wrap known error types for user-friendly messages
 					if errors.Is(err, domaingit.ErrBranchAlreadyCheckedOut) {
 						return fmt.Errorf("creating worktree: branch '%s' is already checked out in another worktree: %w", branchName, err)
@@ -378,7 +563,7 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 					if errors.Is(err, domaingit.ErrPathAlreadyExists) {
 						return fmt.Errorf("creating worktree: path '%s' already exists: %w", path, err)
 					}
-					if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, domaingit.ErrWorktreeTimeout) {
+					if timedOut {
 						return fmt.Errorf("creating worktree: operation timed out after %v: %w", s.worktreeTimeout, err)
 					}
 					return fmt.Errorf("creating worktree: %w", err)
@@ -441,19 +626,71 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 
 	// Step 4: Create InfrastructureConfig
 	infraCfg := v2.InfrastructureConfig{
-		Port:                    port,
-		AgentProviders:          s.agentProviders,
-		WorkDir:                 workDir,
-		BeadsDir:                s.beadsDir,
-		SessionID:               inst.ID.String(),
-		SessionDir:              sess.Dir,
-		SessionRefNotifier:      sess,
-		SessionMetadataProvider: sess,
-		SoundService:            s.soundService,
+		Port:                      port,
+		AgentProviders:            s.agentProviders,
+		WorkDir:                   workDir,
+		BeadsDir:                  s.beadsDir,
+		CommitAuthor:              inst.CommitAuthor,
+		SkipReview:                inst.SkipReview,
+		SensitivePaths:            s.sensitivePaths,
+		SessionID:                 inst.ID.String(),
+		SessionDir:                sess.Dir,
+		SessionRefNotifier:        sess,
+		SessionMetadataProvider:   sess,
+		MessageContentStore:       sess,
+		MessageContentLimit:       sess.MessageContentLimit(),
+		SoundService:              s.soundService,
+		Tracker:                   s.tracker,
+		MinReadyWorkers:           s.minReadyWorkers,
+		MaxConcurrentReviews:      s.maxConcurrentReviews,
+		ConfirmDestructiveActions: s.confirmDestructive,
+		SyncBeadsStatus:           s.syncBeadsStatus,
+		WorkerKeepaliveInterval:   s.keepaliveInterval,
+		WorkerKeepaliveMax:        s.keepaliveMax,
+		ReconcileInterval:         s.reconcileInterval,
+		ReadyTimeout:              s.readyTimeout,
+		RespawnUnreadyWorkers:     s.respawnUnready,
+		ReconcilePolicyName:       s.reconcilePolicy,
+		StreamBufferSize:          s.streamBufferSize,
+		HandoffThreshold:          s.handoffThreshold,
+		RequirePassingTests:       s.requirePassingTests,
+		TaskPromptLimit:           s.taskPromptLimit,
 		CommandPersistenceProvider: func() processor.CommandWriter {
 			return sess
 		},
 	}
+	if s.workerToolReminder {
+		infraCfg.WorkerToolHints = mcp.WorkerToolHints()
+	}
+	// Review diff checkpoints need a git executor scoped to the workflow's worktree
+	if inst.WorktreePath != "" && s.gitExecutorFactory != nil {
+		infraCfg.GitExecutor = s.gitExecutorFactory(inst.WorktreePath)
+		infraCfg.CheckpointsEnabled = true
+	} else if inst.SkipReview && len(s.sensitivePaths) > 0 && s.gitExecutorFactory != nil {
+		// Without a worktree, sensitive paths are still checked against the work directory
+		infraCfg.GitExecutor = s.gitExecutorFactory(workDir)
+	}
+	// Worker spawns draw from the pool shared with other workflows
+	capacity := s.capacity
+	if capacity == nil && inst.MaxWorkers > 0 {
+		// Without a shared pool the workflow's own limit still applies
+		capacity = NewCapacityAllocator(0)
+	}
+	if capacity != nil {
+		infraCfg.WorkerCapacity = capacity.ForWorkflow(inst.ID, inst.Priority, inst.MaxWorkers)
+	}
+	// The coordinator can see the other workflows the control plane is running
+	if s.instanceRegistry != nil {
+		infraCfg.WorkflowLister = NewWorkflowLister(s.instanceRegistry, inst.ID)
+	}
+
+	// Workers of some agent types may run on a different provider than the rest
+	if len(inst.WorkerProviders) > 0 {
+		infraCfg.WorkerProviders = make(map[roles.AgentType]client.AgentProvider, len(inst.WorkerProviders))
+		for agentType, clientType := range inst.WorkerProviders {
+			infraCfg.WorkerProviders[agentType] = s.workerProviderFactory(clientType)
+		}
+	}
 
 	// Step 5: Create Infrastructure
 	infra, err = s.infrastructureFactory.Create(infraCfg)
@@ -537,12 +774,19 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		mcpCoordServer.SetFabricService(infra.Core.FabricService)
 	}
 
+	// Restrict the coordinator to the workflow's task namespace
+	mcpCoordServer.SetTaskIDPrefix(inst.BeadsPrefix)
+
+	if s.diagnostics {
+		mcpCoordServer.EnableDiagnostics()
+	}
+
 	// Attach MCP broker to session for mcp_requests.jsonl logging
 	sess.AttachMCPBroker(workflowCtx, mcpCoordServer.Broker())
 
 	// Create worker server cache for /worker/ routes
 	// Pass sess as AccountabilityWriter so workers can persist their accountability summaries
-	workerServers := newWorkerServerCache(sess, infra.Core.Adapter, infra.Internal.TurnEnforcer, infra.Core.FabricService, sess, workflowCtx)
+	workerServers := newWorkerServerCache(sess, infra.Core.Adapter, infra.Internal.TurnEnforcer, infra.Core.FabricService, sess, workflowCtx, s.diagnostics)
 
 	// Create observer MCP server (singleton - one observer per workflow)
 	observerServer := mcp.NewObserverServer(repository.ObserverID)
@@ -555,13 +799,14 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 
 	// Set up HTTP routes
 	// IMPORTANT: Route registration order matters!
-	// 1. MCP routes first (/mcp, /worker/, /observer)
+	// 1. MCP routes first (/mcp, /worker/, /observer, /metrics)
 	// 2. API routes second (/api/*)
 	// 3. SPA catch-all LAST (/) - serves index.html for client-side routing
 	mux := http.NewServeMux()
 	mux.Handle("/mcp", mcpCoordServer.ServeHTTP())
 	mux.HandleFunc("/worker/", workerServers.ServeHTTP)
 	mux.Handle("/observer", observerServer.ServeHTTP())
+	mux.Handle("/metrics", mcp.MetricsHandler(mcpCoordServer, v2.DefaultStuckWorkerTimeout))
 
 	httpServer = &http.Server{
 		Handler:           mux,
@@ -595,6 +840,10 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 			// Log but don't fail - we can still try to resume even if restore fails
 			log.Debug(log.CatOrch, "Failed to restore process state from session (will spawn fresh)",
 				"subsystem", "supervisor", "workflowID", inst.ID, "error", err)
+		} else if err := s.restoreCoordinatorState(workflowCtx, inst); err != nil {
+			// Log but don't fail - the coordinator can re-sync tasks with sync_task_status
+			log.Warn(log.CatOrch, "Failed to restore coordinator state from session",
+				"subsystem", "supervisor", "workflowID", inst.ID, "error", err)
 		}
 	}
 
@@ -614,6 +863,8 @@ func (s *defaultSupervisor) SpawnCoordinator(ctx context.Context, inst *Workflow
 			ErrInvalidState, inst.State, WorkflowPending)
 	}
 
+	s.reconcileWithTracker(ctx, inst)
+
 	// Spawn coordinator
 	spawnCmd := command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleCoordinator, command.WithWorkflowConfig(&roles.WorkflowConfig{
 		// TODO we need to figure out what we want to do here, currently
@@ -771,6 +1022,8 @@ func (s *defaultSupervisor) Resume(ctx context.Context, inst *WorkflowInstance)
 		}
 	}
 
+	s.reconcileWithTracker(ctx, inst)
+
 	// Send system message with pause context - this triggers the delivery flow
 	// which spawns a new AI session and attaches it to the existing coordinator process
 	if err := s.sendResumeContextMessage(inst); err != nil {
@@ -781,6 +1034,32 @@ func (s *defaultSupervisor) Resume(ctx context.Context, inst *WorkflowInstance)
 	return nil
 }
 
+// reconcileWithTracker compares the workflow's task assignments with the tasks beads
+// reports in_progress under the workflow's prefix and logs any divergence, so tasks
+// left in_progress by a crash are surfaced when the workflow starts or resumes.
+// Does nothing without a tracker. Report-only: beads statuses are not changed.
+func (s *defaultSupervisor) reconcileWithTracker(ctx context.Context, inst *WorkflowInstance) {
+	if s.tracker == nil || inst.Infrastructure == nil || inst.Infrastructure.Repositories.TaskRepo == nil {
+		return
+	}
+	reconciler := v2.NewReconciler(inst.Infrastructure.Repositories.TaskRepo, s.tracker,
+		v2.WithReconcileTaskIDPrefix(inst.BeadsPrefix))
+	report, err := reconciler.ReconcileWithTracker(ctx)
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to reconcile tasks with beads", "subsystem", "supervisor",
+			"workflowID", inst.ID, "error", err)
+		return
+	}
+	for _, d := range report.Untracked {
+		log.Warn(log.CatOrch, "Task is in_progress in beads but not assigned in this workflow", "subsystem", "supervisor",
+			"workflowID", inst.ID, "taskID", d.TaskID)
+	}
+	for _, d := range report.Stale {
+		log.Warn(log.CatOrch, "Task is assigned in this workflow but not in_progress in beads", "subsystem", "supervisor",
+			"workflowID", inst.ID, "taskID", d.TaskID, "beadsStatus", d.TrackerStatus, "assignmentStatus", d.AssignmentStatus)
+	}
+}
+
 // restoreProcessStateFromSession loads session metadata and restores ProcessRepository
 // and ProcessRegistry from the persisted session data. This enables cold resume by
 // populating the coordinator and worker processes so Resume() can find them.
@@ -845,6 +1124,33 @@ func (s *defaultSupervisor) restoreProcessStateFromSession(inst *WorkflowInstanc
 	return nil
 }
 
+// restoreCoordinatorState re-imports the coordinator state persisted at shutdown
+// (coordinator_state.json), restoring task assignments and each worker's task and phase.
+// Must run after restoreProcessStateFromSession so the snapshot's workers are in the pool.
+func (s *defaultSupervisor) restoreCoordinatorState(ctx context.Context, inst *WorkflowInstance) error {
+	data, err := session.LoadCoordinatorState(inst.SessionDir)
+	if err != nil {
+		return err
+	}
+	if data == nil || inst.Infrastructure.Core.Adapter == nil {
+		return nil
+	}
+
+	var state adapter.StateExport
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("parsing coordinator state: %w", err)
+	}
+	workers, tasks, err := inst.Infrastructure.Core.Adapter.ImportState(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("importing coordinator state: %w", err)
+	}
+
+	log.Debug(log.CatOrch, "Restored coordinator state from session",
+		"subsystem", "supervisor", "workflowID", inst.ID,
+		"workerAssignments", workers, "tasks", tasks)
+	return nil
+}
+
 // restoreFabricState loads and replays persisted Fabric events to restore messaging state.
 // This restores channels, messages, artifacts, subscriptions, and acks from fabric_events.jsonl.
 func (s *defaultSupervisor) restoreFabricState(inst *WorkflowInstance) error {
@@ -928,6 +1234,94 @@ If you are still unsure how to proceed then you MUST call the notify_user tool a
 	return nil
 }
 
+// removePartialWorktree best-effort removes what an aborted worktree creation left behind.
+// git may have registered the worktree, populated part of the directory and created the
+// branch before it was killed. The directory is removed only if it did not exist before
+// the attempt (even if git no longer recognises it), and the branch only if it was new.
+func removePartialWorktree(gitExec appgit.GitExecutor, path, branch string, createdDir, createdBranch bool) {
+	if createdDir {
+		_ = gitExec.RemoveWorktree(path)
+		_ = os.RemoveAll(path)
+	}
+	_ = gitExec.PruneWorktrees()
+	if createdBranch {
+		_ = gitExec.DeleteBranch(branch)
+	}
+}
+
+// guardDirtyWorktree applies inst.DirtyWorktreePolicy to uncommitted changes in an
+// existing worktree. Refuse (the default) returns ErrUncommittedChanges, stash saves the
+// changes and records the stash in inst.WorktreeStashRef (restored by a graceful Shutdown),
+// and proceed only logs a warning.
+func guardDirtyWorktree(inst *WorkflowInstance, gitExec appgit.GitExecutor) error {
+	hasUncommitted, err := gitExec.HasUncommittedChanges()
+	if err != nil {
+		if inst.DirtyWorktreePolicy == DirtyWorktreeProceed {
+			log.Warn(log.CatOrch, "Failed to check uncommitted changes in existing worktree",
+				"subsystem", "supervisor", "workflowID", inst.ID, "path", inst.WorktreePath, "error", err)
+			return nil
+		}
+		return fmt.Errorf("checking existing worktree %q for uncommitted changes: %w", inst.WorktreePath, err)
+	}
+	if !hasUncommitted {
+		return nil
+	}
+
+	switch inst.DirtyWorktreePolicy {
+	case DirtyWorktreeProceed:
+		log.Warn(log.CatOrch, "Existing worktree has uncommitted changes",
+			"subsystem", "supervisor", "workflowID", inst.ID, "path", inst.WorktreePath)
+		return nil
+	case DirtyWorktreeStash:
+		ref, err := gitExec.StashPush(fmt.Sprintf("perles: uncommitted changes before workflow %s", inst.ID))
+		if err != nil {
+			return fmt.Errorf("stashing uncommitted changes in existing worktree %q: %w", inst.WorktreePath, err)
+		}
+		inst.WorktreeStashRef = ref
+		log.Info(log.CatOrch, "Stashed uncommitted changes in existing worktree",
+			"subsystem", "supervisor", "workflowID", inst.ID, "path", inst.WorktreePath, "stash", ref)
+		return nil
+	default:
+		return fmt.Errorf("%w: existing worktree %q; commit or stash them, or choose the stash or proceed policy",
+			ErrUncommittedChanges, inst.WorktreePath)
+	}
+}
+
+// restoreWorktreeStash applies inst.WorktreeStashRef back to the worktree after a graceful
+// stop, which Step 0 of Shutdown guarantees left the worktree clean. After a forced stop,
+// or if the stash does not apply, the stash is kept and reported to the user.
+func (s *defaultSupervisor) restoreWorktreeStash(inst *WorkflowInstance, opts StopOptions) {
+	if inst.WorktreeStashRef == "" {
+		return
+	}
+	if opts.Force || s.gitExecutorFactory == nil {
+		reportWorktreeStash(inst, nil)
+		return
+	}
+	gitExec := s.gitExecutorFactory(inst.WorktreePath)
+	if err := gitExec.StashApply(inst.WorktreeStashRef); err != nil {
+		reportWorktreeStash(inst, err)
+		return
+	}
+	log.Info(log.CatOrch, "Restored uncommitted changes stashed at workflow start",
+		"subsystem", "supervisor", "workflowID", inst.ID, "path", inst.WorktreePath, "stash", inst.WorktreeStashRef)
+	inst.WorktreeStashRef = ""
+}
+
+// reportWorktreeStash warns that the changes stashed when inst started are still in the
+// stash, with the command that restores them. err is why restoring failed, if it was tried.
+func reportWorktreeStash(inst *WorkflowInstance, err error) {
+	if inst.WorktreeStashRef == "" {
+		return
+	}
+	args := []any{"subsystem", "supervisor", "workflowID", inst.ID, "path", inst.WorktreePath,
+		"stash", inst.WorktreeStashRef, "restore", "git stash apply " + inst.WorktreeStashRef}
+	if err != nil {
+		args = append(args, "error", err)
+	}
+	log.Warn(log.CatOrch, "Uncommitted changes stashed at workflow start were not restored", args...)
+}
+
 // Shutdown terminates a workflow and releases all resources.
 func (s *defaultSupervisor) Shutdown(ctx context.Context, inst *WorkflowInstance, opts StopOptions) error {
 	// Validate workflow can be stopped (Running or Paused can transition to Failed)
@@ -1009,11 +1403,19 @@ func (s *defaultSupervisor) Shutdown(ctx context.Context, inst *WorkflowInstance
 		inst.Cancel()
 	}
 
+	// Return the workflow's worker slots to the shared pool
+	if s.capacity != nil {
+		s.capacity.ReleaseAll(inst.ID)
+	}
+
 	// Step 6: Transition to Failed state (user-initiated stop is treated as failure)
 	if err := inst.TransitionTo(WorkflowFailed); err != nil {
 		return fmt.Errorf("transitioning to Failed: %w", err)
 	}
 
+	// Step 7: Restore changes stashed from an existing worktree when the workflow started
+	s.restoreWorktreeStash(inst, opts)
+
 	// Clear resources
 	inst.Infrastructure = nil
 	inst.MCPPort = 0
@@ -1024,6 +1426,171 @@ func (s *defaultSupervisor) Shutdown(ctx context.Context, inst *WorkflowInstance
 	return nil
 }
 
+// KillStopTimeout bounds how long Kill waits for the command processor to
+// force-stop processes before falling back to killing PIDs directly.
+const KillStopTimeout = 2 * time.Second
+
+// Kill immediately terminates a workflow and releases all resources.
+// Unlike Shutdown, it does not pause first or check for uncommitted changes.
+func (s *defaultSupervisor) Kill(ctx context.Context, inst *WorkflowInstance) error {
+	if !inst.State.CanTransitionTo(WorkflowFailed) {
+		return fmt.Errorf("%w: cannot kill workflow in state %s", ErrInvalidState, inst.State)
+	}
+
+	if inst.Infrastructure != nil {
+		s.killProcesses(ctx, inst)
+	}
+
+	return s.Shutdown(ctx, inst, StopOptions{Reason: "killed", Force: true})
+}
+
+// RemoveWorktree removes the worktree created for a stopped workflow.
+func (s *defaultSupervisor) RemoveWorktree(_ context.Context, inst *WorkflowInstance) error {
+	if inst.WorktreeMode != WorktreeModeNew || inst.WorktreePath == "" || s.gitExecutorFactory == nil {
+		return nil
+	}
+	if !inst.State.IsTerminal() {
+		return fmt.Errorf("%w: cannot remove worktree of workflow in state %s", ErrInvalidState, inst.State)
+	}
+	if _, err := os.Stat(inst.WorktreePath); os.IsNotExist(err) {
+		return nil
+	}
+
+	gitExec := s.gitExecutorFactory(inst.WorktreePath)
+	hasUncommitted, err := gitExec.HasUncommittedChanges()
+	if err != nil {
+		return fmt.Errorf("checking worktree %q for uncommitted changes: %w", inst.WorktreePath, err)
+	}
+	if hasUncommitted {
+		return fmt.Errorf("%w: keeping worktree %q", ErrUncommittedChanges, inst.WorktreePath)
+	}
+	if err := gitExec.RemoveWorktree(inst.WorktreePath); err != nil {
+		return fmt.Errorf("removing worktree %q: %w", inst.WorktreePath, err)
+	}
+
+	log.Debug(log.CatOrch, "Removed workflow worktree", "subsystem", "supervisor",
+		"workflowID", inst.ID, "path", inst.WorktreePath)
+	return nil
+}
+
+// RuntimeSettings returns the settings the workflow runs with that are not part of its spec.
+func (s *defaultSupervisor) RuntimeSettings(inst *WorkflowInstance) RuntimeSettings {
+	settings := RuntimeSettings{
+		Coordinator:               providerSettings(s.agentProviders.Coordinator()),
+		Worker:                    providerSettings(s.agentProviders.Worker()),
+		WorkerProviders:           maps.Clone(inst.WorkerProviders),
+		MinReadyWorkers:           s.minReadyWorkers,
+		MaxConcurrentReviews:      s.maxConcurrentReviews,
+		RequireReview:             !inst.SkipReview,
+		ConfirmDestructiveActions: s.confirmDestructive,
+		SensitivePaths:            slices.Clone(s.sensitivePaths),
+		WorkerToolReminder:        s.workerToolReminder,
+		SyncBeadsStatus:           s.syncBeadsStatus,
+		WorkerKeepaliveInterval:   s.keepaliveInterval,
+		WorkerKeepaliveMax:        s.keepaliveMax,
+		ReconcileInterval:         s.reconcileInterval,
+		WorkerReadyTimeout:        s.readyTimeout,
+		RespawnUnreadyWorkers:     s.respawnUnready,
+		ReconcilePolicy:           s.reconcilePolicy,
+		StreamBufferSize:          s.streamBufferSize,
+		HandoffThreshold:          s.handoffThreshold,
+		RequirePassingTests:       s.requirePassingTests,
+		TaskPromptLimit:           s.taskPromptLimit,
+		Diagnostics:               s.diagnostics,
+		WorkDir:                   getWorkDir(inst),
+		WorktreePath:              inst.WorktreePath,
+		WorktreeBranch:            inst.WorktreeBranch,
+		MCPPort:                   inst.MCPPort,
+		SessionDir:                inst.SessionDir,
+	}
+	if alternate, ok := s.agentProviders.WorkerAlternate(); ok {
+		alt := providerSettings(alternate)
+		settings.WorkerAlternate = &alt
+	}
+	if s.keepaliveInterval > 0 && s.keepaliveMax <= 0 {
+		settings.WorkerKeepaliveMax = v2.DefaultMaxWorkerKeepalives
+	}
+	if s.reconcileInterval == 0 {
+		settings.ReconcileInterval = v2.DefaultReconcileInterval
+	}
+	if s.readyTimeout == 0 {
+		settings.WorkerReadyTimeout = v2.DefaultReadyTimeout
+	}
+	if s.reconcilePolicy == "" {
+		settings.ReconcilePolicy = v2.ReconcilePolicyLog
+	}
+	if s.streamBufferSize == 0 {
+		settings.StreamBufferSize = client.DefaultStreamBufferSize
+	}
+	if s.capacity != nil {
+		settings.MaxWorkers = max(s.capacity.Capacity(), 0)
+	}
+	return settings
+}
+
+// killProcesses force-stops every process in the workflow and clears
+// coordinator bookkeeping so nothing is redelivered or left assigned.
+func (s *defaultSupervisor) killProcesses(ctx context.Context, inst *WorkflowInstance) {
+	infra := inst.Infrastructure
+
+	// Drop pending messages first so nothing is delivered while stopping
+	if infra.Repositories.QueueRepo != nil {
+		infra.Repositories.QueueRepo.ClearAll()
+	}
+
+	// Force-stop through the processor so repository state and events stay consistent
+	if infra.Repositories.ProcessRepo != nil && infra.Core.Processor != nil {
+		stopCtx, cancel := context.WithTimeout(ctx, KillStopTimeout)
+		for _, proc := range infra.Repositories.ProcessRepo.List() {
+			if proc.Status == repository.StatusStopped || proc.Status.IsTerminal() {
+				continue
+			}
+			stopCmd := command.NewStopProcessCommand(command.SourceUser, proc.ID, true, "workflow killed")
+			if _, err := infra.Core.Processor.SubmitAndWait(stopCtx, stopCmd); err != nil {
+				log.Debug(log.CatOrch, "Failed to force-stop process during kill", "subsystem", "supervisor",
+					"workflowID", inst.ID, "processID", proc.ID, "error", err)
+			}
+		}
+		cancel()
+	}
+
+	// SIGKILL fallback for anything the processor could not stop (e.g., it is wedged)
+	if infra.Internal.ProcessRegistry != nil {
+		for _, live := range infra.Internal.ProcessRegistry.All() {
+			if pid := live.PID(); pid > 0 && osproc.IsAlive(pid) {
+				if err := osproc.Kill(pid); err != nil {
+					log.Debug(log.CatOrch, "Failed to kill process", "subsystem", "supervisor",
+						"workflowID", inst.ID, "processID", live.ID, "pid", pid, "error", err)
+				}
+			}
+			live.SetRetired(true)
+		}
+		infra.Internal.ProcessRegistry.StopAll()
+	}
+
+	// Mark any process the processor did not reach as stopped
+	if infra.Repositories.ProcessRepo != nil {
+		for _, proc := range infra.Repositories.ProcessRepo.List() {
+			if infra.Internal.TurnEnforcer != nil {
+				infra.Internal.TurnEnforcer.CleanupProcess(proc.ID)
+			}
+			if proc.Status == repository.StatusStopped || proc.Status.IsTerminal() {
+				continue
+			}
+			proc.Status = repository.StatusStopped
+			proc.TaskID = ""
+			_ = infra.Repositories.ProcessRepo.Save(proc)
+		}
+	}
+
+	// Remove task assignments; killed workflows cannot hand work back
+	if infra.Repositories.TaskRepo != nil {
+		for _, task := range infra.Repositories.TaskRepo.All() {
+			_ = infra.Repositories.TaskRepo.Delete(task.TaskID)
+		}
+	}
+}
+
 // getWorkDir returns the effective working directory for a workflow.
 // Returns the WorkDir from the instance, or current working directory as fallback.
 func getWorkDir(inst *WorkflowInstance) string {
@@ -1058,6 +1625,9 @@ type workerServerCache struct {
 	servers              map[string]*mcp.WorkerServer
 	mu                   sync.RWMutex
 
+	// diagnostics registers diagnostic tools on each worker server
+	diagnostics bool
+
 	// For attaching worker MCP brokers to session logging
 	session     *session.Session
 	workflowCtx context.Context
@@ -1071,6 +1641,7 @@ func newWorkerServerCache(
 	fabricService *fabric.Service,
 	sess *session.Session,
 	workflowCtx context.Context,
+	diagnostics bool,
 ) *workerServerCache {
 	return &workerServerCache{
 		accountabilityWriter: accountabilityWriter,
@@ -1080,6 +1651,7 @@ func newWorkerServerCache(
 		servers:              make(map[string]*mcp.WorkerServer),
 		session:              sess,
 		workflowCtx:          workflowCtx,
+		diagnostics:          diagnostics,
 	}
 }
 
@@ -1126,6 +1698,9 @@ func (c *workerServerCache) getOrCreate(workerID string) *mcp.WorkerServer {
 	if c.fabricService != nil {
 		ws.SetFabricService(c.fabricService)
 	}
+	if c.diagnostics {
+		ws.EnableDiagnostics()
+	}
 
 	// Attach worker MCP broker to session for mcp_requests.jsonl logging
 	if c.session != nil && c.workflowCtx != nil {
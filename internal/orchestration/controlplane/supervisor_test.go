@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -12,6 +15,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/flags"
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	domaingit "github.com/zjrosen/perles/internal/git/domain"
@@ -20,12 +24,15 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricpersist "github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
 	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+	"github.com/zjrosen/perles/internal/orchestration/mcp"
 	"github.com/zjrosen/perles/internal/orchestration/session"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
@@ -408,6 +415,439 @@ func TestSupervisor_Shutdown_TransitionsPausedToFailed(t *testing.T) {
 	require.Equal(t, WorkflowFailed, inst.State)
 }
 
+func TestSupervisor_AllocateResources_PassesWorkflowCapacity(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	allocator := NewCapacityAllocator(2)
+	cfg.CapacityAllocator = allocator
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := newTestSpec("test-workflow")
+	spec.Priority = 7
+	spec.MaxWorkers = 3
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+
+	capacity, ok := capturedCfg.WorkerCapacity.(*WorkflowCapacity)
+	require.True(t, ok, "WorkerCapacity should be bound to the shared allocator")
+	require.Equal(t, inst.ID, capacity.workflowID)
+	require.Equal(t, 7, capacity.priority)
+	require.Equal(t, 3, capacity.maxWorkers)
+}
+
+func TestSupervisor_AllocateResources_WorkflowMaxWorkersWithoutSharedPool(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := newTestSpec("test-workflow")
+	spec.MaxWorkers = 2
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+
+	capacity, ok := capturedCfg.WorkerCapacity.(*WorkflowCapacity)
+	require.True(t, ok, "the workflow's own limit applies without a shared pool")
+	require.Equal(t, 2, capacity.Free())
+}
+
+func TestSupervisor_AllocateResources_PassesWorkflowLister(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	registry := NewInMemoryRegistry()
+	cfg.InstanceRegistry = registry
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+
+	lister, ok := capturedCfg.WorkflowLister.(*WorkflowLister)
+	require.True(t, ok, "WorkflowLister should be bound to the instance registry")
+	require.Equal(t, inst.ID, lister.current)
+	require.Same(t, registry, lister.registry)
+}
+
+func TestSupervisor_AllocateResources_PassesWorkerProviders(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	reviewerProvider := mocks.NewMockAgentProvider(t)
+	var requested []client.ClientType
+	cfg.WorkerProviderFactory = func(clientType client.ClientType) client.AgentProvider {
+		requested = append(requested, clientType)
+		return reviewerProvider
+	}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := newTestSpec("test-workflow")
+	spec.WorkerProviders = map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientCursor}
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+
+	require.Equal(t, []client.ClientType{client.ClientCursor}, requested)
+	require.Equal(t, map[roles.AgentType]client.AgentProvider{roles.AgentTypeReviewer: reviewerProvider}, capturedCfg.WorkerProviders)
+	require.Equal(t, cfg.AgentProviders, capturedCfg.AgentProviders, "other workers keep the default providers")
+}
+
+func TestSupervisor_AllocateResources_NoWorkerProviders(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.Nil(t, capturedCfg.WorkerProviders)
+}
+
+func TestSupervisor_AllocateResources_PassesConfirmDestructiveActions(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.ConfirmDestructiveActions = true
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.True(t, capturedCfg.ConfirmDestructiveActions)
+}
+
+func TestSupervisor_AllocateResources_WorkerToolReminder(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.WorkerToolReminder = true
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.Equal(t, mcp.WorkerToolHints(), capturedCfg.WorkerToolHints)
+}
+
+func TestSupervisor_AllocateResources_SyncBeadsStatus(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.SyncBeadsStatus = true
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.True(t, capturedCfg.SyncBeadsStatus)
+}
+
+func TestWorkerServerCache_Diagnostics(t *testing.T) {
+	plain := newWorkerServerCache(nil, nil, nil, nil, nil, nil, false)
+	_, ok := plain.getOrCreate("worker-1").GetHandler("get_instructions")
+	require.False(t, ok, "diagnostic tools should not be registered by default")
+
+	diag := newWorkerServerCache(nil, nil, nil, nil, nil, nil, true)
+	_, ok = diag.getOrCreate("worker-1").GetHandler("get_instructions")
+	require.True(t, ok)
+}
+
+func TestSupervisor_AllocateResources_HandoffThreshold(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.HandoffThreshold = 150000
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.Equal(t, 150000, capturedCfg.HandoffThreshold)
+}
+
+func TestSupervisor_AllocateResources_RequirePassingTests(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.RequirePassingTests = true
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.True(t, capturedCfg.RequirePassingTests)
+}
+
+func TestSupervisor_AllocateResources_TaskPromptLimit(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.TaskPromptLimit = prompt.PromptLimit{MaxBytes: 16384}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.Equal(t, prompt.PromptLimit{MaxBytes: 16384, Strategy: prompt.TruncateDescription}, capturedCfg.TaskPromptLimit,
+		"an empty strategy should default to truncating the description")
+}
+
+func TestNewSupervisor_RejectsInvalidTaskPromptStrategy(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	cfg.TaskPromptLimit = prompt.PromptLimit{MaxBytes: 16384, Strategy: "summarize"}
+
+	_, err := NewSupervisor(cfg)
+	require.ErrorContains(t, err, "TaskPromptLimit")
+}
+
+func TestSupervisor_AllocateResources_WorkerKeepalive(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.WorkerKeepaliveInterval = 10 * time.Minute
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.Equal(t, 10*time.Minute, capturedCfg.WorkerKeepaliveInterval)
+	require.Zero(t, capturedCfg.WorkerKeepaliveMax)
+}
+
+func TestSupervisor_AllocateResources_ReconcileAndStreamSettings(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.ReconcileInterval = time.Minute
+	cfg.WorkerReadyTimeout = 2 * time.Minute
+	cfg.RespawnUnreadyWorkers = true
+	cfg.ReconcilePolicy = v2.ReconcilePolicyNotifyCoordinator
+	cfg.StreamBufferSize = 1 << 20
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(newTestSpec("test-workflow"))
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.Equal(t, time.Minute, capturedCfg.ReconcileInterval)
+	require.Equal(t, 2*time.Minute, capturedCfg.ReadyTimeout)
+	require.True(t, capturedCfg.RespawnUnreadyWorkers)
+	require.Equal(t, v2.ReconcilePolicyNotifyCoordinator, capturedCfg.ReconcilePolicyName)
+	require.Equal(t, 1<<20, capturedCfg.StreamBufferSize)
+}
+
+func TestNewSupervisor_RejectsUnknownReconcilePolicy(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	cfg.ReconcilePolicy = "reassign"
+
+	_, err := NewSupervisor(cfg)
+	require.ErrorContains(t, err, "unknown reconcile policy")
+}
+
+func TestSupervisor_AllocateResources_PassesCommitAuthor(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := newTestSpec("test-workflow")
+	spec.CommitAuthor = "perles-worker <workflow@bot>"
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+
+	require.Equal(t, "perles-worker <workflow@bot>", capturedCfg.CommitAuthor)
+}
+
+func TestSupervisor_AllocateResources_PassesSkipReview(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := newTestSpec("test-workflow")
+	spec.SkipReview = true
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+
+	require.True(t, capturedCfg.SkipReview)
+}
+
+func TestSupervisor_AllocateResources_SensitivePathsGetGitExecutorWithoutWorktree(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.SensitivePaths = []string{"auth/"}
+	var gitWorkDir string
+	cfg.GitExecutorFactory = func(workDir string) appgit.GitExecutor {
+		gitWorkDir = workDir
+		return mocks.NewMockGitExecutor(t)
+	}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := newTestSpec("test-workflow")
+	spec.SkipReview = true
+	spec.WorkDir = t.TempDir()
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+
+	require.Equal(t, []string{"auth/"}, capturedCfg.SensitivePaths)
+	require.NotNil(t, capturedCfg.GitExecutor, "sensitive paths are checked against git status")
+	require.Equal(t, spec.WorkDir, gitWorkDir)
+	require.False(t, capturedCfg.CheckpointsEnabled, "checkpoints would commit to the user's work directory")
+}
+
+func TestSupervisor_Shutdown_ReleasesWorkerCapacity(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	allocator := NewCapacityAllocator(2)
+	cfg.CapacityAllocator = allocator
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.State = WorkflowPaused
+	require.NoError(t, allocator.Acquire(context.Background(), inst.ID, 0))
+	require.NoError(t, allocator.Acquire(context.Background(), inst.ID, 0))
+
+	require.NoError(t, supervisor.Shutdown(context.Background(), inst, StopOptions{Reason: "test"}))
+
+	require.Equal(t, 0, allocator.Held(inst.ID))
+	require.Equal(t, 0, allocator.InUse())
+}
+
 func TestSupervisor_Shutdown_WithForce_SkipsGracefulShutdown(t *testing.T) {
 	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
 	supervisor, err := NewSupervisor(cfg)
@@ -433,6 +873,122 @@ func TestSupervisor_Shutdown_WithForce_SkipsGracefulShutdown(t *testing.T) {
 	require.Equal(t, WorkflowFailed, inst.State)
 }
 
+// seedKillableInfrastructure populates infrastructure repositories with a coordinator,
+// a worker holding a task assignment, and a queued message.
+func seedKillableInfrastructure(t *testing.T, infra *v2.Infrastructure) {
+	t.Helper()
+
+	infra.Repositories.TaskRepo = repository.NewMemoryTaskRepository()
+	require.NoError(t, infra.Repositories.ProcessRepo.Save(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusWorking,
+	}))
+	require.NoError(t, infra.Repositories.ProcessRepo.Save(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		TaskID: "perles-abc1.1",
+	}))
+	require.NoError(t, infra.Repositories.TaskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.1",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+	}))
+	_ = infra.Repositories.QueueRepo.GetOrCreate("worker-1").Enqueue("pending", repository.SenderSystem)
+}
+
+func TestSupervisor_Kill_TransitionsToFailedAndClearsState(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.State = WorkflowRunning
+	infra := createMinimalInfrastructure(t)
+	seedKillableInfrastructure(t, infra)
+	inst.Infrastructure = infra
+
+	// Processor is not running, so Kill must fall back to direct cleanup
+	err = supervisor.Kill(context.Background(), inst)
+
+	require.NoError(t, err)
+	require.Equal(t, WorkflowFailed, inst.State)
+	require.Nil(t, inst.Infrastructure)
+
+	// Task assignments and queued messages are gone
+	require.Empty(t, infra.Repositories.TaskRepo.All())
+	require.Equal(t, 0, infra.Repositories.QueueRepo.GetOrCreate("worker-1").Size())
+
+	// Every process is stopped with no task
+	for _, proc := range infra.Repositories.ProcessRepo.List() {
+		require.Equal(t, repository.StatusStopped, proc.Status, "process %s should be stopped", proc.ID)
+		require.Empty(t, proc.TaskID)
+	}
+}
+
+func TestSupervisor_Kill_ForceStopsProcessesViaProcessor(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.State = WorkflowRunning
+	infra := createMinimalInfrastructure(t)
+	seedKillableInfrastructure(t, infra)
+	inst.Infrastructure = infra
+
+	stopHandler := &recordingStopHandler{}
+	infra.Core.Processor.RegisterHandler(command.CmdStopProcess, stopHandler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+
+	err = supervisor.Kill(ctx, inst)
+
+	require.NoError(t, err)
+	require.Equal(t, WorkflowFailed, inst.State)
+
+	// Each active process received a forced stop
+	require.ElementsMatch(t, []string{repository.CoordinatorID, "worker-1"}, stopHandler.stopped)
+	for _, force := range stopHandler.forced {
+		require.True(t, force, "kill should always force-stop")
+	}
+}
+
+func TestSupervisor_Kill_RejectsTerminalState(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.State = WorkflowCompleted
+
+	err = supervisor.Kill(context.Background(), inst)
+
+	require.ErrorIs(t, err, ErrInvalidState)
+	require.Equal(t, WorkflowCompleted, inst.State)
+}
+
+// recordingStopHandler records StopProcess commands it receives.
+type recordingStopHandler struct {
+	mu      sync.Mutex
+	stopped []string
+	forced  []bool
+}
+
+func (h *recordingStopHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	stopCmd := cmd.(*command.StopProcessCommand)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stopped = append(h.stopped, stopCmd.ProcessID)
+	h.forced = append(h.forced, stopCmd.Force)
+	return &command.CommandResult{Success: true}, nil
+}
+
 func TestSupervisor_Shutdown_RejectsTerminalState(t *testing.T) {
 	cfg, _, _ := newTestSupervisorConfig(t)
 	supervisor, err := NewSupervisor(cfg)
@@ -1023,6 +1579,7 @@ func TestSupervisor_Start_CreatesWorktreeWhenEnabled(t *testing.T) {
 	// Setup mock expectations for worktree creation
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(expectedPath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
 		mock.Anything, expectedPath, expectedBranch, "main",
 	).Return(nil)
@@ -1122,6 +1679,7 @@ func TestSupervisor_Start_UsesCustomBranchNameWhenSet(t *testing.T) {
 	// Setup mock expectations - should use custom branch name
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(expectedPath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
 		mock.Anything, expectedPath, customBranch, "develop",
 	).Return(nil)
@@ -1173,6 +1731,7 @@ func TestSupervisor_Start_AutoGeneratesBranchNameWhenEmpty(t *testing.T) {
 	// Setup mock expectations
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(expectedPath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
 		mock.Anything, expectedPath, expectedBranch, "main",
 	).Return(nil)
@@ -1224,41 +1783,178 @@ func TestSupervisor_Start_SetsInstanceFieldsCorrectly(t *testing.T) {
 	// Setup mock expectations
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
 		mock.Anything, worktreePath, branchName, "main",
 	).Return(nil)
 
 	// Setup mock infrastructure
 	infra := createMinimalInfrastructure(t)
-	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).Return(infra, nil)
+	var capturedCfg v2.InfrastructureConfig
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).
+		Run(func(args mock.Arguments) { capturedCfg = args.Get(0).(v2.InfrastructureConfig) }).
+		Return(infra, nil)
 	setupAgentProviderMock(t, mockProvider)
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	go infra.Core.Processor.Run(ctx)
-	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+
+	// Execute Start
+	err = startWorkflow(ctx, supervisor, inst)
+
+	require.NoError(t, err)
+	require.Equal(t, worktreePath, inst.WorktreePath, "WorktreePath should be set")
+	require.Equal(t, branchName, inst.WorktreeBranch, "WorktreeBranch should be set")
+	require.Equal(t, worktreePath, inst.WorkDir, "WorkDir should be overridden to worktree path")
+	require.True(t, capturedCfg.CheckpointsEnabled, "workers can checkpoint to the workflow's worktree")
+}
+
+func TestSupervisor_Start_CleansUpWorktreeOnSubsequentFailure(t *testing.T) {
+	mockGitExecutor := mocks.NewMockGitExecutor(t)
+	mockProvider := mocks.NewMockAgentProvider(t)
+	mockFactory := &mockInfrastructureFactory{}
+
+	cfg := SupervisorConfig{
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: mockProvider,
+		},
+		ListenerFactory:       &mockListenerFactory{},
+		InfrastructureFactory: mockFactory,
+		SessionFactory:        session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		GitExecutorFactory: func(workDir string) appgit.GitExecutor {
+			return mockGitExecutor
+		},
+	}
+
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstanceWithWorktree(t, "cleanup-test", "main", "")
+	cleanupSessionOnTestEnd(t, inst) // Close session before TempDir cleanup (Windows)
+	workflowID := inst.ID.String()
+	worktreePath := "/tmp/worktrees/" + workflowID
+	expectedBranch := "perles-workflow-" + workflowID[:8]
+
+	// Setup mock expectations for successful worktree creation
+	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
+	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
+	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
+		mock.Anything, worktreePath, expectedBranch, "main",
+	).Return(nil)
+
+	// Setup RemoveWorktree expectation - should be called during cleanup
+	mockGitExecutor.EXPECT().RemoveWorktree(worktreePath).Return(nil)
+
+	// Make infrastructure creation fail to trigger cleanup
+	infrastructureErr := errors.New("infrastructure creation failed")
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).Return(nil, infrastructureErr)
+
+	// Execute Start
+	err = startWorkflow(context.Background(), supervisor, inst)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "creating infrastructure")
+	require.Equal(t, WorkflowPending, inst.State) // Should stay in Pending state
+}
+
+func TestSupervisor_Start_HandlesErrBranchAlreadyCheckedOut(t *testing.T) {
+	mockGitExecutor := mocks.NewMockGitExecutor(t)
+	mockProvider := mocks.NewMockAgentProvider(t)
+
+	cfg := SupervisorConfig{
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: mockProvider,
+		},
+		ListenerFactory: &mockListenerFactory{},
+		SessionFactory:  session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		GitExecutorFactory: func(workDir string) appgit.GitExecutor {
+			return mockGitExecutor
+		},
+	}
+
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstanceWithWorktree(t, "branch-error-test", "main", "existing-branch")
+	workflowID := inst.ID.String()
+	worktreePath := "/tmp/worktrees/" + workflowID
+
+	// Setup mock expectations
+	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
+	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
+	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
+		mock.Anything, worktreePath, "existing-branch", "main",
+	).Return(fmt.Errorf("%w: already in use", domaingit.ErrBranchAlreadyCheckedOut))
+
+	// Execute Start
+	err = startWorkflow(context.Background(), supervisor, inst)
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, domaingit.ErrBranchAlreadyCheckedOut)
+	require.Contains(t, err.Error(), "already checked out in another worktree")
+	require.Contains(t, err.Error(), "existing-branch")
+	require.Equal(t, WorkflowPending, inst.State) // Should stay in Pending state
+}
+
+func TestSupervisor_Start_HandlesTimeoutCorrectly(t *testing.T) {
+	mockGitExecutor := mocks.NewMockGitExecutor(t)
+	mockProvider := mocks.NewMockAgentProvider(t)
+
+	cfg := SupervisorConfig{
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: mockProvider,
+		},
+		ListenerFactory: &mockListenerFactory{},
+		SessionFactory:  session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		WorktreeTimeout: 100 * time.Millisecond, // Short timeout for test
+		GitExecutorFactory: func(workDir string) appgit.GitExecutor {
+			return mockGitExecutor
+		},
+	}
+
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstanceWithWorktree(t, "timeout-test", "main", "")
+	workflowID := inst.ID.String()
+	worktreePath := "/tmp/worktrees/" + workflowID
+	expectedBranch := "perles-workflow-" + workflowID[:8]
+
+	// Setup mock expectations - CreateWorktreeWithContext returns timeout error
+	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
+	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
+	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
+		mock.Anything, worktreePath, expectedBranch, "main",
+	).Return(domaingit.ErrWorktreeTimeout)
+	// The timed-out worktree may be partially created, so it and its new branch are removed
+	mockGitExecutor.EXPECT().RemoveWorktree(worktreePath).Return(nil)
+	mockGitExecutor.EXPECT().DeleteBranch(expectedBranch).Return(nil).Once()
 
 	// Execute Start
-	err = startWorkflow(ctx, supervisor, inst)
+	err = startWorkflow(context.Background(), supervisor, inst)
 
-	require.NoError(t, err)
-	require.Equal(t, worktreePath, inst.WorktreePath, "WorktreePath should be set")
-	require.Equal(t, branchName, inst.WorktreeBranch, "WorktreeBranch should be set")
-	require.Equal(t, worktreePath, inst.WorkDir, "WorkDir should be overridden to worktree path")
+	require.Error(t, err)
+	require.ErrorIs(t, err, domaingit.ErrWorktreeTimeout)
+	require.Contains(t, err.Error(), "timed out")
+	require.Equal(t, WorkflowPending, inst.State) // Should stay in Pending state
 }
 
-func TestSupervisor_Start_CleansUpWorktreeOnSubsequentFailure(t *testing.T) {
+func TestSupervisor_AllocateResources_CancelledContextAbortsWorktreeCreation(t *testing.T) {
 	mockGitExecutor := mocks.NewMockGitExecutor(t)
 	mockProvider := mocks.NewMockAgentProvider(t)
-	mockFactory := &mockInfrastructureFactory{}
 
 	cfg := SupervisorConfig{
 		AgentProviders: client.AgentProviders{
 			client.RoleCoordinator: mockProvider,
 		},
-		ListenerFactory:       &mockListenerFactory{},
-		InfrastructureFactory: mockFactory,
-		SessionFactory:        session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		ListenerFactory: &mockListenerFactory{},
+		SessionFactory:  session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		WorktreeTimeout: time.Hour, // Only cancellation can unblock the git command
 		GitExecutorFactory: func(workDir string) appgit.GitExecutor {
 			return mockGitExecutor
 		},
@@ -1267,35 +1963,48 @@ func TestSupervisor_Start_CleansUpWorktreeOnSubsequentFailure(t *testing.T) {
 	supervisor, err := NewSupervisor(cfg)
 	require.NoError(t, err)
 
-	inst := newTestInstanceWithWorktree(t, "cleanup-test", "main", "")
-	cleanupSessionOnTestEnd(t, inst) // Close session before TempDir cleanup (Windows)
+	inst := newTestInstanceWithWorktree(t, "cancel-test", "main", "")
 	workflowID := inst.ID.String()
-	worktreePath := "/tmp/worktrees/" + workflowID
+	worktreePath := filepath.Join(t.TempDir(), workflowID)
 	expectedBranch := "perles-workflow-" + workflowID[:8]
 
-	// Setup mock expectations for successful worktree creation
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Simulate a slow git command that has started populating the worktree and only
+	// returns once its context is cancelled
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
 		mock.Anything, worktreePath, expectedBranch, "main",
-	).Return(nil)
-
-	// Setup RemoveWorktree expectation - should be called during cleanup
-	mockGitExecutor.EXPECT().RemoveWorktree(worktreePath).Return(nil)
+	).RunAndReturn(func(gitCtx context.Context, path, _, _ string) error {
+		require.NoError(t, os.MkdirAll(filepath.Join(path, ".git"), 0o750))
+		cancel()
+		<-gitCtx.Done()
+		return fmt.Errorf("%w: git worktree add", domaingit.ErrWorktreeCancelled)
+	})
+	mockGitExecutor.EXPECT().RemoveWorktree(worktreePath).Return(nil).Once()
+	mockGitExecutor.EXPECT().DeleteBranch(expectedBranch).Return(nil).Once()
 
-	// Make infrastructure creation fail to trigger cleanup
-	infrastructureErr := errors.New("infrastructure creation failed")
-	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).Return(nil, infrastructureErr)
+	done := make(chan error, 1)
+	go func() { done <- supervisor.AllocateResources(ctx, inst) }()
 
-	// Execute Start
-	err = startWorkflow(context.Background(), supervisor, inst)
+	select {
+	case err = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("AllocateResources did not return after context cancellation")
+	}
 
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "creating infrastructure")
-	require.Equal(t, WorkflowPending, inst.State) // Should stay in Pending state
+	require.ErrorIs(t, err, domaingit.ErrWorktreeCancelled)
+	require.Contains(t, err.Error(), "workflow start cancelled")
+	require.NoDirExists(t, worktreePath, "partially-created worktree directory should be removed")
+	require.Empty(t, inst.WorktreePath)
+	require.Equal(t, WorkflowPending, inst.State)
+	mockGitExecutor.AssertNumberOfCalls(t, "PruneWorktrees", 2)
 }
 
-func TestSupervisor_Start_HandlesErrBranchAlreadyCheckedOut(t *testing.T) {
+func TestSupervisor_AllocateResources_CancelledCreationKeepsPreexistingDirAndBranch(t *testing.T) {
 	mockGitExecutor := mocks.NewMockGitExecutor(t)
 	mockProvider := mocks.NewMockAgentProvider(t)
 
@@ -1313,28 +2022,33 @@ func TestSupervisor_Start_HandlesErrBranchAlreadyCheckedOut(t *testing.T) {
 	supervisor, err := NewSupervisor(cfg)
 	require.NoError(t, err)
 
-	inst := newTestInstanceWithWorktree(t, "branch-error-test", "main", "existing-branch")
+	inst := newTestInstanceWithWorktree(t, "preexisting-test", "main", "feature/existing")
 	workflowID := inst.ID.String()
-	worktreePath := "/tmp/worktrees/" + workflowID
+	// The directory was there before the workflow started, e.g. the user's own checkout
+	worktreePath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "notes.txt"), []byte("mine"), 0o600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
 
-	// Setup mock expectations
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists("feature/existing").Return(true)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
-		mock.Anything, worktreePath, "existing-branch", "main",
-	).Return(fmt.Errorf("%w: already in use", domaingit.ErrBranchAlreadyCheckedOut))
+		mock.Anything, worktreePath, "feature/existing", "main",
+	).RunAndReturn(func(gitCtx context.Context, _, _, _ string) error {
+		return gitCtx.Err()
+	})
 
-	// Execute Start
-	err = startWorkflow(context.Background(), supervisor, inst)
+	err = supervisor.AllocateResources(ctx, inst)
 
-	require.Error(t, err)
-	require.ErrorIs(t, err, domaingit.ErrBranchAlreadyCheckedOut)
-	require.Contains(t, err.Error(), "already checked out in another worktree")
-	require.Contains(t, err.Error(), "existing-branch")
-	require.Equal(t, WorkflowPending, inst.State) // Should stay in Pending state
+	require.ErrorIs(t, err, context.Canceled)
+	require.FileExists(t, filepath.Join(worktreePath, "notes.txt"), "a directory this attempt did not create must be kept")
+	mockGitExecutor.AssertNotCalled(t, "RemoveWorktree", mock.Anything)
+	mockGitExecutor.AssertNotCalled(t, "DeleteBranch", mock.Anything)
 }
 
-func TestSupervisor_Start_HandlesTimeoutCorrectly(t *testing.T) {
+func TestSupervisor_AllocateResources_AlreadyCancelledContextReportsCancellation(t *testing.T) {
 	mockGitExecutor := mocks.NewMockGitExecutor(t)
 	mockProvider := mocks.NewMockAgentProvider(t)
 
@@ -1344,7 +2058,6 @@ func TestSupervisor_Start_HandlesTimeoutCorrectly(t *testing.T) {
 		},
 		ListenerFactory: &mockListenerFactory{},
 		SessionFactory:  session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
-		WorktreeTimeout: 100 * time.Millisecond, // Short timeout for test
 		GitExecutorFactory: func(workDir string) appgit.GitExecutor {
 			return mockGitExecutor
 		},
@@ -1353,25 +2066,30 @@ func TestSupervisor_Start_HandlesTimeoutCorrectly(t *testing.T) {
 	supervisor, err := NewSupervisor(cfg)
 	require.NoError(t, err)
 
-	inst := newTestInstanceWithWorktree(t, "timeout-test", "main", "")
+	inst := newTestInstanceWithWorktree(t, "cancelled-test", "main", "")
 	workflowID := inst.ID.String()
-	worktreePath := "/tmp/worktrees/" + workflowID
-	expectedBranch := "perles-workflow-" + workflowID[:8]
+	worktreePath := filepath.Join(t.TempDir(), workflowID)
 
-	// Setup mock expectations - CreateWorktreeWithContext returns timeout error
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The executor sees a cancelled context and fails with the raw context error
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
-		mock.Anything, worktreePath, expectedBranch, "main",
-	).Return(domaingit.ErrWorktreeTimeout)
+		mock.Anything, worktreePath, mock.Anything, "main",
+	).RunAndReturn(func(gitCtx context.Context, _, _, _ string) error {
+		return gitCtx.Err()
+	})
+	mockGitExecutor.EXPECT().RemoveWorktree(worktreePath).Return(nil).Once()
+	mockGitExecutor.EXPECT().DeleteBranch(mock.Anything).Return(nil).Once()
 
-	// Execute Start
-	err = startWorkflow(context.Background(), supervisor, inst)
+	err = supervisor.AllocateResources(ctx, inst)
 
-	require.Error(t, err)
-	require.ErrorIs(t, err, domaingit.ErrWorktreeTimeout)
-	require.Contains(t, err.Error(), "timed out")
-	require.Equal(t, WorkflowPending, inst.State) // Should stay in Pending state
+	require.ErrorIs(t, err, context.Canceled)
+	require.Contains(t, err.Error(), "workflow start cancelled")
+	require.NotContains(t, err.Error(), "timed out")
 }
 
 // === Unit Tests: Stop() with Worktree Cleanup ===
@@ -1415,6 +2133,49 @@ func TestSupervisor_Shutdown_ReturnsErrUncommittedChanges(t *testing.T) {
 	require.Equal(t, WorkflowRunning, inst.State) // Should NOT transition
 }
 
+func TestSupervisor_RemoveWorktree(t *testing.T) {
+	mockGitExecutor := mocks.NewMockGitExecutor(t)
+
+	cfg := SupervisorConfig{
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: mocks.NewMockAgentProvider(t),
+		},
+		ListenerFactory: &mockListenerFactory{},
+		SessionFactory:  session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		GitExecutorFactory: func(workDir string) appgit.GitExecutor {
+			return mockGitExecutor
+		},
+	}
+
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	worktreePath := t.TempDir()
+	inst := newTestInstance(t, "remove-worktree-test")
+	inst.State = WorkflowFailed
+	inst.WorktreeMode = WorktreeModeNew
+	inst.WorktreePath = worktreePath
+
+	// Dirty worktrees are kept
+	mockGitExecutor.EXPECT().HasUncommittedChanges().Return(true, nil).Once()
+	err = supervisor.RemoveWorktree(context.Background(), inst)
+	require.ErrorIs(t, err, ErrUncommittedChanges)
+
+	// Clean worktrees are removed
+	mockGitExecutor.EXPECT().HasUncommittedChanges().Return(false, nil).Once()
+	mockGitExecutor.EXPECT().RemoveWorktree(worktreePath).Return(nil).Once()
+	require.NoError(t, supervisor.RemoveWorktree(context.Background(), inst))
+
+	// User-owned worktrees are never touched
+	inst.WorktreeMode = WorktreeModeExisting
+	require.NoError(t, supervisor.RemoveWorktree(context.Background(), inst))
+
+	// Worktrees of live workflows are not removed
+	inst.WorktreeMode = WorktreeModeNew
+	inst.State = WorkflowPaused
+	require.ErrorIs(t, supervisor.RemoveWorktree(context.Background(), inst), ErrInvalidState)
+}
+
 func TestSupervisor_Shutdown_BypassesUncommittedCheckWhenForceTrue(t *testing.T) {
 	mockGitExecutor := mocks.NewMockGitExecutor(t)
 	mockProvider := mocks.NewMockAgentProvider(t)
@@ -1454,6 +2215,67 @@ func TestSupervisor_Shutdown_BypassesUncommittedCheckWhenForceTrue(t *testing.T)
 	mockGitExecutor.AssertNotCalled(t, "HasUncommittedChanges")
 }
 
+func TestSupervisor_Shutdown_RestoresWorktreeStash(t *testing.T) {
+	const stashRef = "0123456789abcdef0123456789abcdef01234567"
+
+	newSupervisor := func(t *testing.T) (Supervisor, *mocks.MockGitExecutor) {
+		mockGitExecutor := mocks.NewMockGitExecutor(t)
+		cfg := SupervisorConfig{
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: mocks.NewMockAgentProvider(t),
+			},
+			ListenerFactory: &mockListenerFactory{},
+			SessionFactory:  session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+			GitExecutorFactory: func(workDir string) appgit.GitExecutor {
+				return mockGitExecutor
+			},
+		}
+		supervisor, err := NewSupervisor(cfg)
+		require.NoError(t, err)
+		return supervisor, mockGitExecutor
+	}
+	newStashedInstance := func(t *testing.T) *WorkflowInstance {
+		inst := newTestInstance(t, "stash-restore-test")
+		inst.State = WorkflowRunning
+		inst.WorktreeMode = WorktreeModeExisting
+		inst.WorktreePath = "/tmp/worktree-stash"
+		inst.WorktreeStashRef = stashRef
+		return inst
+	}
+
+	t.Run("graceful stop applies the stash", func(t *testing.T) {
+		supervisor, mockGitExecutor := newSupervisor(t)
+		inst := newStashedInstance(t)
+		mockGitExecutor.EXPECT().HasUncommittedChanges().Return(false, nil).Once()
+		mockGitExecutor.EXPECT().StashApply(stashRef).Return(nil).Once()
+
+		require.NoError(t, supervisor.Shutdown(context.Background(), inst, StopOptions{Reason: "test stop"}))
+		require.Equal(t, WorkflowFailed, inst.State)
+		require.Empty(t, inst.WorktreeStashRef, "a restored stash should be cleared")
+	})
+
+	t.Run("failed apply keeps the stash ref", func(t *testing.T) {
+		supervisor, mockGitExecutor := newSupervisor(t)
+		inst := newStashedInstance(t)
+		mockGitExecutor.EXPECT().HasUncommittedChanges().Return(false, nil).Once()
+		mockGitExecutor.EXPECT().StashApply(stashRef).Return(errors.New("conflict")).Once()
+
+		require.NoError(t, supervisor.Shutdown(context.Background(), inst, StopOptions{Reason: "test stop"}))
+		require.Equal(t, WorkflowFailed, inst.State)
+		require.Equal(t, stashRef, inst.WorktreeStashRef)
+	})
+
+	t.Run("forced stop keeps the stash", func(t *testing.T) {
+		supervisor, mockGitExecutor := newSupervisor(t)
+		inst := newStashedInstance(t)
+
+		require.NoError(t, supervisor.Shutdown(context.Background(), inst, StopOptions{Reason: "test stop", Force: true}))
+		require.Equal(t, WorkflowFailed, inst.State)
+		require.Equal(t, stashRef, inst.WorktreeStashRef)
+		mockGitExecutor.AssertNotCalled(t, "StashApply", mock.Anything)
+	})
+}
+
 // === Session Factory Integration Tests ===
 
 func TestSupervisor_Start_CreatesSessionWhenFactoryConfigured(t *testing.T) {
@@ -1813,6 +2635,65 @@ func TestSupervisor_SpawnCoordinator_SpawnsObserverWhenEnabled(t *testing.T) {
 	require.Equal(t, 2, spawnCalls, "Should spawn both coordinator and observer")
 }
 
+func TestSupervisor_SpawnCoordinator_ReconcilesWithTracker(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(`status = in_progress and id ~ "feat1-"`).Return([]beads.Issue{
+		{ID: "feat1-abc.1", Status: beads.StatusInProgress},
+	}, nil).Once()
+	cfg.Tracker = tracker
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "reconcile-test")
+	inst.BeadsPrefix = "feat1"
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	infra.Repositories.TaskRepo = repository.NewMemoryTaskRepository()
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+
+	require.NoError(t, startWorkflow(ctx, supervisor, inst))
+	require.Equal(t, WorkflowRunning, inst.State)
+}
+
+func TestSupervisor_Resume_ReconcilesWithTracker(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(`status = in_progress and id ~ "feat1-"`).Return(nil, nil).Once()
+	cfg.Tracker = tracker
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "reconcile-resume-test")
+	inst.State = WorkflowPaused
+	inst.BeadsPrefix = "feat1"
+
+	infra := createMinimalInfrastructure(t)
+	infra.Repositories.TaskRepo = repository.NewMemoryTaskRepository()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+	inst.Infrastructure = infra
+	inst.Ctx = ctx
+
+	require.NoError(t, infra.Repositories.ProcessRepo.Save(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusPaused,
+	}))
+	infra.Core.Processor.RegisterHandler(command.CmdResumeProcess, &handlerTracker{})
+
+	require.NoError(t, supervisor.Resume(ctx, inst))
+}
+
 func TestSupervisor_SpawnCoordinator_SkipsObserverWhenDisabled(t *testing.T) {
 	mockCoordinatorProvider := mocks.NewMockAgentProvider(t)
 	mockFactory := &mockInfrastructureFactory{}
@@ -2081,6 +2962,51 @@ func TestSupervisor_RestoreFabricState_RestoresChannelsAndMessages(t *testing.T)
 	require.Equal(t, "Test message for restore", messages[0].Content)
 }
 
+func TestSupervisor_RestoreCoordinatorState_ImportsShutdownSnapshot(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+	ds := supervisor.(*defaultSupervisor)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.SessionDir = t.TempDir()
+	inst.Infrastructure = createMinimalInfrastructure(t)
+	processRepo := inst.Infrastructure.Repositories.ProcessRepo
+	taskRepo := repository.NewMemoryTaskRepository()
+	inst.Infrastructure.Core.Processor.RegisterHandler(command.CmdImportState,
+		handler.NewImportStateHandler(processRepo, taskRepo))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go inst.Infrastructure.Core.Processor.Run(ctx)
+	require.NoError(t, inst.Infrastructure.Core.Processor.WaitForReady(ctx))
+
+	// No snapshot: nothing to restore
+	require.NoError(t, ds.restoreCoordinatorState(ctx, inst))
+
+	// Snapshot written at shutdown, after the worker was restored from session metadata
+	require.NoError(t, processRepo.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady}))
+	sess, err := session.New(string(inst.ID), inst.SessionDir)
+	require.NoError(t, err)
+	_, err = sess.WriteCoordinatorState([]byte(`{
+		"version": 1,
+		"worker_assignments": [{"worker_id": "worker-1", "task_id": "perles-abc.1", "phase": "implementing"}],
+		"task_assignments": [{"task_id": "perles-abc.1", "status": "implementing", "implementer": "worker-1"}],
+		"global_instructions": []
+	}`))
+	require.NoError(t, err)
+	require.NoError(t, sess.Close(session.StatusCompleted))
+
+	require.NoError(t, ds.restoreCoordinatorState(ctx, inst))
+
+	task, err := taskRepo.Get("perles-abc.1")
+	require.NoError(t, err)
+	require.Equal(t, "worker-1", task.Implementer)
+	worker, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, "perles-abc.1", worker.TaskID)
+}
+
 func TestSupervisor_RestoreFabricState_NilFabricService(t *testing.T) {
 	cfg, _, _ := newTestSupervisorConfig(t)
 	supervisor, err := NewSupervisor(cfg)
@@ -2198,7 +3124,7 @@ func TestSupervisor_AllocateResources_ExistingMode_EmptyPath_ReturnsError(t *tes
 	require.Equal(t, WorkflowPending, inst.State)
 }
 
-func TestSupervisor_AllocateResources_ExistingMode_DirtyWorktree_LogsWarningButSucceeds(t *testing.T) {
+func TestSupervisor_AllocateResources_ExistingMode_DirtyWorktree_ProceedPolicy_LogsWarningButSucceeds(t *testing.T) {
 	mockGitExecutor := mocks.NewMockGitExecutor(t)
 	mockProvider := mocks.NewMockAgentProvider(t)
 	mockFactory := &mockInfrastructureFactory{}
@@ -2227,6 +3153,8 @@ func TestSupervisor_AllocateResources_ExistingMode_DirtyWorktree_LogsWarningButS
 		Name:          "dirty-worktree-test",
 		WorktreeMode:  WorktreeModeExisting,
 		WorktreePath:  existingWorktreePath,
+		// Explicit override: use the dirty worktree as-is
+		DirtyWorktreePolicy: DirtyWorktreeProceed,
 	}
 	inst, err := NewWorkflowInstance(spec)
 	require.NoError(t, err)
@@ -2245,13 +3173,118 @@ func TestSupervisor_AllocateResources_ExistingMode_DirtyWorktree_LogsWarningButS
 	go infra.Core.Processor.Run(ctx)
 	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
 
-	// Should succeed despite dirty worktree (warning only, does not block)
+	// Should succeed despite dirty worktree (warning only with the proceed policy)
 	err = supervisor.AllocateResources(ctx, inst)
 
 	require.NoError(t, err, "Dirty worktree should log warning but not return error")
 	require.Equal(t, existingWorktreePath, inst.WorkDir)
 }
 
+func TestSupervisor_AllocateResources_ExistingMode_DirtyWorktree_RefusesByDefault(t *testing.T) {
+	mockGitExecutor := mocks.NewMockGitExecutor(t)
+	cfg, _, mockFactory := newTestSupervisorConfig(t)
+	cfg.GitExecutorFactory = func(workDir string) appgit.GitExecutor {
+		return mockGitExecutor
+	}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := &WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Test goal",
+		Name:          "dirty-worktree-refuse-test",
+		WorktreeMode:  WorktreeModeExisting,
+		WorktreePath:  t.TempDir(),
+	}
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+
+	mockGitExecutor.EXPECT().HasUncommittedChanges().Return(true, nil)
+
+	err = supervisor.AllocateResources(context.Background(), inst)
+
+	require.ErrorIs(t, err, ErrUncommittedChanges)
+	require.Equal(t, WorkflowPending, inst.State)
+	require.Empty(t, inst.WorktreeStashRef)
+	mockGitExecutor.AssertNotCalled(t, "StashPush", mock.Anything)
+	mockFactory.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestSupervisor_AllocateResources_ExistingMode_DirtyWorktree_StashPolicy_RecordsStashRef(t *testing.T) {
+	mockGitExecutor := mocks.NewMockGitExecutor(t)
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.GitExecutorFactory = func(workDir string) appgit.GitExecutor {
+		return mockGitExecutor
+	}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	existingWorktreePath := t.TempDir()
+	spec := &WorkflowSpec{
+		TemplateID:          "test-template",
+		InitialPrompt:       "Test goal",
+		Name:                "dirty-worktree-stash-test",
+		WorktreeMode:        WorktreeModeExisting,
+		WorktreePath:        existingWorktreePath,
+		DirtyWorktreePolicy: DirtyWorktreeStash,
+	}
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	cleanupSessionOnTestEnd(t, inst)
+
+	const stashRef = "0123456789abcdef0123456789abcdef01234567"
+	mockGitExecutor.EXPECT().HasUncommittedChanges().Return(true, nil)
+	mockGitExecutor.EXPECT().StashPush(mock.MatchedBy(func(msg string) bool {
+		return strings.Contains(msg, inst.ID.String())
+	})).Return(stashRef, nil).Once()
+
+	infra := createMinimalInfrastructure(t)
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).Return(infra, nil)
+	setupAgentProviderMock(t, mockProvider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+
+	err = supervisor.AllocateResources(ctx, inst)
+
+	require.NoError(t, err)
+	require.Equal(t, stashRef, inst.WorktreeStashRef)
+	require.Equal(t, existingWorktreePath, inst.WorkDir)
+}
+
+func TestSupervisor_AllocateResources_ExistingMode_DirtyWorktree_StashFailure_ReturnsError(t *testing.T) {
+	mockGitExecutor := mocks.NewMockGitExecutor(t)
+	cfg, _, mockFactory := newTestSupervisorConfig(t)
+	cfg.GitExecutorFactory = func(workDir string) appgit.GitExecutor {
+		return mockGitExecutor
+	}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	spec := &WorkflowSpec{
+		TemplateID:          "test-template",
+		InitialPrompt:       "Test goal",
+		Name:                "dirty-worktree-stash-failure-test",
+		WorktreeMode:        WorktreeModeExisting,
+		WorktreePath:        t.TempDir(),
+		DirtyWorktreePolicy: DirtyWorktreeStash,
+	}
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+
+	mockGitExecutor.EXPECT().HasUncommittedChanges().Return(true, nil)
+	mockGitExecutor.EXPECT().StashPush(mock.Anything).Return("", errors.New("cannot stash")).Once()
+
+	err = supervisor.AllocateResources(context.Background(), inst)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "stashing uncommitted changes")
+	require.Empty(t, inst.WorktreeStashRef)
+	mockFactory.AssertNotCalled(t, "Create", mock.Anything)
+}
+
 func TestSupervisor_AllocateResources_NewMode_BehavesIdenticallyToLegacy(t *testing.T) {
 	mockGitExecutor := mocks.NewMockGitExecutor(t)
 	mockProvider := mocks.NewMockAgentProvider(t)
@@ -2291,6 +3324,7 @@ func TestSupervisor_AllocateResources_NewMode_BehavesIdenticallyToLegacy(t *test
 	// Setup mock expectations for worktree creation (same as legacy path)
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(expectedPath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
 		mock.Anything, expectedPath, expectedBranch, "main",
 	).Return(nil)
@@ -2457,6 +3491,7 @@ func TestSupervisor_AllocateResources_CleanupClosure_RemovesNewWorktree(t *testi
 	// Setup mock expectations for successful worktree creation
 	mockGitExecutor.EXPECT().PruneWorktrees().Return(nil)
 	mockGitExecutor.EXPECT().DetermineWorktreePath(workflowID).Return(worktreePath, nil)
+	mockGitExecutor.EXPECT().BranchExists(mock.Anything).Return(false)
 	mockGitExecutor.EXPECT().CreateWorktreeWithContext(
 		mock.Anything, worktreePath, expectedBranch, "main",
 	).Return(nil)
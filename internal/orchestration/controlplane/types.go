@@ -13,12 +13,16 @@ import (
 
 	"github.com/google/uuid"
 
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricpersist "github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
 	"github.com/zjrosen/perles/internal/orchestration/mcp"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/session"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
 // WorkflowID uniquely identifies a workflow instance.
@@ -142,6 +146,19 @@ const (
 	WorktreeModeExisting WorktreeMode = "existing"
 )
 
+// DirtyWorktreePolicy specifies what happens when an existing worktree has uncommitted
+// changes at allocation time. The zero value refuses to use the worktree.
+type DirtyWorktreePolicy string
+
+const (
+	// DirtyWorktreeRefuse fails allocation so workers cannot clobber the changes (the default zero value).
+	DirtyWorktreeRefuse DirtyWorktreePolicy = ""
+	// DirtyWorktreeStash stashes the changes under a labeled stash before workers start.
+	DirtyWorktreeStash DirtyWorktreePolicy = "stash"
+	// DirtyWorktreeProceed uses the worktree as-is, leaving the changes in place.
+	DirtyWorktreeProceed DirtyWorktreePolicy = "proceed"
+)
+
 // WorkflowSpec defines parameters for creating a new workflow instance.
 // It captures all the information needed to initialize and start a workflow.
 type WorkflowSpec struct {
@@ -182,6 +199,11 @@ type WorkflowSpec struct {
 	// Only used when WorktreeMode is WorktreeModeExisting.
 	WorktreePath string
 
+	// DirtyWorktreePolicy decides how uncommitted changes in the pre-existing worktree
+	// are handled. Only used when WorktreeMode is WorktreeModeExisting.
+	// See DirtyWorktreeRefuse, DirtyWorktreeStash, DirtyWorktreeProceed.
+	DirtyWorktreePolicy DirtyWorktreePolicy
+
 	// WorktreeBaseBranch is the branch to base the worktree on (e.g., "main", "develop").
 	// Required when WorktreeEnabled is true.
 	WorktreeBaseBranch string
@@ -189,6 +211,53 @@ type WorkflowSpec struct {
 	// WorktreeBranchName is an optional custom branch name for the worktree.
 	// If empty, a branch name will be auto-generated based on workflow ID.
 	WorktreeBranchName string
+
+	// Priority ranks this workflow for shared worker capacity.
+	// Higher values are granted worker slots first when the pool is full.
+	// Zero is normal priority; negative values yield to other workflows.
+	Priority int
+
+	// CommitAuthor attributes commits made by workers, in "Name <email>" format.
+	// If empty, workers commit with the user's git identity.
+	CommitAuthor string
+
+	// SkipReview sends completed tasks straight to committing without review.
+	// Set from the template's require_review: false.
+	SkipReview bool
+
+	// WorkerProviders picks the provider for workers spawned as a given agent type,
+	// e.g. implementers on cursor and reviewers on claude for cross-provider review.
+	// Set from the template's worker_providers. Agent types not listed use the
+	// workflow's worker provider.
+	WorkerProviders map[roles.AgentType]client.ClientType
+
+	// BeadsPrefix namespaces the workflow's bd task IDs (e.g. "feat1" gives "feat1-abc.1")
+	// so workflows sharing one tracker do not intermix tasks. The coordinator only accepts
+	// task IDs under this prefix. If empty, the tracker's default prefix is used.
+	BeadsPrefix string
+
+	// MaxWorkflowDuration bounds how long the workflow may run, measured from when it
+	// first started. When exceeded, the control plane interrupts the workers, tells the
+	// coordinator and fails the workflow. Zero means unlimited.
+	MaxWorkflowDuration time.Duration
+
+	// MaxWorkers caps how many workers this workflow may hold at once, within the
+	// worker pool shared across workflows. Zero means only the shared pool applies.
+	MaxWorkers int
+}
+
+// ParseWorkerProviders converts a template's worker_providers (agent type -> provider
+// name) into WorkflowSpec.WorkerProviders. Returns nil when no providers are given.
+// Names are not checked here; WorkflowSpec.Validate rejects unknown agent types and providers.
+func ParseWorkerProviders(providers map[string]string) map[roles.AgentType]client.ClientType {
+	if len(providers) == 0 {
+		return nil
+	}
+	parsed := make(map[roles.AgentType]client.ClientType, len(providers))
+	for agentType, provider := range providers {
+		parsed[roles.AgentType(agentType)] = client.ClientType(provider)
+	}
+	return parsed
 }
 
 // Validate checks that the WorkflowSpec has all required fields
@@ -200,6 +269,40 @@ func (s *WorkflowSpec) Validate() error {
 	if s.InitialPrompt == "" {
 		return fmt.Errorf("initial_prompt is required")
 	}
+	if s.CommitAuthor != "" {
+		if _, err := domaingit.ParseAuthor(s.CommitAuthor); err != nil {
+			return fmt.Errorf("commit_author: %w", err)
+		}
+	}
+	if s.BeadsPrefix != "" && !validation.IsValidTaskIDPrefix(s.BeadsPrefix) {
+		return fmt.Errorf("invalid beads_prefix: %q", s.BeadsPrefix)
+	}
+	if s.MaxWorkflowDuration < 0 {
+		return fmt.Errorf("max_workflow_duration must not be negative")
+	}
+	if s.MaxWorkers < 0 {
+		return fmt.Errorf("max_workers must not be negative")
+	}
+	if s.BeadsPrefix != "" && s.EpicID != "" && !validation.IsValidTaskIDWithPrefix(s.EpicID, s.BeadsPrefix) {
+		return fmt.Errorf("epic_id %s is outside beads_prefix %q", s.EpicID, s.BeadsPrefix)
+	}
+	for agentType, provider := range s.WorkerProviders {
+		if agentType == roles.AgentTypeGeneric || !agentType.IsValid() {
+			return fmt.Errorf("worker_providers: invalid agent type %q", agentType)
+		}
+		if provider == "" {
+			return fmt.Errorf("worker_providers: provider for %s is required", agentType)
+		}
+		if !provider.IsKnown() {
+			return fmt.Errorf("worker_providers: unknown provider %q for %s (expected one of %v)",
+				provider, agentType, client.KnownClientTypes())
+		}
+	}
+	switch s.DirtyWorktreePolicy {
+	case DirtyWorktreeRefuse, DirtyWorktreeStash, DirtyWorktreeProceed:
+	default:
+		return fmt.Errorf("invalid dirty_worktree_policy: %q", s.DirtyWorktreePolicy)
+	}
 	return nil
 }
 
@@ -216,6 +319,18 @@ type WorkflowInstance struct {
 	WorkDir       string // Working directory for the workflow
 	InitialPrompt string // Initial prompt for the coordinator
 	EpicID        string // Beads epic ID associated with this workflow (optional)
+	Priority      int    // Scheduling priority for shared worker capacity (higher first)
+	CommitAuthor  string // Git author for worker commits (optional, "Name <email>")
+	SkipReview    bool   // Completed tasks commit without review (template require_review: false)
+	BeadsPrefix   string // bd ID prefix the workflow's tasks are namespaced under (optional)
+
+	// MaxWorkflowDuration is how long the workflow may run before it is failed (0 = unlimited)
+	MaxWorkflowDuration time.Duration
+	// MaxWorkers is how many workers the workflow may hold at once (0 = only the shared pool applies)
+	MaxWorkers int
+
+	// WorkerProviders maps agent types to the provider their workers use (from WorkflowSpec)
+	WorkerProviders map[roles.AgentType]client.ClientType
 
 	// Worktree configuration (from WorkflowSpec)
 	WorktreeEnabled    bool         // Whether worktree was requested (derived from WorktreeMode)
@@ -223,9 +338,13 @@ type WorkflowInstance struct {
 	WorktreeBaseBranch string       // Branch to base worktree on
 	WorktreeBranchName string       // Custom branch name (may be empty)
 
+	// DirtyWorktreePolicy handles uncommitted changes in an existing worktree (from WorkflowSpec)
+	DirtyWorktreePolicy DirtyWorktreePolicy
+
 	// Worktree state (set by Supervisor.AllocateResources() when worktree is created)
-	WorktreePath   string // Path to created worktree (empty if not using worktree)
-	WorktreeBranch string // Actual branch name (auto-generated or custom)
+	WorktreePath     string // Path to created worktree (empty if not using worktree)
+	WorktreeBranch   string // Actual branch name (auto-generated or custom)
+	WorktreeStashRef string // Stash commit holding changes found in an existing worktree; applied and cleared by a graceful stop
 
 	// Session storage path (for file-based session logs in ~/.perles/sessions/)
 	// Set by Supervisor.AllocateResources() when the session is created.
@@ -305,6 +424,15 @@ func NewWorkflowInstance(spec *WorkflowSpec) (*WorkflowInstance, error) {
 		WorkDir:       spec.WorkDir,
 		InitialPrompt: spec.InitialPrompt,
 		EpicID:        spec.EpicID,
+		Priority:      spec.Priority,
+		CommitAuthor:  spec.CommitAuthor,
+		SkipReview:    spec.SkipReview,
+		BeadsPrefix:   spec.BeadsPrefix,
+		// Safety valve from spec
+		MaxWorkflowDuration: spec.MaxWorkflowDuration,
+		MaxWorkers:          spec.MaxWorkers,
+		// Worker providers per agent type from spec
+		WorkerProviders: maps.Clone(spec.WorkerProviders),
 		// Worktree configuration from spec
 		WorktreeEnabled:    worktreeEnabled,
 		WorktreeMode:       spec.WorktreeMode,
@@ -320,6 +448,7 @@ func NewWorkflowInstance(spec *WorkflowSpec) (*WorkflowInstance, error) {
 	if spec.WorktreeMode == WorktreeModeExisting {
 		inst.WorktreePath = spec.WorktreePath
 		inst.WorkDir = spec.WorktreePath
+		inst.DirtyWorktreePolicy = spec.DirtyWorktreePolicy
 	}
 
 	return inst, nil
@@ -392,3 +521,14 @@ func (w *WorkflowInstance) TokenMetrics() *metrics.TokenMetrics {
 		TotalTokens: int(w.TokensUsed),
 	}
 }
+
+// TaskSummary is a read-only view of a task assignment within a workflow,
+// used to build cross-workflow task listings.
+type TaskSummary struct {
+	TaskID      string
+	Status      string    // Task assignment status (implementing, in_review, ...)
+	Implementer string    // Worker implementing the task
+	Reviewer    string    // Worker reviewing the task (empty if none)
+	Phase       string    // Implementer's current process phase (empty if unknown)
+	StartedAt   time.Time // When the task was first assigned
+}
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 )
 
 // === WorkflowID Tests ===
@@ -230,6 +234,138 @@ func TestWorkflowSpec_Validate_RequiredFields(t *testing.T) {
 
 // === WorkflowInstance Tests ===
 
+func TestWorkflowSpec_Validate_CommitAuthor(t *testing.T) {
+	spec := &WorkflowSpec{
+		TemplateID:    "cook.md",
+		InitialPrompt: "Implement feature X",
+		CommitAuthor:  "perles-worker <workflow@bot>",
+	}
+	require.NoError(t, spec.Validate())
+
+	spec.CommitAuthor = "perles-worker"
+	err := spec.Validate()
+	require.ErrorIs(t, err, domaingit.ErrInvalidAuthor)
+	require.Contains(t, err.Error(), "commit_author")
+}
+
+func TestNewWorkflowInstance_CopiesCommitAuthor(t *testing.T) {
+	inst, err := NewWorkflowInstance(&WorkflowSpec{
+		TemplateID:    "cook.md",
+		InitialPrompt: "Implement feature X",
+		CommitAuthor:  "perles-worker <workflow@bot>",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "perles-worker <workflow@bot>", inst.CommitAuthor)
+}
+
+func TestWorkflowSpec_Validate_WorkerProviders(t *testing.T) {
+	spec := &WorkflowSpec{
+		TemplateID:    "cook.md",
+		InitialPrompt: "Implement feature X",
+		WorkerProviders: map[roles.AgentType]client.ClientType{
+			roles.AgentTypeImplementer: client.ClientCursor,
+			roles.AgentTypeReviewer:    client.ClientClaude,
+		},
+	}
+	require.NoError(t, spec.Validate())
+
+	spec.WorkerProviders = map[roles.AgentType]client.ClientType{roles.AgentTypeGeneric: client.ClientClaude}
+	require.ErrorContains(t, spec.Validate(), `worker_providers: invalid agent type "generic"`)
+
+	spec.WorkerProviders = map[roles.AgentType]client.ClientType{"tester": client.ClientClaude}
+	require.ErrorContains(t, spec.Validate(), `worker_providers: invalid agent type "tester"`)
+
+	spec.WorkerProviders = map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: ""}
+	require.ErrorContains(t, spec.Validate(), "worker_providers: provider for reviewer is required")
+
+	spec.WorkerProviders = map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: "claud"}
+	require.ErrorContains(t, spec.Validate(), `worker_providers: unknown provider "claud" for reviewer`)
+
+	spec.WorkerProviders = map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientMock}
+	require.ErrorContains(t, spec.Validate(), `worker_providers: unknown provider "mock" for reviewer`)
+}
+
+func TestParseWorkerProviders(t *testing.T) {
+	require.Nil(t, ParseWorkerProviders(nil))
+	require.Equal(t, map[roles.AgentType]client.ClientType{
+		roles.AgentTypeImplementer: client.ClientCursor,
+		roles.AgentTypeReviewer:    client.ClientClaude,
+	}, ParseWorkerProviders(map[string]string{"implementer": "cursor", "reviewer": "claude"}))
+}
+
+func TestNewWorkflowInstance_CopiesWorkerProviders(t *testing.T) {
+	providers := map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientClaude}
+	inst, err := NewWorkflowInstance(&WorkflowSpec{
+		TemplateID:      "cook.md",
+		InitialPrompt:   "Implement feature X",
+		WorkerProviders: providers,
+	})
+	require.NoError(t, err)
+
+	providers[roles.AgentTypeReviewer] = client.ClientCodex
+	require.Equal(t, map[roles.AgentType]client.ClientType{roles.AgentTypeReviewer: client.ClientClaude}, inst.WorkerProviders)
+}
+
+func TestWorkflowSpec_Validate_BeadsPrefix(t *testing.T) {
+	spec := &WorkflowSpec{
+		TemplateID:    "cook.md",
+		InitialPrompt: "Implement feature X",
+		BeadsPrefix:   "feat1",
+		EpicID:        "feat1-abc",
+	}
+	require.NoError(t, spec.Validate())
+
+	spec.EpicID = "feat10-abc"
+	err := spec.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "outside beads_prefix")
+
+	spec.EpicID = ""
+	spec.BeadsPrefix = "Feat_1"
+	err = spec.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "beads_prefix")
+
+	spec.BeadsPrefix = "feat1"
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	require.Equal(t, "feat1", inst.BeadsPrefix)
+}
+
+func TestWorkflowSpec_Validate_DirtyWorktreePolicy(t *testing.T) {
+	spec := &WorkflowSpec{
+		TemplateID:          "cook.md",
+		InitialPrompt:       "Implement feature X",
+		WorktreeMode:        WorktreeModeExisting,
+		WorktreePath:        "/repo-worktree",
+		DirtyWorktreePolicy: DirtyWorktreeStash,
+	}
+	require.NoError(t, spec.Validate())
+
+	spec.DirtyWorktreePolicy = "discard"
+	err := spec.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "dirty_worktree_policy")
+
+	spec.DirtyWorktreePolicy = DirtyWorktreeStash
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	require.Equal(t, DirtyWorktreeStash, inst.DirtyWorktreePolicy)
+}
+
+func TestWorkflowSpec_Validate_MaxWorkers(t *testing.T) {
+	spec := &WorkflowSpec{TemplateID: "cook.md", InitialPrompt: "Implement feature X", MaxWorkers: 3}
+	require.NoError(t, spec.Validate())
+
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	require.Equal(t, 3, inst.MaxWorkers)
+	require.Equal(t, 3, inst.Spec().MaxWorkers)
+
+	spec.MaxWorkers = -1
+	require.ErrorContains(t, spec.Validate(), "max_workers")
+}
+
 func TestNewWorkflowInstance_CreatesInstance(t *testing.T) {
 	spec := &WorkflowSpec{
 		TemplateID:    "cook.md",
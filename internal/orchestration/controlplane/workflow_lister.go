@@ -0,0 +1,40 @@
+package controlplane
+
+import (
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+)
+
+// WorkflowLister lists the registry's workflows for one workflow's coordinator.
+// It satisfies adapter.WorkflowLister so the v2 adapter can serve list_workflows.
+type WorkflowLister struct {
+	registry Registry
+	current  WorkflowID
+}
+
+// NewWorkflowLister creates a WorkflowLister over registry that marks current
+// as the calling coordinator's own workflow.
+func NewWorkflowLister(registry Registry, current WorkflowID) *WorkflowLister {
+	return &WorkflowLister{registry: registry, current: current}
+}
+
+// ListWorkflows returns a summary of every workflow in the registry, newest first.
+// Task progress counts the tasks assigned in each workflow's running infrastructure,
+// so workflows that aren't running report no tasks.
+func (l *WorkflowLister) ListWorkflows() []adapter.WorkflowSummary {
+	instances := l.registry.List(ListQuery{})
+	summaries := make([]adapter.WorkflowSummary, 0, len(instances))
+	for _, inst := range instances {
+		summary := adapter.WorkflowSummary{
+			ID:      inst.ID.String(),
+			Name:    inst.Name,
+			State:   inst.State.String(),
+			EpicID:  inst.EpicID,
+			Current: inst.ID == l.current,
+		}
+		if inst.Infrastructure != nil && inst.Infrastructure.Repositories.TaskRepo != nil {
+			summary.TaskProgress = adapter.SummarizeTasks(inst.Infrastructure.Repositories.TaskRepo.All())
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
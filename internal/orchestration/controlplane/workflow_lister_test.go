@@ -0,0 +1,74 @@
+package controlplane
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func newListedWorkflow(t *testing.T, registry Registry, name, epicID string, createdAt time.Time, tasks ...*repository.TaskAssignment) *WorkflowInstance {
+	t.Helper()
+	spec := newTestSpec(name)
+	spec.EpicID = epicID
+	inst, err := NewWorkflowInstance(spec)
+	require.NoError(t, err)
+	inst.CreatedAt = createdAt
+	if tasks != nil {
+		taskRepo := repository.NewMemoryTaskRepository()
+		for _, task := range tasks {
+			require.NoError(t, taskRepo.Save(task))
+		}
+		inst.Infrastructure = &v2.Infrastructure{
+			Repositories: v2.RepositoryComponents{TaskRepo: taskRepo},
+		}
+	}
+	require.NoError(t, registry.Put(inst))
+	return inst
+}
+
+func TestWorkflowLister_ListsWorkflowsWithProgress(t *testing.T) {
+	registry := NewInMemoryRegistry()
+	now := time.Now()
+
+	billing := newListedWorkflow(t, registry, "billing", "perles-bill", now.Add(-2*time.Hour),
+		&repository.TaskAssignment{TaskID: "perles-bill.1", Status: repository.TaskCompleted},
+		&repository.TaskAssignment{TaskID: "perles-bill.2", Status: repository.TaskCompleted},
+		&repository.TaskAssignment{TaskID: "perles-bill.3", Status: repository.TaskFailed},
+		&repository.TaskAssignment{TaskID: "perles-bill.4", Status: repository.TaskInReview},
+	)
+	require.NoError(t, billing.TransitionTo(WorkflowRunning))
+	auth := newListedWorkflow(t, registry, "auth", "perles-auth", now.Add(-time.Hour),
+		&repository.TaskAssignment{TaskID: "perles-auth.1", Status: repository.TaskImplementing},
+		&repository.TaskAssignment{TaskID: "perles-auth.2", Status: repository.TaskCompleted},
+		&repository.TaskAssignment{TaskID: "perles-auth.3", Status: repository.TaskCommitting},
+	)
+	require.NoError(t, auth.TransitionTo(WorkflowRunning))
+	// Not started yet, so there is no infrastructure to count tasks from
+	docs := newListedWorkflow(t, registry, "docs", "", now)
+
+	lister := NewWorkflowLister(registry, auth.ID)
+	workflows := lister.ListWorkflows()
+
+	require.Equal(t, []adapter.WorkflowSummary{
+		{ID: docs.ID.String(), Name: "docs", State: "pending"},
+		{
+			ID: auth.ID.String(), Name: "auth", State: "running", EpicID: "perles-auth", Current: true,
+			TaskProgress: adapter.TaskProgress{Tasks: 3, Completed: 1, Percent: 33},
+		},
+		{
+			ID: billing.ID.String(), Name: "billing", State: "running", EpicID: "perles-bill",
+			TaskProgress: adapter.TaskProgress{Tasks: 4, Completed: 2, Failed: 1, Percent: 50},
+		},
+	}, workflows)
+}
+
+func TestWorkflowLister_EmptyRegistry(t *testing.T) {
+	lister := NewWorkflowLister(NewInMemoryRegistry(), NewWorkflowID())
+
+	require.Empty(t, lister.ListWorkflows())
+}
@@ -0,0 +1,81 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
+)
+
+// WorkflowTimeoutStopTimeout bounds how long a timed-out workflow waits for its
+// processes to stop and its infrastructure to shut down.
+const WorkflowTimeoutStopTimeout = 30 * time.Second
+
+// armDeadline schedules the workflow to time out once MaxWorkflowDuration has passed
+// since it first started. A workflow already past its deadline times out right away.
+// Does nothing if the duration is unlimited or a timer is already armed.
+func (cp *defaultControlPlane) armDeadline(inst *WorkflowInstance) {
+	if inst.MaxWorkflowDuration <= 0 || inst.StartedAt == nil {
+		return
+	}
+	remaining := max(inst.MaxWorkflowDuration-time.Since(*inst.StartedAt), 0)
+
+	cp.deadlinesMu.Lock()
+	defer cp.deadlinesMu.Unlock()
+	if _, armed := cp.deadlines[inst.ID]; armed {
+		return
+	}
+	id := inst.ID
+	cp.deadlines[id] = time.AfterFunc(remaining, func() {
+		cp.timeoutWorkflow(id)
+	})
+}
+
+// disarmDeadline cancels the workflow's timeout, if one is armed.
+func (cp *defaultControlPlane) disarmDeadline(id WorkflowID) {
+	cp.deadlinesMu.Lock()
+	defer cp.deadlinesMu.Unlock()
+	if timer, ok := cp.deadlines[id]; ok {
+		timer.Stop()
+		delete(cp.deadlines, id)
+	}
+}
+
+// timeoutWorkflow kills a workflow that ran past its MaxWorkflowDuration.
+// A running workflow first gets a system notice in its coordinator log, so the session
+// records why it ended; the notice is written directly and does not start a coordinator turn.
+func (cp *defaultControlPlane) timeoutWorkflow(id WorkflowID) {
+	cp.deadlinesMu.Lock()
+	delete(cp.deadlines, id)
+	cp.deadlinesMu.Unlock()
+
+	inst, ok := cp.registry.Get(id)
+	if !ok || inst.State.IsTerminal() {
+		return
+	}
+	log.Warn(log.CatOrch, "Workflow exceeded max duration, killing it",
+		"workflowID", id, "maxDuration", inst.MaxWorkflowDuration)
+
+	if inst.State == WorkflowRunning && inst.Session != nil {
+		notice := chatrender.Message{
+			Role: "system",
+			Content: fmt.Sprintf("[SYSTEM] Workflow Timed Out\n\nThis workflow ran longer than its maximum duration of %s. "+
+				"All processes have been stopped and the workflow has been failed.", inst.MaxWorkflowDuration),
+			Timestamp: time.Now().UTC(),
+		}
+		if err := inst.Session.WriteCoordinatorMessage(notice); err != nil {
+			log.Debug(log.CatOrch, "Failed to record workflow timeout notice",
+				"workflowID", id, "error", err)
+		}
+	}
+
+	// Kill stops every process through the processor, shuts the infrastructure down
+	// and releases the workflow's worker slots before marking it failed.
+	ctx, cancel := context.WithTimeout(context.Background(), WorkflowTimeoutStopTimeout)
+	defer cancel()
+	if err := cp.Kill(ctx, id); err != nil {
+		log.Error(log.CatOrch, "Failed to kill timed-out workflow", "workflowID", id, "error", err)
+	}
+}
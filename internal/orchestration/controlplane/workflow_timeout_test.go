@@ -0,0 +1,144 @@
+package controlplane
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/session"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// recordingHandler records the commands it handles and always succeeds.
+type recordingHandler struct {
+	mu   sync.Mutex
+	cmds []command.Command
+}
+
+func (h *recordingHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.cmds = append(h.cmds, cmd)
+	return &command.CommandResult{Success: true}, nil
+}
+
+func (h *recordingHandler) Commands() []command.Command {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]command.Command(nil), h.cmds...)
+}
+
+// startTimedWorkflow starts a workflow with the given max duration and two workers,
+// one of them retired. It returns the handlers recording stop and send commands.
+func startTimedWorkflow(t *testing.T, cp ControlPlane, mockFactory *mockInfrastructureFactory, maxDuration time.Duration) (WorkflowID, *recordingHandler, *recordingHandler) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	id, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID:          "test-template",
+		InitialPrompt:       "Build a feature",
+		MaxWorkflowDuration: maxDuration,
+	})
+	require.NoError(t, err)
+	cleanupWorkflowSessionOnTestEnd(t, cp, id)
+
+	infra := createTestInfrastructure(t)
+	stops, sends := &recordingHandler{}, &recordingHandler{}
+	infra.Core.Processor.RegisterHandler(command.CmdStopProcess, stops)
+	infra.Core.Processor.RegisterHandler(command.CmdSendToProcess, sends)
+	processRepo := repository.NewMemoryProcessRepository()
+	require.NoError(t, processRepo.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking}))
+	require.NoError(t, processRepo.Save(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusRetired}))
+	infra.Repositories.ProcessRepo = processRepo
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).Return(infra, nil)
+
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+	require.NoError(t, cp.Start(ctx, id))
+	return id, stops, sends
+}
+
+func TestControlPlane_MaxWorkflowDuration_KillsWorkflow(t *testing.T) {
+	cp, mockFactory, mockProvider := newTestControlPlane(t)
+	setupTestAgentProviderMock(t, mockProvider)
+
+	eventCh, unsubscribe := cp.Subscribe(context.Background())
+	defer unsubscribe()
+
+	id, stops, sends := startTimedWorkflow(t, cp, mockFactory, 50*time.Millisecond)
+	inst, err := cp.Get(context.Background(), id)
+	require.NoError(t, err)
+	sessionDir := inst.SessionDir
+	require.NotEmpty(t, sessionDir)
+
+	// The failed event is published once the workflow has been shut down
+	require.Eventually(t, func() bool {
+		select {
+		case event := <-eventCh:
+			return event.Type == EventWorkflowFailed && event.WorkflowID == id
+		default:
+			return false
+		}
+	}, 2*time.Second, 10*time.Millisecond)
+
+	inst, err = cp.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowFailed, inst.State)
+	require.Nil(t, inst.Infrastructure, "the workflow's infrastructure should be shut down")
+	require.Nil(t, inst.Session, "the workflow's session should be closed")
+
+	stopped := stops.Commands()
+	require.Len(t, stopped, 1, "only the active worker needs stopping")
+	require.Equal(t, "worker-1", stopped[0].(*command.StopProcessCommand).ProcessID)
+
+	require.Empty(t, sends.Commands(), "the timeout notice must not start a coordinator turn")
+
+	messages, err := session.LoadCoordinatorMessages(sessionDir)
+	require.NoError(t, err)
+	var notified bool
+	for _, msg := range messages {
+		if msg.Role == "system" && strings.Contains(msg.Content, "maximum duration of 50ms") {
+			notified = true
+		}
+	}
+	require.True(t, notified, "the coordinator log should record why the workflow ended")
+}
+
+func TestControlPlane_MaxWorkflowDuration_DisarmedOnComplete(t *testing.T) {
+	cp, mockFactory, mockProvider := newTestControlPlane(t)
+	setupTestAgentProviderMock(t, mockProvider)
+
+	id, stops, _ := startTimedWorkflow(t, cp, mockFactory, 100*time.Millisecond)
+	require.NoError(t, cp.Complete(context.Background(), id))
+
+	time.Sleep(200 * time.Millisecond)
+	inst, err := cp.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowCompleted, inst.State)
+	require.Empty(t, stops.Commands())
+}
+
+func TestControlPlane_MaxWorkflowDuration_ZeroIsUnlimited(t *testing.T) {
+	cp, mockFactory, mockProvider := newTestControlPlane(t)
+	setupTestAgentProviderMock(t, mockProvider)
+
+	id, _, _ := startTimedWorkflow(t, cp, mockFactory, 0)
+
+	dcp := cp.(*defaultControlPlane)
+	dcp.deadlinesMu.Lock()
+	_, armed := dcp.deadlines[id]
+	dcp.deadlinesMu.Unlock()
+	require.False(t, armed)
+}
+
+func TestWorkflowSpec_Validate_RejectsNegativeMaxWorkflowDuration(t *testing.T) {
+	spec := WorkflowSpec{TemplateID: "t", InitialPrompt: "p", MaxWorkflowDuration: -time.Second}
+	require.ErrorContains(t, spec.Validate(), "max_workflow_duration")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	"github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/message"
 )
 
 // ToolCallResult is an alias for the shared MCP types package.
@@ -45,6 +46,20 @@ func (h *Handlers) WithRole(role domain.ParticipantRole) *Handlers {
 	return h
 }
 
+// truncatedMarker ends message content cut by the service's content limit. It is
+// formatted with the number of bytes removed and the message ID.
+const truncatedMarker = "\n[... truncated %d bytes; full content: fabric_read_thread(message_id=%q)]"
+
+// truncateContent cuts a message's content to the service's content limit, ending it in
+// a marker naming the message whose thread holds the full content.
+func (h *Handlers) truncateContent(messageID, content string) string {
+	kept, removed := message.TruncateText(content, h.service.ContentLimit())
+	if removed == 0 {
+		return content
+	}
+	return kept + fmt.Sprintf(truncatedMarker, removed, messageID)
+}
+
 // RegisterAll registers all Fabric tools with the MCP server.
 func (h *Handlers) RegisterAll(server ToolRegistrar) {
 	server.RegisterTool(ToolFabricJoin, h.HandleJoin)
@@ -120,7 +135,7 @@ func (h *Handlers) HandleInbox(_ context.Context, rawArgs json.RawMessage) (*Too
 
 			inbox.Messages = append(inbox.Messages, InboxMessage{
 				ID:        thread.ID,
-				Content:   thread.Content,
+				Content:   h.truncateContent(thread.ID, thread.Content),
 				CreatedBy: thread.CreatedBy,
 				CreatedAt: thread.CreatedAt,
 				Mentions:  thread.Mentions,
@@ -444,7 +459,7 @@ func (h *Handlers) HandleHistory(_ context.Context, rawArgs json.RawMessage) (*T
 		response.Messages = append(response.Messages, HistoryMessage{
 			ID:          msg.ID,
 			Seq:         msg.Seq,
-			Content:     msg.Content,
+			Content:     h.truncateContent(msg.ID, msg.Content),
 			Kind:        msg.Kind,
 			CreatedBy:   msg.CreatedBy,
 			CreatedAt:   msg.CreatedAt,
@@ -468,6 +483,7 @@ type readThreadArgs struct {
 }
 
 // HandleReadThread handles the fabric_read_thread tool call.
+// The requested message is returned whole; replies are cut to the content limit.
 func (h *Handlers) HandleReadThread(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	var args readThreadArgs
 	if err := json.Unmarshal(rawArgs, &args); err != nil {
@@ -514,7 +530,7 @@ func (h *Handlers) HandleReadThread(_ context.Context, rawArgs json.RawMessage)
 		response.Replies = append(response.Replies, ThreadMessage{
 			ID:        reply.ID,
 			Seq:       reply.Seq,
-			Content:   reply.Content,
+			Content:   h.truncateContent(reply.ID, reply.Content),
 			Kind:      reply.Kind,
 			CreatedBy: reply.CreatedBy,
 			CreatedAt: reply.CreatedAt,
@@ -361,6 +361,66 @@ func TestHandlers_History(t *testing.T) {
 	require.Len(t, response.Messages, 3)
 }
 
+func TestHandlers_TruncatesLongContentToServiceLimit(t *testing.T) {
+	h, svc := newTestHandlers(t)
+	svc.SetContentLimit(10)
+	_, err := svc.Subscribe(domain.SlugTasks, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	long := "0123456789 tail of a very long worker output"
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     long + " @COORDINATOR",
+		CreatedBy:   "WORKER.1",
+	})
+	require.NoError(t, err)
+	reply, err := svc.Reply(fabric.ReplyInput{MessageID: msg.ID, Content: long, CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+	_, err = svc.Reply(fabric.ReplyInput{MessageID: msg.ID, Content: "short", CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+
+	decode := func(result *ToolCallResult, v any) {
+		t.Helper()
+		data, err := json.Marshal(result.StructuredContent)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, v))
+	}
+	wantMsg := "0123456789\n[... truncated 47 bytes; full content: fabric_read_thread(message_id=\"" + msg.ID + "\")]"
+
+	// Inbox
+	result, err := h.HandleInbox(context.Background(), nil)
+	require.NoError(t, err)
+	var inbox InboxResponse
+	decode(result, &inbox)
+	require.Len(t, inbox.Channels, 1)
+	require.Equal(t, wantMsg, inbox.Channels[0].Messages[0].Content)
+
+	// History
+	args, _ := json.Marshal(historyArgs{Channel: domain.SlugTasks})
+	result, err = h.HandleHistory(context.Background(), args)
+	require.NoError(t, err)
+	var history HistoryResponse
+	decode(result, &history)
+	require.Equal(t, wantMsg, history.Messages[0].Content)
+
+	// The read message is whole; long replies are cut and readable by their own ID
+	args, _ = json.Marshal(readThreadArgs{MessageID: msg.ID})
+	result, err = h.HandleReadThread(context.Background(), args)
+	require.NoError(t, err)
+	var thread ReadThreadResponse
+	decode(result, &thread)
+	require.Equal(t, long+" @COORDINATOR", thread.Message.Content)
+	require.Equal(t, "0123456789\n[... truncated 34 bytes; full content: fabric_read_thread(message_id=\""+reply.ID+"\")]",
+		thread.Replies[0].Content)
+	require.Equal(t, "short", thread.Replies[1].Content)
+
+	args, _ = json.Marshal(readThreadArgs{MessageID: reply.ID})
+	result, err = h.HandleReadThread(context.Background(), args)
+	require.NoError(t, err)
+	decode(result, &thread)
+	require.Equal(t, long, thread.Message.Content)
+}
+
 func TestHandlers_ReadThread(t *testing.T) {
 	h, svc := newTestHandlers(t)
 
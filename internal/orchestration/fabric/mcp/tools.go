@@ -358,13 +358,13 @@ var ToolFabricHistory = Tool{
 // ToolFabricReadThread reads a message thread with all replies.
 var ToolFabricReadThread = Tool{
 	Name:        "fabric_read_thread",
-	Description: "Read a message thread including all replies and artifacts. Use for detailed task discussion review.",
+	Description: "Read a message thread including all replies and artifacts. Use for detailed task discussion review, or to get the full content of a truncated message.",
 	InputSchema: &InputSchema{
 		Type: "object",
 		Properties: map[string]*PropertySchema{
 			"message_id": {
 				Type:        "string",
-				Description: "ID of the root message to read",
+				Description: "ID of the message to read; it is returned untruncated",
 			},
 			"include_artifacts": {
 				Type:        "boolean",
@@ -95,6 +95,21 @@ func (l *EventLogger) HandleEvent(event fabric.Event) {
 	l.eventsWritten++
 }
 
+// Sync flushes written events to stable storage without closing the file.
+// Syncing a closed logger is a no-op.
+func (l *EventLogger) Sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("syncing fabric events file: %w", err)
+	}
+	return nil
+}
+
 // Close flushes and closes the underlying file.
 func (l *EventLogger) Close() error {
 	l.mu.Lock()
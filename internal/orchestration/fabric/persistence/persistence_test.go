@@ -72,6 +72,30 @@ func TestEventLogger_WriteAndLoad(t *testing.T) {
 	require.Equal(t, []string{"COORDINATOR"}, events[1].Event.Mentions)
 }
 
+func TestEventLogger_Sync(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	logger, err := NewEventLogger(tmpDir)
+	require.NoError(t, err)
+
+	logger.HandleEvent(fabric.NewChannelCreatedEvent(&domain.Thread{
+		ID:        "ch-1",
+		Type:      domain.ThreadChannel,
+		Slug:      "general",
+		CreatedAt: time.Now(),
+		CreatedBy: "SYSTEM",
+	}))
+	require.NoError(t, logger.Sync())
+
+	// Events are readable while the logger is still open
+	events, err := LoadPersistedEvents(tmpDir)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	require.NoError(t, logger.Close())
+	require.NoError(t, logger.Sync(), "sync after close is a no-op")
+}
+
 func TestEventLogger_ArtifactEvent(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -33,6 +33,9 @@ type Service struct {
 
 	// Event handler (optional)
 	onEvent func(Event)
+
+	// contentLimit caps message content returned by the fabric tools (0 = no limit)
+	contentLimit int
 }
 
 // NewService creates a new Fabric service.
@@ -58,6 +61,17 @@ func (s *Service) SetEventHandler(handler func(Event)) {
 	s.onEvent = handler
 }
 
+// SetContentLimit caps the bytes of each message's content returned to agents by the
+// fabric tools. Stored messages are kept whole. Values <= 0 disable the limit.
+func (s *Service) SetContentLimit(limit int) {
+	s.contentLimit = max(limit, 0)
+}
+
+// ContentLimit returns the content limit set by SetContentLimit (0 = no limit).
+func (s *Service) ContentLimit() int {
+	return s.contentLimit
+}
+
 // SubscriptionRepository returns the subscription repository for external use (e.g., FabricBroker).
 func (s *Service) SubscriptionRepository() repository.SubscriptionRepository {
 	return s.subscriptions
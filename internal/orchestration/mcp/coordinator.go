@@ -34,6 +34,9 @@ type CoordinatorServer struct {
 
 	// fabricService provides graph-based messaging for task assignments
 	fabricService *fabric.Service
+
+	// taskIDPrefix restricts task IDs to the workflow's beads prefix (empty allows any prefix)
+	taskIDPrefix string
 }
 
 // NewCoordinatorServer creates a new coordinator MCP server.
@@ -76,6 +79,9 @@ const coordinatorInstructions = `Perles orchestrator MCP server providing worker
 // This is useful for testing and for setting up the adapter after initialization.
 func (cs *CoordinatorServer) SetV2Adapter(adapter *adapter.V2Adapter) {
 	cs.v2Adapter = adapter
+	if adapter != nil && cs.taskIDPrefix != "" {
+		adapter.SetTaskIDPrefix(cs.taskIDPrefix)
+	}
 }
 
 // SetTracer sets the tracer for distributed tracing of MCP tool calls.
@@ -94,6 +100,17 @@ func (cs *CoordinatorServer) SetFabricService(svc *fabric.Service) {
 	registerFabricTools(cs.Server, handlers)
 }
 
+// SetTaskIDPrefix scopes task ID validation to a workflow's beads prefix.
+// Once set, task IDs outside the prefix are rejected so workflows sharing a
+// beads database cannot act on each other's tasks. An empty prefix allows any task ID.
+// The prefix is also enforced by the adapter for the tools that change task state.
+func (cs *CoordinatorServer) SetTaskIDPrefix(prefix string) {
+	cs.taskIDPrefix = prefix
+	if cs.v2Adapter != nil {
+		cs.v2Adapter.SetTaskIDPrefix(prefix)
+	}
+}
+
 // registerFabricTools registers all Fabric MCP tools with an MCP server.
 // This bridges the fabric/mcp types to orchestration/mcp types.
 func registerFabricTools(server *Server, h *fabricmcp.Handlers) {
@@ -190,7 +207,7 @@ func convertPropertySchema(p *fabricmcp.PropertySchema) *PropertySchema {
 }
 
 // registerTools registers all coordinator tools with the MCP server.
-// In prompt mode, task-related tools (assign_task, get_task_status, mark_task_complete, mark_task_failed) are excluded.
+// In prompt mode, task-related tools (assign_task, assign_tasks_batch, get_task_status, mark_task_complete, mark_task_failed) are excluded.
 func (cs *CoordinatorServer) registerTools() {
 	cs.RegisterTool(Tool{
 		Name:        "spawn_worker",
@@ -214,14 +231,73 @@ func (cs *CoordinatorServer) registerTools() {
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
-				"worker_id": {Type: "string", Description: "The worker ID to assign (e.g., 'worker-1')"},
-				"task_id":   {Type: "string", Description: "The bd task ID to work on (e.g., 'perles-abc.1')"},
-				"summary":   {Type: "string", Description: "Optional detailed instructions or context to include with the task assignment. Use for task-specific guidance, key files to modify, or implementation hints."},
+				"worker_id":        {Type: "string", Description: "The worker ID to assign (e.g., 'worker-1'). Omit to pick the ready worker that has been idle longest."},
+				"task_id":          {Type: "string", Description: "The bd task ID to work on (e.g., 'perles-abc.1')"},
+				"summary":          {Type: "string", Description: "Optional detailed instructions or context to include with the task assignment. Use for task-specific guidance, key files to modify, or implementation hints."},
+				"estimate_minutes": {Type: "number", Description: "Optional effort estimate in minutes. Larger estimates let the worker run longer before being flagged stuck. An estimate on the bd task takes precedence."},
 			},
-			Required: []string{"worker_id", "task_id"},
+			Required: []string{"task_id"},
 		},
 	}, cs.handleAssignTask)
 
+	cs.RegisterTool(Tool{
+		Name:        "assign_tasks_batch",
+		Description: "Assign several independent tasks to ready workers in one call. Each assignment is validated and applied independently; returns a success or error result for every item. Use at the start of a parallel phase instead of repeated assign_task calls.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"assignments": {
+					Type:        "array",
+					Description: "Worker/task pairs to assign. Each task and each worker may appear only once.",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"worker_id": {Type: "string", Description: "The worker ID to assign (e.g., 'worker-1')"},
+							"task_id":   {Type: "string", Description: "The bd task ID to work on (e.g., 'perles-abc.1')"},
+							"summary":   {Type: "string", Description: "Optional instructions or context for this task"},
+						},
+						Required: []string{"worker_id", "task_id"},
+					},
+				},
+			},
+			Required: []string{"assignments"},
+		},
+	}, cs.handleAssignTasksBatch)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_eligible_workers",
+		Description: "List the workers that could be assigned a bd task right now, using the same checks as assign_task, and the reason each other worker cannot take it. Fails if the task itself cannot be assigned (on hold or not found in bd). Assigns nothing.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID to check (e.g., 'perles-abc.1')"},
+			},
+			Required: []string{"task_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id":  {Type: "string", Description: "The task that was checked"},
+				"eligible": {Type: "array", Description: "IDs of workers that could take the task, in worker order", Items: &PropertySchema{Type: "string"}},
+				"ineligible": {
+					Type:        "array",
+					Description: "Active workers that cannot take the task, in worker order",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"worker_id": {Type: "string", Description: "Worker ID"},
+							"status":    {Type: "string", Description: "Worker status (e.g., ready, working)"},
+							"phase":     {Type: "string", Description: "Worker phase, if any (e.g., implementing)"},
+							"reason":    {Type: "string", Description: "Why the worker cannot take the task"},
+						},
+						Required: []string{"worker_id", "status", "reason"},
+					},
+				},
+			},
+			Required: []string{"task_id", "eligible", "ineligible"},
+		},
+	}, cs.handleGetEligibleWorkers)
+
 	cs.RegisterTool(Tool{
 		Name:        "replace_worker",
 		Description: "Retire a worker (e.g., due to token limit) and spawn a fresh replacement. Returns the new worker ID.",
@@ -230,6 +306,7 @@ func (cs *CoordinatorServer) registerTools() {
 			Properties: map[string]*PropertySchema{
 				"worker_id": {Type: "string", Description: "The worker ID to retire"},
 				"reason":    {Type: "string", Description: "Reason for replacement (e.g., 'token limit', 'stuck')"},
+				"reassign":  {Type: "boolean", Description: "Hand the worker's in-progress task to the replacement, seeded with its progress summary and changed files (default: false)"},
 			},
 			Required: []string{"worker_id"},
 		},
@@ -262,11 +339,12 @@ func (cs *CoordinatorServer) registerTools() {
 
 	cs.RegisterTool(Tool{
 		Name:        "mark_task_complete",
-		Description: "Mark a task as completed in the bd tracker.",
+		Description: "Mark a task as completed in the bd tracker. The task must be committing unless force is set.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
 				"task_id": {Type: "string", Description: "The bd task ID to mark complete"},
+				"force":   {Type: "boolean", Description: "Complete even if the task has not reached the commit phase (optional)"},
 			},
 			Required: []string{"task_id"},
 		},
@@ -280,11 +358,114 @@ func (cs *CoordinatorServer) registerTools() {
 			Properties: map[string]*PropertySchema{
 				"task_id": {Type: "string", Description: "The bd task ID to mark as failed"},
 				"reason":  {Type: "string", Description: "Reason for failure/block"},
+				"category": {
+					Type:        "string",
+					Description: "Optional failure classification for later analysis",
+					Enum:        []string{"blocked", "test_failure", "timeout", "tooling_error", "unclear_requirements", "abandoned"},
+				},
 			},
 			Required: []string{"task_id", "reason"},
 		},
 	}, cs.handleMarkTaskFailed)
 
+	cs.RegisterTool(Tool{
+		Name:        "add_task_blocker",
+		Description: "Record in the bd tracker that a task is blocked by another issue. Rejected if the blocker already depends on the task.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id":    {Type: "string", Description: "The bd task ID that is blocked"},
+				"blocker_id": {Type: "string", Description: "The bd issue ID that blocks the task (e.g., a newly filed bug)"},
+			},
+			Required: []string{"task_id", "blocker_id"},
+		},
+	}, cs.handleAddTaskBlocker)
+
+	cs.RegisterTool(Tool{
+		Name:        "sync_task_status",
+		Description: "Re-read a task's status from the bd tracker and update the coordinator's record if it diverged (e.g., after the task was closed or reopened directly in bd). Reports any correction made.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID to sync"},
+			},
+			Required: []string{"task_id"},
+		},
+	}, cs.handleSyncTaskStatus)
+
+	cs.RegisterTool(Tool{
+		Name:        "complete_epic_tasks",
+		Description: "Close every open subtask of a bd epic in one call. Subtasks still assigned to a worker are skipped and reported. If confirmation is enabled, the first call closes nothing and returns a confirm_token describing the impact; call again with that token to proceed.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"epic_id":       {Type: "string", Description: "The bd epic ID whose open subtasks should be closed"},
+				"confirm_token": {Type: "string", Description: "Token returned by a previous call for the same epic, confirming the close"},
+			},
+			Required: []string{"epic_id"},
+		},
+	}, cs.handleCompleteEpicTasks)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_critical_path",
+		Description: "Compute the critical path of a bd epic: the longest chain of blocking dependencies through its open subtasks, in order. Weighted by effort estimates when every subtask has one, otherwise by task count. Fails with the offending tasks if the dependencies form a cycle.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"epic_id": {Type: "string", Description: "The bd epic ID whose subtasks should be scheduled"},
+			},
+			Required: []string{"epic_id"},
+		},
+	}, cs.handleGetCriticalPath)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_dependency_graph",
+		Description: "Export the dependency graph of a bd epic as JSON: the epic and its subtasks as nodes (id, title, status) and their relationships as edges. A blocked_by edge means 'from' cannot start until 'to' is closed; a parent edge points from a subtask to the epic. Cycles are returned as-is, with their nodes and edges flagged in_cycle.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"epic_id": {Type: "string", Description: "The bd epic ID whose graph should be exported"},
+			},
+			Required: []string{"epic_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"epic_id": {Type: "string", Description: "The epic the graph was exported for"},
+				"nodes": {
+					Type:        "array",
+					Description: "The epic and its subtasks, sorted by id",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"id":       {Type: "string", Description: "Issue ID"},
+							"title":    {Type: "string", Description: "Issue title"},
+							"status":   {Type: "string", Description: "Issue status (open, in_progress, closed)"},
+							"in_cycle": {Type: "boolean", Description: "True if the issue is on a cycle of blocked_by edges"},
+						},
+						Required: []string{"id", "title", "status", "in_cycle"},
+					},
+				},
+				"edges": {
+					Type:        "array",
+					Description: "Relationships between nodes, sorted by from, to and type",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"from":     {Type: "string", Description: "Issue the relationship starts at"},
+							"to":       {Type: "string", Description: "Blocking issue (blocked_by) or parent issue (parent)"},
+							"type":     {Type: "string", Description: "blocked_by or parent"},
+							"in_cycle": {Type: "boolean", Description: "True if both ends are on the same blocking cycle"},
+						},
+						Required: []string{"from", "to", "type", "in_cycle"},
+					},
+				},
+				"has_cycle": {Type: "boolean", Description: "True if any blocked_by edges form a cycle"},
+			},
+			Required: []string{"epic_id", "nodes", "edges", "has_cycle"},
+		},
+	}, cs.handleGetDependencyGraph)
+
 	cs.RegisterTool(Tool{
 		Name:        "query_worker_state",
 		Description: "Query current state of workers with role/phase details. Use before assignments to check availability and prevent duplicates.",
@@ -306,6 +487,7 @@ func (cs *CoordinatorServer) registerTools() {
 						Type: "object",
 						Properties: map[string]*PropertySchema{
 							"id":      {Type: "string", Description: "Worker ID (e.g., worker-1)"},
+							"label":   {Type: "string", Description: "Label set with label_worker, if any"},
 							"status":  {Type: "string", Description: "Current status (Pending, Ready, Working, Paused)"},
 							"phase":   {Type: "string", Description: "Current phase (Idle, Implementing, Reviewing, etc.)"},
 							"task_id": {Type: "string", Description: "Assigned task ID if any"},
@@ -330,52 +512,476 @@ func (cs *CoordinatorServer) registerTools() {
 				},
 				"tasks": {
 					Type:        "object",
-					Description: "Map of task ID to assignment info",
+					Description: "Map of task ID to assignment info (held: true if on hold)",
+				},
+				"held_tasks": {
+					Type:        "object",
+					Description: "Map of task ID to hold reason for tasks put on hold with hold_task (omitted when none)",
 				},
 			},
 			Required: []string{"workers", "ready_workers", "retired_workers", "failed_workers", "tasks"},
 		},
 	}, cs.handleQueryWorkerState)
 
+	cs.RegisterTool(Tool{
+		Name:        "ping_worker",
+		Description: "Check whether a single worker is still alive and when it was last active. Set probe to also verify the worker's live process responds.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id":       {Type: "string", Description: "Worker to check (e.g., 'worker-1')"},
+				"probe":           {Type: "boolean", Description: "If true, send a no-op to the worker's live process and require it to respond. Default: false"},
+				"timeout_seconds": {Type: "number", Description: "How long to wait for the probe to respond. Default: 5"},
+			},
+			Required: []string{"worker_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id":        {Type: "string", Description: "Worker ID"},
+				"alive":            {Type: "boolean", Description: "False if the worker is retired or failed, or its process did not respond to the probe"},
+				"status":           {Type: "string", Description: "Current status (starting, ready, working, retired)"},
+				"phase":            {Type: "string", Description: "Current phase (idle, implementing, reviewing, etc.)"},
+				"task_id":          {Type: "string", Description: "Assigned task ID if any"},
+				"last_activity_at": {Type: "string", Description: "When the worker last completed a turn"},
+				"retired_at":       {Type: "string", Description: "When the worker was retired, if it was"},
+				"probe": {
+					Type:        "object",
+					Description: "Probe outcome (only when probe was requested and the worker is not retired)",
+					Properties: map[string]*PropertySchema{
+						"responded":  {Type: "boolean", Description: "Whether the process responded within the timeout"},
+						"pid":        {Type: "number", Description: "OS process ID probed (omitted when the worker is between turns)"},
+						"latency_ms": {Type: "number", Description: "Time taken by the probe"},
+						"error":      {Type: "string", Description: "Why the probe failed"},
+					},
+				},
+			},
+			Required: []string{"worker_id", "alive", "status"},
+		},
+	}, cs.handlePingWorker)
+
+	cs.RegisterTool(Tool{
+		Name:        "peek_worker",
+		Description: "Show the last few lines of a worker's reasoning and replies, without tool calls or tool output. Use to quickly see what a worker is thinking.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "Worker to peek at (e.g., 'worker-1')"},
+				"lines":     {Type: "number", Description: "Number of text lines to return. Default: 5, max: 50"},
+			},
+			Required: []string{"worker_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "Worker ID"},
+				"status":    {Type: "string", Description: "Current status (starting, ready, working, retired)"},
+				"phase":     {Type: "string", Description: "Current phase (idle, implementing, reviewing, etc.)"},
+				"task_id":   {Type: "string", Description: "Assigned task ID if any"},
+				"lines": {
+					Type:        "array",
+					Description: "Most recent lines of text, oldest first (empty if the worker has no live process)",
+					Items:       &PropertySchema{Type: "string"},
+				},
+			},
+			Required: []string{"worker_id", "status", "lines"},
+		},
+	}, cs.handlePeekWorker)
+
+	cs.RegisterTool(Tool{
+		Name:        "label_worker",
+		Description: "Give a worker a human-readable label (e.g., 'auth-impl') shown alongside its ID in query_worker_state. The label is cosmetic: keep addressing the worker by its ID. An empty label clears it.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "Worker to label (e.g., 'worker-1')"},
+				"label":     {Type: "string", Description: "Label of up to 64 characters, without control characters. Empty clears the label"},
+			},
+			Required: []string{"worker_id", "label"},
+		},
+	}, cs.handleLabelWorker)
+
+	cs.RegisterTool(Tool{
+		Name:        "list_workflows",
+		Description: "List every workflow the control plane is running, including this one, with epic IDs and task progress. Use for decisions that span workflows.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"workflows": {
+					Type:        "array",
+					Description: "Workflows, newest first",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"id":               {Type: "string", Description: "Workflow ID"},
+							"name":             {Type: "string", Description: "Workflow name"},
+							"state":            {Type: "string", Description: "Lifecycle state (pending, running, paused, completed, failed)"},
+							"epic_id":          {Type: "string", Description: "Beads epic the workflow works on, if any"},
+							"current":          {Type: "boolean", Description: "True for the workflow this coordinator belongs to"},
+							"tasks":            {Type: "number", Description: "Tasks assigned in the workflow"},
+							"completed":        {Type: "number", Description: "Tasks completed"},
+							"failed":           {Type: "number", Description: "Tasks failed"},
+							"progress_percent": {Type: "number", Description: "Share of tasks completed, 0-100"},
+						},
+					},
+				},
+			},
+			Required: []string{"workflows"},
+		},
+	}, cs.handleListWorkflows)
+
+	cs.RegisterTool(Tool{
+		Name:        "list_models",
+		Description: "List the model names the worker provider accepts, from its CLI when it can list them and a known set otherwise. Informational: workers run on the model configured for the provider, and spawn_worker does not take a model. Results are cached for a few minutes.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"provider": {Type: "string", Description: "Worker provider (e.g., claude, cursor)"},
+				"models":   {Type: "array", Description: "Model names the provider accepts", Items: &PropertySchema{Type: "string"}},
+				"default":  {Type: "string", Description: "Model workers run on, if one is configured"},
+				"source":   {Type: "string", Description: "'cli' if listed by the provider's CLI, 'static' for the known set"},
+			},
+			Required: []string{"provider", "models", "source"},
+		},
+	}, cs.handleListModels)
+
+	cs.RegisterTool(Tool{
+		Name:        "list_orphaned_tasks",
+		Description: "List active tasks whose implementer or reviewer is retired, failed, or missing. Use to find tasks that need reassignment.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"orphaned_tasks": {
+					Type:        "array",
+					Description: "Orphaned tasks with the reason they are stuck",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"task_id":     {Type: "string", Description: "The bd task ID"},
+							"status":      {Type: "string", Description: "Task assignment status"},
+							"implementer": {Type: "string", Description: "Assigned implementer worker ID"},
+							"reviewer":    {Type: "string", Description: "Assigned reviewer worker ID, if any"},
+							"reason":      {Type: "string", Description: "implementer_missing, implementer_retired, implementer_failed, reviewer_missing, reviewer_retired, or reviewer_failed"},
+						},
+						Required: []string{"task_id", "status", "implementer", "reason"},
+					},
+				},
+			},
+			Required: []string{"orphaned_tasks"},
+		},
+	}, cs.handleListOrphanedTasks)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_task_timings",
+		Description: "Report how long a task spent implementing, awaiting review, reviewing and committing, plus the total. Use after mark_task_complete for cycle-time reporting, or on an active task to find where it is stalling.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID"},
+			},
+			Required: []string{"task_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id":  {Type: "string", Description: "The bd task ID"},
+				"status":   {Type: "string", Description: "Task assignment status"},
+				"finished": {Type: "boolean", Description: "Whether the task's timeline has ended (e.g., marked failed); otherwise the current phase counts up to now"},
+				"phases": {
+					Type:        "array",
+					Description: "Time spent in each phase, in lifecycle order",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"phase":    {Type: "string", Description: "implementing, awaiting_review, reviewing, or committing"},
+							"duration": {Type: "string", Description: "Human-readable duration (e.g., 12m30s)"},
+							"seconds":  {Type: "number", Description: "Duration in seconds"},
+						},
+						Required: []string{"phase", "duration", "seconds"},
+					},
+				},
+				"total":         {Type: "string", Description: "Human-readable sum of all phases"},
+				"total_seconds": {Type: "number", Description: "Sum of all phases in seconds"},
+			},
+			Required: []string{"task_id", "status", "finished", "phases", "total", "total_seconds"},
+		},
+	}, cs.handleGetTaskTimings)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_utilization",
+		Description: "Report what fraction of worker time was spent working, reviewing, awaiting review and ready over the workflow's lifetime, overall and per worker. Use for capacity planning: a high ready share means too many workers, a high busy share means too few.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"total_seconds":   {Type: "number", Description: "Worker time observed across all workers, in seconds"},
+				"working":         {Type: "number", Description: "Fraction of worker time spent implementing, addressing feedback or committing"},
+				"reviewing":       {Type: "number", Description: "Fraction of worker time spent reviewing"},
+				"awaiting_review": {Type: "number", Description: "Fraction of worker time spent waiting for a review"},
+				"ready":           {Type: "number", Description: "Fraction of worker time spent ready with no task"},
+				"phases": {
+					Type:        "array",
+					Description: "Total worker time in each phase, in lifecycle order",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"phase":    {Type: "string", Description: "idle, implementing, awaiting_review, reviewing, addressing_feedback, or committing"},
+							"duration": {Type: "string", Description: "Human-readable duration (e.g., 12m30s)"},
+							"seconds":  {Type: "number", Description: "Duration in seconds"},
+						},
+						Required: []string{"phase", "duration", "seconds"},
+					},
+				},
+				"workers": {
+					Type:        "array",
+					Description: "Each worker's total time and activity fractions",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"worker_id":       {Type: "string", Description: "Worker ID"},
+							"total_seconds":   {Type: "number", Description: "Time the worker was observed, in seconds"},
+							"working":         {Type: "number", Description: "Fraction of its time spent working"},
+							"reviewing":       {Type: "number", Description: "Fraction of its time spent reviewing"},
+							"awaiting_review": {Type: "number", Description: "Fraction of its time spent awaiting review"},
+							"ready":           {Type: "number", Description: "Fraction of its time spent ready"},
+						},
+						Required: []string{"worker_id", "total_seconds", "working", "reviewing", "awaiting_review", "ready"},
+					},
+				},
+				"assessment": {Type: "string", Description: "Whether the pool looks over- or under-provisioned (omitted if neither)"},
+			},
+			Required: []string{"total_seconds", "working", "reviewing", "awaiting_review", "ready", "phases", "workers"},
+		},
+	}, cs.handleGetUtilization)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_headroom",
+		Description: "Report how many new tasks can be assigned right now: ready workers minus those reserved for reviews awaiting a reviewer. Also reports how many more workers the shared worker pool allows. Use before assigning a batch of tasks.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"headroom":          {Type: "number", Description: "Tasks that can be assigned now without spawning workers (never negative)"},
+				"ready_workers":     {Type: "number", Description: "Idle workers that can take a task now"},
+				"reserved_reviews":  {Type: "number", Description: "Implemented tasks awaiting a reviewer, each of which will claim a ready worker"},
+				"spawnable_workers": {Type: "number", Description: "More workers the shared worker pool allows (omitted when unlimited)"},
+			},
+			Required: []string{"headroom", "ready_workers", "reserved_reviews"},
+		},
+	}, cs.handleGetHeadroom)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_worker_backlog",
+		Description: "Report how many Fabric messages each active worker has not yet read, by channel. A worker with a growing backlog has stopped checking its inbox.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "Only report this worker (optional; defaults to all active workers)"},
+			},
+			Required: []string{},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"workers": {
+					Type:        "array",
+					Description: "Unread message counts per worker",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"worker_id": {Type: "string", Description: "Worker ID"},
+							"unread":    {Type: "number", Description: "Messages the worker has not read"},
+							"channels":  {Type: "object", Description: "Unread counts by channel slug (omitted when none)"},
+						},
+						Required: []string{"worker_id", "unread"},
+					},
+				},
+				"total_unread": {Type: "number", Description: "Unread messages across the reported workers"},
+			},
+			Required: []string{"workers", "total_unread"},
+		},
+	}, cs.handleGetWorkerBacklog)
+
+	cs.RegisterTool(Tool{
+		Name:        "clear_worker_backlog",
+		Description: "Mark all of a worker's unread Fabric messages as read on its behalf. Use when replacing a worker so stale messages are not left pending; other agents' read state is unchanged.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "The worker whose messages to mark read"},
+			},
+			Required: []string{"worker_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "The worker whose messages were marked read"},
+				"cleared":   {Type: "number", Description: "Number of messages marked read"},
+				"message":   {Type: "string", Description: "Human-readable summary"},
+			},
+			Required: []string{"worker_id", "cleared", "message"},
+		},
+	}, cs.handleClearWorkerBacklog)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_message_content",
+		Description: "Get the full content of a message that was truncated in the message log. Truncated messages end with a marker naming their message_id.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"message_id": {Type: "string", Description: "ID of the truncated message"},
+			},
+			Required: []string{"message_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"message_id": {Type: "string", Description: "ID of the message"},
+				"content":    {Type: "string", Description: "The message's full, untruncated content"},
+			},
+			Required: []string{"message_id", "content"},
+		},
+	}, cs.handleGetMessageContent)
+
 	cs.RegisterTool(Tool{
 		Name:        "assign_task_review",
 		Description: "Assign a worker to review completed implementation. Validates reviewer is ready and different from implementer.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
-				"reviewer_id":    {Type: "string", Description: "Worker ID to assign as reviewer (e.g., 'worker-2')"},
+				"reviewer_id":    {Type: "string", Description: "Worker ID to assign as reviewer (e.g., 'worker-2'). Omit or pass 'auto' to pick the ready worker, other than the implementer, that has been idle longest; the chosen reviewer is returned."},
 				"task_id":        {Type: "string", Description: "The bd task ID being reviewed"},
 				"implementer_id": {Type: "string", Description: "Worker ID who implemented the task"},
 				"summary":        {Type: "string", Description: "Brief summary of what was implemented"},
 				"review_type":    {Type: "string", Description: "Review complexity: 'simple' (reviewer checks all dimensions directly) or 'complex' (spawn sub-agents for thorough parallel review). Defaults to 'complex'."},
 			},
-			Required: []string{"reviewer_id", "task_id", "implementer_id", "summary"},
+			Required: []string{"task_id", "implementer_id", "summary"},
 		},
 	}, cs.handleAssignTaskReview)
 
 	cs.RegisterTool(Tool{
 		Name:        "assign_review_feedback",
-		Description: "Send review feedback to implementer requiring changes. Used when reviewer denies and implementer needs to fix issues.",
+		Description: "Send review feedback to implementer requiring changes. Used when reviewer denies and implementer needs to fix issues. Pass items to track each required change; the implementer marks them addressed and is warned about any left when reporting completion.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
 				"implementer_id": {Type: "string", Description: "Worker ID to send feedback to"},
 				"task_id":        {Type: "string", Description: "The bd task ID"},
-				"feedback":       {Type: "string", Description: "Specific feedback about required changes"},
+				"feedback":       {Type: "string", Description: "Specific feedback about required changes (required unless items is given)"},
+				"items":          {Type: "array", Description: "Discrete required changes, tracked individually (optional)", Items: &PropertySchema{Type: "string"}},
 			},
-			Required: []string{"implementer_id", "task_id", "feedback"},
+			Required: []string{"implementer_id", "task_id"},
 		},
 	}, cs.handleAssignReviewFeedback)
 
+	cs.RegisterTool(Tool{
+		Name:        "transfer_task",
+		Description: "Move an in-flight task from one worker to a ready worker, keeping its phase and review state. The previous worker is told to stop and returns to idle; use instead of replace_worker when the worker itself is fine.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id":     {Type: "string", Description: "The bd task ID to transfer"},
+				"from_worker": {Type: "string", Description: "Worker ID currently implementing the task"},
+				"to_worker":   {Type: "string", Description: "Ready worker ID to take over the task"},
+				"note":        {Type: "string", Description: "Handoff context for the new worker (progress so far, known problems)"},
+			},
+			Required: []string{"task_id", "from_worker", "to_worker"},
+		},
+	}, cs.handleTransferTask)
+
+	cs.RegisterTool(Tool{
+		Name:        "drain_worker",
+		Description: "Gracefully decommission a worker: transfer each of its in-flight tasks to a ready worker, then retire it. Tasks with no ready worker to take them, or that the worker was reviewing, are left orphaned for you to reassign. Use for planned scale-down.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "The worker ID to drain and retire"},
+				"reason":    {Type: "string", Description: "Why the worker is being drained (optional, passed to the workers taking over)"},
+			},
+			Required: []string{"worker_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "The drained worker"},
+				"tasks": {
+					Type:        "array",
+					Description: "Outcome for each task the worker held",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"task_id":   {Type: "string", Description: "The bd task ID"},
+							"outcome":   {Type: "string", Description: "transferred or orphaned"},
+							"to_worker": {Type: "string", Description: "Worker that took over the task (transferred only)"},
+							"reason":    {Type: "string", Description: "Why the task was not transferred (orphaned only)"},
+						},
+						Required: []string{"task_id", "outcome"},
+					},
+				},
+				"transferred": {Type: "number", Description: "Number of tasks transferred"},
+				"orphaned":    {Type: "number", Description: "Number of tasks left orphaned"},
+			},
+			Required: []string{"worker_id", "tasks", "transferred", "orphaned"},
+		},
+	}, cs.handleDrainWorker)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_diff_since_last_review",
+		Description: "Show only what changed in a task's worktree diff since its last review verdict. Use on re-review after a denial instead of re-reading the full diff.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID"},
+			},
+			Required: []string{"task_id"},
+		},
+	}, cs.handleGetDiffSinceLastReview)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_changed_files",
+		Description: "List the file paths changed in a task's worktree (staged, unstaged and untracked) without the diff. The list is capped; total reports the full count.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID"},
+			},
+			Required: []string{"task_id"},
+		},
+	}, cs.handleGetChangedFiles)
+
 	cs.RegisterTool(Tool{
 		Name:        "approve_commit",
 		Description: "Approve implementation and instruct worker to commit. Called after reviewer approves.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
-				"implementer_id": {Type: "string", Description: "Worker ID to instruct to commit"},
-				"task_id":        {Type: "string", Description: "The bd task ID"},
-				"commit_message": {Type: "string", Description: "Suggested commit message (optional)"},
+				"implementer_id":      {Type: "string", Description: "Worker ID to instruct to commit"},
+				"task_id":             {Type: "string", Description: "The bd task ID"},
+				"commit_message":      {Type: "string", Description: "Suggested commit message (optional)"},
+				"allow_failing_tests": {Type: "boolean", Description: "Approve even if the task's reported test results include failures (optional)"},
 			},
 			Required: []string{"implementer_id", "task_id"},
 		},
@@ -409,7 +1015,7 @@ func (cs *CoordinatorServer) registerTools() {
 
 	cs.RegisterTool(Tool{
 		Name:        "signal_workflow_complete",
-		Description: "Signal that the workflow has completed. Call this when the orchestration workflow reaches its natural conclusion.",
+		Description: "Signal that the workflow has completed. Call this when the orchestration workflow reaches its natural conclusion. The result counts the workflow's failed tasks by failure category.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
@@ -435,6 +1041,143 @@ func (cs *CoordinatorServer) registerTools() {
 		},
 	}, cs.handleSignalWorkflowComplete)
 
+	cs.RegisterTool(Tool{
+		Name:        "set_global_instruction",
+		Description: "Add a coordinator-wide instruction (e.g., 'the DB migration is frozen') that is included in the Coordinator Instructions of every subsequent task assignment. Tasks already assigned are not updated.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"instruction": {
+					Type:        "string",
+					Description: "Instruction to include in every new task assignment",
+				},
+				"replace": {
+					Type:        "boolean",
+					Description: "If true, replace all existing global instructions instead of appending. Default: false",
+				},
+			},
+			Required: []string{"instruction"},
+		},
+	}, cs.handleSetGlobalInstruction)
+
+	cs.RegisterTool(Tool{
+		Name:        "clear_global_instruction",
+		Description: "Remove all coordinator-wide instructions so new task assignments no longer include them.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+	}, cs.handleClearGlobalInstruction)
+
+	cs.RegisterTool(Tool{
+		Name:        "set_focus",
+		Description: "Set a workflow-wide focus area (e.g., 'the internal/parser module') that is included in the Coordinator Instructions of every subsequent task assignment until cleared. Set a blank focus to clear it. Tasks already assigned are not updated.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"focus": {
+					Type:        "string",
+					Description: "Focus area for new task assignments; empty clears the focus",
+				},
+			},
+			Required: []string{"focus"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"focus":   {Type: "string", Description: "Focus area now set; empty if it was cleared"},
+				"message": {Type: "string", Description: "Human-readable summary"},
+			},
+			Required: []string{"focus", "message"},
+		},
+	}, cs.handleSetFocus)
+
+	cs.RegisterTool(Tool{
+		Name:        "get_focus",
+		Description: "Get the workflow-wide focus area set with set_focus. An empty focus means none is set.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"focus": {Type: "string", Description: "Current focus area; empty if none is set"},
+			},
+			Required: []string{"focus"},
+		},
+	}, cs.handleGetFocus)
+
+	cs.RegisterTool(Tool{
+		Name:        "hold_task",
+		Description: "Put a task on hold so it is not assigned to any worker until release_task is called, e.g. while it waits on a human decision. Unlike mark_task_failed or add_task_blocker, bd status is unchanged.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID to hold"},
+				"reason":  {Type: "string", Description: "Why the task is held (optional, shown in query_worker_state)"},
+			},
+			Required: []string{"task_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id":    {Type: "string", Description: "The held task"},
+				"held_tasks": {Type: "number", Description: "Number of tasks now on hold"},
+				"message":    {Type: "string", Description: "Human-readable summary"},
+			},
+			Required: []string{"task_id", "held_tasks", "message"},
+		},
+	}, cs.handleHoldTask)
+
+	cs.RegisterTool(Tool{
+		Name:        "release_task",
+		Description: "Take a task off hold so it can be assigned again.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID to release"},
+			},
+			Required: []string{"task_id"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id":    {Type: "string", Description: "The released task"},
+				"held_tasks": {Type: "number", Description: "Number of tasks still on hold"},
+				"message":    {Type: "string", Description: "Human-readable summary"},
+			},
+			Required: []string{"task_id", "held_tasks", "message"},
+		},
+	}, cs.handleReleaseTask)
+
+	cs.RegisterTool(Tool{
+		Name:        "export_state",
+		Description: "Export worker assignments, task assignments (with review, transfer and failure history) and global instructions as JSON. Save the output to restore it later with import_state.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+			Required:   []string{},
+		},
+	}, cs.handleExportState)
+
+	cs.RegisterTool(Tool{
+		Name:        "import_state",
+		Description: "Restore coordinator state previously returned by export_state. Rejected without changes if it assigns tasks to workers that are not active in the current pool; spawn matching workers first.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"state": {
+					Type:        "object",
+					Description: "The JSON object returned by export_state",
+				},
+			},
+			Required: []string{"state"},
+		},
+	}, cs.handleImportState)
+
 	cs.RegisterTool(Tool{
 		Name:        "notify_user",
 		Description: "Request user attention for a human checkpoint. Use this during DAG workflow phases that require human review or input (e.g., clarification-review). Plays a notification sound and displays the message to the user.",
@@ -471,9 +1214,17 @@ type stopWorkerArgs struct {
 }
 
 type assignTaskArgs struct {
-	WorkerID string `json:"worker_id"`
-	TaskID   string `json:"task_id"`
-	Summary  string `json:"summary,omitempty"`
+	WorkerID        string `json:"worker_id"`
+	TaskID          string `json:"task_id"`
+	Summary         string `json:"summary,omitempty"`
+	EstimateMinutes int    `json:"estimate_minutes,omitempty"`
+}
+
+// GetTaskStatusResult is the result of the get_task_status tool.
+// The text content keeps the bd show array format; Issues holds the same issue.
+type GetTaskStatusResult struct {
+	adapter.ToolResult
+	Issues []*beads.Issue `json:"issues"`
 }
 
 // SpawnIdleWorker spawns a new idle worker via v2Adapter.
@@ -521,6 +1272,8 @@ func (cs *CoordinatorServer) handleSpawnWorker(ctx context.Context, args json.Ra
 // handleAssignTask assigns a task to a ready worker.
 // Posts task assignment to #tasks channel first (no @mention) to create the task thread,
 // then passes the thread ID through to the v2Adapter so the worker knows where to reply.
+// When no worker is named, the worker the handler selects is recorded in the thread
+// once the assignment succeeds.
 func (cs *CoordinatorServer) handleAssignTask(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	// Parse args to get task details for Fabric message
 	var args assignTaskArgs
@@ -529,49 +1282,128 @@ func (cs *CoordinatorServer) handleAssignTask(ctx context.Context, rawArgs json.
 	}
 
 	// Post to Fabric first to create the task thread (no @mention - avoids double notification)
-	var threadID string
-	if cs.fabricService != nil {
-		summary := args.Summary
-		if summary == "" {
-			summary = "Task assignment"
-		}
-		content := fmt.Sprintf("Task: %s [%s] assigned to %s", summary, args.TaskID, args.WorkerID)
-
-		thread, postErr := cs.fabricService.SendMessage(fabric.SendMessageInput{
-			ChannelSlug: "tasks",
-			Content:     content,
-			CreatedBy:   repository.CoordinatorID,
-			// No mentions - worker gets notified via the v2 delivery mechanism
-		})
-		if postErr != nil {
-			// Log but continue - we can still assign the task without the Fabric thread
-			log.Debug(log.CatMCP, "Failed to post task assignment to #tasks",
-				"error", postErr, "taskID", args.TaskID, "workerID", args.WorkerID)
-		} else {
-			threadID = thread.ID
-		}
-	}
+	threadID := cs.postTaskThread(args)
 
 	// Inject threadID into the args for the v2Adapter
 	// Re-marshal with the threadID included
 	enrichedArgs := struct {
+		WorkerID        string `json:"worker_id"`
+		TaskID          string `json:"task_id"`
+		Summary         string `json:"summary,omitempty"`
+		ThreadID        string `json:"thread_id,omitempty"`
+		EstimateMinutes int    `json:"estimate_minutes,omitempty"`
+	}{
+		WorkerID:        args.WorkerID,
+		TaskID:          args.TaskID,
+		Summary:         args.Summary,
+		ThreadID:        threadID,
+		EstimateMinutes: args.EstimateMinutes,
+	}
+	enrichedRawArgs, err := json.Marshal(enrichedArgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal enriched args: %w", err)
+	}
+
+	// Submit command via v2Adapter with threadID included
+	result, err := cs.v2Adapter.HandleAssignTask(ctx, enrichedRawArgs)
+	if err != nil || result.IsError || args.WorkerID != "" {
+		return result, err
+	}
+	if assigned, ok := result.StructuredContent.(adapter.AssignTaskResult); ok {
+		cs.replyToTaskThread(threadID, fmt.Sprintf("Assigned to %s", assigned.WorkerID))
+	}
+	return result, nil
+}
+
+// postTaskThread posts a task assignment to the #tasks channel and returns the new thread ID.
+// The assignee is only named when the coordinator chose one; a worker selected by the
+// handler is added to the thread afterwards. Returns an empty string if Fabric is not
+// configured or the post fails; assignment can still proceed without a thread.
+func (cs *CoordinatorServer) postTaskThread(args assignTaskArgs) string {
+	if cs.fabricService == nil {
+		return ""
+	}
+
+	summary := args.Summary
+	if summary == "" {
+		summary = "Task assignment"
+	}
+	content := fmt.Sprintf("Task: %s [%s]", summary, args.TaskID)
+	if args.WorkerID != "" {
+		content += " assigned to " + args.WorkerID
+	}
+
+	thread, err := cs.fabricService.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: "tasks",
+		Content:     content,
+		CreatedBy:   repository.CoordinatorID,
+		// No mentions - worker gets notified via the v2 delivery mechanism
+	})
+	if err != nil {
+		// Log but continue - we can still assign the task without the Fabric thread
+		log.Debug(log.CatMCP, "Failed to post task assignment to #tasks",
+			"error", err, "taskID", args.TaskID, "workerID", args.WorkerID)
+		return ""
+	}
+	return thread.ID
+}
+
+// replyToTaskThread posts content as a reply in a task thread. It does nothing if Fabric
+// is not configured or the task has no thread; a failed reply is only logged.
+func (cs *CoordinatorServer) replyToTaskThread(threadID, content string) {
+	if cs.fabricService == nil || threadID == "" {
+		return
+	}
+	if _, err := cs.fabricService.Reply(fabric.ReplyInput{
+		MessageID: threadID,
+		Content:   content,
+		CreatedBy: repository.CoordinatorID,
+	}); err != nil {
+		log.Debug(log.CatMCP, "Failed to reply to task thread", "error", err, "threadID", threadID)
+	}
+}
+
+// handleAssignTasksBatch assigns several tasks to ready workers in one call.
+// Like handleAssignTask, each assignment gets its own #tasks thread before the
+// batch is submitted so every worker knows where to reply.
+func (cs *CoordinatorServer) handleAssignTasksBatch(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args struct {
+		Assignments []assignTaskArgs `json:"assignments"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	type enrichedAssignment struct {
 		WorkerID string `json:"worker_id"`
 		TaskID   string `json:"task_id"`
 		Summary  string `json:"summary,omitempty"`
 		ThreadID string `json:"thread_id,omitempty"`
-	}{
-		WorkerID: args.WorkerID,
-		TaskID:   args.TaskID,
-		Summary:  args.Summary,
-		ThreadID: threadID,
 	}
-	enrichedRawArgs, err := json.Marshal(enrichedArgs)
+	enriched := make([]enrichedAssignment, len(args.Assignments))
+	for i, assignment := range args.Assignments {
+		enriched[i] = enrichedAssignment{
+			WorkerID: assignment.WorkerID,
+			TaskID:   assignment.TaskID,
+			Summary:  assignment.Summary,
+			ThreadID: cs.postTaskThread(assignment),
+		}
+	}
+
+	enrichedRawArgs, err := json.Marshal(struct {
+		Assignments []enrichedAssignment `json:"assignments"`
+	}{Assignments: enriched})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal enriched args: %w", err)
 	}
 
-	// Submit command via v2Adapter with threadID included
-	return cs.v2Adapter.HandleAssignTask(ctx, enrichedRawArgs)
+	return cs.v2Adapter.HandleAssignTasksBatch(ctx, enrichedRawArgs)
+}
+
+// handleGetEligibleWorkers reports which workers could take a task and why the rest cannot.
+// Routes through v2Adapter which uses the command processor to run the assignment checks.
+func (cs *CoordinatorServer) handleGetEligibleWorkers(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetEligibleWorkers(ctx, rawArgs)
 }
 
 // handleReplaceWorker retires a worker and spawns a fresh replacement.
@@ -597,6 +1429,9 @@ func (cs *CoordinatorServer) handleGetTaskStatus(_ context.Context, rawArgs json
 	if !isValidTaskID(args.TaskID) {
 		return nil, fmt.Errorf("invalid task_id format: %s", args.TaskID)
 	}
+	if !validation.IsValidTaskIDWithPrefix(args.TaskID, cs.taskIDPrefix) {
+		return nil, fmt.Errorf("task_id %s is outside this workflow's prefix %q", args.TaskID, cs.taskIDPrefix)
+	}
 
 	// Get task info using BeadsExecutor
 	issue, err := cs.beadsExecutor.ShowIssue(args.TaskID)
@@ -606,12 +1441,13 @@ func (cs *CoordinatorServer) handleGetTaskStatus(_ context.Context, rawArgs json
 	}
 
 	// Return the issue as JSON wrapped in an array (for backward compatibility with bd show output)
-	data, err := json.MarshalIndent([]*beads.Issue{issue}, "", "  ")
+	issues := []*beads.Issue{issue}
+	data, err := json.MarshalIndent(issues, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("marshaling issue: %w", err)
 	}
 
-	return SuccessResult(string(data)), nil
+	return StructuredResult(string(data), GetTaskStatusResult{ToolResult: adapter.ToolResult{OK: true}, Issues: issues}), nil
 }
 
 // handleMarkTaskComplete marks a task as complete in bd.
@@ -626,15 +1462,123 @@ func (cs *CoordinatorServer) handleMarkTaskFailed(ctx context.Context, rawArgs j
 	return cs.v2Adapter.HandleMarkTaskFailed(ctx, rawArgs)
 }
 
+// handleAddTaskBlocker records a blocking dependency between two issues in bd.
+// Routes through v2Adapter which uses the command processor to update BD.
+func (cs *CoordinatorServer) handleAddTaskBlocker(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleAddTaskBlocker(ctx, rawArgs)
+}
+
+// handleSyncTaskStatus reconciles the coordinator's record of a task with its status in bd.
+// Routes through v2Adapter which uses the command processor to update task state.
+func (cs *CoordinatorServer) handleSyncTaskStatus(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleSyncTaskStatus(ctx, rawArgs)
+}
+
+// handleCompleteEpicTasks closes the open, unassigned subtasks of an epic in bd.
+// Routes through v2Adapter which uses the command processor to update BD.
+func (cs *CoordinatorServer) handleCompleteEpicTasks(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleCompleteEpicTasks(ctx, rawArgs)
+}
+
+// handleGetCriticalPath returns the longest dependency chain through an epic's open subtasks.
+// Routes through v2Adapter which uses the command processor to query BD.
+func (cs *CoordinatorServer) handleGetCriticalPath(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetCriticalPath(ctx, rawArgs)
+}
+
+// handleGetDependencyGraph returns the nodes and edges of an epic's dependency graph.
+// Routes through v2Adapter which uses the command processor to query BD.
+func (cs *CoordinatorServer) handleGetDependencyGraph(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetDependencyGraph(ctx, rawArgs)
+}
+
 // handleQueryWorkerState returns detailed worker state including phase.
 // Task assignment details are managed by v2 repositories.
 func (cs *CoordinatorServer) handleQueryWorkerState(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	return cs.v2Adapter.HandleQueryWorkerState(ctx, rawArgs)
 }
 
+// handlePingWorker checks a single worker's liveness.
+func (cs *CoordinatorServer) handlePingWorker(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandlePingWorker(ctx, rawArgs)
+}
+
+// handlePeekWorker returns the last lines of a worker's text output.
+func (cs *CoordinatorServer) handlePeekWorker(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandlePeekWorker(ctx, rawArgs)
+}
+
+// handleLabelWorker sets the cosmetic label shown alongside a worker's ID.
+func (cs *CoordinatorServer) handleLabelWorker(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleLabelWorker(ctx, rawArgs)
+}
+
+// handleListWorkflows lists the workflows running in the control plane.
+func (cs *CoordinatorServer) handleListWorkflows(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleListWorkflows(ctx, rawArgs)
+}
+
+// handleListModels lists the models the worker provider accepts.
+func (cs *CoordinatorServer) handleListModels(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleListModels(ctx, rawArgs)
+}
+
+// handleListOrphanedTasks lists active tasks whose assigned workers can no longer progress them.
+func (cs *CoordinatorServer) handleListOrphanedTasks(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleListOrphanedTasks(ctx, rawArgs)
+}
+
+// handleGetTaskTimings reports the time a task spent in each phase.
+func (cs *CoordinatorServer) handleGetTaskTimings(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetTaskTimings(ctx, rawArgs)
+}
+
+// handleGetHeadroom reports how many new tasks can be assigned right now.
+func (cs *CoordinatorServer) handleGetHeadroom(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetHeadroom(ctx, rawArgs)
+}
+
+// handleGetUtilization reports the share of worker time spent in each activity.
+func (cs *CoordinatorServer) handleGetUtilization(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetUtilization(ctx, rawArgs)
+}
+
+// handleGetWorkerBacklog reports each worker's unread message count.
+func (cs *CoordinatorServer) handleGetWorkerBacklog(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetWorkerBacklog(ctx, rawArgs)
+}
+
+// handleClearWorkerBacklog marks a worker's unread messages as read.
+func (cs *CoordinatorServer) handleClearWorkerBacklog(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleClearWorkerBacklog(ctx, rawArgs)
+}
+
+// handleGetMessageContent returns the full content of a truncated message.
+func (cs *CoordinatorServer) handleGetMessageContent(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetMessageContent(ctx, rawArgs)
+}
+
+// handleGetDiffSinceLastReview returns the diff delta since a task's last review.
+func (cs *CoordinatorServer) handleGetDiffSinceLastReview(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetDiffSinceLastReview(ctx, rawArgs, "")
+}
+
+// handleGetChangedFiles lists the files changed in a task's worktree.
+func (cs *CoordinatorServer) handleGetChangedFiles(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetChangedFiles(ctx, rawArgs, "")
+}
+
 // handleAssignTaskReview assigns a reviewer to a completed implementation.
 func (cs *CoordinatorServer) handleAssignTaskReview(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
-	return cs.v2Adapter.HandleAssignTaskReview(ctx, rawArgs)
+	result, err := cs.v2Adapter.HandleAssignTaskReview(ctx, rawArgs)
+	if err != nil || result.IsError {
+		return result, err
+	}
+	// Record the reviewer in the task thread, including one the handler selected
+	if review, ok := result.StructuredContent.(adapter.AssignTaskReviewResult); ok {
+		cs.replyToTaskThread(review.ThreadID, fmt.Sprintf("Review assigned to %s", review.ReviewerID))
+	}
+	return result, nil
 }
 
 // handleAssignReviewFeedback sends review feedback to implementer requiring changes.
@@ -642,6 +1586,16 @@ func (cs *CoordinatorServer) handleAssignReviewFeedback(ctx context.Context, raw
 	return cs.v2Adapter.HandleAssignReviewFeedback(ctx, rawArgs)
 }
 
+// handleTransferTask moves an in-flight task between workers.
+func (cs *CoordinatorServer) handleTransferTask(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleTransferTask(ctx, rawArgs)
+}
+
+// handleDrainWorker transfers a worker's tasks to ready workers and retires it.
+func (cs *CoordinatorServer) handleDrainWorker(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleDrainWorker(ctx, rawArgs)
+}
+
 // handleApproveCommit approves implementation and instructs worker to commit.
 func (cs *CoordinatorServer) handleApproveCommit(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	return cs.v2Adapter.HandleApproveCommit(ctx, rawArgs)
@@ -666,7 +1620,13 @@ func (cs *CoordinatorServer) handleStopProcess(_ context.Context, rawArgs json.R
 		return nil, fmt.Errorf("stop_worker failed: %w", err)
 	}
 
-	return SuccessResult("Worker stop command submitted"), nil
+	msg := "Worker stop command submitted"
+	return StructuredResult(msg, adapter.StopWorkerResult{
+		ToolResult: adapter.ToolResult{OK: true},
+		WorkerID:   args.WorkerID,
+		Force:      args.Force,
+		Message:    msg,
+	}), nil
 }
 
 // isValidTaskID validates that a task ID matches the expected format.
@@ -698,3 +1658,43 @@ func (cs *CoordinatorServer) handleNotifyUser(ctx context.Context, rawArgs json.
 	}
 	return cs.v2Adapter.HandleNotifyUser(ctx, rawArgs)
 }
+
+// handleSetGlobalInstruction adds or replaces the coordinator-wide task instruction.
+func (cs *CoordinatorServer) handleSetGlobalInstruction(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleSetGlobalInstruction(ctx, rawArgs)
+}
+
+// handleClearGlobalInstruction removes the coordinator-wide task instructions.
+func (cs *CoordinatorServer) handleClearGlobalInstruction(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleClearGlobalInstruction(ctx, rawArgs)
+}
+
+// handleSetFocus sets or clears the workflow-wide focus area.
+func (cs *CoordinatorServer) handleSetFocus(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleSetFocus(ctx, rawArgs)
+}
+
+// handleGetFocus returns the workflow-wide focus area.
+func (cs *CoordinatorServer) handleGetFocus(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleGetFocus(ctx, rawArgs)
+}
+
+// handleHoldTask puts a task on hold so it is not assigned.
+func (cs *CoordinatorServer) handleHoldTask(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleHoldTask(ctx, rawArgs)
+}
+
+// handleReleaseTask takes a task off hold.
+func (cs *CoordinatorServer) handleReleaseTask(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleReleaseTask(ctx, rawArgs)
+}
+
+// handleExportState returns the coordinator state as restorable JSON.
+func (cs *CoordinatorServer) handleExportState(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleExportState(ctx, rawArgs)
+}
+
+// handleImportState restores coordinator state returned by export_state.
+func (cs *CoordinatorServer) handleImportState(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleImportState(ctx, rawArgs)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
@@ -69,18 +70,49 @@ func TestCoordinatorServer_RegistersAllTools(t *testing.T) {
 	expectedTools := []string{
 		"spawn_worker",
 		"assign_task",
+		"assign_tasks_batch",
 		"replace_worker",
 		"retire_worker",
+		"get_eligible_workers",
 		"get_task_status",
 		"mark_task_complete",
 		"mark_task_failed",
+		"add_task_blocker",
+		"sync_task_status",
+		"complete_epic_tasks",
+		"get_critical_path",
+		"get_dependency_graph",
 		"query_worker_state",
+		"ping_worker",
+		"peek_worker",
+		"label_worker",
+		"list_workflows",
+		"list_models",
+		"list_orphaned_tasks",
+		"get_task_timings",
+		"get_utilization",
+		"get_headroom",
+		"get_worker_backlog",
+		"clear_worker_backlog",
+		"get_message_content",
 		"assign_task_review",
 		"assign_review_feedback",
+		"transfer_task",
+		"drain_worker",
+		"get_diff_since_last_review",
+		"get_changed_files",
 		"approve_commit",
 		"stop_worker",
 		"generate_accountability_summary",
 		"signal_workflow_complete",
+		"set_global_instruction",
+		"clear_global_instruction",
+		"set_focus",
+		"get_focus",
+		"hold_task",
+		"release_task",
+		"export_state",
+		"import_state",
 		"notify_user",
 	}
 
@@ -144,21 +176,11 @@ func TestCoordinatorServer_AssignTaskValidation(t *testing.T) {
 		args    string
 		wantErr bool
 	}{
-		{
-			name:    "missing worker_id",
-			args:    `{"task_id": "perles-abc"}`,
-			wantErr: true,
-		},
 		{
 			name:    "missing task_id",
 			args:    `{"worker_id": "worker-1"}`,
 			wantErr: true,
 		},
-		{
-			name:    "empty worker_id",
-			args:    `{"worker_id": "", "task_id": "perles-abc"}`,
-			wantErr: true,
-		},
 		{
 			name:    "empty task_id",
 			args:    `{"worker_id": "worker-1", "task_id": ""}`,
@@ -247,6 +269,38 @@ func TestCoordinatorServer_GetTaskStatusValidation(t *testing.T) {
 	}
 }
 
+// TestCoordinatorServer_GetTaskStatusPrefix tests that a workflow prefix scopes get_task_status.
+func TestCoordinatorServer_GetTaskStatusPrefix(t *testing.T) {
+	mockExec := mocks.NewMockIssueExecutor(t)
+	mockExec.EXPECT().ShowIssue("feat1-abc.1").Return(&beads.Issue{ID: "feat1-abc.1", Status: beads.StatusOpen}, nil).Once()
+
+	cs := NewCoordinatorServer("/tmp/test", 8765, mockExec)
+	cs.SetTaskIDPrefix("feat1")
+	handler := cs.handlers["get_task_status"]
+
+	result, err := handler(context.Background(), json.RawMessage(`{"task_id": "feat1-abc.1"}`))
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "feat1-abc.1")
+
+	for _, taskID := range []string{"feat2-abc", "feat10-abc", "perles-abc"} {
+		t.Run(taskID, func(t *testing.T) {
+			_, err := handler(context.Background(), json.RawMessage(`{"task_id": "`+taskID+`"}`))
+			require.ErrorContains(t, err, `outside this workflow's prefix "feat1"`)
+		})
+	}
+}
+
+// TestCoordinatorServer_SetTaskIDPrefixScopesAdapter tests that the prefix also scopes
+// the tools that change task state, which route through the adapter.
+func TestCoordinatorServer_SetTaskIDPrefixScopesAdapter(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	cs.SetV2Adapter(adapter.NewV2Adapter(nil))
+	cs.SetTaskIDPrefix("feat1")
+
+	_, err := cs.handlers["mark_task_complete"](context.Background(), json.RawMessage(`{"task_id": "feat2-abc.1"}`))
+	require.ErrorContains(t, err, `outside this workflow's prefix "feat1"`)
+}
+
 // TestCoordinatorServer_MarkTaskCompleteValidation tests input validation for mark_task_complete.
 func TestCoordinatorServer_MarkTaskCompleteValidation(t *testing.T) {
 	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
@@ -399,6 +453,70 @@ func TestCoordinatorServer_AssignTaskRouting(t *testing.T) {
 	require.Equal(t, command.CmdAssignTask, cmds[0].Type())
 }
 
+// assignedWorkerResult is handler result data reporting the worker a task went to.
+type assignedWorkerResult struct{ workerID string }
+
+func (r assignedWorkerResult) GetProcessID() string { return r.workerID }
+
+// TestCoordinatorServer_AssignTask_ThreadNamesSelectedWorker verifies the task thread is
+// not posted with a placeholder assignee when worker_id is omitted, and records the worker
+// the handler selected once the assignment succeeds.
+func TestCoordinatorServer_AssignTask_ThreadNamesSelectedWorker(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	fabricService := createTestFabricService(t)
+	cs.SetFabricService(fabricService)
+
+	v2handler, cleanup := injectV2AdapterToCoordinator(t, cs)
+	defer cleanup()
+	v2handler.SetResult(&command.CommandResult{
+		Success: true,
+		Data:    assignedWorkerResult{workerID: "worker-3"},
+	})
+
+	args := `{"task_id": "perles-abc.1", "summary": "Add login"}`
+	result, err := cs.handlers["assign_task"](context.Background(), json.RawMessage(args))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	messages, err := fabricService.ListMessages("tasks", 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, "Task: Add login [perles-abc.1]", messages[0].Content)
+
+	replies, err := fabricService.GetReplies(messages[0].ID)
+	require.NoError(t, err)
+	require.Len(t, replies, 1)
+	require.Equal(t, "Assigned to worker-3", replies[0].Content)
+}
+
+// TestCoordinatorServer_AssignTask_NamedWorkerInThreadRoot verifies a worker named by the
+// coordinator is in the thread's first message, with no follow-up reply.
+func TestCoordinatorServer_AssignTask_NamedWorkerInThreadRoot(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	fabricService := createTestFabricService(t)
+	cs.SetFabricService(fabricService)
+
+	v2handler, cleanup := injectV2AdapterToCoordinator(t, cs)
+	defer cleanup()
+	v2handler.SetResult(&command.CommandResult{
+		Success: true,
+		Data:    assignedWorkerResult{workerID: "worker-1"},
+	})
+
+	args := `{"worker_id": "worker-1", "task_id": "perles-abc.1", "summary": "Add login"}`
+	_, err := cs.handlers["assign_task"](context.Background(), json.RawMessage(args))
+	require.NoError(t, err)
+
+	messages, err := fabricService.ListMessages("tasks", 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, "Task: Add login [perles-abc.1] assigned to worker-1", messages[0].Content)
+
+	replies, err := fabricService.GetReplies(messages[0].ID)
+	require.NoError(t, err)
+	require.Empty(t, replies)
+}
+
 // TestQueryWorkerState_NoWorkers verifies query_worker_state returns empty when no workers exist.
 // This test uses the v2 adapter since handleQueryWorkerState delegates to it.
 func TestQueryWorkerState_NoWorkers(t *testing.T) {
@@ -603,7 +721,6 @@ func TestAssignTaskReview_ValidationRequired(t *testing.T) {
 		name string
 		args string
 	}{
-		{"missing reviewer_id", `{"task_id": "perles-abc.1", "implementer_id": "worker-1"}`},
 		{"missing task_id", `{"reviewer_id": "worker-2", "implementer_id": "worker-1"}`},
 		{"missing implementer_id", `{"reviewer_id": "worker-2", "task_id": "perles-abc.1"}`},
 	}
@@ -645,6 +762,34 @@ func TestAssignReviewFeedback_ValidationRequired(t *testing.T) {
 	}
 }
 
+// TestTransferTask_ValidationRequired verifies required field validation.
+func TestTransferTask_ValidationRequired(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+
+	// Inject v2 adapter for test
+	_, cleanup := injectV2AdapterToCoordinator(t, cs)
+	defer cleanup()
+
+	handler := cs.handlers["transfer_task"]
+
+	tests := []struct {
+		name string
+		args string
+	}{
+		{"missing task_id", `{"from_worker": "worker-1", "to_worker": "worker-2"}`},
+		{"missing from_worker", `{"task_id": "perles-abc.1", "to_worker": "worker-2"}`},
+		{"missing to_worker", `{"task_id": "perles-abc.1", "from_worker": "worker-1"}`},
+		{"same worker", `{"task_id": "perles-abc.1", "from_worker": "worker-1", "to_worker": "worker-1"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler(context.Background(), json.RawMessage(tt.args))
+			require.Error(t, err, "Expected error for %s", tt.name)
+		})
+	}
+}
+
 // TestApproveCommit_ValidationRequired verifies required field validation.
 // Note: Business logic tests (task not approved, implementer mismatch) are now in v2 handler tests.
 func TestApproveCommit_ValidationRequired(t *testing.T) {
@@ -783,6 +928,20 @@ func TestCoordinatorServer_AssignTaskSchemaIncludesSummary(t *testing.T) {
 	}
 }
 
+// TestCoordinatorServer_FocusToolsHaveOutputSchemas verifies set_focus and get_focus
+// describe the focus they return.
+func TestCoordinatorServer_FocusToolsHaveOutputSchemas(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+
+	for _, name := range []string{"set_focus", "get_focus"} {
+		tool, ok := cs.tools[name]
+		require.True(t, ok, "%s tool not registered", name)
+		require.NotNil(t, tool.OutputSchema, "%s should have an output schema", name)
+		require.Contains(t, tool.OutputSchema.Required, "focus", "%s should return the focus", name)
+	}
+	require.Contains(t, cs.tools["set_focus"].OutputSchema.Properties, "message")
+}
+
 // TestIntegration_QueryWorkerState verifies query_worker_state returns correct data from v2 repository.
 func TestIntegration_QueryWorkerState(t *testing.T) {
 	// Use NewTestCoordinatorServer which includes v2 adapter with repositories
@@ -0,0 +1,88 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/zjrosen/perles/internal/orchestration/client/providers/cursor"
+)
+
+// instructionsResult is the response payload for get_instructions.
+type instructionsResult struct {
+	ServerInfo   ImplementationInfo `json:"server_info"`
+	Instructions string             `json:"instructions"`
+}
+
+// registerDiagnosticTools registers read-only debugging tools.
+func (s *Server) registerDiagnosticTools() {
+	s.RegisterTool(Tool{
+		Name:        "get_instructions",
+		Description: "Diagnostic: return the instructions and server info this MCP server was configured with.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+		},
+	}, s.handleGetInstructions)
+}
+
+// handleGetInstructions returns the server's configured instructions and info.
+func (s *Server) handleGetInstructions(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+	data, err := json.MarshalIndent(instructionsResult{
+		ServerInfo:   s.info,
+		Instructions: s.instructions,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling instructions: %w", err)
+	}
+
+	return SuccessResult(string(data)), nil
+}
+
+// cursorMCPConfigArgs holds arguments for the get_cursor_mcp_config tool.
+type cursorMCPConfigArgs struct {
+	WorkDir string `json:"work_dir,omitempty"`
+}
+
+// EnableDiagnostics registers the shared diagnostic tools plus get_cursor_mcp_config,
+// the coordinator-only diagnostic for inspecting the MCP config the Cursor provider
+// merged into .cursor/mcp.json.
+func (cs *CoordinatorServer) EnableDiagnostics() {
+	cs.registerDiagnosticTools()
+
+	cs.RegisterTool(Tool{
+		Name:        "get_cursor_mcp_config",
+		Description: "Diagnostic: return the .cursor/mcp.json written by the Cursor provider, as merged with any user-defined servers. Use to debug MCP wiring for Cursor workers.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"work_dir": {Type: "string", Description: "Directory the Cursor process ran in; relative paths are resolved against the coordinator's work directory (default: the coordinator's work directory)"},
+			},
+		},
+	}, cs.handleGetCursorMCPConfig)
+}
+
+// handleGetCursorMCPConfig returns the rendered .cursor/mcp.json for a work directory.
+func (cs *CoordinatorServer) handleGetCursorMCPConfig(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args cursorMCPConfigArgs
+	if len(rawArgs) > 0 {
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	workDir := cs.workDir
+	if args.WorkDir != "" {
+		workDir = args.WorkDir
+		if !filepath.IsAbs(workDir) {
+			workDir = filepath.Join(cs.workDir, workDir)
+		}
+	}
+
+	data, err := cursor.NewClient().RenderedMCPConfig(workDir)
+	if err != nil {
+		return ErrorResult(err.Error()), nil
+	}
+	return SuccessResult(string(data)), nil
+}
@@ -0,0 +1,88 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+)
+
+func TestDiagnosticTools_NotRegisteredByDefault(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	ws := NewWorkerServer("worker-1")
+
+	require.NotContains(t, cs.tools, "get_instructions")
+	require.NotContains(t, cs.tools, "get_cursor_mcp_config")
+	require.NotContains(t, ws.tools, "get_instructions")
+}
+
+func TestGetInstructions_CoordinatorReturnsInstructions(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	cs.EnableDiagnostics()
+
+	handler := cs.handlers["get_instructions"]
+	result, err := handler(context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	var got instructionsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &got))
+	require.Equal(t, coordinatorInstructions, got.Instructions)
+	require.Equal(t, "perles-orchestrator", got.ServerInfo.Name)
+	require.Equal(t, "1.0.0", got.ServerInfo.Version)
+}
+
+func TestGetInstructions_WorkerReturnsInstructions(t *testing.T) {
+	ws := NewWorkerServer("worker-1")
+	ws.EnableDiagnostics()
+
+	handler := ws.handlers["get_instructions"]
+	result, err := handler(context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	var got instructionsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &got))
+	require.Equal(t, prompt.WorkerMCPInstructions("worker-1"), got.Instructions)
+	require.Equal(t, "perles-worker", got.ServerInfo.Name)
+}
+
+func TestGetInstructions_ServerWithDiagnosticsEnabled(t *testing.T) {
+	s := NewServer("test", "1.0.0", WithInstructions("secret prompt"))
+	s.EnableDiagnostics()
+
+	result, err := s.handlers["get_instructions"](context.Background(), nil)
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "secret prompt")
+}
+
+func TestGetCursorMCPConfig_ReturnsRenderedConfig(t *testing.T) {
+	workDir := t.TempDir()
+	workerDir := filepath.Join(workDir, "workers", "worker-1")
+	cursorDir := filepath.Join(workerDir, ".cursor")
+	require.NoError(t, os.MkdirAll(cursorDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(cursorDir, "mcp.json"),
+		[]byte(`{"mcpServers":{"perles-worker":{"url":"http://localhost:8765/worker/worker-1"}}}`), 0o600))
+
+	cs := NewCoordinatorServer(workDir, 8765, mocks.NewMockIssueExecutor(t))
+	cs.EnableDiagnostics()
+
+	result, err := cs.handlers["get_cursor_mcp_config"](context.Background(), json.RawMessage(`{"work_dir": "workers/worker-1"}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Contains(t, result.Content[0].Text, "perles-worker")
+}
+
+func TestGetCursorMCPConfig_MissingFile(t *testing.T) {
+	cs := NewCoordinatorServer(t.TempDir(), 8765, mocks.NewMockIssueExecutor(t))
+	cs.EnableDiagnostics()
+
+	result, err := cs.handlers["get_cursor_mcp_config"](context.Background(), nil)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	require.Contains(t, result.Content[0].Text, "cursor MCP config has not been written")
+}
@@ -0,0 +1,74 @@
+package mcp
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+)
+
+// metricsContentType is the Prometheus text exposition format content type.
+const metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// MetricsHandler returns an HTTP handler that exposes worker pool and task state
+// in the Prometheus text exposition format. Workers are counted as stuck after
+// stuckTimeout without completing a turn.
+//
+// Values are read from the coordinator's V2 adapter on every scrape; if no adapter
+// is configured the handler responds with 503 Service Unavailable.
+func MetricsHandler(cs *CoordinatorServer, stuckTimeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if cs.v2Adapter == nil {
+			http.Error(w, "metrics unavailable: v2 adapter not configured", http.StatusServiceUnavailable)
+			return
+		}
+
+		var buf bytes.Buffer
+		writeMetrics(&buf, cs.v2Adapter.Metrics(cs.v2Adapter.Now(), stuckTimeout))
+
+		w.Header().Set("Content-Type", metricsContentType)
+		_, _ = w.Write(buf.Bytes())
+	})
+}
+
+// writeMetrics renders a snapshot in the Prometheus text exposition format.
+func writeMetrics(buf *bytes.Buffer, m adapter.MetricsSnapshot) {
+	writeMetricHeader(buf, "perles_workers", "gauge", "Number of workers by status.")
+	fmt.Fprintf(buf, "perles_workers{status=\"ready\"} %d\n", m.ReadyWorkers)
+	fmt.Fprintf(buf, "perles_workers{status=\"working\"} %d\n", m.WorkingWorkers)
+	fmt.Fprintf(buf, "perles_workers{status=\"retired\"} %d\n", m.RetiredWorkers)
+
+	writeMetricHeader(buf, "perles_orphaned_tasks", "gauge", "Number of active tasks whose implementer or reviewer is gone.")
+	fmt.Fprintf(buf, "perles_orphaned_tasks %d\n", m.OrphanedTasks)
+
+	writeMetricHeader(buf, "perles_stuck_workers", "gauge", "Number of workers working past the stuck timeout without completing a turn.")
+	fmt.Fprintf(buf, "perles_stuck_workers %d\n", m.StuckWorkers)
+
+	writeMetricHeader(buf, "perles_tasks", "gauge", "Number of tasks by status.")
+	for _, status := range adapter.MetricsTaskStatuses() {
+		fmt.Fprintf(buf, "perles_tasks{status=%q} %d\n", string(status), m.TasksByStatus[status])
+	}
+
+	// The totals below are read from current state rather than counted as events happen,
+	// so they can go down and are exposed as gauges
+	writeMetricHeader(buf, "perles_spawned_workers", "gauge", "Number of workers spawned, including retired and failed ones.")
+	fmt.Fprintf(buf, "perles_spawned_workers %d\n", m.SpawnedWorkers)
+
+	writeMetricHeader(buf, "perles_completed_tasks", "gauge", "Number of tasks completed.")
+	fmt.Fprintf(buf, "perles_completed_tasks %d\n", m.CompletedTasks)
+
+	writeMetricHeader(buf, "perles_review_rounds", "gauge", "Number of review verdicts recorded on current tasks.")
+	fmt.Fprintf(buf, "perles_review_rounds %d\n", m.ReviewRounds)
+}
+
+// writeMetricHeader writes the HELP and TYPE lines that precede a metric family.
+func writeMetricHeader(buf *bytes.Buffer, name, metricType, help string) {
+	fmt.Fprintf(buf, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(buf, "# TYPE %s %s\n", name, metricType)
+}
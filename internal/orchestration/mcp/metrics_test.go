@@ -0,0 +1,125 @@
+package mcp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// newMetricsCoordinator returns a coordinator server whose adapter reads the given repositories.
+func newMetricsCoordinator(t *testing.T, processRepo repository.ProcessRepository, taskRepo repository.TaskRepository) *CoordinatorServer {
+	t.Helper()
+	v2Adapter := adapter.NewV2Adapter(nil,
+		adapter.WithProcessRepository(processRepo),
+		adapter.WithTaskRepository(taskRepo),
+	)
+	return NewCoordinatorServerWithV2Adapter("/tmp/test", 8765, mocks.NewMockIssueExecutor(t), v2Adapter)
+}
+
+func scrapeMetrics(t *testing.T, h http.Handler) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	return rec
+}
+
+func TestMetricsHandler_ExpositionFormat(t *testing.T) {
+	cs := newMetricsCoordinator(t, repository.NewMemoryProcessRepository(), repository.NewMemoryTaskRepository())
+
+	rec := scrapeMetrics(t, MetricsHandler(cs, 15*time.Minute))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	body := rec.Body.String()
+	require.True(t, strings.HasSuffix(body, "\n"), "exposition must end with a newline")
+
+	types := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "# HELP "):
+			require.Len(t, strings.SplitN(line, " ", 4), 4, "HELP line needs name and text: %q", line)
+		case strings.HasPrefix(line, "# TYPE "):
+			fields := strings.Fields(line)
+			require.Len(t, fields, 4, "TYPE line: %q", line)
+			types[fields[2]] = fields[3]
+		default:
+			fields := strings.Fields(line)
+			require.Len(t, fields, 2, "sample line: %q", line)
+			name, _, _ := strings.Cut(fields[0], "{")
+			require.Contains(t, types, name, "sample %q must follow its TYPE line", line)
+		}
+	}
+
+	require.Equal(t, map[string]string{
+		"perles_workers":         "gauge",
+		"perles_orphaned_tasks":  "gauge",
+		"perles_stuck_workers":   "gauge",
+		"perles_tasks":           "gauge",
+		"perles_spawned_workers": "gauge",
+		"perles_completed_tasks": "gauge",
+		"perles_review_rounds":   "gauge",
+	}, types)
+}
+
+func TestMetricsHandler_ReflectsSeededState(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	for _, p := range []*repository.Process{
+		{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady},
+		{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, LastActivityAt: time.Now().Add(-time.Hour)},
+		{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusRetired},
+	} {
+		require.NoError(t, processRepo.Save(p))
+	}
+	taskRepo := repository.NewMemoryTaskRepository()
+	for _, task := range []*repository.TaskAssignment{
+		{TaskID: "perles-abc1", Implementer: "worker-2", Status: repository.TaskInReview, Reviewer: "worker-3", ReviewRounds: 1},
+		{TaskID: "perles-abc2", Implementer: "worker-1", Status: repository.TaskCompleted, ReviewRounds: 2},
+	} {
+		require.NoError(t, taskRepo.Save(task))
+	}
+	cs := newMetricsCoordinator(t, processRepo, taskRepo)
+
+	body := scrapeMetrics(t, MetricsHandler(cs, 15*time.Minute)).Body.String()
+
+	for _, sample := range []string{
+		`perles_workers{status="ready"} 1`,
+		`perles_workers{status="working"} 1`,
+		`perles_workers{status="retired"} 1`,
+		`perles_orphaned_tasks 1`,
+		`perles_stuck_workers 1`,
+		`perles_tasks{status="in_review"} 1`,
+		`perles_tasks{status="completed"} 1`,
+		`perles_tasks{status="implementing"} 0`,
+		`perles_spawned_workers 3`,
+		`perles_completed_tasks 1`,
+		`perles_review_rounds 3`,
+	} {
+		require.Contains(t, body, sample+"\n")
+	}
+}
+
+func TestMetricsHandler_RejectsNonGet(t *testing.T) {
+	cs := newMetricsCoordinator(t, repository.NewMemoryProcessRepository(), repository.NewMemoryTaskRepository())
+
+	rec := httptest.NewRecorder()
+	MetricsHandler(cs, time.Minute).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/metrics", nil))
+
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestMetricsHandler_NoAdapter(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+
+	rec := scrapeMetrics(t, MetricsHandler(cs, time.Minute))
+
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
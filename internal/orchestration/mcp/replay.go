@@ -0,0 +1,163 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+)
+
+// RecordedCall is a single tool call captured for replay, along with its outcome
+// and the orchestration state observed after it ran.
+type RecordedCall struct {
+	// WorkerID identifies the worker that made the call (empty for coordinator).
+	WorkerID string `json:"worker_id,omitempty"`
+	// Tool is the name of the tool that was called.
+	Tool string `json:"tool"`
+	// Args contains the raw tool arguments.
+	Args json.RawMessage `json:"args,omitempty"`
+	// Result is the text content returned by the tool.
+	Result string `json:"result,omitempty"`
+	// IsError is true when the tool returned an error result.
+	IsError bool `json:"is_error,omitempty"`
+	// Error contains the error message if the handler failed.
+	Error string `json:"error,omitempty"`
+	// Snapshot is the process and task state after the call.
+	// Nil skips the state comparison during replay.
+	Snapshot *adapter.StateSnapshot `json:"snapshot,omitempty"`
+}
+
+// RecordedSession is an ordered log of tool calls that can be replayed
+// against a fresh coordinator to reproduce orchestration state.
+type RecordedSession struct {
+	Calls []RecordedCall `json:"calls"`
+}
+
+// Recorder executes tool calls against a coordinator and records them,
+// together with a state snapshot, into a RecordedSession.
+type Recorder struct {
+	dispatcher *replayDispatcher
+	session    RecordedSession
+}
+
+// NewRecorder creates a recorder that dispatches calls through the given coordinator.
+// Worker calls are routed to worker servers that share the coordinator's v2 adapter.
+func NewRecorder(cs *CoordinatorServer) *Recorder {
+	return &Recorder{dispatcher: newReplayDispatcher(cs)}
+}
+
+// Call executes a tool call and appends it to the recorded session.
+// An empty workerID routes the call to the coordinator.
+func (r *Recorder) Call(ctx context.Context, workerID, tool string, args json.RawMessage) (*ToolCallResult, error) {
+	result, err := r.dispatcher.call(ctx, workerID, tool, args)
+	r.session.Calls = append(r.session.Calls, r.dispatcher.record(workerID, tool, args, result, err))
+	return result, err
+}
+
+// Session returns the calls recorded so far.
+func (r *Recorder) Session() *RecordedSession {
+	return &r.session
+}
+
+// Replay feeds the recorded tool calls to the coordinator in order and verifies that
+// each call produces the recorded result and leaves the recorded state behind.
+// Returns an error describing the first call that diverges.
+func Replay(cs *CoordinatorServer, session *RecordedSession) error {
+	dispatcher := newReplayDispatcher(cs)
+	ctx := context.Background()
+
+	for i, want := range session.Calls {
+		result, err := dispatcher.call(ctx, want.WorkerID, want.Tool, want.Args)
+		got := dispatcher.record(want.WorkerID, want.Tool, want.Args, result, err)
+
+		if got.Error != want.Error {
+			return replayMismatch(i, want, "error", want.Error, got.Error)
+		}
+		if got.IsError != want.IsError || got.Result != want.Result {
+			return replayMismatch(i, want, "result", want.Result, got.Result)
+		}
+		if want.Snapshot != nil && !reflect.DeepEqual(got.Snapshot, want.Snapshot) {
+			wantJSON, _ := json.Marshal(want.Snapshot)
+			gotJSON, _ := json.Marshal(got.Snapshot)
+			return replayMismatch(i, want, "state", string(wantJSON), string(gotJSON))
+		}
+	}
+
+	return nil
+}
+
+func replayMismatch(index int, call RecordedCall, field, want, got string) error {
+	caller := call.WorkerID
+	if caller == "" {
+		caller = "coordinator"
+	}
+	return fmt.Errorf("replay call %d (%s by %s): %s mismatch: want %q, got %q",
+		index, call.Tool, caller, field, want, got)
+}
+
+// replayDispatcher routes recorded calls to the coordinator or to per-worker servers.
+type replayDispatcher struct {
+	cs      *CoordinatorServer
+	workers map[string]*WorkerServer
+}
+
+func newReplayDispatcher(cs *CoordinatorServer) *replayDispatcher {
+	return &replayDispatcher{
+		cs:      cs,
+		workers: make(map[string]*WorkerServer),
+	}
+}
+
+// server returns the MCP server that handles calls for the given worker.
+func (d *replayDispatcher) server(workerID string) *Server {
+	if workerID == "" {
+		return d.cs.Server
+	}
+
+	ws, ok := d.workers[workerID]
+	if !ok {
+		ws = NewWorkerServer(workerID)
+		ws.SetV2Adapter(d.cs.v2Adapter)
+		if d.cs.fabricService != nil {
+			ws.SetFabricService(d.cs.fabricService)
+		}
+		d.workers[workerID] = ws
+	}
+	return ws.Server
+}
+
+func (d *replayDispatcher) call(ctx context.Context, workerID, tool string, args json.RawMessage) (*ToolCallResult, error) {
+	handler, ok := d.server(workerID).GetHandler(tool)
+	if !ok {
+		return nil, fmt.Errorf("unknown tool: %s", tool)
+	}
+	return handler(ctx, args)
+}
+
+// record converts a call outcome into a RecordedCall, capturing the current state.
+func (d *replayDispatcher) record(workerID, tool string, args json.RawMessage, result *ToolCallResult, err error) RecordedCall {
+	call := RecordedCall{
+		WorkerID: workerID,
+		Tool:     tool,
+		Args:     args,
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+	if result != nil {
+		call.IsError = result.IsError
+		texts := make([]string, 0, len(result.Content))
+		for _, item := range result.Content {
+			texts = append(texts, item.Text)
+		}
+		call.Result = strings.Join(texts, "\n")
+	}
+	if d.cs.v2Adapter != nil {
+		snapshot := d.cs.v2Adapter.Snapshot()
+		call.Snapshot = &snapshot
+	}
+	return call
+}
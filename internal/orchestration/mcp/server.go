@@ -115,6 +115,12 @@ func (s *Server) RegisterTool(tool Tool, handler ToolHandler) {
 	s.handlers[tool.Name] = handler
 }
 
+// EnableDiagnostics registers read-only debugging tools such as get_instructions.
+// They are not registered by default because they can expose prompt contents.
+func (s *Server) EnableDiagnostics() {
+	s.registerDiagnosticTools()
+}
+
 // Broker returns the MCP event broker for session logging.
 func (s *Server) Broker() *pubsub.Broker[events.MCPEvent] {
 	return s.broker
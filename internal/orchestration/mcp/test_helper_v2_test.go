@@ -97,6 +97,7 @@ func injectV2AdapterToCoordinator(t *testing.T, cs *CoordinatorServer) (*testV2H
 	proc.RegisterHandler(command.CmdAssignReview, handler)
 	proc.RegisterHandler(command.CmdReportComplete, handler)
 	proc.RegisterHandler(command.CmdReportVerdict, handler)
+	proc.RegisterHandler(command.CmdRequestRetirement, handler)
 	proc.RegisterHandler(command.CmdMarkTaskComplete, handler)
 	proc.RegisterHandler(command.CmdMarkTaskFailed, handler)
 	proc.RegisterHandler(command.CmdApproveCommit, handler)
@@ -171,6 +172,7 @@ func NewTestCoordinatorServer(t *testing.T) *TestCoordinatorServerWrapper {
 	proc.RegisterHandler(command.CmdAssignReview, handler)
 	proc.RegisterHandler(command.CmdReportComplete, handler)
 	proc.RegisterHandler(command.CmdReportVerdict, handler)
+	proc.RegisterHandler(command.CmdRequestRetirement, handler)
 	proc.RegisterHandler(command.CmdMarkTaskComplete, handler)
 	proc.RegisterHandler(command.CmdMarkTaskFailed, handler)
 	proc.RegisterHandler(command.CmdApproveCommit, handler)
@@ -261,6 +263,7 @@ func NewTestWorkerServer(t *testing.T, workerID string) *TestWorkerServerWrapper
 	proc.RegisterHandler(command.CmdAssignReview, handler)
 	proc.RegisterHandler(command.CmdReportComplete, handler)
 	proc.RegisterHandler(command.CmdReportVerdict, handler)
+	proc.RegisterHandler(command.CmdRequestRetirement, handler)
 	proc.RegisterHandler(command.CmdMarkTaskComplete, handler)
 	proc.RegisterHandler(command.CmdMarkTaskFailed, handler)
 	proc.RegisterHandler(command.CmdApproveCommit, handler)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -82,6 +83,32 @@ func NewWorkerServer(workerID string) *WorkerServer {
 	return ws
 }
 
+// WorkerToolHints returns a hint for each tool a worker server exposes, sorted by name,
+// for the tool reminder in task assignment prompts. Each hint is the first sentence of
+// the tool's description, to keep the reminder short.
+func WorkerToolHints() []prompt.ToolHint {
+	ws := NewWorkerServer("")
+	defer ws.Stop()
+	ws.registerFabricToolsWithEnforcement(fabricmcp.NewHandlers(nil, ""))
+
+	ws.mu.RLock()
+	defer ws.mu.RUnlock()
+	hints := make([]prompt.ToolHint, 0, len(ws.tools))
+	for _, tool := range ws.tools {
+		hints = append(hints, prompt.ToolHint{Name: tool.Name, Usage: firstSentence(tool.Description)})
+	}
+	slices.SortFunc(hints, func(a, b prompt.ToolHint) int { return strings.Compare(a.Name, b.Name) })
+	return hints
+}
+
+// firstSentence returns text up to and including its first ". ", or all of text.
+func firstSentence(text string) string {
+	if i := strings.Index(text, ". "); i >= 0 {
+		return text[:i+1]
+	}
+	return text
+}
+
 // SetAccountabilityWriter sets the accountability writer for saving worker accountability summaries.
 // This must be called before the post_accountability_summary tool can be used.
 func (ws *WorkerServer) SetAccountabilityWriter(writer AccountabilityWriter) {
@@ -199,6 +226,46 @@ func (ws *WorkerServer) registerTools() {
 		},
 	}, ws.handleReportImplementationComplete)
 
+	// report_test_results - Record test run results on the current task
+	ws.RegisterTool(Tool{
+		Name:        "report_test_results",
+		Description: "Record the results of your latest test run on your current task. The reviewer and coordinator see these counts; failing tests may block commit approval.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"passed": {Type: "number", Description: "Number of passing tests"},
+				"failed": {Type: "number", Description: "Number of failing tests"},
+				"output": {Type: "string", Description: "Relevant test output, e.g. failing test names (optional)"},
+			},
+			Required: []string{"passed", "failed"},
+		},
+	}, ws.handleReportTestResults)
+
+	// checkpoint - Snapshot work-in-progress so a replacement can restore it
+	ws.RegisterTool(Tool{
+		Name:        "checkpoint",
+		Description: "Save a snapshot of your uncommitted work on the current task. Nothing is committed and your changes stay in the worktree; if you are replaced, your replacement can restore the latest checkpoint. Call this after each meaningful step of a long task. Only available in worktree-enabled workflows.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"message": {Type: "string", Description: "Short note on the progress so far, added to the commit message (optional)"},
+			},
+		},
+	}, ws.handleCheckpoint)
+
+	// mark_feedback_addressed - Check off review feedback items on the current task
+	ws.RegisterTool(Tool{
+		Name:        "mark_feedback_addressed",
+		Description: "Mark numbered review feedback items on your current task as addressed. Call this as you finish each item from the review feedback checklist.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"item_ids": {Type: "array", Description: "Numbers of the feedback items you addressed", Items: &PropertySchema{Type: "integer"}},
+			},
+			Required: []string{"item_ids"},
+		},
+	}, ws.handleMarkFeedbackAddressed)
+
 	// report_review_verdict - Report code review verdict
 	ws.RegisterTool(Tool{
 		Name:        "report_review_verdict",
@@ -214,6 +281,58 @@ func (ws *WorkerServer) registerTools() {
 		},
 	}, ws.handleReportReviewVerdict)
 
+	// get_diff_since_last_review - Show changes since the task's last review verdict
+	ws.RegisterTool(Tool{
+		Name:        "get_diff_since_last_review",
+		Description: "When re-reviewing a task, show only what changed in the worktree diff since the last review verdict instead of the full diff.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID (defaults to your current task)"},
+			},
+			Required: []string{},
+		},
+	}, ws.handleGetDiffSinceLastReview)
+
+	// list_changed_files - List the files changed in the worktree for the current task
+	ws.RegisterTool(Tool{
+		Name:        "list_changed_files",
+		Description: "List the file paths changed in your worktree (staged, unstaged and untracked) without the diff. The list is capped; total reports the full count.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID (defaults to your current task)"},
+			},
+			Required: []string{},
+		},
+	}, ws.handleListChangedFiles)
+
+	// fetch_context - Read a section elided from the task assignment prompt
+	ws.RegisterTool(Tool{
+		Name:        "fetch_context",
+		Description: "Read a section of your task assignment in full. Use this when your task prompt notes that a section was truncated or omitted to fit the prompt size limit.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"section": {Type: "string", Description: "Section to fetch: 'instructions' (coordinator instructions) or 'guidelines' (implementation workflow)"},
+			},
+			Required: []string{"section"},
+		},
+	}, ws.handleFetchContext)
+
+	// request_retirement - Ask to be replaced after the current turn
+	ws.RegisterTool(Tool{
+		Name:        "request_retirement",
+		Description: "Ask to be retired and replaced by a fresh worker after your current turn, e.g. when your context is corrupted or you are stuck in a bad state. The coordinator is notified and will reassign your task. End your turn after calling this.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"reason": {Type: "string", Description: "Why you need to be retired"},
+			},
+			Required: []string{"reason"},
+		},
+	}, ws.handleRequestRetirement)
+
 	// post_accountability_summary - Save worker accountability summary to session directory
 	ws.RegisterTool(Tool{
 		Name:        "post_accountability_summary",
@@ -309,6 +428,12 @@ func (ws *WorkerServer) handleReportImplementationComplete(ctx context.Context,
 		if args.Summary == "" {
 			content = "Implementation complete @coordinator"
 		}
+		if result.ReviewSkipped {
+			content += " (review not required, committing)"
+		}
+		if n := len(result.Unaddressed); n > 0 {
+			content += fmt.Sprintf(" (%d review feedback item(s) not marked addressed)", n)
+		}
 
 		_, postErr := ws.fabricService.Reply(fabric.ReplyInput{
 			MessageID: result.ThreadID,
@@ -326,6 +451,41 @@ func (ws *WorkerServer) handleReportImplementationComplete(ctx context.Context,
 	return mcptypes.SuccessResult(result.Message), nil
 }
 
+// handleReportTestResults records test run results on the worker's current task.
+func (ws *WorkerServer) handleReportTestResults(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return ws.v2Adapter.HandleReportTestResults(ctx, rawArgs, ws.workerID)
+}
+
+// handleCheckpoint commits the worker's work-in-progress on its current task.
+func (ws *WorkerServer) handleCheckpoint(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return ws.v2Adapter.HandleCheckpoint(ctx, rawArgs, ws.workerID)
+}
+
+// handleMarkFeedbackAddressed marks review feedback items on the worker's current task as addressed.
+func (ws *WorkerServer) handleMarkFeedbackAddressed(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return ws.v2Adapter.HandleMarkFeedbackAddressed(ctx, rawArgs, ws.workerID)
+}
+
+// handleGetDiffSinceLastReview returns the diff delta since the last review of the worker's task.
+func (ws *WorkerServer) handleGetDiffSinceLastReview(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return ws.v2Adapter.HandleGetDiffSinceLastReview(ctx, rawArgs, ws.workerID)
+}
+
+// handleListChangedFiles lists the files changed in the worktree for the worker's task.
+func (ws *WorkerServer) handleListChangedFiles(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return ws.v2Adapter.HandleGetChangedFiles(ctx, rawArgs, ws.workerID)
+}
+
+// handleFetchContext returns a section of the worker's task assignment prompt in full.
+func (ws *WorkerServer) handleFetchContext(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return ws.v2Adapter.HandleFetchContext(ctx, rawArgs, ws.workerID)
+}
+
+// handleRequestRetirement marks the worker for retirement after its current turn.
+func (ws *WorkerServer) handleRequestRetirement(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return ws.v2Adapter.HandleRequestRetirement(ctx, rawArgs, ws.workerID)
+}
+
 // handleReportReviewVerdict reports the code review verdict (APPROVED or DENIED).
 // Replies to the task's Fabric thread (if available) with @coordinator mention.
 func (ws *WorkerServer) handleReportReviewVerdict(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -114,7 +115,14 @@ func TestWorkerServer_RegistersAllTools(t *testing.T) {
 	// Worker-specific tools
 	workerTools := []string{
 		"report_implementation_complete",
+		"report_test_results",
+		"checkpoint",
+		"mark_feedback_addressed",
 		"report_review_verdict",
+		"get_diff_since_last_review",
+		"list_changed_files",
+		"fetch_context",
+		"request_retirement",
 		"post_accountability_summary",
 	}
 
@@ -145,6 +153,28 @@ func TestWorkerServer_RegistersAllTools(t *testing.T) {
 	require.Equal(t, len(expectedTools), len(ws.tools), "Tool count mismatch")
 }
 
+// TestWorkerToolHints verifies the hints cover every worker tool with a short usage.
+func TestWorkerToolHints(t *testing.T) {
+	ws := NewWorkerServer("WORKER.1")
+	ws.SetFabricService(createTestFabricServiceForWorkerTest(t))
+
+	hints := WorkerToolHints()
+
+	names := make([]string, 0, len(hints))
+	for _, hint := range hints {
+		names = append(names, hint.Name)
+		require.NotEmpty(t, hint.Usage, "tool %q has no usage", hint.Name)
+		require.NotContains(t, hint.Usage, ". ", "usage for %q should be a single sentence", hint.Name)
+	}
+	require.True(t, slices.IsSorted(names))
+	require.Contains(t, names, "fabric_inbox")
+	require.Contains(t, names, "report_implementation_complete")
+	require.Len(t, hints, len(ws.tools))
+
+	require.Equal(t, "Signal that implementation is complete and ready for review.",
+		hints[slices.Index(names, "report_implementation_complete")].Usage)
+}
+
 // createTestFabricServiceForWorkerTest creates a minimal fabric service for testing.
 func createTestFabricServiceForWorkerTest(t *testing.T) *fabric.Service {
 	t.Helper()
@@ -532,6 +562,44 @@ func TestWorkerServer_ReportReviewVerdict_Denied(t *testing.T) {
 	require.Contains(t, result.Content[0].Text, "DENIED", "Response should contain 'DENIED'")
 }
 
+// TestWorkerServer_RequestRetirement_SubmitsCommand tests that request_retirement submits
+// a RequestRetirementCommand carrying the worker ID and reason.
+func TestWorkerServer_RequestRetirement_SubmitsCommand(t *testing.T) {
+	tws := NewTestWorkerServer(t, "WORKER.1")
+	defer tws.Close()
+	handler := tws.handlers["request_retirement"]
+
+	tws.V2Handler.SetResult(&command.CommandResult{Success: true})
+
+	result, err := handler(context.Background(), json.RawMessage(`{"reason": "context is corrupted"}`))
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.False(t, result.IsError, "Expected success result")
+	require.Contains(t, result.Content[0].Text, "Retirement requested")
+
+	commands := tws.V2Handler.GetCommands()
+	require.Len(t, commands, 1, "Expected 1 command")
+	retireCmd, ok := commands[0].(*command.RequestRetirementCommand)
+	require.True(t, ok, "Expected RequestRetirementCommand, got %T", commands[0])
+	require.Equal(t, "WORKER.1", retireCmd.WorkerID)
+	require.Equal(t, "context is corrupted", retireCmd.Reason)
+}
+
+// TestWorkerServer_RequestRetirement_RequiresReason tests that a blank reason is rejected
+// before a command is submitted.
+func TestWorkerServer_RequestRetirement_RequiresReason(t *testing.T) {
+	tws := NewTestWorkerServer(t, "WORKER.1")
+	defer tws.Close()
+	handler := tws.handlers["request_retirement"]
+
+	for _, args := range []string{`{}`, `{"reason": "   "}`} {
+		_, err := handler(context.Background(), json.RawMessage(args))
+		require.ErrorContains(t, err, "reason is required", "args: %s", args)
+	}
+
+	require.Empty(t, tws.V2Handler.GetCommands(), "No command should be submitted")
+}
+
 // TestWorkerServer_ReportImplementationCompleteSchema verifies tool schema.
 func TestWorkerServer_ReportImplementationCompleteSchema(t *testing.T) {
 	ws := NewWorkerServer("WORKER.1")
@@ -546,6 +614,21 @@ func TestWorkerServer_ReportImplementationCompleteSchema(t *testing.T) {
 	require.True(t, ok, "'summary' property should be defined")
 }
 
+// TestWorkerServer_MarkFeedbackAddressedSchema verifies item_ids is an array of item numbers.
+func TestWorkerServer_MarkFeedbackAddressedSchema(t *testing.T) {
+	ws := NewWorkerServer("WORKER.1")
+
+	tool, ok := ws.tools["mark_feedback_addressed"]
+	require.True(t, ok, "mark_feedback_addressed tool not registered")
+	require.Equal(t, []string{"item_ids"}, tool.InputSchema.Required)
+
+	itemIDs, ok := tool.InputSchema.Properties["item_ids"]
+	require.True(t, ok, "'item_ids' property should be defined")
+	require.Equal(t, "array", itemIDs.Type)
+	require.NotNil(t, itemIDs.Items)
+	require.Equal(t, "integer", itemIDs.Items.Type, "item numbers are parsed as integers")
+}
+
 // TestWorkerServer_ReportReviewVerdictSchema verifies tool schema.
 func TestWorkerServer_ReportReviewVerdictSchema(t *testing.T) {
 	ws := NewWorkerServer("WORKER.1")
@@ -4,6 +4,7 @@ package message
 import (
 	"fmt"
 	"time"
+	"unicode/utf8"
 )
 
 // MessageType categorizes the kind of message being sent.
@@ -55,8 +56,43 @@ type Entry struct {
 
 	// ReadBy tracks which agents have seen this message.
 	ReadBy []string `json:"read_by,omitempty"`
+
+	// WorkflowID records the workflow the message was posted in, so logs from
+	// workflows sharing a store can be told apart.
+	WorkflowID string `json:"workflow_id,omitempty"`
+}
+
+// Truncate returns the entry with its content cut to at most limit bytes, ending in a
+// marker that names the entry ID the full content can be retrieved by, and whether it
+// was cut. The cut falls on a UTF-8 boundary. Entries within the limit, and any entry
+// when limit <= 0, are returned unchanged.
+func (e Entry) Truncate(limit int) (Entry, bool) {
+	kept, removed := TruncateText(e.Content, limit)
+	if removed == 0 {
+		return e, false
+	}
+	e.Content = kept + fmt.Sprintf(TruncatedMarker, removed, e.ID)
+	return e, true
+}
+
+// TruncateText cuts text to at most limit bytes on a UTF-8 boundary and returns the kept
+// prefix and the number of bytes removed. Text within the limit, and any text when
+// limit <= 0, is returned whole with 0 removed.
+func TruncateText(text string, limit int) (string, int) {
+	if limit <= 0 || len(text) <= limit {
+		return text, 0
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut], len(text) - cut
 }
 
+// TruncatedMarker ends the content of a truncated entry. It is formatted with the number
+// of bytes removed and the entry ID.
+const TruncatedMarker = "\n[... truncated %d bytes; full content: get_message_content(message_id=%q)]"
+
 // Common sender/recipient identifiers.
 const (
 	// ActorCoordinator is the orchestrating agent.
@@ -67,8 +103,28 @@ const (
 
 	// ActorAll broadcasts to all agents.
 	ActorAll = "ALL"
+
+	// ActorAllReviewers broadcasts to workers currently reviewing a task.
+	ActorAllReviewers = "ALL_REVIEWERS"
+
+	// ActorAllImplementers broadcasts to workers currently implementing a task,
+	// including those awaiting review, addressing feedback, or committing.
+	ActorAllImplementers = "ALL_IMPLEMENTERS"
 )
 
+// BroadcastRecipients lists the recipient tokens that fan out to multiple workers.
+var BroadcastRecipients = []string{ActorAll, ActorAllReviewers, ActorAllImplementers}
+
+// IsBroadcast reports whether recipient is a broadcast token rather than a single actor.
+func IsBroadcast(recipient string) bool {
+	for _, r := range BroadcastRecipients {
+		if r == recipient {
+			return true
+		}
+	}
+	return false
+}
+
 // WorkerID returns the standard worker identifier for a given index.
 // Worker indices are 1-based: WORKER.1, WORKER.2, etc.
 func WorkerID(index int) string {
@@ -1,6 +1,7 @@
 package message
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +28,18 @@ func TestActorConstants(t *testing.T) {
 	require.Equal(t, "COORDINATOR", ActorCoordinator)
 	require.Equal(t, "USER", ActorUser)
 	require.Equal(t, "ALL", ActorAll)
+	require.Equal(t, "ALL_REVIEWERS", ActorAllReviewers)
+	require.Equal(t, "ALL_IMPLEMENTERS", ActorAllImplementers)
+}
+
+func TestIsBroadcast(t *testing.T) {
+	require.True(t, IsBroadcast(ActorAll))
+	require.True(t, IsBroadcast(ActorAllReviewers))
+	require.True(t, IsBroadcast(ActorAllImplementers))
+	require.False(t, IsBroadcast(ActorCoordinator))
+	require.False(t, IsBroadcast("WORKER.1"))
+	require.False(t, IsBroadcast("ALL_TESTERS"))
+	require.False(t, IsBroadcast(""))
 }
 
 func TestMessageTypeConstants(t *testing.T) {
@@ -70,3 +83,24 @@ func TestEntry_ReadByTracking(t *testing.T) {
 	require.Contains(t, entry.ReadBy, "WORKER.1")
 	require.Contains(t, entry.ReadBy, "WORKER.2")
 }
+
+func TestEntryTruncate(t *testing.T) {
+	e := Entry{ID: "msg-1", Content: "0123456789"}
+
+	same, cut := e.Truncate(10)
+	require.False(t, cut)
+	require.Equal(t, e, same)
+
+	same, cut = e.Truncate(0)
+	require.False(t, cut, "a limit of zero disables truncation")
+	require.Equal(t, e, same)
+
+	short, cut := e.Truncate(4)
+	require.True(t, cut)
+	require.Equal(t, "0123\n[... truncated 6 bytes; full content: get_message_content(message_id=\"msg-1\")]", short.Content)
+	require.Equal(t, "0123456789", e.Content, "the original entry is not modified")
+
+	// Never split a multi-byte rune
+	short, _ = Entry{ID: "msg-2", Content: "héllo"}.Truncate(2)
+	require.True(t, strings.HasPrefix(short.Content, "h\n"))
+}
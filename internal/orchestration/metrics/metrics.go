@@ -42,3 +42,27 @@ func (m TokenMetrics) FormatContextDisplay() string {
 func (m TokenMetrics) FormatCostDisplay() string {
 	return fmt.Sprintf("$%.4f", m.TotalCostUSD)
 }
+
+// WorkerUsage holds cumulative token counts for a process across all turns.
+// Unlike TokenMetrics, which is a per-turn snapshot, WorkerUsage only grows.
+type WorkerUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+	Turns        int `json:"turns"`
+}
+
+// Add accumulates a completed turn's token metrics into the usage totals.
+// A nil metrics value is ignored.
+func (u *WorkerUsage) Add(m *TokenMetrics) {
+	if m == nil {
+		return
+	}
+	u.InputTokens += m.TokensUsed
+	u.OutputTokens += m.OutputTokens
+	u.Turns++
+}
+
+// TotalTokens returns the sum of input and output tokens.
+func (u WorkerUsage) TotalTokens() int {
+	return u.InputTokens + u.OutputTokens
+}
@@ -172,3 +172,16 @@ func TestTokenMetrics_FullStruct(t *testing.T) {
 	require.Equal(t, "$0.0892", m.FormatCostDisplay(), "FormatCostDisplay()")
 	require.Equal(t, now, m.LastUpdatedAt, "LastUpdatedAt")
 }
+
+func TestWorkerUsage_AddAccumulatesAcrossTurns(t *testing.T) {
+	var u WorkerUsage
+
+	u.Add(&TokenMetrics{TokensUsed: 10000, OutputTokens: 500})
+	u.Add(&TokenMetrics{TokensUsed: 12000, OutputTokens: 800})
+	u.Add(nil)
+
+	require.Equal(t, 22000, u.InputTokens, "InputTokens")
+	require.Equal(t, 1300, u.OutputTokens, "OutputTokens")
+	require.Equal(t, 2, u.Turns, "Turns")
+	require.Equal(t, 23300, u.TotalTokens(), "TotalTokens()")
+}
@@ -30,6 +30,17 @@ func (c *Client) Type() client.ClientType {
 	return client.ClientMock
 }
 
+// Capabilities reports a resumable provider with flag-based configuration,
+// matching the behavior of the reference Claude client.
+func (c *Client) Capabilities() client.Capabilities {
+	return client.Capabilities{
+		SupportsResume:           true,
+		SupportsSystemPromptFlag: true,
+		SupportsToolFiltering:    true,
+		MCPConfigMethod:          client.MCPConfigFlag,
+	}
+}
+
 // Spawn creates a new mock process or resumes an existing one.
 // If cfg.SessionID is set, this counts as a resume operation.
 // If SpawnFunc is set, it delegates to that function.
@@ -0,0 +1,140 @@
+// Package orchestrator coordinates process-wide lifecycle across the orchestration
+// subsystems: the shared worker pool, each workflow's coordinator, the control plane
+// and git.
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+)
+
+// DefaultStepTimeout bounds each shutdown step when Config.StepTimeout is zero.
+const DefaultStepTimeout = 10 * time.Second
+
+// AssignmentPauser stops new work from being handed to workers.
+// Implemented by controlplane.CapacityAllocator.
+type AssignmentPauser interface {
+	PauseAssignments()
+}
+
+// ControlPlane is the subset of controlplane.ControlPlane used during shutdown.
+type ControlPlane interface {
+	DrainWorkers(ctx context.Context) error
+	FlushLogs(ctx context.Context) error
+	PersistCoordinatorState(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+}
+
+// WorktreeCleaner removes the worktrees created for stopped workflows.
+// Implemented by controlplane.ControlPlane.
+type WorktreeCleaner interface {
+	RemoveEphemeralWorktrees(ctx context.Context) error
+}
+
+// Config configures an Orchestrator.
+type Config struct {
+	// Pool is the shared worker pool (optional). It is paused first so no new
+	// workers are spawned while shutting down.
+	Pool AssignmentPauser
+	// ControlPlane persists coordinator state, drains workers, flushes logs and
+	// stops workflows (required).
+	ControlPlane ControlPlane
+	// Worktrees removes ephemeral worktrees after workflows stop (optional).
+	// Leave nil to keep worktrees on shutdown.
+	Worktrees WorktreeCleaner
+	// StepTimeout bounds each step. If zero, DefaultStepTimeout is used.
+	StepTimeout time.Duration
+}
+
+// Orchestrator runs the shutdown sequence for the whole orchestration system.
+type Orchestrator struct {
+	pool         AssignmentPauser
+	controlPlane ControlPlane
+	worktrees    WorktreeCleaner
+	stepTimeout  time.Duration
+}
+
+// New creates an Orchestrator from the given config.
+func New(cfg Config) (*Orchestrator, error) {
+	if cfg.ControlPlane == nil {
+		return nil, fmt.Errorf("ControlPlane is required")
+	}
+	stepTimeout := cfg.StepTimeout
+	if stepTimeout <= 0 {
+		stepTimeout = DefaultStepTimeout
+	}
+	return &Orchestrator{
+		pool:         cfg.Pool,
+		controlPlane: cfg.ControlPlane,
+		worktrees:    cfg.Worktrees,
+		stepTimeout:  stepTimeout,
+	}, nil
+}
+
+// shutdownStep is one best-effort stage of the shutdown sequence.
+type shutdownStep struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// steps returns the shutdown sequence in order. Optional components that are not
+// configured are left out.
+func (o *Orchestrator) steps() []shutdownStep {
+	var steps []shutdownStep
+	if o.pool != nil {
+		steps = append(steps, shutdownStep{"pause assignments", func(context.Context) error {
+			o.pool.PauseAssignments()
+			return nil
+		}})
+	}
+	steps = append(steps,
+		shutdownStep{"persist coordinator state", o.controlPlane.PersistCoordinatorState},
+		shutdownStep{"drain workers", o.controlPlane.DrainWorkers},
+		shutdownStep{"flush logs", o.controlPlane.FlushLogs},
+		shutdownStep{"stop control plane", o.controlPlane.Shutdown},
+	)
+	if o.worktrees != nil {
+		steps = append(steps, shutdownStep{"remove worktrees", o.worktrees.RemoveEphemeralWorktrees})
+	}
+	return steps
+}
+
+// Shutdown cleanly stops the orchestrator: it pauses new assignments, persists
+// coordinator state while workers still hold their assignments, interrupts workers,
+// flushes the message logs, stops the control plane and finally removes ephemeral
+// worktrees.
+//
+// Every step is best-effort and bounded by its own step timeout, so a failing or stuck
+// step is logged and the remaining steps still run. Cancelling ctx does not cut the
+// sequence short; only its values are passed to the steps. A step that times out keeps
+// running in the background. Returns the step errors joined together.
+func (o *Orchestrator) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, step := range o.steps() {
+		if err := o.runStep(ctx, step); err != nil {
+			log.Warn(log.CatOrch, "Shutdown step failed", "step", step.name, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", step.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// runStep runs a single step, returning early if it outlives its timeout.
+func (o *Orchestrator) runStep(ctx context.Context, step shutdownStep) error {
+	stepCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), o.stepTimeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	log.SafeGo("orchestrator.shutdown."+step.name, func() { done <- step.run(stepCtx) })
+
+	select {
+	case err := <-done:
+		return err
+	case <-stepCtx.Done():
+		return stepCtx.Err()
+	}
+}
@@ -0,0 +1,126 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the shutdown steps invoked, in order.
+type recorder struct {
+	mu    sync.Mutex
+	calls []string
+	errs  map[string]error
+	block map[string]bool
+}
+
+func newRecorder() *recorder {
+	return &recorder{errs: make(map[string]error), block: make(map[string]bool)}
+}
+
+func (r *recorder) record(ctx context.Context, name string) error {
+	r.mu.Lock()
+	r.calls = append(r.calls, name)
+	err, block := r.errs[name], r.block[name]
+	r.mu.Unlock()
+	if block {
+		<-ctx.Done()
+	}
+	return err
+}
+
+func (r *recorder) Calls() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.calls...)
+}
+
+func (r *recorder) PauseAssignments() {
+	_ = r.record(context.Background(), "pause")
+}
+
+func (r *recorder) DrainWorkers(ctx context.Context) error {
+	return r.record(ctx, "drain")
+}
+
+func (r *recorder) FlushLogs(ctx context.Context) error {
+	return r.record(ctx, "flush")
+}
+
+func (r *recorder) PersistCoordinatorState(ctx context.Context) error {
+	return r.record(ctx, "persist")
+}
+
+func (r *recorder) Shutdown(ctx context.Context) error {
+	return r.record(ctx, "stop")
+}
+
+func (r *recorder) RemoveEphemeralWorktrees(ctx context.Context) error {
+	return r.record(ctx, "cleanup")
+}
+
+func TestNew_RequiresControlPlane(t *testing.T) {
+	_, err := New(Config{})
+	require.ErrorContains(t, err, "ControlPlane is required")
+}
+
+func TestShutdown_RunsStepsInOrder(t *testing.T) {
+	r := newRecorder()
+	o, err := New(Config{Pool: r, ControlPlane: r, Worktrees: r})
+	require.NoError(t, err)
+
+	require.NoError(t, o.Shutdown(context.Background()))
+	require.Equal(t, []string{"pause", "persist", "drain", "flush", "stop", "cleanup"}, r.Calls())
+}
+
+func TestShutdown_SkipsUnconfiguredSteps(t *testing.T) {
+	r := newRecorder()
+	o, err := New(Config{ControlPlane: r})
+	require.NoError(t, err)
+
+	require.NoError(t, o.Shutdown(context.Background()))
+	require.Equal(t, []string{"persist", "drain", "flush", "stop"}, r.Calls())
+}
+
+func TestShutdown_FailedStepDoesNotStopSequence(t *testing.T) {
+	r := newRecorder()
+	persistErr := errors.New("disk full")
+	r.errs["persist"] = persistErr
+	o, err := New(Config{Pool: r, ControlPlane: r, Worktrees: r})
+	require.NoError(t, err)
+
+	err = o.Shutdown(context.Background())
+	require.ErrorIs(t, err, persistErr)
+	require.ErrorContains(t, err, "persist coordinator state")
+	require.Equal(t, []string{"pause", "persist", "drain", "flush", "stop", "cleanup"}, r.Calls())
+}
+
+func TestShutdown_StuckStepTimesOut(t *testing.T) {
+	r := newRecorder()
+	r.block["drain"] = true
+	o, err := New(Config{Pool: r, ControlPlane: r, Worktrees: r, StepTimeout: 20 * time.Millisecond})
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = o.Shutdown(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "drain workers")
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, []string{"pause", "persist", "drain", "flush", "stop", "cleanup"}, r.Calls())
+}
+
+func TestShutdown_CancelledContextStillRunsSteps(t *testing.T) {
+	r := newRecorder()
+	o, err := New(Config{ControlPlane: r, Worktrees: r})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.NoError(t, o.Shutdown(ctx))
+	require.Equal(t, []string{"persist", "drain", "flush", "stop", "cleanup"}, r.Calls())
+}
@@ -0,0 +1,3 @@
+// Package osproc provides platform-specific checks and signals for OS processes by PID,
+// shared by the control plane and the v2 process and handler packages.
+package osproc
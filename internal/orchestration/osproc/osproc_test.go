@@ -0,0 +1,20 @@
+package osproc
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAlive_CurrentProcess(t *testing.T) {
+	require.True(t, IsAlive(os.Getpid()))
+}
+
+func TestIsAlive_ExitedProcess(t *testing.T) {
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	require.NoError(t, cmd.Run())
+
+	require.False(t, IsAlive(cmd.Process.Pid))
+}
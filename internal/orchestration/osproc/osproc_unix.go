@@ -1,6 +1,6 @@
 //go:build !windows
 
-package controlplane
+package osproc
 
 import (
 	"errors"
@@ -8,9 +8,9 @@ import (
 	"syscall"
 )
 
-// isProcessAlive checks if a process with the given PID is still running.
+// IsAlive checks if a process with the given PID is still running.
 // On Unix, we send signal 0 to check if the process exists.
-func isProcessAlive(pid int) bool {
+func IsAlive(pid int) bool {
 	process, err := os.FindProcess(pid)
 	if err != nil {
 		return false
@@ -28,3 +28,8 @@ func isProcessAlive(pid int) bool {
 	}
 	return false
 }
+
+// Kill forcefully terminates a process by PID using SIGKILL.
+func Kill(pid int) error {
+	return syscall.Kill(pid, syscall.SIGKILL)
+}
@@ -1,14 +1,16 @@
 //go:build windows
 
-package controlplane
+package osproc
 
 import (
+	"os"
+
 	"golang.org/x/sys/windows"
 )
 
-// isProcessAlive checks if a process with the given PID is still running.
+// IsAlive checks if a process with the given PID is still running.
 // On Windows, we use OpenProcess to check if the process exists.
-func isProcessAlive(pid int) bool {
+func IsAlive(pid int) bool {
 	// PROCESS_QUERY_LIMITED_INFORMATION is the minimum access right needed
 	// to check if a process exists.
 	const PROCESS_QUERY_LIMITED_INFORMATION = 0x1000
@@ -30,3 +32,13 @@ func isProcessAlive(pid int) bool {
 	// STILL_ACTIVE (259) means the process is still running
 	return exitCode == 259
 }
+
+// Kill forcefully terminates a process by PID.
+// On Windows, os.Process.Kill() calls TerminateProcess.
+func Kill(pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return proc.Kill()
+}
@@ -9,8 +9,9 @@ import (
 // It is shared between mode/orchestration.Initializer and controlplane.Supervisor
 // to ensure sessions are created with the same directory structure and options.
 type Factory struct {
-	baseDir     string
-	gitExecutor GitRemoteGetter
+	baseDir             string
+	gitExecutor         GitRemoteGetter
+	messageContentLimit int
 }
 
 // FactoryConfig holds configuration for creating a Factory.
@@ -22,6 +23,10 @@ type FactoryConfig struct {
 	// GitExecutor is used to derive the application name from git remote.
 	// If nil, the application name falls back to the work directory basename.
 	GitExecutor GitRemoteGetter
+
+	// MessageContentLimit caps the content of each inter-agent message in the message log,
+	// in bytes. Longer content is truncated and stored in full beside it. 0 means no limit.
+	MessageContentLimit int
 }
 
 // NewFactory creates a new session Factory with the given configuration.
@@ -31,8 +36,9 @@ func NewFactory(cfg FactoryConfig) *Factory {
 		baseDir = DefaultBaseDir()
 	}
 	return &Factory{
-		baseDir:     baseDir,
-		gitExecutor: cfg.GitExecutor,
+		baseDir:             baseDir,
+		gitExecutor:         cfg.GitExecutor,
+		messageContentLimit: cfg.MessageContentLimit,
 	}
 }
 
@@ -84,6 +90,7 @@ func (f *Factory) Create(opts CreateOptions) (*Session, error) {
 		WithDatePartition(datePartition),
 		WithPathBuilder(pathBuilder),
 		WithWorkflowID(opts.WorkflowID),
+		WithMessageContentLimit(f.messageContentLimit),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("creating session: %w", err)
@@ -121,6 +128,7 @@ func (f *Factory) Reopen(opts CreateOptions, existingDir string) (*Session, erro
 		WithApplicationName(appName),
 		WithDatePartition(datePartition),
 		WithPathBuilder(pathBuilder),
+		WithMessageContentLimit(f.messageContentLimit),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("reopening session: %w", err)
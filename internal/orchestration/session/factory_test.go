@@ -61,6 +61,22 @@ func TestFactory_Create_WithApplicationName(t *testing.T) {
 	_ = sess.Close(StatusCompleted)
 }
 
+func TestFactory_Create_AppliesMessageContentLimit(t *testing.T) {
+	factory := NewFactory(FactoryConfig{
+		BaseDir:             t.TempDir(),
+		MessageContentLimit: 1024,
+	})
+
+	sess, err := factory.Create(CreateOptions{
+		SessionID: "test-session-limit",
+		WorkDir:   t.TempDir(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sess.Close(StatusCompleted) })
+
+	require.Equal(t, 1024, sess.messageContentLimit)
+}
+
 func TestFactory_Create_RequiresSessionID(t *testing.T) {
 	factory := NewFactory(FactoryConfig{
 		BaseDir: t.TempDir(),
@@ -63,6 +63,20 @@ func LoadObserverNotes(sessionDir string) (string, error) {
 	return string(data), nil
 }
 
+// LoadCoordinatorState loads the coordinator state snapshot written at shutdown by
+// Session.WriteCoordinatorState. Returns nil if the session has no snapshot.
+func LoadCoordinatorState(sessionDir string) ([]byte, error) {
+	path := filepath.Join(sessionDir, coordinatorStateFile)
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed from trusted sessionDir parameter
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading coordinator state: %w", err)
+	}
+	return data, nil
+}
+
 // loadMessagesJSONL is the internal implementation for loading chat messages from a JSONL file.
 // Returns an empty slice if the file doesn't exist.
 // Malformed JSON lines are skipped gracefully to provide resilience against partial writes.
@@ -476,6 +476,27 @@ func TestLoadInterAgentMessages_AllMessageTypes(t *testing.T) {
 
 // --- ValidateForResumption Tests ---
 
+func TestLoadCoordinatorState_RoundTrip(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	sess, err := New("test-load-coordinator-state", sessionDir)
+	require.NoError(t, err)
+
+	content := []byte(`{"version":1}`)
+	_, err = sess.WriteCoordinatorState(content)
+	require.NoError(t, err)
+	require.NoError(t, sess.Close(StatusCompleted))
+
+	data, err := LoadCoordinatorState(sessionDir)
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+}
+
+func TestLoadCoordinatorState_FileNotExist(t *testing.T) {
+	data, err := LoadCoordinatorState(createTestSessionDir(t))
+	require.NoError(t, err)
+	require.Nil(t, data)
+}
+
 func TestValidateForResumption_Valid(t *testing.T) {
 	// Valid session: resumable=true, has coordinator ref, status=completed
 	metadata := &Metadata{
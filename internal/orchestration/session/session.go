@@ -84,6 +84,9 @@ type Session struct {
 	datePartition   string
 	workflowID      string
 
+	// messageContentLimit caps the content of entries written to messages.jsonl (0 = no limit).
+	messageContentLimit int
+
 	// pathBuilder is used for constructing session index paths.
 	// Set via WithPathBuilder option.
 	pathBuilder *SessionPathBuilder
@@ -131,6 +134,15 @@ func WithWorkflowID(id string) SessionOption {
 	}
 }
 
+// WithMessageContentLimit caps the content of each inter-agent message written to
+// messages.jsonl at limit bytes. Longer content is truncated with a marker and stored in
+// full under message_content/, retrievable with MessageContent. Values <= 0 disable it.
+func WithMessageContentLimit(limit int) SessionOption {
+	return func(s *Session) {
+		s.messageContentLimit = max(limit, 0)
+	}
+}
+
 // WithPathBuilder sets the SessionPathBuilder for constructing index paths.
 // This enables writing to both application-level and global session indexes.
 func WithPathBuilder(pb *SessionPathBuilder) SessionOption {
@@ -145,6 +157,8 @@ const (
 	coordinatorDir = "coordinator"
 	observerDir    = "observer"
 	workersDir     = "workers"
+	// messageContentDir holds the full content of truncated inter-agent messages.
+	messageContentDir = "message_content"
 
 	// File names.
 	rawJSONLFile              = "raw.jsonl"
@@ -154,6 +168,7 @@ const (
 	commandsFile              = "commands.jsonl"
 	summaryFile               = "summary.md"
 	accountabilitySummaryFile = "accountability_summary.md"
+	coordinatorStateFile      = "coordinator_state.json"
 )
 
 // New creates a new session with the given ID and directory.
@@ -171,6 +186,7 @@ const (
 //	│   └── raw.jsonl                # Raw Claude API JSON responses
 //	├── workers/                     # Worker directories created on demand
 //	├── messages.jsonl               # Inter-agent message log
+//	├── message_content/             # Full content of truncated messages (created on demand)
 //	├── mcp_requests.jsonl           # MCP tool call requests/responses
 //	└── summary.md                   # Post-session summary (created on close)
 func New(id, dir string, opts ...SessionOption) (*Session, error) {
@@ -583,6 +599,9 @@ func (s *Session) WriteWorkerRawJSON(workerID string, timestamp time.Time, rawJS
 }
 
 // WriteMessage appends a message entry to messages.jsonl in JSONL format.
+// Entries without a workflow ID are namespaced to the session's workflow.
+// With a message content limit, longer content is logged truncated and stored in full
+// under message_content/.
 func (s *Session) WriteMessage(entry message.Entry) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -591,6 +610,17 @@ func (s *Session) WriteMessage(entry message.Entry) error {
 		return os.ErrClosed
 	}
 
+	if entry.WorkflowID == "" {
+		entry.WorkflowID = s.workflowID
+	}
+
+	if truncated, cut := entry.Truncate(s.messageContentLimit); cut && validMessageID(entry.ID) {
+		if err := s.storeMessageContent(entry.ID, entry.Content); err != nil {
+			return fmt.Errorf("storing full message content: %w", err)
+		}
+		entry = truncated
+	}
+
 	data, err := json.Marshal(entry)
 	if err != nil {
 		return fmt.Errorf("marshaling message entry: %w", err)
@@ -601,6 +631,40 @@ func (s *Session) WriteMessage(entry message.Entry) error {
 	return s.messageLog.Write(data)
 }
 
+// MessageContentLimit returns the byte limit on logged message content (0 = no limit).
+func (s *Session) MessageContentLimit() int {
+	return s.messageContentLimit
+}
+
+// MessageContent returns the full content of a message that was truncated in
+// messages.jsonl. Returns an error wrapping os.ErrNotExist if no full content was
+// stored for the ID, e.g. because the message was within the limit.
+func (s *Session) MessageContent(id string) (string, error) {
+	if !validMessageID(id) {
+		return "", fmt.Errorf("invalid message ID %q: %w", id, os.ErrNotExist)
+	}
+	data, err := os.ReadFile(filepath.Join(s.Dir, messageContentDir, id+".txt")) //nolint:gosec // G304: ID is checked to be a single path element
+	if err != nil {
+		return "", fmt.Errorf("reading content of message %s: %w", id, err)
+	}
+	return string(data), nil
+}
+
+// storeMessageContent writes a message's full content to message_content/{id}.txt.
+// Caller must hold s.mu.
+func (s *Session) storeMessageContent(id, content string) error {
+	dir := filepath.Join(s.Dir, messageContentDir)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, id+".txt"), []byte(content), 0600)
+}
+
+// validMessageID reports whether id can safely name a file in message_content/.
+func validMessageID(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
+
 // WriteMCPEvent appends an MCP event to mcp_requests.jsonl in JSONL format.
 func (s *Session) WriteMCPEvent(event events.MCPEvent) error {
 	s.mu.Lock()
@@ -1186,6 +1250,10 @@ func (s *Session) AttachV2EventBus(ctx context.Context, broker *pubsub.Broker[an
 						s.handleProcessEvent(processEvent)
 					}
 				}
+				// Progress messages posted by long-running handlers go to messages.jsonl
+				if messageEvent, isMessage := ev.Payload.(message.Event); isMessage {
+					s.handleMessageEvent(messageEvent)
+				}
 				// Other event types from v2EventBus are ignored by session logger
 			}
 		}
@@ -1476,6 +1544,56 @@ func (s *Session) retireWorker(workerID string, retiredAt time.Time, finalPhase
 	}
 }
 
+// Flush writes all buffered log entries to disk without closing the session.
+// Used during shutdown so logs are durable even if a later step hangs.
+func (s *Session) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return os.ErrClosed
+	}
+
+	writers := []*BufferedWriter{s.coordRaw, s.coordMessages, s.observerMessages, s.messageLog, s.mcpLog, s.commandLog}
+	for _, w := range s.workerRaws {
+		writers = append(writers, w)
+	}
+	for _, w := range s.workerMessages {
+		writers = append(writers, w)
+	}
+
+	var firstErr error
+	for _, w := range writers {
+		if w == nil {
+			continue
+		}
+		if err := w.Flush(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// WriteCoordinatorState writes a snapshot of the coordinator's state to the session directory.
+// Overwrites any earlier snapshot. Returns the full path where the state was saved.
+func (s *Session) WriteCoordinatorState(content []byte) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return "", os.ErrClosed
+	}
+
+	statePath := filepath.Join(s.Dir, coordinatorStateFile)
+	if err := os.WriteFile(statePath, content, 0600); err != nil {
+		return "", fmt.Errorf("writing coordinator state file: %w", err)
+	}
+
+	log.Debug(log.CatOrch, "Wrote coordinator state", "path", statePath)
+
+	return statePath, nil
+}
+
 // WriteWorkerAccountabilitySummary writes a worker's accountability summary to their session directory.
 // Creates the worker directory if it doesn't exist (follows getOrCreateWorkerLog pattern).
 // Returns the full path where the summary was saved.
@@ -1547,6 +1547,54 @@ func TestSession_WriteMessage(t *testing.T) {
 	require.Equal(t, "WORKER.1", parsed2.From)
 }
 
+func TestSession_WriteMessage_NamespacesToWorkflow(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+
+	session, err := New("test-message-namespace", sessionDir, WithWorkflowID("wf-a"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close(StatusCompleted) })
+
+	require.NoError(t, session.WriteMessage(message.Entry{ID: "msg-001", From: "COORDINATOR", To: "WORKER.1"}))
+	require.NoError(t, session.WriteMessage(message.Entry{ID: "msg-002", From: "COORDINATOR", To: "WORKER.1", WorkflowID: "wf-b"}))
+	require.NoError(t, session.Close(StatusCompleted))
+
+	entries, err := LoadInterAgentMessages(sessionDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "wf-a", entries[0].WorkflowID, "entry without a workflow should take the session's")
+	require.Equal(t, "wf-b", entries[1].WorkflowID, "explicit workflow should be kept")
+}
+
+func TestSession_WriteMessage_TruncatesLongContent(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+
+	session, err := New("test-message-truncate", sessionDir, WithWorkflowID("wf-a"), WithMessageContentLimit(16))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close(StatusCompleted) })
+
+	long := strings.Repeat("build output ", 100)
+	require.NoError(t, session.WriteMessage(message.Entry{ID: "msg-001", From: "WORKER.1", To: "COORDINATOR", Content: long}))
+	require.NoError(t, session.WriteMessage(message.Entry{ID: "msg-002", From: "WORKER.1", To: "COORDINATOR", Content: "short"}))
+	require.NoError(t, session.Close(StatusCompleted))
+
+	entries, err := LoadInterAgentMessages(sessionDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.True(t, strings.HasPrefix(entries[0].Content, long[:16]))
+	require.Contains(t, entries[0].Content, `get_message_content(message_id="msg-001")`)
+	require.Less(t, len(entries[0].Content), len(long))
+	require.Equal(t, "short", entries[1].Content)
+
+	full, err := session.MessageContent("msg-001")
+	require.NoError(t, err)
+	require.Equal(t, long, full)
+
+	_, err = session.MessageContent("msg-002")
+	require.ErrorIs(t, err, os.ErrNotExist, "content within the limit is not stored separately")
+	_, err = session.MessageContent("../metadata.json")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
 // Tests for WriteMCPEvent
 
 func TestSession_WriteMCPEvent(t *testing.T) {
@@ -1939,6 +1987,48 @@ func TestSession_AttachToBrokers_NilBrokers(t *testing.T) {
 	_ = session.Close(StatusCompleted)
 }
 
+func TestSession_V2EventBus_WritesPostedMessages(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+
+	session, err := New("test-v2-messages", sessionDir)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close(StatusCompleted) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v2EventBus := pubsub.NewBroker[any]()
+	defer v2EventBus.Close()
+
+	session.AttachV2EventBus(ctx, v2EventBus)
+
+	// Give goroutine time to start
+	time.Sleep(10 * time.Millisecond)
+
+	// Long-running handlers post progress as info messages on the v2 event bus
+	v2EventBus.Publish(pubsub.UpdatedEvent, message.Event{
+		Type: message.EventPosted,
+		Entry: message.Entry{
+			ID:        "progress-1",
+			Timestamp: time.Now(),
+			From:      message.ActorCoordinator,
+			To:        message.ActorUser,
+			Content:   "[1/2] Assigned perles-abc1.1 to worker-1",
+			Type:      message.MessageInfo,
+		},
+	})
+
+	// Give time for events to be processed
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, session.Close(StatusCompleted))
+
+	data, err := os.ReadFile(filepath.Join(sessionDir, "messages.jsonl"))
+	require.NoError(t, err)
+	require.Contains(t, string(data), "[1/2] Assigned perles-abc1.1 to worker-1")
+	require.Contains(t, string(data), `"type":"info"`)
+}
+
 func TestSession_CoordinatorSubscriber(t *testing.T) {
 	baseDir := t.TempDir()
 	sessionID := "test-coord-subscriber"
@@ -2713,6 +2803,45 @@ func TestSession_AllFourBrokersFromAllBrokers(t *testing.T) {
 
 // Tests for WriteWorkerAccountabilitySummary
 
+func TestSession_Flush_WritesBufferedLogsBeforeClose(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	session, err := New("test-flush", sessionDir)
+	require.NoError(t, err)
+
+	require.NoError(t, session.WriteCoordinatorRawJSON(time.Now(), []byte(`{"type":"assistant"}`)))
+	require.NoError(t, session.Flush())
+
+	data, err := os.ReadFile(filepath.Join(sessionDir, "coordinator", "raw.jsonl"))
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"type":"assistant"`)
+
+	require.NoError(t, session.Close(StatusCompleted))
+	require.ErrorIs(t, session.Flush(), os.ErrClosed)
+}
+
+func TestWriteCoordinatorState(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	session, err := New("test-coordinator-state", sessionDir)
+	require.NoError(t, err)
+
+	_, err = session.WriteCoordinatorState([]byte(`{"tasks":{}}`))
+	require.NoError(t, err)
+
+	// A later snapshot replaces the earlier one
+	content := []byte(`{"tasks":{"perles-abc.1":{}}}`)
+	filePath, err := session.WriteCoordinatorState(content)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(sessionDir, "coordinator_state.json"), filePath)
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+
+	require.NoError(t, session.Close(StatusCompleted))
+	_, err = session.WriteCoordinatorState(content)
+	require.ErrorIs(t, err, os.ErrClosed)
+}
+
 func TestWriteWorkerAccountabilitySummary_Success(t *testing.T) {
 	baseDir := t.TempDir()
 	sessionID := "test-accountability-success"
@@ -8,14 +8,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"sort"
+	"strings"
 	"time"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	appgit "github.com/zjrosen/perles/internal/git/application"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/message"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
 // DefaultTimeout is the default timeout for command execution.
@@ -31,9 +40,9 @@ type WorkflowConfigProvider interface {
 // It parses MCP arguments, creates commands, submits them to the processor,
 // and converts results back to MCP format.
 //
-// For read-only operations (query_worker_state), the adapter
-// reads directly from repositories without going through the CommandProcessor,
-// since these operations don't mutate state and don't require FIFO ordering.
+// For read-only operations (query_worker_state, ping_worker, list_orphaned_tasks, get_diff_since_last_review,
+// get_changed_files), the adapter reads directly from repositories without going through
+// the CommandProcessor, since these operations don't mutate state and don't require FIFO ordering.
 type V2Adapter struct {
 	processor        *processor.CommandProcessor
 	processRepo      repository.ProcessRepository
@@ -44,6 +53,26 @@ type V2Adapter struct {
 	sessionID        string // Session ID for accountability summary generation
 	workDir          string // Working directory (project root or worktree path)
 	sessionDir       string // Session directory for accountability summaries
+	gitExecutor      appgit.GitExecutor
+	workerCapacity   WorkerCapacity
+	processProber    ProcessProber
+	outputReader     ProcessOutputReader
+	workflowLister   WorkflowLister
+	confirmGate      *ConfirmationGate
+	modelCatalog     ModelCatalog
+	utilization      UtilizationSource
+	inbox            MessageInbox
+	messageContent   MessageContentStore
+	clock            types.Clock
+	taskIDPrefix     string // Restricts task IDs on state-changing tools (empty allows any prefix)
+}
+
+// WorkerCapacity gates worker spawns against capacity shared with other workflows.
+type WorkerCapacity interface {
+	// Acquire blocks until a worker slot is available or ctx is done.
+	Acquire(ctx context.Context) error
+	// Release returns a previously acquired worker slot.
+	Release()
 }
 
 // Option configures the V2Adapter.
@@ -77,6 +106,14 @@ func WithQueueRepository(repo repository.QueueRepository) Option {
 	}
 }
 
+// WithWorkerCapacity sets the shared worker capacity consulted by spawn_worker.
+// When nil, spawns are not limited.
+func WithWorkerCapacity(capacity WorkerCapacity) Option {
+	return func(a *V2Adapter) {
+		a.workerCapacity = capacity
+	}
+}
+
 // WithSessionID sets the session ID, work directory, and session directory for accountability
 // summary generation. The sessionDir is the actual path where session files are stored
 // (e.g., ~/.perles/sessions/{app}/{date}/{id}/ for centralized storage).
@@ -88,11 +125,21 @@ func WithSessionID(sessionID, workDir, sessionDir string) Option {
 	}
 }
 
+// WithClock sets the clock read-only tools use for ready grace and timing calculations.
+func WithClock(clock types.Clock) Option {
+	return func(a *V2Adapter) {
+		if clock != nil {
+			a.clock = clock
+		}
+	}
+}
+
 // NewV2Adapter creates a new V2Adapter with the given processor.
 func NewV2Adapter(proc *processor.CommandProcessor, opts ...Option) *V2Adapter {
 	a := &V2Adapter{
 		processor: proc,
 		timeout:   DefaultTimeout,
+		clock:     types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(a)
@@ -100,6 +147,27 @@ func NewV2Adapter(proc *processor.CommandProcessor, opts ...Option) *V2Adapter {
 	return a
 }
 
+// Now returns the current time according to the adapter's clock.
+func (a *V2Adapter) Now() time.Time {
+	return a.clock.Now()
+}
+
+// SetTaskIDPrefix scopes the tools that change task state to a workflow's beads prefix.
+// Once set, task IDs outside the prefix are rejected so workflows sharing a beads
+// database cannot change each other's tasks. An empty prefix allows any task ID.
+func (a *V2Adapter) SetTaskIDPrefix(prefix string) {
+	a.taskIDPrefix = prefix
+}
+
+// checkTaskIDPrefix returns an error if the task ID passed to tool as field is outside
+// the workflow's task ID prefix.
+func (a *V2Adapter) checkTaskIDPrefix(tool, field, taskID string) error {
+	if a == nil || a.taskIDPrefix == "" || taskID == "" || validation.IsValidTaskIDWithPrefix(taskID, a.taskIDPrefix) {
+		return nil
+	}
+	return fmt.Errorf("%s: %s %s is outside this workflow's prefix %q", tool, field, taskID, a.taskIDPrefix)
+}
+
 // SetWorkflowConfigProvider sets the workflow config provider after construction.
 // This is useful when the provider (e.g., the orchestration Model) is created after
 // the adapter, but needs to provide workflow configuration for process spawning.
@@ -121,6 +189,7 @@ type retireWorkerArgs struct {
 type replaceWorkerArgs struct {
 	WorkerID string `json:"worker_id"`
 	Reason   string `json:"reason,omitempty"`
+	Reassign bool   `json:"reassign,omitempty"`
 }
 
 // sendToWorkerArgs holds arguments for send_to_worker tool.
@@ -131,10 +200,16 @@ type sendToWorkerArgs struct {
 
 // assignTaskArgs holds arguments for assign_task tool.
 type assignTaskArgs struct {
-	WorkerID string `json:"worker_id"`
-	TaskID   string `json:"task_id"`
-	Summary  string `json:"summary,omitempty"`
-	ThreadID string `json:"thread_id,omitempty"`
+	WorkerID        string `json:"worker_id"`
+	TaskID          string `json:"task_id"`
+	Summary         string `json:"summary,omitempty"`
+	ThreadID        string `json:"thread_id,omitempty"`
+	EstimateMinutes int    `json:"estimate_minutes,omitempty"`
+}
+
+// assignTasksBatchArgs holds arguments for assign_tasks_batch tool.
+type assignTasksBatchArgs struct {
+	Assignments []assignTaskArgs `json:"assignments"`
 }
 
 // assignTaskReviewArgs holds arguments for assign_task_review tool.
@@ -148,16 +223,32 @@ type assignTaskReviewArgs struct {
 
 // assignReviewFeedbackArgs holds arguments for assign_review_feedback tool.
 type assignReviewFeedbackArgs struct {
-	ImplementerID string `json:"implementer_id"`
-	TaskID        string `json:"task_id"`
-	Feedback      string `json:"feedback"`
+	ImplementerID string   `json:"implementer_id"`
+	TaskID        string   `json:"task_id"`
+	Feedback      string   `json:"feedback"`
+	Items         []string `json:"items,omitempty"`
+}
+
+// transferTaskArgs holds arguments for transfer_task tool.
+type transferTaskArgs struct {
+	TaskID     string `json:"task_id"`
+	FromWorker string `json:"from_worker"`
+	ToWorker   string `json:"to_worker"`
+	Note       string `json:"note,omitempty"`
+}
+
+// drainWorkerArgs holds arguments for drain_worker tool.
+type drainWorkerArgs struct {
+	WorkerID string `json:"worker_id"`
+	Reason   string `json:"reason,omitempty"`
 }
 
 // approveCommitArgs holds arguments for approve_commit tool.
 type approveCommitArgs struct {
-	ImplementerID string `json:"implementer_id"`
-	TaskID        string `json:"task_id"`
-	CommitMessage string `json:"commit_message,omitempty"`
+	ImplementerID     string `json:"implementer_id"`
+	TaskID            string `json:"task_id"`
+	CommitMessage     string `json:"commit_message,omitempty"`
+	AllowFailingTests bool   `json:"allow_failing_tests,omitempty"`
 }
 
 // reportImplementationCompleteArgs holds arguments for report_implementation_complete tool.
@@ -165,6 +256,28 @@ type reportImplementationCompleteArgs struct {
 	Summary string `json:"summary"`
 }
 
+// markFeedbackAddressedArgs holds arguments for mark_feedback_addressed tool.
+type markFeedbackAddressedArgs struct {
+	ItemIDs []int `json:"item_ids"`
+}
+
+// checkpointArgs holds arguments for checkpoint tool.
+type checkpointArgs struct {
+	Message string `json:"message,omitempty"`
+}
+
+// reportTestResultsArgs holds arguments for report_test_results tool.
+type reportTestResultsArgs struct {
+	Passed int    `json:"passed"`
+	Failed int    `json:"failed"`
+	Output string `json:"output,omitempty"`
+}
+
+// requestRetirementArgs holds arguments for request_retirement tool.
+type requestRetirementArgs struct {
+	Reason string `json:"reason"`
+}
+
 // reportReviewVerdictArgs holds arguments for report_review_verdict tool.
 type reportReviewVerdictArgs struct {
 	Verdict  string `json:"verdict"`
@@ -223,21 +336,39 @@ func (a *V2Adapter) HandleSpawnProcess(ctx context.Context, args json.RawMessage
 		}
 	}
 
+	// Reserve a worker slot from the shared pool before spawning.
+	// Higher-priority workflows are granted slots first when the pool is full.
+	if a.workerCapacity != nil {
+		acquireCtx, cancel := context.WithTimeout(ctx, a.timeout)
+		err := a.workerCapacity.Acquire(acquireCtx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return errorResult("worker pool is at capacity, retire an idle worker or try again later"), nil
+		}
+		if err != nil {
+			// Refused outright, e.g. the workflow is at its own worker limit
+			return errorResult(fmt.Sprintf("cannot spawn worker: %v", err)), nil
+		}
+	}
+
 	// Create command with options
 	cmd := command.NewSpawnProcessCommand(command.SourceMCPTool, repository.RoleWorker, opts...)
 
 	result, err := a.submitWithTimeout(ctx, cmd)
 	if err != nil {
+		a.ReleaseWorkerSlot()
 		return nil, fmt.Errorf("spawn_process command failed: %w", err)
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		a.ReleaseWorkerSlot()
+		return errorResult(result.Error.Error()), nil
 	}
 
 	// Extract ProcessID from result
 	processID := extractProcessID(result.Data)
-	return mcptypes.SuccessResult(fmt.Sprintf("Process %s spawned the process will notify you when they are ready. DO NOT assign work until they have sent you a ready signal", processID)), nil
+	msg := fmt.Sprintf("Process %s spawned the process will notify you when they are ready. DO NOT assign work until they have sent you a ready signal", processID)
+	return messageResult(msg, SpawnWorkerResult{ToolResult: okResult(), WorkerID: processID, Message: msg}), nil
 }
 
 // HandleRetireProcess handles the retire_process MCP tool call.
@@ -258,10 +389,20 @@ func (a *V2Adapter) HandleRetireProcess(ctx context.Context, args json.RawMessag
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Process %s retired successfully", parsed.WorkerID)), nil
+	msg := fmt.Sprintf("Process %s retired successfully", parsed.WorkerID)
+	return messageResult(msg, RetireWorkerResult{ToolResult: okResult(), WorkerID: parsed.WorkerID, Message: msg}), nil
+}
+
+// ReleaseWorkerSlot returns a worker slot to the shared pool, if one is configured.
+// It is only for slots acquired for a spawn that did not happen; a spawned worker's
+// slot is returned by the retire handler when the worker is retired.
+func (a *V2Adapter) ReleaseWorkerSlot() {
+	if a.workerCapacity != nil {
+		a.workerCapacity.Release()
+	}
 }
 
 // HandleReplaceProcess handles the replace_process MCP tool call.
@@ -272,6 +413,7 @@ func (a *V2Adapter) HandleReplaceProcess(ctx context.Context, args json.RawMessa
 	}
 
 	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, parsed.WorkerID, parsed.Reason)
+	cmd.Reassign = parsed.Reassign
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("replace_process command validation failed: %w", err)
 	}
@@ -282,10 +424,20 @@ func (a *V2Adapter) HandleReplaceProcess(ctx context.Context, args json.RawMessa
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Process %s replaced successfully", parsed.WorkerID)), nil
+	response := ReplaceWorkerResult{ToolResult: okResult(), WorkerID: parsed.WorkerID}
+	msg := fmt.Sprintf("Process %s replaced successfully", parsed.WorkerID)
+	if v, ok := result.Data.(replacementExtractor); ok {
+		response.NewWorkerID = v.GetNewProcessID()
+		response.TaskID = v.GetReassignedTaskID()
+		if response.TaskID != "" {
+			msg = fmt.Sprintf("Process %s replaced by %s, which continues task %s", parsed.WorkerID, response.NewWorkerID, response.TaskID)
+		}
+	}
+	response.Message = msg
+	return messageResult(msg, response), nil
 }
 
 // processStatusToWorkerStatus converts ProcessStatus to the string format expected by the API.
@@ -323,6 +475,7 @@ type queryWorkerStateArgs struct {
 // Field names match coordinator.go for backward compatibility.
 type workerStateInfo struct {
 	WorkerID     string `json:"worker_id"`
+	Label        string `json:"label,omitempty"`
 	Status       string `json:"status"`
 	Phase        string `json:"phase"`
 	AgentType    string `json:"agent_type,omitempty"`
@@ -330,32 +483,69 @@ type workerStateInfo struct {
 	SessionID    string `json:"session_id,omitempty"`
 	QueueSize    int    `json:"queue_size,omitempty"`
 	ContextUsage string `json:"context_usage,omitempty"`
+	InputTokens  int    `json:"input_tokens,omitempty"`
+	OutputTokens int    `json:"output_tokens,omitempty"`
 	StartedAt    string `json:"started_at"`
 	CreatedAt    string `json:"created_at,omitempty"`
 	RetiredAt    string `json:"retired_at,omitempty"`
+	// RetirementReason is set when the worker requested retirement after its current turn
+	RetirementReason string `json:"retirement_reason,omitempty"`
 	// Task details if assigned
-	TaskStatus  string `json:"task_status,omitempty"`
-	TaskStarted string `json:"task_started,omitempty"`
-	ReviewerID  string `json:"reviewer_id,omitempty"`
+	TaskStatus  string           `json:"task_status,omitempty"`
+	TaskStarted string           `json:"task_started,omitempty"`
+	ReviewerID  string           `json:"reviewer_id,omitempty"`
+	TestResults *testResultsInfo `json:"test_results,omitempty"`
+}
+
+// testResultsInfo represents reported test results in the query_worker_state response.
+type testResultsInfo struct {
+	Passed     int    `json:"passed"`
+	Failed     int    `json:"failed"`
+	Output     string `json:"output,omitempty"`
+	ReportedAt string `json:"reported_at,omitempty"`
+}
+
+// newTestResultsInfo converts repository test results to the response format.
+// Returns nil if no results were reported.
+func newTestResultsInfo(tr *repository.TestResults) *testResultsInfo {
+	if tr == nil {
+		return nil
+	}
+	info := &testResultsInfo{
+		Passed: tr.Passed,
+		Failed: tr.Failed,
+		Output: tr.Output,
+	}
+	if !tr.ReportedAt.IsZero() {
+		info.ReportedAt = tr.ReportedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return info
 }
 
 // taskAssignmentInfo represents a task assignment in the query_worker_state response.
 type taskAssignmentInfo struct {
-	TaskID          string `json:"task_id"`
-	Implementer     string `json:"implementer"`
-	Reviewer        string `json:"reviewer,omitempty"`
-	Status          string `json:"status"`
-	StartedAt       string `json:"started_at,omitempty"`
-	ReviewStartedAt string `json:"review_started_at,omitempty"`
+	TaskID          string           `json:"task_id"`
+	Implementer     string           `json:"implementer"`
+	Reviewer        string           `json:"reviewer,omitempty"`
+	Status          string           `json:"status"`
+	StartedAt       string           `json:"started_at,omitempty"`
+	ReviewStartedAt string           `json:"review_started_at,omitempty"`
+	TestResults     *testResultsInfo `json:"test_results,omitempty"`
+	TransferredFrom string           `json:"transferred_from,omitempty"`
+	TransferNote    string           `json:"transfer_note,omitempty"`
+	Held            bool             `json:"held,omitempty"`
 }
 
-// workerStateResponse is the response format for query_worker_state tool.
-type workerStateResponse struct {
+// QueryWorkerStateResult is the result of the query_worker_state tool.
+type QueryWorkerStateResult struct {
+	ToolResult
 	Workers        []workerStateInfo             `json:"workers"`
 	ReadyWorkers   []string                      `json:"ready_workers"`
 	RetiredWorkers []string                      `json:"retired_workers"`
 	FailedWorkers  []string                      `json:"failed_workers"`
 	Tasks          map[string]taskAssignmentInfo `json:"tasks"`
+	// HeldTasks maps tasks put on hold with hold_task to the reason given.
+	HeldTasks map[string]string `json:"held_tasks,omitempty"`
 }
 
 // HandleQueryWorkerState handles the query_worker_state MCP tool call.
@@ -385,7 +575,8 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 	workers := a.processRepo.ActiveWorkers()
 
 	// Build response
-	response := workerStateResponse{
+	response := QueryWorkerStateResult{
+		ToolResult:     okResult(),
 		Workers:        make([]workerStateInfo, 0),
 		ReadyWorkers:   make([]string, 0),
 		RetiredWorkers: make([]string, 0),
@@ -393,6 +584,11 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 		Tasks:          make(map[string]taskAssignmentInfo),
 	}
 
+	// Held tasks are recorded on the coordinator
+	if coord, err := a.processRepo.GetCoordinator(); err == nil && len(coord.HeldTasks) > 0 {
+		response.HeldTasks = maps.Clone(coord.HeldTasks)
+	}
+
 	// Populate retired workers (gracefully retired)
 	retiredWorkers := a.processRepo.RetiredWorkers()
 	for _, p := range retiredWorkers {
@@ -405,16 +601,23 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 		response.FailedWorkers = append(response.FailedWorkers, p.ID)
 	}
 
-	// Populate all tasks
+	// Populate all tasks still in progress (completed tasks are kept only as history)
 	if a.taskRepo != nil {
 		allTasks := a.taskRepo.All()
 		for _, task := range allTasks {
+			if task.Status == repository.TaskCompleted {
+				continue
+			}
 			info := taskAssignmentInfo{
-				TaskID:      task.TaskID,
-				Implementer: task.Implementer,
-				Reviewer:    task.Reviewer,
-				Status:      string(task.Status),
+				TaskID:          task.TaskID,
+				Implementer:     task.Implementer,
+				Reviewer:        task.Reviewer,
+				Status:          string(task.Status),
+				TestResults:     newTestResultsInfo(task.TestResults),
+				TransferredFrom: task.TransferredFrom,
+				TransferNote:    task.TransferNote,
 			}
+			_, info.Held = response.HeldTasks[task.TaskID]
 			if !task.StartedAt.IsZero() {
 				info.StartedAt = task.StartedAt.Format("2006-01-02T15:04:05Z07:00")
 			}
@@ -449,6 +652,7 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 		}
 		info := workerStateInfo{
 			WorkerID:  p.ID,
+			Label:     p.Label,
 			Status:    processStatusToWorkerStatus(p.Status),
 			Phase:     phase,
 			AgentType: p.AgentType.String(),
@@ -457,6 +661,8 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 			QueueSize: queueSize,
 			StartedAt: p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			CreatedAt: p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+			RetirementReason: p.RetirementReason,
 		}
 
 		// Add retired_at if worker is retired
@@ -469,6 +675,10 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 			info.ContextUsage = formatContextUsage(p.Metrics.TokensUsed, p.Metrics.TotalTokens)
 		}
 
+		// Add cumulative token usage across all turns
+		info.InputTokens = p.Usage.InputTokens
+		info.OutputTokens = p.Usage.OutputTokens
+
 		// Get current task assignment if task repository is available
 		if a.taskRepo != nil && p.TaskID != "" {
 			if task, err := a.taskRepo.Get(p.TaskID); err == nil {
@@ -477,23 +687,240 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 					info.TaskStarted = task.StartedAt.Format("2006-01-02T15:04:05Z07:00")
 				}
 				info.ReviewerID = task.Reviewer
+				info.TestResults = newTestResultsInfo(task.TestResults)
 			}
 		}
 
 		response.Workers = append(response.Workers, info)
 
-		// Track ready workers (Ready status with no task)
-		if p.Status == repository.StatusReady && p.TaskID == "" {
+		// Track ready workers (Ready status with no task, past any post-ready grace period)
+		if p.Status == repository.StatusReady && p.TaskID == "" && !p.InReadyGrace(a.Now()) {
 			response.ReadyWorkers = append(response.ReadyWorkers, p.ID)
 		}
 	}
 
-	jsonBytes, err := json.MarshalIndent(response, "", "  ")
+	// Repositories iterate maps, so sort for stable output across calls.
+	// Tasks is a map, which encoding/json already serializes in key order.
+	sort.Slice(response.Workers, func(i, j int) bool { return response.Workers[i].WorkerID < response.Workers[j].WorkerID })
+	sort.Strings(response.ReadyWorkers)
+	sort.Strings(response.RetiredWorkers)
+	sort.Strings(response.FailedWorkers)
+
+	return jsonResult(response)
+}
+
+// Orphan reasons reported by list_orphaned_tasks.
+const (
+	orphanReasonUnassigned         = "unassigned"
+	orphanReasonImplementerMissing = "implementer_missing"
+	orphanReasonImplementerRetired = "implementer_retired"
+	orphanReasonImplementerFailed  = "implementer_failed"
+	orphanReasonReviewerMissing    = "reviewer_missing"
+	orphanReasonReviewerRetired    = "reviewer_retired"
+	orphanReasonReviewerFailed     = "reviewer_failed"
+)
+
+// OrphanedTask describes an active task whose assigned worker can no longer progress it.
+type OrphanedTask struct {
+	TaskID      string `json:"task_id"`
+	Status      string `json:"status"`
+	Implementer string `json:"implementer"`
+	Reviewer    string `json:"reviewer,omitempty"`
+	Reason      string `json:"reason"`
+}
+
+// ListOrphanedTasksResult is the result of the list_orphaned_tasks tool.
+type ListOrphanedTasksResult struct {
+	ToolResult
+	OrphanedTasks []OrphanedTask `json:"orphaned_tasks"`
+}
+
+// HandleListOrphanedTasks handles the list_orphaned_tasks MCP tool call.
+// This is a read-only operation that reads directly from repositories.
+func (a *V2Adapter) HandleListOrphanedTasks(_ context.Context, _ json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil || a.taskRepo == nil {
+		return nil, fmt.Errorf("process and task repositories not configured for read-only operations")
+	}
+
+	return jsonResult(ListOrphanedTasksResult{ToolResult: okResult(), OrphanedTasks: a.DetectOrphanedTasks()})
+}
+
+// DetectOrphanedTasks returns active tasks without an implementer, or whose implementer
+// is retired, failed, or missing, or whose reviewer is in that state while the task is in review.
+// Results are sorted by task ID. Returns an empty slice if repositories are not configured.
+func (a *V2Adapter) DetectOrphanedTasks() []OrphanedTask {
+	orphans := make([]OrphanedTask, 0)
+	if a.processRepo == nil || a.taskRepo == nil {
+		return orphans
+	}
+	for _, task := range a.taskRepo.All() {
+		if task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed {
+			continue
+		}
+
+		var reason string
+		if task.Implementer == "" {
+			// A task reopened in bd is left without a worker until it is assigned again
+			reason = orphanReasonUnassigned
+		} else {
+			reason = a.orphanReason(task.Implementer,
+				orphanReasonImplementerMissing, orphanReasonImplementerRetired, orphanReasonImplementerFailed)
+		}
+		if reason == "" && task.Status == repository.TaskInReview && task.Reviewer != "" {
+			reason = a.orphanReason(task.Reviewer,
+				orphanReasonReviewerMissing, orphanReasonReviewerRetired, orphanReasonReviewerFailed)
+		}
+		if reason == "" {
+			continue
+		}
+
+		orphans = append(orphans, OrphanedTask{
+			TaskID:      task.TaskID,
+			Status:      string(task.Status),
+			Implementer: task.Implementer,
+			Reviewer:    task.Reviewer,
+			Reason:      reason,
+		})
+	}
+
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i].TaskID < orphans[j].TaskID })
+	return orphans
+}
+
+// orphanReason returns the matching reason if the worker can no longer progress its task,
+// or an empty string if the worker is still usable.
+func (a *V2Adapter) orphanReason(workerID, missing, retired, failed string) string {
+	proc, err := a.processRepo.Get(workerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal worker state: %w", err)
+		return missing
 	}
+	switch proc.Status {
+	case repository.StatusRetiring, repository.StatusRetired:
+		return retired
+	case repository.StatusFailed:
+		return failed
+	}
+	return ""
+}
 
-	return mcptypes.StructuredResult(string(jsonBytes), response), nil
+// EstimateStuckFactor scales a task's effort estimate into the time its worker may work
+// before being reported stuck.
+const EstimateStuckFactor = 1.5
+
+// StuckWorker describes a worker that has been working without completing a turn
+// for longer than the stuck timeout.
+type StuckWorker struct {
+	WorkerID string        `json:"worker_id"`
+	TaskID   string        `json:"task_id,omitempty"`
+	Idle     time.Duration `json:"idle"`
+}
+
+// CheckStuckWorkers returns working workers whose last completed turn (or spawn,
+// if they never completed one) is older than their stuck threshold as of now.
+// The threshold is timeout, raised to the assigned task's estimate scaled by
+// EstimateStuckFactor when that is longer.
+// Results are sorted by worker ID. Returns an empty slice if the process repository is not configured.
+func (a *V2Adapter) CheckStuckWorkers(now time.Time, timeout time.Duration) []StuckWorker {
+	stuck := make([]StuckWorker, 0)
+	if a.processRepo == nil {
+		return stuck
+	}
+	for _, p := range a.processRepo.ActiveWorkers() {
+		if p.Status != repository.StatusWorking {
+			continue
+		}
+		since := p.LastActivityAt
+		if since.IsZero() {
+			since = p.CreatedAt
+		}
+		if idle := now.Sub(since); idle > a.stuckThreshold(p.TaskID, timeout) {
+			stuck = append(stuck, StuckWorker{WorkerID: p.ID, TaskID: p.TaskID, Idle: idle})
+		}
+	}
+
+	sort.Slice(stuck, func(i, j int) bool { return stuck[i].WorkerID < stuck[j].WorkerID })
+	return stuck
+}
+
+// stuckThreshold returns how long a worker on taskID may work before it is stuck:
+// timeout, or the task's estimate scaled by EstimateStuckFactor if that is longer.
+func (a *V2Adapter) stuckThreshold(taskID string, timeout time.Duration) time.Duration {
+	if a.taskRepo == nil || taskID == "" {
+		return timeout
+	}
+	task, err := a.taskRepo.Get(taskID)
+	if err != nil || task.EstimateMinutes <= 0 {
+		return timeout
+	}
+	return max(timeout, time.Duration(float64(task.EstimateMinutes)*EstimateStuckFactor*float64(time.Minute)))
+}
+
+// UnreadyWorker describes a spawned worker that has not signaled ready (completed its
+// first turn) within the ready timeout.
+type UnreadyWorker struct {
+	WorkerID string        `json:"worker_id"`
+	Waiting  time.Duration `json:"waiting"`
+}
+
+// CheckUnreadyWorkers returns starting workers (pending, starting, or running their first
+// turn) that were spawned longer than timeout ago as of now but have never completed a turn.
+// Workers without a recorded spawn time are skipped.
+// Results are sorted by worker ID. Returns an empty slice if the process repository is not configured.
+func (a *V2Adapter) CheckUnreadyWorkers(now time.Time, timeout time.Duration) []UnreadyWorker {
+	unready := make([]UnreadyWorker, 0)
+	if a.processRepo == nil {
+		return unready
+	}
+	for _, p := range a.processRepo.ActiveWorkers() {
+		if p.HasCompletedTurn || p.CreatedAt.IsZero() {
+			continue
+		}
+		if p.Status != repository.StatusPending && p.Status != repository.StatusStarting && p.Status != repository.StatusWorking {
+			continue
+		}
+		if waiting := now.Sub(p.CreatedAt); waiting > timeout {
+			unready = append(unready, UnreadyWorker{WorkerID: p.ID, Waiting: waiting})
+		}
+	}
+
+	sort.Slice(unready, func(i, j int) bool { return unready[i].WorkerID < unready[j].WorkerID })
+	return unready
+}
+
+// UnassignedWorker is a worker that has signaled ready and holds no task.
+type UnassignedWorker struct {
+	WorkerID string `json:"worker_id"`
+	// Ready is true if the worker is waiting for input rather than running a turn.
+	Ready bool `json:"ready"`
+	// Idle is how long since the worker last completed a turn.
+	Idle time.Duration `json:"idle"`
+}
+
+// UnassignedWorkers returns active workers that have completed at least one turn and
+// hold no task, as of now.
+// Results are sorted by worker ID. Returns an empty slice if the process repository is not configured.
+func (a *V2Adapter) UnassignedWorkers(now time.Time) []UnassignedWorker {
+	unassigned := make([]UnassignedWorker, 0)
+	if a.processRepo == nil {
+		return unassigned
+	}
+	for _, p := range a.processRepo.ActiveWorkers() {
+		if !p.HasCompletedTurn || p.TaskID != "" {
+			continue
+		}
+		since := p.LastActivityAt
+		if since.IsZero() {
+			since = p.CreatedAt
+		}
+		unassigned = append(unassigned, UnassignedWorker{
+			WorkerID: p.ID,
+			Ready:    p.Status == repository.StatusReady && (p.Phase == nil || *p.Phase == events.ProcessPhaseIdle),
+			Idle:     now.Sub(since),
+		})
+	}
+
+	sort.Slice(unassigned, func(i, j int) bool { return unassigned[i].WorkerID < unassigned[j].WorkerID })
+	return unassigned
 }
 
 // ===========================================================================
@@ -501,12 +928,17 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 // ===========================================================================
 
 // HandleSendToWorker handles the send_to_worker MCP tool call.
+// A worker_id of ALL, ALL_REVIEWERS, or ALL_IMPLEMENTERS broadcasts to every worker in that role.
 func (a *V2Adapter) HandleSendToWorker(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
 	var parsed sendToWorkerArgs
 	if err := json.Unmarshal(args, &parsed); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
 
+	if message.IsBroadcast(parsed.WorkerID) {
+		return a.broadcastToWorkers(ctx, parsed.WorkerID, parsed.Message)
+	}
+
 	cmd := command.NewSendToProcessCommand(command.SourceMCPTool, parsed.WorkerID, parsed.Message)
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("send_to_worker command validation failed: %w", err)
@@ -518,10 +950,51 @@ func (a *V2Adapter) HandleSendToWorker(ctx context.Context, args json.RawMessage
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
+	}
+
+	return jsonResult(SendToWorkerResult{
+		ToolResult: okResult(),
+		Recipient:  parsed.WorkerID,
+		WorkerIDs:  []string{parsed.WorkerID},
+		Message:    fmt.Sprintf("Message sent to worker %s", parsed.WorkerID),
+	})
+}
+
+// broadcastToWorkers submits a role-scoped broadcast for the given recipient token.
+func (a *V2Adapter) broadcastToWorkers(ctx context.Context, recipient, content string) (*mcptypes.ToolCallResult, error) {
+	cmd := command.NewBroadcastCommand(command.SourceMCPTool, content, nil)
+	cmd.Recipient = recipient
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("send_to_worker command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("send_to_worker command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Message sent to worker %s", parsed.WorkerID)), nil
+	res := SendToWorkerResult{
+		ToolResult: okResult(),
+		Recipient:  recipient,
+		WorkerIDs:  []string{},
+		Message:    fmt.Sprintf("Message sent to %s", recipient),
+	}
+	if v, ok := result.Data.(broadcastTargetsExtractor); ok {
+		targets := v.GetTargetWorkers()
+		if len(targets) == 0 {
+			res.Message = fmt.Sprintf("No workers matched %s; message not delivered", recipient)
+		} else {
+			res.WorkerIDs = targets
+			res.Message = fmt.Sprintf("Message sent to %s (%d workers: %s)",
+				recipient, len(targets), strings.Join(targets, ", "))
+		}
+	}
+	return jsonResult(res)
 }
 
 // ===========================================================================
@@ -534,8 +1007,12 @@ func (a *V2Adapter) HandleAssignTask(ctx context.Context, args json.RawMessage)
 	if err := json.Unmarshal(args, &parsed); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
+	if err := a.checkTaskIDPrefix("assign_task", "task_id", parsed.TaskID); err != nil {
+		return nil, err
+	}
 
 	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, parsed.WorkerID, parsed.TaskID, parsed.Summary, parsed.ThreadID)
+	cmd.EstimateMinutes = parsed.EstimateMinutes
 	err := cmd.Validate()
 	if err != nil {
 		return nil, fmt.Errorf("assign_task command validation failed: %w", err)
@@ -547,13 +1024,128 @@ func (a *V2Adapter) HandleAssignTask(ctx context.Context, args json.RawMessage)
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
+	}
+
+	// The handler selects a worker when none was named, so report the one it chose
+	workerID := parsed.WorkerID
+	if v, ok := result.Data.(processIDExtractor); ok {
+		workerID = v.GetProcessID()
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Task %s assigned to worker %s", parsed.TaskID, parsed.WorkerID)), nil
+	msg := fmt.Sprintf("Task %s assigned to worker %s", parsed.TaskID, workerID)
+	return messageResult(msg, AssignTaskResult{ToolResult: okResult(), TaskID: parsed.TaskID, WorkerID: workerID, Message: msg}), nil
+}
+
+// HandleAssignTasksBatch handles the assign_tasks_batch MCP tool call.
+// Each assignment is attempted independently; the response lists a result per item.
+func (a *V2Adapter) HandleAssignTasksBatch(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed assignTasksBatchArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	for _, assignment := range parsed.Assignments {
+		if err := a.checkTaskIDPrefix("assign_tasks_batch", "task_id", assignment.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
+	items := make([]command.TaskAssignmentItem, len(parsed.Assignments))
+	for i, assignment := range parsed.Assignments {
+		items[i] = command.TaskAssignmentItem{
+			WorkerID: assignment.WorkerID,
+			TaskID:   assignment.TaskID,
+			Summary:  assignment.Summary,
+			ThreadID: assignment.ThreadID,
+		}
+	}
+
+	cmd := command.NewAssignTasksBatchCommand(command.SourceMCPTool, items)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("assign_tasks_batch command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("assign_tasks_batch command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	// Result data carries per-item outcomes with JSON tags matching the response
+	response := AssignTasksBatchResult{ToolResult: okResult(), Results: []AssignTasksBatchItem{}}
+	data, err := json.Marshal(result.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch result: %w", err)
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode batch result: %w", err)
+	}
+
+	return jsonResult(response)
+}
+
+// eligibleWorkersExtractor is an interface for results that report which workers could take a task.
+type eligibleWorkersExtractor interface {
+	EligibleWorkerIDs() []string
+	IneligibleWorkerIDs() []string
+	IneligibilityOf(workerID string) (status, phase, reason string)
+}
+
+// getEligibleWorkersArgs holds arguments for get_eligible_workers tool.
+type getEligibleWorkersArgs struct {
+	TaskID string `json:"task_id"`
+}
+
+// HandleGetEligibleWorkers handles the get_eligible_workers MCP tool call.
+// Routes through the v2 command processor using CmdGetEligibleWorkers, which runs the
+// assign_task checks against every active worker without assigning anything.
+func (a *V2Adapter) HandleGetEligibleWorkers(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed getEligibleWorkersArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewGetEligibleWorkersCommand(command.SourceMCPTool, parsed.TaskID)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("get_eligible_workers command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("get_eligible_workers command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	response := GetEligibleWorkersResult{
+		ToolResult: okResult(),
+		TaskID:     parsed.TaskID,
+		Eligible:   []string{},
+		Ineligible: []IneligibleWorkerItem{},
+	}
+	if v, ok := result.Data.(eligibleWorkersExtractor); ok {
+		response.Eligible = append(response.Eligible, v.EligibleWorkerIDs()...)
+		for _, workerID := range v.IneligibleWorkerIDs() {
+			status, phase, reason := v.IneligibilityOf(workerID)
+			response.Ineligible = append(response.Ineligible, IneligibleWorkerItem{
+				WorkerID: workerID,
+				Status:   status,
+				Phase:    phase,
+				Reason:   reason,
+			})
+		}
+	}
+
+	return jsonResult(response)
 }
 
 // HandleAssignTaskReview handles the assign_task_review MCP tool call.
+// A reviewer_id of "auto" (or none) lets the handler pick a ready reviewer other than the implementer.
 func (a *V2Adapter) HandleAssignTaskReview(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
 	var parsed assignTaskReviewArgs
 	if err := json.Unmarshal(args, &parsed); err != nil {
@@ -577,10 +1169,32 @@ func (a *V2Adapter) HandleAssignTaskReview(ctx context.Context, args json.RawMes
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Review of task %s assigned to worker %s", parsed.TaskID, parsed.ReviewerID)), nil
+	reviewerID := parsed.ReviewerID
+	if v, ok := result.Data.(processIDExtractor); ok {
+		reviewerID = v.GetProcessID()
+	}
+
+	var threadID string
+	if v, ok := result.Data.(threadIDExtractor); ok {
+		threadID = v.GetThreadID()
+	}
+
+	autoSelected := parsed.ReviewerID == "" || parsed.ReviewerID == command.AutoReviewer
+	msg := fmt.Sprintf("Review of task %s assigned to worker %s", parsed.TaskID, reviewerID)
+	if autoSelected {
+		msg += " (selected automatically)"
+	}
+	return messageResult(msg, AssignTaskReviewResult{
+		ToolResult:   okResult(),
+		TaskID:       parsed.TaskID,
+		ReviewerID:   reviewerID,
+		AutoSelected: autoSelected,
+		ThreadID:     threadID,
+		Message:      msg,
+	}), nil
 }
 
 // HandleAssignReviewFeedback handles the assign_review_feedback MCP tool call.
@@ -592,6 +1206,7 @@ func (a *V2Adapter) HandleAssignReviewFeedback(ctx context.Context, args json.Ra
 	}
 
 	cmd := command.NewAssignReviewFeedbackCommand(command.SourceMCPTool, parsed.ImplementerID, parsed.TaskID, parsed.Feedback)
+	cmd.Items = parsed.Items
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("assign_review_feedback command validation failed: %w", err)
 	}
@@ -602,10 +1217,91 @@ func (a *V2Adapter) HandleAssignReviewFeedback(ctx context.Context, args json.Ra
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
+	}
+
+	msg := fmt.Sprintf("Review feedback sent to worker %s for task %s", parsed.ImplementerID, parsed.TaskID)
+	if len(parsed.Items) > 0 {
+		msg = fmt.Sprintf("Review feedback (%d items) sent to worker %s for task %s", len(parsed.Items), parsed.ImplementerID, parsed.TaskID)
+	}
+	return messageResult(msg, AssignReviewFeedbackResult{
+		ToolResult:    okResult(),
+		TaskID:        parsed.TaskID,
+		ImplementerID: parsed.ImplementerID,
+		Items:         len(parsed.Items),
+		Message:       msg,
+	}), nil
+}
+
+// HandleTransferTask handles the transfer_task MCP tool call.
+// This moves an in-flight task to a ready worker without retiring the current one.
+func (a *V2Adapter) HandleTransferTask(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed transferTaskArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if err := a.checkTaskIDPrefix("transfer_task", "task_id", parsed.TaskID); err != nil {
+		return nil, err
+	}
+
+	cmd := command.NewTransferTaskCommand(command.SourceMCPTool, parsed.TaskID, parsed.FromWorker, parsed.ToWorker, parsed.Note)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("transfer_task command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("transfer_task command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Review feedback sent to worker %s for task %s", parsed.ImplementerID, parsed.TaskID)), nil
+	msg := fmt.Sprintf("Task %s transferred from worker %s to worker %s", parsed.TaskID, parsed.FromWorker, parsed.ToWorker)
+	return messageResult(msg, TransferTaskResult{
+		ToolResult: okResult(),
+		TaskID:     parsed.TaskID,
+		FromWorker: parsed.FromWorker,
+		ToWorker:   parsed.ToWorker,
+		Message:    msg,
+	}), nil
+}
+
+// HandleDrainWorker handles the drain_worker MCP tool call.
+// This transfers each of the worker's in-flight tasks to a ready worker and retires it,
+// reporting which tasks moved and which were left orphaned.
+func (a *V2Adapter) HandleDrainWorker(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed drainWorkerArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewDrainWorkerCommand(command.SourceMCPTool, parsed.WorkerID, parsed.Reason)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("drain_worker command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("drain_worker command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	// Result data carries per-task outcomes with JSON tags matching the response
+	response := DrainWorkerResult{ToolResult: okResult(), Tasks: []DrainedTaskItem{}}
+	data, err := json.Marshal(result.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal drain result: %w", err)
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode drain result: %w", err)
+	}
+
+	return jsonResult(response)
 }
 
 // HandleApproveCommit handles the approve_commit MCP tool call.
@@ -616,6 +1312,7 @@ func (a *V2Adapter) HandleApproveCommit(ctx context.Context, args json.RawMessag
 	}
 
 	cmd := command.NewApproveCommitCommand(command.SourceMCPTool, parsed.ImplementerID, parsed.TaskID)
+	cmd.AllowFailingTests = parsed.AllowFailingTests
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("approve_commit command validation failed: %w", err)
 	}
@@ -626,10 +1323,11 @@ func (a *V2Adapter) HandleApproveCommit(ctx context.Context, args json.RawMessag
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Commit approved for worker %s on task %s", parsed.ImplementerID, parsed.TaskID)), nil
+	msg := fmt.Sprintf("Commit approved for worker %s on task %s", parsed.ImplementerID, parsed.TaskID)
+	return messageResult(msg, ApproveCommitResult{ToolResult: okResult(), TaskID: parsed.TaskID, ImplementerID: parsed.ImplementerID, Message: msg}), nil
 }
 
 // ===========================================================================
@@ -639,9 +1337,12 @@ func (a *V2Adapter) HandleApproveCommit(ctx context.Context, args json.RawMessag
 // ReportImplementationCompleteResult contains the result of report_implementation_complete.
 // This allows the MCP layer to access the task's ThreadID for Fabric replies.
 type ReportImplementationCompleteResult struct {
-	Success  bool
-	ThreadID string // Fabric thread ID for the task conversation
-	Message  string
+	Success       bool
+	ThreadID      string // Fabric thread ID for the task conversation
+	Message       string
+	ReviewSkipped bool     // Task went straight to committing because the workflow does not require review
+	ReviewForced  []string // Changed files under sensitive paths that required review despite the workflow
+	Unaddressed   []string // Review feedback items not marked addressed, as "<id>. <text>"
 }
 
 // HandleReportImplementationComplete handles the report_implementation_complete MCP tool call.
@@ -678,11 +1379,145 @@ func (a *V2Adapter) HandleReportImplementationComplete(ctx context.Context, args
 		}
 	}
 
-	return &ReportImplementationCompleteResult{
+	response := &ReportImplementationCompleteResult{
 		Success:  true,
 		ThreadID: threadID,
 		Message:  "Implementation complete signal sent",
-	}, nil
+	}
+	if skipped, ok := result.Data.(reviewSkippedExtractor); ok && skipped.IsReviewSkipped() {
+		response.ReviewSkipped = true
+		response.Message = "Implementation complete signal sent; this workflow does not require review, commit your changes"
+	}
+	if v, ok := result.Data.(reviewForcedExtractor); ok {
+		if response.ReviewForced = v.ReviewForcedBy(); len(response.ReviewForced) > 0 {
+			response.Message = fmt.Sprintf("Implementation complete signal sent; review is required because the change touches sensitive paths: %s. Wait for the review before committing.",
+				strings.Join(response.ReviewForced, ", "))
+		}
+	}
+	if v, ok := result.Data.(unaddressedFeedbackExtractor); ok {
+		if response.Unaddressed = v.UnaddressedFeedbackItems(); len(response.Unaddressed) > 0 {
+			response.Message += fmt.Sprintf("\n\nWARNING: %d review feedback item(s) not marked addressed:\n%s\n"+
+				"Address them or mark them with mark_feedback_addressed if already done.",
+				len(response.Unaddressed), strings.Join(response.Unaddressed, "\n"))
+		}
+	}
+	return response, nil
+}
+
+// HandleMarkFeedbackAddressed handles the mark_feedback_addressed MCP tool call.
+// Marks review feedback items on the worker's current task as addressed.
+func (a *V2Adapter) HandleMarkFeedbackAddressed(ctx context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	var parsed markFeedbackAddressedArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewMarkFeedbackAddressedCommand(command.SourceMCPTool, workerID, parsed.ItemIDs)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("mark_feedback_addressed command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("mark_feedback_addressed command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	var remaining []string
+	if v, ok := result.Data.(unaddressedFeedbackExtractor); ok {
+		remaining = v.UnaddressedFeedbackItems()
+	}
+	if len(remaining) == 0 {
+		return mcptypes.SuccessResult("All review feedback items addressed"), nil
+	}
+	return mcptypes.SuccessResult(fmt.Sprintf("Feedback marked addressed; %d item(s) remaining:\n%s",
+		len(remaining), strings.Join(remaining, "\n"))), nil
+}
+
+// HandleReportTestResults handles the report_test_results MCP tool call.
+// Results are attached to the task currently assigned to the worker.
+func (a *V2Adapter) HandleReportTestResults(ctx context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	var parsed reportTestResultsArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewReportTestResultsCommand(command.SourceMCPTool, workerID, parsed.Passed, parsed.Failed, parsed.Output)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("report_test_results command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("report_test_results command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	return mcptypes.SuccessResult(fmt.Sprintf("Test results recorded: %d passed, %d failed", parsed.Passed, parsed.Failed)), nil
+}
+
+// HandleCheckpoint handles the checkpoint MCP tool call.
+// The worker's uncommitted changes are snapshotted as a [WIP] commit without moving HEAD,
+// which a replacement worker restores from if this worker is lost.
+func (a *V2Adapter) HandleCheckpoint(ctx context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	var parsed checkpointArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewCheckpointCommand(command.SourceMCPTool, workerID, parsed.Message)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("checkpoint command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("checkpoint command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	if v, ok := result.Data.(checkpointExtractor); ok && v.CheckpointHash() != "" {
+		return mcptypes.SuccessResult(fmt.Sprintf("Checkpoint saved: %s (your changes remain uncommitted)", v.CheckpointHash())), nil
+	}
+	return mcptypes.SuccessResult("Nothing to checkpoint: the worktree has no uncommitted changes"), nil
+}
+
+// HandleRequestRetirement handles the request_retirement MCP tool call.
+// The worker is replaced after its current turn and the coordinator is asked to reassign its task.
+func (a *V2Adapter) HandleRequestRetirement(ctx context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	var parsed requestRetirementArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewRequestRetirementCommand(command.SourceMCPTool, workerID, parsed.Reason)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("request_retirement command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("request_retirement command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	if v, ok := result.Data.(retirementRequestExtractor); ok && v.WasAlreadyRequested() {
+		return mcptypes.SuccessResult("Retirement already requested. You will be replaced when this turn ends."), nil
+	}
+
+	return mcptypes.SuccessResult("Retirement requested. The coordinator has been notified and you will be replaced when this turn ends. Stop working and end your turn now."), nil
 }
 
 // ReportReviewVerdictResult contains the result of report_review_verdict.
@@ -760,12 +1595,25 @@ func (a *V2Adapter) HandleReportReviewVerdict(ctx context.Context, args json.Raw
 // markTaskCompleteArgs holds arguments for mark_task_complete tool.
 type markTaskCompleteArgs struct {
 	TaskID string `json:"task_id"`
+	Force  bool   `json:"force,omitempty"`
 }
 
 // markTaskFailedArgs holds arguments for mark_task_failed tool.
 type markTaskFailedArgs struct {
-	TaskID string `json:"task_id"`
-	Reason string `json:"reason"`
+	TaskID   string `json:"task_id"`
+	Reason   string `json:"reason"`
+	Category string `json:"category,omitempty"`
+}
+
+// addTaskBlockerArgs holds arguments for add_task_blocker tool.
+type addTaskBlockerArgs struct {
+	TaskID    string `json:"task_id"`
+	BlockerID string `json:"blocker_id"`
+}
+
+// addTaskBlockerResultExtractor is an interface for results that report an existing blocker link.
+type addTaskBlockerResultExtractor interface {
+	IsAlreadyBlocked() bool
 }
 
 // HandleMarkTaskComplete handles the mark_task_complete MCP tool call.
@@ -775,8 +1623,12 @@ func (a *V2Adapter) HandleMarkTaskComplete(ctx context.Context, args json.RawMes
 	if err := json.Unmarshal(args, &parsed); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
+	if err := a.checkTaskIDPrefix("mark_task_complete", "task_id", parsed.TaskID); err != nil {
+		return nil, err
+	}
 
 	cmd := command.NewMarkTaskCompleteCommand(command.SourceMCPTool, parsed.TaskID)
+	cmd.Force = parsed.Force
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("mark_task_complete command validation failed: %w", err)
 	}
@@ -787,16 +1639,15 @@ func (a *V2Adapter) HandleMarkTaskComplete(ctx context.Context, args json.RawMes
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
-	// Return structured response for consistency with existing behavior
-	response := map[string]any{
-		"status":  "success",
-		"message": fmt.Sprintf("Task %s marked as completed", parsed.TaskID),
-	}
-	data, _ := json.MarshalIndent(response, "", "  ")
-	return mcptypes.StructuredResult(string(data), response), nil
+	return jsonResult(MarkTaskCompleteResult{
+		ToolResult: okResult(),
+		TaskID:     parsed.TaskID,
+		Status:     "success",
+		Message:    fmt.Sprintf("Task %s marked as completed", parsed.TaskID),
+	})
 }
 
 // HandleMarkTaskFailed handles the mark_task_failed MCP tool call.
@@ -806,8 +1657,11 @@ func (a *V2Adapter) HandleMarkTaskFailed(ctx context.Context, args json.RawMessa
 	if err := json.Unmarshal(args, &parsed); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
+	if err := a.checkTaskIDPrefix("mark_task_failed", "task_id", parsed.TaskID); err != nil {
+		return nil, err
+	}
 
-	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, parsed.TaskID, parsed.Reason)
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, parsed.TaskID, parsed.Reason, repository.FailureCategory(parsed.Category))
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("mark_task_failed command validation failed: %w", err)
 	}
@@ -818,10 +1672,371 @@ func (a *V2Adapter) HandleMarkTaskFailed(ctx context.Context, args json.RawMessa
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Task %s marked as failed with comment: %s", parsed.TaskID, parsed.Reason)), nil
+	msg := fmt.Sprintf("Task %s marked as failed with comment: %s", parsed.TaskID, parsed.Reason)
+	if parsed.Category != "" {
+		msg = fmt.Sprintf("Task %s marked as failed (%s) with comment: %s", parsed.TaskID, parsed.Category, parsed.Reason)
+	}
+	return messageResult(msg, MarkTaskFailedResult{
+		ToolResult: okResult(),
+		TaskID:     parsed.TaskID,
+		Category:   parsed.Category,
+		Reason:     parsed.Reason,
+		Message:    msg,
+	}), nil
+}
+
+// HandleAddTaskBlocker handles the add_task_blocker MCP tool call.
+// Routes through the v2 command processor using CmdAddTaskBlocker.
+func (a *V2Adapter) HandleAddTaskBlocker(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed addTaskBlockerArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if err := a.checkTaskIDPrefix("add_task_blocker", "task_id", parsed.TaskID); err != nil {
+		return nil, err
+	}
+
+	cmd := command.NewAddTaskBlockerCommand(command.SourceMCPTool, parsed.TaskID, parsed.BlockerID)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("add_task_blocker command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("add_task_blocker command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	response := AddTaskBlockerResult{
+		ToolResult: okResult(),
+		TaskID:     parsed.TaskID,
+		BlockerID:  parsed.BlockerID,
+		Message:    fmt.Sprintf("Task %s is now blocked by %s", parsed.TaskID, parsed.BlockerID),
+	}
+	if v, ok := result.Data.(addTaskBlockerResultExtractor); ok && v.IsAlreadyBlocked() {
+		response.AlreadyBlocked = true
+		response.Message = fmt.Sprintf("Task %s is already blocked by %s", parsed.TaskID, parsed.BlockerID)
+	}
+	return messageResult(response.Message, response), nil
+}
+
+// syncTaskStatusArgs holds arguments for sync_task_status tool.
+type syncTaskStatusArgs struct {
+	TaskID string `json:"task_id"`
+}
+
+// syncTaskStatusResultExtractor is an interface for results that report a status reconciliation.
+type syncTaskStatusResultExtractor interface {
+	GetBeadsStatus() string
+	GetPreviousStatus() string
+	GetStatus() string
+	WasCorrected() bool
+	IsOrphaned() bool
+}
+
+// HandleSyncTaskStatus handles the sync_task_status MCP tool call.
+// Routes through the v2 command processor using CmdSyncTaskStatus.
+func (a *V2Adapter) HandleSyncTaskStatus(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed syncTaskStatusArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if err := a.checkTaskIDPrefix("sync_task_status", "task_id", parsed.TaskID); err != nil {
+		return nil, err
+	}
+
+	cmd := command.NewSyncTaskStatusCommand(command.SourceMCPTool, parsed.TaskID)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("sync_task_status command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("sync_task_status command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	response := SyncTaskStatusResult{
+		ToolResult: okResult(),
+		TaskID:     parsed.TaskID,
+		Message:    fmt.Sprintf("Task %s is in sync with bd", parsed.TaskID),
+	}
+	if v, ok := result.Data.(syncTaskStatusResultExtractor); ok {
+		response.BeadsStatus = v.GetBeadsStatus()
+		response.PreviousStatus = v.GetPreviousStatus()
+		response.Status = v.GetStatus()
+		response.Corrected = v.WasCorrected()
+		response.NeedsAssignment = v.IsOrphaned()
+		if response.Corrected {
+			response.Message = fmt.Sprintf("Task %s was %s but bd says %s; updated to %s",
+				parsed.TaskID, response.PreviousStatus, response.BeadsStatus, response.Status)
+			if response.NeedsAssignment {
+				response.Message += "; it has no worker, assign it again with assign_task"
+			}
+		} else {
+			response.Message = fmt.Sprintf("Task %s is in sync with bd (%s, %s)",
+				parsed.TaskID, response.BeadsStatus, response.Status)
+		}
+	}
+	return messageResult(response.Message, response), nil
+}
+
+// completeEpicTasksArgs holds arguments for complete_epic_tasks tool.
+type completeEpicTasksArgs struct {
+	EpicID       string `json:"epic_id"`
+	ConfirmToken string `json:"confirm_token,omitempty"`
+}
+
+// completeEpicTasksResultExtractor is an interface for results that report closed and skipped subtasks.
+type completeEpicTasksResultExtractor interface {
+	ClosedTaskIDs() []string
+	SkippedTaskIDs() []string
+}
+
+// CompleteEpicTasksResult is the result of the complete_epic_tasks tool.
+type CompleteEpicTasksResult struct {
+	ToolResult
+	EpicID      string   `json:"epic_id"`
+	ClosedCount int      `json:"closed_count"`
+	Closed      []string `json:"closed"`
+	Skipped     []string `json:"skipped_assigned"`
+}
+
+// HandleCompleteEpicTasks handles the complete_epic_tasks MCP tool call.
+// Routes through the v2 command processor using CmdCompleteEpicTasks.
+// Subtasks still actively assigned to a worker are reported as skipped rather than closed.
+//
+// When a confirmation gate is configured, a call without confirm_token closes nothing:
+// it reports the subtasks that would be closed and returns a token, and the subtasks
+// are only closed when the tool is called again with that token.
+func (a *V2Adapter) HandleCompleteEpicTasks(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed completeEpicTasksArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if err := a.checkTaskIDPrefix("complete_epic_tasks", "epic_id", parsed.EpicID); err != nil {
+		return nil, err
+	}
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, parsed.EpicID)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("complete_epic_tasks command validation failed: %w", err)
+	}
+
+	if a.confirmGate != nil {
+		if parsed.ConfirmToken == "" {
+			cmd.DryRun = true
+		} else if !a.confirmGate.Confirm(parsed.ConfirmToken, "complete_epic_tasks", parsed.EpicID) {
+			return errorResult(fmt.Sprintf(
+				"invalid or expired confirm_token for epic %s; call complete_epic_tasks without a token to get a new one",
+				parsed.EpicID)), nil
+		}
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("complete_epic_tasks command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	response := CompleteEpicTasksResult{
+		ToolResult: okResult(),
+		EpicID:     parsed.EpicID,
+		Closed:     []string{},
+		Skipped:    []string{},
+	}
+	if v, ok := result.Data.(completeEpicTasksResultExtractor); ok {
+		response.Closed = append(response.Closed, v.ClosedTaskIDs()...)
+		response.Skipped = append(response.Skipped, v.SkippedTaskIDs()...)
+	}
+
+	// Nothing would be closed, so there is nothing to confirm
+	if cmd.DryRun && len(response.Closed) > 0 {
+		impact := fmt.Sprintf("will close %d subtasks of %s", len(response.Closed), parsed.EpicID)
+		if len(response.Skipped) > 0 {
+			impact += fmt.Sprintf(" (%d still assigned to workers are skipped)", len(response.Skipped))
+		}
+		confirm := ConfirmationRequiredResult{
+			ToolResult:   okResult(),
+			Tool:         "complete_epic_tasks",
+			ConfirmToken: a.confirmGate.Issue("complete_epic_tasks", parsed.EpicID),
+			Impact:       impact,
+			Affected:     response.Closed,
+		}
+		msg := fmt.Sprintf("Confirmation required: %s. Call complete_epic_tasks again with confirm_token to proceed.", impact)
+		return messageResult(msg, confirm), nil
+	}
+	response.ClosedCount = len(response.Closed)
+
+	return jsonResult(response)
+}
+
+// getCriticalPathArgs holds arguments for get_critical_path tool.
+type getCriticalPathArgs struct {
+	EpicID string `json:"epic_id"`
+}
+
+// criticalPathResultExtractor is an interface for results that report a critical path.
+type criticalPathResultExtractor interface {
+	CriticalPathTaskIDs() []string
+	CriticalPathLength() int
+	UsedEstimates() bool
+	ConsideredTaskCount() int
+}
+
+// GetCriticalPathResult is the result of the get_critical_path tool.
+type GetCriticalPathResult struct {
+	ToolResult
+	EpicID          string   `json:"epic_id"`
+	TaskIDs         []string `json:"task_ids"`
+	Length          int      `json:"length"`
+	Weighting       string   `json:"weighting"` // "estimated_minutes" or "task_count"
+	TasksConsidered int      `json:"tasks_considered"`
+}
+
+// HandleGetCriticalPath handles the get_critical_path MCP tool call.
+// Routes through the v2 command processor using CmdGetCriticalPath.
+// Returns the longest dependency chain through the epic's open subtasks, in order.
+func (a *V2Adapter) HandleGetCriticalPath(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed getCriticalPathArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewGetCriticalPathCommand(command.SourceMCPTool, parsed.EpicID)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("get_critical_path command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("get_critical_path command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	response := GetCriticalPathResult{
+		ToolResult: okResult(),
+		EpicID:     parsed.EpicID,
+		TaskIDs:    []string{},
+		Weighting:  "task_count",
+	}
+	if v, ok := result.Data.(criticalPathResultExtractor); ok {
+		response.TaskIDs = append(response.TaskIDs, v.CriticalPathTaskIDs()...)
+		response.Length = v.CriticalPathLength()
+		response.TasksConsidered = v.ConsideredTaskCount()
+		if v.UsedEstimates() {
+			response.Weighting = "estimated_minutes"
+		}
+	}
+
+	return jsonResult(response)
+}
+
+// getDependencyGraphArgs holds arguments for get_dependency_graph tool.
+type getDependencyGraphArgs struct {
+	EpicID string `json:"epic_id"`
+}
+
+// dependencyGraphExtractor is an interface for results that carry a dependency graph.
+type dependencyGraphExtractor interface {
+	DependencyGraph() beads.DependencyGraph
+}
+
+// dependencyGraphNode is an issue in the get_dependency_graph result.
+type dependencyGraphNode struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Status  string `json:"status"`
+	InCycle bool   `json:"in_cycle"` // On a cycle of blocked_by edges
+}
+
+// dependencyGraphEdge is a relationship in the get_dependency_graph result.
+// A "blocked_by" edge means from cannot start until to is closed; a "parent" edge
+// means to is the parent of from.
+type dependencyGraphEdge struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Type    string `json:"type"`     // "blocked_by" or "parent"
+	InCycle bool   `json:"in_cycle"` // Both ends are on the same blocking cycle
+}
+
+// GetDependencyGraphResult is the result of the get_dependency_graph tool.
+// Nodes are sorted by ID and edges by from, to and type, so output is stable.
+type GetDependencyGraphResult struct {
+	ToolResult
+	EpicID   string                `json:"epic_id"`
+	Nodes    []dependencyGraphNode `json:"nodes"`
+	Edges    []dependencyGraphEdge `json:"edges"`
+	HasCycle bool                  `json:"has_cycle"`
+}
+
+// HandleGetDependencyGraph handles the get_dependency_graph MCP tool call.
+// Routes through the v2 command processor using CmdGetDependencyGraph.
+// Returns the epic and its subtasks as nodes, and their blocking and parent relationships
+// as edges, for external tools to visualize or analyze.
+func (a *V2Adapter) HandleGetDependencyGraph(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed getDependencyGraphArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewGetDependencyGraphCommand(command.SourceMCPTool, parsed.EpicID)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("get_dependency_graph command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("get_dependency_graph command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	response := GetDependencyGraphResult{
+		ToolResult: okResult(),
+		EpicID:     parsed.EpicID,
+		Nodes:      []dependencyGraphNode{},
+		Edges:      []dependencyGraphEdge{},
+	}
+	if v, ok := result.Data.(dependencyGraphExtractor); ok {
+		graph := v.DependencyGraph()
+		for _, n := range graph.Nodes {
+			response.Nodes = append(response.Nodes, dependencyGraphNode{
+				ID:      n.ID,
+				Title:   n.Title,
+				Status:  string(n.Status),
+				InCycle: n.InCycle,
+			})
+			response.HasCycle = response.HasCycle || n.InCycle
+		}
+		for _, e := range graph.Edges {
+			response.Edges = append(response.Edges, dependencyGraphEdge{
+				From:    e.From,
+				To:      e.To,
+				Type:    string(e.Kind),
+				InCycle: e.InCycle,
+			})
+		}
+	}
+
+	return jsonResult(response)
 }
 
 // ===========================================================================
@@ -873,10 +2088,11 @@ func (a *V2Adapter) HandleGenerateAccountabilitySummary(ctx context.Context, arg
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Accountability summary task assigned to worker %s", parsed.WorkerID)), nil
+	msg := fmt.Sprintf("Accountability summary task assigned to worker %s", parsed.WorkerID)
+	return messageResult(msg, GenerateAccountabilitySummaryResult{ToolResult: okResult(), WorkerID: parsed.WorkerID, Message: msg}), nil
 }
 
 // ===========================================================================
@@ -914,7 +2130,7 @@ func (a *V2Adapter) HandleSignalWorkflowComplete(ctx context.Context, args json.
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
 	// Build response message based on optional fields
@@ -926,7 +2142,61 @@ func (a *V2Adapter) HandleSignalWorkflowComplete(ctx context.Context, args json.
 		msg += fmt.Sprintf(" - %d tasks closed", parsed.TasksClosed)
 	}
 
-	return mcptypes.SuccessResult(msg), nil
+	var failures map[string]int
+	if a.taskRepo != nil {
+		failures = failuresByCategory(a.taskRepo.All())
+	}
+	if len(failures) > 0 {
+		msg += " - failed tasks: " + formatFailuresByCategory(failures)
+	}
+
+	return messageResult(msg, SignalWorkflowCompleteResult{
+		ToolResult:         okResult(),
+		Status:             parsed.Status,
+		EpicID:             parsed.EpicID,
+		TasksClosed:        parsed.TasksClosed,
+		FailuresByCategory: failures,
+		Message:            msg,
+	}), nil
+}
+
+// uncategorizedFailure is the key failuresByCategory uses for failures recorded without a category.
+const uncategorizedFailure = "uncategorized"
+
+// failuresByCategory tallies the workflow's failed tasks by failure category.
+// Returns nil if no task failed.
+func failuresByCategory(tasks []*repository.TaskAssignment) map[string]int {
+	counts := repository.CountFailuresByCategory(tasks)
+	if len(counts) == 0 {
+		return nil
+	}
+	failures := make(map[string]int, len(counts))
+	for category, n := range counts {
+		key := string(category)
+		if category == "" {
+			key = uncategorizedFailure
+		}
+		failures[key] = n
+	}
+	return failures
+}
+
+// formatFailuresByCategory renders failure counts in category order, e.g. "test_failure 2, timeout 1",
+// with uncategorized failures last.
+func formatFailuresByCategory(failures map[string]int) string {
+	keys := make([]string, 0, len(repository.FailureCategories)+1)
+	for _, category := range repository.FailureCategories {
+		keys = append(keys, string(category))
+	}
+	keys = append(keys, uncategorizedFailure)
+
+	parts := make([]string, 0, len(failures))
+	for _, key := range keys {
+		if n := failures[key]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", key, n))
+		}
+	}
+	return strings.Join(parts, ", ")
 }
 
 // ===========================================================================
@@ -958,7 +2228,7 @@ func (a *V2Adapter) HandleNotifyUser(ctx context.Context, args json.RawMessage)
 	}
 
 	if !result.Success {
-		return mcptypes.ErrorResult(result.Error.Error()), nil
+		return errorResult(result.Error.Error()), nil
 	}
 
 	// Build response message
@@ -967,7 +2237,7 @@ func (a *V2Adapter) HandleNotifyUser(ctx context.Context, args json.RawMessage)
 		msg = fmt.Sprintf("User notified for phase: %s", parsed.Phase)
 	}
 
-	return mcptypes.SuccessResult(msg), nil
+	return messageResult(msg, NotifyUserResult{ToolResult: okResult(), Phase: parsed.Phase, Message: msg}), nil
 }
 
 // notifyUserArgs represents arguments for the notify_user MCP tool.
@@ -1005,6 +2275,49 @@ type processIDExtractor interface {
 	GetProcessID() string
 }
 
+// threadIDExtractor is an interface for types that report a task's Fabric thread.
+type threadIDExtractor interface {
+	GetThreadID() string
+}
+
+// broadcastTargetsExtractor is an interface for types that report broadcast targets.
+type broadcastTargetsExtractor interface {
+	GetTargetWorkers() []string
+}
+
+// retirementRequestExtractor is an interface for types that report repeated retirement requests.
+type retirementRequestExtractor interface {
+	WasAlreadyRequested() bool
+}
+
+// replacementExtractor is an interface for types that report a process replacement.
+type replacementExtractor interface {
+	GetNewProcessID() string
+	GetReassignedTaskID() string
+}
+
+// reviewSkippedExtractor is an interface for types that report a task skipping review.
+type reviewSkippedExtractor interface {
+	IsReviewSkipped() bool
+}
+
+// reviewForcedExtractor is an interface for results that report the changed files
+// that forced review in a workflow that does not otherwise require it.
+type reviewForcedExtractor interface {
+	ReviewForcedBy() []string
+}
+
+// unaddressedFeedbackExtractor is an interface for results that report review feedback
+// items the implementer has not yet marked addressed.
+type unaddressedFeedbackExtractor interface {
+	UnaddressedFeedbackItems() []string
+}
+
+// checkpointExtractor is an interface for results that report a checkpoint commit.
+type checkpointExtractor interface {
+	CheckpointHash() string
+}
+
 // extractProcessID extracts a process ID from command result data.
 // Supports SpawnProcessResult structs and raw string values.
 func extractProcessID(data any) string {
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -12,12 +14,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
 
 // ===========================================================================
@@ -87,20 +92,39 @@ func testAdapter(t *testing.T, opts ...Option) (*V2Adapter, *mockHandler, func()
 		command.CmdRetireProcess,
 		command.CmdReplaceProcess,
 		command.CmdAssignTask,
+		command.CmdAssignTasksBatch,
+		command.CmdGetEligibleWorkers,
 		command.CmdAssignReview,
+		command.CmdTransferTask,
+		command.CmdDrainWorker,
 		command.CmdApproveCommit,
 		command.CmdAssignReviewFeedback,
 		command.CmdSendToProcess,
 		command.CmdBroadcast,
 		command.CmdDeliverProcessQueued,
 		command.CmdReportComplete,
+		command.CmdReportTestResults,
+		command.CmdMarkFeedbackAddressed,
+		command.CmdCheckpoint,
 		command.CmdReportVerdict,
 		command.CmdTransitionPhase,
 		command.CmdMarkTaskComplete,
 		command.CmdMarkTaskFailed,
+		command.CmdAddTaskBlocker,
+		command.CmdSyncTaskStatus,
+		command.CmdCompleteEpicTasks,
+		command.CmdGetCriticalPath,
+		command.CmdGetDependencyGraph,
 		command.CmdStopProcess,
 		command.CmdSignalWorkflowComplete,
 		command.CmdNotifyUser,
+		command.CmdSetGlobalInstruction,
+		command.CmdClearGlobalInstruction,
+		command.CmdSetFocus,
+		command.CmdHoldTask,
+		command.CmdReleaseTask,
+		command.CmdLabelWorker,
+		command.CmdImportState,
 	} {
 		p.RegisterHandler(cmdType, handler)
 	}
@@ -123,6 +147,48 @@ func testAdapter(t *testing.T, opts ...Option) (*V2Adapter, *mockHandler, func()
 	return adapter, handler, cleanup
 }
 
+// processIDResultStub is command result data that reports the process it acted on.
+type processIDResultStub struct {
+	id string
+}
+
+func (r *processIDResultStub) GetProcessID() string { return r.id }
+
+// checkpointResultStub is command result data for a checkpoint.
+type checkpointResultStub struct {
+	hash string
+}
+
+func (r *checkpointResultStub) CheckpointHash() string { return r.hash }
+
+// reviewSkippedResultStub is command result data for a task that skipped review.
+type reviewSkippedResultStub struct{}
+
+func (r *reviewSkippedResultStub) IsReviewSkipped() bool { return true }
+
+// reviewForcedStub is command result data for a task whose sensitive changes forced review.
+type reviewForcedStub struct {
+	paths []string
+}
+
+func (s *reviewForcedStub) ReviewForcedBy() []string { return s.paths }
+
+// unaddressedFeedbackStub is command result data that reports unaddressed feedback items.
+type unaddressedFeedbackStub struct {
+	items []string
+}
+
+func (s *unaddressedFeedbackStub) UnaddressedFeedbackItems() []string { return s.items }
+
+// replacementResultStub is command result data for a replaced worker.
+type replacementResultStub struct {
+	newID  string
+	taskID string
+}
+
+func (r *replacementResultStub) GetNewProcessID() string     { return r.newID }
+func (r *replacementResultStub) GetReassignedTaskID() string { return r.taskID }
+
 // toJSON converts a value to json.RawMessage.
 func toJSON(t *testing.T, v any) json.RawMessage {
 	t.Helper()
@@ -214,6 +280,118 @@ func TestHandleSpawnProcess(t *testing.T) {
 	})
 }
 
+// fakeWorkerCapacity records Acquire/Release calls for spawn gating tests.
+type fakeWorkerCapacity struct {
+	mu         sync.Mutex
+	acquireErr error
+	acquired   int
+	released   int
+}
+
+func (c *fakeWorkerCapacity) Acquire(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.acquireErr != nil {
+		return c.acquireErr
+	}
+	c.acquired++
+	return nil
+}
+
+func (c *fakeWorkerCapacity) Release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.released++
+}
+
+func TestHandleSpawnProcess_WorkerCapacity(t *testing.T) {
+	t.Run("acquires_slot_before_spawning", func(t *testing.T) {
+		capacity := &fakeWorkerCapacity{}
+		adapter, handler, cleanup := testAdapter(t, WithWorkerCapacity(capacity))
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: "worker-1"}
+
+		result, err := adapter.HandleSpawnProcess(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Equal(t, 1, capacity.acquired)
+		assert.Equal(t, 0, capacity.released)
+		require.Len(t, handler.getCommands(), 1)
+	})
+
+	t.Run("pool_full_does_not_spawn", func(t *testing.T) {
+		capacity := &fakeWorkerCapacity{acquireErr: context.DeadlineExceeded}
+		adapter, handler, cleanup := testAdapter(t, WithWorkerCapacity(capacity))
+		defer cleanup()
+
+		result, err := adapter.HandleSpawnProcess(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "worker pool is at capacity")
+		assert.Empty(t, handler.getCommands())
+	})
+
+	t.Run("refused_slot_reports_reason", func(t *testing.T) {
+		capacity := &fakeWorkerCapacity{acquireErr: errors.New("workflow is at its max_workers limit")}
+		adapter, handler, cleanup := testAdapter(t, WithWorkerCapacity(capacity))
+		defer cleanup()
+
+		result, err := adapter.HandleSpawnProcess(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "cannot spawn worker: workflow is at its max_workers limit")
+		assert.Empty(t, handler.getCommands())
+	})
+
+	t.Run("failed_spawn_releases_slot", func(t *testing.T) {
+		capacity := &fakeWorkerCapacity{}
+		adapter, handler, cleanup := testAdapter(t, WithWorkerCapacity(capacity))
+		defer cleanup()
+		handler.returnErr = errors.New("spawn failed")
+
+		result, err := adapter.HandleSpawnProcess(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Equal(t, 1, capacity.acquired)
+		assert.Equal(t, 1, capacity.released)
+	})
+
+	t.Run("retire_leaves_slot_to_handler", func(t *testing.T) {
+		capacity := &fakeWorkerCapacity{}
+		adapter, _, cleanup := testAdapter(t, WithWorkerCapacity(capacity))
+		defer cleanup()
+
+		result, err := adapter.HandleRetireProcess(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1"}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Equal(t, 0, capacity.released, "the retire handler releases the slot")
+	})
+
+	t.Run("drain_leaves_slot_to_handler", func(t *testing.T) {
+		capacity := &fakeWorkerCapacity{}
+		adapter, handler, cleanup := testAdapter(t, WithWorkerCapacity(capacity))
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: drainResultStub{WorkerID: "worker-1"}}
+
+		result, err := adapter.HandleDrainWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1", "reason": "scale down"}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Equal(t, 0, capacity.released, "the retire handler releases the slot")
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		drainCmd, ok := cmds[0].(*command.DrainWorkerCommand)
+		require.True(t, ok, "expected DrainWorkerCommand, got %T", cmds[0])
+		assert.Equal(t, "worker-1", drainCmd.WorkerID)
+		assert.Equal(t, "scale down", drainCmd.Reason)
+	})
+}
+
 func TestHandleRetireProcess(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		adapter, handler, cleanup := testAdapter(t)
@@ -289,6 +467,33 @@ func TestHandleReplaceProcess(t *testing.T) {
 		replaceCmd, ok := cmds[0].(*command.ReplaceProcessCommand)
 		require.True(t, ok)
 		assert.Equal(t, "worker-789", replaceCmd.ProcessID)
+		assert.False(t, replaceCmd.Reassign)
+	})
+
+	t.Run("reassign", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    &replacementResultStub{newID: "worker-790", taskID: "perles-abc1"},
+		}
+
+		args := toJSON(t, map[string]any{"worker_id": "worker-789", "reassign": true})
+
+		result, err := adapter.HandleReplaceProcess(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "replaced by worker-790, which continues task perles-abc1")
+		assert.Equal(t, ReplaceWorkerResult{
+			ToolResult:  ToolResult{OK: true},
+			WorkerID:    "worker-789",
+			NewWorkerID: "worker-790",
+			TaskID:      "perles-abc1",
+			Message:     result.Content[0].Text,
+		}, result.StructuredContent)
+
+		replaceCmd := handler.getCommands()[0].(*command.ReplaceProcessCommand)
+		assert.True(t, replaceCmd.Reassign)
 	})
 
 	t.Run("missing_worker_id", func(t *testing.T) {
@@ -360,6 +565,50 @@ func TestHandleSendToWorker(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "content is required")
 	})
+
+	t.Run("role_scoped_broadcast", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"worker_id": "ALL_REVIEWERS",
+			"message":   "Check error handling",
+		})
+
+		result, err := adapter.HandleSendToWorker(context.Background(), args)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "ALL_REVIEWERS")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		broadcastCmd, ok := cmds[0].(*command.BroadcastCommand)
+		require.True(t, ok)
+		assert.Equal(t, "ALL_REVIEWERS", broadcastCmd.Recipient)
+		assert.Equal(t, "Check error handling", broadcastCmd.Content)
+	})
+
+	t.Run("only_exact_tokens_broadcast", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"worker_id": "ALL_TESTERS",
+			"message":   "test",
+		})
+
+		_, err := adapter.HandleSendToWorker(context.Background(), args)
+		require.NoError(t, err)
+
+		// A worker ID that merely starts with ALL is sent to that worker, not broadcast
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		sendCmd, ok := cmds[0].(*command.SendToProcessCommand)
+		require.True(t, ok, "expected SendToProcessCommand, got %T", cmds[0])
+		assert.Equal(t, "ALL_TESTERS", sendCmd.ProcessID)
+	})
 }
 
 // ===========================================================================
@@ -395,9 +644,10 @@ func TestHandleAssignTask(t *testing.T) {
 		assert.Equal(t, "Implement feature X", assignCmd.Summary)
 	})
 
-	t.Run("missing_worker_id", func(t *testing.T) {
-		adapter, _, cleanup := testAdapter(t)
+	t.Run("missing_worker_id_reports_selected_worker", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
 		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: &processIDResultStub{id: "worker-3"}}
 
 		args := toJSON(t, map[string]string{
 			"task_id": "perles-abc1",
@@ -405,9 +655,29 @@ func TestHandleAssignTask(t *testing.T) {
 
 		result, err := adapter.HandleAssignTask(context.Background(), args)
 
-		require.Error(t, err)
-		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "worker_id is required")
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "assigned to worker worker-3")
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		assert.Empty(t, cmds[0].(*command.AssignTaskCommand).WorkerID, "handler selects the worker")
+	})
+
+	t.Run("estimate_minutes_passed_to_command", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"worker_id":        "worker-123",
+			"task_id":          "perles-abc1",
+			"estimate_minutes": 90,
+		})
+
+		_, err := adapter.HandleAssignTask(context.Background(), args)
+
+		require.NoError(t, err)
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		assert.Equal(t, 90, cmds[0].(*command.AssignTaskCommand).EstimateMinutes)
 	})
 
 	t.Run("missing_task_id", func(t *testing.T) {
@@ -531,9 +801,10 @@ func TestHandleAssignTaskReview(t *testing.T) {
 		assert.Equal(t, command.ReviewTypeComplex, assignCmd.ReviewType)
 	})
 
-	t.Run("missing_reviewer_id", func(t *testing.T) {
-		adapter, _, cleanup := testAdapter(t)
+	t.Run("missing_reviewer_id_reports_selected_reviewer", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
 		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: &processIDResultStub{id: "worker-2"}}
 
 		args := toJSON(t, map[string]string{
 			"task_id":        "perles-xyz9",
@@ -542,9 +813,32 @@ func TestHandleAssignTaskReview(t *testing.T) {
 
 		result, err := adapter.HandleAssignTaskReview(context.Background(), args)
 
-		require.Error(t, err)
-		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "reviewer_id is required")
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "assigned to worker worker-2")
+	})
+
+	t.Run("auto_reviewer_reports_selected_reviewer", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: &processIDResultStub{id: "worker-2"}}
+
+		args := toJSON(t, map[string]string{
+			"reviewer_id":    "auto",
+			"task_id":        "perles-xyz9",
+			"implementer_id": "worker-impl",
+		})
+
+		result, err := adapter.HandleAssignTaskReview(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "assigned to worker worker-2 (selected automatically)")
+		response := result.StructuredContent.(AssignTaskReviewResult)
+		assert.Equal(t, "worker-2", response.ReviewerID)
+		assert.True(t, response.AutoSelected)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		assert.Equal(t, command.AutoReviewer, cmds[0].(*command.AssignReviewCommand).ReviewerID)
 	})
 
 	t.Run("missing_task_id", func(t *testing.T) {
@@ -653,7 +947,30 @@ func TestHandleAssignReviewFeedback(t *testing.T) {
 
 		require.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "feedback is required")
+		assert.Contains(t, err.Error(), "feedback or items is required")
+	})
+
+	t.Run("items_without_feedback", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"implementer_id": "worker-impl",
+			"task_id":        "perles-abc1",
+			"items":          []string{"Handle the nil case", "Add a regression test"},
+		})
+
+		result, err := adapter.HandleAssignReviewFeedback(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "Review feedback (2 items) sent")
+		assert.Equal(t, 2, result.StructuredContent.(AssignReviewFeedbackResult).Items)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		feedbackCmd, ok := cmds[0].(*command.AssignReviewFeedbackCommand)
+		require.True(t, ok)
+		assert.Equal(t, []string{"Handle the nil case", "Add a regression test"}, feedbackCmd.Items)
 	})
 }
 
@@ -683,6 +1000,27 @@ func TestHandleApproveCommit(t *testing.T) {
 		require.True(t, ok)
 		assert.Equal(t, "worker-impl", approveCmd.ImplementerID)
 		assert.Equal(t, "perles-abc1", approveCmd.TaskID)
+		assert.False(t, approveCmd.AllowFailingTests)
+	})
+
+	t.Run("allow_failing_tests", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"implementer_id":      "worker-impl",
+			"task_id":             "perles-abc1",
+			"allow_failing_tests": true,
+		})
+
+		_, err := adapter.HandleApproveCommit(context.Background(), args)
+		require.NoError(t, err)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		approveCmd, ok := cmds[0].(*command.ApproveCommitCommand)
+		require.True(t, ok)
+		assert.True(t, approveCmd.AllowFailingTests)
 	})
 
 	t.Run("missing_implementer_id", func(t *testing.T) {
@@ -743,6 +1081,55 @@ func TestHandleReportImplementationComplete(t *testing.T) {
 		require.True(t, ok)
 		assert.Equal(t, "worker-456", reportCmd.WorkerID)
 		assert.Equal(t, "Implemented the feature successfully", reportCmd.Summary)
+		assert.False(t, result.ReviewSkipped)
+	})
+
+	t.Run("review_skipped", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: &reviewSkippedResultStub{}}
+
+		result, err := adapter.HandleReportImplementationComplete(context.Background(), toJSON(t, map[string]string{}), "worker-456")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.True(t, result.ReviewSkipped)
+		assert.Contains(t, result.Message, "does not require review")
+	})
+
+	t.Run("review_forced_by_sensitive_paths", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    &reviewForcedStub{paths: []string{"auth/session.go"}},
+		}
+
+		result, err := adapter.HandleReportImplementationComplete(context.Background(), toJSON(t, map[string]string{}), "worker-456")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.False(t, result.ReviewSkipped)
+		assert.Equal(t, []string{"auth/session.go"}, result.ReviewForced)
+		assert.Contains(t, result.Message, "review is required")
+		assert.Contains(t, result.Message, "auth/session.go")
+	})
+
+	t.Run("warns_about_unaddressed_feedback", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    &unaddressedFeedbackStub{items: []string{"2. Add a regression test"}},
+		}
+
+		result, err := adapter.HandleReportImplementationComplete(context.Background(), toJSON(t, map[string]string{}), "worker-456")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, []string{"2. Add a regression test"}, result.Unaddressed)
+		assert.Contains(t, result.Message, "WARNING: 1 review feedback item(s) not marked addressed")
+		assert.Contains(t, result.Message, "2. Add a regression test")
 	})
 
 	t.Run("invalid_json", func(t *testing.T) {
@@ -757,50 +1144,173 @@ func TestHandleReportImplementationComplete(t *testing.T) {
 	})
 }
 
-func TestHandleReportReviewVerdict(t *testing.T) {
-	t.Run("approved", func(t *testing.T) {
+func TestHandleCheckpoint(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		adapter, handler, cleanup := testAdapter(t)
 		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: &checkpointResultStub{hash: "abc123"}}
 
-		args := toJSON(t, map[string]string{
-			"verdict":  "APPROVED",
-			"comments": "LGTM",
-		})
-
-		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
+		result, err := adapter.HandleCheckpoint(context.Background(), toJSON(t, map[string]any{"message": "parser done"}), "worker-456")
 
 		require.NoError(t, err)
-		require.NotNil(t, result)
-		assert.True(t, result.Success)
-		assert.Equal(t, "APPROVED", result.Verdict)
-		assert.Equal(t, "LGTM", result.Comments)
-		assert.Contains(t, result.Message, "APPROVED")
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "Checkpoint saved: abc123")
 
-		// Verify command
 		cmds := handler.getCommands()
 		require.Len(t, cmds, 1)
-		reportCmd, ok := cmds[0].(*command.ReportVerdictCommand)
+		checkpointCmd, ok := cmds[0].(*command.CheckpointCommand)
 		require.True(t, ok)
-		assert.Equal(t, "worker-reviewer", reportCmd.WorkerID)
-		assert.Equal(t, command.VerdictApproved, reportCmd.Verdict)
-		assert.Equal(t, "LGTM", reportCmd.Comments)
+		assert.Equal(t, "worker-456", checkpointCmd.WorkerID)
+		assert.Equal(t, "parser done", checkpointCmd.Message)
 	})
 
-	t.Run("denied", func(t *testing.T) {
+	t.Run("nothing_to_commit", func(t *testing.T) {
 		adapter, handler, cleanup := testAdapter(t)
 		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: &checkpointResultStub{}}
 
-		args := toJSON(t, map[string]string{
-			"verdict":  "DENIED",
-			"comments": "Needs more tests",
+		result, err := adapter.HandleCheckpoint(context.Background(), json.RawMessage(`{}`), "worker-456")
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "Nothing to checkpoint")
+	})
+}
+
+func TestHandleReportTestResults(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"passed": 40,
+			"failed": 2,
+			"output": "FAIL TestFoo",
 		})
 
-		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
+		result, err := adapter.HandleReportTestResults(context.Background(), args, "worker-456")
 
 		require.NoError(t, err)
 		require.NotNil(t, result)
-		assert.True(t, result.Success)
-		assert.Equal(t, "DENIED", result.Verdict)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "40 passed, 2 failed")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		reportCmd, ok := cmds[0].(*command.ReportTestResultsCommand)
+		require.True(t, ok)
+		assert.Equal(t, "worker-456", reportCmd.WorkerID)
+		assert.Equal(t, 40, reportCmd.Passed)
+		assert.Equal(t, 2, reportCmd.Failed)
+		assert.Equal(t, "FAIL TestFoo", reportCmd.Output)
+	})
+
+	t.Run("negative_count", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{"passed": 1, "failed": -1})
+
+		result, err := adapter.HandleReportTestResults(context.Background(), args, "worker-456")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed must be non-negative")
+	})
+}
+
+func TestHandleMarkFeedbackAddressed(t *testing.T) {
+	t.Run("reports_remaining_items", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    &unaddressedFeedbackStub{items: []string{"3. Rename the helper"}},
+		}
+
+		result, err := adapter.HandleMarkFeedbackAddressed(context.Background(),
+			toJSON(t, map[string]any{"item_ids": []int{1, 2}}), "worker-456")
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "1 item(s) remaining")
+		assert.Contains(t, result.Content[0].Text, "3. Rename the helper")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		markCmd, ok := cmds[0].(*command.MarkFeedbackAddressedCommand)
+		require.True(t, ok)
+		assert.Equal(t, "worker-456", markCmd.WorkerID)
+		assert.Equal(t, []int{1, 2}, markCmd.ItemIDs)
+	})
+
+	t.Run("all_addressed", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: &unaddressedFeedbackStub{}}
+
+		result, err := adapter.HandleMarkFeedbackAddressed(context.Background(),
+			toJSON(t, map[string]any{"item_ids": []int{1}}), "worker-456")
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "All review feedback items addressed")
+	})
+
+	t.Run("missing_item_ids", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleMarkFeedbackAddressed(context.Background(), toJSON(t, map[string]any{}), "worker-456")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "item_ids is required")
+	})
+}
+
+func TestHandleReportReviewVerdict(t *testing.T) {
+	t.Run("approved", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"verdict":  "APPROVED",
+			"comments": "LGTM",
+		})
+
+		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, result.Success)
+		assert.Equal(t, "APPROVED", result.Verdict)
+		assert.Equal(t, "LGTM", result.Comments)
+		assert.Contains(t, result.Message, "APPROVED")
+
+		// Verify command
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		reportCmd, ok := cmds[0].(*command.ReportVerdictCommand)
+		require.True(t, ok)
+		assert.Equal(t, "worker-reviewer", reportCmd.WorkerID)
+		assert.Equal(t, command.VerdictApproved, reportCmd.Verdict)
+		assert.Equal(t, "LGTM", reportCmd.Comments)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"verdict":  "DENIED",
+			"comments": "Needs more tests",
+		})
+
+		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, result.Success)
+		assert.Equal(t, "DENIED", result.Verdict)
 		assert.Equal(t, "Needs more tests", result.Comments)
 		assert.Contains(t, result.Message, "DENIED")
 
@@ -906,6 +1416,28 @@ func TestHandleMarkTaskComplete(t *testing.T) {
 		markCmd, ok := cmds[0].(*command.MarkTaskCompleteCommand)
 		require.True(t, ok)
 		assert.Equal(t, "perles-abc1", markCmd.TaskID)
+		assert.False(t, markCmd.Force)
+	})
+
+	t.Run("force", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"task_id": "perles-abc1",
+			"force":   true,
+		})
+
+		result, err := adapter.HandleMarkTaskComplete(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		markCmd, ok := cmds[0].(*command.MarkTaskCompleteCommand)
+		require.True(t, ok)
+		assert.True(t, markCmd.Force)
 	})
 
 	t.Run("missing_task_id", func(t *testing.T) {
@@ -964,12 +1496,404 @@ func TestHandleMarkTaskComplete(t *testing.T) {
 			"task_id": "perles-abc1",
 		})
 
-		result, err := adapter.HandleMarkTaskComplete(context.Background(), args)
+		result, err := adapter.HandleMarkTaskComplete(context.Background(), args)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "database error")
+	})
+}
+
+func TestHandleAddTaskBlocker(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"task_id":    "perles-xyz9",
+			"blocker_id": "perles-bug1",
+		})
+
+		result, err := adapter.HandleAddTaskBlocker(context.Background(), args)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "perles-xyz9 is now blocked by perles-bug1")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		blockerCmd, ok := cmds[0].(*command.AddTaskBlockerCommand)
+		require.True(t, ok)
+		assert.Equal(t, "perles-xyz9", blockerCmd.TaskID)
+		assert.Equal(t, "perles-bug1", blockerCmd.BlockerID)
+	})
+
+	t.Run("missing_blocker_id", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"task_id": "perles-xyz9",
+		})
+
+		result, err := adapter.HandleAddTaskBlocker(context.Background(), args)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "blocker_id is required")
+	})
+
+	t.Run("cycle_wrapped_in_result", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnErr = errors.New("dependency would create a cycle: perles-bug1 -> perles-xyz9")
+
+		args := toJSON(t, map[string]string{
+			"task_id":    "perles-xyz9",
+			"blocker_id": "perles-bug1",
+		})
+
+		result, err := adapter.HandleAddTaskBlocker(context.Background(), args)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "cycle")
+	})
+}
+
+// fakeSyncTaskStatusResult reports a status reconciliation like the handler result.
+type fakeSyncTaskStatusResult struct {
+	beadsStatus, previous, status string
+	corrected, orphaned           bool
+}
+
+func (r *fakeSyncTaskStatusResult) GetBeadsStatus() string    { return r.beadsStatus }
+func (r *fakeSyncTaskStatusResult) GetPreviousStatus() string { return r.previous }
+func (r *fakeSyncTaskStatusResult) GetStatus() string         { return r.status }
+func (r *fakeSyncTaskStatusResult) WasCorrected() bool        { return r.corrected }
+func (r *fakeSyncTaskStatusResult) IsOrphaned() bool          { return r.orphaned }
+
+func TestHandleSyncTaskStatus(t *testing.T) {
+	t.Run("reports_correction", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data: &fakeSyncTaskStatusResult{
+				beadsStatus: "closed", previous: "implementing", status: "completed", corrected: true,
+			},
+		}
+
+		result, err := adapter.HandleSyncTaskStatus(context.Background(), toJSON(t, map[string]string{
+			"task_id": "perles-xyz9",
+		}))
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "was implementing but bd says closed; updated to completed")
+		response := result.StructuredContent.(SyncTaskStatusResult)
+		assert.True(t, response.Corrected)
+		assert.Equal(t, "closed", response.BeadsStatus)
+		assert.Equal(t, "completed", response.Status)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		syncCmd, ok := cmds[0].(*command.SyncTaskStatusCommand)
+		require.True(t, ok)
+		assert.Equal(t, "perles-xyz9", syncCmd.TaskID)
+	})
+
+	t.Run("reports_reopened_task_needs_assignment", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data: &fakeSyncTaskStatusResult{
+				beadsStatus: "open", previous: "completed", status: "implementing", corrected: true, orphaned: true,
+			},
+		}
+
+		result, err := adapter.HandleSyncTaskStatus(context.Background(), toJSON(t, map[string]string{
+			"task_id": "perles-xyz9",
+		}))
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "it has no worker, assign it again with assign_task")
+		assert.True(t, result.StructuredContent.(SyncTaskStatusResult).NeedsAssignment)
+	})
+
+	t.Run("already_in_sync", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    &fakeSyncTaskStatusResult{beadsStatus: "in_progress", previous: "implementing", status: "implementing"},
+		}
+
+		result, err := adapter.HandleSyncTaskStatus(context.Background(), toJSON(t, map[string]string{
+			"task_id": "perles-xyz9",
+		}))
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Content[0].Text, "in sync with bd")
+		assert.False(t, result.StructuredContent.(SyncTaskStatusResult).Corrected)
+	})
+
+	t.Run("invalid_task_id", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleSyncTaskStatus(context.Background(), toJSON(t, map[string]string{
+			"task_id": "not a task",
+		}))
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "invalid task_id format")
+	})
+}
+
+// fakeEpicTasksResult reports closed and skipped subtasks like the handler result.
+type fakeEpicTasksResult struct {
+	closed, skipped []string
+}
+
+func (r *fakeEpicTasksResult) ClosedTaskIDs() []string  { return r.closed }
+func (r *fakeEpicTasksResult) SkippedTaskIDs() []string { return r.skipped }
+
+func TestHandleCompleteEpicTasks(t *testing.T) {
+	t.Run("reports_closed_and_skipped", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data: &fakeEpicTasksResult{
+				closed:  []string{"perles-epic1.1", "perles-epic1.2"},
+				skipped: []string{"perles-epic1.3"},
+			},
+		}
+
+		result, err := adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "perles-epic1",
+		}))
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		response := result.StructuredContent.(CompleteEpicTasksResult)
+		assert.Equal(t, "perles-epic1", response.EpicID)
+		assert.Equal(t, 2, response.ClosedCount)
+		assert.Equal(t, []string{"perles-epic1.3"}, response.Skipped)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		epicCmd, ok := cmds[0].(*command.CompleteEpicTasksCommand)
+		require.True(t, ok)
+		assert.Equal(t, "perles-epic1", epicCmd.EpicID)
+	})
+
+	t.Run("invalid_epic_id", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "not an epic",
+		}))
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "invalid epic_id format")
+	})
+}
+
+type fakeCriticalPathResult struct {
+	taskIDs   []string
+	length    int
+	estimated bool
+	count     int
+}
+
+func (r *fakeCriticalPathResult) CriticalPathTaskIDs() []string { return r.taskIDs }
+func (r *fakeCriticalPathResult) CriticalPathLength() int       { return r.length }
+func (r *fakeCriticalPathResult) UsedEstimates() bool           { return r.estimated }
+func (r *fakeCriticalPathResult) ConsideredTaskCount() int      { return r.count }
+
+func TestHandleGetCriticalPath(t *testing.T) {
+	t.Run("reports_path_with_estimates", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data: &fakeCriticalPathResult{
+				taskIDs:   []string{"perles-epic1.1", "perles-epic1.3"},
+				length:    150,
+				estimated: true,
+				count:     4,
+			},
+		}
+
+		result, err := adapter.HandleGetCriticalPath(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "perles-epic1",
+		}))
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		response := result.StructuredContent.(GetCriticalPathResult)
+		assert.Equal(t, "perles-epic1", response.EpicID)
+		assert.Equal(t, []string{"perles-epic1.1", "perles-epic1.3"}, response.TaskIDs)
+		assert.Equal(t, 150, response.Length)
+		assert.Equal(t, "estimated_minutes", response.Weighting)
+		assert.Equal(t, 4, response.TasksConsidered)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		pathCmd, ok := cmds[0].(*command.GetCriticalPathCommand)
+		require.True(t, ok)
+		assert.Equal(t, "perles-epic1", pathCmd.EpicID)
+	})
+
+	t.Run("unit_weights", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    &fakeCriticalPathResult{taskIDs: []string{"perles-epic1.1"}, length: 1, count: 1},
+		}
+
+		result, err := adapter.HandleGetCriticalPath(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "perles-epic1",
+		}))
+
+		require.NoError(t, err)
+		response := result.StructuredContent.(GetCriticalPathResult)
+		assert.Equal(t, "task_count", response.Weighting)
+	})
+
+	t.Run("cycle_error", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: false,
+			Error:   errors.New("dependency cycle among issues: perles-epic1.1, perles-epic1.2"),
+		}
+
+		result, err := adapter.HandleGetCriticalPath(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "perles-epic1",
+		}))
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "perles-epic1.1, perles-epic1.2")
+	})
+
+	t.Run("invalid_epic_id", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleGetCriticalPath(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "not an epic",
+		}))
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "invalid epic_id format")
+	})
+}
+
+type fakeDependencyGraphResult struct {
+	graph beads.DependencyGraph
+}
+
+func (r *fakeDependencyGraphResult) DependencyGraph() beads.DependencyGraph { return r.graph }
+
+func TestHandleGetDependencyGraph(t *testing.T) {
+	t.Run("reports_nodes_and_edges", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data: &fakeDependencyGraphResult{graph: beads.DependencyGraph{
+				Nodes: []beads.DependencyNode{
+					{ID: "perles-epic1", Title: "Epic", Status: beads.StatusOpen},
+					{ID: "perles-epic1.1", Title: "Schema", Status: beads.StatusClosed, InCycle: true},
+					{ID: "perles-epic1.2", Title: "API", Status: beads.StatusOpen, InCycle: true},
+				},
+				Edges: []beads.DependencyEdge{
+					{From: "perles-epic1.1", To: "perles-epic1", Kind: beads.EdgeParent},
+					{From: "perles-epic1.1", To: "perles-epic1.2", Kind: beads.EdgeBlockedBy, InCycle: true},
+					{From: "perles-epic1.2", To: "perles-epic1.1", Kind: beads.EdgeBlockedBy, InCycle: true},
+				},
+			}},
+		}
+
+		result, err := adapter.HandleGetDependencyGraph(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "perles-epic1",
+		}))
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		var response GetDependencyGraphResult
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+		assert.Equal(t, "perles-epic1", response.EpicID)
+		assert.True(t, response.HasCycle)
+		assert.Equal(t, []dependencyGraphNode{
+			{ID: "perles-epic1", Title: "Epic", Status: "open"},
+			{ID: "perles-epic1.1", Title: "Schema", Status: "closed", InCycle: true},
+			{ID: "perles-epic1.2", Title: "API", Status: "open", InCycle: true},
+		}, response.Nodes)
+		assert.Equal(t, []dependencyGraphEdge{
+			{From: "perles-epic1.1", To: "perles-epic1", Type: "parent"},
+			{From: "perles-epic1.1", To: "perles-epic1.2", Type: "blocked_by", InCycle: true},
+			{From: "perles-epic1.2", To: "perles-epic1.1", Type: "blocked_by", InCycle: true},
+		}, response.Edges)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		graphCmd, ok := cmds[0].(*command.GetDependencyGraphCommand)
+		require.True(t, ok)
+		assert.Equal(t, "perles-epic1", graphCmd.EpicID)
+	})
+
+	t.Run("command_error", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: false,
+			Error:   errors.New("epic not found: perles-epic1"),
+		}
+
+		result, err := adapter.HandleGetDependencyGraph(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "perles-epic1",
+		}))
 
 		require.NoError(t, err)
-		require.NotNil(t, result)
-		assert.True(t, result.IsError)
-		assert.Contains(t, result.Content[0].Text, "database error")
+		require.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "epic not found")
+	})
+
+	t.Run("invalid_epic_id", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleGetDependencyGraph(context.Background(), toJSON(t, map[string]string{
+			"epic_id": "not an epic",
+		}))
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "invalid epic_id format")
 	})
 }
 
@@ -1001,6 +1925,44 @@ func TestHandleMarkTaskFailed(t *testing.T) {
 		assert.Equal(t, "Tests failed", markCmd.Reason)
 	})
 
+	t.Run("with_category", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"task_id":  "perles-xyz9",
+			"reason":   "Tests failed",
+			"category": "test_failure",
+		})
+
+		result, err := adapter.HandleMarkTaskFailed(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "test_failure")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		markCmd := cmds[0].(*command.MarkTaskFailedCommand)
+		assert.Equal(t, repository.FailureTestFailure, markCmd.Category)
+	})
+
+	t.Run("unknown_category", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"task_id":  "perles-xyz9",
+			"reason":   "Tests failed",
+			"category": "flaky",
+		})
+
+		_, err := adapter.HandleMarkTaskFailed(context.Background(), args)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be one of")
+	})
+
 	t.Run("missing_task_id", func(t *testing.T) {
 		adapter, _, cleanup := testAdapter(t)
 		defer cleanup()
@@ -1613,6 +2575,75 @@ func TestHandleQueryWorkerState_ReturnsReadyWorkers(t *testing.T) {
 	})
 }
 
+func TestHandleQueryWorkerState_DeterministicOrdering(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Enough entries that map iteration order would vary between calls
+	for i := 20; i >= 1; i-- {
+		id := fmt.Sprintf("worker-%02d", i)
+		proc := &repository.Process{
+			ID:        id,
+			Role:      repository.RoleWorker,
+			Status:    repository.StatusReady,
+			Phase:     ptr(events.ProcessPhaseIdle),
+			CreatedAt: createdAt,
+		}
+		switch {
+		case i%5 == 0:
+			proc.Status = repository.StatusRetired
+		case i%7 == 0:
+			proc.Status = repository.StatusFailed
+		case i%3 == 0:
+			taskID := fmt.Sprintf("perles-abc%d.1", i)
+			proc.Status = repository.StatusWorking
+			proc.Phase = ptr(events.ProcessPhaseImplementing)
+			proc.TaskID = taskID
+			require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+				TaskID:      taskID,
+				Implementer: id,
+				Status:      repository.TaskImplementing,
+				StartedAt:   createdAt,
+			}))
+		}
+		require.NoError(t, processRepo.Save(proc))
+	}
+
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(processRepo),
+		WithTaskRepository(taskRepo),
+	)
+	defer cleanup()
+
+	first, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+	require.NoError(t, err)
+	second, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+	require.NoError(t, err)
+
+	require.Equal(t, first.Content[0].Text, second.Content[0].Text, "consecutive calls should be byte-identical")
+
+	var response struct {
+		Workers []struct {
+			WorkerID string `json:"worker_id"`
+		} `json:"workers"`
+		ReadyWorkers   []string `json:"ready_workers"`
+		RetiredWorkers []string `json:"retired_workers"`
+		FailedWorkers  []string `json:"failed_workers"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(first.Content[0].Text), &response))
+
+	workerIDs := make([]string, 0, len(response.Workers))
+	for _, w := range response.Workers {
+		workerIDs = append(workerIDs, w.WorkerID)
+	}
+	require.Len(t, workerIDs, 14)
+	require.True(t, sort.StringsAreSorted(workerIDs), "workers should be sorted by ID: %v", workerIDs)
+	require.True(t, sort.StringsAreSorted(response.ReadyWorkers))
+	require.Equal(t, []string{"worker-05", "worker-10", "worker-15", "worker-20"}, response.RetiredWorkers)
+	require.Equal(t, []string{"worker-07", "worker-14"}, response.FailedWorkers)
+}
+
 func TestHandleQueryWorkerState_MatchesCoordinatorFormat(t *testing.T) {
 	// Verify response format includes all required fields for comprehensive worker state queries
 	processRepo := repository.NewMemoryProcessRepository()
@@ -1724,6 +2755,56 @@ func TestHandleQueryWorkerState_IncludesTaskAssignmentDetails(t *testing.T) {
 	assert.Equal(t, taskStarted.Format("2006-01-02T15:04:05Z07:00"), w["task_started"]) // task started timestamp
 }
 
+func TestHandleQueryWorkerState_IncludesTestResults(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     ptr(events.ProcessPhaseImplementing),
+		TaskID:    "task-123",
+		CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "task-123",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+		TestResults: &repository.TestResults{Passed: 9, Failed: 1, Output: "FAIL TestBar", ReportedAt: time.Now()},
+	})
+
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(processRepo),
+		WithTaskRepository(taskRepo),
+	)
+	defer cleanup()
+
+	result, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var response struct {
+		Workers []struct {
+			TestResults *testResultsInfo `json:"test_results"`
+		} `json:"workers"`
+		Tasks map[string]struct {
+			TestResults *testResultsInfo `json:"test_results"`
+		} `json:"tasks"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+
+	require.Len(t, response.Workers, 1)
+	require.NotNil(t, response.Workers[0].TestResults)
+	assert.Equal(t, 9, response.Workers[0].TestResults.Passed)
+	assert.Equal(t, 1, response.Workers[0].TestResults.Failed)
+	assert.Equal(t, "FAIL TestBar", response.Workers[0].TestResults.Output)
+	assert.NotEmpty(t, response.Workers[0].TestResults.ReportedAt)
+
+	require.NotNil(t, response.Tasks["task-123"].TestResults)
+	assert.Equal(t, 1, response.Tasks["task-123"].TestResults.Failed)
+}
+
 func TestHandleQueryWorkerState_IncludesRetiredAt(t *testing.T) {
 	// Verify that retired_at is included when worker is retired
 	processRepo := repository.NewMemoryProcessRepository()
@@ -1767,6 +2848,38 @@ func TestHandleQueryWorkerState_IncludesRetiredAt(t *testing.T) {
 	}
 }
 
+func TestHandleQueryWorkerState_IncludesTokenUsage(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     ptr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+		Usage:     metrics.WorkerUsage{InputTokens: 45000, OutputTokens: 3200, Turns: 3},
+	})
+
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(processRepo),
+	)
+	defer cleanup()
+
+	result, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var response struct {
+		Workers []map[string]any `json:"workers"`
+	}
+	err = json.Unmarshal([]byte(result.Content[0].Text), &response)
+	require.NoError(t, err)
+	require.Len(t, response.Workers, 1)
+
+	w := response.Workers[0]
+	assert.Equal(t, float64(45000), w["input_tokens"])
+	assert.Equal(t, float64(3200), w["output_tokens"])
+}
+
 func TestHandleQueryWorkerState_IncludesQueueSize(t *testing.T) {
 	// Verify that queue_size is populated from queue repository
 	processRepo := repository.NewMemoryProcessRepository()
@@ -2417,6 +3530,36 @@ func TestHandleSignalWorkflowComplete(t *testing.T) {
 		assert.Equal(t, 3, workflowCmd.TasksClosed)
 	})
 
+	t.Run("reports_failures_by_category", func(t *testing.T) {
+		taskRepo := repository.NewMemoryTaskRepository()
+		for _, task := range []*repository.TaskAssignment{
+			{TaskID: "perles-abc.1", Status: repository.TaskFailed, FailureCategory: repository.FailureTimeout},
+			{TaskID: "perles-abc.2", Status: repository.TaskFailed, FailureCategory: repository.FailureTestFailure},
+			{TaskID: "perles-abc.3", Status: repository.TaskFailed, FailureCategory: repository.FailureTestFailure},
+			{TaskID: "perles-abc.4", Status: repository.TaskFailed},
+			{TaskID: "perles-abc.5", Status: repository.TaskCompleted},
+		} {
+			require.NoError(t, taskRepo.Save(task))
+		}
+
+		adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo))
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"status":  "partial",
+			"summary": "Some tasks failed",
+		})
+
+		result, err := adapter.HandleSignalWorkflowComplete(context.Background(), args)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "failed tasks: test_failure 2, timeout 1, uncategorized 1")
+		response, ok := result.StructuredContent.(SignalWorkflowCompleteResult)
+		require.True(t, ok)
+		assert.Equal(t, map[string]int{"test_failure": 2, "timeout": 1, "uncategorized": 1}, response.FailuresByCategory)
+	})
+
 	t.Run("success_aborted_status", func(t *testing.T) {
 		adapter, handler, cleanup := testAdapter(t)
 		defer cleanup()
@@ -2733,3 +3876,313 @@ func TestHandleNotifyUser(t *testing.T) {
 		assert.Contains(t, result.Content[0].Text, "notification failed")
 	})
 }
+
+// ===========================================================================
+// list_orphaned_tasks Tests
+// ===========================================================================
+
+func TestHandleListOrphanedTasks(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	for _, p := range []*repository.Process{
+		{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "task-ok"},
+		{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusRetired},
+		{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusFailed},
+		{ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "task-reviewer-retired"},
+		{ID: "worker-5", Role: repository.RoleWorker, Status: repository.StatusRetiring},
+	} {
+		require.NoError(t, processRepo.Save(p))
+	}
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	for _, task := range []*repository.TaskAssignment{
+		{TaskID: "task-ok", Implementer: "worker-1", Status: repository.TaskImplementing},
+		{TaskID: "task-implementer-retired", Implementer: "worker-2", Status: repository.TaskImplementing},
+		{TaskID: "task-implementer-failed", Implementer: "worker-3", Status: repository.TaskCommitting},
+		{TaskID: "task-implementer-missing", Implementer: "worker-99", Status: repository.TaskDenied},
+		{TaskID: "task-reviewer-retired", Implementer: "worker-4", Reviewer: "worker-5", Status: repository.TaskInReview},
+		// A task reopened in bd has no implementer until it is assigned again
+		{TaskID: "task-reopened", Status: repository.TaskImplementing},
+		// Finished tasks are never orphaned
+		{TaskID: "task-done", Implementer: "worker-2", Status: repository.TaskCompleted},
+		// A retired reviewer only matters while the task is in review
+		{TaskID: "task-review-finished", Implementer: "worker-1", Reviewer: "worker-2", Status: repository.TaskApproved},
+	} {
+		require.NoError(t, taskRepo.Save(task))
+	}
+
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(processRepo),
+		WithTaskRepository(taskRepo),
+	)
+	defer cleanup()
+
+	result, err := adapter.HandleListOrphanedTasks(context.Background(), nil)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response ListOrphanedTasksResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+
+	require.Equal(t, []OrphanedTask{
+		{TaskID: "task-implementer-failed", Status: "committing", Implementer: "worker-3", Reason: orphanReasonImplementerFailed},
+		{TaskID: "task-implementer-missing", Status: "denied", Implementer: "worker-99", Reason: orphanReasonImplementerMissing},
+		{TaskID: "task-implementer-retired", Status: "implementing", Implementer: "worker-2", Reason: orphanReasonImplementerRetired},
+		{TaskID: "task-reopened", Status: "implementing", Reason: orphanReasonUnassigned},
+		{TaskID: "task-reviewer-retired", Status: "in_review", Implementer: "worker-4", Reviewer: "worker-5", Reason: orphanReasonReviewerRetired},
+	}, response.OrphanedTasks)
+}
+
+func TestHandleListOrphanedTasks_NoOrphansReturnsEmptyList(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(repository.NewMemoryProcessRepository()),
+		WithTaskRepository(repository.NewMemoryTaskRepository()),
+	)
+	defer cleanup()
+
+	result, err := adapter.HandleListOrphanedTasks(context.Background(), nil)
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, `"orphaned_tasks": []`)
+}
+
+func TestHandleListOrphanedTasks_RequiresRepositories(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleListOrphanedTasks(context.Background(), nil)
+	require.ErrorContains(t, err, "not configured")
+}
+
+func TestCheckStuckWorkers(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	// Never completed a turn - measured from spawn
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		CreatedAt: now.Add(-30 * time.Minute),
+	})
+	_ = processRepo.Save(&repository.Process{
+		ID:             "worker-1",
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusWorking,
+		TaskID:         "perles-abc.1",
+		LastActivityAt: now.Add(-11 * time.Minute),
+	})
+	// Ready workers are never stuck, however long they idle
+	_ = processRepo.Save(&repository.Process{
+		ID:             "worker-3",
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusReady,
+		LastActivityAt: now.Add(-time.Hour),
+	})
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo))
+	defer cleanup()
+
+	require.Equal(t, []StuckWorker{
+		{WorkerID: "worker-1", TaskID: "perles-abc.1", Idle: 11 * time.Minute},
+		{WorkerID: "worker-2", Idle: 30 * time.Minute},
+	}, adapter.CheckStuckWorkers(now, 10*time.Minute))
+	require.Empty(t, adapter.CheckStuckWorkers(now, time.Hour))
+}
+
+func TestCheckStuckWorkers_FakeClock(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	processRepo := repository.NewMemoryProcessRepository()
+	_ = processRepo.Save(&repository.Process{
+		ID:             "worker-1",
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusWorking,
+		TaskID:         "perles-abc.1",
+		LastActivityAt: clock.Now(),
+	})
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo), WithClock(clock))
+	defer cleanup()
+
+	require.Empty(t, adapter.CheckStuckWorkers(adapter.Now(), 10*time.Minute))
+
+	clock.Advance(10*time.Minute + time.Second)
+	require.Equal(t, []StuckWorker{
+		{WorkerID: "worker-1", TaskID: "perles-abc.1", Idle: 10*time.Minute + time.Second},
+	}, adapter.CheckStuckWorkers(adapter.Now(), 10*time.Minute))
+}
+
+func TestCheckStuckWorkers_TaskEstimateRaisesThreshold(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	// Both workers have been working for 40 minutes on their tasks
+	for _, w := range []struct{ workerID, taskID string }{
+		{"worker-1", "perles-abc.1"},
+		{"worker-2", "perles-abc.2"},
+	} {
+		_ = processRepo.Save(&repository.Process{
+			ID:             w.workerID,
+			Role:           repository.RoleWorker,
+			Status:         repository.StatusWorking,
+			TaskID:         w.taskID,
+			LastActivityAt: now.Add(-40 * time.Minute),
+		})
+	}
+	// A 2 hour task may run up to 3 hours before it is stuck
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.1", Implementer: "worker-1", EstimateMinutes: 120})
+	// A 10 minute estimate falls back to the flat timeout
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.2", Implementer: "worker-2", EstimateMinutes: 10})
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+	defer cleanup()
+
+	require.Equal(t, []StuckWorker{
+		{WorkerID: "worker-2", TaskID: "perles-abc.2", Idle: 40 * time.Minute},
+	}, adapter.CheckStuckWorkers(now, 30*time.Minute))
+
+	now = now.Add(3 * time.Hour)
+	stuck := adapter.CheckStuckWorkers(now, 30*time.Minute)
+	require.Len(t, stuck, 2, "the estimate only extends the threshold, it does not disable detection")
+}
+
+func TestCheckUnreadyWorkers(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	// Spawned 10 minutes ago and still running its first turn
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		CreatedAt: now.Add(-10 * time.Minute),
+	})
+	// Spawned 6 minutes ago and never started
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusPending,
+		CreatedAt: now.Add(-6 * time.Minute),
+	})
+	// Signaled ready long ago
+	_ = processRepo.Save(&repository.Process{
+		ID:               "worker-3",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+		CreatedAt:        now.Add(-time.Hour),
+	})
+	// Spawned recently
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-4",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		CreatedAt: now.Add(-time.Minute),
+	})
+	// Paused by the user before it became ready
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-5",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusPaused,
+		CreatedAt: now.Add(-time.Hour),
+	})
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo))
+	defer cleanup()
+
+	require.Equal(t, []UnreadyWorker{
+		{WorkerID: "worker-1", Waiting: 6 * time.Minute},
+		{WorkerID: "worker-2", Waiting: 10 * time.Minute},
+	}, adapter.CheckUnreadyWorkers(now, 5*time.Minute))
+	require.Empty(t, adapter.CheckUnreadyWorkers(now, time.Hour))
+}
+
+func TestUnassignedWorkers(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	idlePhase := events.ProcessPhaseIdle
+	reviewing := events.ProcessPhaseReviewing
+	processRepo := repository.NewMemoryProcessRepository()
+	// Ready and waiting for work
+	_ = processRepo.Save(&repository.Process{
+		ID:               "worker-2",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusReady,
+		Phase:            &idlePhase,
+		HasCompletedTurn: true,
+		LastActivityAt:   now.Add(-10 * time.Minute),
+	})
+	// Ready with no phase recorded yet
+	_ = processRepo.Save(&repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusReady,
+		HasCompletedTurn: true,
+		LastActivityAt:   now.Add(-time.Minute),
+	})
+	// Ready between turns of an assigned task
+	_ = processRepo.Save(&repository.Process{
+		ID:               "worker-3",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusReady,
+		Phase:            &reviewing,
+		TaskID:           "perles-abc.1",
+		HasCompletedTurn: true,
+		LastActivityAt:   now.Add(-time.Hour),
+	})
+	// Running a turn
+	_ = processRepo.Save(&repository.Process{
+		ID:               "worker-4",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+		LastActivityAt:   now.Add(-time.Hour),
+	})
+	// Never signaled ready
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-5",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		CreatedAt: now.Add(-time.Hour),
+	})
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo))
+	defer cleanup()
+
+	require.Equal(t, []UnassignedWorker{
+		{WorkerID: "worker-1", Ready: true, Idle: time.Minute},
+		{WorkerID: "worker-2", Ready: true, Idle: 10 * time.Minute},
+		{WorkerID: "worker-4", Ready: false, Idle: time.Hour},
+	}, adapter.UnassignedWorkers(now))
+}
+
+func TestDetectOrphanedTasks_NoRepositories(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	require.Empty(t, adapter.DetectOrphanedTasks())
+	require.Empty(t, adapter.CheckStuckWorkers(time.Now(), time.Minute))
+	require.Empty(t, adapter.CheckUnreadyWorkers(time.Now(), time.Minute))
+}
+
+func TestV2Adapter_TaskIDPrefix_RejectsOtherWorkflowsTasks(t *testing.T) {
+	adapter := NewV2Adapter(nil)
+	adapter.SetTaskIDPrefix("feat1")
+
+	tests := []struct {
+		tool   string
+		handle func(context.Context, json.RawMessage) (*mcptypes.ToolCallResult, error)
+		args   string
+	}{
+		{"assign_task", adapter.HandleAssignTask, `{"worker_id": "worker-1", "task_id": "feat2-abc.1"}`},
+		{"assign_tasks_batch", adapter.HandleAssignTasksBatch, `{"assignments": [{"worker_id": "worker-1", "task_id": "feat1-abc.1"}, {"worker_id": "worker-2", "task_id": "feat2-abc.2"}]}`},
+		{"mark_task_complete", adapter.HandleMarkTaskComplete, `{"task_id": "feat2-abc.1"}`},
+		{"mark_task_failed", adapter.HandleMarkTaskFailed, `{"task_id": "feat2-abc.1", "reason": "broken"}`},
+		{"complete_epic_tasks", adapter.HandleCompleteEpicTasks, `{"epic_id": "feat2-abc"}`},
+		{"sync_task_status", adapter.HandleSyncTaskStatus, `{"task_id": "feat2-abc.1"}`},
+		{"add_task_blocker", adapter.HandleAddTaskBlocker, `{"task_id": "feat2-abc.1", "blocker_id": "feat1-abc.2"}`},
+		{"transfer_task", adapter.HandleTransferTask, `{"task_id": "feat10-abc.1", "from_worker": "worker-1", "to_worker": "worker-2"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tool, func(t *testing.T) {
+			_, err := tt.handle(context.Background(), json.RawMessage(tt.args))
+			require.ErrorContains(t, err, tt.tool)
+			require.ErrorContains(t, err, `outside this workflow's prefix "feat1"`)
+		})
+	}
+}
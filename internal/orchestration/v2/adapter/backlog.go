@@ -0,0 +1,194 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// MessageInbox exposes each agent's unread Fabric messages. *fabric.Service satisfies it.
+type MessageInbox interface {
+	// GetUnacked returns the agent's unread messages, grouped by channel ID.
+	GetUnacked(agentID string) (map[string]fabricrepo.UnackedSummary, error)
+	// Ack marks messages as read by the agent.
+	Ack(agentID string, messageIDs ...string) error
+	// GetChannelSlug returns the slug (e.g. "tasks") of a channel ID.
+	GetChannelSlug(channelID string) string
+}
+
+// WithMessageInbox sets the inbox read by get_worker_backlog and cleared by
+// clear_worker_backlog. When nil, both tools return an error.
+func WithMessageInbox(inbox MessageInbox) Option {
+	return func(a *V2Adapter) {
+		a.inbox = inbox
+	}
+}
+
+// getWorkerBacklogArgs holds arguments for get_worker_backlog tool.
+type getWorkerBacklogArgs struct {
+	WorkerID string `json:"worker_id,omitempty"`
+}
+
+// workerBacklog is one worker's unread message count.
+type workerBacklog struct {
+	WorkerID string `json:"worker_id"`
+	Unread   int    `json:"unread"`
+	// Channels maps channel slugs to unread counts, omitting channels with none.
+	Channels map[string]int `json:"channels,omitempty"`
+}
+
+// GetWorkerBacklogResult is the result of the get_worker_backlog tool.
+type GetWorkerBacklogResult struct {
+	ToolResult
+	Workers     []workerBacklog `json:"workers"`
+	TotalUnread int             `json:"total_unread"`
+}
+
+// HandleGetWorkerBacklog handles the get_worker_backlog MCP tool call.
+// It reports how many messages each active worker has not yet read, so the coordinator
+// can spot a worker that has fallen behind on its inbox. With worker_id set, only that
+// worker is reported, whatever its status.
+//
+// This is a read-only operation that bypasses the command processor.
+func (a *V2Adapter) HandleGetWorkerBacklog(_ context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil {
+		return nil, fmt.Errorf("process repository not configured for read-only operations")
+	}
+	if a.inbox == nil {
+		return nil, fmt.Errorf("message inbox not configured")
+	}
+
+	var parsed getWorkerBacklogArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	var workerIDs []string
+	if parsed.WorkerID != "" {
+		if res := a.lookupWorker(parsed.WorkerID); res != nil {
+			return res, nil
+		}
+		workerIDs = []string{parsed.WorkerID}
+	} else {
+		for _, w := range a.processRepo.ActiveWorkers() {
+			workerIDs = append(workerIDs, w.ID)
+		}
+		sort.Strings(workerIDs)
+	}
+
+	response := GetWorkerBacklogResult{
+		ToolResult: okResult(),
+		Workers:    make([]workerBacklog, 0, len(workerIDs)),
+	}
+	for _, id := range workerIDs {
+		unacked, err := a.inbox.GetUnacked(id)
+		if err != nil {
+			return errorResult(fmt.Sprintf("failed to read inbox of %s: %v", id, err)), nil
+		}
+		backlog := workerBacklog{WorkerID: id}
+		for channelID, summary := range unacked {
+			if summary.Count == 0 {
+				continue
+			}
+			if backlog.Channels == nil {
+				backlog.Channels = make(map[string]int)
+			}
+			backlog.Channels[a.channelName(channelID)] += summary.Count
+			backlog.Unread += summary.Count
+		}
+		response.TotalUnread += backlog.Unread
+		response.Workers = append(response.Workers, backlog)
+	}
+
+	return jsonResult(response)
+}
+
+// clearWorkerBacklogArgs holds arguments for clear_worker_backlog tool.
+type clearWorkerBacklogArgs struct {
+	WorkerID string `json:"worker_id"`
+}
+
+// ClearWorkerBacklogResult is the result of the clear_worker_backlog tool.
+type ClearWorkerBacklogResult struct {
+	ToolResult
+	WorkerID string `json:"worker_id"`
+	Cleared  int    `json:"cleared"`
+	Message  string `json:"message"`
+}
+
+// HandleClearWorkerBacklog handles the clear_worker_backlog MCP tool call.
+// It marks all of a worker's unread messages as read on its behalf, e.g. before replacing
+// a worker whose backlog the replacement should not inherit. Messages stay unread for
+// every other agent.
+func (a *V2Adapter) HandleClearWorkerBacklog(_ context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil {
+		return nil, fmt.Errorf("process repository not configured for read-only operations")
+	}
+	if a.inbox == nil {
+		return nil, fmt.Errorf("message inbox not configured")
+	}
+
+	var parsed clearWorkerBacklogArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if parsed.WorkerID == "" {
+		return nil, fmt.Errorf("worker_id is required")
+	}
+	if res := a.lookupWorker(parsed.WorkerID); res != nil {
+		return res, nil
+	}
+
+	unacked, err := a.inbox.GetUnacked(parsed.WorkerID)
+	if err != nil {
+		return errorResult(fmt.Sprintf("failed to read inbox of %s: %v", parsed.WorkerID, err)), nil
+	}
+	var messageIDs []string
+	for _, summary := range unacked {
+		messageIDs = append(messageIDs, summary.ThreadIDs...)
+	}
+	if len(messageIDs) > 0 {
+		slices.Sort(messageIDs)
+		if err := a.inbox.Ack(parsed.WorkerID, messageIDs...); err != nil {
+			return errorResult(fmt.Sprintf("failed to mark messages read for %s: %v", parsed.WorkerID, err)), nil
+		}
+	}
+
+	msg := fmt.Sprintf("Marked %d message(s) read for %s", len(messageIDs), parsed.WorkerID)
+	return messageResult(msg, ClearWorkerBacklogResult{
+		ToolResult: okResult(),
+		WorkerID:   parsed.WorkerID,
+		Cleared:    len(messageIDs),
+		Message:    msg,
+	}), nil
+}
+
+// lookupWorker returns a tool error if workerID is unknown or not a worker, nil otherwise.
+func (a *V2Adapter) lookupWorker(workerID string) *mcptypes.ToolCallResult {
+	proc, err := a.processRepo.Get(workerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return errorResult(fmt.Sprintf("worker not found: %s", workerID))
+		}
+		return errorResult(fmt.Sprintf("failed to get worker: %v", err))
+	}
+	if !proc.IsWorker() {
+		return errorResult(fmt.Sprintf("%s is not a worker", workerID))
+	}
+	return nil
+}
+
+// channelName returns the channel's slug, or its ID if the slug is unknown.
+func (a *V2Adapter) channelName(channelID string) string {
+	if slug := a.inbox.GetChannelSlug(channelID); slug != "" {
+		return slug
+	}
+	return channelID
+}
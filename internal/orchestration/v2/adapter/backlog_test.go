@@ -0,0 +1,151 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// newBacklogTestFabric creates a fabric service with its session channels initialized.
+func newBacklogTestFabric(t *testing.T) *fabric.Service {
+	t.Helper()
+
+	threads := fabricrepo.NewMemoryThreadRepository()
+	deps := fabricrepo.NewMemoryDependencyRepository()
+	subs := fabricrepo.NewMemorySubscriptionRepository()
+	acks := fabricrepo.NewMemoryAckRepository(deps, threads, subs)
+	participants := fabricrepo.NewMemoryParticipantRepository()
+
+	svc := fabric.NewService(threads, deps, subs, acks, participants)
+	require.NoError(t, svc.InitSession("coordinator"))
+	return svc
+}
+
+// newBacklogTestAdapter creates an adapter with two ready workers and the given inbox.
+func newBacklogTestAdapter(t *testing.T, inbox MessageInbox) (*V2Adapter, func()) {
+	t.Helper()
+
+	processRepo := repository.NewMemoryProcessRepository()
+	for _, id := range []string{"worker-2", "worker-1"} {
+		require.NoError(t, processRepo.Save(&repository.Process{
+			ID:        id,
+			Role:      repository.RoleWorker,
+			Status:    repository.StatusReady,
+			Phase:     ptr(events.ProcessPhaseIdle),
+			CreatedAt: time.Now(),
+		}))
+	}
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo), WithMessageInbox(inbox))
+	return adapter, cleanup
+}
+
+// sendBacklogMessage posts a message from the coordinator to a channel.
+func sendBacklogMessage(t *testing.T, svc *fabric.Service, channel, content string) {
+	t.Helper()
+	_, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: channel,
+		Content:     content,
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+}
+
+// getBacklog calls get_worker_backlog and decodes the response.
+func getBacklog(t *testing.T, adapter *V2Adapter, args string) GetWorkerBacklogResult {
+	t.Helper()
+	result, err := adapter.HandleGetWorkerBacklog(context.Background(), json.RawMessage(args))
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].Text)
+
+	var response GetWorkerBacklogResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+	return response
+}
+
+func TestHandleGetWorkerBacklog_CountsUnreadPerWorker(t *testing.T) {
+	svc := newBacklogTestFabric(t)
+	adapter, cleanup := newBacklogTestAdapter(t, svc)
+	defer cleanup()
+
+	sendBacklogMessage(t, svc, "tasks", "@worker-1 please pick up task-1")
+	sendBacklogMessage(t, svc, "general", "@worker-1 heads up")
+	sendBacklogMessage(t, svc, "tasks", "@worker-2 please pick up task-2")
+	sendBacklogMessage(t, svc, "tasks", "@worker-1 and another")
+
+	response := getBacklog(t, adapter, `{}`)
+
+	require.True(t, response.OK)
+	require.Equal(t, 4, response.TotalUnread)
+	require.Equal(t, []workerBacklog{
+		{WorkerID: "worker-1", Unread: 3, Channels: map[string]int{"tasks": 2, "general": 1}},
+		{WorkerID: "worker-2", Unread: 1, Channels: map[string]int{"tasks": 1}},
+	}, response.Workers)
+
+	only := getBacklog(t, adapter, `{"worker_id": "worker-2"}`)
+	require.Equal(t, 1, only.TotalUnread)
+	require.Len(t, only.Workers, 1)
+	require.Equal(t, "worker-2", only.Workers[0].WorkerID)
+}
+
+func TestHandleClearWorkerBacklog_ZeroesOnlyThatWorker(t *testing.T) {
+	svc := newBacklogTestFabric(t)
+	adapter, cleanup := newBacklogTestAdapter(t, svc)
+	defer cleanup()
+
+	sendBacklogMessage(t, svc, "tasks", "@worker-1 @worker-2 please sync up")
+	sendBacklogMessage(t, svc, "general", "@worker-1 heads up")
+
+	result, err := adapter.HandleClearWorkerBacklog(context.Background(), json.RawMessage(`{"worker_id": "worker-1"}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Contains(t, result.Content[0].Text, "Marked 2 message(s) read for worker-1")
+
+	require.Equal(t, 2, result.StructuredContent.(ClearWorkerBacklogResult).Cleared)
+
+	response := getBacklog(t, adapter, `{}`)
+	require.Equal(t, []workerBacklog{
+		{WorkerID: "worker-1"},
+		{WorkerID: "worker-2", Unread: 1, Channels: map[string]int{"tasks": 1}},
+	}, response.Workers)
+
+	// Clearing an empty backlog is a no-op.
+	result, err = adapter.HandleClearWorkerBacklog(context.Background(), json.RawMessage(`{"worker_id": "worker-1"}`))
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "Marked 0 message(s)")
+}
+
+func TestHandleWorkerBacklog_RejectsUnknownWorker(t *testing.T) {
+	adapter, cleanup := newBacklogTestAdapter(t, newBacklogTestFabric(t))
+	defer cleanup()
+
+	result, err := adapter.HandleGetWorkerBacklog(context.Background(), json.RawMessage(`{"worker_id": "worker-9"}`))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	require.Contains(t, result.Content[0].Text, "worker not found: worker-9")
+
+	result, err = adapter.HandleClearWorkerBacklog(context.Background(), json.RawMessage(`{"worker_id": "worker-9"}`))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+
+	_, err = adapter.HandleClearWorkerBacklog(context.Background(), json.RawMessage(`{}`))
+	require.Error(t, err)
+}
+
+func TestHandleWorkerBacklog_NotConfigured(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(repository.NewMemoryProcessRepository()))
+	defer cleanup()
+
+	_, err := adapter.HandleGetWorkerBacklog(context.Background(), json.RawMessage(`{}`))
+	require.Error(t, err)
+	_, err = adapter.HandleClearWorkerBacklog(context.Background(), json.RawMessage(`{"worker_id": "worker-1"}`))
+	require.Error(t, err)
+}
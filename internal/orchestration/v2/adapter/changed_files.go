@@ -0,0 +1,78 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// MaxChangedFiles caps the number of paths returned by list_changed_files and
+// get_changed_files. Total always reports the full count.
+const MaxChangedFiles = 200
+
+// getChangedFilesArgs holds arguments for the list_changed_files and get_changed_files tools.
+type getChangedFilesArgs struct {
+	TaskID string `json:"task_id,omitempty"`
+}
+
+// HandleGetChangedFiles handles the list_changed_files (worker) and get_changed_files
+// (coordinator) MCP tool calls. It reports the paths changed in the worktree, as seen by
+// git status, for the given task without returning the diff itself.
+//
+// This is a read-only operation. If task_id is omitted, the task currently assigned
+// to workerID is used (workerID is empty for coordinator calls).
+func (a *V2Adapter) HandleGetChangedFiles(_ context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	if a.taskRepo == nil {
+		return nil, fmt.Errorf("task repository not configured for read-only operations")
+	}
+	if a.gitExecutor == nil {
+		return nil, fmt.Errorf("git executor not configured")
+	}
+
+	var parsed getChangedFilesArgs
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &parsed); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	var task *repository.TaskAssignment
+	var err error
+	switch {
+	case parsed.TaskID != "":
+		task, err = a.taskRepo.Get(parsed.TaskID)
+	case workerID != "":
+		task, err = a.taskRepo.GetByWorker(workerID)
+	default:
+		return nil, fmt.Errorf("task_id is required")
+	}
+	if err != nil {
+		return errorResult(fmt.Sprintf("task not found: %v", err)), nil
+	}
+
+	status, err := a.gitExecutor.GetStatus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+
+	response := GetChangedFilesResult{
+		ToolResult: okResult(),
+		TaskID:     task.TaskID,
+		Files:      make([]ChangedFile, 0, min(len(status), MaxChangedFiles)),
+		Total:      len(status),
+		Truncated:  len(status) > MaxChangedFiles,
+	}
+	for _, f := range status[:min(len(status), MaxChangedFiles)] {
+		response.Files = append(response.Files, ChangedFile{
+			Path:      f.Path,
+			Staged:    f.Staged,
+			Unstaged:  f.Unstaged,
+			Untracked: f.Untracked,
+		})
+	}
+
+	return jsonResult(response)
+}
@@ -0,0 +1,144 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// seedImplementingTask stores a task being implemented by worker-1.
+func seedImplementingTask(t *testing.T) *repository.MemoryTaskRepository {
+	t.Helper()
+	taskRepo := repository.NewMemoryTaskRepository()
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	})
+	return taskRepo
+}
+
+// decodeChangedFiles decodes the JSON text content of a changed-files result.
+func decodeChangedFiles(t *testing.T, text string) GetChangedFilesResult {
+	t.Helper()
+	var result GetChangedFilesResult
+	require.NoError(t, json.Unmarshal([]byte(text), &result))
+	return result
+}
+
+func TestHandleGetChangedFiles_ReportsStagedAndUnstaged(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetStatus().Return([]domain.FileStatus{
+		{Path: "a.go", Staged: true},
+		{Path: "b.go", Unstaged: true},
+		{Path: "c.go", Staged: true, Unstaged: true},
+		{Path: "new.go", Untracked: true},
+	}, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedImplementingTask(t)), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetChangedFiles(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	got := decodeChangedFiles(t, result.Content[0].Text)
+	assert.True(t, got.OK)
+	assert.Equal(t, "perles-abc1", got.TaskID)
+	assert.Equal(t, 4, got.Total)
+	assert.False(t, got.Truncated)
+	assert.Equal(t, []ChangedFile{
+		{Path: "a.go", Staged: true},
+		{Path: "b.go", Unstaged: true},
+		{Path: "c.go", Staged: true, Unstaged: true},
+		{Path: "new.go", Untracked: true},
+	}, got.Files)
+	assert.Equal(t, got, result.StructuredContent)
+}
+
+func TestHandleGetChangedFiles_ResolvesWorkerTask(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetStatus().Return(nil, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedImplementingTask(t)), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetChangedFiles(context.Background(), nil, "worker-1")
+
+	require.NoError(t, err)
+	got := decodeChangedFiles(t, result.Content[0].Text)
+	assert.Equal(t, "perles-abc1", got.TaskID)
+	assert.Empty(t, got.Files)
+	assert.Zero(t, got.Total)
+}
+
+func TestHandleGetChangedFiles_CapsList(t *testing.T) {
+	status := make([]domain.FileStatus, MaxChangedFiles+5)
+	for i := range status {
+		status[i] = domain.FileStatus{Path: fmt.Sprintf("file%03d.go", i), Unstaged: true}
+	}
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetStatus().Return(status, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedImplementingTask(t)), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetChangedFiles(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+
+	require.NoError(t, err)
+	got := decodeChangedFiles(t, result.Content[0].Text)
+	assert.Len(t, got.Files, MaxChangedFiles)
+	assert.Equal(t, MaxChangedFiles+5, got.Total)
+	assert.True(t, got.Truncated)
+	assert.Equal(t, "file000.go", got.Files[0].Path)
+}
+
+func TestHandleGetChangedFiles_Errors(t *testing.T) {
+	t.Run("missing_task_id_for_coordinator", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t,
+			WithTaskRepository(repository.NewMemoryTaskRepository()),
+			WithGitExecutor(mocks.NewMockGitExecutor(t)))
+		defer cleanup()
+
+		_, err := adapter.HandleGetChangedFiles(context.Background(), nil, "")
+		require.ErrorContains(t, err, "task_id is required")
+	})
+
+	t.Run("unknown_task", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t,
+			WithTaskRepository(repository.NewMemoryTaskRepository()),
+			WithGitExecutor(mocks.NewMockGitExecutor(t)))
+		defer cleanup()
+
+		result, err := adapter.HandleGetChangedFiles(context.Background(), toJSON(t, map[string]string{"task_id": "nope"}), "")
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("git_status_fails", func(t *testing.T) {
+		gitExec := mocks.NewMockGitExecutor(t)
+		gitExec.EXPECT().GetStatus().Return(nil, fmt.Errorf("not a git repository")).Once()
+
+		adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedImplementingTask(t)), WithGitExecutor(gitExec))
+		defer cleanup()
+
+		_, err := adapter.HandleGetChangedFiles(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+		require.ErrorContains(t, err, "not a git repository")
+	})
+
+	t.Run("git_not_configured", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t, WithTaskRepository(repository.NewMemoryTaskRepository()))
+		defer cleanup()
+
+		_, err := adapter.HandleGetChangedFiles(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+		require.ErrorContains(t, err, "git executor not configured")
+	})
+}
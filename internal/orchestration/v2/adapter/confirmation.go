@@ -0,0 +1,76 @@
+package adapter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultConfirmationTTL is how long a confirmation token stays valid.
+const DefaultConfirmationTTL = 5 * time.Minute
+
+// ConfirmationGate issues and redeems single-use tokens for destructive tool calls.
+// The first call to a gated tool describes its impact and returns a token; the action
+// only runs when the tool is called again with that token for the same target.
+type ConfirmationGate struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	pending map[string]pendingConfirmation
+}
+
+// pendingConfirmation records what an issued token confirms.
+type pendingConfirmation struct {
+	tool    string
+	key     string
+	expires time.Time
+}
+
+// NewConfirmationGate creates a gate whose tokens expire after ttl.
+func NewConfirmationGate(ttl time.Duration) *ConfirmationGate {
+	return &ConfirmationGate{
+		ttl:     ttl,
+		now:     time.Now,
+		pending: make(map[string]pendingConfirmation),
+	}
+}
+
+// Issue returns a new token confirming tool against key (e.g. an epic ID).
+func (g *ConfirmationGate) Issue(tool, key string) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	for token, p := range g.pending {
+		if now.After(p.expires) {
+			delete(g.pending, token)
+		}
+	}
+
+	token := uuid.New().String()
+	g.pending[token] = pendingConfirmation{tool: tool, key: key, expires: now.Add(g.ttl)}
+	return token
+}
+
+// Confirm redeems token for tool and key. It returns false if the token is unknown,
+// expired, or was issued for a different tool or key. A token can be redeemed once.
+func (g *ConfirmationGate) Confirm(token, tool, key string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	p, ok := g.pending[token]
+	if !ok {
+		return false
+	}
+	delete(g.pending, token)
+	return p.tool == tool && p.key == key && !g.now().After(p.expires)
+}
+
+// WithConfirmationGate requires destructive tools to be confirmed with a token from gate.
+// When nil, destructive tools run on the first call.
+func WithConfirmationGate(gate *ConfirmationGate) Option {
+	return func(a *V2Adapter) {
+		a.confirmGate = gate
+	}
+}
@@ -0,0 +1,180 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+func TestConfirmationGate_TokenIsSingleUse(t *testing.T) {
+	gate := NewConfirmationGate(time.Minute)
+
+	token := gate.Issue("complete_epic_tasks", "perles-epic1")
+
+	require.True(t, gate.Confirm(token, "complete_epic_tasks", "perles-epic1"))
+	require.False(t, gate.Confirm(token, "complete_epic_tasks", "perles-epic1"))
+}
+
+func TestConfirmationGate_RejectsMismatchedToolOrKey(t *testing.T) {
+	gate := NewConfirmationGate(time.Minute)
+
+	token := gate.Issue("complete_epic_tasks", "perles-epic1")
+	require.False(t, gate.Confirm(token, "complete_epic_tasks", "perles-epic2"))
+
+	token = gate.Issue("complete_epic_tasks", "perles-epic1")
+	require.False(t, gate.Confirm(token, "reopen_task", "perles-epic1"))
+
+	require.False(t, gate.Confirm("not-a-token", "complete_epic_tasks", "perles-epic1"))
+}
+
+func TestConfirmationGate_TokenExpires(t *testing.T) {
+	gate := NewConfirmationGate(time.Minute)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	gate.now = func() time.Time { return now }
+
+	token := gate.Issue("complete_epic_tasks", "perles-epic1")
+	now = now.Add(2 * time.Minute)
+
+	require.False(t, gate.Confirm(token, "complete_epic_tasks", "perles-epic1"))
+}
+
+func TestHandleCompleteEpicTasks_UnconfirmedReturnsToken(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t, WithConfirmationGate(NewConfirmationGate(time.Minute)))
+	defer cleanup()
+
+	handler.returnResult = &command.CommandResult{
+		Success: true,
+		Data: &fakeEpicTasksResult{
+			closed:  []string{"perles-epic1.1", "perles-epic1.2"},
+			skipped: []string{"perles-epic1.3"},
+		},
+	}
+
+	result, err := adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id": "perles-epic1",
+	}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	confirm := result.StructuredContent.(ConfirmationRequiredResult)
+	assert.NotEmpty(t, confirm.ConfirmToken)
+	assert.Equal(t, "complete_epic_tasks", confirm.Tool)
+	assert.Equal(t, "will close 2 subtasks of perles-epic1 (1 still assigned to workers are skipped)", confirm.Impact)
+	assert.Equal(t, []string{"perles-epic1.1", "perles-epic1.2"}, confirm.Affected)
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	assert.True(t, cmds[0].(*command.CompleteEpicTasksCommand).DryRun, "unconfirmed call must not close tasks")
+}
+
+func TestHandleCompleteEpicTasks_ConfirmedExecutes(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t, WithConfirmationGate(NewConfirmationGate(time.Minute)))
+	defer cleanup()
+
+	handler.returnResult = &command.CommandResult{
+		Success: true,
+		Data:    &fakeEpicTasksResult{closed: []string{"perles-epic1.1", "perles-epic1.2"}},
+	}
+
+	result, err := adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id": "perles-epic1",
+	}))
+	require.NoError(t, err)
+	token := result.StructuredContent.(ConfirmationRequiredResult).ConfirmToken
+
+	result, err = adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id":       "perles-epic1",
+		"confirm_token": token,
+	}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	response := result.StructuredContent.(CompleteEpicTasksResult)
+	assert.Equal(t, 2, response.ClosedCount)
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 2)
+	assert.False(t, cmds[1].(*command.CompleteEpicTasksCommand).DryRun)
+
+	// The token was spent by the confirmed call
+	result, err = adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id":       "perles-epic1",
+		"confirm_token": token,
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "invalid or expired confirm_token")
+	require.Len(t, handler.getCommands(), 2)
+}
+
+func TestHandleCompleteEpicTasks_TokenForOtherEpicRejected(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t, WithConfirmationGate(NewConfirmationGate(time.Minute)))
+	defer cleanup()
+
+	handler.returnResult = &command.CommandResult{
+		Success: true,
+		Data:    &fakeEpicTasksResult{closed: []string{"perles-epic1.1"}},
+	}
+
+	result, err := adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id": "perles-epic1",
+	}))
+	require.NoError(t, err)
+	token := result.StructuredContent.(ConfirmationRequiredResult).ConfirmToken
+
+	result, err = adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id":       "perles-epic2",
+		"confirm_token": token,
+	}))
+
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	require.Len(t, handler.getCommands(), 1)
+}
+
+func TestHandleCompleteEpicTasks_NothingToConfirm(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t, WithConfirmationGate(NewConfirmationGate(time.Minute)))
+	defer cleanup()
+
+	handler.returnResult = &command.CommandResult{
+		Success: true,
+		Data:    &fakeEpicTasksResult{skipped: []string{"perles-epic1.1"}},
+	}
+
+	result, err := adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id": "perles-epic1",
+	}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	response := result.StructuredContent.(CompleteEpicTasksResult)
+	assert.Zero(t, response.ClosedCount)
+	assert.Equal(t, []string{"perles-epic1.1"}, response.Skipped)
+}
+
+func TestHandleCompleteEpicTasks_GateDisabledExecutesDirectly(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	handler.returnResult = &command.CommandResult{
+		Success: true,
+		Data:    &fakeEpicTasksResult{closed: []string{"perles-epic1.1"}},
+	}
+
+	result, err := adapter.HandleCompleteEpicTasks(context.Background(), toJSON(t, map[string]string{
+		"epic_id":       "perles-epic1",
+		"confirm_token": "ignored",
+	}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, 1, result.StructuredContent.(CompleteEpicTasksResult).ClosedCount)
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	assert.False(t, cmds[0].(*command.CompleteEpicTasksCommand).DryRun)
+}
@@ -0,0 +1,49 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+)
+
+// fetchContextArgs holds arguments for fetch_context tool.
+type fetchContextArgs struct {
+	Section string `json:"section"`
+}
+
+// HandleFetchContext handles the fetch_context MCP tool call.
+// It returns a section of the worker's task assignment prompt in full, for use when
+// the prompt was truncated to fit the configured size limit.
+//
+// This is a read-only operation on the task currently assigned to workerID.
+func (a *V2Adapter) HandleFetchContext(_ context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	if a.taskRepo == nil {
+		return nil, fmt.Errorf("task repository not configured for read-only operations")
+	}
+
+	var parsed fetchContextArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if parsed.Section == "" {
+		return nil, fmt.Errorf("section is required")
+	}
+
+	task, err := a.taskRepo.GetByWorker(workerID)
+	if err != nil {
+		return errorResult(fmt.Sprintf("no task assigned to %s: %v", workerID, err)), nil
+	}
+
+	content, err := prompt.TaskAssignmentContext(parsed.Section, task.TaskID, task.Instructions)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	if content == "" {
+		return mcptypes.SuccessResult(fmt.Sprintf("Task %s has no %s.", task.TaskID, parsed.Section)), nil
+	}
+
+	return mcptypes.SuccessResult(content), nil
+}
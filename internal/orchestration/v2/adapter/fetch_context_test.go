@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// seedInstructedTask stores a task assigned to worker-1 with the given coordinator instructions.
+func seedInstructedTask(t *testing.T, instructions string) *repository.MemoryTaskRepository {
+	t.Helper()
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:       "perles-abc1",
+		Implementer:  "worker-1",
+		Status:       repository.TaskImplementing,
+		Instructions: instructions,
+	}))
+	return taskRepo
+}
+
+func TestHandleFetchContext_ReturnsFullInstructions(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedInstructedTask(t, "Refactor the parser, then update all callers.")))
+	defer cleanup()
+
+	result, err := adapter.HandleFetchContext(context.Background(), toJSON(t, map[string]string{"section": "instructions"}), "worker-1")
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Refactor the parser, then update all callers.", result.Content[0].Text)
+}
+
+func TestHandleFetchContext_ReturnsGuidelines(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedInstructedTask(t, "")))
+	defer cleanup()
+
+	result, err := adapter.HandleFetchContext(context.Background(), toJSON(t, map[string]string{"section": "guidelines"}), "worker-1")
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "## Implementation Workflow")
+	assert.Contains(t, result.Content[0].Text, "bd show perles-abc1")
+}
+
+func TestHandleFetchContext_NoInstructions(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedInstructedTask(t, "")))
+	defer cleanup()
+
+	result, err := adapter.HandleFetchContext(context.Background(), toJSON(t, map[string]string{"section": "instructions"}), "worker-1")
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Task perles-abc1 has no instructions.", result.Content[0].Text)
+}
+
+func TestHandleFetchContext_Errors(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(seedInstructedTask(t, "")))
+	defer cleanup()
+
+	_, err := adapter.HandleFetchContext(context.Background(), toJSON(t, map[string]string{}), "worker-1")
+	require.ErrorContains(t, err, "section is required")
+
+	result, err := adapter.HandleFetchContext(context.Background(), toJSON(t, map[string]string{"section": "everything"}), "worker-1")
+	require.NoError(t, err)
+	assert.True(t, result.IsError, "unknown section should be a tool error")
+
+	result, err = adapter.HandleFetchContext(context.Background(), toJSON(t, map[string]string{"section": "instructions"}), "worker-2")
+	require.NoError(t, err)
+	assert.True(t, result.IsError, "worker without a task should get a tool error")
+}
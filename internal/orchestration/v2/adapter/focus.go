@@ -0,0 +1,70 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// setFocusArgs holds arguments for set_focus tool.
+type setFocusArgs struct {
+	Focus string `json:"focus"`
+}
+
+// focusExtractor is an interface for results that carry the current focus area.
+type focusExtractor interface {
+	GetFocus() string
+}
+
+// HandleSetFocus handles the set_focus MCP tool call.
+// The focus area is included in every subsequent task assignment until it is cleared
+// by setting a blank focus.
+func (a *V2Adapter) HandleSetFocus(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed setFocusArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewSetFocusCommand(command.SourceMCPTool, parsed.Focus)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("set_focus command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("set_focus command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	focus := ""
+	if v, ok := result.Data.(focusExtractor); ok {
+		focus = v.GetFocus()
+	}
+
+	msg := "Focus cleared. New task assignments will no longer include it."
+	if focus != "" {
+		msg = fmt.Sprintf(
+			"Focus set to %q. It will be included in every new task assignment until cleared; tasks already assigned are unchanged.", focus)
+	}
+	return messageResult(msg, SetFocusResult{ToolResult: okResult(), Focus: focus, Message: msg}), nil
+}
+
+// HandleGetFocus handles the get_focus MCP tool call.
+// This is a read-only operation that reads the focus area directly from the coordinator.
+func (a *V2Adapter) HandleGetFocus(_ context.Context, _ json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil {
+		return nil, fmt.Errorf("process repository not configured for read-only operations")
+	}
+
+	response := GetFocusResult{ToolResult: okResult()}
+	if coord, err := a.processRepo.GetCoordinator(); err == nil {
+		response.Focus = coord.Focus
+	}
+	return jsonResult(response)
+}
@@ -0,0 +1,61 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// stubFocusResult reports a fixed focus area.
+type stubFocusResult struct {
+	focus string
+}
+
+func (r *stubFocusResult) GetFocus() string { return r.focus }
+
+func TestHandleSetFocus_SubmitsCommand(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+	handler.returnResult = &command.CommandResult{Success: true, Data: &stubFocusResult{focus: "the parser"}}
+
+	result, err := adapter.HandleSetFocus(context.Background(), toJSON(t, map[string]string{"focus": "the parser"}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, `Focus set to "the parser"`)
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	focusCmd, ok := cmds[0].(*command.SetFocusCommand)
+	require.True(t, ok, "expected SetFocusCommand, got %T", cmds[0])
+	assert.Equal(t, "the parser", focusCmd.Focus)
+}
+
+func TestHandleSetFocus_BlankClears(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+	handler.returnResult = &command.CommandResult{Success: true, Data: &stubFocusResult{}}
+
+	result, err := adapter.HandleSetFocus(context.Background(), toJSON(t, map[string]string{"focus": ""}))
+
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].Text, "Focus cleared")
+	require.Len(t, handler.getCommands(), 1)
+}
+
+func TestHandleGetFocus_NoCoordinator(t *testing.T) {
+	adapter := NewV2Adapter(nil, WithProcessRepository(repository.NewMemoryProcessRepository()))
+
+	result, err := adapter.HandleGetFocus(context.Background(), nil)
+
+	require.NoError(t, err)
+	var got GetFocusResult
+	decodeStructured(t, result, &got)
+	require.True(t, got.OK)
+	require.Empty(t, got.Focus)
+}
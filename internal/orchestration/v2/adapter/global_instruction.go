@@ -0,0 +1,71 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// setGlobalInstructionArgs holds arguments for set_global_instruction tool.
+type setGlobalInstructionArgs struct {
+	Instruction string `json:"instruction"`
+	Replace     bool   `json:"replace,omitempty"`
+}
+
+// globalInstructionsExtractor is an interface for results that carry the current global instructions.
+type globalInstructionsExtractor interface {
+	GetInstructions() []string
+}
+
+// HandleSetGlobalInstruction handles the set_global_instruction MCP tool call.
+// The instruction is appended to (or, with replace set, replaces) the coordinator-wide
+// instructions included in every subsequent task assignment.
+func (a *V2Adapter) HandleSetGlobalInstruction(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed setGlobalInstructionArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewSetGlobalInstructionCommand(command.SourceMCPTool, parsed.Instruction, parsed.Replace)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("set_global_instruction command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("set_global_instruction command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	count := 0
+	if v, ok := result.Data.(globalInstructionsExtractor); ok {
+		count = len(v.GetInstructions())
+	}
+
+	msg := fmt.Sprintf(
+		"Global instructions updated (%d active). They will be included in every new task assignment; tasks already assigned are unchanged.", count)
+	return messageResult(msg, SetGlobalInstructionResult{ToolResult: okResult(), ActiveInstructions: count, Message: msg}), nil
+}
+
+// HandleClearGlobalInstruction handles the clear_global_instruction MCP tool call.
+func (a *V2Adapter) HandleClearGlobalInstruction(ctx context.Context, _ json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	cmd := command.NewClearGlobalInstructionCommand(command.SourceMCPTool)
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("clear_global_instruction command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	msg := "Global instructions cleared. New task assignments will no longer include them."
+	return messageResult(msg, ClearGlobalInstructionResult{ToolResult: okResult(), Message: msg}), nil
+}
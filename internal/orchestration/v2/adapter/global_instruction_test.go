@@ -0,0 +1,59 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// stubInstructionsResult reports a fixed set of global instructions.
+type stubInstructionsResult []string
+
+func (r stubInstructionsResult) GetInstructions() []string { return r }
+
+func TestHandleSetGlobalInstruction_SubmitsCommand(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+	handler.returnResult = &command.CommandResult{Success: true, Data: stubInstructionsResult{"a", "b"}}
+
+	result, err := adapter.HandleSetGlobalInstruction(context.Background(),
+		toJSON(t, map[string]any{"instruction": "The DB migration is frozen", "replace": true}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "(2 active)")
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	setCmd, ok := cmds[0].(*command.SetGlobalInstructionCommand)
+	require.True(t, ok, "expected SetGlobalInstructionCommand, got %T", cmds[0])
+	assert.Equal(t, "The DB migration is frozen", setCmd.Instruction)
+	assert.True(t, setCmd.Replace)
+}
+
+func TestHandleSetGlobalInstruction_RequiresInstruction(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleSetGlobalInstruction(context.Background(), toJSON(t, map[string]string{"instruction": "  "}))
+
+	require.ErrorContains(t, err, "instruction is required")
+	assert.Empty(t, handler.getCommands())
+}
+
+func TestHandleClearGlobalInstruction_SubmitsCommand(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	result, err := adapter.HandleClearGlobalInstruction(context.Background(), toJSON(t, map[string]string{}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	assert.Equal(t, command.CmdClearGlobalInstruction, cmds[0].Type())
+}
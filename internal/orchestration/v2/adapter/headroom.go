@@ -0,0 +1,71 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// WorkerCapacityReporter is implemented by a WorkerCapacity that can report how many
+// worker slots are free. get_headroom uses it to report how many workers can be spawned.
+type WorkerCapacityReporter interface {
+	// Free returns the number of slots the workflow can acquire without waiting, counting
+	// any per-workflow worker limit, or -1 if unlimited.
+	Free() int
+}
+
+// GetHeadroomResult is the result of the get_headroom tool.
+type GetHeadroomResult struct {
+	ToolResult
+	// Headroom is how many new tasks can be assigned right now: ready workers
+	// minus the workers reserved for reviews waiting on a reviewer.
+	Headroom int `json:"headroom"`
+	// ReadyWorkers is the number of idle workers that can take a task now.
+	ReadyWorkers int `json:"ready_workers"`
+	// ReservedReviews is the number of tasks awaiting a reviewer.
+	ReservedReviews int `json:"reserved_reviews"`
+	// SpawnableWorkers is how many more workers can be spawned: the shared pool's free
+	// slots, capped by the workflow's max workers minus the workers it already holds.
+	// nil when neither is limited.
+	SpawnableWorkers *int `json:"spawnable_workers,omitempty"`
+}
+
+// HandleGetHeadroom handles the get_headroom MCP tool call.
+// This is a read-only operation that reads directly from repositories and the worker pool.
+// Ready workers already hold their pool slots, so the pool and the workflow's worker
+// limit only bound new spawns.
+func (a *V2Adapter) HandleGetHeadroom(_ context.Context, _ json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil || a.taskRepo == nil {
+		return nil, fmt.Errorf("process and task repositories not configured for read-only operations")
+	}
+
+	response := GetHeadroomResult{ToolResult: okResult()}
+
+	// Count workers the same way assignment selects them: no task, past any ready grace period
+	now := a.Now()
+	for _, p := range a.processRepo.ReadyWorkers() {
+		if p.TaskID == "" && !p.InReadyGrace(now) {
+			response.ReadyWorkers++
+		}
+	}
+
+	// Implemented tasks waiting for a reviewer will claim a ready worker next
+	for _, task := range a.taskRepo.All() {
+		if task.Status == repository.TaskInReview && task.Reviewer == "" {
+			response.ReservedReviews++
+		}
+	}
+
+	response.Headroom = max(response.ReadyWorkers-response.ReservedReviews, 0)
+
+	if reporter, ok := a.workerCapacity.(WorkerCapacityReporter); ok {
+		if free := reporter.Free(); free >= 0 {
+			response.SpawnableWorkers = &free
+		}
+	}
+
+	return jsonResult(response)
+}
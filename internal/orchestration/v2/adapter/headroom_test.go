@@ -0,0 +1,117 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// reportingWorkerCapacity is a WorkerCapacity that reports a fixed number of free slots.
+type reportingWorkerCapacity struct {
+	fakeWorkerCapacity
+	free int
+}
+
+func (c *reportingWorkerCapacity) Free() int { return c.free }
+
+// headroomFixture returns repositories with three idle ready workers, one ready worker
+// still in its grace period, one working worker and one retired worker.
+func headroomFixture(t *testing.T) (*repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+	idle := events.ProcessPhaseIdle
+	processRepo := repository.NewMemoryProcessRepository()
+	for _, id := range []string{"worker-1", "worker-2", "worker-3"} {
+		processRepo.AddProcess(&repository.Process{ID: id, Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle})
+	}
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle,
+		AssignableAt: time.Now().Add(time.Hour),
+	})
+	processRepo.AddProcess(&repository.Process{ID: "worker-5", Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "perles-abc.1"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-6", Role: repository.RoleWorker, Status: repository.StatusRetired})
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.1", Status: repository.TaskImplementing, Implementer: "worker-5"}))
+	return processRepo, taskRepo
+}
+
+// getHeadroom calls get_headroom and decodes its result.
+func getHeadroom(t *testing.T, adapter *V2Adapter) GetHeadroomResult {
+	t.Helper()
+	result, err := adapter.HandleGetHeadroom(context.Background(), nil)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	var got GetHeadroomResult
+	decodeStructured(t, result, &got)
+	require.True(t, got.OK)
+	return got
+}
+
+func TestHandleGetHeadroom_CountsReadyWorkers(t *testing.T) {
+	processRepo, taskRepo := headroomFixture(t)
+	adapter := NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+
+	got := getHeadroom(t, adapter)
+	require.Equal(t, 3, got.ReadyWorkers, "workers in their grace period, working or retired are not ready")
+	require.Equal(t, 0, got.ReservedReviews)
+	require.Equal(t, 3, got.Headroom)
+	require.Nil(t, got.SpawnableWorkers, "no worker pool means spawns are unlimited")
+}
+
+func TestHandleGetHeadroom_SubtractsReviewsAwaitingReviewer(t *testing.T) {
+	processRepo, taskRepo := headroomFixture(t)
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.2", Status: repository.TaskInReview, Implementer: "worker-7"}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.3", Status: repository.TaskInReview, Implementer: "worker-8"}))
+	// A review that already has its reviewer reserves nothing further
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.4", Status: repository.TaskInReview, Implementer: "worker-9", Reviewer: "worker-10"}))
+	adapter := NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+
+	got := getHeadroom(t, adapter)
+	require.Equal(t, 3, got.ReadyWorkers)
+	require.Equal(t, 2, got.ReservedReviews)
+	require.Equal(t, 1, got.Headroom)
+}
+
+func TestHandleGetHeadroom_NeverNegative(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.2", Status: repository.TaskInReview, Implementer: "worker-1"}))
+	adapter := NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+
+	got := getHeadroom(t, adapter)
+	require.Equal(t, 1, got.ReservedReviews)
+	require.Equal(t, 0, got.Headroom)
+}
+
+func TestHandleGetHeadroom_ReportsWorkerPoolCap(t *testing.T) {
+	processRepo, taskRepo := headroomFixture(t)
+
+	adapter := NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo),
+		WithWorkerCapacity(&reportingWorkerCapacity{free: 2}))
+	got := getHeadroom(t, adapter)
+	require.NotNil(t, got.SpawnableWorkers)
+	require.Equal(t, 2, *got.SpawnableWorkers)
+	require.Equal(t, 3, got.Headroom, "ready workers already hold their pool slots")
+
+	adapter = NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo),
+		WithWorkerCapacity(&reportingWorkerCapacity{free: 0}))
+	got = getHeadroom(t, adapter)
+	require.NotNil(t, got.SpawnableWorkers)
+	require.Equal(t, 0, *got.SpawnableWorkers, "a full pool reports zero, not unlimited")
+
+	adapter = NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo),
+		WithWorkerCapacity(&reportingWorkerCapacity{free: -1}))
+	require.Nil(t, getHeadroom(t, adapter).SpawnableWorkers)
+}
+
+func TestHandleGetHeadroom_RequiresRepositories(t *testing.T) {
+	adapter := NewV2Adapter(nil, WithProcessRepository(repository.NewMemoryProcessRepository()))
+
+	_, err := adapter.HandleGetHeadroom(context.Background(), nil)
+	require.ErrorContains(t, err, "not configured")
+}
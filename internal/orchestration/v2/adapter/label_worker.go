@@ -0,0 +1,47 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// labelWorkerArgs holds arguments for label_worker tool.
+type labelWorkerArgs struct {
+	WorkerID string `json:"worker_id"`
+	Label    string `json:"label"`
+}
+
+// HandleLabelWorker handles the label_worker MCP tool call.
+// The label is shown alongside the worker ID in query_worker_state; tools and messages
+// still address the worker by its ID. An empty label clears it.
+func (a *V2Adapter) HandleLabelWorker(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed labelWorkerArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewLabelWorkerCommand(command.SourceMCPTool, parsed.WorkerID, parsed.Label)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("label_worker command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("label_worker command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	label := cmd.Label
+	msg := fmt.Sprintf("Worker %s labeled %q. Keep addressing it as %s.", cmd.WorkerID, label, cmd.WorkerID)
+	if label == "" {
+		msg = fmt.Sprintf("Label cleared from worker %s.", cmd.WorkerID)
+	}
+	return messageResult(msg, LabelWorkerResult{ToolResult: okResult(), WorkerID: cmd.WorkerID, Label: label, Message: msg}), nil
+}
@@ -0,0 +1,85 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func TestHandleLabelWorker_SubmitsCommand(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	result, err := adapter.HandleLabelWorker(context.Background(),
+		toJSON(t, map[string]string{"worker_id": "worker-1", "label": " auth-impl "}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, `Worker worker-1 labeled "auth-impl"`)
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	labelCmd, ok := cmds[0].(*command.LabelWorkerCommand)
+	require.True(t, ok, "expected LabelWorkerCommand, got %T", cmds[0])
+	assert.Equal(t, "worker-1", labelCmd.WorkerID)
+	assert.Equal(t, "auth-impl", labelCmd.Label)
+}
+
+func TestHandleLabelWorker_EmptyLabelClears(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	result, err := adapter.HandleLabelWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1"}))
+
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].Text, "Label cleared from worker worker-1")
+}
+
+func TestHandleLabelWorker_RejectsControlCharacters(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleLabelWorker(context.Background(),
+		toJSON(t, map[string]string{"worker_id": "worker-1", "label": "auth\x1b[2Jimpl"}))
+
+	require.ErrorContains(t, err, "control characters")
+	assert.Empty(t, handler.getCommands())
+}
+
+func TestHandleLabelWorker_HandlerErrorIsToolError(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+	handler.returnResult = &command.CommandResult{Success: false, Error: errors.New("process not found")}
+
+	result, err := adapter.HandleLabelWorker(context.Background(),
+		toJSON(t, map[string]string{"worker_id": "worker-9", "label": "auth-impl"}))
+
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "process not found")
+}
+
+func TestHandleQueryWorkerState_ShowsLabel(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, Label: "auth-impl"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady})
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo))
+	defer cleanup()
+
+	result, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+
+	require.NoError(t, err)
+	state := result.StructuredContent.(QueryWorkerStateResult)
+	require.Len(t, state.Workers, 2)
+	assert.Equal(t, "worker-1", state.Workers[0].WorkerID)
+	assert.Equal(t, "auth-impl", state.Workers[0].Label)
+	assert.Empty(t, state.Workers[1].Label)
+	assert.Contains(t, result.Content[0].Text, `"label": "auth-impl"`)
+	assert.Equal(t, []string{"worker-1", "worker-2"}, state.ReadyWorkers, "ready workers are still listed by ID")
+}
@@ -0,0 +1,71 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+)
+
+// MessageContentStore holds the full content of messages truncated in the message log.
+// *session.Session satisfies it.
+type MessageContentStore interface {
+	// MessageContent returns a message's full content, or an error wrapping
+	// os.ErrNotExist if none was stored for the ID.
+	MessageContent(id string) (string, error)
+}
+
+// WithMessageContentStore sets the store read by get_message_content.
+// When nil, get_message_content returns an error.
+func WithMessageContentStore(store MessageContentStore) Option {
+	return func(a *V2Adapter) {
+		a.messageContent = store
+	}
+}
+
+// getMessageContentArgs holds arguments for get_message_content tool.
+type getMessageContentArgs struct {
+	MessageID string `json:"message_id"`
+}
+
+// GetMessageContentResult is the result of the get_message_content tool.
+type GetMessageContentResult struct {
+	ToolResult
+	MessageID string `json:"message_id"`
+	Content   string `json:"content"`
+}
+
+// HandleGetMessageContent handles the get_message_content MCP tool call.
+// It returns the full content of a message whose content was truncated in the message log.
+//
+// This is a read-only operation that bypasses the command processor.
+func (a *V2Adapter) HandleGetMessageContent(_ context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.messageContent == nil {
+		return nil, fmt.Errorf("message content store not configured")
+	}
+
+	var parsed getMessageContentArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if parsed.MessageID == "" {
+		return nil, fmt.Errorf("message_id is required")
+	}
+
+	content, err := a.messageContent.MessageContent(parsed.MessageID)
+	if errors.Is(err, os.ErrNotExist) {
+		return errorResult(fmt.Sprintf("no stored content for message %s; only truncated messages have one", parsed.MessageID)), nil
+	}
+	if err != nil {
+		return errorResult(fmt.Sprintf("failed to read content of message %s: %v", parsed.MessageID, err)), nil
+	}
+
+	return jsonResult(GetMessageContentResult{
+		ToolResult: okResult(),
+		MessageID:  parsed.MessageID,
+		Content:    content,
+	})
+}
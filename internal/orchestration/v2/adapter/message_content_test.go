@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// staticMessageContent is a MessageContentStore backed by a map.
+type staticMessageContent map[string]string
+
+func (s staticMessageContent) MessageContent(id string) (string, error) {
+	content, ok := s[id]
+	if !ok {
+		return "", fmt.Errorf("message %s: %w", id, os.ErrNotExist)
+	}
+	return content, nil
+}
+
+func TestHandleGetMessageContent(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithMessageContentStore(staticMessageContent{"msg-1": "full output"}))
+	defer cleanup()
+
+	result, err := adapter.HandleGetMessageContent(context.Background(), json.RawMessage(`{"message_id": "msg-1"}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response GetMessageContentResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+	require.True(t, response.OK)
+	require.Equal(t, "msg-1", response.MessageID)
+	require.Equal(t, "full output", response.Content)
+}
+
+func TestHandleGetMessageContent_NotStored(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithMessageContentStore(staticMessageContent{}))
+	defer cleanup()
+
+	result, err := adapter.HandleGetMessageContent(context.Background(), json.RawMessage(`{"message_id": "msg-2"}`))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	require.Contains(t, result.Content[0].Text, "no stored content for message msg-2")
+
+	_, err = adapter.HandleGetMessageContent(context.Background(), json.RawMessage(`{}`))
+	require.ErrorContains(t, err, "message_id is required")
+}
+
+func TestHandleGetMessageContent_NotConfigured(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleGetMessageContent(context.Background(), json.RawMessage(`{"message_id": "msg-1"}`))
+	require.Error(t, err)
+}
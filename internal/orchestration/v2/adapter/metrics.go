@@ -0,0 +1,86 @@
+package adapter
+
+import (
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// MetricsSnapshot is a point-in-time summary of worker pool and task state,
+// read directly from the repositories.
+type MetricsSnapshot struct {
+	// ReadyWorkers is the number of workers idle and available for assignment.
+	ReadyWorkers int
+	// WorkingWorkers is the number of workers currently processing a turn or task.
+	WorkingWorkers int
+	// RetiredWorkers is the number of workers that have been retired.
+	RetiredWorkers int
+	// OrphanedTasks is the number of active tasks whose implementer or reviewer is gone.
+	OrphanedTasks int
+	// StuckWorkers is the number of working workers idle for longer than the stuck timeout.
+	StuckWorkers int
+	// TasksByStatus counts tasks per status. Every known status is present, even at zero.
+	TasksByStatus map[repository.TaskStatus]int
+
+	// SpawnedWorkers is the number of workers spawned, including retired and failed ones.
+	SpawnedWorkers int
+	// CompletedTasks is the number of tasks in TaskCompleted.
+	CompletedTasks int
+	// ReviewRounds is the number of review verdicts recorded on the current tasks. A task
+	// reassigned or reopened takes its rounds with it, so it can go down.
+	ReviewRounds int
+}
+
+// MetricsTaskStatuses returns the task statuses reported in MetricsSnapshot.TasksByStatus,
+// in lifecycle order.
+func MetricsTaskStatuses() []repository.TaskStatus {
+	return append([]repository.TaskStatus(nil), metricsTaskStatuses...)
+}
+
+// metricsTaskStatuses lists the task statuses reported in MetricsSnapshot.TasksByStatus.
+var metricsTaskStatuses = []repository.TaskStatus{
+	repository.TaskImplementing,
+	repository.TaskInReview,
+	repository.TaskApproved,
+	repository.TaskDenied,
+	repository.TaskCommitting,
+	repository.TaskCompleted,
+	repository.TaskFailed,
+}
+
+// Metrics returns a snapshot of pool and task state as of now, counting workers
+// as stuck after stuckTimeout. Counts derived from an unconfigured repository are zero.
+func (a *V2Adapter) Metrics(now time.Time, stuckTimeout time.Duration) MetricsSnapshot {
+	snapshot := MetricsSnapshot{
+		TasksByStatus: make(map[repository.TaskStatus]int, len(metricsTaskStatuses)),
+	}
+	for _, status := range metricsTaskStatuses {
+		snapshot.TasksByStatus[status] = 0
+	}
+
+	if a.processRepo != nil {
+		for _, p := range a.processRepo.Workers() {
+			snapshot.SpawnedWorkers++
+			switch p.Status {
+			case repository.StatusReady:
+				snapshot.ReadyWorkers++
+			case repository.StatusWorking:
+				snapshot.WorkingWorkers++
+			case repository.StatusRetired:
+				snapshot.RetiredWorkers++
+			}
+		}
+		snapshot.StuckWorkers = len(a.CheckStuckWorkers(now, stuckTimeout))
+	}
+
+	if a.taskRepo != nil {
+		for _, task := range a.taskRepo.All() {
+			snapshot.TasksByStatus[task.Status]++
+			snapshot.ReviewRounds += task.ReviewRounds
+		}
+		snapshot.CompletedTasks = snapshot.TasksByStatus[repository.TaskCompleted]
+	}
+
+	snapshot.OrphanedTasks = len(a.DetectOrphanedTasks())
+	return snapshot
+}
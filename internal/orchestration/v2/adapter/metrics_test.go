@@ -0,0 +1,69 @@
+package adapter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func TestMetrics_ReflectsRepositoryState(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	for _, p := range []*repository.Process{
+		{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusWorking},
+		{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady},
+		{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, LastActivityAt: now.Add(-time.Minute)},
+		{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusWorking, LastActivityAt: now.Add(-time.Hour)},
+		{ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusRetired},
+		{ID: "worker-5", Role: repository.RoleWorker, Status: repository.StatusFailed},
+	} {
+		require.NoError(t, processRepo.Save(p))
+	}
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	for _, task := range []*repository.TaskAssignment{
+		{TaskID: "perles-abc1", Implementer: "worker-2", Status: repository.TaskImplementing},
+		{TaskID: "perles-abc2", Implementer: "worker-4", Status: repository.TaskImplementing},
+		{TaskID: "perles-abc3", Implementer: "worker-3", Status: repository.TaskDenied, ReviewRounds: 1},
+		{TaskID: "perles-abc4", Implementer: "worker-4", Status: repository.TaskCompleted, ReviewRounds: 2},
+	} {
+		require.NoError(t, taskRepo.Save(task))
+	}
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+	defer cleanup()
+
+	m := adapter.Metrics(now, 15*time.Minute)
+
+	assert.Equal(t, 1, m.ReadyWorkers)
+	assert.Equal(t, 2, m.WorkingWorkers, "coordinator is not counted")
+	assert.Equal(t, 1, m.RetiredWorkers)
+	assert.Equal(t, 1, m.OrphanedTasks)
+	assert.Equal(t, 1, m.StuckWorkers)
+	assert.Equal(t, 5, m.SpawnedWorkers, "retired and failed workers are counted")
+	assert.Equal(t, 1, m.CompletedTasks)
+	assert.Equal(t, 3, m.ReviewRounds)
+	assert.Equal(t, 2, m.TasksByStatus[repository.TaskImplementing])
+	assert.Equal(t, 1, m.TasksByStatus[repository.TaskDenied])
+	assert.Equal(t, 1, m.TasksByStatus[repository.TaskCompleted])
+}
+
+func TestMetrics_EmptyRepositoriesReportZero(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(repository.NewMemoryProcessRepository()),
+		WithTaskRepository(repository.NewMemoryTaskRepository()))
+	defer cleanup()
+
+	m := adapter.Metrics(time.Now(), 15*time.Minute)
+
+	assert.Zero(t, m.SpawnedWorkers)
+	assert.Zero(t, m.OrphanedTasks)
+	require.Len(t, m.TasksByStatus, len(MetricsTaskStatuses()), "every status is reported")
+	for _, status := range MetricsTaskStatuses() {
+		assert.Zero(t, m.TasksByStatus[status])
+	}
+}
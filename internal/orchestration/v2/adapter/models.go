@@ -0,0 +1,65 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+)
+
+// ModelCatalog lists the models the worker provider accepts, reusing a listing
+// that is still fresh at now.
+type ModelCatalog interface {
+	Models(ctx context.Context, now time.Time) (client.ModelList, error)
+}
+
+// WithModelCatalog sets the catalog used by list_models.
+// When nil, list_models returns an error.
+func WithModelCatalog(catalog ModelCatalog) Option {
+	return func(a *V2Adapter) {
+		a.modelCatalog = catalog
+	}
+}
+
+// ListModelsResult is the result of the list_models tool.
+type ListModelsResult struct {
+	ToolResult
+	Provider string   `json:"provider"`
+	Models   []string `json:"models"`
+	Default  string   `json:"default,omitempty"`
+	// Source is "cli" when the provider's CLI listed the models, "static" for the known set.
+	Source string `json:"source"`
+}
+
+// HandleListModels handles the list_models MCP tool call.
+// It returns the model names the worker provider accepts, listed by its CLI when the
+// CLI supports it and from the provider's known set otherwise. Workers run on the model
+// configured for the provider; spawn_worker does not choose one, so the listing is
+// informational.
+//
+// This is a read-only operation that bypasses the command processor.
+func (a *V2Adapter) HandleListModels(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.modelCatalog == nil {
+		return nil, fmt.Errorf("model catalog not configured")
+	}
+
+	list, err := a.modelCatalog.Models(ctx, a.Now())
+	if err != nil {
+		return errorResult(fmt.Sprintf("failed to list models: %v", err)), nil
+	}
+	models := list.Models
+	if models == nil {
+		models = []string{}
+	}
+
+	return jsonResult(ListModelsResult{
+		ToolResult: okResult(),
+		Provider:   string(list.Provider),
+		Models:     models,
+		Default:    list.Default,
+		Source:     string(list.Source),
+	})
+}
@@ -0,0 +1,70 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// fixedModelCatalog returns a fixed listing or error and records the time it was asked at.
+type fixedModelCatalog struct {
+	list client.ModelList
+	err  error
+	at   time.Time
+}
+
+func (c *fixedModelCatalog) Models(_ context.Context, now time.Time) (client.ModelList, error) {
+	c.at = now
+	return c.list, c.err
+}
+
+func TestHandleListModels_ReturnsCatalog(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	catalog := &fixedModelCatalog{list: client.ModelList{
+		Provider: client.ClientCursor,
+		Models:   []string{"auto", "composer-1"},
+		Default:  "composer-1",
+		Source:   client.ModelSourceCLI,
+	}}
+	adapter, _, cleanup := testAdapter(t, WithModelCatalog(catalog), WithClock(types.NewFakeClock(now)))
+	defer cleanup()
+
+	result, err := adapter.HandleListModels(context.Background(), json.RawMessage(`{}`))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	var resp ListModelsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &resp))
+	assert.Equal(t, "cursor", resp.Provider)
+	assert.Equal(t, []string{"auto", "composer-1"}, resp.Models)
+	assert.Equal(t, "composer-1", resp.Default)
+	assert.Equal(t, "cli", resp.Source)
+	assert.Equal(t, now, catalog.at, "the catalog's cache is timed by the adapter's clock")
+}
+
+func TestHandleListModels_CatalogError(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithModelCatalog(&fixedModelCatalog{err: errors.New("unknown client type")}))
+	defer cleanup()
+
+	result, err := adapter.HandleListModels(context.Background(), json.RawMessage(`{}`))
+
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "failed to list models: unknown client type")
+}
+
+func TestHandleListModels_RequiresCatalog(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleListModels(context.Background(), json.RawMessage(`{}`))
+	require.ErrorContains(t, err, "model catalog not configured")
+}
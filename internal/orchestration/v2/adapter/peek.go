@@ -0,0 +1,110 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// DefaultPeekLines is how many lines of text peek_worker returns when none are requested.
+const DefaultPeekLines = 5
+
+// MaxPeekLines bounds how many lines of text peek_worker returns.
+const MaxPeekLines = 50
+
+// ProcessOutputReader reads the recent output of live processes.
+type ProcessOutputReader interface {
+	// LastText returns the last n lines of assistant text produced by the process,
+	// excluding tool calls and tool output. Returns an error if the process has no
+	// live output to read.
+	LastText(processID string, n int) ([]string, error)
+}
+
+// WithProcessOutputReader sets the reader used by peek_worker to read worker output.
+// When nil, peek_worker returns an error.
+func WithProcessOutputReader(reader ProcessOutputReader) Option {
+	return func(a *V2Adapter) {
+		a.outputReader = reader
+	}
+}
+
+// peekWorkerArgs holds arguments for peek_worker tool.
+type peekWorkerArgs struct {
+	WorkerID string `json:"worker_id"`
+	Lines    int    `json:"lines,omitempty"`
+}
+
+// PeekWorkerResult is the result of the peek_worker tool.
+type PeekWorkerResult struct {
+	ToolResult
+	WorkerID string   `json:"worker_id"`
+	Status   string   `json:"status"`
+	Phase    string   `json:"phase,omitempty"`
+	TaskID   string   `json:"task_id,omitempty"`
+	Lines    []string `json:"lines"`
+}
+
+// HandlePeekWorker handles the peek_worker MCP tool call.
+// It returns the last few lines of a worker's reasoning and replies, leaving out tool
+// calls and tool output, so the coordinator can see what a worker is thinking without
+// reading its full log.
+//
+// This is a read-only operation. Workers without a live process (e.g. retired) return
+// no lines; unknown IDs and non-worker processes are tool errors.
+func (a *V2Adapter) HandlePeekWorker(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil {
+		return nil, fmt.Errorf("process repository not configured for read-only operations")
+	}
+	if a.outputReader == nil {
+		return nil, fmt.Errorf("process output reader not configured")
+	}
+
+	var parsed peekWorkerArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if parsed.WorkerID == "" {
+		return nil, fmt.Errorf("worker_id is required")
+	}
+	if parsed.Lines < 0 {
+		return nil, fmt.Errorf("lines must be non-negative")
+	}
+	n := parsed.Lines
+	if n == 0 {
+		n = DefaultPeekLines
+	}
+	n = min(n, MaxPeekLines)
+
+	proc, err := a.processRepo.Get(parsed.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return errorResult(fmt.Sprintf("worker not found: %s", parsed.WorkerID)), nil
+		}
+		return nil, fmt.Errorf("failed to get worker: %w", err)
+	}
+	if !proc.IsWorker() {
+		return errorResult(fmt.Sprintf("%s is not a worker", parsed.WorkerID)), nil
+	}
+
+	response := PeekWorkerResult{
+		ToolResult: okResult(),
+		WorkerID:   proc.ID,
+		Status:     processStatusToWorkerStatus(proc.Status),
+		TaskID:     proc.TaskID,
+		Lines:      []string{},
+	}
+	if proc.Phase != nil {
+		response.Phase = string(*proc.Phase)
+	}
+
+	// A worker without a live process has no output left to read
+	if lines, err := a.outputReader.LastText(proc.ID, n); err == nil && lines != nil {
+		response.Lines = lines
+	}
+
+	return jsonResult(response)
+}
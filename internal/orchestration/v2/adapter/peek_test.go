@@ -0,0 +1,144 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+)
+
+// bufferOutputReader serves worker output from seeded output buffers.
+type bufferOutputReader struct {
+	buffers map[string]*process.OutputBuffer
+}
+
+func (r *bufferOutputReader) LastText(processID string, n int) ([]string, error) {
+	buf, ok := r.buffers[processID]
+	if !ok {
+		return nil, errors.New("no live process")
+	}
+	return buf.LastText(n), nil
+}
+
+// seedPeekReader returns a reader whose worker-1 buffer mixes text deltas with
+// tool calls, tool results, and status lines.
+func seedPeekReader() *bufferOutputReader {
+	buf := process.NewOutputBuffer(20)
+	buf.AppendText("Starting on the retry logic.")
+	buf.Append("🔧 Read: client.go")
+	buf.Append(`[Read] {"path":"client.go","content":"package client"}`)
+	for _, line := range []string{"The backoff", " never resets", " after success."} {
+		buf.AppendText(line)
+	}
+	buf.Append("🔧 Edit: client.go")
+	buf.Append("⚠️ Error: rate limited")
+	buf.AppendText("Adding a reset after each successful call.")
+	buf.AppendText("Running the tests next.")
+	buf.AppendText("Tests pass.")
+	buf.AppendText("Reporting completion.")
+	return &bufferOutputReader{buffers: map[string]*process.OutputBuffer{"worker-1": buf}}
+}
+
+func decodePeek(t *testing.T, text string) PeekWorkerResult {
+	t.Helper()
+	var resp PeekWorkerResult
+	require.NoError(t, json.Unmarshal([]byte(text), &resp))
+	return resp
+}
+
+func TestHandlePeekWorker_ReturnsOnlyTextDeltas(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessOutputReader(seedPeekReader()))
+	defer cleanup()
+
+	result, err := adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]any{"worker_id": "worker-1", "lines": 6}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	resp := decodePeek(t, result.Content[0].Text)
+	assert.Equal(t, "worker-1", resp.WorkerID)
+	assert.Equal(t, "implementing", resp.Phase)
+	assert.Equal(t, "perles-abc1", resp.TaskID)
+	assert.Equal(t, []string{
+		" never resets",
+		" after success.",
+		"Adding a reset after each successful call.",
+		"Running the tests next.",
+		"Tests pass.",
+		"Reporting completion.",
+	}, resp.Lines)
+}
+
+func TestHandlePeekWorker_DefaultsToFewLines(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessOutputReader(seedPeekReader()))
+	defer cleanup()
+
+	result, err := adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1"}))
+
+	require.NoError(t, err)
+	resp := decodePeek(t, result.Content[0].Text)
+	require.Len(t, resp.Lines, DefaultPeekLines)
+	assert.Equal(t, "Reporting completion.", resp.Lines[DefaultPeekLines-1])
+	for _, line := range resp.Lines {
+		assert.NotContains(t, line, "🔧")
+		assert.NotContains(t, line, "[Read]")
+	}
+}
+
+func TestHandlePeekWorker_WorkerWithoutLiveProcessReturnsNoLines(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessOutputReader(seedPeekReader()))
+	defer cleanup()
+
+	result, err := adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-2"}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	resp := decodePeek(t, result.Content[0].Text)
+	assert.Equal(t, "retired", resp.Status)
+	assert.Empty(t, resp.Lines)
+}
+
+func TestHandlePeekWorker_UnknownWorker(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessOutputReader(seedPeekReader()))
+	defer cleanup()
+
+	result, err := adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-9"}))
+
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "worker not found: worker-9")
+}
+
+func TestHandlePeekWorker_RejectsCoordinator(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessOutputReader(seedPeekReader()))
+	defer cleanup()
+
+	result, err := adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "coordinator"}))
+
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "is not a worker")
+}
+
+func TestHandlePeekWorker_ValidatesArguments(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessOutputReader(seedPeekReader()))
+	defer cleanup()
+
+	_, err := adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]string{}))
+	require.ErrorContains(t, err, "worker_id is required")
+
+	_, err = adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]any{"worker_id": "worker-1", "lines": -1}))
+	require.ErrorContains(t, err, "lines must be non-negative")
+}
+
+func TestHandlePeekWorker_RequiresOutputReader(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)))
+	defer cleanup()
+
+	_, err := adapter.HandlePeekWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1"}))
+	require.ErrorContains(t, err, "process output reader not configured")
+}
@@ -0,0 +1,143 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// DefaultPingTimeout is how long ping_worker waits for a probed process to respond.
+const DefaultPingTimeout = 5 * time.Second
+
+// ProcessProber checks that a process's live OS process responds.
+type ProcessProber interface {
+	// Probe sends a no-op to the process and returns the PID that responded,
+	// or 0 if the process is between turns and has no OS process to signal.
+	Probe(ctx context.Context, processID string) (int, error)
+}
+
+// WithProcessProber sets the prober used by ping_worker to verify live processes.
+// When nil, ping_worker only reports repository state.
+func WithProcessProber(prober ProcessProber) Option {
+	return func(a *V2Adapter) {
+		a.processProber = prober
+	}
+}
+
+// pingWorkerArgs holds arguments for ping_worker tool.
+type pingWorkerArgs struct {
+	WorkerID       string `json:"worker_id"`
+	Probe          bool   `json:"probe,omitempty"`
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty"`
+}
+
+// PingWorkerResult is the result of the ping_worker tool.
+type PingWorkerResult struct {
+	ToolResult
+	WorkerID       string         `json:"worker_id"`
+	Alive          bool           `json:"alive"`
+	Status         string         `json:"status"`
+	Phase          string         `json:"phase,omitempty"`
+	TaskID         string         `json:"task_id,omitempty"`
+	LastActivityAt string         `json:"last_activity_at,omitempty"`
+	RetiredAt      string         `json:"retired_at,omitempty"`
+	Probe          *pingProbeInfo `json:"probe,omitempty"`
+}
+
+// pingProbeInfo reports the outcome of probing the worker's live process.
+type pingProbeInfo struct {
+	Responded bool   `json:"responded"`
+	PID       int    `json:"pid,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// HandlePingWorker handles the ping_worker MCP tool call.
+// It reports whether a single worker is alive along with its last activity. With probe
+// set, it also sends a no-op to the worker's live process and reports whether it
+// responded within the timeout; a worker whose process does not respond is not alive.
+//
+// This is a read-only operation. Retired and failed workers are reported as not alive
+// without probing; unknown IDs and non-worker processes are tool errors.
+func (a *V2Adapter) HandlePingWorker(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil {
+		return nil, fmt.Errorf("process repository not configured for read-only operations")
+	}
+
+	var parsed pingWorkerArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if parsed.WorkerID == "" {
+		return nil, fmt.Errorf("worker_id is required")
+	}
+	if parsed.TimeoutSeconds < 0 {
+		return nil, fmt.Errorf("timeout_seconds must be non-negative")
+	}
+
+	proc, err := a.processRepo.Get(parsed.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return errorResult(fmt.Sprintf("worker not found: %s", parsed.WorkerID)), nil
+		}
+		return nil, fmt.Errorf("failed to get worker: %w", err)
+	}
+	if !proc.IsWorker() {
+		return errorResult(fmt.Sprintf("%s is not a worker", parsed.WorkerID)), nil
+	}
+
+	response := PingWorkerResult{
+		ToolResult: okResult(),
+		WorkerID:   proc.ID,
+		Alive:      proc.Status != repository.StatusRetired && proc.Status != repository.StatusFailed,
+		Status:     processStatusToWorkerStatus(proc.Status),
+		TaskID:     proc.TaskID,
+	}
+	if proc.Phase != nil {
+		response.Phase = string(*proc.Phase)
+	}
+	if !proc.LastActivityAt.IsZero() {
+		response.LastActivityAt = proc.LastActivityAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if !proc.RetiredAt.IsZero() {
+		response.RetiredAt = proc.RetiredAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	if parsed.Probe && response.Alive {
+		if a.processProber == nil {
+			return nil, fmt.Errorf("process prober not configured")
+		}
+		response.Probe = a.probeWorker(ctx, proc.ID, parsed.TimeoutSeconds)
+		response.Alive = response.Probe.Responded
+	}
+
+	return jsonResult(response)
+}
+
+// probeWorker sends a no-op to the worker's live process, bounded by timeoutSeconds
+// (DefaultPingTimeout if zero).
+func (a *V2Adapter) probeWorker(ctx context.Context, workerID string, timeoutSeconds int) *pingProbeInfo {
+	timeout := DefaultPingTimeout
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	pid, err := a.processProber.Probe(probeCtx, workerID)
+	info := &pingProbeInfo{
+		Responded: err == nil,
+		PID:       pid,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		info.Error = err.Error()
+	}
+	return info
+}
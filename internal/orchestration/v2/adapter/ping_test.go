@@ -0,0 +1,163 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// stubProber returns a fixed probe outcome and records the probed IDs.
+type stubProber struct {
+	pid    int
+	err    error
+	probed []string
+}
+
+func (p *stubProber) Probe(ctx context.Context, processID string) (int, error) {
+	p.probed = append(p.probed, processID)
+	if p.err != nil {
+		return p.pid, p.err
+	}
+	// Respect the deadline like a real probe would
+	if _, ok := ctx.Deadline(); !ok {
+		return 0, errors.New("probe called without a timeout")
+	}
+	return p.pid, nil
+}
+
+// seedPingWorkers stores a working worker, a retired worker, and the coordinator.
+func seedPingWorkers(t *testing.T) *repository.MemoryProcessRepository {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	implementing := events.ProcessPhaseImplementing
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:             "worker-1",
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusWorking,
+		Phase:          &implementing,
+		TaskID:         "perles-abc1",
+		LastActivityAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}))
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusRetired,
+		RetiredAt: time.Date(2025, 1, 1, 13, 0, 0, 0, time.UTC),
+	}))
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusReady,
+	}))
+	return processRepo
+}
+
+func decodePing(t *testing.T, text string) PingWorkerResult {
+	t.Helper()
+	var resp PingWorkerResult
+	require.NoError(t, json.Unmarshal([]byte(text), &resp))
+	return resp
+}
+
+func TestHandlePingWorker_Alive(t *testing.T) {
+	prober := &stubProber{}
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessProber(prober))
+	defer cleanup()
+
+	result, err := adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1"}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	resp := decodePing(t, result.Content[0].Text)
+	assert.True(t, resp.Alive)
+	assert.Equal(t, "working", resp.Status)
+	assert.Equal(t, "implementing", resp.Phase)
+	assert.Equal(t, "perles-abc1", resp.TaskID)
+	assert.Equal(t, "2025-01-01T12:00:00Z", resp.LastActivityAt)
+	assert.Nil(t, resp.Probe, "probe not requested")
+	assert.Empty(t, prober.probed)
+}
+
+func TestHandlePingWorker_ProbeResponds(t *testing.T) {
+	prober := &stubProber{pid: 4242}
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessProber(prober))
+	defer cleanup()
+
+	result, err := adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]any{"worker_id": "worker-1", "probe": true}))
+
+	require.NoError(t, err)
+	resp := decodePing(t, result.Content[0].Text)
+	assert.True(t, resp.Alive)
+	require.NotNil(t, resp.Probe)
+	assert.True(t, resp.Probe.Responded)
+	assert.Equal(t, 4242, resp.Probe.PID)
+	assert.Empty(t, resp.Probe.Error)
+	assert.Equal(t, []string{"worker-1"}, prober.probed)
+}
+
+func TestHandlePingWorker_ProbeFails(t *testing.T) {
+	prober := &stubProber{pid: 4242, err: errors.New("process 4242 did not respond: context deadline exceeded")}
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessProber(prober))
+	defer cleanup()
+
+	result, err := adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]any{"worker_id": "worker-1", "probe": true, "timeout_seconds": 1}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	resp := decodePing(t, result.Content[0].Text)
+	assert.False(t, resp.Alive, "unresponsive process means the worker is not alive")
+	require.NotNil(t, resp.Probe)
+	assert.False(t, resp.Probe.Responded)
+	assert.Contains(t, resp.Probe.Error, "did not respond")
+}
+
+func TestHandlePingWorker_Retired(t *testing.T) {
+	prober := &stubProber{}
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessProber(prober))
+	defer cleanup()
+
+	result, err := adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]any{"worker_id": "worker-2", "probe": true}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	resp := decodePing(t, result.Content[0].Text)
+	assert.False(t, resp.Alive)
+	assert.Equal(t, "retired", resp.Status)
+	assert.Equal(t, "2025-01-01T13:00:00Z", resp.RetiredAt)
+	assert.Nil(t, resp.Probe, "retired workers are not probed")
+	assert.Empty(t, prober.probed)
+}
+
+func TestHandlePingWorker_Missing(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessProber(&stubProber{}))
+	defer cleanup()
+
+	result, err := adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-99"}))
+
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "worker not found: worker-99")
+}
+
+func TestHandlePingWorker_ValidatesWorkerID(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(seedPingWorkers(t)), WithProcessProber(&stubProber{}))
+	defer cleanup()
+
+	_, err := adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]string{}))
+	require.ErrorContains(t, err, "worker_id is required")
+
+	_, err = adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]any{"worker_id": "worker-1", "timeout_seconds": -1}))
+	require.ErrorContains(t, err, "timeout_seconds must be non-negative")
+
+	result, err := adapter.HandlePingWorker(context.Background(), toJSON(t, map[string]string{"worker_id": repository.CoordinatorID}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError, "coordinator is not a worker")
+}
@@ -0,0 +1,82 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// WarmWorkers returns the number of workers that are ready for assignment or still
+// starting up and expected to become ready: idle ready workers plus workers that have
+// not yet completed their first turn. Returns 0 if the process repository is not configured.
+func (a *V2Adapter) WarmWorkers() int {
+	if a.processRepo == nil {
+		return 0
+	}
+	warm := 0
+	for _, p := range a.processRepo.ActiveWorkers() {
+		switch {
+		case p.Status == repository.StatusReady && (p.Phase == nil || *p.Phase == events.ProcessPhaseIdle):
+			warm++
+		case !p.HasCompletedTurn && (p.Status == repository.StatusPending ||
+			p.Status == repository.StatusStarting || p.Status == repository.StatusWorking):
+			warm++
+		}
+	}
+	return warm
+}
+
+// EnsureReadyWorkers spawns generic workers until at least minReady are warm (see
+// WarmWorkers), so tasks can be assigned without waiting for a spawn.
+//
+// Spawns never wait for the shared worker pool: once it is at capacity the shortfall is
+// left for a later call, so higher-priority workflows asking for workers are not starved
+// by warm-up spawns. Returns the IDs of the workers spawned, along with the error that
+// stopped spawning early, if any.
+func (a *V2Adapter) EnsureReadyWorkers(ctx context.Context, minReady int) ([]string, error) {
+	var spawned []string
+	for warm := a.WarmWorkers(); warm+len(spawned) < minReady; {
+		if !a.tryAcquireWorkerSlot(ctx) {
+			log.Debug(log.CatOrch, "Worker pool at capacity, deferring ready pool spawn",
+				"warm", warm+len(spawned), "minReady", minReady)
+			break
+		}
+
+		opts := []command.SpawnProcessOption{command.WithAgentType(roles.AgentTypeGeneric)}
+		if a.workflowProvider != nil {
+			if wfConfig := a.workflowProvider.GetWorkflowConfig(roles.AgentTypeGeneric); wfConfig != nil {
+				opts = append(opts, command.WithWorkflowConfig(wfConfig))
+			}
+		}
+
+		cmd := command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker, opts...)
+		result, err := a.submitWithTimeout(ctx, cmd)
+		if err != nil {
+			a.ReleaseWorkerSlot()
+			return spawned, fmt.Errorf("spawn_process command failed: %w", err)
+		}
+		if !result.Success {
+			a.ReleaseWorkerSlot()
+			return spawned, fmt.Errorf("spawn_process command failed: %w", result.Error)
+		}
+		spawned = append(spawned, extractProcessID(result.Data))
+	}
+	return spawned, nil
+}
+
+// tryAcquireWorkerSlot reserves a worker slot only if one is free right now.
+// Acquiring with an already-cancelled context grants a free slot immediately and
+// otherwise gives up instead of queueing. Always succeeds when no capacity is configured.
+func (a *V2Adapter) tryAcquireWorkerSlot(ctx context.Context) bool {
+	if a.workerCapacity == nil {
+		return true
+	}
+	tryCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	return a.workerCapacity.Acquire(tryCtx) == nil
+}
@@ -0,0 +1,353 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+)
+
+// ToolResult is the envelope embedded in every typed coordinator tool result.
+// OK is true when the call succeeded; otherwise Error holds the reason it failed.
+// Failed calls carry only the envelope as structured content.
+type ToolResult struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// okResult returns the envelope for a successful tool call.
+func okResult() ToolResult {
+	return ToolResult{OK: true}
+}
+
+// messageResult returns a successful tool result with a human-readable message as text
+// content and the typed result as structured content.
+func messageResult(message string, result any) *mcptypes.ToolCallResult {
+	return mcptypes.StructuredResult(message, result)
+}
+
+// jsonResult returns a successful tool result whose text content is the indented JSON
+// encoding of the typed result, which is also returned as structured content.
+func jsonResult(result any) (*mcptypes.ToolCallResult, error) {
+	jsonBytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tool result: %w", err)
+	}
+	return mcptypes.StructuredResult(string(jsonBytes), result), nil
+}
+
+// errorResult returns a failed tool result carrying the message as both the text content
+// and the envelope's error.
+func errorResult(message string) *mcptypes.ToolCallResult {
+	result := mcptypes.ErrorResult(message)
+	result.StructuredContent = ToolResult{Error: message}
+	return result
+}
+
+// SpawnWorkerResult is the result of the spawn_worker tool.
+type SpawnWorkerResult struct {
+	ToolResult
+	WorkerID string `json:"worker_id"`
+	Message  string `json:"message"`
+}
+
+// RetireWorkerResult is the result of the retire_worker tool.
+type RetireWorkerResult struct {
+	ToolResult
+	WorkerID string `json:"worker_id"`
+	Message  string `json:"message"`
+}
+
+// ReplaceWorkerResult is the result of the replace_worker tool.
+// TaskID is the task handed to the replacement when reassign was requested (empty otherwise).
+type ReplaceWorkerResult struct {
+	ToolResult
+	WorkerID    string `json:"worker_id"`
+	NewWorkerID string `json:"new_worker_id,omitempty"`
+	TaskID      string `json:"task_id,omitempty"`
+	Message     string `json:"message"`
+}
+
+// StopWorkerResult is the result of the stop_worker tool.
+type StopWorkerResult struct {
+	ToolResult
+	WorkerID string `json:"worker_id"`
+	Force    bool   `json:"force"`
+	Message  string `json:"message"`
+}
+
+// SendToWorkerResult is the result of the send_to_worker tool. WorkerIDs lists the
+// workers the message was sent to; for a broadcast (ALL, ALL_REVIEWERS, ALL_IMPLEMENTERS)
+// it is empty when no worker matched Recipient.
+type SendToWorkerResult struct {
+	ToolResult
+	Recipient string   `json:"recipient"`
+	WorkerIDs []string `json:"worker_ids"`
+	Message   string   `json:"message"`
+}
+
+// AssignTaskResult is the result of the assign_task tool.
+// WorkerID is the worker the task went to, chosen by the coordinator when none was named.
+type AssignTaskResult struct {
+	ToolResult
+	TaskID   string `json:"task_id"`
+	WorkerID string `json:"worker_id"`
+	Message  string `json:"message"`
+}
+
+// AssignTasksBatchItem is the outcome of a single assignment within assign_tasks_batch.
+type AssignTasksBatchItem struct {
+	WorkerID string `json:"worker_id"`
+	TaskID   string `json:"task_id"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// AssignTasksBatchResult is the result of the assign_tasks_batch tool.
+// OK reports that the batch was processed; each item reports its own outcome.
+type AssignTasksBatchResult struct {
+	ToolResult
+	Results  []AssignTasksBatchItem `json:"results"`
+	Assigned int                    `json:"assigned"`
+	Failed   int                    `json:"failed"`
+}
+
+// IneligibleWorkerItem is a worker that cannot take the task checked by get_eligible_workers.
+type IneligibleWorkerItem struct {
+	WorkerID string `json:"worker_id"`
+	Status   string `json:"status"`
+	Phase    string `json:"phase,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+// GetEligibleWorkersResult is the result of the get_eligible_workers tool.
+type GetEligibleWorkersResult struct {
+	ToolResult
+	TaskID     string                 `json:"task_id"`
+	Eligible   []string               `json:"eligible"`
+	Ineligible []IneligibleWorkerItem `json:"ineligible"`
+}
+
+// AssignTaskReviewResult is the result of the assign_task_review tool.
+type AssignTaskReviewResult struct {
+	ToolResult
+	TaskID       string `json:"task_id"`
+	ReviewerID   string `json:"reviewer_id"`
+	AutoSelected bool   `json:"auto_selected,omitempty"`
+	ThreadID     string `json:"thread_id,omitempty"`
+	Message      string `json:"message"`
+}
+
+// AssignReviewFeedbackResult is the result of the assign_review_feedback tool.
+// Items is the number of discrete feedback items recorded on the task (zero for free text only).
+type AssignReviewFeedbackResult struct {
+	ToolResult
+	TaskID        string `json:"task_id"`
+	ImplementerID string `json:"implementer_id"`
+	Items         int    `json:"items,omitempty"`
+	Message       string `json:"message"`
+}
+
+// TransferTaskResult is the result of the transfer_task tool.
+type TransferTaskResult struct {
+	ToolResult
+	TaskID     string `json:"task_id"`
+	FromWorker string `json:"from_worker"`
+	ToWorker   string `json:"to_worker"`
+	Message    string `json:"message"`
+}
+
+// DrainedTaskItem is the outcome of moving a single task off a worker drained by drain_worker.
+type DrainedTaskItem struct {
+	TaskID   string `json:"task_id"`
+	Outcome  string `json:"outcome"`
+	ToWorker string `json:"to_worker,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// DrainWorkerResult is the result of the drain_worker tool.
+// OK reports that the worker was drained and retired; each task reports its own outcome.
+type DrainWorkerResult struct {
+	ToolResult
+	WorkerID    string            `json:"worker_id"`
+	Tasks       []DrainedTaskItem `json:"tasks"`
+	Transferred int               `json:"transferred"`
+	Orphaned    int               `json:"orphaned"`
+}
+
+// ApproveCommitResult is the result of the approve_commit tool.
+type ApproveCommitResult struct {
+	ToolResult
+	TaskID        string `json:"task_id"`
+	ImplementerID string `json:"implementer_id"`
+	Message       string `json:"message"`
+}
+
+// MarkTaskCompleteResult is the result of the mark_task_complete tool.
+// Status is always "success" and is kept for callers of the original response shape.
+type MarkTaskCompleteResult struct {
+	ToolResult
+	TaskID  string `json:"task_id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// MarkTaskFailedResult is the result of the mark_task_failed tool.
+type MarkTaskFailedResult struct {
+	ToolResult
+	TaskID   string `json:"task_id"`
+	Category string `json:"category,omitempty"`
+	Reason   string `json:"reason"`
+	Message  string `json:"message"`
+}
+
+// AddTaskBlockerResult is the result of the add_task_blocker tool.
+type AddTaskBlockerResult struct {
+	ToolResult
+	TaskID         string `json:"task_id"`
+	BlockerID      string `json:"blocker_id"`
+	AlreadyBlocked bool   `json:"already_blocked"`
+	Message        string `json:"message"`
+}
+
+// SyncTaskStatusResult is the result of the sync_task_status tool.
+// Corrected reports whether the coordinator's record diverged from bd and was updated;
+// PreviousStatus is the status before the sync and Status the status after it.
+type SyncTaskStatusResult struct {
+	ToolResult
+	TaskID         string `json:"task_id"`
+	BeadsStatus    string `json:"beads_status"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+	Corrected      bool   `json:"corrected"`
+	// NeedsAssignment is true when the task was reopened and has no worker.
+	NeedsAssignment bool   `json:"needs_assignment,omitempty"`
+	Message         string `json:"message"`
+}
+
+// GenerateAccountabilitySummaryResult is the result of the generate_accountability_summary tool.
+type GenerateAccountabilitySummaryResult struct {
+	ToolResult
+	WorkerID string `json:"worker_id"`
+	Message  string `json:"message"`
+}
+
+// SignalWorkflowCompleteResult is the result of the signal_workflow_complete tool.
+type SignalWorkflowCompleteResult struct {
+	ToolResult
+	Status      string `json:"status"`
+	EpicID      string `json:"epic_id,omitempty"`
+	TasksClosed int    `json:"tasks_closed,omitempty"`
+	// FailuresByCategory counts the workflow's failed tasks by failure category,
+	// with failures recorded without one under "uncategorized".
+	FailuresByCategory map[string]int `json:"failures_by_category,omitempty"`
+	Message            string         `json:"message"`
+}
+
+// NotifyUserResult is the result of the notify_user tool.
+type NotifyUserResult struct {
+	ToolResult
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message"`
+}
+
+// SetGlobalInstructionResult is the result of the set_global_instruction tool.
+type SetGlobalInstructionResult struct {
+	ToolResult
+	ActiveInstructions int    `json:"active_instructions"`
+	Message            string `json:"message"`
+}
+
+// ClearGlobalInstructionResult is the result of the clear_global_instruction tool.
+type ClearGlobalInstructionResult struct {
+	ToolResult
+	Message string `json:"message"`
+}
+
+// SetFocusResult is the result of the set_focus tool.
+type SetFocusResult struct {
+	ToolResult
+	// Focus is the focus area now set; empty means it was cleared.
+	Focus   string `json:"focus"`
+	Message string `json:"message"`
+}
+
+// GetFocusResult is the result of the get_focus tool.
+type GetFocusResult struct {
+	ToolResult
+	// Focus is the current focus area; empty means none is set.
+	Focus string `json:"focus"`
+}
+
+// TaskHoldResult is the result of the hold_task and release_task tools.
+type TaskHoldResult struct {
+	ToolResult
+	TaskID    string `json:"task_id"`
+	HeldTasks int    `json:"held_tasks"`
+	Message   string `json:"message"`
+}
+
+// LabelWorkerResult is the result of the label_worker tool.
+type LabelWorkerResult struct {
+	ToolResult
+	WorkerID string `json:"worker_id"`
+	Label    string `json:"label"`
+	Message  string `json:"message"`
+}
+
+// ImportStateResult is the result of the import_state tool.
+type ImportStateResult struct {
+	ToolResult
+	WorkerAssignments int    `json:"worker_assignments"`
+	Tasks             int    `json:"tasks"`
+	Message           string `json:"message"`
+}
+
+// ExportStateResult is the result of the export_state tool.
+// The state fields are flattened alongside the envelope, so the JSON can be passed
+// back to import_state unchanged.
+type ExportStateResult struct {
+	ToolResult
+	StateExport
+}
+
+// GetDiffSinceLastReviewResult is the result of the get_diff_since_last_review tool.
+// Round is the review round of the checkpoint compared against, or zero when the task
+// has no checkpoint and Diff is the full worktree diff.
+type GetDiffSinceLastReviewResult struct {
+	ToolResult
+	TaskID   string   `json:"task_id"`
+	Round    int      `json:"round,omitempty"`
+	Changed  []string `json:"changed,omitempty"`
+	Reverted []string `json:"reverted,omitempty"`
+	Diff     string   `json:"diff"`
+}
+
+// ChangedFile is a single path reported by list_changed_files and get_changed_files.
+type ChangedFile struct {
+	Path      string `json:"path"`
+	Staged    bool   `json:"staged,omitempty"`
+	Unstaged  bool   `json:"unstaged,omitempty"`
+	Untracked bool   `json:"untracked,omitempty"`
+}
+
+// GetChangedFilesResult is the result of the list_changed_files and get_changed_files tools.
+// Files holds at most MaxChangedFiles entries; Total is the full count and Truncated
+// reports whether any were dropped.
+type GetChangedFilesResult struct {
+	ToolResult
+	TaskID    string        `json:"task_id"`
+	Files     []ChangedFile `json:"files"`
+	Total     int           `json:"total"`
+	Truncated bool          `json:"truncated,omitempty"`
+}
+
+// ConfirmationRequiredResult is returned by a destructive tool called without a
+// confirm_token while confirmation is enabled. Nothing has been changed yet.
+type ConfirmationRequiredResult struct {
+	ToolResult
+	Tool         string   `json:"tool"`
+	ConfirmToken string   `json:"confirm_token"`
+	Impact       string   `json:"impact"`
+	Affected     []string `json:"affected"`
+}
@@ -0,0 +1,579 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// decodeStructured round-trips a tool result's structured content through JSON into v.
+func decodeStructured(t *testing.T, result *mcptypes.ToolCallResult, v any) {
+	t.Helper()
+	require.NotNil(t, result.StructuredContent, "tool result should carry structured content")
+	data, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
+
+// broadcastTargetsStub is command result data for a broadcast.
+type broadcastTargetsStub struct {
+	targets []string
+}
+
+func (r *broadcastTargetsStub) GetTargetWorkers() []string { return r.targets }
+
+// batchResultStub mirrors the JSON shape of the batch assignment handler result.
+type batchResultStub struct {
+	Results  []AssignTasksBatchItem `json:"results"`
+	Assigned int                    `json:"assigned"`
+	Failed   int                    `json:"failed"`
+}
+
+// drainResultStub mirrors the JSON shape of the drain worker handler result.
+type drainResultStub struct {
+	WorkerID    string            `json:"worker_id"`
+	Tasks       []DrainedTaskItem `json:"tasks"`
+	Transferred int               `json:"transferred"`
+	Orphaned    int               `json:"orphaned"`
+}
+
+// eligibleWorkersStub implements eligibleWorkersExtractor like the eligible workers handler result.
+type eligibleWorkersStub struct {
+	eligible   []string
+	ineligible []IneligibleWorkerItem
+}
+
+func (s *eligibleWorkersStub) EligibleWorkerIDs() []string { return s.eligible }
+
+func (s *eligibleWorkersStub) IneligibleWorkerIDs() []string {
+	ids := make([]string, len(s.ineligible))
+	for i, w := range s.ineligible {
+		ids[i] = w.WorkerID
+	}
+	return ids
+}
+
+func (s *eligibleWorkersStub) IneligibilityOf(workerID string) (status, phase, reason string) {
+	for _, w := range s.ineligible {
+		if w.WorkerID == workerID {
+			return w.Status, w.Phase, w.Reason
+		}
+	}
+	return "", "", ""
+}
+
+func TestCommandToolResults_UnmarshalIntoTypedResults(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   any
+		call   func(a *V2Adapter) (*mcptypes.ToolCallResult, error)
+		target func() any
+		check  func(t *testing.T, v any)
+	}{
+		{
+			name: "spawn_worker",
+			data: &processIDResultStub{id: "worker-3"},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleSpawnProcess(context.Background(), nil)
+			},
+			target: func() any { return &SpawnWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "worker-3", v.(*SpawnWorkerResult).WorkerID)
+			},
+		},
+		{
+			name: "retire_worker",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleRetireProcess(context.Background(), json.RawMessage(`{"worker_id": "worker-1", "reason": "done"}`))
+			},
+			target: func() any { return &RetireWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "worker-1", v.(*RetireWorkerResult).WorkerID)
+			},
+		},
+		{
+			name: "replace_worker",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleReplaceProcess(context.Background(), json.RawMessage(`{"worker_id": "worker-1"}`))
+			},
+			target: func() any { return &ReplaceWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "worker-1", v.(*ReplaceWorkerResult).WorkerID)
+			},
+		},
+		{
+			name: "send_to_worker",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleSendToWorker(context.Background(), json.RawMessage(`{"worker_id": "worker-1", "message": "hi"}`))
+			},
+			target: func() any { return &SendToWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*SendToWorkerResult)
+				require.Equal(t, "worker-1", r.Recipient)
+				require.Equal(t, []string{"worker-1"}, r.WorkerIDs)
+			},
+		},
+		{
+			name: "send_to_worker broadcast",
+			data: &broadcastTargetsStub{targets: []string{"worker-2", "worker-3"}},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleSendToWorker(context.Background(), json.RawMessage(`{"worker_id": "ALL_REVIEWERS", "message": "hi"}`))
+			},
+			target: func() any { return &SendToWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*SendToWorkerResult)
+				require.Equal(t, "ALL_REVIEWERS", r.Recipient)
+				require.Equal(t, []string{"worker-2", "worker-3"}, r.WorkerIDs)
+				require.Equal(t, "Message sent to ALL_REVIEWERS (2 workers: worker-2, worker-3)", r.Message)
+			},
+		},
+		{
+			name: "send_to_worker broadcast without matches",
+			data: &broadcastTargetsStub{},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleSendToWorker(context.Background(), json.RawMessage(`{"worker_id": "ALL", "message": "hi"}`))
+			},
+			target: func() any { return &SendToWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*SendToWorkerResult)
+				require.Empty(t, r.WorkerIDs)
+				require.Equal(t, "No workers matched ALL; message not delivered", r.Message)
+			},
+		},
+		{
+			name: "assign_task",
+			data: &processIDResultStub{id: "worker-2"},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleAssignTask(context.Background(), json.RawMessage(`{"task_id": "perles-abc.1"}`))
+			},
+			target: func() any { return &AssignTaskResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*AssignTaskResult)
+				require.Equal(t, "perles-abc.1", r.TaskID)
+				require.Equal(t, "worker-2", r.WorkerID, "reports the worker the coordinator chose")
+			},
+		},
+		{
+			name: "assign_tasks_batch",
+			data: batchResultStub{
+				Results: []AssignTasksBatchItem{
+					{WorkerID: "worker-1", TaskID: "perles-abc.1", Success: true},
+					{WorkerID: "worker-2", TaskID: "perles-abc.2", Error: "worker busy"},
+				},
+				Assigned: 1,
+				Failed:   1,
+			},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleAssignTasksBatch(context.Background(), json.RawMessage(`{"assignments": [
+					{"worker_id": "worker-1", "task_id": "perles-abc.1"},
+					{"worker_id": "worker-2", "task_id": "perles-abc.2"}
+				]}`))
+			},
+			target: func() any { return &AssignTasksBatchResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*AssignTasksBatchResult)
+				require.Len(t, r.Results, 2)
+				require.Equal(t, 1, r.Assigned)
+				require.Equal(t, 1, r.Failed)
+				require.Equal(t, "worker busy", r.Results[1].Error)
+			},
+		},
+		{
+			name: "get_eligible_workers",
+			data: &eligibleWorkersStub{
+				eligible: []string{"worker-1"},
+				ineligible: []IneligibleWorkerItem{
+					{WorkerID: "worker-2", Status: "working", Phase: "implementing", Reason: "process is not ready"},
+				},
+			},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleGetEligibleWorkers(context.Background(), json.RawMessage(`{"task_id": "perles-abc.1"}`))
+			},
+			target: func() any { return &GetEligibleWorkersResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*GetEligibleWorkersResult)
+				require.Equal(t, "perles-abc.1", r.TaskID)
+				require.Equal(t, []string{"worker-1"}, r.Eligible)
+				require.Equal(t, []IneligibleWorkerItem{
+					{WorkerID: "worker-2", Status: "working", Phase: "implementing", Reason: "process is not ready"},
+				}, r.Ineligible)
+			},
+		},
+		{
+			name: "assign_task_review",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleAssignTaskReview(context.Background(), json.RawMessage(
+					`{"reviewer_id": "worker-2", "task_id": "perles-abc.1", "implementer_id": "worker-1"}`))
+			},
+			target: func() any { return &AssignTaskReviewResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "worker-2", v.(*AssignTaskReviewResult).ReviewerID)
+			},
+		},
+		{
+			name: "assign_review_feedback",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleAssignReviewFeedback(context.Background(), json.RawMessage(
+					`{"implementer_id": "worker-1", "task_id": "perles-abc.1", "feedback": "fix the tests"}`))
+			},
+			target: func() any { return &AssignReviewFeedbackResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "worker-1", v.(*AssignReviewFeedbackResult).ImplementerID)
+			},
+		},
+		{
+			name: "transfer_task",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleTransferTask(context.Background(), json.RawMessage(
+					`{"task_id": "perles-abc.1", "from_worker": "worker-1", "to_worker": "worker-2"}`))
+			},
+			target: func() any { return &TransferTaskResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*TransferTaskResult)
+				require.Equal(t, "worker-1", r.FromWorker)
+				require.Equal(t, "worker-2", r.ToWorker)
+			},
+		},
+		{
+			name: "drain_worker",
+			data: drainResultStub{
+				WorkerID: "worker-1",
+				Tasks: []DrainedTaskItem{
+					{TaskID: "perles-abc.1", Outcome: "transferred", ToWorker: "worker-2"},
+					{TaskID: "perles-abc.2", Outcome: "orphaned", Reason: "no ready worker to take over the task"},
+				},
+				Transferred: 1,
+				Orphaned:    1,
+			},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleDrainWorker(context.Background(), json.RawMessage(`{"worker_id": "worker-1"}`))
+			},
+			target: func() any { return &DrainWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*DrainWorkerResult)
+				require.Equal(t, "worker-1", r.WorkerID)
+				require.Len(t, r.Tasks, 2)
+				require.Equal(t, "worker-2", r.Tasks[0].ToWorker)
+				require.Equal(t, 1, r.Orphaned)
+			},
+		},
+		{
+			name: "approve_commit",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleApproveCommit(context.Background(), json.RawMessage(
+					`{"implementer_id": "worker-1", "task_id": "perles-abc.1"}`))
+			},
+			target: func() any { return &ApproveCommitResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "perles-abc.1", v.(*ApproveCommitResult).TaskID)
+			},
+		},
+		{
+			name: "mark_task_complete",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleMarkTaskComplete(context.Background(), json.RawMessage(`{"task_id": "perles-abc.1"}`))
+			},
+			target: func() any { return &MarkTaskCompleteResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*MarkTaskCompleteResult)
+				require.Equal(t, "perles-abc.1", r.TaskID)
+				require.Equal(t, "success", r.Status)
+			},
+		},
+		{
+			name: "mark_task_failed",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleMarkTaskFailed(context.Background(), json.RawMessage(
+					`{"task_id": "perles-abc.1", "reason": "flaky CI", "category": "test_failure"}`))
+			},
+			target: func() any { return &MarkTaskFailedResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*MarkTaskFailedResult)
+				require.Equal(t, "test_failure", r.Category)
+				require.Equal(t, "flaky CI", r.Reason)
+			},
+		},
+		{
+			name: "add_task_blocker",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleAddTaskBlocker(context.Background(), json.RawMessage(
+					`{"task_id": "perles-abc.1", "blocker_id": "perles-bug1"}`))
+			},
+			target: func() any { return &AddTaskBlockerResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*AddTaskBlockerResult)
+				require.Equal(t, "perles-bug1", r.BlockerID)
+				require.False(t, r.AlreadyBlocked)
+			},
+		},
+		{
+			name: "complete_epic_tasks",
+			data: &fakeEpicTasksResult{closed: []string{"perles-abc.1"}},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleCompleteEpicTasks(context.Background(), json.RawMessage(`{"epic_id": "perles-abc"}`))
+			},
+			target: func() any { return &CompleteEpicTasksResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*CompleteEpicTasksResult)
+				require.Equal(t, 1, r.ClosedCount)
+				require.Equal(t, []string{"perles-abc.1"}, r.Closed)
+			},
+		},
+		{
+			name: "signal_workflow_complete",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleSignalWorkflowComplete(context.Background(), json.RawMessage(
+					`{"status": "success", "summary": "all done", "tasks_closed": 3}`))
+			},
+			target: func() any { return &SignalWorkflowCompleteResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*SignalWorkflowCompleteResult)
+				require.Equal(t, "success", r.Status)
+				require.Equal(t, 3, r.TasksClosed)
+			},
+		},
+		{
+			name: "notify_user",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleNotifyUser(context.Background(), json.RawMessage(`{"message": "please review", "phase": "design"}`))
+			},
+			target: func() any { return &NotifyUserResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "design", v.(*NotifyUserResult).Phase)
+			},
+		},
+		{
+			name: "set_global_instruction",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleSetGlobalInstruction(context.Background(), json.RawMessage(`{"instruction": "do not touch the schema"}`))
+			},
+			target: func() any { return &SetGlobalInstructionResult{} },
+		},
+		{
+			name: "clear_global_instruction",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleClearGlobalInstruction(context.Background(), nil)
+			},
+			target: func() any { return &ClearGlobalInstructionResult{} },
+		},
+		{
+			name: "set_focus",
+			data: &stubFocusResult{focus: "the parser"},
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleSetFocus(context.Background(), json.RawMessage(`{"focus": "the parser"}`))
+			},
+			target: func() any { return &SetFocusResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "the parser", v.(*SetFocusResult).Focus)
+			},
+		},
+		{
+			name: "hold_task",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleHoldTask(context.Background(), json.RawMessage(`{"task_id": "perles-abc1.2"}`))
+			},
+			target: func() any { return &TaskHoldResult{} },
+		},
+		{
+			name: "release_task",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleReleaseTask(context.Background(), json.RawMessage(`{"task_id": "perles-abc1.2"}`))
+			},
+			target: func() any { return &TaskHoldResult{} },
+		},
+		{
+			name: "import_state",
+			call: func(a *V2Adapter) (*mcptypes.ToolCallResult, error) {
+				return a.HandleImportState(context.Background(), json.RawMessage(
+					`{"state": {"version": 1, "worker_assignments": [], "task_assignments": []}}`))
+			},
+			target: func() any { return &ImportStateResult{} },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter, handler, cleanup := testAdapter(t)
+			defer cleanup()
+			if tt.data != nil {
+				handler.returnResult = &command.CommandResult{Success: true, Data: tt.data}
+			}
+
+			result, err := tt.call(adapter)
+			require.NoError(t, err)
+			require.False(t, result.IsError, result.Content[0].Text)
+
+			v := tt.target()
+			decodeStructured(t, result, v)
+
+			var envelope ToolResult
+			decodeStructured(t, result, &envelope)
+			require.True(t, envelope.OK)
+			require.Empty(t, envelope.Error)
+
+			if tt.check != nil {
+				tt.check(t, v)
+			}
+		})
+	}
+}
+
+func TestCommandToolResults_FailureCarriesEnvelope(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+	handler.returnErr = errors.New("worker not found: worker-9")
+
+	result, err := adapter.HandleAssignTask(context.Background(), json.RawMessage(`{"worker_id": "worker-9", "task_id": "perles-abc.1"}`))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+
+	var typed AssignTaskResult
+	decodeStructured(t, result, &typed)
+	require.False(t, typed.OK)
+	require.Contains(t, typed.Error, "worker not found: worker-9")
+	require.Equal(t, typed.Error, result.Content[0].Text)
+}
+
+func TestReadOnlyToolResults_UnmarshalIntoTypedResults(t *testing.T) {
+	now := time.Now()
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{
+		ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Focus: "the parser",
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		TaskID: "perles-abc.1", CreatedAt: now, LastActivityAt: now,
+	})
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc.1", Status: repository.TaskImplementing, Implementer: "worker-1", StartedAt: now,
+	}))
+	adapter := NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+
+	tests := []struct {
+		name   string
+		call   func() (*mcptypes.ToolCallResult, error)
+		target func() any
+		check  func(t *testing.T, v any)
+	}{
+		{
+			name: "query_worker_state",
+			call: func() (*mcptypes.ToolCallResult, error) {
+				return adapter.HandleQueryWorkerState(context.Background(), nil)
+			},
+			target: func() any { return &QueryWorkerStateResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*QueryWorkerStateResult)
+				require.Len(t, r.Workers, 1)
+				require.Contains(t, r.Tasks, "perles-abc.1")
+			},
+		},
+		{
+			name: "ping_worker",
+			call: func() (*mcptypes.ToolCallResult, error) {
+				return adapter.HandlePingWorker(context.Background(), json.RawMessage(`{"worker_id": "worker-1"}`))
+			},
+			target: func() any { return &PingWorkerResult{} },
+			check: func(t *testing.T, v any) {
+				require.True(t, v.(*PingWorkerResult).Alive)
+			},
+		},
+		{
+			name: "list_orphaned_tasks",
+			call: func() (*mcptypes.ToolCallResult, error) {
+				return adapter.HandleListOrphanedTasks(context.Background(), nil)
+			},
+			target: func() any { return &ListOrphanedTasksResult{} },
+			check: func(t *testing.T, v any) {
+				require.Empty(t, v.(*ListOrphanedTasksResult).OrphanedTasks)
+			},
+		},
+		{
+			name: "get_task_timings",
+			call: func() (*mcptypes.ToolCallResult, error) {
+				return adapter.HandleGetTaskTimings(context.Background(), json.RawMessage(`{"task_id": "perles-abc.1"}`))
+			},
+			target: func() any { return &GetTaskTimingsResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "perles-abc.1", v.(*GetTaskTimingsResult).TaskID)
+			},
+		},
+		{
+			name: "get_headroom",
+			call: func() (*mcptypes.ToolCallResult, error) {
+				return adapter.HandleGetHeadroom(context.Background(), nil)
+			},
+			target: func() any { return &GetHeadroomResult{} },
+			check: func(t *testing.T, v any) {
+				require.Zero(t, v.(*GetHeadroomResult).Headroom)
+			},
+		},
+		{
+			name: "get_focus",
+			call: func() (*mcptypes.ToolCallResult, error) {
+				return adapter.HandleGetFocus(context.Background(), nil)
+			},
+			target: func() any { return &GetFocusResult{} },
+			check: func(t *testing.T, v any) {
+				require.Equal(t, "the parser", v.(*GetFocusResult).Focus)
+			},
+		},
+		{
+			name: "export_state",
+			call: func() (*mcptypes.ToolCallResult, error) {
+				return adapter.HandleExportState(context.Background(), nil)
+			},
+			target: func() any { return &ExportStateResult{} },
+			check: func(t *testing.T, v any) {
+				r := v.(*ExportStateResult)
+				require.Equal(t, StateExportVersion, r.Version)
+				require.Len(t, r.TaskAssignments, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.call()
+			require.NoError(t, err)
+			require.False(t, result.IsError, result.Content[0].Text)
+
+			// JSON tools return the typed result as both text and structured content
+			v := tt.target()
+			require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), v))
+			decodeStructured(t, result, tt.target())
+
+			var envelope ToolResult
+			require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &envelope))
+			require.True(t, envelope.OK)
+
+			tt.check(t, v)
+		})
+	}
+}
+
+func TestExportStateResult_RoundTripsThroughImport(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	exporter := NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+
+	exported, err := exporter.HandleExportState(context.Background(), nil)
+	require.NoError(t, err)
+
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	args, err := json.Marshal(map[string]json.RawMessage{"state": json.RawMessage(exported.Content[0].Text)})
+	require.NoError(t, err)
+	result, err := adapter.HandleImportState(context.Background(), args)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].Text)
+}
@@ -0,0 +1,171 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// WithGitExecutor sets the git executor used for read-only diff queries
+// (get_diff_since_last_review).
+func WithGitExecutor(executor appgit.GitExecutor) Option {
+	return func(a *V2Adapter) {
+		a.gitExecutor = executor
+	}
+}
+
+// getDiffSinceLastReviewArgs holds arguments for get_diff_since_last_review tool.
+type getDiffSinceLastReviewArgs struct {
+	TaskID string `json:"task_id,omitempty"`
+}
+
+// HandleGetDiffSinceLastReview handles the get_diff_since_last_review MCP tool call.
+// It compares the current worktree diff against the checkpoint captured at the task's
+// last review verdict and returns only the files whose changes differ.
+//
+// This is a read-only operation. If task_id is omitted, the task currently assigned
+// to workerID is used (workerID is empty for coordinator calls).
+func (a *V2Adapter) HandleGetDiffSinceLastReview(_ context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	if a.taskRepo == nil {
+		return nil, fmt.Errorf("task repository not configured for read-only operations")
+	}
+	if a.gitExecutor == nil {
+		return nil, fmt.Errorf("git executor not configured")
+	}
+
+	var parsed getDiffSinceLastReviewArgs
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &parsed); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	var task *repository.TaskAssignment
+	var err error
+	switch {
+	case parsed.TaskID != "":
+		task, err = a.taskRepo.Get(parsed.TaskID)
+	case workerID != "":
+		task, err = a.taskRepo.GetByWorker(workerID)
+	default:
+		return nil, fmt.Errorf("task_id is required")
+	}
+	if err != nil {
+		return errorResult(fmt.Sprintf("task not found: %v", err)), nil
+	}
+
+	current, err := a.gitExecutor.GetWorkingDirDiff()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree diff: %w", err)
+	}
+
+	response := GetDiffSinceLastReviewResult{ToolResult: okResult(), TaskID: task.TaskID}
+
+	checkpoint := task.DiffCheckpoint
+	if checkpoint == nil {
+		response.Diff = current
+		return messageResult(fmt.Sprintf(
+			"No review checkpoint for task %s yet; showing the full diff.\n\n%s", task.TaskID, current), response), nil
+	}
+
+	response.Round = checkpoint.Round
+	if repository.HashDiff(current) == checkpoint.Hash {
+		return messageResult(fmt.Sprintf(
+			"No changes to task %s since review round %d.", task.TaskID, checkpoint.Round), response), nil
+	}
+
+	delta := diffDelta(checkpoint.Diff, current)
+	response.Changed = delta.changed
+	response.Reverted = delta.reverted
+	response.Diff = delta.diff
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Changes to task %s since review round %d (%s):\n",
+		task.TaskID, checkpoint.Round, checkpoint.CapturedAt.Format("2006-01-02T15:04:05Z07:00"))
+	if len(delta.changed) > 0 {
+		fmt.Fprintf(&sb, "Files changed since last review: %s\n", strings.Join(delta.changed, ", "))
+	}
+	if len(delta.reverted) > 0 {
+		fmt.Fprintf(&sb, "Files reverted since last review (no longer in diff): %s\n", strings.Join(delta.reverted, ", "))
+	}
+	if delta.diff != "" {
+		sb.WriteString("\n")
+		sb.WriteString(delta.diff)
+	}
+
+	return messageResult(sb.String(), response), nil
+}
+
+// reviewDelta is the per-file difference between two unified diffs.
+type reviewDelta struct {
+	changed  []string // Files whose diff section is new or differs
+	reverted []string // Files present in the old diff but not the new one
+	diff     string   // Current diff sections for changed files
+}
+
+// diffDelta compares two unified diffs file by file.
+func diffDelta(previous, current string) reviewDelta {
+	prevFiles, prevOrder := splitDiffByFile(previous)
+	currFiles, order := splitDiffByFile(current)
+
+	var delta reviewDelta
+	var sb strings.Builder
+	for _, path := range order {
+		section := currFiles[path]
+		if prevFiles[path] == section {
+			continue
+		}
+		delta.changed = append(delta.changed, path)
+		sb.WriteString(section)
+	}
+	for _, path := range prevOrder {
+		if _, ok := currFiles[path]; !ok {
+			delta.reverted = append(delta.reverted, path)
+		}
+	}
+	delta.diff = sb.String()
+	return delta
+}
+
+// splitDiffByFile splits a unified diff into per-file sections keyed by path,
+// returning the paths in their original order. Sections start at "diff --git" lines.
+func splitDiffByFile(diff string) (map[string]string, []string) {
+	sections := make(map[string]string)
+	var order []string
+	var path string
+	var sb strings.Builder
+
+	flush := func() {
+		if path != "" {
+			sections[path] = sb.String()
+			order = append(order, path)
+		}
+		sb.Reset()
+	}
+
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			flush()
+			path = diffPath(line)
+		}
+		if path != "" {
+			sb.WriteString(line)
+		}
+	}
+	flush()
+	return sections, order
+}
+
+// diffPath extracts the destination path from a "diff --git a/x b/x" header.
+func diffPath(header string) string {
+	header = strings.TrimSpace(strings.TrimPrefix(header, "diff --git "))
+	if i := strings.LastIndex(header, " b/"); i >= 0 {
+		return header[i+3:]
+	}
+	return header
+}
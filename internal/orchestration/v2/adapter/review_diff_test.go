@@ -0,0 +1,189 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+const (
+	diffA1 = "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-old\n+first attempt\n"
+	diffA2 = "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-old\n+second attempt\n"
+	diffB  = "diff --git a/b.go b/b.go\n--- a/b.go\n+++ b/b.go\n@@ -1 +1 @@\n-x\n+y\n"
+	diffC  = "diff --git a/c.go b/c.go\nnew file mode 100644\n--- /dev/null\n+++ b/c.go\n@@ -0,0 +1 @@\n+added\n"
+)
+
+// seedReviewedTask stores a task reviewed once with the given checkpoint diff.
+func seedReviewedTask(t *testing.T, checkpointDiff string) *repository.MemoryTaskRepository {
+	t.Helper()
+	taskRepo := repository.NewMemoryTaskRepository()
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:         "perles-abc1",
+		Implementer:    "worker-1",
+		Reviewer:       "worker-2",
+		Status:         repository.TaskInReview,
+		DiffCheckpoint: repository.NewDiffCheckpoint(checkpointDiff, 1),
+	})
+	return taskRepo
+}
+
+func TestHandleGetDiffSinceLastReview_ReturnsOnlyDelta(t *testing.T) {
+	taskRepo := seedReviewedTask(t, diffA1+diffB)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return(diffA2+diffB+diffC, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetDiffSinceLastReview(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	text := result.Content[0].Text
+	assert.Contains(t, text, "since review round 1")
+	assert.Contains(t, text, "Files changed since last review: a.go, c.go")
+	assert.Contains(t, text, "+second attempt")
+	assert.Contains(t, text, "+added")
+	assert.NotContains(t, text, "+first attempt")
+	assert.NotContains(t, text, "b/b.go", "unchanged file should be omitted")
+}
+
+func TestHandleGetDiffSinceLastReview_ReportsRevertedFiles(t *testing.T) {
+	taskRepo := seedReviewedTask(t, diffA1+diffB)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return(diffA1, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetDiffSinceLastReview(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+
+	require.NoError(t, err)
+	text := result.Content[0].Text
+	assert.Contains(t, text, "Files reverted since last review (no longer in diff): b.go")
+	assert.NotContains(t, text, "Files changed since last review")
+}
+
+func TestHandleGetDiffSinceLastReview_NoChanges(t *testing.T) {
+	taskRepo := seedReviewedTask(t, diffA1)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return(diffA1, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetDiffSinceLastReview(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].Text, "No changes to task perles-abc1 since review round 1")
+}
+
+func TestHandleGetDiffSinceLastReview_EvolvingDiffsAcrossRounds(t *testing.T) {
+	taskRepo := seedReviewedTask(t, diffA1)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return(diffA2, nil).Once()
+	gitExec.EXPECT().GetWorkingDirDiff().Return(diffA2+diffC, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo), WithGitExecutor(gitExec))
+	defer cleanup()
+	args := toJSON(t, map[string]string{"task_id": "perles-abc1"})
+
+	// Round 2 review sees the rework of a.go
+	result, err := adapter.HandleGetDiffSinceLastReview(context.Background(), args, "")
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].Text, "Files changed since last review: a.go")
+
+	// A second verdict checkpoints the round 2 diff
+	task, _ := taskRepo.Get("perles-abc1")
+	task.DiffCheckpoint = repository.NewDiffCheckpoint(diffA2, 2)
+	_ = taskRepo.Save(task)
+
+	// Round 3 review sees only the newly added file
+	result, err = adapter.HandleGetDiffSinceLastReview(context.Background(), args, "")
+	require.NoError(t, err)
+	text := result.Content[0].Text
+	assert.Contains(t, text, "since review round 2")
+	assert.Contains(t, text, "Files changed since last review: c.go")
+	assert.NotContains(t, text, "second attempt")
+}
+
+func TestHandleGetDiffSinceLastReview_NoCheckpointReturnsFullDiff(t *testing.T) {
+	taskRepo := repository.NewMemoryTaskRepository()
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1", Implementer: "worker-1"})
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return(diffA1+diffB, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetDiffSinceLastReview(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+
+	require.NoError(t, err)
+	text := result.Content[0].Text
+	assert.Contains(t, text, "No review checkpoint for task perles-abc1 yet")
+	assert.Contains(t, text, "+first attempt")
+	assert.Contains(t, text, "b/b.go")
+}
+
+func TestHandleGetDiffSinceLastReview_ResolvesWorkerTask(t *testing.T) {
+	taskRepo := seedReviewedTask(t, diffA1)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return(diffA1, nil).Once()
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo), WithGitExecutor(gitExec))
+	defer cleanup()
+
+	result, err := adapter.HandleGetDiffSinceLastReview(context.Background(), nil, "worker-2")
+
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].Text, "perles-abc1")
+}
+
+func TestHandleGetDiffSinceLastReview_Errors(t *testing.T) {
+	t.Run("missing_task_id_for_coordinator", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t,
+			WithTaskRepository(repository.NewMemoryTaskRepository()),
+			WithGitExecutor(mocks.NewMockGitExecutor(t)))
+		defer cleanup()
+
+		_, err := adapter.HandleGetDiffSinceLastReview(context.Background(), nil, "")
+		require.ErrorContains(t, err, "task_id is required")
+	})
+
+	t.Run("unknown_task", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t,
+			WithTaskRepository(repository.NewMemoryTaskRepository()),
+			WithGitExecutor(mocks.NewMockGitExecutor(t)))
+		defer cleanup()
+
+		result, err := adapter.HandleGetDiffSinceLastReview(context.Background(), toJSON(t, map[string]string{"task_id": "nope"}), "")
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("git_not_configured", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t, WithTaskRepository(repository.NewMemoryTaskRepository()))
+		defer cleanup()
+
+		_, err := adapter.HandleGetDiffSinceLastReview(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1"}), "")
+		require.ErrorContains(t, err, "git executor not configured")
+	})
+}
+
+func TestSplitDiffByFile(t *testing.T) {
+	sections, order := splitDiffByFile(diffA1 + diffB + diffC)
+
+	require.Equal(t, []string{"a.go", "b.go", "c.go"}, order)
+	assert.Equal(t, diffA1, sections["a.go"])
+	assert.Equal(t, diffB, sections["b.go"])
+	assert.Equal(t, diffC, sections["c.go"])
+
+	sections, order = splitDiffByFile("")
+	assert.Empty(t, sections)
+	assert.Empty(t, order)
+}
@@ -0,0 +1,71 @@
+package adapter
+
+import "sort"
+
+// ProcessSnapshot is the replay-relevant state of a single process.
+// Timestamps, session IDs and metrics are omitted so snapshots compare deterministically.
+type ProcessSnapshot struct {
+	ID     string `json:"id"`
+	Role   string `json:"role"`
+	Status string `json:"status"`
+	Phase  string `json:"phase,omitempty"`
+	TaskID string `json:"task_id,omitempty"`
+}
+
+// TaskSnapshot is the replay-relevant state of a single task assignment.
+type TaskSnapshot struct {
+	TaskID      string `json:"task_id"`
+	Status      string `json:"status"`
+	Implementer string `json:"implementer,omitempty"`
+	Reviewer    string `json:"reviewer,omitempty"`
+}
+
+// StateSnapshot captures process and task state at a point in time.
+// Entries are sorted by ID so two snapshots of the same state are equal.
+type StateSnapshot struct {
+	Processes []ProcessSnapshot `json:"processes"`
+	Tasks     []TaskSnapshot    `json:"tasks"`
+}
+
+// Snapshot returns the current process and task state from the adapter's repositories.
+// Repositories that are not configured contribute no entries.
+func (a *V2Adapter) Snapshot() StateSnapshot {
+	snap := StateSnapshot{
+		Processes: []ProcessSnapshot{},
+		Tasks:     []TaskSnapshot{},
+	}
+
+	if a.processRepo != nil {
+		for _, proc := range a.processRepo.List() {
+			ps := ProcessSnapshot{
+				ID:     proc.ID,
+				Role:   string(proc.Role),
+				Status: string(proc.Status),
+				TaskID: proc.TaskID,
+			}
+			if proc.Phase != nil {
+				ps.Phase = string(*proc.Phase)
+			}
+			snap.Processes = append(snap.Processes, ps)
+		}
+		sort.Slice(snap.Processes, func(i, j int) bool {
+			return snap.Processes[i].ID < snap.Processes[j].ID
+		})
+	}
+
+	if a.taskRepo != nil {
+		for _, task := range a.taskRepo.All() {
+			snap.Tasks = append(snap.Tasks, TaskSnapshot{
+				TaskID:      task.TaskID,
+				Status:      string(task.Status),
+				Implementer: task.Implementer,
+				Reviewer:    task.Reviewer,
+			})
+		}
+		sort.Slice(snap.Tasks, func(i, j int) bool {
+			return snap.Tasks[i].TaskID < snap.Tasks[j].TaskID
+		})
+	}
+
+	return snap
+}
@@ -0,0 +1,276 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// StateExportVersion is the format version written by export_state and accepted by import_state.
+const StateExportVersion = 1
+
+// StateExport is the coordinator state returned by export_state and accepted by import_state.
+// Unlike StateSnapshot it keeps each task's full history so it can be restored.
+type StateExport struct {
+	Version            int                      `json:"version"`
+	ExportedAt         time.Time                `json:"exported_at"`
+	WorkerAssignments  []WorkerAssignmentExport `json:"worker_assignments"`
+	TaskAssignments    []TaskAssignmentExport   `json:"task_assignments"`
+	GlobalInstructions []string                 `json:"global_instructions"`
+	HeldTasks          map[string]string        `json:"held_tasks"`
+}
+
+// WorkerAssignmentExport is an active worker's current task assignment.
+// Status is informational; import_state keeps the live worker's status.
+type WorkerAssignmentExport struct {
+	WorkerID string `json:"worker_id"`
+	TaskID   string `json:"task_id"`
+	Phase    string `json:"phase"`
+	Status   string `json:"status,omitempty"`
+}
+
+// TaskAssignmentExport is a task assignment along with its review, transfer and failure history.
+type TaskAssignmentExport struct {
+	TaskID            string                       `json:"task_id"`
+	Status            string                       `json:"status"`
+	Implementer       string                       `json:"implementer,omitempty"`
+	Reviewer          string                       `json:"reviewer,omitempty"`
+	StartedAt         time.Time                    `json:"started_at"`
+	EstimateMinutes   int                          `json:"estimate_minutes,omitempty"`
+	ReviewStartedAt   time.Time                    `json:"review_started_at"`
+	ThreadID          string                       `json:"thread_id,omitempty"`
+	ReviewRounds      int                          `json:"review_rounds,omitempty"`
+	TransferredFrom   string                       `json:"transferred_from,omitempty"`
+	TransferNote      string                       `json:"transfer_note,omitempty"`
+	HandoffFiles      []string                     `json:"handoff_files,omitempty"`
+	Checkpoints       []string                     `json:"checkpoints,omitempty"`
+	BaseTree          string                       `json:"base_tree,omitempty"`
+	FailureCategory   string                       `json:"failure_category,omitempty"`
+	FailureReason     string                       `json:"failure_reason,omitempty"`
+	CompletionSummary string                       `json:"completion_summary,omitempty"`
+	Instructions      string                       `json:"instructions,omitempty"`
+	BlockedBy         []string                     `json:"blocked_by,omitempty"`
+	FeedbackItems     []repository.FeedbackItem    `json:"feedback_items,omitempty"`
+	PhaseHistory      []repository.PhaseTransition `json:"phase_history,omitempty"`
+	TestResults       *repository.TestResults      `json:"test_results,omitempty"`
+	DiffCheckpoint    *repository.DiffCheckpoint   `json:"diff_checkpoint,omitempty"`
+}
+
+// importStateArgs holds arguments for import_state tool.
+type importStateArgs struct {
+	State *StateExport `json:"state"`
+}
+
+// importStateResultExtractor is an interface for results that report how much state was restored.
+type importStateResultExtractor interface {
+	GetImportedCounts() (workers, tasks int)
+}
+
+// ExportState returns the current worker assignments, task assignments, global
+// instructions and held tasks. Entries are sorted by ID. Retired and failed workers are omitted, as are
+// workers without a task.
+func (a *V2Adapter) ExportState() StateExport {
+	export := StateExport{
+		Version:            StateExportVersion,
+		ExportedAt:         a.Now(),
+		WorkerAssignments:  []WorkerAssignmentExport{},
+		TaskAssignments:    []TaskAssignmentExport{},
+		GlobalInstructions: []string{},
+		HeldTasks:          map[string]string{},
+	}
+
+	if a.processRepo != nil {
+		for _, proc := range a.processRepo.ActiveWorkers() {
+			if proc.TaskID == "" {
+				continue
+			}
+			wa := WorkerAssignmentExport{
+				WorkerID: proc.ID,
+				TaskID:   proc.TaskID,
+				Status:   string(proc.Status),
+			}
+			if proc.Phase != nil {
+				wa.Phase = string(*proc.Phase)
+			}
+			export.WorkerAssignments = append(export.WorkerAssignments, wa)
+		}
+		sort.Slice(export.WorkerAssignments, func(i, j int) bool {
+			return export.WorkerAssignments[i].WorkerID < export.WorkerAssignments[j].WorkerID
+		})
+
+		if coord, err := a.processRepo.GetCoordinator(); err == nil {
+			export.GlobalInstructions = append(export.GlobalInstructions, coord.GlobalInstructions...)
+			maps.Copy(export.HeldTasks, coord.HeldTasks)
+		}
+	}
+
+	if a.taskRepo != nil {
+		for _, task := range a.taskRepo.All() {
+			export.TaskAssignments = append(export.TaskAssignments, TaskAssignmentExport{
+				TaskID:            task.TaskID,
+				Status:            string(task.Status),
+				Implementer:       task.Implementer,
+				Reviewer:          task.Reviewer,
+				StartedAt:         task.StartedAt,
+				EstimateMinutes:   task.EstimateMinutes,
+				ReviewStartedAt:   task.ReviewStartedAt,
+				ThreadID:          task.ThreadID,
+				ReviewRounds:      task.ReviewRounds,
+				TransferredFrom:   task.TransferredFrom,
+				TransferNote:      task.TransferNote,
+				HandoffFiles:      task.HandoffFiles,
+				Checkpoints:       task.Checkpoints,
+				BaseTree:          task.BaseTree,
+				FailureCategory:   string(task.FailureCategory),
+				FailureReason:     task.FailureReason,
+				CompletionSummary: task.CompletionSummary,
+				Instructions:      task.Instructions,
+				BlockedBy:         task.BlockedBy,
+				FeedbackItems:     task.FeedbackItems,
+				PhaseHistory:      task.PhaseHistory,
+				TestResults:       task.TestResults,
+				DiffCheckpoint:    task.DiffCheckpoint,
+			})
+		}
+		sort.Slice(export.TaskAssignments, func(i, j int) bool {
+			return export.TaskAssignments[i].TaskID < export.TaskAssignments[j].TaskID
+		})
+	}
+
+	return export
+}
+
+// HandleExportState handles the export_state MCP tool call.
+// Returns the coordinator state as JSON that can later be passed to import_state.
+func (a *V2Adapter) HandleExportState(_ context.Context, _ json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil || a.taskRepo == nil {
+		return nil, fmt.Errorf("process and task repositories not configured for read-only operations")
+	}
+
+	return jsonResult(ExportStateResult{ToolResult: okResult(), StateExport: a.ExportState()})
+}
+
+// HandleImportState handles the import_state MCP tool call.
+// The state must come from export_state; it is rejected if it references workers that
+// are not active in the current pool.
+func (a *V2Adapter) HandleImportState(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed importStateArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd, err := newImportStateCommand(command.SourceMCPTool, parsed.State)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("import_state command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	workers, taskCount := importedCounts(cmd, result)
+	msg := fmt.Sprintf("State imported: %d worker assignments and %d tasks restored.", workers, taskCount)
+	return messageResult(msg, ImportStateResult{
+		ToolResult:        okResult(),
+		WorkerAssignments: workers,
+		Tasks:             taskCount,
+		Message:           msg,
+	}), nil
+}
+
+// ImportState restores coordinator state captured by ExportState, e.g. the snapshot
+// persisted at shutdown when a workflow is resumed. Returns the number of worker
+// assignments and tasks restored.
+func (a *V2Adapter) ImportState(ctx context.Context, state *StateExport) (workers, tasks int, err error) {
+	cmd, err := newImportStateCommand(command.SourceInternal, state)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return 0, 0, fmt.Errorf("import_state command failed: %w", err)
+	}
+	if !result.Success {
+		return 0, 0, result.Error
+	}
+
+	workers, tasks = importedCounts(cmd, result)
+	return workers, tasks, nil
+}
+
+// newImportStateCommand converts exported state into a validated ImportStateCommand.
+func newImportStateCommand(source command.CommandSource, state *StateExport) (*command.ImportStateCommand, error) {
+	if state == nil {
+		return nil, fmt.Errorf("state is required")
+	}
+	if state.Version != StateExportVersion {
+		return nil, fmt.Errorf("unsupported state version %d (expected %d)", state.Version, StateExportVersion)
+	}
+
+	workerAssignments := make([]command.WorkerAssignmentState, 0, len(state.WorkerAssignments))
+	for _, wa := range state.WorkerAssignments {
+		workerAssignments = append(workerAssignments, command.WorkerAssignmentState{
+			WorkerID: wa.WorkerID,
+			TaskID:   wa.TaskID,
+			Phase:    events.ProcessPhase(wa.Phase),
+		})
+	}
+
+	tasks := make([]*repository.TaskAssignment, 0, len(state.TaskAssignments))
+	for _, t := range state.TaskAssignments {
+		tasks = append(tasks, &repository.TaskAssignment{
+			TaskID:            t.TaskID,
+			Status:            repository.TaskStatus(t.Status),
+			Implementer:       t.Implementer,
+			Reviewer:          t.Reviewer,
+			StartedAt:         t.StartedAt,
+			EstimateMinutes:   t.EstimateMinutes,
+			ReviewStartedAt:   t.ReviewStartedAt,
+			ThreadID:          t.ThreadID,
+			ReviewRounds:      t.ReviewRounds,
+			TransferredFrom:   t.TransferredFrom,
+			TransferNote:      t.TransferNote,
+			HandoffFiles:      t.HandoffFiles,
+			Checkpoints:       t.Checkpoints,
+			BaseTree:          t.BaseTree,
+			FailureCategory:   repository.FailureCategory(t.FailureCategory),
+			FailureReason:     t.FailureReason,
+			CompletionSummary: t.CompletionSummary,
+			Instructions:      t.Instructions,
+			BlockedBy:         t.BlockedBy,
+			FeedbackItems:     t.FeedbackItems,
+			PhaseHistory:      t.PhaseHistory,
+			TestResults:       t.TestResults,
+			DiffCheckpoint:    t.DiffCheckpoint,
+		})
+	}
+
+	cmd := command.NewImportStateCommand(source, workerAssignments, tasks, state.GlobalInstructions, state.HeldTasks)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("import_state command validation failed: %w", err)
+	}
+	return cmd, nil
+}
+
+// importedCounts returns how many worker assignments and tasks an import restored.
+func importedCounts(cmd *command.ImportStateCommand, result *command.CommandResult) (workers, tasks int) {
+	workers, tasks = len(cmd.WorkerAssignments), len(cmd.Tasks)
+	if v, ok := result.Data.(importStateResultExtractor); ok {
+		workers, tasks = v.GetImportedCounts()
+	}
+	return workers, tasks
+}
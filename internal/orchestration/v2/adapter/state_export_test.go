@@ -0,0 +1,130 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func TestHandleExportState_IncludesAssignmentsAndHistory(t *testing.T) {
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{
+		ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady,
+		GlobalInstructions: []string{"The DB migration is frozen"},
+		HeldTasks:          map[string]string{"perles-abc.3": "waiting on design"},
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		Phase: ptr(events.ProcessPhaseAddressingFeedback), TaskID: "perles-abc.1",
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: ptr(events.ProcessPhaseIdle),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusRetired,
+		Phase: ptr(events.ProcessPhaseImplementing), TaskID: "perles-abc.2",
+	})
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc.2", Status: repository.TaskFailed, Implementer: "worker-3",
+		FailureCategory: "blocked", FailureReason: "missing credentials",
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc.1", Status: repository.TaskDenied, Implementer: "worker-2",
+		StartedAt: started, ReviewRounds: 2, TransferredFrom: "worker-3",
+		TestResults: &repository.TestResults{Passed: 3, Failed: 1, ReportedAt: started},
+		Checkpoints: []string{"abc123"}, BaseTree: "def456", CompletionSummary: "Added retry to the parser",
+	}))
+
+	adapter := NewV2Adapter(nil, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+	result, err := adapter.HandleExportState(context.Background(), nil)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var export StateExport
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &export))
+	assert.Equal(t, StateExportVersion, export.Version)
+	assert.Equal(t, []WorkerAssignmentExport{
+		{WorkerID: "worker-2", TaskID: "perles-abc.1", Phase: "addressing_feedback", Status: "working"},
+	}, export.WorkerAssignments, "only active workers holding a task are exported")
+	assert.Equal(t, []string{"The DB migration is frozen"}, export.GlobalInstructions)
+	assert.Equal(t, map[string]string{"perles-abc.3": "waiting on design"}, export.HeldTasks)
+
+	require.Len(t, export.TaskAssignments, 2)
+	first := export.TaskAssignments[0]
+	assert.Equal(t, "perles-abc.1", first.TaskID)
+	assert.Equal(t, 2, first.ReviewRounds)
+	assert.Equal(t, "worker-3", first.TransferredFrom)
+	assert.Equal(t, started, first.StartedAt)
+	assert.Equal(t, []string{"abc123"}, first.Checkpoints)
+	assert.Equal(t, "def456", first.BaseTree)
+	assert.Equal(t, "Added retry to the parser", first.CompletionSummary)
+	require.NotNil(t, first.TestResults)
+	assert.Equal(t, 1, first.TestResults.Failed)
+	assert.Equal(t, "missing credentials", export.TaskAssignments[1].FailureReason)
+}
+
+func TestHandleExportState_RequiresRepositories(t *testing.T) {
+	_, err := NewV2Adapter(nil).HandleExportState(context.Background(), nil)
+	require.ErrorContains(t, err, "repositories not configured")
+}
+
+func TestHandleImportState_SubmitsCommand(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	state := StateExport{
+		Version:           StateExportVersion,
+		WorkerAssignments: []WorkerAssignmentExport{{WorkerID: "worker-1", TaskID: "perles-abc.1", Phase: "implementing"}},
+		TaskAssignments: []TaskAssignmentExport{{
+			TaskID: "perles-abc.1", Status: "implementing", Implementer: "worker-1", ReviewRounds: 1,
+			BaseTree: "def456", CompletionSummary: "Added retry to the parser",
+		}},
+		HeldTasks: map[string]string{"perles-abc.3": "waiting on design"},
+	}
+	result, err := adapter.HandleImportState(context.Background(), toJSON(t, map[string]any{"state": state}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "1 worker assignments and 1 tasks")
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	importCmd, ok := cmds[0].(*command.ImportStateCommand)
+	require.True(t, ok, "expected ImportStateCommand, got %T", cmds[0])
+	assert.Equal(t, events.ProcessPhaseImplementing, importCmd.WorkerAssignments[0].Phase)
+	assert.Equal(t, repository.TaskImplementing, importCmd.Tasks[0].Status)
+	assert.Equal(t, 1, importCmd.Tasks[0].ReviewRounds)
+	assert.Equal(t, "Added retry to the parser", importCmd.Tasks[0].CompletionSummary)
+	assert.Equal(t, "def456", importCmd.Tasks[0].BaseTree)
+	assert.Equal(t, map[string]string{"perles-abc.3": "waiting on design"}, importCmd.HeldTasks)
+}
+
+func TestHandleImportState_ValidatesArguments(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleImportState(context.Background(), toJSON(t, map[string]any{}))
+	require.ErrorContains(t, err, "state is required")
+
+	_, err = adapter.HandleImportState(context.Background(), toJSON(t, map[string]any{"state": map[string]any{"version": 99}}))
+	require.ErrorContains(t, err, "unsupported state version 99")
+
+	state := StateExport{
+		Version:           StateExportVersion,
+		WorkerAssignments: []WorkerAssignmentExport{{WorkerID: "worker-1", TaskID: "perles-abc.9", Phase: "implementing"}},
+	}
+	_, err = adapter.HandleImportState(context.Background(), toJSON(t, map[string]any{"state": state}))
+	require.ErrorContains(t, err, "not in the imported tasks")
+
+	assert.Empty(t, handler.getCommands())
+}
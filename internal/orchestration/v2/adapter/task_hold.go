@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// holdTaskArgs holds arguments for hold_task tool.
+type holdTaskArgs struct {
+	TaskID string `json:"task_id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// releaseTaskArgs holds arguments for release_task tool.
+type releaseTaskArgs struct {
+	TaskID string `json:"task_id"`
+}
+
+// heldTasksExtractor is an interface for results that carry the tasks currently on hold.
+type heldTasksExtractor interface {
+	GetHeldTasks() map[string]string
+}
+
+// HandleHoldTask handles the hold_task MCP tool call.
+// The task is not assigned to any worker until release_task is called. Its bd status and
+// any existing assignment are unchanged.
+func (a *V2Adapter) HandleHoldTask(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed holdTaskArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewHoldTaskCommand(command.SourceMCPTool, parsed.TaskID, parsed.Reason)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("hold_task command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("hold_task command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	count := 0
+	if v, ok := result.Data.(heldTasksExtractor); ok {
+		count = len(v.GetHeldTasks())
+	}
+
+	msg := fmt.Sprintf("Task %s is on hold and will not be assigned until released (%d held).", parsed.TaskID, count)
+	return messageResult(msg, TaskHoldResult{ToolResult: okResult(), TaskID: parsed.TaskID, HeldTasks: count, Message: msg}), nil
+}
+
+// HandleReleaseTask handles the release_task MCP tool call.
+func (a *V2Adapter) HandleReleaseTask(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed releaseTaskArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewReleaseTaskCommand(command.SourceMCPTool, parsed.TaskID)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("release_task command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("release_task command failed: %w", err)
+	}
+
+	if !result.Success {
+		return errorResult(result.Error.Error()), nil
+	}
+
+	count := 0
+	if v, ok := result.Data.(heldTasksExtractor); ok {
+		count = len(v.GetHeldTasks())
+	}
+
+	msg := fmt.Sprintf("Task %s released and can be assigned again (%d still held).", parsed.TaskID, count)
+	return messageResult(msg, TaskHoldResult{ToolResult: okResult(), TaskID: parsed.TaskID, HeldTasks: count, Message: msg}), nil
+}
@@ -0,0 +1,91 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// stubHeldTasksResult reports a fixed set of held tasks.
+type stubHeldTasksResult map[string]string
+
+func (r stubHeldTasksResult) GetHeldTasks() map[string]string { return r }
+
+func TestHandleHoldTask_SubmitsCommand(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+	handler.returnResult = &command.CommandResult{Success: true, Data: stubHeldTasksResult{"perles-abc1.2": "waiting on product"}}
+
+	result, err := adapter.HandleHoldTask(context.Background(),
+		toJSON(t, map[string]any{"task_id": "perles-abc1.2", "reason": "waiting on product"}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "perles-abc1.2 is on hold")
+	assert.Equal(t, 1, result.StructuredContent.(TaskHoldResult).HeldTasks)
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	holdCmd, ok := cmds[0].(*command.HoldTaskCommand)
+	require.True(t, ok, "expected HoldTaskCommand, got %T", cmds[0])
+	assert.Equal(t, "perles-abc1.2", holdCmd.TaskID)
+	assert.Equal(t, "waiting on product", holdCmd.Reason)
+}
+
+func TestHandleHoldTask_ValidatesTaskID(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleHoldTask(context.Background(), toJSON(t, map[string]string{"task_id": "bad id"}))
+	require.ErrorContains(t, err, "invalid task_id format")
+
+	_, err = adapter.HandleReleaseTask(context.Background(), toJSON(t, map[string]string{}))
+	require.ErrorContains(t, err, "task_id is required")
+
+	assert.Empty(t, handler.getCommands())
+}
+
+func TestHandleReleaseTask_SubmitsCommand(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	result, err := adapter.HandleReleaseTask(context.Background(), toJSON(t, map[string]string{"task_id": "perles-abc1.2"}))
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "perles-abc1.2 released")
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	assert.Equal(t, command.CmdReleaseTask, cmds[0].Type())
+}
+
+func TestHandleQueryWorkerState_FlagsHeldTasks(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo), WithTaskRepository(taskRepo))
+	defer cleanup()
+
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:        repository.CoordinatorID,
+		Role:      repository.RoleCoordinator,
+		Status:    repository.StatusReady,
+		HeldTasks: map[string]string{"perles-abc1.1": "waiting on product", "perles-abc1.3": ""},
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.1", Implementer: "worker-1", Status: repository.TaskImplementing}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-2", Status: repository.TaskImplementing}))
+
+	result, err := adapter.HandleQueryWorkerState(context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	var response QueryWorkerStateResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+	assert.Equal(t, map[string]string{"perles-abc1.1": "waiting on product", "perles-abc1.3": ""}, response.HeldTasks)
+	assert.True(t, response.Tasks["perles-abc1.1"].Held)
+	assert.False(t, response.Tasks["perles-abc1.2"].Held)
+}
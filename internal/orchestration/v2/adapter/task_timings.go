@@ -0,0 +1,79 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// getTaskTimingsArgs holds arguments for get_task_timings tool.
+type getTaskTimingsArgs struct {
+	TaskID string `json:"task_id"`
+}
+
+// GetTaskTimingsResult is the result of the get_task_timings tool.
+type GetTaskTimingsResult struct {
+	ToolResult
+	TaskID       string        `json:"task_id"`
+	Status       string        `json:"status"`
+	Finished     bool          `json:"finished"`
+	Phases       []phaseTiming `json:"phases"`
+	Total        string        `json:"total"`
+	TotalSeconds float64       `json:"total_seconds"`
+}
+
+// phaseTiming is the time a task spent in a single phase.
+type phaseTiming struct {
+	Phase    string  `json:"phase"`
+	Duration string  `json:"duration"`
+	Seconds  float64 `json:"seconds"`
+}
+
+// HandleGetTaskTimings handles the get_task_timings MCP tool call.
+// It reports how long the task has spent implementing, awaiting review, reviewing and
+// committing, plus the total. The current phase of an unfinished task counts up to now.
+//
+// This is a read-only operation. Completed and failed tasks report the full timeline.
+func (a *V2Adapter) HandleGetTaskTimings(_ context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.taskRepo == nil {
+		return nil, fmt.Errorf("task repository not configured for read-only operations")
+	}
+
+	var parsed getTaskTimingsArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if parsed.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+
+	task, err := a.taskRepo.Get(parsed.TaskID)
+	if err != nil {
+		return errorResult(fmt.Sprintf("task not found: %v", err)), nil
+	}
+
+	timings := repository.ComputeTaskTimings(task.PhaseHistory, a.Now())
+	response := GetTaskTimingsResult{
+		ToolResult:   okResult(),
+		TaskID:       task.TaskID,
+		Status:       string(task.Status),
+		Finished:     timings.Finished,
+		Phases:       make([]phaseTiming, 0, len(repository.TimedTaskPhases)),
+		Total:        timings.Total.Round(time.Second).String(),
+		TotalSeconds: timings.Total.Seconds(),
+	}
+	for _, phase := range repository.TimedTaskPhases {
+		d := timings.Phases[phase]
+		response.Phases = append(response.Phases, phaseTiming{
+			Phase:    string(phase),
+			Duration: d.Round(time.Second).String(),
+			Seconds:  d.Seconds(),
+		})
+	}
+
+	return jsonResult(response)
+}
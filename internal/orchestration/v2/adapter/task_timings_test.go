@@ -0,0 +1,97 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+func TestHandleGetTaskTimings(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	task := &repository.TaskAssignment{TaskID: "perles-abc.1", Implementer: "worker-1", Status: repository.TaskFailed}
+	task.EnterPhase(repository.TaskPhaseImplementing, start)
+	task.EnterPhase(repository.TaskPhaseAwaitingReview, start.Add(20*time.Minute))
+	task.EnterPhase(repository.TaskPhaseReviewing, start.Add(22*time.Minute))
+	task.EnterPhase(repository.TaskPhaseFinished, start.Add(30*time.Minute))
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(task))
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo))
+	defer cleanup()
+
+	result, err := adapter.HandleGetTaskTimings(context.Background(), json.RawMessage(`{"task_id": "perles-abc.1"}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response GetTaskTimingsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+
+	require.Equal(t, GetTaskTimingsResult{
+		ToolResult: ToolResult{OK: true},
+		TaskID:     "perles-abc.1",
+		Status:     "failed",
+		Finished:   true,
+		Phases: []phaseTiming{
+			{Phase: "implementing", Duration: "20m0s", Seconds: 1200},
+			{Phase: "awaiting_review", Duration: "2m0s", Seconds: 120},
+			{Phase: "reviewing", Duration: "8m0s", Seconds: 480},
+			{Phase: "committing", Duration: "0s", Seconds: 0},
+		},
+		Total:        "30m0s",
+		TotalSeconds: 1800,
+	}, response)
+}
+
+func TestHandleGetTaskTimings_InProgressUsesClock(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC))
+	task := &repository.TaskAssignment{TaskID: "perles-abc.1", Implementer: "worker-1", Status: repository.TaskImplementing}
+	task.EnterPhase(repository.TaskPhaseImplementing, clock.Now())
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(task))
+
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(taskRepo), WithClock(clock))
+	defer cleanup()
+	clock.Advance(15 * time.Minute)
+
+	result, err := adapter.HandleGetTaskTimings(context.Background(), json.RawMessage(`{"task_id": "perles-abc.1"}`))
+	require.NoError(t, err)
+
+	var response GetTaskTimingsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+	require.False(t, response.Finished)
+	require.Equal(t, "15m0s", response.Total, "the running phase is measured up to the clock's now")
+}
+
+func TestHandleGetTaskTimings_UnknownTask(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(repository.NewMemoryTaskRepository()))
+	defer cleanup()
+
+	result, err := adapter.HandleGetTaskTimings(context.Background(), json.RawMessage(`{"task_id": "perles-missing"}`))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	require.Contains(t, result.Content[0].Text, "task not found")
+}
+
+func TestHandleGetTaskTimings_RequiresTaskID(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithTaskRepository(repository.NewMemoryTaskRepository()))
+	defer cleanup()
+
+	_, err := adapter.HandleGetTaskTimings(context.Background(), json.RawMessage(`{}`))
+	require.ErrorContains(t, err, "task_id is required")
+}
+
+func TestHandleGetTaskTimings_RequiresRepository(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleGetTaskTimings(context.Background(), json.RawMessage(`{"task_id": "perles-abc.1"}`))
+	require.ErrorContains(t, err, "not configured")
+}
@@ -0,0 +1,158 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+)
+
+// UtilizationSource reports how long each process has spent in each phase.
+type UtilizationSource interface {
+	PhaseDurations() map[string]map[events.ProcessPhase]time.Duration
+}
+
+// WithUtilizationSource sets the time-in-phase data used by get_utilization.
+// When nil, get_utilization returns an error.
+func WithUtilizationSource(source UtilizationSource) Option {
+	return func(a *V2Adapter) {
+		a.utilization = source
+	}
+}
+
+// Utilization thresholds behind the assessment in get_utilization.
+const (
+	// overProvisionedReadyShare is the share of worker time spent ready above which the
+	// pool is reported as larger than the workload needs.
+	overProvisionedReadyShare = 0.5
+	// underProvisionedBusyShare is the share of worker time spent working or reviewing
+	// above which the pool is reported as too small for the workload.
+	underProvisionedBusyShare = 0.9
+)
+
+// utilizationShares is how worker time divides between activities, as fractions of the total.
+type utilizationShares struct {
+	// Working covers implementing, addressing feedback and committing.
+	Working        float64 `json:"working"`
+	Reviewing      float64 `json:"reviewing"`
+	AwaitingReview float64 `json:"awaiting_review"`
+	// Ready is time spent idle, available for a task.
+	Ready float64 `json:"ready"`
+}
+
+// workerUtilization is one worker's share of time in each activity.
+type workerUtilization struct {
+	WorkerID     string  `json:"worker_id"`
+	TotalSeconds float64 `json:"total_seconds"`
+	utilizationShares
+}
+
+// GetUtilizationResult is the result of the get_utilization tool.
+type GetUtilizationResult struct {
+	ToolResult
+	// TotalSeconds is the worker time observed across all workers.
+	TotalSeconds float64 `json:"total_seconds"`
+	utilizationShares
+	// Phases is the total time spent in each worker phase, in lifecycle order.
+	Phases  []phaseTiming       `json:"phases"`
+	Workers []workerUtilization `json:"workers"`
+	// Assessment says whether the pool looks over- or under-provisioned, if either.
+	Assessment string `json:"assessment,omitempty"`
+}
+
+// utilizationPhases lists the worker phases reported by get_utilization, in lifecycle order.
+var utilizationPhases = []events.ProcessPhase{
+	events.ProcessPhaseIdle,
+	events.ProcessPhaseImplementing,
+	events.ProcessPhaseAwaitingReview,
+	events.ProcessPhaseReviewing,
+	events.ProcessPhaseAddressingFeedback,
+	events.ProcessPhaseCommitting,
+}
+
+// HandleGetUtilization handles the get_utilization MCP tool call.
+// It reports what fraction of worker time was spent working, reviewing, awaiting review
+// and ready over the workflow's lifetime, overall and per worker, so the coordinator can
+// tell whether the pool is over- or under-provisioned.
+//
+// This is a read-only operation that bypasses the command processor.
+func (a *V2Adapter) HandleGetUtilization(_ context.Context, _ json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.utilization == nil {
+		return nil, fmt.Errorf("utilization tracking not configured")
+	}
+	return jsonResult(computeUtilization(a.utilization.PhaseDurations()))
+}
+
+// computeUtilization turns per-worker phase durations into activity shares.
+func computeUtilization(durations map[string]map[events.ProcessPhase]time.Duration) GetUtilizationResult {
+	result := GetUtilizationResult{
+		ToolResult: okResult(),
+		Phases:     make([]phaseTiming, 0, len(utilizationPhases)),
+		Workers:    make([]workerUtilization, 0, len(durations)),
+	}
+
+	totals := make(map[events.ProcessPhase]time.Duration, len(utilizationPhases))
+	var total time.Duration
+	for id, phases := range durations {
+		var workerTotal time.Duration
+		for phase, d := range phases {
+			totals[phase] += d
+			workerTotal += d
+		}
+		total += workerTotal
+		result.Workers = append(result.Workers, workerUtilization{
+			WorkerID:          id,
+			TotalSeconds:      workerTotal.Seconds(),
+			utilizationShares: sharesOf(phases, workerTotal),
+		})
+	}
+	sort.Slice(result.Workers, func(i, j int) bool {
+		return result.Workers[i].WorkerID < result.Workers[j].WorkerID
+	})
+
+	result.TotalSeconds = total.Seconds()
+	result.utilizationShares = sharesOf(totals, total)
+	for _, phase := range utilizationPhases {
+		d := totals[phase]
+		result.Phases = append(result.Phases, phaseTiming{
+			Phase:    string(phase),
+			Duration: d.Round(time.Second).String(),
+			Seconds:  d.Seconds(),
+		})
+	}
+
+	switch busy := result.Working + result.Reviewing; {
+	case total == 0:
+	case result.Ready > overProvisionedReadyShare:
+		result.Assessment = fmt.Sprintf("workers were ready with nothing to do %.0f%% of the time; the pool looks over-provisioned", result.Ready*100)
+	case busy > underProvisionedBusyShare:
+		result.Assessment = fmt.Sprintf("workers were busy %.0f%% of the time; the pool looks under-provisioned", busy*100)
+	}
+	return result
+}
+
+// sharesOf groups phase durations into activities as fractions of total.
+func sharesOf(phases map[events.ProcessPhase]time.Duration, total time.Duration) utilizationShares {
+	var shares utilizationShares
+	if total <= 0 {
+		return shares
+	}
+	for phase, d := range phases {
+		share := float64(d) / float64(total)
+		switch phase {
+		case events.ProcessPhaseIdle:
+			shares.Ready += share
+		case events.ProcessPhaseReviewing:
+			shares.Reviewing += share
+		case events.ProcessPhaseAwaitingReview:
+			shares.AwaitingReview += share
+		default:
+			shares.Working += share
+		}
+	}
+	return shares
+}
@@ -0,0 +1,108 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+)
+
+// staticUtilization is a UtilizationSource with fixed phase durations.
+type staticUtilization map[string]map[events.ProcessPhase]time.Duration
+
+func (s staticUtilization) PhaseDurations() map[string]map[events.ProcessPhase]time.Duration {
+	return s
+}
+
+func TestComputeUtilization_Ratios(t *testing.T) {
+	result := computeUtilization(map[string]map[events.ProcessPhase]time.Duration{
+		"worker-1": {
+			events.ProcessPhaseIdle:               10 * time.Minute,
+			events.ProcessPhaseImplementing:       30 * time.Minute,
+			events.ProcessPhaseAwaitingReview:     10 * time.Minute,
+			events.ProcessPhaseAddressingFeedback: 5 * time.Minute,
+			events.ProcessPhaseCommitting:         5 * time.Minute,
+		},
+		"worker-2": {
+			events.ProcessPhaseIdle:      40 * time.Minute,
+			events.ProcessPhaseReviewing: 20 * time.Minute,
+		},
+	})
+
+	require.Equal(t, float64(7200), result.TotalSeconds)
+	require.InDelta(t, 40.0/120, result.Working, 1e-9)
+	require.InDelta(t, 20.0/120, result.Reviewing, 1e-9)
+	require.InDelta(t, 10.0/120, result.AwaitingReview, 1e-9)
+	require.InDelta(t, 50.0/120, result.Ready, 1e-9)
+	require.InDelta(t, 1.0, result.Working+result.Reviewing+result.AwaitingReview+result.Ready, 1e-9)
+	require.Empty(t, result.Assessment)
+
+	require.Len(t, result.Workers, 2)
+	require.Equal(t, "worker-1", result.Workers[0].WorkerID)
+	require.Equal(t, float64(3600), result.Workers[0].TotalSeconds)
+	require.InDelta(t, 40.0/60, result.Workers[0].Working, 1e-9)
+	require.InDelta(t, 10.0/60, result.Workers[0].Ready, 1e-9)
+	require.Equal(t, "worker-2", result.Workers[1].WorkerID)
+	require.InDelta(t, 20.0/60, result.Workers[1].Reviewing, 1e-9)
+	require.InDelta(t, 40.0/60, result.Workers[1].Ready, 1e-9)
+
+	require.Equal(t, []phaseTiming{
+		{Phase: "idle", Duration: "50m0s", Seconds: 3000},
+		{Phase: "implementing", Duration: "30m0s", Seconds: 1800},
+		{Phase: "awaiting_review", Duration: "10m0s", Seconds: 600},
+		{Phase: "reviewing", Duration: "20m0s", Seconds: 1200},
+		{Phase: "addressing_feedback", Duration: "5m0s", Seconds: 300},
+		{Phase: "committing", Duration: "5m0s", Seconds: 300},
+	}, result.Phases)
+}
+
+func TestComputeUtilization_Assessment(t *testing.T) {
+	idle := computeUtilization(map[string]map[events.ProcessPhase]time.Duration{
+		"worker-1": {events.ProcessPhaseIdle: 45 * time.Minute, events.ProcessPhaseImplementing: 15 * time.Minute},
+	})
+	require.Contains(t, idle.Assessment, "over-provisioned")
+
+	busy := computeUtilization(map[string]map[events.ProcessPhase]time.Duration{
+		"worker-1": {events.ProcessPhaseIdle: time.Minute, events.ProcessPhaseImplementing: 59 * time.Minute},
+	})
+	require.Contains(t, busy.Assessment, "under-provisioned")
+}
+
+func TestComputeUtilization_NoWorkers(t *testing.T) {
+	result := computeUtilization(nil)
+
+	require.Zero(t, result.TotalSeconds)
+	require.Zero(t, result.Ready)
+	require.Empty(t, result.Workers)
+	require.Empty(t, result.Assessment)
+}
+
+func TestHandleGetUtilization(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithUtilizationSource(staticUtilization{
+		"worker-1": {events.ProcessPhaseIdle: 30 * time.Minute, events.ProcessPhaseImplementing: 30 * time.Minute},
+	}))
+	defer cleanup()
+
+	result, err := adapter.HandleGetUtilization(context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response GetUtilizationResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+	require.True(t, response.OK)
+	require.Equal(t, 0.5, response.Working)
+	require.Equal(t, 0.5, response.Ready)
+	require.Len(t, response.Workers, 1)
+}
+
+func TestHandleGetUtilization_NotConfigured(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleGetUtilization(context.Background(), json.RawMessage(`{}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// WorkflowSummary describes one workflow in the list_workflows result.
+type WorkflowSummary struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	EpicID string `json:"epic_id,omitempty"`
+	// Current is true for the workflow the calling coordinator belongs to.
+	Current bool `json:"current"`
+	TaskProgress
+}
+
+// TaskProgress counts a workflow's assigned tasks by outcome.
+type TaskProgress struct {
+	Tasks     int `json:"tasks"`
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+	// Percent is the share of tasks completed, rounded down (0 when there are no tasks).
+	Percent int `json:"progress_percent"`
+}
+
+// SummarizeTasks counts tasks by outcome and computes the completed percentage.
+func SummarizeTasks(tasks []*repository.TaskAssignment) TaskProgress {
+	progress := TaskProgress{Tasks: len(tasks)}
+	for _, task := range tasks {
+		switch task.Status {
+		case repository.TaskCompleted:
+			progress.Completed++
+		case repository.TaskFailed:
+			progress.Failed++
+		}
+	}
+	if progress.Tasks > 0 {
+		progress.Percent = progress.Completed * 100 / progress.Tasks
+	}
+	return progress
+}
+
+// WorkflowLister lists the workflows known to the control plane.
+type WorkflowLister interface {
+	// ListWorkflows returns a summary of every workflow, newest first.
+	ListWorkflows() []WorkflowSummary
+}
+
+// WithWorkflowLister sets the lister used by list_workflows.
+// When nil, list_workflows returns an error.
+func WithWorkflowLister(lister WorkflowLister) Option {
+	return func(a *V2Adapter) {
+		a.workflowLister = lister
+	}
+}
+
+// ListWorkflowsResult is the result of the list_workflows tool.
+type ListWorkflowsResult struct {
+	ToolResult
+	Workflows []WorkflowSummary `json:"workflows"`
+}
+
+// HandleListWorkflows handles the list_workflows MCP tool call.
+// It returns every workflow the control plane is running alongside the caller's,
+// with epic IDs and task progress, so the coordinator can reason across workflows.
+//
+// This is a read-only operation that bypasses the command processor.
+func (a *V2Adapter) HandleListWorkflows(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.workflowLister == nil {
+		return nil, fmt.Errorf("workflow lister not configured")
+	}
+
+	workflows := a.workflowLister.ListWorkflows()
+	if workflows == nil {
+		workflows = []WorkflowSummary{}
+	}
+
+	return jsonResult(ListWorkflowsResult{
+		ToolResult: okResult(),
+		Workflows:  workflows,
+	})
+}
@@ -0,0 +1,70 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// staticWorkflowLister returns a fixed list of workflows.
+type staticWorkflowLister []WorkflowSummary
+
+func (l staticWorkflowLister) ListWorkflows() []WorkflowSummary {
+	return l
+}
+
+func TestHandleListWorkflows_ReturnsWorkflows(t *testing.T) {
+	lister := staticWorkflowLister{
+		{ID: "wf-2", Name: "Auth", State: "running", EpicID: "perles-auth", Current: true,
+			TaskProgress: TaskProgress{Tasks: 4, Completed: 1, Percent: 25}},
+		{ID: "wf-1", Name: "Billing", State: "paused", EpicID: "perles-bill"},
+	}
+	adapter, _, cleanup := testAdapter(t, WithWorkflowLister(lister))
+	defer cleanup()
+
+	result, err := adapter.HandleListWorkflows(context.Background(), nil)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	var resp ListWorkflowsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &resp))
+	assert.Equal(t, []WorkflowSummary(lister), resp.Workflows)
+	assert.Contains(t, result.Content[0].Text, `"progress_percent": 25`)
+}
+
+func TestHandleListWorkflows_EmptyListIsArray(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t, WithWorkflowLister(staticWorkflowLister(nil)))
+	defer cleanup()
+
+	result, err := adapter.HandleListWorkflows(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].Text, `"workflows": []`)
+}
+
+func TestHandleListWorkflows_RequiresLister(t *testing.T) {
+	adapter, _, cleanup := testAdapter(t)
+	defer cleanup()
+
+	_, err := adapter.HandleListWorkflows(context.Background(), nil)
+	require.ErrorContains(t, err, "workflow lister not configured")
+}
+
+func TestSummarizeTasks(t *testing.T) {
+	tasks := []*repository.TaskAssignment{
+		{TaskID: "a", Status: repository.TaskCompleted},
+		{TaskID: "b", Status: repository.TaskCompleted},
+		{TaskID: "c", Status: repository.TaskFailed},
+		{TaskID: "d", Status: repository.TaskInReview},
+		{TaskID: "e", Status: repository.TaskImplementing},
+		{TaskID: "f", Status: repository.TaskCompleted},
+	}
+
+	assert.Equal(t, TaskProgress{Tasks: 6, Completed: 3, Failed: 1, Percent: 50}, SummarizeTasks(tasks))
+	assert.Equal(t, TaskProgress{}, SummarizeTasks(nil))
+}
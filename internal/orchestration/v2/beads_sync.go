@@ -0,0 +1,125 @@
+package v2
+
+import (
+	"context"
+	"sync"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+)
+
+// beadsStatusQueueSize is how many status writes may wait for the sync goroutine
+// before further writes are dropped.
+const beadsStatusQueueSize = 256
+
+// BeadsStatusSync mirrors worker phase transitions into beads task statuses, so the
+// tracker stays a live source of truth for external observers whichever path moved the
+// task (transfer, replacement, import). It is a processor.TransitionLogger: a worker
+// entering implementing marks its task in_progress.
+//
+// assign_task and mark_task_complete write the beads status themselves, so their
+// transitions are not written again. A task that completes or fails is forgotten.
+//
+// Writes run on the goroutine started by Run, never on the processor goroutine. Write
+// failures are logged and otherwise ignored; they never fail the command.
+type BeadsStatusSync struct {
+	writer  appbeads.IssueWriter
+	updates chan beadsStatusUpdate
+
+	mu sync.Mutex
+	// written is the last status written (or queued) per task, so repeated transitions
+	// for the same task (e.g. a transfer after assignment) write once.
+	written map[string]beads.Status
+}
+
+// beadsStatusUpdate is a status write queued for the sync goroutine.
+type beadsStatusUpdate struct {
+	taskID  string
+	status  beads.Status
+	trigger command.CommandType
+}
+
+// NewBeadsStatusSync creates a BeadsStatusSync that writes through writer.
+// Writes are queued until Run is called.
+// Panics if writer is nil.
+func NewBeadsStatusSync(writer appbeads.IssueWriter) *BeadsStatusSync {
+	if writer == nil {
+		panic("writer is required for BeadsStatusSync")
+	}
+	return &BeadsStatusSync{
+		writer:  writer,
+		updates: make(chan beadsStatusUpdate, beadsStatusQueueSize),
+		written: make(map[string]beads.Status),
+	}
+}
+
+// LogTransition implements processor.TransitionLogger.
+func (s *BeadsStatusSync) LogTransition(t processor.PhaseTransition) {
+	if t.TaskID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch t.Trigger {
+	case command.CmdAssignTask:
+		// The assign handler already marked the task in_progress
+		if t.ToPhase == events.ProcessPhaseImplementing {
+			s.written[t.TaskID] = beads.StatusInProgress
+		}
+		return
+	case command.CmdMarkTaskComplete, command.CmdMarkTaskFailed:
+		delete(s.written, t.TaskID)
+		return
+	}
+
+	if t.ToPhase != events.ProcessPhaseImplementing || s.written[t.TaskID] == beads.StatusInProgress {
+		return
+	}
+
+	select {
+	case s.updates <- beadsStatusUpdate{taskID: t.TaskID, status: beads.StatusInProgress, trigger: t.Trigger}:
+		s.written[t.TaskID] = beads.StatusInProgress
+	default:
+		log.Warn(log.CatOrch, "beads status sync queue full, dropping update",
+			"task_id", t.TaskID, "trigger", t.Trigger)
+	}
+}
+
+// Run writes queued statuses until ctx is cancelled, then writes any still queued.
+func (s *BeadsStatusSync) Run(ctx context.Context) {
+	for {
+		select {
+		case u := <-s.updates:
+			s.write(u)
+		case <-ctx.Done():
+			for {
+				select {
+				case u := <-s.updates:
+					s.write(u)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// write performs one queued status write. A failed write is forgotten, so the next
+// transition for the task retries it.
+func (s *BeadsStatusSync) write(u beadsStatusUpdate) {
+	if err := s.writer.UpdateStatus(u.taskID, u.status); err != nil {
+		log.Warn(log.CatOrch, "failed to sync beads status",
+			"task_id", u.taskID, "status", u.status, "trigger", u.trigger, "error", err)
+		s.mu.Lock()
+		if s.written[u.taskID] == u.status {
+			delete(s.written, u.taskID)
+		}
+		s.mu.Unlock()
+	}
+}
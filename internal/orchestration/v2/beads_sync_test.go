@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+)
+
+// flushBeadsStatusSync runs s until every queued write has been made.
+func flushBeadsStatusSync(s *BeadsStatusSync) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.Run(ctx)
+}
+
+func TestBeadsStatusSync_WritesStatusForTransitions(t *testing.T) {
+	writer := mocks.NewMockIssueWriter(t)
+	writer.EXPECT().UpdateStatus("perles-abc.2", beads.StatusInProgress).Return(nil).Once()
+	sync := NewBeadsStatusSync(writer)
+
+	for _, tr := range []processor.PhaseTransition{
+		// assign_task and mark_task_complete write the status themselves
+		{WorkerID: "worker-1", TaskID: "perles-abc.1", FromPhase: events.ProcessPhaseIdle, ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdAssignTask},
+		{WorkerID: "worker-1", TaskID: "perles-abc.1", FromPhase: events.ProcessPhaseCommitting, ToPhase: events.ProcessPhaseIdle, Trigger: command.CmdMarkTaskComplete},
+		// Other paths into implementing are written
+		{WorkerID: "worker-2", TaskID: "perles-abc.2", FromPhase: events.ProcessPhaseIdle, ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdImportState},
+		// A second implementer for the same task does not write again
+		{WorkerID: "worker-3", TaskID: "perles-abc.2", FromPhase: events.ProcessPhaseIdle, ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdTransferTask},
+		// Transitions out of implementing and without a task are ignored
+		{WorkerID: "worker-3", TaskID: "perles-abc.2", FromPhase: events.ProcessPhaseImplementing, ToPhase: events.ProcessPhaseAwaitingReview, Trigger: command.CmdReportComplete},
+		{WorkerID: "worker-4", ToPhase: events.ProcessPhaseIdle, Trigger: command.CmdSpawnProcess},
+	} {
+		sync.LogTransition(tr)
+	}
+	flushBeadsStatusSync(sync)
+}
+
+func TestBeadsStatusSync_DoesNotRewriteAssignedTask(t *testing.T) {
+	writer := mocks.NewMockIssueWriter(t)
+	sync := NewBeadsStatusSync(writer)
+
+	sync.LogTransition(processor.PhaseTransition{WorkerID: "worker-1", TaskID: "perles-abc.1", ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdAssignTask})
+	sync.LogTransition(processor.PhaseTransition{WorkerID: "worker-2", TaskID: "perles-abc.1", ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdTransferTask})
+	flushBeadsStatusSync(sync)
+}
+
+func TestBeadsStatusSync_ForgetsTasksThatFinish(t *testing.T) {
+	writer := mocks.NewMockIssueWriter(t)
+	writer.EXPECT().UpdateStatus("perles-abc.1", beads.StatusInProgress).Return(nil).Twice()
+	sync := NewBeadsStatusSync(writer)
+
+	transfer := processor.PhaseTransition{WorkerID: "worker-1", TaskID: "perles-abc.1", ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdTransferTask}
+	sync.LogTransition(transfer)
+	flushBeadsStatusSync(sync)
+	require.Len(t, sync.written, 1)
+
+	sync.LogTransition(processor.PhaseTransition{WorkerID: "worker-1", TaskID: "perles-abc.1", ToPhase: events.ProcessPhaseIdle, Trigger: command.CmdMarkTaskFailed})
+	require.Empty(t, sync.written)
+
+	// A task reopened after failing is written again
+	sync.LogTransition(transfer)
+	flushBeadsStatusSync(sync)
+}
+
+func TestBeadsStatusSync_LogsWriteFailuresAndRetries(t *testing.T) {
+	writer := mocks.NewMockIssueWriter(t)
+	writer.EXPECT().UpdateStatus("perles-abc.1", beads.StatusInProgress).Return(errors.New("bd unavailable")).Once()
+	writer.EXPECT().UpdateStatus("perles-abc.1", beads.StatusInProgress).Return(nil).Once()
+	sync := NewBeadsStatusSync(writer)
+
+	transfer := processor.PhaseTransition{WorkerID: "worker-1", TaskID: "perles-abc.1", ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdTransferTask}
+	sync.LogTransition(transfer)
+	require.NotPanics(t, func() { flushBeadsStatusSync(sync) })
+
+	// A failed write is not remembered, so the next transition for the task retries it
+	replace := transfer
+	replace.WorkerID, replace.Trigger = "worker-2", command.CmdReplaceProcess
+	sync.LogTransition(replace)
+	flushBeadsStatusSync(sync)
+}
+
+func TestBeadsStatusSync_LogTransitionDoesNotWrite(t *testing.T) {
+	// No expectations: the mock fails the test if LogTransition writes inline
+	writer := mocks.NewMockIssueWriter(t)
+	sync := NewBeadsStatusSync(writer)
+
+	sync.LogTransition(processor.PhaseTransition{WorkerID: "worker-1", TaskID: "perles-abc.1", ToPhase: events.ProcessPhaseImplementing, Trigger: command.CmdTransferTask})
+	require.Len(t, sync.updates, 1)
+}
+
+func TestNewBeadsStatusSync_PanicsWithoutWriter(t *testing.T) {
+	require.Panics(t, func() { NewBeadsStatusSync(nil) })
+}
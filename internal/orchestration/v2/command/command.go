@@ -34,12 +34,20 @@ const (
 
 	// CmdAssignTask assigns a bd task to an implementer.
 	CmdAssignTask CommandType = "assign_task"
+	// CmdAssignTasksBatch assigns several bd tasks to implementers in one command.
+	CmdAssignTasksBatch CommandType = "assign_tasks_batch"
 	// CmdAssignReview assigns a reviewer to an implemented task.
 	CmdAssignReview CommandType = "assign_review"
 	// CmdApproveCommit approves implementation and triggers commit phase.
 	CmdApproveCommit CommandType = "approve_commit"
 	// CmdAssignReviewFeedback sends review feedback to an implementer after denial.
 	CmdAssignReviewFeedback CommandType = "assign_review_feedback"
+	// CmdTransferTask moves an in-flight task from one worker to another.
+	CmdTransferTask CommandType = "transfer_task"
+	// CmdDrainWorker moves all of a worker's in-flight tasks to ready workers and retires it.
+	CmdDrainWorker CommandType = "drain_worker"
+	// CmdGetEligibleWorkers reports which workers could currently be assigned a bd task.
+	CmdGetEligibleWorkers CommandType = "get_eligible_workers"
 
 	// Message Routing Commands
 
@@ -52,6 +60,14 @@ const (
 	CmdReportComplete CommandType = "report_complete"
 	// CmdReportVerdict signals a reviewer's approval or denial verdict.
 	CmdReportVerdict CommandType = "report_verdict"
+	// CmdReportTestResults records a worker's test run results on its current task.
+	CmdReportTestResults CommandType = "report_test_results"
+	// CmdRequestRetirement flags a worker for retirement after its current turn.
+	CmdRequestRetirement CommandType = "request_retirement"
+	// CmdMarkFeedbackAddressed marks review feedback items on a worker's task as addressed.
+	CmdMarkFeedbackAddressed CommandType = "mark_feedback_addressed"
+	// CmdCheckpoint commits a worker's work-in-progress to the worktree.
+	CmdCheckpoint CommandType = "checkpoint"
 	// CmdTransitionPhase is an internal command for phase changes.
 	CmdTransitionPhase CommandType = "transition_phase"
 	// BD Task Status Commands
@@ -60,6 +76,16 @@ const (
 	CmdMarkTaskComplete CommandType = "mark_task_complete"
 	// CmdMarkTaskFailed marks a BD task as failed with a reason.
 	CmdMarkTaskFailed CommandType = "mark_task_failed"
+	// CmdAddTaskBlocker records a blocking dependency between two BD issues.
+	CmdAddTaskBlocker CommandType = "add_task_blocker"
+	// CmdCompleteEpicTasks marks the open subtasks of a BD epic as completed.
+	CmdCompleteEpicTasks CommandType = "complete_epic_tasks"
+	// CmdSyncTaskStatus refreshes a task assignment's status from its BD status.
+	CmdSyncTaskStatus CommandType = "sync_task_status"
+	// CmdGetCriticalPath computes the longest dependency chain through a BD epic's subtasks.
+	CmdGetCriticalPath CommandType = "get_critical_path"
+	// CmdGetDependencyGraph loads the blocking and parent relationships of a BD epic's subtasks.
+	CmdGetDependencyGraph CommandType = "get_dependency_graph"
 
 	// Unified Process Commands (for both coordinator and workers)
 
@@ -79,6 +105,8 @@ const (
 	CmdPauseProcess CommandType = "pause_process"
 	// CmdResumeProcess resumes a paused coordinator/process (Paused → Ready).
 	CmdResumeProcess CommandType = "resume_process"
+	// CmdLabelWorker sets the human-readable label shown alongside a worker's ID.
+	CmdLabelWorker CommandType = "label_worker"
 
 	// Aggregation Commands
 
@@ -99,6 +127,21 @@ const (
 
 	// CmdNotifyUser requests user attention (e.g., for human review checkpoints).
 	CmdNotifyUser CommandType = "notify_user"
+
+	// Coordinator State Commands
+
+	// CmdSetGlobalInstruction appends or replaces the coordinator-wide instruction.
+	CmdSetGlobalInstruction CommandType = "set_global_instruction"
+	// CmdClearGlobalInstruction removes the coordinator-wide instruction.
+	CmdClearGlobalInstruction CommandType = "clear_global_instruction"
+	// CmdSetFocus sets or clears the workflow-wide focus area.
+	CmdSetFocus CommandType = "set_focus"
+	// CmdHoldTask puts a task on hold so it is not assigned until released.
+	CmdHoldTask CommandType = "hold_task"
+	// CmdReleaseTask takes a task off hold, making it assignable again.
+	CmdReleaseTask CommandType = "release_task"
+	// CmdImportState restores worker and task assignments captured by export_state.
+	CmdImportState CommandType = "import_state"
 )
 
 // String returns the string representation of the CommandType.
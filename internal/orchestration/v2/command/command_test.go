@@ -22,6 +22,7 @@ func TestCommandType_String(t *testing.T) {
 		// State Transition Commands
 		{"report_complete", CmdReportComplete, "report_complete"},
 		{"report_verdict", CmdReportVerdict, "report_verdict"},
+		{"report_test_results", CmdReportTestResults, "report_test_results"},
 		{"transition_phase", CmdTransitionPhase, "transition_phase"},
 		// Unified Process Commands
 		{"spawn_process", CmdSpawnProcess, "spawn_process"},
@@ -206,6 +207,7 @@ func TestAllCommandTypesAreDefined(t *testing.T) {
 		// State Transition Commands
 		CmdReportComplete,
 		CmdReportVerdict,
+		CmdReportTestResults,
 		CmdTransitionPhase,
 		// BD Task Commands
 		CmdMarkTaskComplete,
@@ -219,7 +221,7 @@ func TestAllCommandTypesAreDefined(t *testing.T) {
 		CmdProcessTurnComplete,
 	}
 
-	require.Len(t, allTypes, 15)
+	require.Len(t, allTypes, 16)
 
 	// Check all are unique
 	seen := make(map[CommandType]bool)
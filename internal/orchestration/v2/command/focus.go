@@ -0,0 +1,43 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxFocusLength is the maximum length of the workflow-wide focus area.
+const MaxFocusLength = 1000
+
+// SetFocusCommand sets the workflow-wide focus area included in every subsequent task
+// assignment prompt. A blank Focus clears it.
+type SetFocusCommand struct {
+	*BaseCommand
+	Focus string // Focus area, e.g. "the internal/parser module"; blank clears the focus
+}
+
+// NewSetFocusCommand creates a new SetFocusCommand.
+func NewSetFocusCommand(source CommandSource, focus string) *SetFocusCommand {
+	base := NewBaseCommand(CmdSetFocus, source)
+	return &SetFocusCommand{
+		BaseCommand: &base,
+		Focus:       focus,
+	}
+}
+
+// Validate checks that Focus is within length limits.
+func (c *SetFocusCommand) Validate() error {
+	if len(c.Focus) > MaxFocusLength {
+		return fmt.Errorf("focus exceeds maximum length of %d characters", MaxFocusLength)
+	}
+	return nil
+}
+
+// IsClear returns true if the command clears the focus.
+func (c *SetFocusCommand) IsClear() bool {
+	return strings.TrimSpace(c.Focus) == ""
+}
+
+// String returns a readable representation of the command.
+func (c *SetFocusCommand) String() string {
+	return fmt.Sprintf("SetFocus{focus=%q}", truncate(c.Focus, 50))
+}
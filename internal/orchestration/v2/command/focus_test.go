@@ -0,0 +1,31 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// Focus Command Tests
+// ===========================================================================
+
+func TestSetFocusCommand_Validate(t *testing.T) {
+	require.NoError(t, NewSetFocusCommand(SourceMCPTool, "the internal/parser module").Validate())
+	require.NoError(t, NewSetFocusCommand(SourceMCPTool, "").Validate(), "a blank focus clears it")
+
+	err := NewSetFocusCommand(SourceMCPTool, strings.Repeat("x", MaxFocusLength+1)).Validate()
+	require.ErrorContains(t, err, "exceeds maximum length")
+}
+
+func TestSetFocusCommand_IsClear(t *testing.T) {
+	require.True(t, NewSetFocusCommand(SourceMCPTool, "  \n").IsClear())
+	require.False(t, NewSetFocusCommand(SourceMCPTool, "the parser").IsClear())
+}
+
+func TestSetFocusCommand_Type(t *testing.T) {
+	cmd := NewSetFocusCommand(SourceMCPTool, "the parser")
+	require.Equal(t, CmdSetFocus, cmd.Type())
+	require.Contains(t, cmd.String(), "the parser")
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ===========================================================================
+// Coordinator State Commands
+// ===========================================================================
+
+// MaxGlobalInstructionLength is the maximum length of a single global instruction.
+const MaxGlobalInstructionLength = 4000
+
+// SetGlobalInstructionCommand stores a coordinator-wide instruction that is included
+// in every subsequent task assignment prompt.
+type SetGlobalInstructionCommand struct {
+	*BaseCommand
+	Instruction string // Required: instruction text
+	Replace     bool   // true = replace existing instructions, false = append
+}
+
+// NewSetGlobalInstructionCommand creates a new SetGlobalInstructionCommand.
+func NewSetGlobalInstructionCommand(source CommandSource, instruction string, replace bool) *SetGlobalInstructionCommand {
+	base := NewBaseCommand(CmdSetGlobalInstruction, source)
+	return &SetGlobalInstructionCommand{
+		BaseCommand: &base,
+		Instruction: instruction,
+		Replace:     replace,
+	}
+}
+
+// Validate checks that Instruction is non-blank and within length limits.
+func (c *SetGlobalInstructionCommand) Validate() error {
+	if strings.TrimSpace(c.Instruction) == "" {
+		return fmt.Errorf("instruction is required")
+	}
+	if len(c.Instruction) > MaxGlobalInstructionLength {
+		return fmt.Errorf("instruction exceeds maximum length of %d characters", MaxGlobalInstructionLength)
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *SetGlobalInstructionCommand) String() string {
+	return fmt.Sprintf("SetGlobalInstruction{replace=%t, instruction=%q}", c.Replace, truncate(c.Instruction, 50))
+}
+
+// ClearGlobalInstructionCommand removes all coordinator-wide instructions.
+// Task assignments made before the clear keep the instructions they were given.
+type ClearGlobalInstructionCommand struct {
+	*BaseCommand
+}
+
+// NewClearGlobalInstructionCommand creates a new ClearGlobalInstructionCommand.
+func NewClearGlobalInstructionCommand(source CommandSource) *ClearGlobalInstructionCommand {
+	base := NewBaseCommand(CmdClearGlobalInstruction, source)
+	return &ClearGlobalInstructionCommand{BaseCommand: &base}
+}
+
+// Validate always succeeds; the command has no arguments.
+func (c *ClearGlobalInstructionCommand) Validate() error {
+	return nil
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// Global Instruction Command Tests
+// ===========================================================================
+
+func TestSetGlobalInstructionCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		instruction string
+		wantErr     bool
+		errSubstr   string
+	}{
+		{
+			name:        "valid",
+			instruction: "The DB migration is frozen",
+			wantErr:     false,
+		},
+		{
+			name:      "empty instruction",
+			wantErr:   true,
+			errSubstr: "instruction is required",
+		},
+		{
+			name:        "whitespace instruction",
+			instruction: " \n\t",
+			wantErr:     true,
+			errSubstr:   "instruction is required",
+		},
+		{
+			name:        "instruction too long",
+			instruction: strings.Repeat("x", MaxGlobalInstructionLength+1),
+			wantErr:     true,
+			errSubstr:   "exceeds maximum length",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewSetGlobalInstructionCommand(SourceMCPTool, tt.instruction, false)
+			err := cmd.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetGlobalInstructionCommand_Type(t *testing.T) {
+	cmd := NewSetGlobalInstructionCommand(SourceMCPTool, "The DB migration is frozen", true)
+	require.Equal(t, CmdSetGlobalInstruction, cmd.Type())
+	require.True(t, cmd.Replace)
+}
+
+func TestClearGlobalInstructionCommand(t *testing.T) {
+	cmd := NewClearGlobalInstructionCommand(SourceMCPTool)
+	require.Equal(t, CmdClearGlobalInstruction, cmd.Type())
+	require.NoError(t, cmd.Validate())
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxWorkerLabelLength is the maximum length of a worker label, in characters.
+const MaxWorkerLabelLength = 64
+
+// LabelWorkerCommand sets a human-readable label on a worker, e.g. "auth-impl".
+// The label is cosmetic: messages and tools still address the worker by its ID.
+type LabelWorkerCommand struct {
+	*BaseCommand
+	WorkerID string // Required: ID of the worker to label
+	Label    string // Label to show alongside the ID; empty clears it
+}
+
+// NewLabelWorkerCommand creates a new LabelWorkerCommand.
+func NewLabelWorkerCommand(source CommandSource, workerID, label string) *LabelWorkerCommand {
+	base := NewBaseCommand(CmdLabelWorker, source)
+	return &LabelWorkerCommand{
+		BaseCommand: &base,
+		WorkerID:    workerID,
+		Label:       strings.TrimSpace(label),
+	}
+}
+
+// Validate checks that WorkerID is provided and the label is printable and within length limits.
+func (c *LabelWorkerCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	if utf8.RuneCountInString(c.Label) > MaxWorkerLabelLength {
+		return fmt.Errorf("label exceeds maximum length of %d characters", MaxWorkerLabelLength)
+	}
+	if strings.ContainsFunc(c.Label, unicode.IsControl) {
+		return fmt.Errorf("label must not contain control characters")
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *LabelWorkerCommand) String() string {
+	return fmt.Sprintf("LabelWorker{worker=%s, label=%q}", c.WorkerID, c.Label)
+}
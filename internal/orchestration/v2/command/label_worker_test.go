@@ -0,0 +1,44 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelWorkerCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		workerID  string
+		label     string
+		errSubstr string
+	}{
+		{name: "valid", workerID: "worker-1", label: "auth-impl"},
+		{name: "empty label clears", workerID: "worker-1", label: ""},
+		{name: "unicode label", workerID: "worker-1", label: "référence ✓"},
+		{name: "max length", workerID: "worker-1", label: strings.Repeat("é", MaxWorkerLabelLength)},
+		{name: "missing worker", label: "auth-impl", errSubstr: "worker_id is required"},
+		{name: "too long", workerID: "worker-1", label: strings.Repeat("x", MaxWorkerLabelLength+1), errSubstr: "label exceeds maximum length"},
+		{name: "newline", workerID: "worker-1", label: "auth\nimpl", errSubstr: "control characters"},
+		{name: "escape sequence", workerID: "worker-1", label: "\x1b[31mauth", errSubstr: "control characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewLabelWorkerCommand(SourceMCPTool, tt.workerID, tt.label).Validate()
+			if tt.errSubstr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errSubstr)
+			}
+		})
+	}
+}
+
+func TestNewLabelWorkerCommand_TrimsLabel(t *testing.T) {
+	cmd := NewLabelWorkerCommand(SourceMCPTool, "worker-1", "  auth-impl \t")
+	require.Equal(t, CmdLabelWorker, cmd.Type())
+	require.Equal(t, "auth-impl", cmd.Label)
+	require.Equal(t, `LabelWorker{worker=worker-1, label="auth-impl"}`, cmd.String())
+}
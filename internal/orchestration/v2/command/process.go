@@ -97,6 +97,7 @@ type ReplaceProcessCommand struct {
 	*BaseCommand
 	ProcessID string // Required: ID of the process to replace
 	Reason    string // Optional: reason for replacement
+	Reassign  bool   // Optional: hand the worker's in-progress task to its replacement
 }
 
 // NewReplaceProcessCommand creates a new ReplaceProcessCommand.
@@ -127,6 +128,7 @@ type SendToProcessCommand struct {
 	*BaseCommand
 	ProcessID string // Required: ID of the process (e.g., "coordinator", "worker-1")
 	Content   string // Required: message content
+	Keepalive bool   // Optional: the turn this message starts is exempt from turn enforcement
 }
 
 // NewSendToProcessCommand creates a new SendToProcessCommand.
@@ -139,6 +141,13 @@ func NewSendToProcessCommand(source CommandSource, processID, content string) *S
 	}
 }
 
+// NewSendKeepaliveCommand creates a SendToProcessCommand carrying a keepalive prompt for an idle worker.
+func NewSendKeepaliveCommand(source CommandSource, processID, content string) *SendToProcessCommand {
+	cmd := NewSendToProcessCommand(source, processID, content)
+	cmd.Keepalive = true
+	return cmd
+}
+
 // Validate checks that ProcessID and Content are provided.
 func (c *SendToProcessCommand) Validate() error {
 	if c.ProcessID == "" {
@@ -0,0 +1,106 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// WorkerAssignmentState is a worker's task assignment as captured by export_state.
+type WorkerAssignmentState struct {
+	WorkerID string              // Required: worker that holds the task
+	TaskID   string              // Required: task the worker is assigned to
+	Phase    events.ProcessPhase // Required: worker's workflow phase for the task
+}
+
+// ImportStateCommand restores worker and task assignments previously captured by
+// export_state into the running coordinator.
+type ImportStateCommand struct {
+	*BaseCommand
+	WorkerAssignments  []WorkerAssignmentState      // Worker -> task assignments to restore
+	Tasks              []*repository.TaskAssignment // Task assignments (with history) to restore
+	GlobalInstructions []string                     // Coordinator-wide instructions to restore
+	HeldTasks          map[string]string            // Held task IDs mapped to their hold reasons to restore
+}
+
+// NewImportStateCommand creates a new ImportStateCommand.
+func NewImportStateCommand(
+	source CommandSource,
+	workerAssignments []WorkerAssignmentState,
+	tasks []*repository.TaskAssignment,
+	globalInstructions []string,
+	heldTasks map[string]string,
+) *ImportStateCommand {
+	base := NewBaseCommand(CmdImportState, source)
+	return &ImportStateCommand{
+		BaseCommand:        &base,
+		WorkerAssignments:  workerAssignments,
+		Tasks:              tasks,
+		GlobalInstructions: globalInstructions,
+		HeldTasks:          heldTasks,
+	}
+}
+
+// Validate checks that the state is internally consistent: every task has an ID and a
+// known status, task IDs are unique, and every worker assignment names a known phase and
+// a task included in the state. Whether the workers exist is checked by the handler
+// against the live pool.
+func (c *ImportStateCommand) Validate() error {
+	tasks := make(map[string]bool, len(c.Tasks))
+	for i, task := range c.Tasks {
+		if task == nil || task.TaskID == "" {
+			return fmt.Errorf("tasks[%d]: task_id is required", i)
+		}
+		if tasks[task.TaskID] {
+			return fmt.Errorf("tasks[%d]: duplicate task_id %s", i, task.TaskID)
+		}
+		if !isKnownTaskStatus(task.Status) {
+			return fmt.Errorf("tasks[%d]: invalid status %q", i, task.Status)
+		}
+		tasks[task.TaskID] = true
+	}
+
+	workers := make(map[string]bool, len(c.WorkerAssignments))
+	for i, wa := range c.WorkerAssignments {
+		if wa.WorkerID == "" {
+			return fmt.Errorf("worker_assignments[%d]: worker_id is required", i)
+		}
+		if workers[wa.WorkerID] {
+			return fmt.Errorf("worker_assignments[%d]: duplicate worker_id %s", i, wa.WorkerID)
+		}
+		if !tasks[wa.TaskID] {
+			return fmt.Errorf("worker_assignments[%d]: task %q is not in the imported tasks", i, wa.TaskID)
+		}
+		if !isKnownPhase(wa.Phase) {
+			return fmt.Errorf("worker_assignments[%d]: invalid phase %q", i, wa.Phase)
+		}
+		workers[wa.WorkerID] = true
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *ImportStateCommand) String() string {
+	return fmt.Sprintf("ImportState{workers=%d, tasks=%d}", len(c.WorkerAssignments), len(c.Tasks))
+}
+
+// isKnownTaskStatus returns true if status is one of the defined task statuses.
+func isKnownTaskStatus(status repository.TaskStatus) bool {
+	switch status {
+	case repository.TaskImplementing, repository.TaskInReview, repository.TaskApproved,
+		repository.TaskDenied, repository.TaskCommitting, repository.TaskCompleted, repository.TaskFailed:
+		return true
+	}
+	return false
+}
+
+// isKnownPhase returns true if phase is one of the defined worker phases.
+func isKnownPhase(phase events.ProcessPhase) bool {
+	switch phase {
+	case events.ProcessPhaseIdle, events.ProcessPhaseImplementing, events.ProcessPhaseAwaitingReview,
+		events.ProcessPhaseReviewing, events.ProcessPhaseAddressingFeedback, events.ProcessPhaseCommitting:
+		return true
+	}
+	return false
+}
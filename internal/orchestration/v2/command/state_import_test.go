@@ -0,0 +1,76 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func TestImportStateCommand_Validate(t *testing.T) {
+	task := func(id string, status repository.TaskStatus) *repository.TaskAssignment {
+		return &repository.TaskAssignment{TaskID: id, Status: status, Implementer: "worker-1"}
+	}
+	assignment := WorkerAssignmentState{WorkerID: "worker-1", TaskID: "perles-abc.1", Phase: events.ProcessPhaseImplementing}
+
+	tests := []struct {
+		name    string
+		workers []WorkerAssignmentState
+		tasks   []*repository.TaskAssignment
+		wantErr string
+	}{
+		{name: "empty state", wantErr: ""},
+		{
+			name:    "valid",
+			workers: []WorkerAssignmentState{assignment},
+			tasks:   []*repository.TaskAssignment{task("perles-abc.1", repository.TaskImplementing)},
+		},
+		{
+			name:    "missing task id",
+			tasks:   []*repository.TaskAssignment{task("", repository.TaskImplementing)},
+			wantErr: "tasks[0]: task_id is required",
+		},
+		{
+			name:    "duplicate task",
+			tasks:   []*repository.TaskAssignment{task("perles-abc.1", repository.TaskImplementing), task("perles-abc.1", repository.TaskCompleted)},
+			wantErr: "duplicate task_id perles-abc.1",
+		},
+		{
+			name:    "unknown task status",
+			tasks:   []*repository.TaskAssignment{task("perles-abc.1", "paused")},
+			wantErr: `invalid status "paused"`,
+		},
+		{
+			name:    "assignment to task not in state",
+			workers: []WorkerAssignmentState{assignment},
+			wantErr: `task "perles-abc.1" is not in the imported tasks`,
+		},
+		{
+			name:    "unknown phase",
+			workers: []WorkerAssignmentState{{WorkerID: "worker-1", TaskID: "perles-abc.1", Phase: "sleeping"}},
+			tasks:   []*repository.TaskAssignment{task("perles-abc.1", repository.TaskImplementing)},
+			wantErr: `invalid phase "sleeping"`,
+		},
+		{
+			name:    "duplicate worker",
+			workers: []WorkerAssignmentState{assignment, assignment},
+			tasks:   []*repository.TaskAssignment{task("perles-abc.1", repository.TaskImplementing)},
+			wantErr: "duplicate worker_id worker-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewImportStateCommand(SourceMCPTool, tt.workers, tt.tasks, nil, nil)
+			require.Equal(t, CmdImportState, cmd.Type())
+			err := cmd.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/validation"
+)
+
+// MaxHoldReasonLength is the maximum length of the reason given for holding a task.
+const MaxHoldReasonLength = 1000
+
+// HoldTaskCommand puts a task on hold so it is not assigned to a worker until released,
+// e.g. while it waits on a human decision. Unlike a blocker or a failure, the hold only
+// affects assignment: bd status and any existing assignment are left alone.
+type HoldTaskCommand struct {
+	*BaseCommand
+	TaskID string // Required: bd task ID to hold
+	Reason string // Optional: why the task is held
+}
+
+// NewHoldTaskCommand creates a new HoldTaskCommand.
+func NewHoldTaskCommand(source CommandSource, taskID, reason string) *HoldTaskCommand {
+	base := NewBaseCommand(CmdHoldTask, source)
+	return &HoldTaskCommand{
+		BaseCommand: &base,
+		TaskID:      taskID,
+		Reason:      reason,
+	}
+}
+
+// Validate checks that TaskID is a valid task ID and Reason is within length limits.
+func (c *HoldTaskCommand) Validate() error {
+	if c.TaskID == "" {
+		return fmt.Errorf("task_id is required")
+	}
+	if !validation.IsValidTaskID(c.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", c.TaskID)
+	}
+	if len(c.Reason) > MaxHoldReasonLength {
+		return fmt.Errorf("reason exceeds maximum length of %d characters", MaxHoldReasonLength)
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *HoldTaskCommand) String() string {
+	return fmt.Sprintf("HoldTask{task=%s, reason=%q}", c.TaskID, truncate(c.Reason, 50))
+}
+
+// ReleaseTaskCommand takes a task off hold so it can be assigned again.
+type ReleaseTaskCommand struct {
+	*BaseCommand
+	TaskID string // Required: bd task ID to release
+}
+
+// NewReleaseTaskCommand creates a new ReleaseTaskCommand.
+func NewReleaseTaskCommand(source CommandSource, taskID string) *ReleaseTaskCommand {
+	base := NewBaseCommand(CmdReleaseTask, source)
+	return &ReleaseTaskCommand{
+		BaseCommand: &base,
+		TaskID:      taskID,
+	}
+}
+
+// Validate checks that TaskID is a valid task ID.
+func (c *ReleaseTaskCommand) Validate() error {
+	if c.TaskID == "" {
+		return fmt.Errorf("task_id is required")
+	}
+	if !validation.IsValidTaskID(c.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", c.TaskID)
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *ReleaseTaskCommand) String() string {
+	return fmt.Sprintf("ReleaseTask{task=%s}", c.TaskID)
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// Task Hold Command Tests
+// ===========================================================================
+
+func TestHoldTaskCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		taskID    string
+		reason    string
+		errSubstr string
+	}{
+		{name: "valid", taskID: "perles-abc1.2", reason: "waiting on product decision"},
+		{name: "valid without reason", taskID: "perles-abc1.2"},
+		{name: "missing task", errSubstr: "task_id is required"},
+		{name: "invalid task", taskID: "not a task; rm -rf", errSubstr: "invalid task_id format"},
+		{name: "reason too long", taskID: "perles-abc1.2", reason: strings.Repeat("x", MaxHoldReasonLength+1), errSubstr: "exceeds maximum length"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewHoldTaskCommand(SourceMCPTool, tt.taskID, tt.reason)
+			require.Equal(t, CmdHoldTask, cmd.Type())
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.ErrorContains(t, err, tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReleaseTaskCommand_Validate(t *testing.T) {
+	cmd := NewReleaseTaskCommand(SourceMCPTool, "perles-abc1.2")
+	require.Equal(t, CmdReleaseTask, cmd.Type())
+	require.NoError(t, cmd.Validate())
+
+	require.ErrorContains(t, NewReleaseTaskCommand(SourceMCPTool, "").Validate(), "task_id is required")
+	require.ErrorContains(t, NewReleaseTaskCommand(SourceMCPTool, "bad id").Validate(), "invalid task_id format")
+}
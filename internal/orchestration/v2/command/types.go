@@ -4,8 +4,11 @@ package command
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/message"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
@@ -51,10 +54,11 @@ func (r ReviewType) IsValid() bool {
 // AssignTaskCommand assigns a bd task to an idle worker.
 type AssignTaskCommand struct {
 	*BaseCommand
-	WorkerID string // Required: ID of the worker to assign the task to
-	TaskID   string // Required: BD task ID to assign
-	Summary  string // Optional: context or instructions for the worker
-	ThreadID string // Optional: Fabric thread ID for task conversation
+	WorkerID        string // Optional: ID of the worker to assign the task to (empty = handler selects a ready worker)
+	TaskID          string // Required: BD task ID to assign
+	Summary         string // Optional: context or instructions for the worker
+	ThreadID        string // Optional: Fabric thread ID for task conversation
+	EstimateMinutes int    // Optional: effort estimate, used when the bd issue has none
 }
 
 // NewAssignTaskCommand creates a new AssignTaskCommand.
@@ -69,24 +73,73 @@ func NewAssignTaskCommand(source CommandSource, workerID, taskID, summary, threa
 	}
 }
 
-// Validate checks that WorkerID and TaskID are provided, and TaskID format is valid.
+// Validate checks that TaskID is provided and its format is valid.
+// WorkerID may be empty, in which case the handler selects a ready worker.
 func (c *AssignTaskCommand) Validate() error {
-	if c.WorkerID == "" {
-		return fmt.Errorf("worker_id is required")
-	}
 	if c.TaskID == "" {
 		return fmt.Errorf("task_id is required")
 	}
 	if !validation.IsValidTaskID(c.TaskID) {
 		return fmt.Errorf("invalid task_id format: %s", c.TaskID)
 	}
+	if c.EstimateMinutes < 0 {
+		return fmt.Errorf("estimate_minutes must be non-negative")
+	}
+	return nil
+}
+
+// TaskAssignmentItem is a single worker/task pair within an AssignTasksBatchCommand.
+type TaskAssignmentItem struct {
+	WorkerID string // Required: ID of the worker to assign the task to
+	TaskID   string // Required: BD task ID to assign
+	Summary  string // Optional: context or instructions for the worker
+	ThreadID string // Optional: Fabric thread ID for task conversation
+}
+
+// AssignTasksBatchCommand assigns several independent bd tasks to idle workers.
+// Each item is assigned best-effort; failures are reported per item.
+type AssignTasksBatchCommand struct {
+	*BaseCommand
+	Assignments []TaskAssignmentItem // Required: at least one assignment
+}
+
+// NewAssignTasksBatchCommand creates a new AssignTasksBatchCommand.
+func NewAssignTasksBatchCommand(source CommandSource, assignments []TaskAssignmentItem) *AssignTasksBatchCommand {
+	base := NewBaseCommand(CmdAssignTasksBatch, source)
+	return &AssignTasksBatchCommand{
+		BaseCommand: &base,
+		Assignments: assignments,
+	}
+}
+
+// Validate checks that at least one assignment is provided and each has a
+// WorkerID and a well-formed TaskID. Duplicates are reported per item by the handler.
+func (c *AssignTasksBatchCommand) Validate() error {
+	if len(c.Assignments) == 0 {
+		return fmt.Errorf("assignments is required")
+	}
+	for i, item := range c.Assignments {
+		if item.WorkerID == "" {
+			return fmt.Errorf("assignments[%d]: worker_id is required", i)
+		}
+		if item.TaskID == "" {
+			return fmt.Errorf("assignments[%d]: task_id is required", i)
+		}
+		if !validation.IsValidTaskID(item.TaskID) {
+			return fmt.Errorf("assignments[%d]: invalid task_id format: %s", i, item.TaskID)
+		}
+	}
 	return nil
 }
 
+// AutoReviewer is a ReviewerID asking the handler to select a ready reviewer,
+// the same as leaving ReviewerID empty.
+const AutoReviewer = "auto"
+
 // AssignReviewCommand assigns a reviewer to an implemented task.
 type AssignReviewCommand struct {
 	*BaseCommand
-	ReviewerID    string     // Required: ID of the worker who will review
+	ReviewerID    string     // Optional: ID of the worker who will review (empty or AutoReviewer = handler selects a ready worker)
 	TaskID        string     // Required: BD task ID being reviewed
 	ImplementerID string     // Required: ID of the worker who implemented the task
 	ReviewType    ReviewType // Optional: "simple" or "complex", defaults to "complex"
@@ -104,11 +157,9 @@ func NewAssignReviewCommand(source CommandSource, reviewerID, taskID, implemente
 	}
 }
 
-// Validate checks that ReviewerID, TaskID, and ImplementerID are provided.
+// Validate checks that TaskID and ImplementerID are provided.
+// ReviewerID may be empty or AutoReviewer, in which case the handler selects a ready worker.
 func (c *AssignReviewCommand) Validate() error {
-	if c.ReviewerID == "" {
-		return fmt.Errorf("reviewer_id is required")
-	}
 	if c.TaskID == "" {
 		return fmt.Errorf("task_id is required")
 	}
@@ -121,8 +172,9 @@ func (c *AssignReviewCommand) Validate() error {
 // ApproveCommitCommand approves implementation and triggers commit phase.
 type ApproveCommitCommand struct {
 	*BaseCommand
-	ImplementerID string // Required: ID of the worker who implemented the task
-	TaskID        string // Required: BD task ID to commit
+	ImplementerID     string // Required: ID of the worker who implemented the task
+	TaskID            string // Required: BD task ID to commit
+	AllowFailingTests bool   // Optional: approve even if reported test results include failures
 }
 
 // NewApproveCommitCommand creates a new ApproveCommitCommand.
@@ -148,11 +200,13 @@ func (c *ApproveCommitCommand) Validate() error {
 
 // AssignReviewFeedbackCommand sends review feedback to an implementer after denial.
 // This transitions the implementer to the AddressingFeedback phase.
+// Feedback may be given as free text, as discrete items tracked on the task, or both.
 type AssignReviewFeedbackCommand struct {
 	*BaseCommand
-	ImplementerID string // Required: ID of the worker who implemented the task
-	TaskID        string // Required: BD task ID that was denied
-	Feedback      string // Required: reviewer feedback explaining what needs to change
+	ImplementerID string   // Required: ID of the worker who implemented the task
+	TaskID        string   // Required: BD task ID that was denied
+	Feedback      string   // Required unless Items is set: reviewer feedback explaining what needs to change
+	Items         []string // Optional: discrete feedback items the implementer marks addressed one by one
 }
 
 // NewAssignReviewFeedbackCommand creates a new AssignReviewFeedbackCommand.
@@ -166,7 +220,8 @@ func NewAssignReviewFeedbackCommand(source CommandSource, implementerID, taskID,
 	}
 }
 
-// Validate checks that ImplementerID, TaskID, and Feedback are provided.
+// Validate checks that ImplementerID, TaskID, and either Feedback or Items are provided,
+// and that no item is blank.
 func (c *AssignReviewFeedbackCommand) Validate() error {
 	if c.ImplementerID == "" {
 		return fmt.Errorf("implementer_id is required")
@@ -174,8 +229,106 @@ func (c *AssignReviewFeedbackCommand) Validate() error {
 	if c.TaskID == "" {
 		return fmt.Errorf("task_id is required")
 	}
-	if c.Feedback == "" {
-		return fmt.Errorf("feedback is required")
+	if c.Feedback == "" && len(c.Items) == 0 {
+		return fmt.Errorf("feedback or items is required")
+	}
+	for i, item := range c.Items {
+		if strings.TrimSpace(item) == "" {
+			return fmt.Errorf("items[%d] is empty", i)
+		}
+	}
+	return nil
+}
+
+// TransferTaskCommand moves an in-flight task from one worker to another.
+// The receiving worker takes over the task in the sender's current phase.
+type TransferTaskCommand struct {
+	*BaseCommand
+	TaskID       string // Required: BD task ID to transfer
+	FromWorkerID string // Required: ID of the worker currently implementing the task
+	ToWorkerID   string // Required: ID of the ready worker taking over the task
+	Note         string // Optional: handoff context for the receiving worker
+}
+
+// NewTransferTaskCommand creates a new TransferTaskCommand.
+func NewTransferTaskCommand(source CommandSource, taskID, fromWorkerID, toWorkerID, note string) *TransferTaskCommand {
+	base := NewBaseCommand(CmdTransferTask, source)
+	return &TransferTaskCommand{
+		BaseCommand:  &base,
+		TaskID:       taskID,
+		FromWorkerID: fromWorkerID,
+		ToWorkerID:   toWorkerID,
+		Note:         note,
+	}
+}
+
+// Validate checks that TaskID, FromWorkerID, and ToWorkerID are provided and distinct workers.
+func (c *TransferTaskCommand) Validate() error {
+	if c.TaskID == "" {
+		return fmt.Errorf("task_id is required")
+	}
+	if c.FromWorkerID == "" {
+		return fmt.Errorf("from_worker is required")
+	}
+	if c.ToWorkerID == "" {
+		return fmt.Errorf("to_worker is required")
+	}
+	if c.FromWorkerID == c.ToWorkerID {
+		return fmt.Errorf("from_worker and to_worker must be different")
+	}
+	return nil
+}
+
+// DrainWorkerCommand moves every in-flight task off a worker and then retires it,
+// for planned scale-down. Each task is transferred to a ready worker; tasks that
+// cannot be transferred are left orphaned for the coordinator to reassign.
+type DrainWorkerCommand struct {
+	*BaseCommand
+	WorkerID string // Required: ID of the worker to drain
+	Reason   string // Optional: why the worker is being drained
+}
+
+// NewDrainWorkerCommand creates a new DrainWorkerCommand.
+func NewDrainWorkerCommand(source CommandSource, workerID, reason string) *DrainWorkerCommand {
+	base := NewBaseCommand(CmdDrainWorker, source)
+	return &DrainWorkerCommand{
+		BaseCommand: &base,
+		WorkerID:    workerID,
+		Reason:      reason,
+	}
+}
+
+// Validate checks that WorkerID is provided.
+func (c *DrainWorkerCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	return nil
+}
+
+// GetEligibleWorkersCommand asks which workers could currently be assigned a BD task.
+// It applies the same checks as AssignTaskCommand without assigning anything.
+type GetEligibleWorkersCommand struct {
+	*BaseCommand
+	TaskID string // Required: BD task ID to check eligibility for
+}
+
+// NewGetEligibleWorkersCommand creates a new GetEligibleWorkersCommand.
+func NewGetEligibleWorkersCommand(source CommandSource, taskID string) *GetEligibleWorkersCommand {
+	base := NewBaseCommand(CmdGetEligibleWorkers, source)
+	return &GetEligibleWorkersCommand{
+		BaseCommand: &base,
+		TaskID:      taskID,
+	}
+}
+
+// Validate checks that TaskID is provided and has a valid format.
+func (c *GetEligibleWorkersCommand) Validate() error {
+	if c.TaskID == "" {
+		return fmt.Errorf("task_id is required")
+	}
+	if !validation.IsValidTaskID(c.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", c.TaskID)
 	}
 	return nil
 }
@@ -185,10 +338,13 @@ func (c *AssignReviewFeedbackCommand) Validate() error {
 // ===========================================================================
 
 // BroadcastCommand broadcasts a message to all workers.
+// Recipient optionally scopes the broadcast to workers in a given role
+// (message.ActorAllReviewers, message.ActorAllImplementers); empty means all workers.
 type BroadcastCommand struct {
 	*BaseCommand
 	Content        string   // Required: message content to broadcast
 	ExcludeWorkers []string // Optional: worker IDs to exclude from the broadcast
+	Recipient      string   // Optional: broadcast token (defaults to message.ActorAll)
 }
 
 // NewBroadcastCommand creates a new BroadcastCommand.
@@ -201,11 +357,14 @@ func NewBroadcastCommand(source CommandSource, content string, excludeWorkers []
 	}
 }
 
-// Validate checks that Content is provided.
+// Validate checks that Content is provided and Recipient is a known broadcast token.
 func (c *BroadcastCommand) Validate() error {
 	if c.Content == "" {
 		return fmt.Errorf("content is required")
 	}
+	if c.Recipient != "" && !message.IsBroadcast(c.Recipient) {
+		return fmt.Errorf("invalid recipient %q: must be one of %s", c.Recipient, strings.Join(message.BroadcastRecipients, ", "))
+	}
 	return nil
 }
 
@@ -238,6 +397,134 @@ func (c *ReportCompleteCommand) Validate() error {
 	return nil
 }
 
+// ReportTestResultsCommand records a worker's test run results on its current task.
+type ReportTestResultsCommand struct {
+	*BaseCommand
+	WorkerID string // Required: ID of the worker reporting results
+	Passed   int    // Required: number of passing tests (non-negative)
+	Failed   int    // Required: number of failing tests (non-negative)
+	Output   string // Optional: test output or summary
+}
+
+// NewReportTestResultsCommand creates a new ReportTestResultsCommand.
+func NewReportTestResultsCommand(source CommandSource, workerID string, passed, failed int, output string) *ReportTestResultsCommand {
+	base := NewBaseCommand(CmdReportTestResults, source)
+	return &ReportTestResultsCommand{
+		BaseCommand: &base,
+		WorkerID:    workerID,
+		Passed:      passed,
+		Failed:      failed,
+		Output:      output,
+	}
+}
+
+// Validate checks that WorkerID is provided and counts are non-negative.
+func (c *ReportTestResultsCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	if c.Passed < 0 {
+		return fmt.Errorf("passed must be non-negative, got %d", c.Passed)
+	}
+	if c.Failed < 0 {
+		return fmt.Errorf("failed must be non-negative, got %d", c.Failed)
+	}
+	return nil
+}
+
+// CheckpointCommand commits a worker's work-in-progress on its current task to the worktree.
+type CheckpointCommand struct {
+	*BaseCommand
+	WorkerID string // Required: ID of the implementer checkpointing its work
+	Message  string // Optional: note on the progress so far, added to the commit message
+}
+
+// NewCheckpointCommand creates a new CheckpointCommand.
+func NewCheckpointCommand(source CommandSource, workerID, message string) *CheckpointCommand {
+	base := NewBaseCommand(CmdCheckpoint, source)
+	return &CheckpointCommand{
+		BaseCommand: &base,
+		WorkerID:    workerID,
+		Message:     message,
+	}
+}
+
+// Validate checks that WorkerID is provided.
+func (c *CheckpointCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	return nil
+}
+
+// MarkFeedbackAddressedCommand marks review feedback items on a worker's current task as addressed.
+type MarkFeedbackAddressedCommand struct {
+	*BaseCommand
+	WorkerID string // Required: ID of the implementer addressing the feedback
+	ItemIDs  []int  // Required: IDs of the feedback items addressed
+}
+
+// NewMarkFeedbackAddressedCommand creates a new MarkFeedbackAddressedCommand.
+func NewMarkFeedbackAddressedCommand(source CommandSource, workerID string, itemIDs []int) *MarkFeedbackAddressedCommand {
+	base := NewBaseCommand(CmdMarkFeedbackAddressed, source)
+	return &MarkFeedbackAddressedCommand{
+		BaseCommand: &base,
+		WorkerID:    workerID,
+		ItemIDs:     itemIDs,
+	}
+}
+
+// Validate checks that WorkerID and at least one positive item ID are provided.
+func (c *MarkFeedbackAddressedCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	if len(c.ItemIDs) == 0 {
+		return fmt.Errorf("item_ids is required")
+	}
+	for _, id := range c.ItemIDs {
+		if id <= 0 {
+			return fmt.Errorf("invalid item id %d: must be positive", id)
+		}
+	}
+	return nil
+}
+
+// MaxRetirementReasonLength is the maximum length of a retirement request reason.
+const MaxRetirementReasonLength = 2000
+
+// RequestRetirementCommand is sent by a worker that wants to be retired after its current turn,
+// e.g. because it detected its context is corrupted.
+type RequestRetirementCommand struct {
+	*BaseCommand
+	WorkerID string // Required: ID of the worker requesting retirement
+	Reason   string // Required: why the worker wants to be retired
+}
+
+// NewRequestRetirementCommand creates a new RequestRetirementCommand.
+func NewRequestRetirementCommand(source CommandSource, workerID, reason string) *RequestRetirementCommand {
+	base := NewBaseCommand(CmdRequestRetirement, source)
+	return &RequestRetirementCommand{
+		BaseCommand: &base,
+		WorkerID:    workerID,
+		Reason:      reason,
+	}
+}
+
+// Validate checks that WorkerID is provided and Reason is non-blank and within length limits.
+func (c *RequestRetirementCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	if strings.TrimSpace(c.Reason) == "" {
+		return fmt.Errorf("reason is required")
+	}
+	if len(c.Reason) > MaxRetirementReasonLength {
+		return fmt.Errorf("reason exceeds maximum length of %d characters", MaxRetirementReasonLength)
+	}
+	return nil
+}
+
 // ReportVerdictCommand signals a reviewer's approval or denial verdict.
 type ReportVerdictCommand struct {
 	*BaseCommand
@@ -319,6 +606,7 @@ func isValidProcessPhase(phase events.ProcessPhase) bool {
 type MarkTaskCompleteCommand struct {
 	*BaseCommand
 	TaskID string // Required: BD task ID to mark as complete
+	Force  bool   // Optional: complete even if the task has not reached the commit phase
 }
 
 // NewMarkTaskCompleteCommand creates a new MarkTaskCompleteCommand.
@@ -344,21 +632,24 @@ func (c *MarkTaskCompleteCommand) Validate() error {
 // MarkTaskFailedCommand marks a BD task as failed with a reason.
 type MarkTaskFailedCommand struct {
 	*BaseCommand
-	TaskID string // Required: BD task ID to mark as failed
-	Reason string // Required: reason for failure
+	TaskID   string                     // Required: BD task ID to mark as failed
+	Reason   string                     // Required: reason for failure
+	Category repository.FailureCategory // Optional: failure classification for aggregation
 }
 
 // NewMarkTaskFailedCommand creates a new MarkTaskFailedCommand.
-func NewMarkTaskFailedCommand(source CommandSource, taskID, reason string) *MarkTaskFailedCommand {
+func NewMarkTaskFailedCommand(source CommandSource, taskID, reason string, category repository.FailureCategory) *MarkTaskFailedCommand {
 	base := NewBaseCommand(CmdMarkTaskFailed, source)
 	return &MarkTaskFailedCommand{
 		BaseCommand: &base,
 		TaskID:      taskID,
 		Reason:      reason,
+		Category:    category,
 	}
 }
 
-// Validate checks that TaskID and Reason are provided and TaskID has a valid format.
+// Validate checks that TaskID and Reason are provided, TaskID has a valid format,
+// and Category (if set) is a known failure category.
 func (c *MarkTaskFailedCommand) Validate() error {
 	if c.TaskID == "" {
 		return fmt.Errorf("task_id is required")
@@ -369,6 +660,157 @@ func (c *MarkTaskFailedCommand) Validate() error {
 	if c.Reason == "" {
 		return fmt.Errorf("reason is required")
 	}
+	if c.Category != "" && !c.Category.IsValid() {
+		valid := make([]string, len(repository.FailureCategories))
+		for i, category := range repository.FailureCategories {
+			valid[i] = string(category)
+		}
+		return fmt.Errorf("invalid category %q: must be one of %s", c.Category, strings.Join(valid, ", "))
+	}
+	return nil
+}
+
+// AddTaskBlockerCommand records in BD that a task is blocked by another issue.
+type AddTaskBlockerCommand struct {
+	*BaseCommand
+	TaskID    string // Required: BD task ID that is blocked
+	BlockerID string // Required: BD issue ID that blocks the task
+}
+
+// NewAddTaskBlockerCommand creates a new AddTaskBlockerCommand.
+func NewAddTaskBlockerCommand(source CommandSource, taskID, blockerID string) *AddTaskBlockerCommand {
+	base := NewBaseCommand(CmdAddTaskBlocker, source)
+	return &AddTaskBlockerCommand{
+		BaseCommand: &base,
+		TaskID:      taskID,
+		BlockerID:   blockerID,
+	}
+}
+
+// Validate checks that TaskID and BlockerID are provided, have a valid format,
+// and are not the same issue.
+func (c *AddTaskBlockerCommand) Validate() error {
+	if c.TaskID == "" {
+		return fmt.Errorf("task_id is required")
+	}
+	if !validation.IsValidTaskID(c.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", c.TaskID)
+	}
+	if c.BlockerID == "" {
+		return fmt.Errorf("blocker_id is required")
+	}
+	if !validation.IsValidTaskID(c.BlockerID) {
+		return fmt.Errorf("invalid blocker_id format: %s", c.BlockerID)
+	}
+	if c.TaskID == c.BlockerID {
+		return fmt.Errorf("task %s cannot block itself", c.TaskID)
+	}
+	return nil
+}
+
+// CompleteEpicTasksCommand marks every open subtask of a BD epic as completed.
+type CompleteEpicTasksCommand struct {
+	*BaseCommand
+	EpicID string // Required: BD epic ID whose subtasks are completed
+	DryRun bool   // Report the subtasks that would be closed without closing them
+}
+
+// NewCompleteEpicTasksCommand creates a new CompleteEpicTasksCommand.
+func NewCompleteEpicTasksCommand(source CommandSource, epicID string) *CompleteEpicTasksCommand {
+	base := NewBaseCommand(CmdCompleteEpicTasks, source)
+	return &CompleteEpicTasksCommand{
+		BaseCommand: &base,
+		EpicID:      epicID,
+	}
+}
+
+// Validate checks that EpicID is provided and has a valid format.
+func (c *CompleteEpicTasksCommand) Validate() error {
+	if c.EpicID == "" {
+		return fmt.Errorf("epic_id is required")
+	}
+	if !validation.IsValidTaskID(c.EpicID) {
+		return fmt.Errorf("invalid epic_id format: %s", c.EpicID)
+	}
+	return nil
+}
+
+// SyncTaskStatusCommand re-reads a task's BD status and brings the coordinator's
+// task assignment in line with it.
+type SyncTaskStatusCommand struct {
+	*BaseCommand
+	TaskID string // Required: BD task ID to refresh
+}
+
+// NewSyncTaskStatusCommand creates a new SyncTaskStatusCommand.
+func NewSyncTaskStatusCommand(source CommandSource, taskID string) *SyncTaskStatusCommand {
+	base := NewBaseCommand(CmdSyncTaskStatus, source)
+	return &SyncTaskStatusCommand{
+		BaseCommand: &base,
+		TaskID:      taskID,
+	}
+}
+
+// Validate checks that TaskID is provided and has a valid format.
+func (c *SyncTaskStatusCommand) Validate() error {
+	if c.TaskID == "" {
+		return fmt.Errorf("task_id is required")
+	}
+	if !validation.IsValidTaskID(c.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", c.TaskID)
+	}
+	return nil
+}
+
+// GetCriticalPathCommand computes the longest dependency chain through a BD epic's subtasks.
+type GetCriticalPathCommand struct {
+	*BaseCommand
+	EpicID string // Required: BD epic ID whose subtasks are scheduled
+}
+
+// NewGetCriticalPathCommand creates a new GetCriticalPathCommand.
+func NewGetCriticalPathCommand(source CommandSource, epicID string) *GetCriticalPathCommand {
+	base := NewBaseCommand(CmdGetCriticalPath, source)
+	return &GetCriticalPathCommand{
+		BaseCommand: &base,
+		EpicID:      epicID,
+	}
+}
+
+// Validate checks that EpicID is provided and has a valid format.
+func (c *GetCriticalPathCommand) Validate() error {
+	if c.EpicID == "" {
+		return fmt.Errorf("epic_id is required")
+	}
+	if !validation.IsValidTaskID(c.EpicID) {
+		return fmt.Errorf("invalid epic_id format: %s", c.EpicID)
+	}
+	return nil
+}
+
+// GetDependencyGraphCommand loads the dependency graph of a BD epic and its subtasks.
+type GetDependencyGraphCommand struct {
+	*BaseCommand
+	EpicID string // Required: BD epic ID whose graph is loaded
+}
+
+// NewGetDependencyGraphCommand creates a new GetDependencyGraphCommand.
+func NewGetDependencyGraphCommand(source CommandSource, epicID string) *GetDependencyGraphCommand {
+	base := NewBaseCommand(CmdGetDependencyGraph, source)
+	return &GetDependencyGraphCommand{
+		BaseCommand: &base,
+		EpicID:      epicID,
+	}
+}
+
+// Validate checks that EpicID is provided and has a valid format.
+func (c *GetDependencyGraphCommand) Validate() error {
+	if c.EpicID == "" {
+		return fmt.Errorf("epic_id is required")
+	}
+	if !validation.IsValidTaskID(c.EpicID) {
+		return fmt.Errorf("invalid epic_id format: %s", c.EpicID)
+	}
 	return nil
 }
 
@@ -1,11 +1,13 @@
 package command
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
@@ -104,12 +106,11 @@ func TestAssignTaskCommand_Validate(t *testing.T) {
 			wantErr:  false,
 		},
 		{
-			name:      "empty worker_id",
-			workerID:  "",
-			taskID:    "perles-abc1",
-			summary:   "",
-			wantErr:   true,
-			errSubstr: "worker_id is required",
+			name:     "empty worker_id selects a ready worker",
+			workerID: "",
+			taskID:   "perles-abc1",
+			summary:  "",
+			wantErr:  false,
 		},
 		{
 			name:      "empty task_id",
@@ -161,6 +162,17 @@ func TestAssignTaskCommand_Validate(t *testing.T) {
 	}
 }
 
+func TestAssignTaskCommand_ValidateEstimate(t *testing.T) {
+	cmd := NewAssignTaskCommand(SourceMCPTool, "worker-1", "perles-abc1", "", "")
+	cmd.EstimateMinutes = 120
+	require.NoError(t, cmd.Validate())
+
+	cmd.EstimateMinutes = -5
+	err := cmd.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "estimate_minutes must be non-negative")
+}
+
 func TestAssignTaskCommand_Type(t *testing.T) {
 	cmd := NewAssignTaskCommand(SourceMCPTool, "worker-1", "perles-abc1", "", "")
 	require.Equal(t, CmdAssignTask, cmd.Type())
@@ -170,6 +182,79 @@ func TestAssignTaskCommand_ImplementsCommand(t *testing.T) {
 	var _ Command = &AssignTaskCommand{}
 }
 
+// ===========================================================================
+// AssignTasksBatchCommand Tests
+// ===========================================================================
+
+func TestAssignTasksBatchCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		assignments []TaskAssignmentItem
+		wantErr     bool
+		errSubstr   string
+	}{
+		{
+			name: "valid",
+			assignments: []TaskAssignmentItem{
+				{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+				{WorkerID: "worker-2", TaskID: "perles-abc1.2", Summary: "do the thing"},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "empty assignments",
+			assignments: nil,
+			wantErr:     true,
+			errSubstr:   "assignments is required",
+		},
+		{
+			name: "missing worker_id",
+			assignments: []TaskAssignmentItem{
+				{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+				{WorkerID: "", TaskID: "perles-abc1.2"},
+			},
+			wantErr:   true,
+			errSubstr: "assignments[1]: worker_id is required",
+		},
+		{
+			name: "missing task_id",
+			assignments: []TaskAssignmentItem{
+				{WorkerID: "worker-1", TaskID: ""},
+			},
+			wantErr:   true,
+			errSubstr: "assignments[0]: task_id is required",
+		},
+		{
+			name: "invalid task_id format",
+			assignments: []TaskAssignmentItem{
+				{WorkerID: "worker-1", TaskID: "invalid"},
+			},
+			wantErr:   true,
+			errSubstr: "invalid task_id format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewAssignTasksBatchCommand(SourceMCPTool, tt.assignments)
+			err := cmd.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errSubstr != "" {
+					require.Contains(t, err.Error(), tt.errSubstr)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAssignTasksBatchCommand_Type(t *testing.T) {
+	cmd := NewAssignTasksBatchCommand(SourceMCPTool, []TaskAssignmentItem{{WorkerID: "worker-1", TaskID: "perles-abc1"}})
+	require.Equal(t, CmdAssignTasksBatch, cmd.Type())
+}
+
 // ===========================================================================
 // AssignReviewCommand Tests
 // ===========================================================================
@@ -191,12 +276,11 @@ func TestAssignReviewCommand_Validate(t *testing.T) {
 			wantErr:       false,
 		},
 		{
-			name:          "empty reviewer_id",
+			name:          "empty reviewer_id selects a ready worker",
 			reviewerID:    "",
 			taskID:        "perles-abc1",
 			implementerID: "worker-1",
-			wantErr:       true,
-			errSubstr:     "reviewer_id is required",
+			wantErr:       false,
 		},
 		{
 			name:          "empty task_id",
@@ -373,6 +457,15 @@ func TestAssignReviewFeedbackCommand_Validate(t *testing.T) {
 	}
 }
 
+func TestAssignReviewFeedbackCommand_ValidateItems(t *testing.T) {
+	cmd := NewAssignReviewFeedbackCommand(SourceMCPTool, "worker-1", "perles-abc1", "")
+	cmd.Items = []string{"Handle the nil case", "Add a test"}
+	require.NoError(t, cmd.Validate(), "items replace free-text feedback")
+
+	cmd.Items = []string{"Handle the nil case", "  "}
+	require.ErrorContains(t, cmd.Validate(), "items[1] is empty")
+}
+
 func TestAssignReviewFeedbackCommand_Type(t *testing.T) {
 	cmd := NewAssignReviewFeedbackCommand(SourceMCPTool, "worker-1", "perles-abc1", "Feedback")
 	require.Equal(t, CmdAssignReviewFeedback, cmd.Type())
@@ -382,6 +475,92 @@ func TestAssignReviewFeedbackCommand_ImplementsCommand(t *testing.T) {
 	var _ Command = &AssignReviewFeedbackCommand{}
 }
 
+// ===========================================================================
+// TransferTaskCommand Tests
+// ===========================================================================
+
+func TestTransferTaskCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		taskID  string
+		from    string
+		to      string
+		wantErr string
+	}{
+		{name: "valid command", taskID: "perles-abc1", from: "worker-1", to: "worker-2"},
+		{name: "missing taskID", from: "worker-1", to: "worker-2", wantErr: "task_id is required"},
+		{name: "missing from", taskID: "perles-abc1", to: "worker-2", wantErr: "from_worker is required"},
+		{name: "missing to", taskID: "perles-abc1", from: "worker-1", wantErr: "to_worker is required"},
+		{name: "same worker", taskID: "perles-abc1", from: "worker-1", to: "worker-1", wantErr: "must be different"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewTransferTaskCommand(SourceMCPTool, tt.taskID, tt.from, tt.to, "")
+			err := cmd.Validate()
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTransferTaskCommand_Type(t *testing.T) {
+	cmd := NewTransferTaskCommand(SourceMCPTool, "perles-abc1", "worker-1", "worker-2", "note")
+	require.Equal(t, CmdTransferTask, cmd.Type())
+	require.Equal(t, "note", cmd.Note)
+}
+
+// ===========================================================================
+// DrainWorkerCommand Tests
+// ===========================================================================
+
+func TestDrainWorkerCommand_Validate(t *testing.T) {
+	require.NoError(t, NewDrainWorkerCommand(SourceMCPTool, "worker-1", "").Validate())
+	require.ErrorContains(t, NewDrainWorkerCommand(SourceMCPTool, "", "").Validate(), "worker_id is required")
+}
+
+func TestDrainWorkerCommand_Type(t *testing.T) {
+	cmd := NewDrainWorkerCommand(SourceMCPTool, "worker-1", "scale down")
+	require.Equal(t, CmdDrainWorker, cmd.Type())
+	require.Equal(t, "scale down", cmd.Reason)
+}
+
+// ===========================================================================
+// GetEligibleWorkersCommand Tests
+// ===========================================================================
+
+func TestGetEligibleWorkersCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		taskID  string
+		wantErr string
+	}{
+		{name: "valid", taskID: "perles-abc1"},
+		{name: "missing taskID", wantErr: "task_id is required"},
+		{name: "invalid taskID", taskID: "not a task", wantErr: "invalid task_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewGetEligibleWorkersCommand(SourceMCPTool, tt.taskID).Validate()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetEligibleWorkersCommand_Type(t *testing.T) {
+	cmd := NewGetEligibleWorkersCommand(SourceMCPTool, "perles-abc1")
+	require.Equal(t, CmdGetEligibleWorkers, cmd.Type())
+}
+
 // ===========================================================================
 // BroadcastCommand Tests
 // ===========================================================================
@@ -431,6 +610,22 @@ func TestBroadcastCommand_Validate(t *testing.T) {
 	}
 }
 
+func TestBroadcastCommand_ValidateRecipient(t *testing.T) {
+	for _, recipient := range []string{"", "ALL", "ALL_REVIEWERS", "ALL_IMPLEMENTERS"} {
+		cmd := NewBroadcastCommand(SourceMCPTool, "content", nil)
+		cmd.Recipient = recipient
+		require.NoError(t, cmd.Validate(), "recipient %q should be valid", recipient)
+	}
+
+	for _, recipient := range []string{"ALL_TESTERS", "all_reviewers", "worker-1", "COORDINATOR"} {
+		cmd := NewBroadcastCommand(SourceMCPTool, "content", nil)
+		cmd.Recipient = recipient
+		err := cmd.Validate()
+		require.Error(t, err, "recipient %q should be rejected", recipient)
+		require.Contains(t, err.Error(), "invalid recipient")
+	}
+}
+
 func TestBroadcastCommand_Type(t *testing.T) {
 	cmd := NewBroadcastCommand(SourceMCPTool, "content", nil)
 	require.Equal(t, CmdBroadcast, cmd.Type())
@@ -498,6 +693,184 @@ func TestReportCompleteCommand_ImplementsCommand(t *testing.T) {
 	var _ Command = &ReportCompleteCommand{}
 }
 
+// ===========================================================================
+// ReportTestResultsCommand Tests
+// ===========================================================================
+
+func TestReportTestResultsCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		workerID  string
+		passed    int
+		failed    int
+		wantErr   bool
+		errSubstr string
+	}{
+		{
+			name:     "valid with failures",
+			workerID: "worker-1",
+			passed:   10,
+			failed:   2,
+			wantErr:  false,
+		},
+		{
+			name:     "valid zero counts",
+			workerID: "worker-1",
+			wantErr:  false,
+		},
+		{
+			name:      "empty worker_id",
+			workerID:  "",
+			passed:    1,
+			wantErr:   true,
+			errSubstr: "worker_id is required",
+		},
+		{
+			name:      "negative passed",
+			workerID:  "worker-1",
+			passed:    -1,
+			wantErr:   true,
+			errSubstr: "passed must be non-negative",
+		},
+		{
+			name:      "negative failed",
+			workerID:  "worker-1",
+			failed:    -3,
+			wantErr:   true,
+			errSubstr: "failed must be non-negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewReportTestResultsCommand(SourceMCPTool, tt.workerID, tt.passed, tt.failed, "")
+			err := cmd.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errSubstr != "" {
+					require.Contains(t, err.Error(), tt.errSubstr)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReportTestResultsCommand_Type(t *testing.T) {
+	cmd := NewReportTestResultsCommand(SourceMCPTool, "worker-1", 1, 0, "")
+	require.Equal(t, CmdReportTestResults, cmd.Type())
+}
+
+// ===========================================================================
+// RequestRetirementCommand Tests
+// ===========================================================================
+
+func TestRequestRetirementCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		workerID  string
+		reason    string
+		wantErr   bool
+		errSubstr string
+	}{
+		{
+			name:     "valid",
+			workerID: "worker-1",
+			reason:   "context is corrupted",
+			wantErr:  false,
+		},
+		{
+			name:      "missing worker_id",
+			reason:    "context is corrupted",
+			wantErr:   true,
+			errSubstr: "worker_id is required",
+		},
+		{
+			name:      "empty reason",
+			workerID:  "worker-1",
+			wantErr:   true,
+			errSubstr: "reason is required",
+		},
+		{
+			name:      "whitespace reason",
+			workerID:  "worker-1",
+			reason:    " \n\t",
+			wantErr:   true,
+			errSubstr: "reason is required",
+		},
+		{
+			name:      "reason too long",
+			workerID:  "worker-1",
+			reason:    strings.Repeat("x", MaxRetirementReasonLength+1),
+			wantErr:   true,
+			errSubstr: "exceeds maximum length",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewRequestRetirementCommand(SourceMCPTool, tt.workerID, tt.reason)
+			err := cmd.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequestRetirementCommand_Type(t *testing.T) {
+	cmd := NewRequestRetirementCommand(SourceMCPTool, "worker-1", "context is corrupted")
+	require.Equal(t, CmdRequestRetirement, cmd.Type())
+}
+
+// ===========================================================================
+// MarkFeedbackAddressedCommand Tests
+// ===========================================================================
+
+func TestMarkFeedbackAddressedCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		workerID string
+		itemIDs  []int
+		wantErr  string
+	}{
+		{name: "valid command", workerID: "worker-1", itemIDs: []int{1, 3}},
+		{name: "missing workerID", workerID: "", itemIDs: []int{1}, wantErr: "worker_id is required"},
+		{name: "missing item IDs", workerID: "worker-1", itemIDs: nil, wantErr: "item_ids is required"},
+		{name: "non-positive item ID", workerID: "worker-1", itemIDs: []int{1, 0}, wantErr: "invalid item id 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewMarkFeedbackAddressedCommand(SourceMCPTool, tt.workerID, tt.itemIDs).Validate()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMarkFeedbackAddressedCommand_Type(t *testing.T) {
+	cmd := NewMarkFeedbackAddressedCommand(SourceMCPTool, "worker-1", []int{1})
+	require.Equal(t, CmdMarkFeedbackAddressed, cmd.Type())
+}
+
+func TestCheckpointCommand_Validate(t *testing.T) {
+	require.NoError(t, NewCheckpointCommand(SourceMCPTool, "worker-1", "").Validate())
+	require.ErrorContains(t, NewCheckpointCommand(SourceMCPTool, "", "wip").Validate(), "worker_id is required")
+}
+
+func TestCheckpointCommand_Type(t *testing.T) {
+	cmd := NewCheckpointCommand(SourceMCPTool, "worker-1", "wip")
+	require.Equal(t, CmdCheckpoint, cmd.Type())
+}
+
 // ===========================================================================
 // ReportVerdictCommand Tests
 // ===========================================================================
@@ -822,7 +1195,7 @@ func TestMarkTaskFailedCommand_Validate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cmd := NewMarkTaskFailedCommand(SourceMCPTool, tt.taskID, tt.reason)
+			cmd := NewMarkTaskFailedCommand(SourceMCPTool, tt.taskID, tt.reason, "")
 			err := cmd.Validate()
 			if tt.wantErr {
 				require.Error(t, err)
@@ -836,8 +1209,32 @@ func TestMarkTaskFailedCommand_Validate(t *testing.T) {
 	}
 }
 
+func TestMarkTaskFailedCommand_ValidateCategory(t *testing.T) {
+	for _, category := range repository.FailureCategories {
+		t.Run(string(category), func(t *testing.T) {
+			cmd := NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", "Some reason", category)
+			require.NoError(t, cmd.Validate())
+		})
+	}
+
+	t.Run("unknown category lists valid set", func(t *testing.T) {
+		cmd := NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", "Some reason", "flaky")
+		err := cmd.Validate()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `invalid category "flaky"`)
+		require.Contains(t, err.Error(), "blocked, test_failure, timeout, tooling_error, unclear_requirements, abandoned")
+	})
+
+	t.Run("category does not replace reason", func(t *testing.T) {
+		cmd := NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", "", repository.FailureBlocked)
+		err := cmd.Validate()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "reason is required")
+	})
+}
+
 func TestMarkTaskFailedCommand_Type(t *testing.T) {
-	cmd := NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", "test failure")
+	cmd := NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", "test failure", "")
 	require.Equal(t, CmdMarkTaskFailed, cmd.Type())
 }
 
@@ -845,6 +1242,180 @@ func TestMarkTaskFailedCommand_ImplementsCommand(t *testing.T) {
 	var _ Command = &MarkTaskFailedCommand{}
 }
 
+// ===========================================================================
+// AddTaskBlockerCommand Tests
+// ===========================================================================
+
+func TestAddTaskBlockerCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		taskID    string
+		blockerID string
+		errSubstr string
+	}{
+		{name: "valid", taskID: "perles-abc1.2", blockerID: "perles-bug9"},
+		{name: "empty task_id", taskID: "", blockerID: "perles-bug9", errSubstr: "task_id is required"},
+		{name: "invalid task_id", taskID: "invalid", blockerID: "perles-bug9", errSubstr: "invalid task_id format"},
+		{name: "empty blocker_id", taskID: "perles-abc1", blockerID: "", errSubstr: "blocker_id is required"},
+		{name: "invalid blocker_id", taskID: "perles-abc1", blockerID: "bug 9", errSubstr: "invalid blocker_id format"},
+		{name: "task blocks itself", taskID: "perles-abc1", blockerID: "perles-abc1", errSubstr: "cannot block itself"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewAddTaskBlockerCommand(SourceMCPTool, tt.taskID, tt.blockerID)
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAddTaskBlockerCommand_Type(t *testing.T) {
+	cmd := NewAddTaskBlockerCommand(SourceMCPTool, "perles-abc1", "perles-bug9")
+	require.Equal(t, CmdAddTaskBlocker, cmd.Type())
+}
+
+// ===========================================================================
+// CompleteEpicTasksCommand Tests
+// ===========================================================================
+
+func TestCompleteEpicTasksCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		epicID    string
+		errSubstr string
+	}{
+		{name: "valid", epicID: "perles-epic1"},
+		{name: "empty epic_id", epicID: "", errSubstr: "epic_id is required"},
+		{name: "invalid epic_id", epicID: "not an id", errSubstr: "invalid epic_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewCompleteEpicTasksCommand(SourceMCPTool, tt.epicID)
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCompleteEpicTasksCommand_Type(t *testing.T) {
+	cmd := NewCompleteEpicTasksCommand(SourceMCPTool, "perles-epic1")
+	require.Equal(t, CmdCompleteEpicTasks, cmd.Type())
+}
+
+// ===========================================================================
+// SyncTaskStatusCommand Tests
+// ===========================================================================
+
+func TestSyncTaskStatusCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		taskID    string
+		errSubstr string
+	}{
+		{name: "valid", taskID: "perles-abc1.2"},
+		{name: "empty task_id", taskID: "", errSubstr: "task_id is required"},
+		{name: "invalid task_id", taskID: "perles-abc; rm -rf /", errSubstr: "invalid task_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewSyncTaskStatusCommand(SourceMCPTool, tt.taskID)
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSyncTaskStatusCommand_Type(t *testing.T) {
+	cmd := NewSyncTaskStatusCommand(SourceMCPTool, "perles-abc1")
+	require.Equal(t, CmdSyncTaskStatus, cmd.Type())
+}
+
+// ===========================================================================
+// GetCriticalPathCommand Tests
+// ===========================================================================
+
+func TestGetCriticalPathCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		epicID    string
+		errSubstr string
+	}{
+		{name: "valid", epicID: "perles-epic1"},
+		{name: "empty epic_id", epicID: "", errSubstr: "epic_id is required"},
+		{name: "invalid epic_id", epicID: "not an id", errSubstr: "invalid epic_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewGetCriticalPathCommand(SourceMCPTool, tt.epicID)
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetCriticalPathCommand_Type(t *testing.T) {
+	cmd := NewGetCriticalPathCommand(SourceMCPTool, "perles-epic1")
+	require.Equal(t, CmdGetCriticalPath, cmd.Type())
+}
+
+// ===========================================================================
+// GetDependencyGraphCommand Tests
+// ===========================================================================
+
+func TestGetDependencyGraphCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		epicID    string
+		errSubstr string
+	}{
+		{name: "valid", epicID: "perles-epic1"},
+		{name: "empty epic_id", epicID: "", errSubstr: "epic_id is required"},
+		{name: "invalid epic_id", epicID: "not an id", errSubstr: "invalid epic_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewGetDependencyGraphCommand(SourceMCPTool, tt.epicID)
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetDependencyGraphCommand_Type(t *testing.T) {
+	cmd := NewGetDependencyGraphCommand(SourceMCPTool, "perles-epic1")
+	require.Equal(t, CmdGetDependencyGraph, cmd.Type())
+}
+
 // ===========================================================================
 // isValidTaskID Tests
 // ===========================================================================
@@ -919,9 +1490,9 @@ func TestEmptyStringValidation(t *testing.T) {
 	}{
 		{"RetireProcess empty ProcessID", NewRetireProcessCommand(SourceMCPTool, "", "reason"), true},
 		{"ReplaceProcess empty ProcessID", NewReplaceProcessCommand(SourceMCPTool, "", "reason"), true},
-		{"AssignTask empty WorkerID", NewAssignTaskCommand(SourceMCPTool, "", "task-1", "", ""), true},
+		{"AssignTask empty WorkerID selects a worker", NewAssignTaskCommand(SourceMCPTool, "", "perles-abc1", "", ""), false},
 		{"AssignTask empty TaskID", NewAssignTaskCommand(SourceMCPTool, "worker-1", "", "", ""), true},
-		{"AssignReview empty ReviewerID", NewAssignReviewCommand(SourceMCPTool, "", "task-1", "worker-1", ReviewTypeComplex), true},
+		{"AssignReview empty ReviewerID selects a worker", NewAssignReviewCommand(SourceMCPTool, "", "task-1", "worker-1", ReviewTypeComplex), false},
 		{"AssignReview empty TaskID", NewAssignReviewCommand(SourceMCPTool, "worker-2", "", "worker-1", ReviewTypeComplex), true},
 		{"AssignReview empty ImplementerID", NewAssignReviewCommand(SourceMCPTool, "worker-2", "task-1", "", ReviewTypeComplex), true},
 		{"ApproveCommit empty ImplementerID", NewApproveCommitCommand(SourceMCPTool, "", "task-1"), true},
@@ -936,8 +1507,8 @@ func TestEmptyStringValidation(t *testing.T) {
 		{"TransitionPhase empty WorkerID", NewTransitionPhaseCommand(SourceInternal, "", events.ProcessPhaseIdle), true},
 		{"TransitionPhase empty NewPhase", NewTransitionPhaseCommand(SourceInternal, "worker-1", ""), true},
 		{"MarkTaskComplete empty TaskID", NewMarkTaskCompleteCommand(SourceMCPTool, ""), true},
-		{"MarkTaskFailed empty TaskID", NewMarkTaskFailedCommand(SourceMCPTool, "", "reason"), true},
-		{"MarkTaskFailed empty Reason", NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", ""), true},
+		{"MarkTaskFailed empty TaskID", NewMarkTaskFailedCommand(SourceMCPTool, "", "reason", ""), true},
+		{"MarkTaskFailed empty Reason", NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", "", ""), true},
 		{"StopProcess empty ProcessID", NewStopProcessCommand(SourceUser, "", false, "reason"), true},
 	}
 
@@ -1,17 +1,22 @@
 // Package handler provides command handlers for the v2 orchestration architecture.
-// This file contains handlers for BD task status commands: MarkTaskComplete and MarkTaskFailed.
+// This file contains handlers for BD task status commands: MarkTaskComplete, MarkTaskFailed,
+// AddTaskBlocker, CompleteEpicTasks, SyncTaskStatus and GetCriticalPath.
 // These handlers interact with the BD executor to update task status in the beads database.
 package handler
 
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
 
 // ===========================================================================
@@ -26,6 +31,8 @@ type MarkTaskCompleteHandler struct {
 	bdExecutor  appbeads.IssueExecutor
 	taskRepo    repository.TaskRepository
 	processRepo repository.ProcessRepository
+	completable []repository.TaskStatus
+	clock       types.Clock
 }
 
 // MarkTaskCompleteHandlerOption configures MarkTaskCompleteHandler.
@@ -39,6 +46,27 @@ func WithMarkTaskCompleteProcessRepo(processRepo repository.ProcessRepository) M
 	}
 }
 
+// WithCompletableStatuses sets the task statuses from which a task may be marked complete
+// without force. Defaults to DefaultCompletableStatuses.
+func WithCompletableStatuses(statuses ...repository.TaskStatus) MarkTaskCompleteHandlerOption {
+	return func(h *MarkTaskCompleteHandler) {
+		h.completable = statuses
+	}
+}
+
+// WithMarkTaskCompleteClock sets the clock used to stamp the finished phase.
+func WithMarkTaskCompleteClock(clock types.Clock) MarkTaskCompleteHandlerOption {
+	return func(h *MarkTaskCompleteHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
+// DefaultCompletableStatuses are the task statuses from which mark_task_complete
+// succeeds without force: the work has been approved and is being committed.
+var DefaultCompletableStatuses = []repository.TaskStatus{repository.TaskCommitting}
+
 // NewMarkTaskCompleteHandler creates a new MarkTaskCompleteHandler.
 // Panics if bdExecutor is nil.
 // taskRepo can be nil for backward compatibility (graceful degradation).
@@ -48,8 +76,10 @@ func NewMarkTaskCompleteHandler(bdExecutor appbeads.IssueExecutor, taskRepo repo
 		panic("bdExecutor is required for MarkTaskCompleteHandler")
 	}
 	h := &MarkTaskCompleteHandler{
-		bdExecutor: bdExecutor,
-		taskRepo:   taskRepo,
+		bdExecutor:  bdExecutor,
+		taskRepo:    taskRepo,
+		completable: DefaultCompletableStatuses,
+		clock:       types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -60,10 +90,19 @@ func NewMarkTaskCompleteHandler(bdExecutor appbeads.IssueExecutor, taskRepo repo
 // Handle processes a MarkTaskCompleteCommand.
 // It updates the BD task status to "closed", adds a completion comment,
 // resets associated worker processes (implementer/reviewer) to idle, and
-// deletes the in-memory task assignment.
+// marks the in-memory task assignment completed so its history stays queryable.
 func (h *MarkTaskCompleteHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	markCmd := cmd.(*command.MarkTaskCompleteCommand)
 
+	// Refuse to complete tracked tasks whose work is unfinished, unless forced.
+	// Tasks without an in-memory assignment have no phase to check.
+	if !markCmd.Force && h.taskRepo != nil {
+		if task, err := h.taskRepo.Get(markCmd.TaskID); err == nil && !slices.Contains(h.completable, task.Status) {
+			return nil, fmt.Errorf("%w: task %s is %s, expected %s (set force to complete anyway)",
+				types.ErrTaskNotReadyToComplete, markCmd.TaskID, task.Status, joinTaskStatuses(h.completable))
+		}
+	}
+
 	// 1. Update task status to closed
 	if err := h.bdExecutor.UpdateStatus(markCmd.TaskID, beads.StatusClosed); err != nil {
 		return nil, fmt.Errorf("failed to update BD task status: %w", err)
@@ -75,9 +114,9 @@ func (h *MarkTaskCompleteHandler) Handle(ctx context.Context, cmd command.Comman
 		return nil, fmt.Errorf("failed to add BD comment: %w", err)
 	}
 
-	// 3. Reset associated worker processes to idle before deleting the task.
+	// 3. Reset associated worker processes to idle before completing the task.
 	// This prevents workers from getting stuck in stale phases (e.g., awaiting_review)
-	// with a TaskID pointing to a deleted task, which would block future assign_task calls.
+	// with a TaskID pointing to a finished task, which would block future assign_task calls.
 	// Queue draining is handled by ProcessTurnCompleteHandler when the worker's next turn completes.
 	var resultEvents []any
 	if h.taskRepo != nil && h.processRepo != nil {
@@ -85,20 +124,25 @@ func (h *MarkTaskCompleteHandler) Handle(ctx context.Context, cmd command.Comman
 		if err == nil {
 			// Reset implementer to idle
 			if task.Implementer != "" {
-				resultEvents = append(resultEvents, h.resetProcessToIdle(task.Implementer)...)
+				resultEvents = append(resultEvents, resetProcessToIdle(h.processRepo, task.Implementer)...)
 			}
 			// Reset reviewer to idle (if one was assigned)
 			if task.Reviewer != "" {
-				resultEvents = append(resultEvents, h.resetProcessToIdle(task.Reviewer)...)
+				resultEvents = append(resultEvents, resetProcessToIdle(h.processRepo, task.Reviewer)...)
 			}
 		}
 		// If task not found, nothing to reset - proceed gracefully
 	}
 
-	// 4. Remove task from in-memory tracking
+	// 4. Mark the task completed, keeping it for get_task_timings and metrics.
+	// Completed tasks no longer count as assigned to their workers.
 	// This is best-effort - task may not exist in memory if workflow was restarted
 	if h.taskRepo != nil {
-		_ = h.taskRepo.Delete(markCmd.TaskID)
+		if task, err := h.taskRepo.Get(markCmd.TaskID); err == nil {
+			task.Status = repository.TaskCompleted
+			task.EnterPhase(repository.TaskPhaseFinished, h.clock.Now())
+			_ = h.taskRepo.Save(task)
+		}
 	}
 
 	// 5. Return success result with any events from process resets
@@ -112,11 +156,20 @@ func (h *MarkTaskCompleteHandler) Handle(ctx context.Context, cmd command.Comman
 	return SuccessResult(result), nil
 }
 
+// joinTaskStatuses formats statuses as "a or b".
+func joinTaskStatuses(statuses []repository.TaskStatus) string {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+	return strings.Join(names, " or ")
+}
+
 // resetProcessToIdle resets a worker process to idle phase, ready status, and clears its TaskID.
 // Returns a ProcessStatusChange event so TUI/observers see the transition, or nil if the
 // process was not reset (not found, terminal state, or save failure).
-func (h *MarkTaskCompleteHandler) resetProcessToIdle(processID string) []any {
-	proc, err := h.processRepo.Get(processID)
+func resetProcessToIdle(processRepo repository.ProcessRepository, processID string) []any {
+	proc, err := processRepo.Get(processID)
 	if err != nil {
 		// Process not found (may have been retired/stopped) - gracefully skip
 		return nil
@@ -138,8 +191,8 @@ func (h *MarkTaskCompleteHandler) resetProcessToIdle(processID string) []any {
 	proc.Status = repository.StatusReady
 	proc.TaskID = ""
 
-	if err := h.processRepo.Save(proc); err != nil {
-		// Save failed - log but don't fail the task completion
+	if err := processRepo.Save(proc); err != nil {
+		// Save failed - skip rather than fail the caller
 		return nil
 	}
 
@@ -160,37 +213,82 @@ type MarkTaskCompleteResult struct {
 // ===========================================================================
 
 // MarkTaskFailedHandler handles CmdMarkTaskFailed commands.
-// It adds a failure comment to the BD task with the provided reason.
+// It adds a failure comment to the BD task with the provided reason and,
+// when a task repository is configured, records the failure category.
 type MarkTaskFailedHandler struct {
 	bdExecutor appbeads.IssueExecutor
+	taskRepo   repository.TaskRepository
+	clock      types.Clock
+}
+
+// MarkTaskFailedHandlerOption configures MarkTaskFailedHandler.
+type MarkTaskFailedHandlerOption func(*MarkTaskFailedHandler)
+
+// WithMarkTaskFailedTaskRepo sets the task repository used to record failure
+// category and reason on the in-memory task assignment.
+func WithMarkTaskFailedTaskRepo(taskRepo repository.TaskRepository) MarkTaskFailedHandlerOption {
+	return func(h *MarkTaskFailedHandler) {
+		h.taskRepo = taskRepo
+	}
+}
+
+// WithMarkTaskFailedClock sets the clock used to stamp the finished phase.
+func WithMarkTaskFailedClock(clock types.Clock) MarkTaskFailedHandlerOption {
+	return func(h *MarkTaskFailedHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
 }
 
 // NewMarkTaskFailedHandler creates a new MarkTaskFailedHandler.
 // Panics if bdExecutor is nil.
-func NewMarkTaskFailedHandler(bdExecutor appbeads.IssueExecutor) *MarkTaskFailedHandler {
+func NewMarkTaskFailedHandler(bdExecutor appbeads.IssueExecutor, opts ...MarkTaskFailedHandlerOption) *MarkTaskFailedHandler {
 	if bdExecutor == nil {
 		panic("bdExecutor is required for MarkTaskFailedHandler")
 	}
-	return &MarkTaskFailedHandler{
+	h := &MarkTaskFailedHandler{
 		bdExecutor: bdExecutor,
+		clock:      types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // Handle processes a MarkTaskFailedCommand.
-// It adds a failure comment to the BD task with the provided reason.
+// It adds a failure comment to the BD task with the provided reason and
+// marks the task assignment failed with its category.
 func (h *MarkTaskFailedHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	markCmd := cmd.(*command.MarkTaskFailedCommand)
 
-	// 1. Add failure comment with reason
+	// 1. Add failure comment with reason (and category when provided)
 	comment := fmt.Sprintf("Task failed: %s", markCmd.Reason)
+	if markCmd.Category != "" {
+		comment = fmt.Sprintf("Task failed [%s]: %s", markCmd.Category, markCmd.Reason)
+	}
 	if err := h.bdExecutor.AddComment(markCmd.TaskID, "coordinator", comment); err != nil {
 		return nil, fmt.Errorf("failed to add BD comment: %w", err)
 	}
 
-	// 2. Return success result
+	// 2. Record failure on the task assignment for later aggregation.
+	// Best-effort - task may not exist in memory if it was never assigned or workflow restarted.
+	if h.taskRepo != nil {
+		if task, err := h.taskRepo.Get(markCmd.TaskID); err == nil {
+			task.Status = repository.TaskFailed
+			task.EnterPhase(repository.TaskPhaseFinished, h.clock.Now())
+			task.FailureCategory = markCmd.Category
+			task.FailureReason = markCmd.Reason
+			_ = h.taskRepo.Save(task)
+		}
+	}
+
+	// 3. Return success result
 	result := &MarkTaskFailedResult{
-		TaskID: markCmd.TaskID,
-		Reason: markCmd.Reason,
+		TaskID:   markCmd.TaskID,
+		Reason:   markCmd.Reason,
+		Category: markCmd.Category,
 	}
 
 	return SuccessResult(result), nil
@@ -198,6 +296,651 @@ func (h *MarkTaskFailedHandler) Handle(ctx context.Context, cmd command.Command)
 
 // MarkTaskFailedResult contains the result of marking a task as failed.
 type MarkTaskFailedResult struct {
-	TaskID string
-	Reason string
+	TaskID   string
+	Reason   string
+	Category repository.FailureCategory
+}
+
+// ===========================================================================
+// AddTaskBlockerHandler
+// ===========================================================================
+
+// AddTaskBlockerHandler handles CmdAddTaskBlocker commands.
+// It records in BD that a task is blocked by another issue, rejecting the link if the
+// blocker already (transitively) depends on the task.
+type AddTaskBlockerHandler struct {
+	bdExecutor appbeads.IssueExecutor
+	taskRepo   repository.TaskRepository
+}
+
+// AddTaskBlockerHandlerOption configures AddTaskBlockerHandler.
+type AddTaskBlockerHandlerOption func(*AddTaskBlockerHandler)
+
+// WithAddTaskBlockerTaskRepo sets the task repository used to record the blocker
+// on the in-memory task assignment.
+func WithAddTaskBlockerTaskRepo(taskRepo repository.TaskRepository) AddTaskBlockerHandlerOption {
+	return func(h *AddTaskBlockerHandler) {
+		h.taskRepo = taskRepo
+	}
+}
+
+// NewAddTaskBlockerHandler creates a new AddTaskBlockerHandler.
+// Panics if bdExecutor is nil.
+func NewAddTaskBlockerHandler(bdExecutor appbeads.IssueExecutor, opts ...AddTaskBlockerHandlerOption) *AddTaskBlockerHandler {
+	if bdExecutor == nil {
+		panic("bdExecutor is required for AddTaskBlockerHandler")
+	}
+	h := &AddTaskBlockerHandler{
+		bdExecutor: bdExecutor,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes an AddTaskBlockerCommand.
+// Both issues must exist in BD. The dependency is only written if the blocker is not
+// already blocked, directly or transitively, by the task.
+func (h *AddTaskBlockerHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	blockerCmd := cmd.(*command.AddTaskBlockerCommand)
+
+	// 1. Verify the task exists; an existing link is not written again
+	task, err := h.bdExecutor.ShowIssue(blockerCmd.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task %s: %w", blockerCmd.TaskID, err)
+	}
+	alreadyBlocked := slices.Contains(task.BlockedBy, blockerCmd.BlockerID)
+
+	// 2. Walk the blocker's dependencies to make sure it does not depend on the task
+	if !alreadyBlocked {
+		path, err := h.findBlockerPath(blockerCmd.BlockerID, blockerCmd.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		if path != nil {
+			return nil, fmt.Errorf("%w: %s is already blocked by %s via %s",
+				types.ErrDependencyCycle, blockerCmd.BlockerID, blockerCmd.TaskID, strings.Join(path, " -> "))
+		}
+
+		if err := h.bdExecutor.AddDependency(blockerCmd.TaskID, blockerCmd.BlockerID); err != nil {
+			return nil, fmt.Errorf("failed to add BD dependency: %w", err)
+		}
+	}
+
+	// 3. Record the blocker on the task assignment.
+	// Best-effort - task may not exist in memory if it was never assigned or workflow restarted.
+	if h.taskRepo != nil {
+		if assignment, err := h.taskRepo.Get(blockerCmd.TaskID); err == nil && !slices.Contains(assignment.BlockedBy, blockerCmd.BlockerID) {
+			assignment.BlockedBy = append(assignment.BlockedBy, blockerCmd.BlockerID)
+			_ = h.taskRepo.Save(assignment)
+		}
+	}
+
+	result := &AddTaskBlockerResult{
+		TaskID:         blockerCmd.TaskID,
+		BlockerID:      blockerCmd.BlockerID,
+		AlreadyBlocked: alreadyBlocked,
+	}
+
+	return SuccessResult(result), nil
+}
+
+// findBlockerPath searches the issues blocking fromID, breadth first, for targetID.
+// Returns the chain of issue IDs from fromID to targetID, or nil if targetID does not
+// block fromID. Returns an error if fromID or any issue on the way cannot be read.
+func (h *AddTaskBlockerHandler) findBlockerPath(fromID, targetID string) ([]string, error) {
+	parent := map[string]string{fromID: ""}
+	queue := []string{fromID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		issue, err := h.bdExecutor.ShowIssue(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue %s: %w", id, err)
+		}
+		for _, next := range issue.BlockedBy {
+			if _, seen := parent[next]; seen {
+				continue
+			}
+			parent[next] = id
+			if next == targetID {
+				path := []string{next}
+				for cur := id; cur != ""; cur = parent[cur] {
+					path = append([]string{cur}, path...)
+				}
+				return path, nil
+			}
+			queue = append(queue, next)
+		}
+	}
+	return nil, nil
+}
+
+// AddTaskBlockerResult contains the result of recording a blocker on a task.
+type AddTaskBlockerResult struct {
+	TaskID         string
+	BlockerID      string
+	AlreadyBlocked bool // True if BD already recorded the dependency
+}
+
+// IsAlreadyBlocked returns true if BD already recorded the dependency before this command.
+func (r *AddTaskBlockerResult) IsAlreadyBlocked() bool {
+	return r.AlreadyBlocked
+}
+
+// ===========================================================================
+// CompleteEpicTasksHandler
+// ===========================================================================
+
+// CompleteEpicTasksHandler handles CmdCompleteEpicTasks commands.
+// It finds the open subtasks of a BD epic with a BQL query and closes them in a single
+// bulk update. Subtasks still actively assigned to a worker are skipped.
+type CompleteEpicTasksHandler struct {
+	bdExecutor appbeads.IssueExecutor
+	tracker    bql.BQLExecutor
+	taskRepo   repository.TaskRepository
+	progress   ProgressReporter
+	clock      types.Clock
+}
+
+// CompleteEpicTasksHandlerOption configures CompleteEpicTasksHandler.
+type CompleteEpicTasksHandlerOption func(*CompleteEpicTasksHandler)
+
+// WithCompleteEpicTasksTaskRepo sets the task repository used to skip actively assigned
+// subtasks and to mark closed subtasks completed in coordinator state.
+func WithCompleteEpicTasksTaskRepo(taskRepo repository.TaskRepository) CompleteEpicTasksHandlerOption {
+	return func(h *CompleteEpicTasksHandler) {
+		h.taskRepo = taskRepo
+	}
+}
+
+// WithCompleteEpicTasksProgress sets the reporter that receives an update after the
+// subtask lookup and after the subtasks are closed.
+func WithCompleteEpicTasksProgress(reporter ProgressReporter) CompleteEpicTasksHandlerOption {
+	return func(h *CompleteEpicTasksHandler) {
+		h.progress = reporter
+	}
+}
+
+// WithCompleteEpicTasksClock sets the clock used to stamp the finished phase of closed subtasks.
+func WithCompleteEpicTasksClock(clock types.Clock) CompleteEpicTasksHandlerOption {
+	return func(h *CompleteEpicTasksHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
+// NewCompleteEpicTasksHandler creates a new CompleteEpicTasksHandler.
+// Panics if bdExecutor is nil. tracker may be nil, in which case every command fails
+// because subtasks cannot be looked up.
+func NewCompleteEpicTasksHandler(bdExecutor appbeads.IssueExecutor, tracker bql.BQLExecutor, opts ...CompleteEpicTasksHandlerOption) *CompleteEpicTasksHandler {
+	if bdExecutor == nil {
+		panic("bdExecutor is required for CompleteEpicTasksHandler")
+	}
+	h := &CompleteEpicTasksHandler{
+		bdExecutor: bdExecutor,
+		tracker:    tracker,
+		clock:      types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes a CompleteEpicTasksCommand.
+// Open children of the epic are closed in BD and any coordinator task assignments they
+// have are marked completed. Children with an active assignment are left untouched.
+// A dry run reports the same result without closing anything. An epic that BD does not
+// know fails the command.
+func (h *CompleteEpicTasksHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	epicCmd := cmd.(*command.CompleteEpicTasksCommand)
+
+	if h.tracker == nil {
+		return nil, fmt.Errorf("BQL tracker not configured: cannot look up subtasks of %s", epicCmd.EpicID)
+	}
+
+	// 1. Find the epic's children that are not already closed
+	reportProgress(h.progress, fmt.Sprintf("Looking up subtasks of %s", epicCmd.EpicID))
+	issues, err := h.tracker.Execute(fmt.Sprintf("id = %q expand down depth 1", epicCmd.EpicID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subtasks of %s: %w", epicCmd.EpicID, err)
+	}
+
+	// 2. Split them into closable and actively assigned subtasks
+	var closable, skipped []string
+	found := false
+	for _, issue := range issues {
+		if issue.ID == epicCmd.EpicID || issue.ParentID == epicCmd.EpicID {
+			found = true
+		}
+		if issue.ParentID != epicCmd.EpicID || issue.Status == beads.StatusClosed {
+			continue
+		}
+		if h.isActivelyAssigned(issue.ID) {
+			skipped = append(skipped, issue.ID)
+			continue
+		}
+		closable = append(closable, issue.ID)
+	}
+	if !found {
+		return nil, fmt.Errorf("epic not found: %s", epicCmd.EpicID)
+	}
+	slices.Sort(closable)
+	slices.Sort(skipped)
+	reportProgress(h.progress, fmt.Sprintf("Found %d open subtasks of %s (%d still assigned)",
+		len(closable)+len(skipped), epicCmd.EpicID, len(skipped)))
+
+	if epicCmd.DryRun {
+		return SuccessResult(&CompleteEpicTasksResult{
+			EpicID:  epicCmd.EpicID,
+			Closed:  closable,
+			Skipped: skipped,
+			DryRun:  true,
+		}), nil
+	}
+
+	// 3. Close the remaining subtasks in one BD call
+	if len(closable) > 0 {
+		if err := h.bdExecutor.BulkUpdateStatus(closable, beads.StatusClosed); err != nil {
+			return nil, fmt.Errorf("failed to close subtasks of %s: %w", epicCmd.EpicID, err)
+		}
+		reportProgress(h.progress, fmt.Sprintf("Closed %d subtasks of %s", len(closable), epicCmd.EpicID))
+	}
+
+	// 4. Mark any coordinator task assignments completed.
+	// Best-effort - subtasks that were never assigned have no coordinator state.
+	if h.taskRepo != nil {
+		now := h.clock.Now()
+		for _, id := range closable {
+			if task, err := h.taskRepo.Get(id); err == nil {
+				task.Status = repository.TaskCompleted
+				task.EnterPhase(repository.TaskPhaseFinished, now)
+				_ = h.taskRepo.Save(task)
+			}
+		}
+	}
+
+	result := &CompleteEpicTasksResult{
+		EpicID:  epicCmd.EpicID,
+		Closed:  closable,
+		Skipped: skipped,
+	}
+
+	return SuccessResult(result), nil
+}
+
+// isActivelyAssigned returns true if the coordinator has an unfinished assignment for the task.
+func (h *CompleteEpicTasksHandler) isActivelyAssigned(taskID string) bool {
+	if h.taskRepo == nil {
+		return false
+	}
+	task, err := h.taskRepo.Get(taskID)
+	if err != nil {
+		return false
+	}
+	return task.Status != repository.TaskCompleted && task.Status != repository.TaskFailed
+}
+
+// CompleteEpicTasksResult contains the result of completing an epic's subtasks.
+type CompleteEpicTasksResult struct {
+	EpicID  string
+	Closed  []string // Subtasks closed by this command (or that would be, for a dry run)
+	Skipped []string // Open subtasks left alone because they are still assigned
+	DryRun  bool     // Nothing was closed; Closed lists what would have been
+}
+
+// ClosedTaskIDs returns the subtasks closed by the command.
+func (r *CompleteEpicTasksResult) ClosedTaskIDs() []string {
+	return r.Closed
+}
+
+// SkippedTaskIDs returns the open subtasks left alone because they are still assigned.
+func (r *CompleteEpicTasksResult) SkippedTaskIDs() []string {
+	return r.Skipped
+}
+
+// IsDryRun returns true if the command only reported what it would close.
+func (r *CompleteEpicTasksResult) IsDryRun() bool {
+	return r.DryRun
+}
+
+// ===========================================================================
+// SyncTaskStatusHandler
+// ===========================================================================
+
+// SyncTaskStatusHandler handles CmdSyncTaskStatus commands.
+// It re-reads a task's BD status and corrects the coordinator's task assignment when a
+// human changed the status directly in BD:
+//   - closed in BD marks the assignment completed
+//   - blocked or deferred in BD marks an unfinished assignment failed
+//   - open or in progress in BD reopens a completed or failed assignment
+//
+// With a process repository, workers still holding a corrected task are reset to idle.
+// A reopened task is left without an implementer, so list_orphaned_tasks reports it
+// for the coordinator to assign again.
+type SyncTaskStatusHandler struct {
+	issueReader appbeads.IssueReader
+	taskRepo    repository.TaskRepository
+	processRepo repository.ProcessRepository
+	clock       types.Clock
+}
+
+// SyncTaskStatusHandlerOption configures SyncTaskStatusHandler.
+type SyncTaskStatusHandlerOption func(*SyncTaskStatusHandler)
+
+// WithSyncTaskStatusClock sets the clock used to stamp corrected task phases.
+func WithSyncTaskStatusClock(clock types.Clock) SyncTaskStatusHandlerOption {
+	return func(h *SyncTaskStatusHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
+// WithSyncTaskStatusProcessRepo sets the process repository used to release the
+// workers of a task whose status was corrected.
+func WithSyncTaskStatusProcessRepo(processRepo repository.ProcessRepository) SyncTaskStatusHandlerOption {
+	return func(h *SyncTaskStatusHandler) {
+		h.processRepo = processRepo
+	}
+}
+
+// NewSyncTaskStatusHandler creates a new SyncTaskStatusHandler.
+// Panics if issueReader or taskRepo is nil.
+func NewSyncTaskStatusHandler(issueReader appbeads.IssueReader, taskRepo repository.TaskRepository, opts ...SyncTaskStatusHandlerOption) *SyncTaskStatusHandler {
+	if issueReader == nil {
+		panic("issueReader is required for SyncTaskStatusHandler")
+	}
+	if taskRepo == nil {
+		panic("taskRepo is required for SyncTaskStatusHandler")
+	}
+	h := &SyncTaskStatusHandler{
+		issueReader: issueReader,
+		taskRepo:    taskRepo,
+		clock:       types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes a SyncTaskStatusCommand.
+// Returns an error if the task cannot be read from BD or has no coordinator assignment.
+func (h *SyncTaskStatusHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	syncCmd := cmd.(*command.SyncTaskStatusCommand)
+
+	// 1. Read the current status from BD
+	issue, err := h.issueReader.ShowIssue(syncCmd.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task %s: %w", syncCmd.TaskID, err)
+	}
+
+	// 2. Look up the coordinator's assignment
+	task, err := h.taskRepo.Get(syncCmd.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("task %s has no coordinator assignment: %w", syncCmd.TaskID, err)
+	}
+
+	result := &SyncTaskStatusResult{
+		TaskID:         syncCmd.TaskID,
+		BeadsStatus:    issue.Status,
+		PreviousStatus: task.Status,
+		Status:         task.Status,
+	}
+
+	// 3. Correct the assignment if it disagrees with BD
+	finished := task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed
+	now := h.clock.Now()
+	switch issue.Status {
+	case beads.StatusClosed:
+		if task.Status == repository.TaskCompleted {
+			return SuccessResult(result), nil
+		}
+		task.Status = repository.TaskCompleted
+		task.EnterPhase(repository.TaskPhaseFinished, now)
+	case beads.StatusBlocked, beads.StatusDeferred:
+		if finished {
+			return SuccessResult(result), nil
+		}
+		task.Status = repository.TaskFailed
+		task.EnterPhase(repository.TaskPhaseFinished, now)
+		task.FailureReason = fmt.Sprintf("status set to %s in bd", issue.Status)
+		if issue.Status == beads.StatusBlocked {
+			task.FailureCategory = repository.FailureBlocked
+		}
+	default:
+		if !finished {
+			return SuccessResult(result), nil
+		}
+		task.Status = repository.TaskImplementing
+		task.EnterPhase(repository.TaskPhaseImplementing, now)
+		task.FailureCategory = ""
+		task.FailureReason = ""
+		result.Orphaned = true
+	}
+
+	// 4. Release the workers still holding the task; a reopened task starts over unowned
+	resultEvents := h.releaseWorkers(task)
+	if result.Orphaned {
+		task.Implementer = ""
+		task.Reviewer = ""
+	}
+
+	if err := h.taskRepo.Save(task); err != nil {
+		return nil, fmt.Errorf("failed to save task %s: %w", syncCmd.TaskID, err)
+	}
+	result.Status = task.Status
+	result.Corrected = true
+
+	if len(resultEvents) > 0 {
+		return SuccessWithEvents(result, resultEvents...), nil
+	}
+	return SuccessResult(result), nil
+}
+
+// releaseWorkers resets the task's implementer and reviewer to idle if they still hold
+// it. Workers that have moved on to another task are left alone.
+func (h *SyncTaskStatusHandler) releaseWorkers(task *repository.TaskAssignment) []any {
+	if h.processRepo == nil {
+		return nil
+	}
+	var resultEvents []any
+	for _, workerID := range []string{task.Implementer, task.Reviewer} {
+		if workerID == "" {
+			continue
+		}
+		if proc, err := h.processRepo.Get(workerID); err != nil || proc.TaskID != task.TaskID {
+			continue
+		}
+		resultEvents = append(resultEvents, resetProcessToIdle(h.processRepo, workerID)...)
+	}
+	return resultEvents
+}
+
+// SyncTaskStatusResult contains the result of refreshing a task's status from BD.
+type SyncTaskStatusResult struct {
+	TaskID         string
+	BeadsStatus    beads.Status
+	PreviousStatus repository.TaskStatus // Assignment status before the sync
+	Status         repository.TaskStatus // Assignment status after the sync
+	Corrected      bool                  // True if the assignment diverged from BD and was updated
+	Orphaned       bool                  // True if the task was reopened and needs a new implementer
+}
+
+// GetBeadsStatus returns the task's status in BD.
+func (r *SyncTaskStatusResult) GetBeadsStatus() string {
+	return string(r.BeadsStatus)
+}
+
+// GetPreviousStatus returns the assignment status before the sync.
+func (r *SyncTaskStatusResult) GetPreviousStatus() string {
+	return string(r.PreviousStatus)
+}
+
+// GetStatus returns the assignment status after the sync.
+func (r *SyncTaskStatusResult) GetStatus() string {
+	return string(r.Status)
+}
+
+// WasCorrected returns true if the assignment diverged from BD and was updated.
+func (r *SyncTaskStatusResult) WasCorrected() bool {
+	return r.Corrected
+}
+
+// IsOrphaned returns true if the task was reopened and needs a new implementer.
+func (r *SyncTaskStatusResult) IsOrphaned() bool {
+	return r.Orphaned
+}
+
+// ===========================================================================
+// GetCriticalPathHandler
+// ===========================================================================
+
+// GetCriticalPathHandler handles CmdGetCriticalPath commands.
+// It loads an epic's subtasks with a BQL query and finds the longest chain of blocking
+// dependencies between the ones still open, weighted by effort estimates if every subtask
+// has one. Read-only: nothing in BD or coordinator state is changed.
+type GetCriticalPathHandler struct {
+	tracker bql.BQLExecutor
+}
+
+// NewGetCriticalPathHandler creates a new GetCriticalPathHandler.
+// tracker may be nil, in which case every command fails because subtasks cannot be looked up.
+func NewGetCriticalPathHandler(tracker bql.BQLExecutor) *GetCriticalPathHandler {
+	return &GetCriticalPathHandler{tracker: tracker}
+}
+
+// Handle processes a GetCriticalPathCommand.
+// Closed subtasks no longer constrain the schedule and are left out of the graph.
+// Returns an error naming the offending subtasks if their dependencies form a cycle.
+func (h *GetCriticalPathHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	pathCmd := cmd.(*command.GetCriticalPathCommand)
+
+	if h.tracker == nil {
+		return nil, fmt.Errorf("BQL tracker not configured: cannot look up subtasks of %s", pathCmd.EpicID)
+	}
+
+	// 1. Load the epic's subtasks along with their dependencies
+	issues, err := h.tracker.Execute(fmt.Sprintf("id = %q expand down depth 1", pathCmd.EpicID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subtasks of %s: %w", pathCmd.EpicID, err)
+	}
+
+	var open []beads.Issue
+	for _, issue := range issues {
+		if issue.ParentID == pathCmd.EpicID && issue.Status != beads.StatusClosed {
+			open = append(open, issue)
+		}
+	}
+
+	// 2. Find the longest dependency chain between them
+	path, err := beads.FindCriticalPath(open)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute critical path of %s: %w", pathCmd.EpicID, err)
+	}
+
+	result := &GetCriticalPathResult{
+		EpicID:    pathCmd.EpicID,
+		TaskIDs:   path.IssueIDs,
+		Length:    path.Length,
+		Estimated: path.Estimated,
+		TaskCount: len(open),
+	}
+
+	return SuccessResult(result), nil
+}
+
+// GetCriticalPathResult contains the critical path through an epic's open subtasks.
+type GetCriticalPathResult struct {
+	EpicID    string
+	TaskIDs   []string // Subtasks on the path, each blocking the next
+	Length    int      // Estimated minutes if Estimated, otherwise the number of tasks
+	Estimated bool     // True if effort estimates were used as weights
+	TaskCount int      // Open subtasks considered
+}
+
+// CriticalPathTaskIDs returns the subtasks on the critical path in dependency order.
+func (r *GetCriticalPathResult) CriticalPathTaskIDs() []string {
+	return r.TaskIDs
+}
+
+// CriticalPathLength returns the summed weight of the critical path.
+func (r *GetCriticalPathResult) CriticalPathLength() int {
+	return r.Length
+}
+
+// UsedEstimates returns true if effort estimates were used as weights.
+func (r *GetCriticalPathResult) UsedEstimates() bool {
+	return r.Estimated
+}
+
+// ConsideredTaskCount returns the number of open subtasks in the dependency graph.
+func (r *GetCriticalPathResult) ConsideredTaskCount() int {
+	return r.TaskCount
+}
+
+// ===========================================================================
+// GetDependencyGraphHandler
+// ===========================================================================
+
+// GetDependencyGraphHandler handles CmdGetDependencyGraph commands.
+// It loads an epic and its subtasks with a BQL query and returns the blocking and parent
+// relationships between them, whatever their status. Read-only: nothing in BD or
+// coordinator state is changed.
+type GetDependencyGraphHandler struct {
+	tracker bql.BQLExecutor
+}
+
+// NewGetDependencyGraphHandler creates a new GetDependencyGraphHandler.
+// tracker may be nil, in which case every command fails because subtasks cannot be looked up.
+func NewGetDependencyGraphHandler(tracker bql.BQLExecutor) *GetDependencyGraphHandler {
+	return &GetDependencyGraphHandler{tracker: tracker}
+}
+
+// Handle processes a GetDependencyGraphCommand.
+// Unlike get_critical_path, cycles are not an error: they are returned as-is and flagged.
+func (h *GetDependencyGraphHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	graphCmd := cmd.(*command.GetDependencyGraphCommand)
+
+	if h.tracker == nil {
+		return nil, fmt.Errorf("BQL tracker not configured: cannot look up subtasks of %s", graphCmd.EpicID)
+	}
+
+	issues, err := h.tracker.Execute(fmt.Sprintf("id = %q expand down depth 1", graphCmd.EpicID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subtasks of %s: %w", graphCmd.EpicID, err)
+	}
+
+	var members []beads.Issue
+	for _, issue := range issues {
+		if issue.ID == graphCmd.EpicID || issue.ParentID == graphCmd.EpicID {
+			members = append(members, issue)
+		}
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("epic not found: %s", graphCmd.EpicID)
+	}
+
+	result := &GetDependencyGraphResult{
+		EpicID: graphCmd.EpicID,
+		Graph:  beads.BuildDependencyGraph(members),
+	}
+
+	return SuccessResult(result), nil
+}
+
+// GetDependencyGraphResult contains the dependency graph of an epic and its subtasks.
+type GetDependencyGraphResult struct {
+	EpicID string
+	Graph  beads.DependencyGraph
+}
+
+// DependencyGraph returns the epic's dependency graph.
+func (r *GetDependencyGraphResult) DependencyGraph() beads.DependencyGraph {
+	return r.Graph
 }
@@ -3,7 +3,9 @@ package handler
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -13,6 +15,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
 
 // ===========================================================================
@@ -85,7 +88,7 @@ func TestMarkTaskCompleteHandler_PanicsIfBDExecutorNil(t *testing.T) {
 	}, "expected panic when bdExecutor is nil")
 }
 
-func TestMarkTaskCompleteHandler_DeletesTaskFromRepository(t *testing.T) {
+func TestMarkTaskCompleteHandler_KeepsCompletedTaskInRepository(t *testing.T) {
 	bdExecutor := mocks.NewMockIssueExecutor(t)
 	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusClosed).Return(nil)
 	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Task completed").Return(nil)
@@ -95,7 +98,7 @@ func TestMarkTaskCompleteHandler_DeletesTaskFromRepository(t *testing.T) {
 	task := &repository.TaskAssignment{
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-1",
-		Status:      repository.TaskImplementing,
+		Status:      repository.TaskCommitting,
 	}
 	require.NoError(t, taskRepo.Save(task))
 
@@ -124,9 +127,14 @@ func TestMarkTaskCompleteHandler_DeletesTaskFromRepository(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, result.Success)
 
-	// Verify task was deleted
-	_, err = taskRepo.Get("perles-abc1.2")
-	require.ErrorIs(t, err, repository.ErrTaskNotFound, "task should be deleted after handle")
+	// Verify task was kept as completed history and is no longer assigned to the worker
+	completed, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err, "task should be kept after handle")
+	require.Equal(t, repository.TaskCompleted, completed.Status)
+	require.Len(t, completed.PhaseHistory, 1)
+	require.Equal(t, repository.TaskPhaseFinished, completed.PhaseHistory[0].Phase)
+	_, err = taskRepo.GetByWorker("worker-1")
+	require.ErrorIs(t, err, repository.ErrTaskNotFound)
 
 	// Verify implementer was reset to idle
 	updated, err := processRepo.Get("worker-1")
@@ -137,6 +145,24 @@ func TestMarkTaskCompleteHandler_DeletesTaskFromRepository(t *testing.T) {
 	require.Empty(t, updated.TaskID, "TaskID should be cleared")
 }
 
+func TestMarkTaskCompleteHandler_UsesClock(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusClosed).Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Task completed").Return(nil)
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Status: repository.TaskCommitting}))
+
+	handler := NewMarkTaskCompleteHandler(bdExecutor, taskRepo, WithMarkTaskCompleteClock(clock))
+	_, err := handler.Handle(context.Background(), command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.2"))
+	require.NoError(t, err)
+
+	completed, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Len(t, completed.PhaseHistory, 1)
+	require.Equal(t, clock.Now(), completed.PhaseHistory[0].At)
+}
+
 func TestMarkTaskCompleteHandler_SucceedsWhenTaskNotInRepo(t *testing.T) {
 	bdExecutor := mocks.NewMockIssueExecutor(t)
 	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusClosed).Return(nil)
@@ -186,7 +212,7 @@ func TestMarkTaskCompleteHandler_ResetsImplementerAndReviewer(t *testing.T) {
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-1",
 		Reviewer:    "worker-2",
-		Status:      repository.TaskInReview,
+		Status:      repository.TaskCommitting,
 	}
 	require.NoError(t, taskRepo.Save(task))
 
@@ -244,7 +270,7 @@ func TestMarkTaskCompleteHandler_EmitsEventsForResetWorkers(t *testing.T) {
 	task := &repository.TaskAssignment{
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-1",
-		Status:      repository.TaskImplementing,
+		Status:      repository.TaskCommitting,
 	}
 	require.NoError(t, taskRepo.Save(task))
 
@@ -288,7 +314,7 @@ func TestMarkTaskCompleteHandler_SkipsRetiredProcess(t *testing.T) {
 	task := &repository.TaskAssignment{
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-1",
-		Status:      repository.TaskImplementing,
+		Status:      repository.TaskCommitting,
 	}
 	require.NoError(t, taskRepo.Save(task))
 
@@ -326,7 +352,7 @@ func TestMarkTaskCompleteHandler_GracefulWithMissingProcess(t *testing.T) {
 	task := &repository.TaskAssignment{
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-99", // This worker doesn't exist in processRepo
-		Status:      repository.TaskImplementing,
+		Status:      repository.TaskCommitting,
 	}
 	require.NoError(t, taskRepo.Save(task))
 
@@ -353,7 +379,7 @@ func TestMarkTaskCompleteHandler_SkipsAlreadyIdleProcess(t *testing.T) {
 	task := &repository.TaskAssignment{
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-1",
-		Status:      repository.TaskImplementing,
+		Status:      repository.TaskCommitting,
 	}
 	require.NoError(t, taskRepo.Save(task))
 
@@ -398,7 +424,7 @@ func TestMarkTaskCompleteHandler_WorksWithNilProcessRepo(t *testing.T) {
 	task := &repository.TaskAssignment{
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-1",
-		Status:      repository.TaskImplementing,
+		Status:      repository.TaskCommitting,
 	}
 	require.NoError(t, taskRepo.Save(task))
 
@@ -412,9 +438,94 @@ func TestMarkTaskCompleteHandler_WorksWithNilProcessRepo(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, result.Success)
 
-	// Task should still be deleted
-	_, err = taskRepo.Get("perles-abc1.2")
-	require.ErrorIs(t, err, repository.ErrTaskNotFound)
+	// Task should still be marked completed
+	completed, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Equal(t, repository.TaskCompleted, completed.Status)
+}
+
+func TestMarkTaskCompleteHandler_RefusesTaskBeforeCommitPhase(t *testing.T) {
+	// No bd calls expected - the guard rejects the command before closing the issue
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	}))
+
+	handler := NewMarkTaskCompleteHandler(bdExecutor, taskRepo)
+
+	cmd := command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.2")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrTaskNotReadyToComplete)
+	require.ErrorContains(t, err, "implementing")
+
+	// Task should be left untouched
+	task, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Equal(t, repository.TaskImplementing, task.Status)
+}
+
+func TestMarkTaskCompleteHandler_ForceSkipsPhaseCheck(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusClosed).Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Task completed").Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	}))
+
+	handler := NewMarkTaskCompleteHandler(bdExecutor, taskRepo)
+
+	cmd := command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.2")
+	cmd.Force = true
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	task, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Equal(t, repository.TaskCompleted, task.Status)
+}
+
+func TestMarkTaskCompleteHandler_WithCompletableStatuses(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusClosed).Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Task completed").Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-2",
+		Status:      repository.TaskApproved,
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.3",
+		Implementer: "worker-3",
+		Status:      repository.TaskCommitting,
+	}))
+
+	handler := NewMarkTaskCompleteHandler(bdExecutor, taskRepo,
+		WithCompletableStatuses(repository.TaskApproved))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.2"))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	// Committing is no longer completable once the statuses are overridden
+	_, err = handler.Handle(context.Background(),
+		command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.3"))
+	require.ErrorIs(t, err, types.ErrTaskNotReadyToComplete)
+	require.ErrorContains(t, err, "expected approved")
 }
 
 // ===========================================================================
@@ -428,7 +539,7 @@ func TestMarkTaskFailedHandler_Success(t *testing.T) {
 
 	handler := NewMarkTaskFailedHandler(bdExecutor)
 
-	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Build failed due to missing dependency")
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Build failed due to missing dependency", "")
 	result, err := handler.Handle(context.Background(), cmd)
 
 	require.NoError(t, err)
@@ -441,7 +552,7 @@ func TestMarkTaskFailedHandler_ReturnsResult(t *testing.T) {
 
 	handler := NewMarkTaskFailedHandler(bdExecutor)
 
-	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Tests failing")
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Tests failing", "")
 	result, err := handler.Handle(context.Background(), cmd)
 
 	require.NoError(t, err)
@@ -458,7 +569,7 @@ func TestMarkTaskFailedHandler_FailsOnAddCommentError(t *testing.T) {
 
 	handler := NewMarkTaskFailedHandler(bdExecutor)
 
-	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Some reason")
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Some reason", "")
 	_, err := handler.Handle(context.Background(), cmd)
 
 	require.Error(t, err, "expected error on BD AddComment failure")
@@ -479,9 +590,727 @@ func TestMarkTaskFailedHandler_DoesNotUpdateStatus(t *testing.T) {
 
 	handler := NewMarkTaskFailedHandler(bdExecutor)
 
-	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Some reason")
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Some reason", "")
 	_, err := handler.Handle(context.Background(), cmd)
 
 	require.NoError(t, err)
 	// mockery will fail if UpdateStatus is unexpectedly called
 }
+
+func TestMarkTaskFailedHandler_RecordsCategoryOnTask(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Task failed [test_failure]: Integration tests keep timing out").Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+	}))
+
+	handler := NewMarkTaskFailedHandler(bdExecutor, WithMarkTaskFailedTaskRepo(taskRepo))
+
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Integration tests keep timing out", repository.FailureTestFailure)
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	failedResult := result.Data.(*MarkTaskFailedResult)
+	require.Equal(t, repository.FailureTestFailure, failedResult.Category)
+
+	task, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Equal(t, repository.TaskFailed, task.Status)
+	require.Equal(t, repository.FailureTestFailure, task.FailureCategory)
+	require.Equal(t, "Integration tests keep timing out", task.FailureReason)
+}
+
+func TestMarkTaskFailedHandler_UnknownTaskStillSucceeds(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Task failed [blocked]: Waiting on API keys").Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	handler := NewMarkTaskFailedHandler(bdExecutor, WithMarkTaskFailedTaskRepo(taskRepo))
+
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc1.2", "Waiting on API keys", repository.FailureBlocked)
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Empty(t, taskRepo.All(), "should not create a task assignment")
+}
+
+// ===========================================================================
+// AddTaskBlockerHandler Tests
+// ===========================================================================
+
+func TestAddTaskBlockerHandler_AddsDependency(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2"}, nil)
+	bdExecutor.EXPECT().ShowIssue("perles-bug9").Return(&beads.Issue{ID: "perles-bug9", BlockedBy: []string{"perles-bug8"}}, nil)
+	bdExecutor.EXPECT().ShowIssue("perles-bug8").Return(&beads.Issue{ID: "perles-bug8"}, nil)
+	bdExecutor.EXPECT().AddDependency("perles-abc1.2", "perles-bug9").Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	}))
+
+	handler := NewAddTaskBlockerHandler(bdExecutor, WithAddTaskBlockerTaskRepo(taskRepo))
+
+	cmd := command.NewAddTaskBlockerCommand(command.SourceMCPTool, "perles-abc1.2", "perles-bug9")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	blockerResult := result.Data.(*AddTaskBlockerResult)
+	require.Equal(t, "perles-abc1.2", blockerResult.TaskID)
+	require.Equal(t, "perles-bug9", blockerResult.BlockerID)
+	require.False(t, blockerResult.AlreadyBlocked)
+
+	task, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"perles-bug9"}, task.BlockedBy)
+}
+
+func TestAddTaskBlockerHandler_RejectsCycle(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1").Return(&beads.Issue{ID: "perles-abc1"}, nil)
+	// perles-bug9 is blocked by perles-mid1, which is blocked by perles-abc1
+	bdExecutor.EXPECT().ShowIssue("perles-bug9").Return(&beads.Issue{ID: "perles-bug9", BlockedBy: []string{"perles-mid1"}}, nil)
+	bdExecutor.EXPECT().ShowIssue("perles-mid1").Return(&beads.Issue{ID: "perles-mid1", BlockedBy: []string{"perles-abc1"}}, nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1", Status: repository.TaskImplementing}))
+
+	handler := NewAddTaskBlockerHandler(bdExecutor, WithAddTaskBlockerTaskRepo(taskRepo))
+
+	cmd := command.NewAddTaskBlockerCommand(command.SourceMCPTool, "perles-abc1", "perles-bug9")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrDependencyCycle)
+	require.Contains(t, err.Error(), "perles-bug9 -> perles-mid1 -> perles-abc1")
+	// mockery fails the test if AddDependency is called
+
+	task, err := taskRepo.Get("perles-abc1")
+	require.NoError(t, err)
+	require.Empty(t, task.BlockedBy)
+}
+
+func TestAddTaskBlockerHandler_ExistingLinkNotRewritten(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1").Return(&beads.Issue{ID: "perles-abc1", BlockedBy: []string{"perles-bug9"}}, nil)
+
+	handler := NewAddTaskBlockerHandler(bdExecutor)
+
+	cmd := command.NewAddTaskBlockerCommand(command.SourceMCPTool, "perles-abc1", "perles-bug9")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Data.(*AddTaskBlockerResult).AlreadyBlocked)
+}
+
+func TestAddTaskBlockerHandler_FailsOnUnknownBlocker(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1").Return(&beads.Issue{ID: "perles-abc1"}, nil)
+	bdExecutor.EXPECT().ShowIssue("perles-bug9").Return(nil, errors.New("issue not found: perles-bug9"))
+
+	handler := NewAddTaskBlockerHandler(bdExecutor)
+
+	cmd := command.NewAddTaskBlockerCommand(command.SourceMCPTool, "perles-abc1", "perles-bug9")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get issue perles-bug9")
+}
+
+func TestAddTaskBlockerHandler_PanicsIfBDExecutorNil(t *testing.T) {
+	require.Panics(t, func() {
+		NewAddTaskBlockerHandler(nil)
+	}, "expected panic when bdExecutor is nil")
+}
+
+// ===========================================================================
+// CompleteEpicTasksHandler Tests
+// ===========================================================================
+
+const epicChildrenQuery = `id = "perles-epic1" expand down depth 1`
+
+func TestCompleteEpicTasksHandler_ClosesOpenChildrenAndSkipsAssigned(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1", Status: beads.StatusOpen},
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", Status: beads.StatusOpen},
+		{ID: "perles-epic1.2", ParentID: "perles-epic1", Status: beads.StatusInProgress},
+		{ID: "perles-epic1.3", ParentID: "perles-epic1", Status: beads.StatusInProgress},
+		{ID: "perles-epic1.4", ParentID: "perles-epic1", Status: beads.StatusClosed},
+		{ID: "perles-blk9", Status: beads.StatusOpen}, // blocked by the epic, not a child
+	}, nil)
+
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().BulkUpdateStatus([]string{"perles-epic1.1", "perles-epic1.2"}, beads.StatusClosed).Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	// In progress in BD after a failed attempt - no longer assigned
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-epic1.2", Implementer: "worker-1", Status: repository.TaskFailed,
+	}))
+	// Still being implemented
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-epic1.3", Implementer: "worker-2", Status: repository.TaskImplementing,
+	}))
+
+	handler := NewCompleteEpicTasksHandler(bdExecutor, tracker, WithCompleteEpicTasksTaskRepo(taskRepo))
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	epicResult := result.Data.(*CompleteEpicTasksResult)
+	require.Equal(t, "perles-epic1", epicResult.EpicID)
+	require.Equal(t, []string{"perles-epic1.1", "perles-epic1.2"}, epicResult.Closed)
+	require.Equal(t, []string{"perles-epic1.3"}, epicResult.Skipped)
+
+	task, err := taskRepo.Get("perles-epic1.2")
+	require.NoError(t, err)
+	require.Equal(t, repository.TaskCompleted, task.Status)
+
+	task, err = taskRepo.Get("perles-epic1.3")
+	require.NoError(t, err)
+	require.Equal(t, repository.TaskImplementing, task.Status, "assigned subtask should be left alone")
+}
+
+func TestCompleteEpicTasksHandler_ReportsProgress(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", Status: beads.StatusOpen},
+		{ID: "perles-epic1.2", ParentID: "perles-epic1", Status: beads.StatusOpen},
+		{ID: "perles-epic1.3", ParentID: "perles-epic1", Status: beads.StatusInProgress},
+	}, nil)
+
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().BulkUpdateStatus([]string{"perles-epic1.1", "perles-epic1.2"}, beads.StatusClosed).Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-epic1.3", Implementer: "worker-2", Status: repository.TaskImplementing,
+	}))
+
+	var updates []string
+	handler := NewCompleteEpicTasksHandler(bdExecutor, tracker,
+		WithCompleteEpicTasksTaskRepo(taskRepo),
+		WithCompleteEpicTasksProgress(ProgressReporterFunc(func(content string) {
+			updates = append(updates, content)
+		})))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1"))
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Looking up subtasks of perles-epic1",
+		"Found 3 open subtasks of perles-epic1 (1 still assigned)",
+		"Closed 2 subtasks of perles-epic1",
+	}, updates)
+	require.Equal(t, []string{"perles-epic1.1", "perles-epic1.2"}, result.Data.(*CompleteEpicTasksResult).Closed)
+}
+
+func TestCompleteEpicTasksHandler_AllChildrenAssigned(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", Status: beads.StatusInProgress},
+	}, nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-epic1.1", Implementer: "worker-1", Reviewer: "worker-2", Status: repository.TaskInReview,
+	}))
+
+	// mockery fails the test if BulkUpdateStatus is called
+	handler := NewCompleteEpicTasksHandler(mocks.NewMockIssueExecutor(t), tracker, WithCompleteEpicTasksTaskRepo(taskRepo))
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	epicResult := result.Data.(*CompleteEpicTasksResult)
+	require.Empty(t, epicResult.Closed)
+	require.Equal(t, []string{"perles-epic1.1"}, epicResult.Skipped)
+}
+
+func TestCompleteEpicTasksHandler_DryRunClosesNothing(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", Status: beads.StatusOpen},
+		{ID: "perles-epic1.2", ParentID: "perles-epic1", Status: beads.StatusInProgress},
+	}, nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-epic1.1", Implementer: "worker-1", Status: repository.TaskFailed,
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-epic1.2", Implementer: "worker-2", Status: repository.TaskImplementing,
+	}))
+
+	// mockery fails the test if BulkUpdateStatus is called
+	handler := NewCompleteEpicTasksHandler(mocks.NewMockIssueExecutor(t), tracker, WithCompleteEpicTasksTaskRepo(taskRepo))
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1")
+	cmd.DryRun = true
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	epicResult := result.Data.(*CompleteEpicTasksResult)
+	require.True(t, epicResult.DryRun)
+	require.Equal(t, []string{"perles-epic1.1"}, epicResult.Closed)
+	require.Equal(t, []string{"perles-epic1.2"}, epicResult.Skipped)
+
+	task, err := taskRepo.Get("perles-epic1.1")
+	require.NoError(t, err)
+	require.Equal(t, repository.TaskFailed, task.Status, "dry run should not touch coordinator state")
+}
+
+func TestCompleteEpicTasksHandler_FailsOnBulkUpdateError(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", Status: beads.StatusOpen},
+	}, nil)
+
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().BulkUpdateStatus(mock.Anything, mock.Anything).Return(errors.New("bd database locked"))
+
+	handler := NewCompleteEpicTasksHandler(bdExecutor, tracker)
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to close subtasks of perles-epic1")
+}
+
+func TestCompleteEpicTasksHandler_FailsOnQueryError(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return(nil, errors.New("database locked"))
+
+	handler := NewCompleteEpicTasksHandler(mocks.NewMockIssueExecutor(t), tracker)
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to query subtasks of perles-epic1")
+}
+
+func TestCompleteEpicTasksHandler_FailsForUnknownEpic(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return(nil, nil)
+
+	// No expectations: nothing is closed
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	handler := NewCompleteEpicTasksHandler(bdExecutor, tracker)
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "epic not found: perles-epic1")
+}
+
+func TestCompleteEpicTasksHandler_FailsWithoutTracker(t *testing.T) {
+	handler := NewCompleteEpicTasksHandler(mocks.NewMockIssueExecutor(t), nil)
+
+	cmd := command.NewCompleteEpicTasksCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "BQL tracker not configured")
+}
+
+func TestCompleteEpicTasksHandler_PanicsIfBDExecutorNil(t *testing.T) {
+	require.Panics(t, func() {
+		NewCompleteEpicTasksHandler(nil, nil)
+	}, "expected panic when bdExecutor is nil")
+}
+
+// ===========================================================================
+// SyncTaskStatusHandler Tests
+// ===========================================================================
+
+func TestSyncTaskStatusHandler_CorrectsDivergence(t *testing.T) {
+	tests := []struct {
+		name         string
+		beadsStatus  beads.Status
+		taskStatus   repository.TaskStatus
+		wantStatus   repository.TaskStatus
+		wantCategory repository.FailureCategory
+	}{
+		{"closed in bd while implementing", beads.StatusClosed, repository.TaskImplementing, repository.TaskCompleted, ""},
+		{"closed in bd after failure", beads.StatusClosed, repository.TaskFailed, repository.TaskCompleted, ""},
+		{"blocked in bd while in review", beads.StatusBlocked, repository.TaskInReview, repository.TaskFailed, repository.FailureBlocked},
+		{"deferred in bd while implementing", beads.StatusDeferred, repository.TaskImplementing, repository.TaskFailed, ""},
+		{"reopened in bd after completion", beads.StatusOpen, repository.TaskCompleted, repository.TaskImplementing, ""},
+		{"in progress in bd after failure", beads.StatusInProgress, repository.TaskFailed, repository.TaskImplementing, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := mocks.NewMockIssueReader(t)
+			reader.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2", Status: tt.beadsStatus}, nil)
+
+			taskRepo := repository.NewMemoryTaskRepository()
+			require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+				TaskID: "perles-abc1.2", Implementer: "worker-1", Status: tt.taskStatus,
+			}))
+
+			handler := NewSyncTaskStatusHandler(reader, taskRepo)
+			result, err := handler.Handle(context.Background(), command.NewSyncTaskStatusCommand(command.SourceMCPTool, "perles-abc1.2"))
+
+			require.NoError(t, err)
+			syncResult := result.Data.(*SyncTaskStatusResult)
+			require.True(t, syncResult.Corrected)
+			require.Equal(t, tt.beadsStatus, syncResult.BeadsStatus)
+			require.Equal(t, tt.taskStatus, syncResult.PreviousStatus)
+			require.Equal(t, tt.wantStatus, syncResult.Status)
+
+			task, err := taskRepo.Get("perles-abc1.2")
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, task.Status)
+			require.Equal(t, tt.wantCategory, task.FailureCategory)
+		})
+	}
+}
+
+func TestSyncTaskStatusHandler_ReleasesWorkersHoldingTheTask(t *testing.T) {
+	reviewing := events.ProcessPhaseReviewing
+	implementing := events.ProcessPhaseImplementing
+	tests := []struct {
+		name            string
+		beadsStatus     beads.Status
+		taskStatus      repository.TaskStatus
+		workers         []*repository.Process
+		wantReset       []string
+		wantImplementer string
+		wantOrphaned    bool
+	}{
+		{
+			name:        "reopened after failure while its workers still hold it",
+			beadsStatus: beads.StatusOpen,
+			taskStatus:  repository.TaskFailed,
+			workers: []*repository.Process{
+				{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &implementing, TaskID: "perles-abc1.2"},
+				{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &reviewing, TaskID: "perles-abc1.2"},
+			},
+			wantReset:    []string{"worker-1", "worker-2"},
+			wantOrphaned: true,
+		},
+		{
+			name:        "reopened after completion once its workers moved on",
+			beadsStatus: beads.StatusInProgress,
+			taskStatus:  repository.TaskCompleted,
+			workers: []*repository.Process{
+				{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &implementing, TaskID: "perles-abc1.9"},
+				{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: phasePtr(events.ProcessPhaseIdle)},
+			},
+			wantOrphaned: true,
+		},
+		{
+			name:        "blocked in bd while in review",
+			beadsStatus: beads.StatusBlocked,
+			taskStatus:  repository.TaskInReview,
+			workers: []*repository.Process{
+				{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: phasePtr(events.ProcessPhaseAwaitingReview), TaskID: "perles-abc1.2"},
+				{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &reviewing, TaskID: "perles-abc1.2"},
+			},
+			wantReset:       []string{"worker-1", "worker-2"},
+			wantImplementer: "worker-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := mocks.NewMockIssueReader(t)
+			reader.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2", Status: tt.beadsStatus}, nil)
+
+			processRepo := repository.NewMemoryProcessRepository()
+			before := make(map[string]repository.Process)
+			for _, w := range tt.workers {
+				before[w.ID] = *w
+				processRepo.AddProcess(w)
+			}
+			taskRepo := repository.NewMemoryTaskRepository()
+			require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+				TaskID: "perles-abc1.2", Implementer: "worker-1", Reviewer: "worker-2", Status: tt.taskStatus,
+			}))
+
+			handler := NewSyncTaskStatusHandler(reader, taskRepo, WithSyncTaskStatusProcessRepo(processRepo))
+			result, err := handler.Handle(context.Background(), command.NewSyncTaskStatusCommand(command.SourceMCPTool, "perles-abc1.2"))
+
+			require.NoError(t, err)
+			syncResult := result.Data.(*SyncTaskStatusResult)
+			require.True(t, syncResult.Corrected)
+			require.Equal(t, tt.wantOrphaned, syncResult.IsOrphaned())
+			require.Len(t, result.Events, len(tt.wantReset))
+
+			for _, w := range tt.workers {
+				proc, err := processRepo.Get(w.ID)
+				require.NoError(t, err)
+				if slices.Contains(tt.wantReset, w.ID) {
+					require.Equal(t, repository.StatusReady, proc.Status, w.ID)
+					require.Equal(t, events.ProcessPhaseIdle, *proc.Phase, w.ID)
+					require.Empty(t, proc.TaskID, w.ID)
+					continue
+				}
+				want := before[w.ID]
+				require.Equal(t, want.Status, proc.Status, "%s has moved on and is left alone", w.ID)
+				require.Equal(t, want.TaskID, proc.TaskID, w.ID)
+			}
+
+			task, err := taskRepo.Get("perles-abc1.2")
+			require.NoError(t, err)
+			require.Equal(t, tt.wantImplementer, task.Implementer)
+			if tt.wantOrphaned {
+				require.Empty(t, task.Reviewer)
+				held, err := taskRepo.GetByImplementer("worker-1")
+				require.NoError(t, err)
+				require.Empty(t, held, "the reopened task no longer keeps its old implementer from new work")
+			}
+		})
+	}
+}
+
+func TestSyncTaskStatusHandler_NoDivergence(t *testing.T) {
+	tests := []struct {
+		name        string
+		beadsStatus beads.Status
+		taskStatus  repository.TaskStatus
+	}{
+		{"closed and completed", beads.StatusClosed, repository.TaskCompleted},
+		{"in progress and reviewing", beads.StatusInProgress, repository.TaskInReview},
+		{"blocked and already failed", beads.StatusBlocked, repository.TaskFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := mocks.NewMockIssueReader(t)
+			reader.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2", Status: tt.beadsStatus}, nil)
+
+			taskRepo := repository.NewMemoryTaskRepository()
+			require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+				TaskID: "perles-abc1.2", Implementer: "worker-1", Status: tt.taskStatus,
+			}))
+
+			handler := NewSyncTaskStatusHandler(reader, taskRepo)
+			result, err := handler.Handle(context.Background(), command.NewSyncTaskStatusCommand(command.SourceMCPTool, "perles-abc1.2"))
+
+			require.NoError(t, err)
+			syncResult := result.Data.(*SyncTaskStatusResult)
+			require.False(t, syncResult.Corrected)
+			require.Equal(t, tt.taskStatus, syncResult.Status)
+
+			task, err := taskRepo.Get("perles-abc1.2")
+			require.NoError(t, err)
+			require.Equal(t, tt.taskStatus, task.Status)
+			require.Empty(t, task.PhaseHistory)
+		})
+	}
+}
+
+func TestSyncTaskStatusHandler_FailsWithoutAssignment(t *testing.T) {
+	reader := mocks.NewMockIssueReader(t)
+	reader.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusClosed}, nil)
+
+	handler := NewSyncTaskStatusHandler(reader, repository.NewMemoryTaskRepository())
+	_, err := handler.Handle(context.Background(), command.NewSyncTaskStatusCommand(command.SourceMCPTool, "perles-abc1.2"))
+
+	require.ErrorIs(t, err, repository.ErrTaskNotFound)
+	require.Contains(t, err.Error(), "has no coordinator assignment")
+}
+
+func TestSyncTaskStatusHandler_FailsOnShowIssueError(t *testing.T) {
+	reader := mocks.NewMockIssueReader(t)
+	reader.EXPECT().ShowIssue("perles-abc1.2").Return(nil, errors.New("issue not found"))
+
+	handler := NewSyncTaskStatusHandler(reader, repository.NewMemoryTaskRepository())
+	_, err := handler.Handle(context.Background(), command.NewSyncTaskStatusCommand(command.SourceMCPTool, "perles-abc1.2"))
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get task perles-abc1.2")
+}
+
+func TestSyncTaskStatusHandler_PanicsIfDependenciesNil(t *testing.T) {
+	require.Panics(t, func() {
+		NewSyncTaskStatusHandler(nil, repository.NewMemoryTaskRepository())
+	}, "expected panic when issueReader is nil")
+	require.Panics(t, func() {
+		NewSyncTaskStatusHandler(mocks.NewMockIssueReader(t), nil)
+	}, "expected panic when taskRepo is nil")
+}
+
+// ===========================================================================
+// GetCriticalPathHandler Tests
+// ===========================================================================
+
+func TestGetCriticalPathHandler_ReturnsLongestChain(t *testing.T) {
+	// .1 -> .2 -> .4 -> .6 is longer than .1 -> .3 -> .6; .5 is closed and ignored
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1", Status: beads.StatusOpen},
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", Status: beads.StatusOpen},
+		{ID: "perles-epic1.2", ParentID: "perles-epic1", Status: beads.StatusOpen, BlockedBy: []string{"perles-epic1.1"}},
+		{ID: "perles-epic1.3", ParentID: "perles-epic1", Status: beads.StatusOpen, BlockedBy: []string{"perles-epic1.1"}},
+		{ID: "perles-epic1.4", ParentID: "perles-epic1", Status: beads.StatusInProgress, BlockedBy: []string{"perles-epic1.2", "perles-epic1.5"}},
+		{ID: "perles-epic1.5", ParentID: "perles-epic1", Status: beads.StatusClosed},
+		{ID: "perles-epic1.6", ParentID: "perles-epic1", Status: beads.StatusOpen, BlockedBy: []string{"perles-epic1.3", "perles-epic1.4"}},
+	}, nil)
+
+	handler := NewGetCriticalPathHandler(tracker)
+
+	cmd := command.NewGetCriticalPathCommand(command.SourceMCPTool, "perles-epic1")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	pathResult := result.Data.(*GetCriticalPathResult)
+	require.Equal(t, []string{"perles-epic1.1", "perles-epic1.2", "perles-epic1.4", "perles-epic1.6"}, pathResult.TaskIDs)
+	require.Equal(t, 4, pathResult.Length)
+	require.False(t, pathResult.Estimated)
+	require.Equal(t, 5, pathResult.TaskCount)
+}
+
+func TestGetCriticalPathHandler_UsesEstimates(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", EstimatedMinutes: 30},
+		{ID: "perles-epic1.2", ParentID: "perles-epic1", EstimatedMinutes: 10, BlockedBy: []string{"perles-epic1.1"}},
+		{ID: "perles-epic1.3", ParentID: "perles-epic1", EstimatedMinutes: 90},
+	}, nil)
+
+	handler := NewGetCriticalPathHandler(tracker)
+
+	cmd := command.NewGetCriticalPathCommand(command.SourceMCPTool, "perles-epic1")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	pathResult := result.Data.(*GetCriticalPathResult)
+	require.Equal(t, []string{"perles-epic1.3"}, pathResult.TaskIDs)
+	require.Equal(t, 90, pathResult.Length)
+	require.True(t, pathResult.Estimated)
+}
+
+func TestGetCriticalPathHandler_FailsOnCycle(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", BlockedBy: []string{"perles-epic1.2"}},
+		{ID: "perles-epic1.2", ParentID: "perles-epic1", BlockedBy: []string{"perles-epic1.1"}},
+		{ID: "perles-epic1.3", ParentID: "perles-epic1"},
+	}, nil)
+
+	handler := NewGetCriticalPathHandler(tracker)
+
+	cmd := command.NewGetCriticalPathCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	var cycleErr *beads.DependencyCycleError
+	require.ErrorAs(t, err, &cycleErr)
+	require.Equal(t, []string{"perles-epic1.1", "perles-epic1.2"}, cycleErr.IssueIDs)
+	require.Contains(t, err.Error(), "cannot compute critical path of perles-epic1")
+}
+
+func TestGetCriticalPathHandler_FailsOnQueryError(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return(nil, errors.New("database locked"))
+
+	handler := NewGetCriticalPathHandler(tracker)
+
+	cmd := command.NewGetCriticalPathCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to query subtasks of perles-epic1")
+}
+
+func TestGetCriticalPathHandler_FailsWithoutTracker(t *testing.T) {
+	handler := NewGetCriticalPathHandler(nil)
+
+	cmd := command.NewGetCriticalPathCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "BQL tracker not configured")
+}
+
+// ===========================================================================
+// GetDependencyGraphHandler Tests
+// ===========================================================================
+
+func TestGetDependencyGraphHandler_ReturnsNodesAndEdges(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1", TitleText: "Epic", Status: beads.StatusOpen},
+		{ID: "perles-epic1.1", TitleText: "Schema", ParentID: "perles-epic1", Status: beads.StatusClosed},
+		{ID: "perles-epic1.2", TitleText: "API", ParentID: "perles-epic1", Status: beads.StatusInProgress, BlockedBy: []string{"perles-epic1.1", "perles-other"}},
+		{ID: "perles-other", TitleText: "Unrelated", Status: beads.StatusOpen},
+	}, nil)
+
+	handler := NewGetDependencyGraphHandler(tracker)
+
+	cmd := command.NewGetDependencyGraphCommand(command.SourceMCPTool, "perles-epic1")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	graphResult := result.Data.(*GetDependencyGraphResult)
+	require.Equal(t, "perles-epic1", graphResult.EpicID)
+	require.Equal(t, []beads.DependencyNode{
+		{ID: "perles-epic1", Title: "Epic", Status: beads.StatusOpen},
+		{ID: "perles-epic1.1", Title: "Schema", Status: beads.StatusClosed},
+		{ID: "perles-epic1.2", Title: "API", Status: beads.StatusInProgress},
+	}, graphResult.Graph.Nodes)
+	require.Equal(t, []beads.DependencyEdge{
+		{From: "perles-epic1.1", To: "perles-epic1", Kind: beads.EdgeParent},
+		{From: "perles-epic1.2", To: "perles-epic1", Kind: beads.EdgeParent},
+		{From: "perles-epic1.2", To: "perles-epic1.1", Kind: beads.EdgeBlockedBy},
+	}, graphResult.Graph.Edges)
+}
+
+func TestGetDependencyGraphHandler_FlagsCycle(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return([]beads.Issue{
+		{ID: "perles-epic1.1", ParentID: "perles-epic1", BlockedBy: []string{"perles-epic1.2"}},
+		{ID: "perles-epic1.2", ParentID: "perles-epic1", BlockedBy: []string{"perles-epic1.1"}},
+	}, nil)
+
+	handler := NewGetDependencyGraphHandler(tracker)
+
+	cmd := command.NewGetDependencyGraphCommand(command.SourceMCPTool, "perles-epic1")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err, "cycles are reported, not rejected")
+	graph := result.Data.(*GetDependencyGraphResult).Graph
+	require.True(t, graph.Nodes[0].InCycle)
+	require.True(t, graph.Nodes[1].InCycle)
+}
+
+func TestGetDependencyGraphHandler_FailsForUnknownEpic(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(epicChildrenQuery).Return(nil, nil)
+
+	handler := NewGetDependencyGraphHandler(tracker)
+
+	cmd := command.NewGetDependencyGraphCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "epic not found: perles-epic1")
+}
+
+func TestGetDependencyGraphHandler_FailsWithoutTracker(t *testing.T) {
+	handler := NewGetDependencyGraphHandler(nil)
+
+	cmd := command.NewGetDependencyGraphCommand(command.SourceMCPTool, "perles-epic1")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "BQL tracker not configured")
+}
@@ -0,0 +1,52 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the handler for the SetFocus command.
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// FocusResult contains the workflow-wide focus area after a change.
+type FocusResult struct {
+	Focus string
+}
+
+// GetFocus returns the focus area after the change; empty means it was cleared.
+func (r *FocusResult) GetFocus() string {
+	return r.Focus
+}
+
+// SetFocusHandler handles CmdSetFocus commands.
+// It stores the focus area on the coordinator process so AssignTaskHandler includes it
+// in every subsequent task assignment prompt until it is cleared.
+type SetFocusHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewSetFocusHandler creates a new SetFocusHandler.
+func NewSetFocusHandler(processRepo repository.ProcessRepository) *SetFocusHandler {
+	return &SetFocusHandler{processRepo: processRepo}
+}
+
+// Handle processes a SetFocusCommand. A blank focus clears the focus area.
+// Task assignments already made keep the focus they were given.
+func (h *SetFocusHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	focusCmd := cmd.(*command.SetFocusCommand)
+
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coordinator: %w", err)
+	}
+
+	coord.Focus = strings.TrimSpace(focusCmd.Focus)
+	if err := h.processRepo.Save(coord); err != nil {
+		return nil, fmt.Errorf("failed to save coordinator: %w", err)
+	}
+
+	return SuccessResult(&FocusResult{Focus: coord.Focus}), nil
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ===========================================================================
+// Focus Handler Tests
+// ===========================================================================
+
+func setFocus(t *testing.T, processRepo repository.ProcessRepository, focus string) *FocusResult {
+	t.Helper()
+	result, err := NewSetFocusHandler(processRepo).Handle(context.Background(),
+		command.NewSetFocusCommand(command.SourceMCPTool, focus))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	return result.Data.(*FocusResult)
+}
+
+func TestSetFocusHandler_SetsAndClears(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+
+	got := setFocus(t, processRepo, "  the internal/parser module\n")
+	require.Equal(t, "the internal/parser module", got.GetFocus())
+	coord, _ := processRepo.GetCoordinator()
+	require.Equal(t, "the internal/parser module", coord.Focus)
+
+	got = setFocus(t, processRepo, "")
+	require.Empty(t, got.GetFocus())
+	coord, _ = processRepo.GetCoordinator()
+	require.Empty(t, coord.Focus)
+}
+
+func TestSetFocusHandler_FailsWithoutCoordinator(t *testing.T) {
+	_, err := NewSetFocusHandler(repository.NewMemoryProcessRepository()).Handle(context.Background(),
+		command.NewSetFocusCommand(command.SourceMCPTool, "the parser"))
+	require.ErrorContains(t, err, "failed to get coordinator")
+}
+
+func TestAssignTaskHandler_IncludesFocusUntilCleared(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	for _, id := range []string{"worker-1", "worker-2", "worker-3"} {
+		processRepo.AddProcess(&repository.Process{
+			ID:        id,
+			Role:      repository.RoleWorker,
+			Status:    repository.StatusReady,
+			Phase:     phasePtr(events.ProcessPhaseIdle),
+			CreatedAt: time.Now(),
+		})
+	}
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo))
+	assign := func(workerID, taskID string) string {
+		t.Helper()
+		_, err := handler.Handle(context.Background(),
+			command.NewAssignTaskCommand(command.SourceMCPTool, workerID, taskID, "Implement feature", ""))
+		require.NoError(t, err)
+		msg, _ := queueRepo.GetOrCreate(workerID).Dequeue()
+		return msg.Content
+	}
+
+	setFocus(t, processRepo, "the internal/parser module")
+	setGlobalInstruction(t, processRepo, "The DB migration is frozen", false)
+
+	require.Contains(t, assign("worker-1", "perles-abc1.1"), "## Coordinator Instructions\n\n"+
+		"**Focus area (concentrate your work here):** the internal/parser module\n\n"+
+		"**Standing instructions (apply to every task):**\n- The DB migration is frozen\n\n"+
+		"Implement feature")
+	require.Contains(t, assign("worker-2", "perles-abc1.2"), "the internal/parser module",
+		"the focus stays until cleared")
+
+	setFocus(t, processRepo, "")
+
+	cleared := assign("worker-3", "perles-abc1.3")
+	require.NotContains(t, cleared, "Focus area")
+	require.Contains(t, cleared, "The DB migration is frozen", "clearing the focus keeps global instructions")
+	earlier, _ := taskRepo.Get("perles-abc1.1")
+	require.Contains(t, earlier.Instructions, "the internal/parser module", "earlier assignments keep their focus")
+}
@@ -0,0 +1,94 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains handlers for coordinator-wide instruction commands:
+// SetGlobalInstruction and ClearGlobalInstruction.
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// GlobalInstructionResult contains the coordinator's global instructions after a change.
+type GlobalInstructionResult struct {
+	Instructions []string
+}
+
+// GetInstructions returns the coordinator's global instructions after the change.
+func (r *GlobalInstructionResult) GetInstructions() []string {
+	return r.Instructions
+}
+
+// ===========================================================================
+// SetGlobalInstructionHandler
+// ===========================================================================
+
+// SetGlobalInstructionHandler handles CmdSetGlobalInstruction commands.
+// It stores the instruction on the coordinator process so AssignTaskHandler includes it
+// in every subsequent task assignment prompt.
+type SetGlobalInstructionHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewSetGlobalInstructionHandler creates a new SetGlobalInstructionHandler.
+func NewSetGlobalInstructionHandler(processRepo repository.ProcessRepository) *SetGlobalInstructionHandler {
+	return &SetGlobalInstructionHandler{processRepo: processRepo}
+}
+
+// Handle processes a SetGlobalInstructionCommand.
+// The instruction is appended to the existing global instructions, or replaces them when
+// Replace is set.
+func (h *SetGlobalInstructionHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	setCmd := cmd.(*command.SetGlobalInstructionCommand)
+
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coordinator: %w", err)
+	}
+
+	instruction := strings.TrimSpace(setCmd.Instruction)
+	if setCmd.Replace {
+		coord.GlobalInstructions = []string{instruction}
+	} else {
+		coord.GlobalInstructions = append(coord.GlobalInstructions, instruction)
+	}
+
+	if err := h.processRepo.Save(coord); err != nil {
+		return nil, fmt.Errorf("failed to save coordinator: %w", err)
+	}
+
+	return SuccessResult(&GlobalInstructionResult{Instructions: coord.GlobalInstructions}), nil
+}
+
+// ===========================================================================
+// ClearGlobalInstructionHandler
+// ===========================================================================
+
+// ClearGlobalInstructionHandler handles CmdClearGlobalInstruction commands.
+// Task assignments already made keep the instructions they were given.
+type ClearGlobalInstructionHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewClearGlobalInstructionHandler creates a new ClearGlobalInstructionHandler.
+func NewClearGlobalInstructionHandler(processRepo repository.ProcessRepository) *ClearGlobalInstructionHandler {
+	return &ClearGlobalInstructionHandler{processRepo: processRepo}
+}
+
+// Handle processes a ClearGlobalInstructionCommand.
+func (h *ClearGlobalInstructionHandler) Handle(_ context.Context, _ command.Command) (*command.CommandResult, error) {
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coordinator: %w", err)
+	}
+
+	coord.GlobalInstructions = nil
+	if err := h.processRepo.Save(coord); err != nil {
+		return nil, fmt.Errorf("failed to save coordinator: %w", err)
+	}
+
+	return SuccessResult(&GlobalInstructionResult{}), nil
+}
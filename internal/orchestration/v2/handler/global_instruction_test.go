@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ===========================================================================
+// Global Instruction Handler Tests
+// ===========================================================================
+
+// newGlobalInstructionRepo returns a process repository holding the coordinator.
+func newGlobalInstructionRepo() *repository.MemoryProcessRepository {
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusReady,
+	})
+	return processRepo
+}
+
+func setGlobalInstruction(t *testing.T, processRepo repository.ProcessRepository, instruction string, replace bool) *GlobalInstructionResult {
+	t.Helper()
+	cmd := command.NewSetGlobalInstructionCommand(command.SourceMCPTool, instruction, replace)
+	result, err := NewSetGlobalInstructionHandler(processRepo).Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	return result.Data.(*GlobalInstructionResult)
+}
+
+func TestSetGlobalInstructionHandler_AppendsAndReplaces(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+
+	setGlobalInstruction(t, processRepo, "The DB migration is frozen", false)
+	got := setGlobalInstruction(t, processRepo, "  Do not bump dependencies\n", false)
+	require.Equal(t, []string{"The DB migration is frozen", "Do not bump dependencies"}, got.Instructions)
+
+	got = setGlobalInstruction(t, processRepo, "Only touch the parser", true)
+	require.Equal(t, []string{"Only touch the parser"}, got.Instructions)
+
+	coord, err := processRepo.GetCoordinator()
+	require.NoError(t, err)
+	require.Equal(t, []string{"Only touch the parser"}, coord.GlobalInstructions)
+}
+
+func TestClearGlobalInstructionHandler_RemovesInstructions(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+	setGlobalInstruction(t, processRepo, "The DB migration is frozen", false)
+
+	result, err := NewClearGlobalInstructionHandler(processRepo).Handle(context.Background(),
+		command.NewClearGlobalInstructionCommand(command.SourceMCPTool))
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	coord, _ := processRepo.GetCoordinator()
+	require.Empty(t, coord.GlobalInstructions)
+}
+
+func TestGlobalInstructionHandlers_FailWithoutCoordinator(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+
+	_, err := NewSetGlobalInstructionHandler(processRepo).Handle(context.Background(),
+		command.NewSetGlobalInstructionCommand(command.SourceMCPTool, "The DB migration is frozen", false))
+	require.ErrorContains(t, err, "failed to get coordinator")
+
+	_, err = NewClearGlobalInstructionHandler(processRepo).Handle(context.Background(),
+		command.NewClearGlobalInstructionCommand(command.SourceMCPTool))
+	require.ErrorContains(t, err, "failed to get coordinator")
+}
+
+func TestAssignTaskHandler_IncludesGlobalInstructionsInNewAssignmentsOnly(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	for _, id := range []string{"worker-1", "worker-2"} {
+		processRepo.AddProcess(&repository.Process{
+			ID:        id,
+			Role:      repository.RoleWorker,
+			Status:    repository.StatusReady,
+			Phase:     phasePtr(events.ProcessPhaseIdle),
+			CreatedAt: time.Now(),
+		})
+	}
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo))
+
+	// Assigned before the instruction is set
+	_, err := handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "Implement feature", ""))
+	require.NoError(t, err)
+
+	setGlobalInstruction(t, processRepo, "The DB migration is frozen", false)
+
+	// Assigned after the instruction is set
+	_, err = handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "Implement feature", ""))
+	require.NoError(t, err)
+
+	before, _ := queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.NotContains(t, before.Content, "The DB migration is frozen")
+	earlier, _ := taskRepo.Get("perles-abc1.1")
+	require.Equal(t, "Implement feature", earlier.Instructions, "earlier assignments are not changed retroactively")
+
+	after, _ := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.Contains(t, after.Content, "## Coordinator Instructions\n\n**Standing instructions (apply to every task):**\n- The DB migration is frozen\n\nImplement feature")
+	later, _ := taskRepo.Get("perles-abc1.2")
+	require.Contains(t, later.Instructions, "The DB migration is frozen", "fetch_context should return the instructions the worker saw")
+}
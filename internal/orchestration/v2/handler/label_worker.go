@@ -0,0 +1,52 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the LabelWorker handler.
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// LabelWorkerResult contains the worker's label after the change.
+type LabelWorkerResult struct {
+	WorkerID string
+	Label    string
+}
+
+// LabelWorkerHandler handles CmdLabelWorker commands.
+// It stores a cosmetic label on the worker process; routing still uses the worker ID.
+type LabelWorkerHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewLabelWorkerHandler creates a new LabelWorkerHandler.
+func NewLabelWorkerHandler(processRepo repository.ProcessRepository) *LabelWorkerHandler {
+	return &LabelWorkerHandler{processRepo: processRepo}
+}
+
+// Handle processes a LabelWorkerCommand. An empty label clears the worker's label.
+func (h *LabelWorkerHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	labelCmd := cmd.(*command.LabelWorkerCommand)
+
+	proc, err := h.processRepo.Get(labelCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+	if !proc.IsWorker() {
+		return nil, fmt.Errorf("%s is not a worker", labelCmd.WorkerID)
+	}
+
+	proc.Label = labelCmd.Label
+	if err := h.processRepo.Save(proc); err != nil {
+		return nil, fmt.Errorf("failed to save process: %w", err)
+	}
+
+	return SuccessResult(&LabelWorkerResult{WorkerID: proc.ID, Label: proc.Label}), nil
+}
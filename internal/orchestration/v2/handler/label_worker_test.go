@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// newLabelWorkerRepo returns a process repository holding the coordinator and worker-1.
+func newLabelWorkerRepo() *repository.MemoryProcessRepository {
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusReady,
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	})
+	return processRepo
+}
+
+func labelWorker(processRepo repository.ProcessRepository, workerID, label string) (*command.CommandResult, error) {
+	cmd := command.NewLabelWorkerCommand(command.SourceMCPTool, workerID, label)
+	return NewLabelWorkerHandler(processRepo).Handle(context.Background(), cmd)
+}
+
+func TestLabelWorkerHandler_SetsAndClearsLabel(t *testing.T) {
+	processRepo := newLabelWorkerRepo()
+
+	result, err := labelWorker(processRepo, "worker-1", "auth-impl")
+	require.NoError(t, err)
+	require.Equal(t, &LabelWorkerResult{WorkerID: "worker-1", Label: "auth-impl"}, result.Data)
+
+	proc, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, "auth-impl", proc.Label)
+	require.Equal(t, repository.StatusWorking, proc.Status, "labeling leaves the worker's state alone")
+
+	_, err = labelWorker(processRepo, "worker-1", "")
+	require.NoError(t, err)
+	proc, err = processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Empty(t, proc.Label)
+}
+
+func TestLabelWorkerHandler_RejectsUnknownAndNonWorkers(t *testing.T) {
+	processRepo := newLabelWorkerRepo()
+
+	_, err := labelWorker(processRepo, "worker-9", "auth-impl")
+	require.ErrorIs(t, err, ErrProcessNotFound)
+
+	_, err = labelWorker(processRepo, repository.CoordinatorID, "boss")
+	require.ErrorContains(t, err, "coordinator is not a worker")
+}
+
+func TestLabelWorkerHandler_LabelDoesNotRouteMessages(t *testing.T) {
+	processRepo := newLabelWorkerRepo()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	_, err := labelWorker(processRepo, "worker-1", "auth-impl")
+	require.NoError(t, err)
+
+	send := NewSendToProcessHandler(processRepo, queueRepo)
+
+	// The label is not an address
+	_, err = send.Handle(context.Background(), command.NewSendToProcessCommand(command.SourceMCPTool, "auth-impl", "Hello"))
+	require.ErrorIs(t, err, ErrProcessNotFound)
+	require.Zero(t, queueRepo.Size("auth-impl"))
+
+	// The worker ID still is
+	_, err = send.Handle(context.Background(), command.NewSendToProcessCommand(command.SourceMCPTool, "worker-1", "Hello"))
+	require.NoError(t, err)
+	require.Equal(t, 1, queueRepo.Size("worker-1"))
+}
@@ -14,14 +14,18 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 
+	appgit "github.com/zjrosen/perles/internal/git/application"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/message"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/sound"
 )
@@ -155,10 +159,12 @@ func (h *SendToProcessHandler) handleWithSpan(_ context.Context, sendCmd *comman
 
 	// Determine sender based on command source
 	var sender repository.SenderType
-	switch sendCmd.Source() {
-	case command.SourceMCPTool:
+	switch {
+	case sendCmd.Keepalive:
+		sender = repository.SenderKeepalive
+	case sendCmd.Source() == command.SourceMCPTool:
 		sender = repository.SenderCoordinator
-	case command.SourceInternal:
+	case sendCmd.Source() == command.SourceInternal:
 		sender = repository.SenderSystem
 	default:
 		sender = repository.SenderUser
@@ -329,8 +335,12 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 	// Enforcement reminders (SenderSystem) continue the same turn, so we preserve
 	// the retry count and other state.
 	// For normal messages (SenderUser, SenderCoordinator), we start a fresh turn.
+	// Keepalive prompts start a fresh turn that is exempt from enforcement.
 	if h.enforcer != nil && entry.Sender != repository.SenderSystem {
 		h.enforcer.ResetTurn(proc.ID)
+		if entry.Sender == repository.SenderKeepalive {
+			h.enforcer.MarkAsKeepalive(proc.ID)
+		}
 	}
 
 	// Build events
@@ -391,6 +401,39 @@ type ProcessTurnCompleteHandler struct {
 	registry        *process.ProcessRegistry
 	sessionNotifier SessionRefNotifier
 	soundService    sound.SoundService
+
+	// handoffThreshold is the coordinator context size (in tokens) at which
+	// a handoff summary is automatically posted. Zero disables the check.
+	handoffThreshold int
+
+	// readyGracePeriod delays a worker's first assignment after its first successful turn.
+	readyGracePeriod time.Duration
+	// providerGracePeriods overrides readyGracePeriod for workers on specific providers.
+	providerGracePeriods map[client.ClientType]time.Duration
+
+	// clock stamps worker activity, which stuck detection and ready grace are measured from.
+	clock types.Clock
+
+	// workerCapacity receives the slot of a worker failed on an authentication error.
+	workerCapacity WorkerSlotReleaser
+
+	// providerRotator moves a worker whose turn hit a rate limit to another provider.
+	providerRotator WorkerProviderRotator
+}
+
+// WorkerSlotReleaser returns a worker slot to the pool shared with other workflows.
+type WorkerSlotReleaser interface {
+	Release()
+}
+
+// releaseWorkerSlot returns a worker's slot to the shared pool unless it was already
+// returned, and records that it was. The caller saves proc.
+func releaseWorkerSlot(capacity WorkerSlotReleaser, proc *repository.Process) {
+	if capacity == nil || proc.Role != repository.RoleWorker || proc.WorkerSlotReleased {
+		return
+	}
+	capacity.Release()
+	proc.WorkerSlotReleased = true
 }
 
 // ProcessTurnCompleteHandlerOption configures ProcessTurnCompleteHandler.
@@ -433,6 +476,78 @@ func WithProcessTurnSoundService(svc sound.SoundService) ProcessTurnCompleteHand
 	}
 }
 
+// WithHandoffThreshold sets the coordinator context size (in tokens) at which
+// the handler proactively posts a handoff summary to the coordinator.
+// The summary is posted once, on the turn where the threshold is first crossed.
+// A threshold of zero or less disables automatic handoff.
+func WithHandoffThreshold(tokens int) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		if tokens > 0 {
+			h.handoffThreshold = tokens
+		}
+	}
+}
+
+// WithReadyGracePeriod sets how long a worker stays unassignable after its first
+// successful turn, typically the worker provider's Capabilities.ReadyGracePeriod.
+// The grace period is recorded as a deadline on the process rather than waited out,
+// so it never delays other workers or the command processor.
+// A period of zero or less makes workers assignable immediately.
+func WithReadyGracePeriod(d time.Duration) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		if d > 0 {
+			h.readyGracePeriod = d
+		}
+	}
+}
+
+// WithProviderReadyGracePeriod sets the ready grace period for workers spawned on the
+// given provider, overriding WithReadyGracePeriod for them. A period of zero or less
+// makes those workers assignable immediately.
+func WithProviderReadyGracePeriod(provider client.ClientType, d time.Duration) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		if h.providerGracePeriods == nil {
+			h.providerGracePeriods = make(map[client.ClientType]time.Duration)
+		}
+		h.providerGracePeriods[provider] = max(d, 0)
+	}
+}
+
+// readyGracePeriodFor returns the ready grace period for a worker's provider.
+func (h *ProcessTurnCompleteHandler) readyGracePeriodFor(proc *repository.Process) time.Duration {
+	if d, ok := h.providerGracePeriods[proc.Provider]; ok && proc.Provider != "" {
+		return d
+	}
+	return h.readyGracePeriod
+}
+
+// WithProcessTurnClock sets the clock used to stamp worker activity.
+func WithProcessTurnClock(clock types.Clock) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
+// WithProcessTurnWorkerCapacity sets the shared worker pool that a worker failed on an
+// authentication error returns its slot to. The worker is not replaced, so its slot
+// would otherwise stay held until the workflow stops.
+func WithProcessTurnWorkerCapacity(capacity WorkerSlotReleaser) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		h.workerCapacity = capacity
+	}
+}
+
+// WithProcessTurnProviderRotator sets the rotator a worker whose turn hit its provider's
+// rate limit is moved through. The worker is replaced, with its task, on the next
+// available provider; without a rotator it fails like any other errored turn.
+func WithProcessTurnProviderRotator(rotator WorkerProviderRotator) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		h.providerRotator = rotator
+	}
+}
+
 // NewProcessTurnCompleteHandler creates a new ProcessTurnCompleteHandler.
 func NewProcessTurnCompleteHandler(
 	processRepo repository.ProcessRepository,
@@ -443,6 +558,7 @@ func NewProcessTurnCompleteHandler(
 		processRepo:  processRepo,
 		queueRepo:    queueRepo,
 		soundService: sound.NoopSoundService{},
+		clock:        types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -498,7 +614,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 
 			// Transition to Worker to failed since they cannot respond
 			proc.Status = repository.StatusFailed
-			proc.LastActivityAt = time.Now()
+			proc.LastActivityAt = h.clock.Now()
 
 			// Play sound to alert user
 			h.soundService.Play("deny", "worker_out_of_context")
@@ -524,6 +640,31 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		}
 	}
 
+	// ===========================================================================
+	// Provider Authentication Error Handling
+	// ===========================================================================
+	// A process whose provider rejected its credentials cannot be recovered by replacing
+	// it. A failed worker's coordinator is told to halt the workflow; a failed coordinator
+	// halts it directly and the user is asked to fix the credentials.
+	if turnCmd.Error != nil && client.IsAuthError(turnCmd.Error) {
+		switch proc.Role {
+		case repository.RoleWorker:
+			return h.failWorkerOnAuthError(turnCmd, proc)
+		case repository.RoleCoordinator:
+			return h.failCoordinatorOnAuthError(turnCmd, proc)
+		}
+	}
+
+	// ===========================================================================
+	// Worker Rate Limit Handling
+	// ===========================================================================
+	// A worker whose provider hit its rate limit mid-turn is replaced on another
+	// provider, and its task handed to the replacement, while the limit resets.
+	if turnCmd.Error != nil && proc.Role == repository.RoleWorker && client.IsRateLimitError(turnCmd.Error) &&
+		h.providerRotator != nil && h.providerRotator.RotateFrom(proc.Provider) {
+		return h.rotateRateLimitedWorker(turnCmd, proc)
+	}
+
 	// ===========================================================================
 	// Coordinator Context Exceeded Error Handling
 	// ===========================================================================
@@ -534,7 +675,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		if errors.As(turnCmd.Error, &contextExceededError) {
 			// Mark coordinator as Failed
 			proc.Status = repository.StatusFailed
-			proc.LastActivityAt = time.Now()
+			proc.LastActivityAt = h.clock.Now()
 
 			if err := h.processRepo.Save(proc); err != nil {
 				return nil, fmt.Errorf("failed to save coordinator: %w", err)
@@ -578,7 +719,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		if errors.As(turnCmd.Error, &contextExceededError) {
 			// Mark observer as Failed
 			proc.Status = repository.StatusFailed
-			proc.LastActivityAt = time.Now()
+			proc.LastActivityAt = h.clock.Now()
 
 			if err := h.processRepo.Save(proc); err != nil {
 				return nil, fmt.Errorf("failed to save observer: %w", err)
@@ -612,6 +753,15 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		}
 	}
 
+	// ===========================================================================
+	// Requested retirement handling (workers only)
+	// ===========================================================================
+	// A worker that called request_retirement is replaced as soon as its turn ends.
+	// Enforcement is skipped: the worker is leaving and the coordinator was already notified.
+	if proc.IsWorker() && proc.RetirementReason != "" {
+		return h.replaceRetiringWorker(turnCmd, proc)
+	}
+
 	// ===========================================================================
 	// Turn completion enforcement for workers
 	// ===========================================================================
@@ -622,6 +772,8 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 			// Skip enforcement - process had an error
 		} else if h.enforcer.IsNewlySpawned(proc.ID) {
 			// Skip enforcement - startup turn (workers call fabric_join on first turn)
+		} else if h.enforcer.IsKeepalive(proc.ID) {
+			// Skip enforcement - keepalive turn (idle workers have nothing to report)
 		} else {
 			// Check tool calls
 			missingTools := h.enforcer.CheckTurnCompletion(proc.ID, proc.Role)
@@ -641,11 +793,12 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 
 					// Transition to Ready so DeliverProcessQueuedCommand can deliver the reminder
 					proc.Status = repository.StatusReady
-					proc.LastActivityAt = time.Now()
+					proc.LastActivityAt = h.clock.Now()
 
 					// Update metrics if provided
 					if turnCmd.Metrics != nil {
 						proc.Metrics = turnCmd.Metrics
+						proc.Usage.Add(turnCmd.Metrics)
 					}
 
 					if err := h.processRepo.Save(proc); err != nil {
@@ -684,7 +837,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	// This prevents continuing when a process never established a session.
 	if !proc.HasCompletedTurn && !turnCmd.Succeeded {
 		proc.Status = repository.StatusFailed
-		proc.LastActivityAt = time.Now()
+		proc.LastActivityAt = h.clock.Now()
 		// Keep HasCompletedTurn=false since we never succeeded
 
 		if err := h.processRepo.Save(proc); err != nil {
@@ -758,7 +911,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	// exceeded errors, and other runtime errors that occur after the initial spawn.
 	if !turnCmd.Succeeded && proc.HasCompletedTurn {
 		proc.Status = repository.StatusFailed
-		proc.LastActivityAt = time.Now()
+		proc.LastActivityAt = h.clock.Now()
 
 		if err := h.processRepo.Save(proc); err != nil {
 			return nil, fmt.Errorf("failed to save process: %w", err)
@@ -789,19 +942,37 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	// End of failed turn handling
 	// ===========================================================================
 
+	// Capture context size before metrics are updated so we can detect
+	// the turn on which the handoff threshold is crossed.
+	prevContextTokens := proc.ContextTokens()
+
 	// Update process state - same for coordinator and workers
 	proc.Status = repository.StatusReady
-	proc.LastActivityAt = time.Now()
+	proc.LastActivityAt = h.clock.Now()
+
+	// Give a newly spawned worker time to finish initializing before it is assignable
+	if grace := h.readyGracePeriodFor(proc); wasFirstSuccessfulTurn && proc.IsWorker() && grace > 0 {
+		proc.AssignableAt = proc.LastActivityAt.Add(grace)
+	}
 
 	// Update metrics if provided
 	if turnCmd.Metrics != nil {
 		proc.Metrics = turnCmd.Metrics
+		proc.Usage.Add(turnCmd.Metrics)
 	}
 
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to save process: %w", err)
 	}
 
+	// Proactively post a handoff summary when the coordinator's context
+	// grows past the configured threshold.
+	if proc.IsCoordinator() && h.ShouldHandoff(prevContextTokens, proc.ContextTokens()) {
+		if err := h.postHandoffSummary(proc); err != nil {
+			return nil, err
+		}
+	}
+
 	readyEvent := events.NewProcessEvent(events.ProcessReady, proc.ID, proc.Role).
 		WithStatus(events.ProcessStatusReady).
 		WithTaskID(proc.TaskID)
@@ -827,6 +998,195 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	return SuccessWithEventsAndFollowUp(result, []any{readyEvent}, followUps), nil
 }
 
+// failWorkerOnAuthError marks a worker whose provider rejected its credentials as failed
+// and posts a message telling the coordinator to halt the workflow.
+func (h *ProcessTurnCompleteHandler) failWorkerOnAuthError(turnCmd *command.ProcessTurnCompleteCommand, proc *repository.Process) (*command.CommandResult, error) {
+	coordinator, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coordinator: %w", err)
+	}
+
+	queue := h.queueRepo.GetOrCreate(coordinator.ID)
+	if err := queue.Enqueue(
+		prompt.BuildWorkerAuthFailedPrompt(proc.ID, proc.TaskID, turnCmd.Error.Error()),
+		repository.SenderSystem,
+	); err != nil {
+		return nil, fmt.Errorf("failed to enqueue authentication failure message: %w", err)
+	}
+
+	proc.Status = repository.StatusFailed
+	proc.LastActivityAt = h.clock.Now()
+	// The worker will not be replaced, so give its slot back to the shared pool
+	releaseWorkerSlot(h.workerCapacity, proc)
+
+	h.soundService.Play("deny", "worker_auth_failed")
+
+	if err := h.processRepo.Save(proc); err != nil {
+		return nil, fmt.Errorf("failed to save process: %w", err)
+	}
+
+	errorEvent := events.NewProcessEvent(events.ProcessError, proc.ID, proc.Role).
+		WithStatus(events.ProcessStatusFailed).
+		WithTaskID(proc.TaskID).
+		WithError(turnCmd.Error)
+
+	deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, coordinator.ID)
+	if turnCmd.TraceID() != "" {
+		deliverCmd.SetTraceID(turnCmd.TraceID())
+	}
+
+	result := &ProcessTurnCompleteResult{
+		ProcessID:      proc.ID,
+		NewStatus:      repository.StatusFailed,
+		QueuedDelivery: true,
+		WasNoOp:        false,
+	}
+
+	return SuccessWithEventsAndFollowUp(result, []any{errorEvent}, []command.Command{deliverCmd}), nil
+}
+
+// rotateRateLimitedWorker marks a worker whose provider hit its rate limit as failed and
+// replaces it. The replacement is spawned on a provider outside its cooldown window and
+// continues the worker's task, so the worker's slot passes to it rather than being released.
+func (h *ProcessTurnCompleteHandler) rotateRateLimitedWorker(turnCmd *command.ProcessTurnCompleteCommand, proc *repository.Process) (*command.CommandResult, error) {
+	proc.Status = repository.StatusFailed
+	proc.LastActivityAt = h.clock.Now()
+	if err := h.processRepo.Save(proc); err != nil {
+		return nil, fmt.Errorf("failed to save process: %w", err)
+	}
+
+	log.Warn(log.CatOrch, "Worker hit provider rate limit, replacing it on another provider",
+		"processID", proc.ID, "provider", proc.Provider, "taskID", proc.TaskID, "error", turnCmd.Error)
+
+	errorEvent := events.NewProcessEvent(events.ProcessError, proc.ID, proc.Role).
+		WithStatus(events.ProcessStatusFailed).
+		WithTaskID(proc.TaskID).
+		WithError(turnCmd.Error)
+
+	replaceCmd := command.NewReplaceProcessCommand(command.SourceInternal, proc.ID, "rate_limited")
+	replaceCmd.Reassign = true
+	if turnCmd.TraceID() != "" {
+		replaceCmd.SetTraceID(turnCmd.TraceID())
+	}
+
+	result := &ProcessTurnCompleteResult{
+		ProcessID:      proc.ID,
+		NewStatus:      repository.StatusFailed,
+		QueuedDelivery: false,
+		WasNoOp:        false,
+	}
+
+	return SuccessWithEventsAndFollowUp(result, []any{errorEvent}, []command.Command{replaceCmd}), nil
+}
+
+// failCoordinatorOnAuthError marks a coordinator whose provider rejected its credentials
+// as failed and notifies the user. No replacement is triggered, since a new coordinator
+// would fail the same way until the credentials are fixed.
+func (h *ProcessTurnCompleteHandler) failCoordinatorOnAuthError(turnCmd *command.ProcessTurnCompleteCommand, proc *repository.Process) (*command.CommandResult, error) {
+	proc.Status = repository.StatusFailed
+	proc.LastActivityAt = h.clock.Now()
+
+	if err := h.processRepo.Save(proc); err != nil {
+		return nil, fmt.Errorf("failed to save coordinator: %w", err)
+	}
+
+	h.soundService.Play("deny", "coordinator_auth_failed")
+
+	errorEvent := events.NewProcessEvent(events.ProcessError, proc.ID, proc.Role).
+		WithStatus(events.ProcessStatusFailed).
+		WithError(turnCmd.Error)
+
+	notifyEvent := events.NewProcessEvent(events.ProcessUserNotification, proc.ID, proc.Role).
+		WithOutput("The coordinator's provider rejected its credentials. Fix the provider credentials, then resume the workflow.")
+
+	result := &ProcessTurnCompleteResult{
+		ProcessID: proc.ID,
+		NewStatus: repository.StatusFailed,
+		WasNoOp:   false,
+	}
+
+	return SuccessWithEvents(result, errorEvent, notifyEvent), nil
+}
+
+// replaceRetiringWorker completes the turn of a worker that requested retirement and
+// triggers its replacement. Queued messages are not delivered to the retiring worker.
+func (h *ProcessTurnCompleteHandler) replaceRetiringWorker(turnCmd *command.ProcessTurnCompleteCommand, proc *repository.Process) (*command.CommandResult, error) {
+	proc.Status = repository.StatusReady
+	proc.LastActivityAt = h.clock.Now()
+	if turnCmd.Succeeded {
+		proc.HasCompletedTurn = true
+	}
+	if turnCmd.Metrics != nil {
+		proc.Metrics = turnCmd.Metrics
+		proc.Usage.Add(turnCmd.Metrics)
+	}
+
+	if err := h.processRepo.Save(proc); err != nil {
+		return nil, fmt.Errorf("failed to save process: %w", err)
+	}
+
+	replaceCmd := command.NewReplaceProcessCommand(command.SourceInternal, proc.ID, "retirement_requested")
+	if turnCmd.TraceID() != "" {
+		replaceCmd.SetTraceID(turnCmd.TraceID())
+	}
+
+	result := &ProcessTurnCompleteResult{
+		ProcessID:           proc.ID,
+		NewStatus:           repository.StatusReady,
+		RetirementRequested: true,
+	}
+
+	return SuccessWithFollowUp(result, replaceCmd), nil
+}
+
+// HandoffThreshold returns the configured coordinator context size (in tokens)
+// at which an automatic handoff is triggered. Zero means disabled.
+func (h *ProcessTurnCompleteHandler) HandoffThreshold() int {
+	return h.handoffThreshold
+}
+
+// ShouldHandoff reports whether a turn that moved the coordinator's context
+// size from prevTokens to currTokens crossed the handoff threshold.
+// Returns false if automatic handoff is disabled or the threshold was
+// already exceeded before this turn.
+func (h *ProcessTurnCompleteHandler) ShouldHandoff(prevTokens, currTokens int) bool {
+	if h.handoffThreshold <= 0 {
+		return false
+	}
+	return prevTokens < h.handoffThreshold && currTokens >= h.handoffThreshold
+}
+
+// postHandoffSummary assembles a handoff summary from the current worker state
+// and enqueues it for the coordinator. The existing queue-drain logic delivers it.
+func (h *ProcessTurnCompleteHandler) postHandoffSummary(coordinator *repository.Process) error {
+	var workers []prompt.HandoffWorkerSummary
+	for _, w := range h.processRepo.ActiveWorkers() {
+		summary := prompt.HandoffWorkerSummary{
+			WorkerID: w.ID,
+			Status:   string(w.Status),
+			TaskID:   w.TaskID,
+		}
+		if w.Phase != nil {
+			summary.Phase = string(*w.Phase)
+		}
+		workers = append(workers, summary)
+	}
+
+	content := prompt.BuildHandoffSummaryPrompt(coordinator.ContextTokens(), h.handoffThreshold, workers)
+
+	queue := h.queueRepo.GetOrCreate(coordinator.ID)
+	if err := queue.Enqueue(content, repository.SenderSystem); err != nil {
+		return fmt.Errorf("failed to enqueue handoff summary: %w", err)
+	}
+
+	log.Debug(log.CatOrch, "Posted automatic handoff summary",
+		"processID", coordinator.ID,
+		"contextTokens", coordinator.ContextTokens(),
+		"threshold", h.handoffThreshold)
+
+	return nil
+}
+
 // ProcessTurnCompleteResult contains the result of handling turn completion.
 type ProcessTurnCompleteResult struct {
 	ProcessID            string
@@ -834,6 +1194,7 @@ type ProcessTurnCompleteResult struct {
 	QueuedDelivery       bool // true if DeliverProcessQueuedCommand was added to follow-ups
 	WasNoOp              bool // true if process was already Retired (idempotent)
 	EnforcementTriggered bool // true if a turn completion enforcement reminder was sent
+	RetirementRequested  bool // true if the worker requested retirement and a replacement was triggered
 }
 
 // ===========================================================================
@@ -846,6 +1207,10 @@ type RetireProcessHandler struct {
 	processRepo repository.ProcessRepository
 	registry    *process.ProcessRegistry
 	enforcer    TurnCompletionEnforcer
+	clock       types.Clock
+
+	// workerCapacity receives the slot of a retired worker.
+	workerCapacity WorkerSlotReleaser
 }
 
 // RetireProcessHandlerOption configures RetireProcessHandler.
@@ -859,6 +1224,22 @@ func WithRetireTurnEnforcer(enforcer TurnCompletionEnforcer) RetireProcessHandle
 	}
 }
 
+// WithRetireProcessClock sets the clock used to stamp retirement.
+func WithRetireProcessClock(clock types.Clock) RetireProcessHandlerOption {
+	return func(h *RetireProcessHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
+// WithRetireWorkerCapacity sets the shared worker pool a retired worker's slot is returned to.
+func WithRetireWorkerCapacity(capacity WorkerSlotReleaser) RetireProcessHandlerOption {
+	return func(h *RetireProcessHandler) {
+		h.workerCapacity = capacity
+	}
+}
+
 // NewRetireProcessHandler creates a new RetireProcessHandler.
 func NewRetireProcessHandler(
 	processRepo repository.ProcessRepository,
@@ -868,6 +1249,7 @@ func NewRetireProcessHandler(
 	h := &RetireProcessHandler{
 		processRepo: processRepo,
 		registry:    registry,
+		clock:       types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -900,7 +1282,8 @@ func (h *RetireProcessHandler) Handle(ctx context.Context, cmd command.Command)
 
 	// Update process status
 	proc.Status = repository.StatusRetired
-	proc.RetiredAt = time.Now()
+	proc.RetiredAt = h.clock.Now()
+	releaseWorkerSlot(h.workerCapacity, proc)
 
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to save process: %w", err)
@@ -945,6 +1328,8 @@ type RetireProcessResult struct {
 
 // BroadcastHandler handles CmdBroadcast commands.
 // It sends a message to all active processes by creating SendToWorker follow-up commands.
+// Role-scoped recipients (ALL_REVIEWERS, ALL_IMPLEMENTERS) are resolved against each
+// worker's current phase, so only workers in that role receive the message.
 type BroadcastHandler struct {
 	processRepo repository.ProcessRepository
 }
@@ -980,6 +1365,11 @@ func (h *BroadcastHandler) Handle(ctx context.Context, cmd command.Command) (*co
 			continue
 		}
 
+		// Skip processes outside the requested role
+		if !matchesBroadcastRecipient(proc, broadcastCmd.Recipient) {
+			continue
+		}
+
 		// Create SendToProcessCommand for each target process
 		sendCmd := command.NewSendToProcessCommand(command.SourceInternal, proc.ID, broadcastCmd.Content)
 		if broadcastCmd.TraceID() != "" {
@@ -993,19 +1383,48 @@ func (h *BroadcastHandler) Handle(ctx context.Context, cmd command.Command) (*co
 	result := &BroadcastResult{
 		TargetWorkers:   targetWorkerIDs,
 		ExcludedWorkers: broadcastCmd.ExcludeWorkers,
+		Recipient:       broadcastCmd.Recipient,
 		MessagesSent:    len(followUps),
 	}
 
 	return SuccessWithFollowUp(result, followUps...), nil
 }
 
+// matchesBroadcastRecipient reports whether proc is addressed by the broadcast recipient token.
+func matchesBroadcastRecipient(proc *repository.Process, recipient string) bool {
+	switch recipient {
+	case message.ActorAllReviewers:
+		return proc.Phase != nil && *proc.Phase == events.ProcessPhaseReviewing
+	case message.ActorAllImplementers:
+		if proc.Phase == nil {
+			return false
+		}
+		switch *proc.Phase {
+		case events.ProcessPhaseImplementing,
+			events.ProcessPhaseAwaitingReview,
+			events.ProcessPhaseAddressingFeedback,
+			events.ProcessPhaseCommitting:
+			return true
+		}
+		return false
+	default:
+		return true
+	}
+}
+
 // BroadcastResult contains the result of broadcasting a message.
 type BroadcastResult struct {
 	TargetWorkers   []string
 	ExcludedWorkers []string
+	Recipient       string
 	MessagesSent    int
 }
 
+// GetTargetWorkers returns the workers the broadcast was delivered to.
+func (r *BroadcastResult) GetTargetWorkers() []string {
+	return r.TargetWorkers
+}
+
 // ===========================================================================
 // SpawnProcessHandler
 // ===========================================================================
@@ -1020,6 +1439,7 @@ type SpawnProcessHandler struct {
 	spawner     UnifiedProcessSpawner
 	enforcer    TurnCompletionEnforcer
 	tracer      trace.Tracer
+	clock       types.Clock
 }
 
 // SpawnProcessHandlerOption configures SpawnProcessHandler.
@@ -1050,6 +1470,15 @@ func WithSpawnProcessTracer(tracer trace.Tracer) SpawnProcessHandlerOption {
 	}
 }
 
+// WithSpawnProcessClock sets the clock used to stamp spawned processes.
+func WithSpawnProcessClock(clock types.Clock) SpawnProcessHandlerOption {
+	return func(h *SpawnProcessHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
 // NewSpawnProcessHandler creates a new SpawnProcessHandler.
 func NewSpawnProcessHandler(
 	processRepo repository.ProcessRepository,
@@ -1060,6 +1489,7 @@ func NewSpawnProcessHandler(
 		processRepo: processRepo,
 		registry:    registry,
 		tracer:      noop.NewTracerProvider().Tracer("noop"),
+		clock:       types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -1136,8 +1566,8 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 		ID:             processID,
 		Role:           spawnCmd.Role,
 		Status:         repository.StatusPending,
-		CreatedAt:      time.Now(),
-		LastActivityAt: time.Now(),
+		CreatedAt:      h.clock.Now(),
+		LastActivityAt: h.clock.Now(),
 		AgentType:      spawnCmd.AgentType,
 	}
 
@@ -1166,6 +1596,9 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 		if h.registry != nil {
 			h.registry.Register(liveProcess)
 		}
+		if liveProcess != nil {
+			proc.Provider = liveProcess.Provider
+		}
 	}
 
 	// Update status to Working if we spawned a live process (it's running its first turn).
@@ -1244,13 +1677,17 @@ type SessionDirProvider interface {
 // ReplaceProcessHandler handles CmdReplaceProcess commands.
 // This is one of the two handlers with role-specific branching:
 // - Coordinator: context window refresh with handoff prompt
-// - Worker: simple retire and spawn replacement
+// - Worker: retire and spawn replacement, optionally handing over the worker's task
 type ReplaceProcessHandler struct {
 	processRepo           repository.ProcessRepository
 	registry              *process.ProcessRegistry
 	spawner               UnifiedProcessSpawner
 	workflowStateProvider WorkflowStateProvider
 	sessionDirProvider    SessionDirProvider
+	taskRepo              repository.TaskRepository
+	queueRepo             repository.QueueRepository
+	gitExecutor           appgit.GitExecutor
+	clock                 types.Clock
 }
 
 // ReplaceProcessHandlerOption configures ReplaceProcessHandler.
@@ -1281,6 +1718,33 @@ func WithSessionDirProvider(p SessionDirProvider) ReplaceProcessHandlerOption {
 	}
 }
 
+// WithReplaceTaskReassignment enables handing a replaced worker's task to its replacement
+// when the command sets Reassign. Both repositories are required for reassignment.
+func WithReplaceTaskReassignment(taskRepo repository.TaskRepository, queueRepo repository.QueueRepository) ReplaceProcessHandlerOption {
+	return func(h *ReplaceProcessHandler) {
+		h.taskRepo = taskRepo
+		h.queueRepo = queueRepo
+	}
+}
+
+// WithReplaceGitExecutor sets the git executor used to record the files a replaced worker
+// had changed, so its replacement is told where the work stands.
+// If nil, the continuity prompt omits the changed files.
+func WithReplaceGitExecutor(executor appgit.GitExecutor) ReplaceProcessHandlerOption {
+	return func(h *ReplaceProcessHandler) {
+		h.gitExecutor = executor
+	}
+}
+
+// WithReplaceProcessClock sets the clock used to stamp retired processes and their replacements.
+func WithReplaceProcessClock(clock types.Clock) ReplaceProcessHandlerOption {
+	return func(h *ReplaceProcessHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
 // NewReplaceProcessHandler creates a new ReplaceProcessHandler.
 func NewReplaceProcessHandler(
 	processRepo repository.ProcessRepository,
@@ -1290,6 +1754,7 @@ func NewReplaceProcessHandler(
 	h := &ReplaceProcessHandler{
 		processRepo: processRepo,
 		registry:    registry,
+		clock:       types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -1317,7 +1782,7 @@ func (h *ReplaceProcessHandler) Handle(ctx context.Context, cmd command.Command)
 	if proc.IsObserver() {
 		return h.replaceObserver(ctx, proc)
 	}
-	return h.replaceWorker(ctx, proc, replaceCmd.Reason)
+	return h.replaceWorker(ctx, proc, replaceCmd.Reassign)
 }
 
 // replaceCoordinator handles coordinator replacement with context handoff.
@@ -1380,7 +1845,7 @@ func (h *ReplaceProcessHandler) replaceCoordinator(ctx context.Context, proc *re
 
 	// Step 6: Mark old coordinator as Retired
 	proc.Status = repository.StatusRetired
-	proc.RetiredAt = time.Now()
+	proc.RetiredAt = h.clock.Now()
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to retire old coordinator: %w", err)
 	}
@@ -1391,13 +1856,18 @@ func (h *ReplaceProcessHandler) replaceCoordinator(ctx context.Context, proc *re
 		h.registry.Register(newLiveProcess)
 	}
 
-	// Step 8: Create and save new coordinator entity as Ready
+	// Step 8: Create and save new coordinator entity as Ready.
+	// Coordinator-wide state set through tools belongs to the workflow, not the session,
+	// so the replacement inherits it.
 	newProc := &repository.Process{
-		ID:             repository.CoordinatorID,
-		Role:           repository.RoleCoordinator,
-		Status:         repository.StatusReady,
-		CreatedAt:      time.Now(),
-		LastActivityAt: time.Now(),
+		ID:                 repository.CoordinatorID,
+		Role:               repository.RoleCoordinator,
+		Status:             repository.StatusReady,
+		CreatedAt:          h.clock.Now(),
+		LastActivityAt:     h.clock.Now(),
+		GlobalInstructions: proc.GlobalInstructions,
+		Focus:              proc.Focus,
+		HeldTasks:          proc.HeldTasks,
 	}
 	if err := h.processRepo.Save(newProc); err != nil {
 		return nil, fmt.Errorf("failed to save new coordinator: %w", err)
@@ -1502,7 +1972,7 @@ func (h *ReplaceProcessHandler) replaceObserver(ctx context.Context, proc *repos
 
 	// Step 6: Mark old observer as Retired
 	proc.Status = repository.StatusRetired
-	proc.RetiredAt = time.Now()
+	proc.RetiredAt = h.clock.Now()
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to retire old observer: %w", err)
 	}
@@ -1518,8 +1988,8 @@ func (h *ReplaceProcessHandler) replaceObserver(ctx context.Context, proc *repos
 		ID:             repository.ObserverID,
 		Role:           repository.RoleObserver,
 		Status:         repository.StatusReady,
-		CreatedAt:      time.Now(),
-		LastActivityAt: time.Now(),
+		CreatedAt:      h.clock.Now(),
+		LastActivityAt: h.clock.Now(),
 	}
 	if err := h.processRepo.Save(newProc); err != nil {
 		return nil, fmt.Errorf("failed to save new observer: %w", err)
@@ -1547,12 +2017,23 @@ func (h *ReplaceProcessHandler) replaceObserver(ctx context.Context, proc *repos
 	return SuccessWithEvents(result, resultEvents...), nil
 }
 
-// replaceWorker handles worker replacement with simple retire and spawn.
-// Workers do NOT receive a handoff prompt (unlike coordinators) because workers are stateless
-// task executors. Each worker receives fresh task assignments from the coordinator, so there's
-// no context to hand off - the coordinator maintains all orchestration state and will simply
-// assign new work to the replacement worker.
-func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *repository.Process, _ string) (*command.CommandResult, error) {
+// replaceWorker handles worker replacement with retire and spawn.
+// By default the replacement starts idle and the coordinator assigns it new work.
+//
+// With reassign set, a task the retired worker was implementing moves to the replacement
+// along with the retired worker's phase. The last progress summary and the files changed
+// in the worktree are stored on the task assignment before the retired worker's task is
+// cleared, and the replacement is sent a continuity prompt built from them so it picks up
+// where the retired worker left off instead of restarting the task.
+func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *repository.Process, reassign bool) (*command.CommandResult, error) {
+	var task *repository.TaskAssignment
+	if reassign {
+		var err error
+		if task, err = h.handoffTask(proc); err != nil {
+			return nil, err
+		}
+	}
+
 	// Generate new worker ID
 	workers := h.processRepo.Workers()
 	maxNum := 0
@@ -1575,9 +2056,19 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 		}
 	}
 
-	// Mark old as retired
+	// Mark old as retired, releasing its task if it is being handed over
+	retiredTaskID := proc.TaskID
+	phase := events.ProcessPhaseImplementing
+	if task != nil {
+		if proc.Phase != nil && *proc.Phase != events.ProcessPhaseIdle {
+			phase = *proc.Phase
+		}
+		idle := events.ProcessPhaseIdle
+		proc.Phase = &idle
+		proc.TaskID = ""
+	}
 	proc.Status = repository.StatusRetired
-	proc.RetiredAt = time.Now()
+	proc.RetiredAt = h.clock.Now()
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to retire old worker: %w", err)
 	}
@@ -1587,8 +2078,8 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 		ID:             newWorkerID,
 		Role:           repository.RoleWorker,
 		Status:         repository.StatusPending,
-		CreatedAt:      time.Now(),
-		LastActivityAt: time.Now(),
+		CreatedAt:      h.clock.Now(),
+		LastActivityAt: h.clock.Now(),
 	}
 
 	if err := h.processRepo.Save(newProc); err != nil {
@@ -1607,10 +2098,17 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 			h.registry.Unregister(proc.ID)
 			h.registry.Register(newLiveProcess)
 		}
+		if newLiveProcess != nil {
+			newProc.Provider = newLiveProcess.Provider
+		}
 	}
 
 	// Update status to Ready (spawner success or no spawner = ready for tests)
 	newProc.Status = repository.StatusReady
+	if task != nil {
+		newProc.Phase = &phase
+		newProc.TaskID = task.TaskID
+	}
 	_ = h.processRepo.Save(newProc)
 
 	// Emit events
@@ -1619,12 +2117,15 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 	// Old worker retired
 	retiredEvent := events.NewProcessEvent(events.ProcessStatusChange, proc.ID, events.RoleWorker).
 		WithStatus(events.ProcessStatusRetired).
-		WithTaskID(proc.TaskID)
+		WithTaskID(retiredTaskID)
 	resultEvents = append(resultEvents, retiredEvent)
 
 	// New worker spawned
 	spawnedEvent := events.NewProcessEvent(events.ProcessSpawned, newWorkerID, events.RoleWorker).
 		WithStatus(newProc.Status)
+	if task != nil {
+		spawnedEvent = spawnedEvent.WithTaskID(task.TaskID).WithPhase(phase)
+	}
 	resultEvents = append(resultEvents, spawnedEvent)
 
 	result := &ReplaceProcessResult{
@@ -1633,7 +2134,71 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 		Role:         repository.RoleWorker,
 	}
 
-	return SuccessWithEvents(result, resultEvents...), nil
+	if task == nil {
+		return SuccessWithEvents(result, resultEvents...), nil
+	}
+
+	// Hand the task to the replacement and queue its continuity prompt
+	task.Implementer = newWorkerID
+	task.TransferredFrom = proc.ID
+	if err := h.taskRepo.Save(task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+	result.TaskID = task.TaskID
+
+	summary := task.CompletionSummary
+	if summary == "" {
+		summary = task.TransferNote
+	}
+	var continuityPrompt string
+	if custom := roles.GetPrompts(proc.AgentType).ReplacementContinuityPrompt; custom != nil {
+		continuityPrompt = custom(task.TaskID, proc.ID, string(phase), task.ThreadID, summary, task.HandoffFiles)
+	} else {
+		continuityPrompt = prompt.ReplacementContinuityPrompt(task.TaskID, proc.ID, string(phase), task.ThreadID, summary, task.HandoffFiles)
+	}
+	continuityPrompt += prompt.ReplacementCheckpointNote(task.Checkpoints)
+	if err := h.queueRepo.GetOrCreate(newWorkerID).Enqueue(continuityPrompt, repository.SenderCoordinator); err != nil {
+		return nil, fmt.Errorf("failed to queue continuity prompt: %w", err)
+	}
+
+	deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, newWorkerID)
+	return SuccessWithEventsAndFollowUp(result, resultEvents, []command.Command{deliverCmd}), nil
+}
+
+// handoffTask returns the in-progress task proc is implementing, with the files changed
+// in the worktree recorded on it, or nil if proc holds no task that can be handed over.
+// The assignment is not saved until the replacement has been spawned.
+func (h *ReplaceProcessHandler) handoffTask(proc *repository.Process) (*repository.TaskAssignment, error) {
+	if proc.TaskID == "" {
+		return nil, nil
+	}
+	if h.taskRepo == nil || h.queueRepo == nil {
+		return nil, fmt.Errorf("task reassignment not configured")
+	}
+
+	task, err := h.taskRepo.Get(proc.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	if task.Implementer != proc.ID || task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed {
+		return nil, nil
+	}
+
+	task.HandoffFiles = nil
+	if h.gitExecutor != nil {
+		status, err := h.gitExecutor.GetStatus()
+		if err != nil {
+			log.Warn(log.CatOrch, "Failed to read worktree status for replacement handoff",
+				"processID", proc.ID, "taskID", task.TaskID, "error", err)
+		}
+		for _, f := range status {
+			task.HandoffFiles = append(task.HandoffFiles, f.Path)
+		}
+	}
+	return task, nil
 }
 
 // buildReplacementPrompt determines which prompt to use for coordinator replacement.
@@ -1660,6 +2225,17 @@ type ReplaceProcessResult struct {
 	OldProcessID string
 	NewProcessID string
 	Role         repository.ProcessRole
+	TaskID       string // Task handed to the replacement (empty if none)
+}
+
+// GetNewProcessID returns the ID of the replacement process.
+func (r *ReplaceProcessResult) GetNewProcessID() string {
+	return r.NewProcessID
+}
+
+// GetReassignedTaskID returns the task handed to the replacement, or empty if none.
+func (r *ReplaceProcessResult) GetReassignedTaskID() string {
+	return r.TaskID
 }
 
 // ===========================================================================
@@ -1771,17 +2347,36 @@ type PauseProcessResult struct {
 type ResumeProcessHandler struct {
 	processRepo repository.ProcessRepository
 	queueRepo   repository.QueueRepository
+	clock       types.Clock
+}
+
+// ResumeProcessHandlerOption configures ResumeProcessHandler.
+type ResumeProcessHandlerOption func(*ResumeProcessHandler)
+
+// WithResumeProcessClock sets the clock used to stamp resumed process activity.
+func WithResumeProcessClock(clock types.Clock) ResumeProcessHandlerOption {
+	return func(h *ResumeProcessHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
 }
 
 // NewResumeProcessHandler creates a new ResumeProcessHandler.
 func NewResumeProcessHandler(
 	processRepo repository.ProcessRepository,
 	queueRepo repository.QueueRepository,
+	opts ...ResumeProcessHandlerOption,
 ) *ResumeProcessHandler {
-	return &ResumeProcessHandler{
+	h := &ResumeProcessHandler{
 		processRepo: processRepo,
 		queueRepo:   queueRepo,
+		clock:       types.RealClock{},
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Handle processes a ResumeProcessCommand.
@@ -1819,7 +2414,7 @@ func (h *ResumeProcessHandler) Handle(ctx context.Context, cmd command.Command)
 
 	// Update process status to Ready
 	proc.Status = repository.StatusReady
-	proc.LastActivityAt = time.Now()
+	proc.LastActivityAt = h.clock.Now()
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to save process: %w", err)
 	}
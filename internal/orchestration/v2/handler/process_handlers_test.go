@@ -10,7 +10,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/message"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
@@ -18,6 +22,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 )
 
@@ -204,6 +209,106 @@ func TestSendToProcessHandler_SendToRetiredProcess_ReturnsError(t *testing.T) {
 	assert.ErrorIs(t, err, handler.ErrProcessRetired)
 }
 
+// ===========================================================================
+// BroadcastHandler Tests
+// ===========================================================================
+
+// setupBroadcastWorkers registers one worker in each relevant phase.
+func setupBroadcastWorkers(processRepo *repository.MemoryProcessRepository) {
+	for id, phase := range map[string]events.ProcessPhase{
+		"worker-1": events.ProcessPhaseImplementing,
+		"worker-2": events.ProcessPhaseReviewing,
+		"worker-3": events.ProcessPhaseAddressingFeedback,
+		"worker-4": events.ProcessPhaseIdle,
+		"worker-5": events.ProcessPhaseReviewing,
+	} {
+		processRepo.AddProcess(&repository.Process{
+			ID:     id,
+			Role:   repository.RoleWorker,
+			Status: repository.StatusReady,
+			Phase:  phasePtr(phase),
+		})
+	}
+}
+
+func broadcastTargets(t *testing.T, result *command.CommandResult) []string {
+	t.Helper()
+	require.True(t, result.Success)
+	var targets []string
+	for _, followUp := range result.FollowUp {
+		sendCmd, ok := followUp.(*command.SendToProcessCommand)
+		require.True(t, ok)
+		targets = append(targets, sendCmd.ProcessID)
+	}
+	return targets
+}
+
+func TestBroadcastHandler_AllReachesEveryWorker(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	setupBroadcastWorkers(processRepo)
+
+	h := handler.NewBroadcastHandler(processRepo)
+	result, err := h.Handle(context.Background(), command.NewBroadcastCommand(command.SourceMCPTool, "heads up", nil))
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"worker-1", "worker-2", "worker-3", "worker-4", "worker-5"}, broadcastTargets(t, result))
+}
+
+func TestBroadcastHandler_ReviewerScopedSkipsImplementers(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	setupBroadcastWorkers(processRepo)
+
+	cmd := command.NewBroadcastCommand(command.SourceMCPTool, "check for SQL injection", nil)
+	cmd.Recipient = message.ActorAllReviewers
+
+	h := handler.NewBroadcastHandler(processRepo)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	targets := broadcastTargets(t, result)
+	assert.ElementsMatch(t, []string{"worker-2", "worker-5"}, targets)
+	assert.NotContains(t, targets, "worker-1", "implementer must not receive reviewer broadcast")
+	assert.NotContains(t, targets, "worker-3", "worker addressing feedback must not receive reviewer broadcast")
+
+	broadcastResult := result.Data.(*handler.BroadcastResult)
+	assert.Equal(t, message.ActorAllReviewers, broadcastResult.Recipient)
+	assert.Equal(t, 2, broadcastResult.MessagesSent)
+}
+
+func TestBroadcastHandler_ImplementerScopedSkipsReviewersAndIdle(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	setupBroadcastWorkers(processRepo)
+
+	cmd := command.NewBroadcastCommand(command.SourceMCPTool, "rebase onto main", []string{"worker-3"})
+	cmd.Recipient = message.ActorAllImplementers
+
+	h := handler.NewBroadcastHandler(processRepo)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"worker-1"}, broadcastTargets(t, result))
+}
+
+func TestBroadcastHandler_NoMatchingRole(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  phasePtr(events.ProcessPhaseImplementing),
+	})
+
+	cmd := command.NewBroadcastCommand(command.SourceMCPTool, "reviewers only", nil)
+	cmd.Recipient = message.ActorAllReviewers
+
+	h := handler.NewBroadcastHandler(processRepo)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	assert.Empty(t, broadcastTargets(t, result))
+	assert.Equal(t, 0, result.Data.(*handler.BroadcastResult).MessagesSent)
+}
+
 // ===========================================================================
 // DeliverProcessQueuedHandler Tests
 // ===========================================================================
@@ -568,6 +673,45 @@ func TestProcessTurnCompleteHandler_WorkerUpdatesStatusToReady(t *testing.T) {
 	assert.Equal(t, repository.StatusReady, updated.Status)
 }
 
+func TestProcessTurnCompleteHandler_RetirementRequestedTriggersReplacement(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	worker := &repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		TaskID:           "perles-abc.1",
+		HasCompletedTurn: true,
+		RetirementReason: "context is corrupted",
+	}
+	processRepo.AddProcess(worker)
+
+	// Messages queued for the retiring worker are not delivered
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").Enqueue("more work", repository.SenderCoordinator))
+
+	// Enforcement is skipped even though no required tool was called this turn
+	enforcer := handler.NewTurnCompletionTracker()
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo, handler.WithProcessTurnEnforcer(enforcer))
+
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil)
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	require.True(t, turnResult.RetirementRequested)
+	require.False(t, turnResult.EnforcementTriggered)
+
+	require.Len(t, result.FollowUp, 1)
+	replaceCmd, ok := result.FollowUp[0].(*command.ReplaceProcessCommand)
+	require.True(t, ok, "expected ReplaceProcessCommand, got: %T", result.FollowUp[0])
+	require.Equal(t, "worker-1", replaceCmd.ProcessID)
+	require.Equal(t, "retirement_requested", replaceCmd.Reason)
+
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, repository.StatusReady, updated.Status)
+}
+
 func TestProcessTurnCompleteHandler_UpdatesLastActivityAt(t *testing.T) {
 	processRepo, queueRepo := setupProcessRepos()
 
@@ -616,6 +760,133 @@ func TestProcessTurnCompleteHandler_UpdatesMetricsWhenProvided(t *testing.T) {
 	assert.Equal(t, 500, updated.Metrics.OutputTokens)
 }
 
+func TestProcessTurnCompleteHandler_AccumulatesUsageAcrossTurns(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	worker := &repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	}
+	processRepo.AddProcess(worker)
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo)
+
+	turns := []*metrics.TokenMetrics{
+		{TokensUsed: 1000, OutputTokens: 200},
+		{TokensUsed: 3000, OutputTokens: 400},
+	}
+	for _, m := range turns {
+		worker.Status = repository.StatusWorking
+		cmd := command.NewProcessTurnCompleteCommand("worker-1", true, m, nil)
+		_, err := h.Handle(context.Background(), cmd)
+		require.NoError(t, err)
+	}
+
+	updated, _ := processRepo.Get("worker-1")
+	assert.Equal(t, 4000, updated.Usage.InputTokens)
+	assert.Equal(t, 600, updated.Usage.OutputTokens)
+	assert.Equal(t, 2, updated.Usage.Turns)
+	// Metrics still reflects only the latest turn
+	assert.Equal(t, 3000, updated.Metrics.TokensUsed)
+}
+
+func TestProcessTurnCompleteHandler_HandoffThresholdCrossedPostsSummary(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	coord := &repository.Process{
+		ID:      repository.CoordinatorID,
+		Role:    repository.RoleCoordinator,
+		Status:  repository.StatusWorking,
+		Metrics: &metrics.TokenMetrics{TokensUsed: 90000},
+	}
+	processRepo.AddProcess(coord)
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseImplementing),
+		TaskID: "perles-abc.1",
+	})
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithHandoffThreshold(100000))
+	require.Equal(t, 100000, h.HandoffThreshold())
+
+	cmd := command.NewProcessTurnCompleteCommand(repository.CoordinatorID, true,
+		&metrics.TokenMetrics{TokensUsed: 120000}, nil)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	assert.True(t, turnResult.QueuedDelivery)
+	require.Len(t, result.FollowUp, 1)
+	assert.Equal(t, command.CmdDeliverProcessQueued, result.FollowUp[0].Type())
+
+	queue := queueRepo.GetOrCreate(repository.CoordinatorID)
+	entry, ok := queue.Dequeue()
+	require.True(t, ok)
+	assert.Equal(t, repository.SenderSystem, entry.Sender)
+	assert.Contains(t, entry.Content, "[CONTEXT HANDOFF")
+	assert.Contains(t, entry.Content, "worker-1")
+	assert.Contains(t, entry.Content, "perles-abc.1")
+}
+
+func TestProcessTurnCompleteHandler_HandoffThresholdOnlyTriggersOnce(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	coord := &repository.Process{
+		ID:      repository.CoordinatorID,
+		Role:    repository.RoleCoordinator,
+		Status:  repository.StatusWorking,
+		Metrics: &metrics.TokenMetrics{TokensUsed: 120000},
+	}
+	processRepo.AddProcess(coord)
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithHandoffThreshold(100000))
+
+	// Already past the threshold before this turn - no new handoff
+	cmd := command.NewProcessTurnCompleteCommand(repository.CoordinatorID, true,
+		&metrics.TokenMetrics{TokensUsed: 130000}, nil)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	assert.Empty(t, result.FollowUp)
+	assert.True(t, queueRepo.GetOrCreate(repository.CoordinatorID).IsEmpty())
+}
+
+func TestProcessTurnCompleteHandler_HandoffThresholdIgnoresWorkers(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	})
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithHandoffThreshold(100000))
+
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", true,
+		&metrics.TokenMetrics{TokensUsed: 150000}, nil)
+	_, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	assert.True(t, queueRepo.GetOrCreate("worker-1").IsEmpty())
+}
+
+func TestProcessTurnCompleteHandler_ShouldHandoff(t *testing.T) {
+	disabled := handler.NewProcessTurnCompleteHandler(nil, nil)
+	assert.False(t, disabled.ShouldHandoff(0, 1_000_000))
+
+	h := handler.NewProcessTurnCompleteHandler(nil, nil, handler.WithHandoffThreshold(100000))
+	assert.False(t, h.ShouldHandoff(50000, 99999))
+	assert.True(t, h.ShouldHandoff(50000, 100000))
+	assert.False(t, h.ShouldHandoff(100000, 110000))
+}
+
 func TestProcessTurnCompleteHandler_EmitsProcessReadyEvent(t *testing.T) {
 	processRepo, queueRepo := setupProcessRepos()
 
@@ -1016,6 +1287,114 @@ func TestProcessTurnCompleteHandler_WorkerContextExceeded_PlaysSound(t *testing.
 	assert.Equal(t, "worker_out_of_context", mockSound.playedSounds[0].useCase)
 }
 
+func TestProcessTurnCompleteHandler_WorkerAuthFailed_TellsCoordinatorToHalt(t *testing.T) {
+	tests := []struct {
+		name             string
+		hasCompletedTurn bool
+		err              error
+	}{
+		{"first turn, exit error", false, fmt.Errorf("%w: claude process failed: Invalid API key (exit: exit status 1)", client.ErrProviderAuth)},
+		{"later turn, in-flight error", true, fmt.Errorf("%w: OAuth token has expired", client.ErrProviderAuth)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processRepo, queueRepo := setupProcessRepos()
+			processRepo.AddProcess(&repository.Process{
+				ID:     repository.CoordinatorID,
+				Role:   repository.RoleCoordinator,
+				Status: repository.StatusReady,
+			})
+			processRepo.AddProcess(&repository.Process{
+				ID:               "worker-1",
+				Role:             repository.RoleWorker,
+				Status:           repository.StatusWorking,
+				TaskID:           "task-123",
+				HasCompletedTurn: tt.hasCompletedTurn,
+			})
+
+			mockSound := &mockSoundService{}
+			capacity := &countingSlotReleaser{}
+			h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+				handler.WithProcessTurnSoundService(mockSound),
+				handler.WithProcessTurnWorkerCapacity(capacity))
+
+			result, err := h.Handle(context.Background(),
+				command.NewProcessTurnCompleteCommand("worker-1", false, nil, tt.err))
+			require.NoError(t, err)
+			require.True(t, result.Success)
+
+			updated, _ := processRepo.Get("worker-1")
+			assert.Equal(t, repository.StatusFailed, updated.Status)
+
+			entry, _ := queueRepo.GetOrCreate(repository.CoordinatorID).Dequeue()
+			assert.Contains(t, entry.Content, "PROVIDER AUTHENTICATION FAILED")
+			assert.Contains(t, entry.Content, "authentication failed, check credentials")
+			assert.Contains(t, entry.Content, "task-123")
+			assert.Contains(t, entry.Content, "Do NOT spawn, replace or retry workers")
+			assert.Contains(t, entry.Content, "signal_workflow_complete")
+
+			require.Len(t, result.Events, 1)
+			errorEvent := result.Events[0].(events.ProcessEvent)
+			assert.Equal(t, events.ProcessError, errorEvent.Type)
+			assert.ErrorIs(t, errorEvent.Error, client.ErrProviderAuth)
+
+			require.Len(t, result.FollowUp, 1)
+			deliverCmd := result.FollowUp[0].(*command.DeliverProcessQueuedCommand)
+			assert.Equal(t, repository.CoordinatorID, deliverCmd.ProcessID)
+
+			require.Len(t, mockSound.playedSounds, 1)
+			assert.Equal(t, "worker_auth_failed", mockSound.playedSounds[0].useCase)
+
+			assert.Equal(t, 1, capacity.released, "failed worker's slot should be returned to the pool")
+		})
+	}
+}
+
+// countingSlotReleaser records how many worker slots were released.
+type countingSlotReleaser struct {
+	released int
+}
+
+func (c *countingSlotReleaser) Release() { c.released++ }
+
+func TestProcessTurnCompleteHandler_CoordinatorAuthFailed_HaltsWithoutReplacement(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusWorking,
+	})
+
+	mockSound := &mockSoundService{}
+	capacity := &countingSlotReleaser{}
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithProcessTurnSoundService(mockSound),
+		handler.WithProcessTurnWorkerCapacity(capacity))
+
+	authErr := errors.New("claude process failed: Invalid API key · Please run /login")
+	result, err := h.Handle(context.Background(),
+		command.NewProcessTurnCompleteCommand(repository.CoordinatorID, false, nil, authErr))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	updated, _ := processRepo.Get(repository.CoordinatorID)
+	assert.Equal(t, repository.StatusFailed, updated.Status)
+
+	require.Empty(t, result.FollowUp, "coordinator should not be replaced on an auth failure")
+	require.Len(t, result.Events, 2)
+	errorEvent := result.Events[0].(events.ProcessEvent)
+	assert.Equal(t, events.ProcessError, errorEvent.Type)
+	assert.Equal(t, authErr, errorEvent.Error)
+	notifyEvent := result.Events[1].(events.ProcessEvent)
+	assert.Equal(t, events.ProcessUserNotification, notifyEvent.Type)
+	assert.Contains(t, notifyEvent.Output, "credentials")
+
+	require.Len(t, mockSound.playedSounds, 1)
+	assert.Equal(t, "coordinator_auth_failed", mockSound.playedSounds[0].useCase)
+	assert.Zero(t, capacity.released, "coordinator does not hold a worker slot")
+}
+
 func TestProcessTurnCompleteHandler_NonContextExceededError_NoSound(t *testing.T) {
 	// Tests that sound is NOT played for non-context-exceeded errors
 	processRepo, queueRepo := setupProcessRepos()
@@ -2053,6 +2432,115 @@ func TestProcessTurnCompleteHandler_RepositorySessionID_SetOnFirstSuccessfulTurn
 	assert.True(t, updated.HasCompletedTurn)
 }
 
+func TestProcessTurnCompleteHandler_ReadyGracePeriod_SetOnWorkerFirstTurn(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID:     "coordinator",
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusWorking,
+	})
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithReadyGracePeriod(2*time.Second))
+
+	_, err := h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	_, err = h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("coordinator", true, nil, nil))
+	require.NoError(t, err)
+
+	worker, _ := processRepo.Get("worker-1")
+	require.Equal(t, repository.StatusReady, worker.Status)
+	require.Equal(t, worker.LastActivityAt.Add(2*time.Second), worker.AssignableAt)
+	require.True(t, worker.InReadyGrace(time.Now()))
+
+	coord, _ := processRepo.Get("coordinator")
+	require.True(t, coord.AssignableAt.IsZero(), "grace period only applies to workers")
+
+	// Later turns do not restart the grace period
+	worker.Status = repository.StatusWorking
+	worker.AssignableAt = time.Time{}
+	require.NoError(t, processRepo.Save(worker))
+	_, err = h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	worker, _ = processRepo.Get("worker-1")
+	require.True(t, worker.AssignableAt.IsZero())
+}
+
+func TestProcessTurnCompleteHandler_UsesClock(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	})
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithReadyGracePeriod(2*time.Second),
+		handler.WithProcessTurnClock(clock))
+	_, err := h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+
+	worker, _ := processRepo.Get("worker-1")
+	require.Equal(t, clock.Now(), worker.LastActivityAt)
+	require.Equal(t, clock.Now().Add(2*time.Second), worker.AssignableAt)
+	require.True(t, worker.InReadyGrace(clock.Now()))
+
+	clock.Advance(2 * time.Second)
+	require.False(t, worker.InReadyGrace(clock.Now()))
+}
+
+func TestProcessTurnCompleteHandler_ProviderReadyGracePeriod(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	processRepo, queueRepo := setupProcessRepos()
+	for id, provider := range map[string]client.ClientType{"worker-1": client.ClientClaude, "worker-2": client.ClientCursor, "worker-3": ""} {
+		processRepo.AddProcess(&repository.Process{
+			ID:       id,
+			Role:     repository.RoleWorker,
+			Status:   repository.StatusWorking,
+			Provider: provider,
+		})
+	}
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithReadyGracePeriod(time.Second),
+		handler.WithProviderReadyGracePeriod(client.ClientClaude, 0),
+		handler.WithProviderReadyGracePeriod(client.ClientCursor, 3*time.Second),
+		handler.WithProcessTurnClock(clock))
+	for _, id := range []string{"worker-1", "worker-2", "worker-3"} {
+		_, err := h.Handle(context.Background(), command.NewProcessTurnCompleteCommand(id, true, nil, nil))
+		require.NoError(t, err)
+	}
+
+	claude, _ := processRepo.Get("worker-1")
+	require.True(t, claude.AssignableAt.IsZero(), "provider override disables the grace period")
+	cursor, _ := processRepo.Get("worker-2")
+	require.Equal(t, clock.Now().Add(3*time.Second), cursor.AssignableAt)
+	unknown, _ := processRepo.Get("worker-3")
+	require.Equal(t, clock.Now().Add(time.Second), unknown.AssignableAt, "workers without a provider use the default")
+}
+
+func TestProcessTurnCompleteHandler_NoReadyGracePeriodByDefault(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	})
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo)
+	_, err := h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+
+	worker, _ := processRepo.Get("worker-1")
+	require.True(t, worker.AssignableAt.IsZero())
+}
+
 func TestProcessTurnCompleteHandler_WithSoundService_AcceptsOption(t *testing.T) {
 	// Verifies that WithProcessTurnSoundService option is accepted and doesn't cause panics
 	processRepo, queueRepo := setupProcessRepos()
@@ -2226,6 +2714,38 @@ func TestRetireProcessHandler_AlreadyRetired_ReturnsSuccessNoOp(t *testing.T) {
 	assert.True(t, retireResult.WasNoOp)
 }
 
+func TestRetireProcessHandler_ReleasesWorkerSlotOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		proc         *repository.Process
+		wantReleased int
+	}{
+		{"ready worker", &repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady}, 1},
+		{"already retired", &repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusRetired}, 0},
+		{"slot freed on auth failure", &repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusFailed, WorkerSlotReleased: true}, 0},
+		{"coordinator", &repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processRepo, _ := setupProcessRepos()
+			processRepo.AddProcess(tt.proc)
+			capacity := &countingSlotReleaser{}
+			h := handler.NewRetireProcessHandler(processRepo, process.NewProcessRegistry(),
+				handler.WithRetireWorkerCapacity(capacity))
+
+			for range 2 {
+				result, err := h.Handle(context.Background(),
+					command.NewRetireProcessCommand(command.SourceMCPTool, tt.proc.ID, ""))
+				require.NoError(t, err)
+				require.True(t, result.Success)
+			}
+
+			assert.Equal(t, tt.wantReleased, capacity.released)
+		})
+	}
+}
+
 func TestRetireProcessHandler_CallsCleanupProcessWhenRetiring(t *testing.T) {
 	processRepo, _ := setupProcessRepos()
 	registry := process.NewProcessRegistry()
@@ -2610,6 +3130,39 @@ func TestSpawnProcessHandler_PassesAgentTypeToSpawner(t *testing.T) {
 	assert.Equal(t, roles.AgentTypeImplementer, spawner.spawnCalls[0].AgentType)
 }
 
+func TestSpawnProcessHandler_RecordsProvider(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	live := process.NewDormant("worker-1", repository.RoleWorker, "", nil, nil)
+	live.Provider = client.ClientCursor
+	spawner := &mockProcessSpawnerWithProcess{returnProcess: live}
+
+	h := handler.NewSpawnProcessHandler(processRepo, process.NewProcessRegistry(), handler.WithUnifiedSpawner(spawner))
+	cmd := command.NewSpawnProcessCommand(command.SourceMCPTool, repository.RoleWorker)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	proc, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, client.ClientCursor, proc.Provider, "later turns resume on the provider the worker was spawned with")
+}
+
+func TestSpawnProcessHandler_UsesClock(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	processRepo, _ := setupProcessRepos()
+
+	h := handler.NewSpawnProcessHandler(processRepo, nil,
+		handler.WithUnifiedSpawner(&mockProcessSpawner{}),
+		handler.WithSpawnProcessClock(clock))
+	result, err := h.Handle(context.Background(), command.NewSpawnProcessCommand(command.SourceMCPTool, repository.RoleWorker))
+	require.NoError(t, err)
+
+	proc, err := processRepo.Get(result.Data.(*handler.SpawnProcessResult).ProcessID)
+	require.NoError(t, err)
+	require.Equal(t, clock.Now(), proc.CreatedAt)
+	require.Equal(t, clock.Now(), proc.LastActivityAt)
+}
+
 func TestSpawnProcessHandler_PassesDefaultAgentTypeToSpawner(t *testing.T) {
 	processRepo, _ := setupProcessRepos()
 	spawner := &mockProcessSpawner{}
@@ -2689,6 +3242,30 @@ func TestReplaceProcessHandler_ReplaceCoordinator_CreatesHandoffPrompt(t *testin
 	assert.Equal(t, repository.RoleCoordinator, replaceResult.Role)
 }
 
+func TestReplaceProcessHandler_ReplaceCoordinator_KeepsCoordinatorState(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:                 repository.CoordinatorID,
+		Role:               repository.RoleCoordinator,
+		Status:             repository.StatusReady,
+		GlobalInstructions: []string{"Run make lint before reporting complete"},
+		Focus:              "Finish the auth epic first",
+		HeldTasks:          map[string]string{"perles-abc.1": "waiting on design review"},
+	})
+
+	h := handler.NewReplaceProcessHandler(processRepo, process.NewProcessRegistry())
+	_, err := h.Handle(context.Background(), command.NewReplaceProcessCommand(command.SourceMCPTool, repository.CoordinatorID, "context window full"))
+	require.NoError(t, err)
+
+	coord, err := processRepo.Get(repository.CoordinatorID)
+	require.NoError(t, err)
+	require.Equal(t, repository.StatusReady, coord.Status)
+	require.Equal(t, []string{"Run make lint before reporting complete"}, coord.GlobalInstructions)
+	require.Equal(t, "Finish the auth epic first", coord.Focus)
+	require.Equal(t, map[string]string{"perles-abc.1": "waiting on design review"}, coord.HeldTasks,
+		"held tasks must stay held after a context refresh")
+}
+
 func TestReplaceProcessHandler_ReplaceWorker_RetiresAndSpawnsNew(t *testing.T) {
 	processRepo, _ := setupProcessRepos()
 	registry := process.NewProcessRegistry()
@@ -2761,6 +3338,186 @@ func TestReplaceProcessHandler_EmitsRetiredAndSpawnedEvents(t *testing.T) {
 	assert.Equal(t, events.ProcessSpawned, spawnedEvent.Type)
 }
 
+// setupReassignableWorker stores worker-1 implementing perles-abc1 with a progress summary.
+func setupReassignableWorker(t *testing.T) (*repository.MemoryProcessRepository, *repository.MemoryQueueRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+	processRepo, queueRepo := setupProcessRepos()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseAddressingFeedback),
+		TaskID: "perles-abc1",
+	})
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:            "perles-abc1",
+		Implementer:       "worker-1",
+		Status:            repository.TaskImplementing,
+		ThreadID:          "thread-42",
+		CompletionSummary: "Parser done; lexer tests still failing",
+	}))
+	return processRepo, queueRepo, taskRepo
+}
+
+func TestReplaceProcessHandler_ReplaceWorker_ReassignSeedsContinuityPrompt(t *testing.T) {
+	processRepo, queueRepo, taskRepo := setupReassignableWorker(t)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetStatus().Return([]domain.FileStatus{
+		{Path: "parser.go", Staged: true},
+		{Path: "lexer_test.go", Unstaged: true},
+	}, nil).Once()
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceTaskReassignment(taskRepo, queueRepo),
+		handler.WithReplaceGitExecutor(gitExec))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "stuck")
+	cmd.Reassign = true
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	replaceResult := result.Data.(*handler.ReplaceProcessResult)
+	require.Equal(t, "perles-abc1", replaceResult.TaskID)
+	newID := replaceResult.NewProcessID
+
+	// Task and phase move to the replacement; the retired worker's task is cleared
+	task, err := taskRepo.Get("perles-abc1")
+	require.NoError(t, err)
+	require.Equal(t, newID, task.Implementer)
+	require.Equal(t, "worker-1", task.TransferredFrom)
+	require.Equal(t, []string{"parser.go", "lexer_test.go"}, task.HandoffFiles)
+
+	newProc, err := processRepo.Get(newID)
+	require.NoError(t, err)
+	require.Equal(t, "perles-abc1", newProc.TaskID)
+	require.Equal(t, events.ProcessPhaseAddressingFeedback, *newProc.Phase)
+
+	oldProc, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, repository.StatusRetired, oldProc.Status)
+	require.Empty(t, oldProc.TaskID)
+
+	// The replacement is sent the prior progress summary and changed files
+	entry, ok := queueRepo.GetOrCreate(newID).Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "[TASK CONTINUATION]")
+	require.Contains(t, entry.Content, "Parser done; lexer tests still failing")
+	require.Contains(t, entry.Content, "- parser.go")
+	require.Contains(t, entry.Content, "- lexer_test.go")
+	require.Contains(t, entry.Content, "thread-42")
+
+	require.Len(t, result.FollowUp, 1)
+	require.Equal(t, command.CmdDeliverProcessQueued, result.FollowUp[0].Type())
+}
+
+func TestReplaceProcessHandler_ReplaceWorker_ReassignPointsToCheckpoint(t *testing.T) {
+	processRepo, queueRepo, taskRepo := setupReassignableWorker(t)
+	task, err := taskRepo.Get("perles-abc1")
+	require.NoError(t, err)
+	task.Checkpoints = []string{"abc123", "def456"}
+	require.NoError(t, taskRepo.Save(task))
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceTaskReassignment(taskRepo, queueRepo))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "")
+	cmd.Reassign = true
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	entry, ok := queueRepo.GetOrCreate(result.Data.(*handler.ReplaceProcessResult).NewProcessID).Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "2 [WIP] checkpoint snapshot(s); the latest is `def456`")
+}
+
+func TestReplaceProcessHandler_ReplaceWorker_ReassignFallsBackToTransferNote(t *testing.T) {
+	processRepo, queueRepo, taskRepo := setupReassignableWorker(t)
+	task, err := taskRepo.Get("perles-abc1")
+	require.NoError(t, err)
+	task.CompletionSummary = ""
+	task.TransferNote = "Handed over with the migration half written"
+	require.NoError(t, taskRepo.Save(task))
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceTaskReassignment(taskRepo, queueRepo))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "")
+	cmd.Reassign = true
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	entry, ok := queueRepo.GetOrCreate(result.Data.(*handler.ReplaceProcessResult).NewProcessID).Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "Handed over with the migration half written")
+	require.NotContains(t, entry.Content, "Files Already Changed")
+}
+
+func TestReplaceProcessHandler_ReplaceWorker_WithoutReassignLeavesTask(t *testing.T) {
+	processRepo, queueRepo, taskRepo := setupReassignableWorker(t)
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceTaskReassignment(taskRepo, queueRepo))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "")
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	replaceResult := result.Data.(*handler.ReplaceProcessResult)
+	require.Empty(t, replaceResult.TaskID)
+	require.Empty(t, result.FollowUp)
+
+	task, err := taskRepo.Get("perles-abc1")
+	require.NoError(t, err)
+	require.Equal(t, "worker-1", task.Implementer)
+	require.Zero(t, queueRepo.GetOrCreate(replaceResult.NewProcessID).Size())
+}
+
+func TestReplaceProcessHandler_ReplaceWorker_ReassignRequiresRepositories(t *testing.T) {
+	processRepo, _, _ := setupReassignableWorker(t)
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil)
+
+	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "")
+	cmd.Reassign = true
+	_, err := h.Handle(context.Background(), cmd)
+	require.ErrorContains(t, err, "task reassignment not configured")
+
+	oldProc, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, repository.StatusWorking, oldProc.Status, "worker is not retired when reassignment fails")
+}
+
+func TestReplaceProcessHandler_ReplaceWorker_UsesRegisteredContinuityOverride(t *testing.T) {
+	original := roles.Registry[roles.AgentTypeImplementer]
+	t.Cleanup(func() { roles.Registry[roles.AgentTypeImplementer] = original })
+
+	override := original
+	override.ReplacementContinuityPrompt = func(taskID, previousWorkerID, phase, threadID, summary string, changedFiles []string) string {
+		return fmt.Sprintf("continue %s from %s: %s", taskID, previousWorkerID, summary)
+	}
+	roles.Registry[roles.AgentTypeImplementer] = override
+
+	processRepo, queueRepo, taskRepo := setupReassignableWorker(t)
+	worker, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	worker.AgentType = roles.AgentTypeImplementer
+	require.NoError(t, processRepo.Save(worker))
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceTaskReassignment(taskRepo, queueRepo))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "")
+	cmd.Reassign = true
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	entry, ok := queueRepo.GetOrCreate(result.Data.(*handler.ReplaceProcessResult).NewProcessID).Dequeue()
+	require.True(t, ok)
+	require.Equal(t, "continue perles-abc1 from worker-1: Parser done; lexer tests still failing", entry.Content)
+}
+
 func TestReplaceProcessHandler_ReplaceCoordinator_PassesReplacePrompt(t *testing.T) {
 	processRepo, _ := setupProcessRepos()
 	spawner := &mockProcessSpawner{}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+// ProgressReporter receives intermediate progress from long-running handlers, so the
+// work can be followed while it runs instead of only when the tool call returns.
+type ProgressReporter interface {
+	// ReportProgress records one progress update.
+	ReportProgress(content string)
+}
+
+// ProgressReporterFunc adapts a function to the ProgressReporter interface.
+type ProgressReporterFunc func(content string)
+
+// ReportProgress calls f(content).
+func (f ProgressReporterFunc) ReportProgress(content string) {
+	f(content)
+}
+
+// reportProgress sends content to reporter, ignoring a nil reporter.
+func reportProgress(reporter ProgressReporter, content string) {
+	if reporter != nil {
+		reporter.ReportProgress(content)
+	}
+}
+
+// EventBusProgressReporter publishes progress updates on the v2 event bus as coordinator
+// output events, which the TUI shows in the coordinator pane.
+type EventBusProgressReporter struct {
+	eventBus *pubsub.Broker[any]
+	clock    types.Clock
+}
+
+// NewEventBusProgressReporter creates a ProgressReporter that publishes to eventBus,
+// stamping each update with clock. A nil clock uses the real clock.
+func NewEventBusProgressReporter(eventBus *pubsub.Broker[any], clock types.Clock) *EventBusProgressReporter {
+	if clock == nil {
+		clock = types.RealClock{}
+	}
+	return &EventBusProgressReporter{eventBus: eventBus, clock: clock}
+}
+
+// ReportProgress publishes content as coordinator output.
+func (r *EventBusProgressReporter) ReportProgress(content string) {
+	event := events.NewProcessEvent(events.ProcessOutput, repository.CoordinatorID, events.RoleCoordinator).
+		WithOutput(content)
+	event.Timestamp = r.clock.Now()
+	r.eventBus.Publish(pubsub.UpdatedEvent, event)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+func TestEventBusProgressReporter_PublishesCoordinatorOutput(t *testing.T) {
+	eventBus := pubsub.NewBroker[any]()
+	defer eventBus.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := eventBus.Subscribe(ctx)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	NewEventBusProgressReporter(eventBus, types.NewFakeClock(now)).ReportProgress("[1/2] Assigned perles-abc1.1 to worker-1")
+
+	select {
+	case ev := <-sub:
+		processEvent, ok := ev.Payload.(events.ProcessEvent)
+		require.True(t, ok, "expected events.ProcessEvent, got %T", ev.Payload)
+		require.Equal(t, events.ProcessOutput, processEvent.Type)
+		require.Equal(t, repository.CoordinatorID, processEvent.ProcessID)
+		require.Equal(t, events.RoleCoordinator, processEvent.Role)
+		require.Equal(t, "[1/2] Assigned perles-abc1.1 to worker-1", processEvent.Output)
+		require.Equal(t, now, processEvent.Timestamp)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for progress event")
+	}
+}
+
+func TestReportProgress_NilReporterIsNoop(t *testing.T) {
+	require.NotPanics(t, func() { reportProgress(nil, "ignored") })
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+)
+
+// DefaultRateLimitCooldown is how long a rate-limited provider is deprioritized for worker spawns.
+const DefaultRateLimitCooldown = 5 * time.Minute
+
+// providerCooldowns tracks when each provider's rate-limit cooldown window ends.
+// It is safe for concurrent use.
+type providerCooldowns struct {
+	mu    sync.Mutex
+	until map[client.ClientType]time.Time
+	now   func() time.Time
+}
+
+// newProviderCooldowns creates an empty cooldown tracker using the wall clock.
+func newProviderCooldowns() *providerCooldowns {
+	return &providerCooldowns{
+		until: make(map[client.ClientType]time.Time),
+		now:   time.Now,
+	}
+}
+
+// markRateLimited starts a cooldown window of length d for the provider.
+func (c *providerCooldowns) markRateLimited(provider client.ClientType, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.until[provider] = c.now().Add(d)
+}
+
+// coolingDown reports whether the provider is still inside its cooldown window.
+func (c *providerCooldowns) coolingDown(provider client.ClientType) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	until, ok := c.until[provider]
+	if !ok {
+		return false
+	}
+	if !c.now().Before(until) {
+		delete(c.until, provider)
+		return false
+	}
+	return true
+}
+
+// WorkerProviderRotator moves workers off a provider whose rate limit was hit mid-turn.
+type WorkerProviderRotator interface {
+	// RotateFrom starts a cooldown window for the provider and reports whether another
+	// worker provider is available for a replacement to be spawned on.
+	RotateFrom(provider client.ClientType) bool
+}
+
+// RotateFrom implements WorkerProviderRotator. An empty provider is taken to be the
+// primary worker client, which is what workers spawned before rotation existed ran on.
+func (s *UnifiedProcessSpawnerImpl) RotateFrom(provider client.ClientType) bool {
+	if s.cooldowns == nil || s.workerClient == nil {
+		return false
+	}
+	if provider == "" {
+		provider = s.workerClient.Type()
+	}
+	s.cooldowns.markRateLimited(provider, s.rateLimitCooldown)
+	for _, c := range s.workerCandidates() {
+		if c.client.Type() != provider && !s.cooldowns.coolingDown(c.client.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// workerCandidate is a client a worker can be spawned with, along with its extensions.
+type workerCandidate struct {
+	client     client.HeadlessClient
+	extensions map[string]any
+}
+
+// workerCandidates returns the worker clients in the order they should be tried.
+// Providers outside a cooldown window come first, in configured order (primary, then
+// alternate); providers still cooling down follow, since their limit may have reset early.
+func (s *UnifiedProcessSpawnerImpl) workerCandidates() []workerCandidate {
+	configured := []workerCandidate{{client: s.workerClient, extensions: s.workerExtensions}}
+	if s.workerAlternateClient != nil && s.workerAlternateClient != s.workerClient {
+		configured = append(configured, workerCandidate{client: s.workerAlternateClient, extensions: s.workerAltExtensions})
+	}
+	if s.cooldowns == nil || len(configured) == 1 {
+		return configured
+	}
+
+	ready := make([]workerCandidate, 0, len(configured))
+	cooling := make([]workerCandidate, 0, len(configured))
+	for _, c := range configured {
+		if s.cooldowns.coolingDown(c.client.Type()) {
+			cooling = append(cooling, c)
+		} else {
+			ready = append(ready, c)
+		}
+	}
+	return append(ready, cooling...)
+}
+
+// spawnWorker spawns a worker process, rotating to the next candidate provider only when
+// a spawn fails because of a rate limit. Any other error is returned immediately.
+// Each rate-limited provider starts a cooldown window so later spawns go to the
+// alternate first until the primary's limit resets.
+// The returned client type is the provider the worker was spawned on.
+func (s *UnifiedProcessSpawnerImpl) spawnWorker(ctx context.Context, id string, cfg client.Config) (client.HeadlessProcess, client.ClientType, error) {
+	var lastErr error
+	for _, candidate := range s.workerCandidates() {
+		candidateCfg := cfg
+		if candidate.client != s.workerClient {
+			mcpConfig, err := s.generateWorkerMCPConfigFor(candidate.client, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to generate MCP config for %s: %w", candidate.client.Type(), err)
+			}
+			candidateCfg.MCPConfig = mcpConfig
+			candidateCfg.Extensions = candidate.extensions
+		}
+
+		proc, err := candidate.client.Spawn(ctx, candidateCfg)
+		if err == nil {
+			return proc, candidate.client.Type(), nil
+		}
+		if !client.IsRateLimitError(err) {
+			return nil, "", err
+		}
+
+		lastErr = err
+		if s.cooldowns != nil {
+			s.cooldowns.markRateLimited(candidate.client.Type(), s.rateLimitCooldown)
+		}
+		log.Warn(log.CatOrch, "Worker provider rate limited, rotating to next provider",
+			"processID", id, "provider", candidate.client.Type(), "cooldown", s.rateLimitCooldown, "error", err)
+	}
+	return nil, "", fmt.Errorf("all worker providers rate limited: %w", lastErr)
+}
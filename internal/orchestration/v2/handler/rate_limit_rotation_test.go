@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/mock"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+// rateLimitedSpawn fails every spawn with a wrapped rate-limit error and counts calls.
+func rateLimitedSpawn(calls *int) func(context.Context, client.Config) (client.HeadlessProcess, error) {
+	return func(_ context.Context, _ client.Config) (client.HeadlessProcess, error) {
+		*calls++
+		return nil, fmt.Errorf("claude exited: %w", client.ErrRateLimited)
+	}
+}
+
+// countingSpawn succeeds and records the config of each spawn.
+func countingSpawn(configs *[]client.Config) func(context.Context, client.Config) (client.HeadlessProcess, error) {
+	return func(_ context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		*configs = append(*configs, cfg)
+		return mock.NewProcess(), nil
+	}
+}
+
+// newRotationSpawner creates a spawner with a primary mock client and an OpenCode alternate.
+func newRotationSpawner(primary *mock.Client, alternate *openCodeMockClient) *UnifiedProcessSpawnerImpl {
+	return NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient:         primary,
+		WorkerClient:              primary,
+		WorkerAlternateClient:     alternate,
+		WorkerExtensions:          map[string]any{"provider": "primary"},
+		WorkerAlternateExtensions: map[string]any{"provider": "alternate"},
+		WorkDir:                   "/test/workdir",
+		Port:                      8080,
+		Submitter:                 &mockCommandSubmitter{},
+		EventBus:                  pubsub.NewBroker[any](),
+		RateLimitCooldown:         time.Minute,
+	})
+}
+
+func TestSpawnWorker_RateLimitedPrimaryRotatesToAlternate(t *testing.T) {
+	primaryCalls := 0
+	primary := mock.NewClient()
+	primary.SpawnFunc = rateLimitedSpawn(&primaryCalls)
+
+	var altConfigs []client.Config
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	alternate.SpawnFunc = countingSpawn(&altConfigs)
+
+	spawner := newRotationSpawner(primary, alternate)
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	defer proc.Stop()
+
+	require.Equal(t, 1, primaryCalls)
+	require.Len(t, altConfigs, 1)
+	require.Equal(t, "alternate", altConfigs[0].Extensions["provider"], "alternate uses its own extensions")
+	require.Contains(t, altConfigs[0].MCPConfig, `"mcp"`, "alternate uses its own MCP config format")
+	require.Equal(t, alternate.Type(), proc.Provider, "the process records the provider it was spawned on")
+	require.True(t, spawner.cooldowns.coolingDown(primary.Type()))
+	require.False(t, spawner.cooldowns.coolingDown(alternate.Type()))
+}
+
+func TestSpawnWorker_CoolingPrimaryIsSkippedUntilCooldownEnds(t *testing.T) {
+	primaryCalls := 0
+	primary := mock.NewClient()
+	primary.SpawnFunc = rateLimitedSpawn(&primaryCalls)
+
+	var altConfigs []client.Config
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	alternate.SpawnFunc = countingSpawn(&altConfigs)
+
+	spawner := newRotationSpawner(primary, alternate)
+	now := time.Now()
+	spawner.cooldowns.now = func() time.Time { return now }
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	proc.Stop()
+	require.Equal(t, 1, primaryCalls)
+
+	// Within the cooldown window the alternate is tried first
+	proc, err = spawner.SpawnProcess(context.Background(), "worker-2", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	proc.Stop()
+	require.Equal(t, 1, primaryCalls, "cooling primary should not be tried first")
+	require.Len(t, altConfigs, 2)
+
+	// Once the limit resets the primary is used again
+	var primaryConfigs []client.Config
+	primary.SpawnFunc = countingSpawn(&primaryConfigs)
+	now = now.Add(time.Minute)
+
+	proc, err = spawner.SpawnProcess(context.Background(), "worker-3", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	proc.Stop()
+	require.Len(t, primaryConfigs, 1)
+	require.Equal(t, "primary", primaryConfigs[0].Extensions["provider"])
+	require.Len(t, altConfigs, 2)
+}
+
+func TestSpawnWorker_NonRateLimitErrorDoesNotRotate(t *testing.T) {
+	spawnErr := errors.New("executable not found")
+	primary := mock.NewClient()
+	primary.SpawnFunc = func(_ context.Context, _ client.Config) (client.HeadlessProcess, error) {
+		return nil, spawnErr
+	}
+
+	var altConfigs []client.Config
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	alternate.SpawnFunc = countingSpawn(&altConfigs)
+
+	spawner := newRotationSpawner(primary, alternate)
+
+	_, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.ErrorIs(t, err, spawnErr)
+	require.Empty(t, altConfigs, "only rate-limit errors rotate providers")
+	require.False(t, spawner.cooldowns.coolingDown(primary.Type()))
+}
+
+func TestSpawnWorker_AllProvidersRateLimited(t *testing.T) {
+	primaryCalls, altCalls := 0, 0
+	primary := mock.NewClient()
+	primary.SpawnFunc = rateLimitedSpawn(&primaryCalls)
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	alternate.SpawnFunc = rateLimitedSpawn(&altCalls)
+
+	spawner := newRotationSpawner(primary, alternate)
+
+	_, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.ErrorIs(t, err, client.ErrRateLimited)
+	require.Contains(t, err.Error(), "all worker providers rate limited")
+	require.Equal(t, 1, primaryCalls)
+	require.Equal(t, 1, altCalls)
+}
+
+func TestSpawnWorker_CoordinatorDoesNotRotate(t *testing.T) {
+	primaryCalls := 0
+	primary := mock.NewClient()
+	primary.SpawnFunc = rateLimitedSpawn(&primaryCalls)
+
+	var altConfigs []client.Config
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	alternate.SpawnFunc = countingSpawn(&altConfigs)
+
+	spawner := newRotationSpawner(primary, alternate)
+
+	_, err := spawner.SpawnProcess(context.Background(), "coordinator", repository.RoleCoordinator, SpawnOptions{})
+	require.ErrorIs(t, err, client.ErrRateLimited)
+	require.Empty(t, altConfigs, "alternate provider applies to workers only")
+}
+
+// chanSubmitter forwards commands submitted by a process's event loop to a channel.
+type chanSubmitter chan command.Command
+
+func (c chanSubmitter) Submit(cmd command.Command) { c <- cmd }
+
+func TestRotateFrom_ReportsWhetherAnotherProviderIsAvailable(t *testing.T) {
+	primary := mock.NewClient()
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	spawner := newRotationSpawner(primary, alternate)
+
+	require.True(t, spawner.RotateFrom(""), "an unrecorded provider is the primary")
+	require.True(t, spawner.cooldowns.coolingDown(primary.Type()))
+
+	require.False(t, spawner.RotateFrom(alternate.Type()), "both providers are cooling down")
+	require.True(t, spawner.cooldowns.coolingDown(alternate.Type()))
+
+	single := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: primary,
+		WorkerClient:      primary,
+		Submitter:         &mockCommandSubmitter{},
+		EventBus:          pubsub.NewBroker[any](),
+	})
+	require.False(t, single.RotateFrom(primary.Type()), "there is nothing to rotate to")
+}
+
+func TestRateLimitEventMidTurn_ReplacesWorkerOnAlternateProvider(t *testing.T) {
+	limited := mock.NewProcess()
+	primary := mock.NewClient()
+	primary.SpawnFunc = func(_ context.Context, _ client.Config) (client.HeadlessProcess, error) {
+		return limited, nil
+	}
+	var altConfigs []client.Config
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	alternate.SpawnFunc = countingSpawn(&altConfigs)
+
+	submitted := make(chanSubmitter, 4)
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient:     primary,
+		WorkerClient:          primary,
+		WorkerAlternateClient: alternate,
+		WorkDir:               "/test/workdir",
+		Port:                  8080,
+		Submitter:             submitted,
+		EventBus:              pubsub.NewBroker[any](),
+		RateLimitCooldown:     time.Minute,
+	})
+
+	live, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	defer live.Stop()
+	require.Equal(t, primary.Type(), live.Provider)
+
+	processRepo := repository.NewMemoryProcessRepository()
+	queueRepo := repository.NewMemoryQueueRepository(100)
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady,
+	}))
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		TaskID: "perles-abc.1", Provider: live.Provider,
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc.1", Implementer: "worker-1", Status: repository.TaskImplementing,
+	}))
+
+	// The provider reports its rate limit partway through the turn
+	limited.SendEvent(client.OutputEvent{
+		Type:  client.EventError,
+		Error: &client.ErrorInfo{Message: "429 Too Many Requests", Reason: client.ErrReasonRateLimited},
+	})
+	limited.Complete()
+
+	var turnCmd *command.ProcessTurnCompleteCommand
+	select {
+	case cmd := <-submitted:
+		turnCmd = cmd.(*command.ProcessTurnCompleteCommand)
+	case <-time.After(time.Second):
+		t.Fatal("turn never completed")
+	}
+
+	turnHandler := NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		WithProcessTurnProviderRotator(spawner))
+	result, err := turnHandler.Handle(context.Background(), turnCmd)
+	require.NoError(t, err)
+	require.Len(t, result.FollowUp, 1)
+	replaceCmd, ok := result.FollowUp[0].(*command.ReplaceProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, "worker-1", replaceCmd.ProcessID)
+	require.True(t, replaceCmd.Reassign)
+	require.True(t, spawner.cooldowns.coolingDown(primary.Type()))
+
+	replaceHandler := NewReplaceProcessHandler(processRepo, nil,
+		WithReplaceSpawner(spawner),
+		WithReplaceTaskReassignment(taskRepo, queueRepo))
+	_, err = replaceHandler.Handle(context.Background(), replaceCmd)
+	require.NoError(t, err)
+
+	require.Len(t, altConfigs, 1, "the replacement is spawned on the alternate provider")
+	replacement, err := processRepo.Get("worker-2")
+	require.NoError(t, err)
+	require.Equal(t, alternate.Type(), replacement.Provider)
+	require.Equal(t, "perles-abc.1", replacement.TaskID)
+
+	task, err := taskRepo.Get("perles-abc.1")
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", task.Implementer)
+}
+
+func TestProviderCooldowns_WindowExpires(t *testing.T) {
+	c := newProviderCooldowns()
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	require.False(t, c.coolingDown(client.ClientClaude))
+
+	c.markRateLimited(client.ClientClaude, 30*time.Second)
+	require.True(t, c.coolingDown(client.ClientClaude))
+	require.False(t, c.coolingDown(client.ClientCodex), "cooldowns are tracked per provider")
+
+	now = now.Add(30 * time.Second)
+	require.False(t, c.coolingDown(client.ClientClaude))
+}
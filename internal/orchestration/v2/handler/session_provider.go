@@ -19,6 +19,18 @@ type ProcessRegistrySessionProvider struct {
 	observerClient    client.HeadlessClient
 	workDir           string
 	port              int
+	processRepo       repository.ProcessRepository
+}
+
+// SessionProviderOption configures a ProcessRegistrySessionProvider.
+type SessionProviderOption func(*ProcessRegistrySessionProvider)
+
+// WithSessionProcessRepository generates each worker's MCP config in the format of the
+// provider recorded on its Process, instead of always the default worker client's.
+func WithSessionProcessRepository(processRepo repository.ProcessRepository) SessionProviderOption {
+	return func(p *ProcessRegistrySessionProvider) {
+		p.processRepo = processRepo
+	}
 }
 
 // NewProcessRegistrySessionProvider creates a new ProcessRegistrySessionProvider.
@@ -37,12 +49,13 @@ func NewProcessRegistrySessionProvider(
 	observerClient client.HeadlessClient,
 	workDir string,
 	port int,
+	opts ...SessionProviderOption,
 ) *ProcessRegistrySessionProvider {
 	// Fall back to worker client if observer client not provided
 	if observerClient == nil {
 		observerClient = workerClient
 	}
-	return &ProcessRegistrySessionProvider{
+	p := &ProcessRegistrySessionProvider{
 		registry:          registry,
 		coordinatorClient: coordinatorClient,
 		workerClient:      workerClient,
@@ -50,6 +63,10 @@ func NewProcessRegistrySessionProvider(
 		workDir:           workDir,
 		port:              port,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // GetProcessSessionID returns the session ID for a process (coordinator or worker) from the registry.
@@ -103,12 +120,23 @@ func (p *ProcessRegistrySessionProvider) generateCoordinatorMCPConfig() (string,
 	}
 }
 
-// generateWorkerMCPConfig generates the worker-specific MCP config.
-func (p *ProcessRegistrySessionProvider) generateWorkerMCPConfig(workerID string) (string, error) {
+// workerProvider returns the provider the worker was spawned with, falling back to
+// the default worker client's type. Empty if neither is known.
+func (p *ProcessRegistrySessionProvider) workerProvider(workerID string) client.ClientType {
+	if p.processRepo != nil {
+		if proc, err := p.processRepo.Get(workerID); err == nil && proc.Provider != "" {
+			return proc.Provider
+		}
+	}
 	if p.workerClient == nil {
-		return mcp.GenerateWorkerConfigHTTP(p.port, workerID)
+		return ""
 	}
-	switch p.workerClient.Type() {
+	return p.workerClient.Type()
+}
+
+// generateWorkerMCPConfig generates the worker-specific MCP config.
+func (p *ProcessRegistrySessionProvider) generateWorkerMCPConfig(workerID string) (string, error) {
+	switch p.workerProvider(workerID) {
 	case client.ClientAmp:
 		return mcp.GenerateWorkerConfigAmp(p.port, workerID)
 	case client.ClientCodex:
@@ -81,6 +81,24 @@ func TestProcessRegistrySessionProvider_GenerateProcessMCPConfig_Worker_Amp(t *t
 	require.NotContains(t, config, "mcpServers")
 }
 
+func TestProcessRegistrySessionProvider_GenerateProcessMCPConfig_Worker_RecordedProvider(t *testing.T) {
+	registry := process.NewProcessRegistry()
+	aiClient := &mockHeadlessClient{clientType: client.ClientClaude}
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Provider: client.ClientAmp})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker})
+	provider := NewProcessRegistrySessionProvider(registry, aiClient, aiClient, aiClient, "/work/dir", 8765,
+		WithSessionProcessRepository(processRepo))
+
+	config, err := provider.GenerateProcessMCPConfig("worker-1")
+	require.NoError(t, err)
+	require.NotContains(t, config, "mcpServers", "a worker rotated to Amp gets Amp's config format")
+
+	config, err = provider.GenerateProcessMCPConfig("worker-2")
+	require.NoError(t, err)
+	require.Contains(t, config, "mcpServers", "no recorded provider falls back to the worker client")
+}
+
 func TestProcessRegistrySessionProvider_GenerateProcessMCPConfig_Coordinator_HTTP(t *testing.T) {
 	registry := process.NewProcessRegistry()
 	aiClient := &mockHeadlessClient{clientType: client.ClientClaude}
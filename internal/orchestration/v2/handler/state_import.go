@@ -0,0 +1,134 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the handler for restoring coordinator state exported by export_state.
+package handler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ImportStateResult contains the number of assignments restored by import_state.
+type ImportStateResult struct {
+	Workers int
+	Tasks   int
+}
+
+// GetImportedCounts returns the number of worker assignments and tasks restored.
+func (r *ImportStateResult) GetImportedCounts() (workers, tasks int) {
+	return r.Workers, r.Tasks
+}
+
+// ===========================================================================
+// ImportStateHandler
+// ===========================================================================
+
+// ImportStateHandler handles CmdImportState commands.
+// It validates the imported assignments against the live worker pool and, only if every
+// referenced worker is active, restores the task assignments, worker phases and the
+// coordinator's global instructions and held tasks. Workers and tasks not in the imported state are
+// left unchanged.
+type ImportStateHandler struct {
+	processRepo repository.ProcessRepository
+	taskRepo    repository.TaskRepository
+}
+
+// NewImportStateHandler creates a new ImportStateHandler.
+func NewImportStateHandler(
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+) *ImportStateHandler {
+	return &ImportStateHandler{
+		processRepo: processRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+// Handle processes an ImportStateCommand.
+// Nothing is written if any worker assignment, or the implementer or reviewer of any
+// unfinished task, references a worker that is not active in the pool. Completed and
+// failed tasks may reference retired workers since they are kept only as history.
+func (h *ImportStateHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	importCmd := cmd.(*command.ImportStateCommand)
+
+	pool := make(map[string]bool)
+	for _, proc := range h.processRepo.ActiveWorkers() {
+		pool[proc.ID] = true
+	}
+
+	missing := make(map[string]bool)
+	for _, wa := range importCmd.WorkerAssignments {
+		if !pool[wa.WorkerID] {
+			missing[wa.WorkerID] = true
+		}
+	}
+	for _, task := range importCmd.Tasks {
+		if task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed {
+			continue
+		}
+		for _, workerID := range []string{task.Implementer, task.Reviewer} {
+			if workerID != "" && !pool[workerID] {
+				missing[workerID] = true
+			}
+		}
+	}
+	if len(missing) > 0 {
+		ids := make([]string, 0, len(missing))
+		for id := range missing {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return nil, fmt.Errorf("%w: %s", types.ErrWorkerNotInPool, strings.Join(ids, ", "))
+	}
+
+	// Instructions and holds are only restored onto a coordinator; empty ones just clear them
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		if len(importCmd.GlobalInstructions) > 0 || len(importCmd.HeldTasks) > 0 {
+			return nil, fmt.Errorf("failed to get coordinator: %w", err)
+		}
+		coord = nil
+	}
+
+	for _, task := range importCmd.Tasks {
+		if err := h.taskRepo.Save(task); err != nil {
+			return nil, fmt.Errorf("failed to save task %s: %w", task.TaskID, err)
+		}
+	}
+
+	for _, wa := range importCmd.WorkerAssignments {
+		proc, err := h.processRepo.Get(wa.WorkerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worker %s: %w", wa.WorkerID, err)
+		}
+		phase := wa.Phase
+		proc.Phase = &phase
+		proc.TaskID = wa.TaskID
+		if err := h.processRepo.Save(proc); err != nil {
+			return nil, fmt.Errorf("failed to save worker %s: %w", wa.WorkerID, err)
+		}
+	}
+
+	if coord != nil && (importCmd.GlobalInstructions != nil || importCmd.HeldTasks != nil) {
+		if importCmd.GlobalInstructions != nil {
+			coord.GlobalInstructions = importCmd.GlobalInstructions
+		}
+		if importCmd.HeldTasks != nil {
+			coord.HeldTasks = maps.Clone(importCmd.HeldTasks)
+		}
+		if err := h.processRepo.Save(coord); err != nil {
+			return nil, fmt.Errorf("failed to save coordinator: %w", err)
+		}
+	}
+
+	return SuccessResult(&ImportStateResult{
+		Workers: len(importCmd.WorkerAssignments),
+		Tasks:   len(importCmd.Tasks),
+	}), nil
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ===========================================================================
+// Import State Handler Tests
+// ===========================================================================
+
+// newImportStatePool returns a process repository with the coordinator and the given ready workers.
+func newImportStatePool(workerIDs ...string) *repository.MemoryProcessRepository {
+	processRepo := newGlobalInstructionRepo()
+	for _, id := range workerIDs {
+		processRepo.AddProcess(&repository.Process{
+			ID:     id,
+			Role:   repository.RoleWorker,
+			Status: repository.StatusReady,
+			Phase:  phasePtr(events.ProcessPhaseIdle),
+		})
+	}
+	return processRepo
+}
+
+func TestImportStateHandler_RestoresAssignments(t *testing.T) {
+	processRepo := newImportStatePool("worker-1", "worker-2")
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	cmd := command.NewImportStateCommand(command.SourceMCPTool,
+		[]command.WorkerAssignmentState{
+			{WorkerID: "worker-1", TaskID: "perles-abc.1", Phase: events.ProcessPhaseAwaitingReview},
+			{WorkerID: "worker-2", TaskID: "perles-abc.1", Phase: events.ProcessPhaseReviewing},
+		},
+		[]*repository.TaskAssignment{
+			{TaskID: "perles-abc.1", Status: repository.TaskInReview, Implementer: "worker-1", Reviewer: "worker-2", ReviewRounds: 1, StartedAt: time.Now()},
+			// Finished tasks keep retired workers as history
+			{TaskID: "perles-abc.2", Status: repository.TaskCompleted, Implementer: "worker-9"},
+		},
+		[]string{"The DB migration is frozen"},
+		map[string]string{"perles-abc.3": "waiting on design"})
+
+	result, err := NewImportStateHandler(processRepo, taskRepo).Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	workers, tasks := result.Data.(*ImportStateResult).GetImportedCounts()
+	require.Equal(t, 2, workers)
+	require.Equal(t, 2, tasks)
+
+	reviewer, _ := processRepo.Get("worker-2")
+	require.Equal(t, events.ProcessPhaseReviewing, *reviewer.Phase)
+	require.Equal(t, "perles-abc.1", reviewer.TaskID)
+	require.Equal(t, repository.StatusReady, reviewer.Status, "live status is kept")
+
+	task, err := taskRepo.Get("perles-abc.1")
+	require.NoError(t, err)
+	require.Equal(t, 1, task.ReviewRounds)
+
+	coord, _ := processRepo.GetCoordinator()
+	require.Equal(t, []string{"The DB migration is frozen"}, coord.GlobalInstructions)
+	require.Equal(t, map[string]string{"perles-abc.3": "waiting on design"}, coord.HeldTasks)
+}
+
+func TestImportStateHandler_RejectsMissingWorkers(t *testing.T) {
+	processRepo := newImportStatePool("worker-1")
+	processRepo.AddProcess(&repository.Process{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusRetired})
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	cmd := command.NewImportStateCommand(command.SourceMCPTool,
+		[]command.WorkerAssignmentState{
+			{WorkerID: "worker-1", TaskID: "perles-abc.1", Phase: events.ProcessPhaseImplementing},
+			{WorkerID: "worker-3", TaskID: "perles-abc.2", Phase: events.ProcessPhaseImplementing},
+		},
+		[]*repository.TaskAssignment{
+			{TaskID: "perles-abc.1", Status: repository.TaskInReview, Implementer: "worker-1", Reviewer: "worker-2"},
+			{TaskID: "perles-abc.2", Status: repository.TaskImplementing, Implementer: "worker-3"},
+		},
+		nil, nil)
+
+	_, err := NewImportStateHandler(processRepo, taskRepo).Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrWorkerNotInPool)
+	require.ErrorContains(t, err, "worker-2, worker-3")
+	require.Empty(t, taskRepo.All(), "nothing is restored when validation fails")
+	worker, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseIdle, *worker.Phase)
+	require.Empty(t, worker.TaskID)
+}
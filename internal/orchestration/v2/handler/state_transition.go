@@ -1,5 +1,6 @@
 // Package handler provides command handlers for the v2 orchestration architecture.
-// This file contains handlers for state transition commands: ReportComplete, ReportVerdict, TransitionPhase.
+// This file contains handlers for state transition commands: ReportComplete, ReportVerdict,
+// RequestRetirement, TransitionPhase.
 // These are high-risk handlers that manage critical state machine transitions.
 // These handlers use the unified ProcessRepository for process state management.
 package handler
@@ -8,7 +9,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"slices"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -17,9 +20,12 @@ import (
 	"go.opentelemetry.io/otel/trace/noop"
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/sound"
@@ -31,6 +37,8 @@ import (
 
 // ValidTransitions defines the allowed state machine transitions.
 // Map key is the "from" phase, value is a slice of valid "to" phases.
+// Workflows that do not require review move Implementing -> Committing, but only via
+// ReportCompleteHandler; that shortcut is deliberately not a valid manual transition.
 var ValidTransitions = map[events.ProcessPhase][]events.ProcessPhase{
 	events.ProcessPhaseIdle:               {events.ProcessPhaseImplementing, events.ProcessPhaseReviewing},
 	events.ProcessPhaseImplementing:       {events.ProcessPhaseAwaitingReview, events.ProcessPhaseIdle}, // idle on cancel/error
@@ -55,7 +63,8 @@ func IsValidTransition(from, to events.ProcessPhase) bool {
 
 // ReportCompleteHandler handles CmdReportComplete commands.
 // It transitions a process from Implementing to AwaitingReview phase when they report
-// their implementation is complete. This handler maintains the callback-before-event
+// their implementation is complete, or straight to Committing when the workflow does
+// not require review. This handler maintains the callback-before-event
 // invariant by checking if the queue has pending messages and creating a DeliverQueued
 // follow-up command before the Ready event is emitted.
 type ReportCompleteHandler struct {
@@ -63,6 +72,11 @@ type ReportCompleteHandler struct {
 	taskRepo    repository.TaskRepository
 	queueRepo   repository.QueueRepository
 	bdExecutor  appbeads.IssueExecutor
+	skipReview  bool
+	// sensitivePaths are globs whose changes need review even when skipReview is set.
+	sensitivePaths []string
+	gitExecutor    appgit.GitExecutor
+	clock          types.Clock
 }
 
 // ReportCompleteHandlerOption configures ReportCompleteHandler.
@@ -76,6 +90,39 @@ func WithReportCompleteBDExecutor(executor appbeads.IssueExecutor) ReportComplet
 	}
 }
 
+// WithReportCompleteSkipReview sends completed implementations straight to committing
+// instead of awaiting review. Used for workflows whose require_review is false.
+func WithReportCompleteSkipReview(skip bool) ReportCompleteHandlerOption {
+	return func(h *ReportCompleteHandler) {
+		h.skipReview = skip
+	}
+}
+
+// WithReportCompleteSensitivePaths forces review, despite WithReportCompleteSkipReview,
+// when any changed file matches one of patterns. See MatchesSensitivePath for the syntax.
+func WithReportCompleteSensitivePaths(patterns []string) ReportCompleteHandlerOption {
+	return func(h *ReportCompleteHandler) {
+		h.sensitivePaths = patterns
+	}
+}
+
+// WithReportCompleteGitExecutor sets the git executor used to list the changed files
+// checked against the sensitive paths.
+func WithReportCompleteGitExecutor(executor appgit.GitExecutor) ReportCompleteHandlerOption {
+	return func(h *ReportCompleteHandler) {
+		h.gitExecutor = executor
+	}
+}
+
+// WithReportCompleteClock sets the clock used to stamp the committing phase and review start.
+func WithReportCompleteClock(clock types.Clock) ReportCompleteHandlerOption {
+	return func(h *ReportCompleteHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
 // NewReportCompleteHandler creates a new ReportCompleteHandler.
 // Panics if bdExecutor is not provided via WithReportCompleteBDExecutor option.
 func NewReportCompleteHandler(
@@ -88,6 +135,7 @@ func NewReportCompleteHandler(
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
+		clock:       types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -99,7 +147,7 @@ func NewReportCompleteHandler(
 }
 
 // Handle processes a ReportCompleteCommand.
-// Phase transition: Implementing -> AwaitingReview
+// Phase transition: Implementing -> AwaitingReview (or Committing when review is skipped)
 // Status transition: Working -> Ready (available for messaging)
 // CRITICAL: Preserves callback-before-event invariant by checking queue before Ready event.
 func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
@@ -142,13 +190,33 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 	}
 
 	// 3. Update process: Phase = PhaseAwaitingReview, Status = StatusReady
-	awaitingReview := events.ProcessPhaseAwaitingReview
-	proc.Phase = &awaitingReview
-	proc.Status = repository.StatusReady
-
 	// 4. Update task: Status = TaskInReview
-	task.Status = repository.TaskInReview
-	task.ReviewStartedAt = time.Now()
+	// Without review, both go straight to committing instead, unless the change
+	// touches a sensitive path.
+	skipReview := h.skipReview
+	var sensitive []string
+	if skipReview && len(h.sensitivePaths) > 0 {
+		sensitive = h.changedSensitivePaths(task)
+		skipReview = len(sensitive) == 0
+	}
+	prevTaskStatus := task.Status
+	prevSummary := task.CompletionSummary
+	if reportCmd.Summary != "" {
+		task.CompletionSummary = reportCmd.Summary
+	}
+	now := h.clock.Now()
+	nextPhase := events.ProcessPhaseAwaitingReview
+	if skipReview {
+		nextPhase = events.ProcessPhaseCommitting
+		task.Status = repository.TaskCommitting
+		task.EnterPhase(repository.TaskPhaseCommitting, now)
+	} else {
+		task.Status = repository.TaskInReview
+		task.ReviewStartedAt = now
+		task.EnterPhase(repository.TaskPhaseAwaitingReview, task.ReviewStartedAt)
+	}
+	proc.Phase = &nextPhase
+	proc.Status = repository.StatusReady
 
 	// 5. Save to repositories
 	if err := h.taskRepo.Save(task); err != nil {
@@ -157,8 +225,10 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 
 	if err := h.processRepo.Save(proc); err != nil {
 		// Revert task changes on failure
-		task.Status = repository.TaskImplementing
+		task.Status = prevTaskStatus
+		task.CompletionSummary = prevSummary
 		task.ReviewStartedAt = time.Time{}
+		task.RevertLastPhase()
 		_ = h.taskRepo.Save(task)
 		return nil, fmt.Errorf("failed to save process: %w", err)
 	}
@@ -167,6 +237,12 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 	// CRITICAL: This preserves the callback-before-event invariant
 	var followUps []command.Command
 	queue := h.queueRepo.GetOrCreate(reportCmd.WorkerID)
+	if skipReview {
+		// Nothing to wait for: tell the implementer to commit right away
+		if err := queue.Enqueue(prompt.CommitWithoutReviewPrompt(task.TaskID), repository.SenderCoordinator); err != nil {
+			return nil, fmt.Errorf("failed to queue commit prompt: %w", err)
+		}
+	}
 	if !queue.IsEmpty() {
 		deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, reportCmd.WorkerID)
 		if reportCmd.TraceID() != "" {
@@ -192,9 +268,12 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 	}
 
 	result := &ReportCompleteResult{
-		WorkerID: proc.ID,
-		TaskID:   task.TaskID,
-		Summary:  reportCmd.Summary,
+		WorkerID:       proc.ID,
+		TaskID:         task.TaskID,
+		Summary:        reportCmd.Summary,
+		ReviewSkipped:  skipReview,
+		SensitivePaths: sensitive,
+		Unaddressed:    task.UnaddressedFeedback(),
 	}
 
 	return SuccessWithEventsAndFollowUp(result, []any{event}, followUps), nil
@@ -205,6 +284,111 @@ type ReportCompleteResult struct {
 	WorkerID string
 	TaskID   string
 	Summary  string
+	// ReviewSkipped is true when the task went straight to committing.
+	ReviewSkipped bool
+	// SensitivePaths lists the changed files that forced review in a workflow that
+	// does not otherwise require it.
+	SensitivePaths []string
+	// Unaddressed lists review feedback items not marked addressed when completion was
+	// reported. Completion is not blocked; the implementer is warned instead.
+	Unaddressed []repository.FeedbackItem
+}
+
+// IsReviewSkipped reports whether the task went straight to committing.
+func (r *ReportCompleteResult) IsReviewSkipped() bool {
+	return r.ReviewSkipped
+}
+
+// UnaddressedFeedbackItems returns the text of each feedback item still to be addressed.
+func (r *ReportCompleteResult) UnaddressedFeedbackItems() []string {
+	return feedbackTexts(r.Unaddressed)
+}
+
+// ReviewForcedBy returns the changed files that forced review, if any.
+func (r *ReportCompleteResult) ReviewForcedBy() []string {
+	return r.SensitivePaths
+}
+
+// changedSensitivePaths returns the files task changed that match a sensitive path.
+// The worktree is shared by the workflow's workers, so changes are taken against the
+// task's BaseTree: edits made before the task was assigned are left out, though edits
+// other workers make while it is in progress cannot be told apart. A task without a
+// BaseTree falls back to every uncommitted change. When the changes cannot be listed,
+// review is forced rather than risk skipping it: the returned slice then holds the
+// patterns themselves.
+func (h *ReportCompleteHandler) changedSensitivePaths(task *repository.TaskAssignment) []string {
+	if h.gitExecutor == nil {
+		return h.sensitivePaths
+	}
+	changed, err := h.changedPaths(task)
+	if err != nil {
+		log.Debug(log.CatOrch, "Failed to list changed files, requiring review",
+			"taskID", task.TaskID, "error", err)
+		return h.sensitivePaths
+	}
+	var matched []string
+	for _, file := range changed {
+		for _, pattern := range h.sensitivePaths {
+			if MatchesSensitivePath(pattern, file) {
+				matched = append(matched, file)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// changedPaths returns the paths changed in the worktree since task's BaseTree, or every
+// uncommitted change when the task has none.
+func (h *ReportCompleteHandler) changedPaths(task *repository.TaskAssignment) ([]string, error) {
+	if task.BaseTree != "" {
+		current, err := h.gitExecutor.WorktreeTree()
+		if err != nil {
+			return nil, err
+		}
+		return h.gitExecutor.ChangedPaths(task.BaseTree, current)
+	}
+	changes, err := h.gitExecutor.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(changes))
+	for i, change := range changes {
+		paths[i] = change.Path
+	}
+	return paths, nil
+}
+
+// MatchesSensitivePath reports whether file, a slash-separated path relative to the
+// repository root, matches pattern. Patterns use path.Match syntax, with two additions:
+// a pattern ending in "/" or "/**" matches everything under that directory (e.g. "auth/"
+// or "services/*/billing/**"), and a pattern without a "/" also matches the file's base
+// name at any depth (e.g. "*.sql").
+func MatchesSensitivePath(pattern, file string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return false
+	}
+	if dir, ok := strings.CutSuffix(pattern, "/**"); ok || strings.HasSuffix(pattern, "/") {
+		if !ok {
+			dir = strings.TrimSuffix(pattern, "/")
+		}
+		depth := strings.Count(dir, "/") + 1
+		segments := strings.Split(file, "/")
+		if len(segments) <= depth {
+			return false
+		}
+		matched, _ := path.Match(dir, strings.Join(segments[:depth], "/"))
+		return matched
+	}
+	if matched, _ := path.Match(pattern, file); matched {
+		return true
+	}
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(file))
+		return matched
+	}
+	return false
 }
 
 // ===========================================================================
@@ -221,6 +405,7 @@ type ReportVerdictHandler struct {
 	bdExecutor   appbeads.IssueExecutor
 	tracer       trace.Tracer
 	soundService sound.SoundService
+	gitExecutor  appgit.GitExecutor
 }
 
 // ReportVerdictHandlerOption configures ReportVerdictHandler.
@@ -254,6 +439,14 @@ func WithReportVerdictSoundService(svc sound.SoundService) ReportVerdictHandlerO
 	}
 }
 
+// WithReportVerdictGitExecutor sets the git executor used to capture a diff
+// checkpoint at each verdict. If nil, no checkpoints are captured.
+func WithReportVerdictGitExecutor(executor appgit.GitExecutor) ReportVerdictHandlerOption {
+	return func(h *ReportVerdictHandler) {
+		h.gitExecutor = executor
+	}
+}
+
 // NewReportVerdictHandler creates a new ReportVerdictHandler.
 // Panics if bdExecutor is not provided via WithReportVerdictBDExecutor option.
 func NewReportVerdictHandler(
@@ -399,6 +592,10 @@ func (h *ReportVerdictHandler) handleVerdict(_ context.Context, verdictCmd *comm
 		resultEvents = append(resultEvents, implEvent)
 	}
 
+	// Capture the worktree diff so the next review round can see only what changed
+	task.ReviewRounds++
+	h.captureDiffCheckpoint(task)
+
 	// 5. Save task and reviewer
 	if err := h.taskRepo.Save(task); err != nil {
 		return nil, fmt.Errorf("failed to save task: %w", err)
@@ -464,6 +661,125 @@ type ReportVerdictResult struct {
 	ImplementerID string
 }
 
+// captureDiffCheckpoint records the current worktree diff on the task.
+// Failures are logged and ignored - a missing checkpoint only means the next
+// review sees the full diff.
+func (h *ReportVerdictHandler) captureDiffCheckpoint(task *repository.TaskAssignment) {
+	if h.gitExecutor == nil {
+		return
+	}
+	diff, err := h.gitExecutor.GetWorkingDirDiff()
+	if err != nil {
+		log.Debug(log.CatOrch, "Failed to capture review diff checkpoint",
+			"taskID", task.TaskID, "error", err)
+		return
+	}
+	round := 1
+	if task.DiffCheckpoint != nil {
+		round = task.DiffCheckpoint.Round + 1
+	}
+	task.DiffCheckpoint = repository.NewDiffCheckpoint(diff, round)
+}
+
+// ===========================================================================
+// RequestRetirementHandler
+// ===========================================================================
+
+// RequestRetirementHandler handles CmdRequestRetirement commands.
+// It marks a worker for retirement and notifies the coordinator. The worker keeps
+// running until its current turn completes; ProcessTurnCompleteHandler then replaces it.
+type RequestRetirementHandler struct {
+	processRepo repository.ProcessRepository
+	queueRepo   repository.QueueRepository
+}
+
+// NewRequestRetirementHandler creates a new RequestRetirementHandler.
+func NewRequestRetirementHandler(
+	processRepo repository.ProcessRepository,
+	queueRepo repository.QueueRepository,
+) *RequestRetirementHandler {
+	return &RequestRetirementHandler{
+		processRepo: processRepo,
+		queueRepo:   queueRepo,
+	}
+}
+
+// Handle processes a RequestRetirementCommand.
+// Repeated requests from the same worker are a no-op so the coordinator is notified once.
+func (h *RequestRetirementHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	retireCmd := cmd.(*command.RequestRetirementCommand)
+
+	// 1. Get process and validate it is an active worker
+	proc, err := h.processRepo.Get(retireCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+
+	if !proc.IsWorker() {
+		return nil, types.ErrProcessNotWorker
+	}
+	if proc.Status == repository.StatusRetired || proc.Status == repository.StatusRetiring {
+		return nil, types.ErrProcessRetired
+	}
+
+	result := &RequestRetirementResult{
+		WorkerID: proc.ID,
+		TaskID:   proc.TaskID,
+		Reason:   retireCmd.Reason,
+	}
+
+	if proc.RetirementReason != "" {
+		result.AlreadyRequested = true
+		return SuccessResult(result), nil
+	}
+
+	// 2. Mark the worker for retirement after its current turn
+	proc.RetirementReason = retireCmd.Reason
+	if err := h.processRepo.Save(proc); err != nil {
+		return nil, fmt.Errorf("failed to save process: %w", err)
+	}
+
+	// 3. Notify the coordinator so it can reassign the worker's task
+	coordinator, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coordinator: %w", err)
+	}
+
+	queue := h.queueRepo.GetOrCreate(coordinator.ID)
+	if err := queue.Enqueue(
+		prompt.BuildWorkerRetirementRequestedPrompt(proc.ID, proc.TaskID, retireCmd.Reason),
+		repository.SenderSystem,
+	); err != nil {
+		return nil, fmt.Errorf("failed to enqueue retirement request message: %w", err)
+	}
+
+	deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, coordinator.ID)
+	if retireCmd.TraceID() != "" {
+		deliverCmd.SetTraceID(retireCmd.TraceID())
+	}
+
+	log.Info(log.CatOrch, "Worker requested retirement",
+		"workerID", proc.ID, "taskID", proc.TaskID, "reason", retireCmd.Reason)
+
+	return SuccessWithFollowUp(result, deliverCmd), nil
+}
+
+// RequestRetirementResult contains the result of a worker retirement request.
+type RequestRetirementResult struct {
+	WorkerID         string
+	TaskID           string
+	Reason           string
+	AlreadyRequested bool // true if the worker had already requested retirement (no-op)
+}
+
+// WasAlreadyRequested returns true if the worker had already requested retirement.
+func (r *RequestRetirementResult) WasAlreadyRequested() bool {
+	return r.AlreadyRequested
+}
+
 // ===========================================================================
 // TransitionPhaseHandler
 // ===========================================================================
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
@@ -176,6 +177,268 @@ func TestReportCompleteHandler_TransitionsToAwaitingReview(t *testing.T) {
 	// Verify task was updated
 	updatedTask, _ := taskRepo.Get("perles-abc1.2")
 	require.Equal(t, repository.TaskInReview, updatedTask.Status)
+	require.Len(t, updatedTask.PhaseHistory, 1)
+	require.Equal(t, repository.TaskPhaseAwaitingReview, updatedTask.PhaseHistory[0].Phase)
+	require.Equal(t, "Implemented feature X", updatedTask.CompletionSummary, "summary is kept for the review prompt")
+}
+
+// newImplementingTask stores worker-1 implementing perles-abc1.2.
+func newImplementingTask(processRepo *repository.MemoryProcessRepository, taskRepo repository.TaskRepository) {
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseImplementing),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+	})
+}
+
+func TestReportCompleteHandler_SkipReviewTransitionsToCommitting(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	newImplementingTask(processRepo, taskRepo)
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
+		WithReportCompleteBDExecutor(bdExecutor), WithReportCompleteSkipReview(true))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.True(t, result.Data.(*ReportCompleteResult).ReviewSkipped)
+
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseCommitting, *updated.Phase)
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, repository.TaskCommitting, updatedTask.Status)
+	require.True(t, updatedTask.ReviewStartedAt.IsZero())
+	require.Len(t, updatedTask.PhaseHistory, 1)
+	require.Equal(t, repository.TaskPhaseCommitting, updatedTask.PhaseHistory[0].Phase)
+
+	// The commit prompt is queued and delivered without waiting on the coordinator
+	entry, ok := queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "does not require review")
+	require.Len(t, result.FollowUp, 1)
+	require.Equal(t, command.CmdDeliverProcessQueued, result.FollowUp[0].Type())
+
+	// A review can no longer be assigned for the task
+	_, err = NewAssignReviewHandler(processRepo, taskRepo, queueRepo).Handle(context.Background(),
+		command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "worker-1", command.ReviewTypeSimple))
+	require.Error(t, err)
+}
+
+func TestReportCompleteHandler_SensitivePathForcesReview(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	newImplementingTask(processRepo, taskRepo)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetStatus().Return([]domain.FileStatus{
+		{Path: "README.md", Unstaged: true},
+		{Path: "internal/auth/session.go", Unstaged: true},
+	}, nil).Once()
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
+		WithReportCompleteBDExecutor(bdExecutor),
+		WithReportCompleteSkipReview(true),
+		WithReportCompleteSensitivePaths([]string{"internal/auth/", "billing/**"}),
+		WithReportCompleteGitExecutor(gitExec))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.NoError(t, err)
+	reportResult := result.Data.(*ReportCompleteResult)
+	require.False(t, reportResult.ReviewSkipped)
+	require.Equal(t, []string{"internal/auth/session.go"}, reportResult.ReviewForcedBy())
+
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseAwaitingReview, *updated.Phase)
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, repository.TaskInReview, updatedTask.Status)
+	require.True(t, queueRepo.GetOrCreate("worker-1").IsEmpty(), "no commit prompt while review is pending")
+}
+
+func TestReportCompleteHandler_InnocuousChangeSkipsReview(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	newImplementingTask(processRepo, taskRepo)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetStatus().Return([]domain.FileStatus{
+		{Path: "docs/authoring.md", Unstaged: true},
+		{Path: "internal/ui/billing_view.go", Untracked: true},
+	}, nil).Once()
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
+		WithReportCompleteBDExecutor(bdExecutor),
+		WithReportCompleteSkipReview(true),
+		WithReportCompleteSensitivePaths([]string{"auth/", "billing/**"}),
+		WithReportCompleteGitExecutor(gitExec))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.NoError(t, err)
+	reportResult := result.Data.(*ReportCompleteResult)
+	require.True(t, reportResult.ReviewSkipped)
+	require.Empty(t, reportResult.ReviewForcedBy())
+
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, repository.TaskCommitting, updatedTask.Status)
+}
+
+func TestReportCompleteHandler_SensitivePathsCheckOnlyChangesSinceBaseTree(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	newImplementingTask(processRepo, taskRepo)
+	task, _ := taskRepo.Get("perles-abc1.2")
+	task.BaseTree = "base-tree"
+	require.NoError(t, taskRepo.Save(task))
+
+	// Another worker's edit under auth/ predates the task, so only the task's own change is checked
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().WorktreeTree().Return("current-tree", nil).Once()
+	gitExec.EXPECT().ChangedPaths("base-tree", "current-tree").Return([]string{"docs/authoring.md"}, nil).Once()
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
+		WithReportCompleteBDExecutor(bdExecutor),
+		WithReportCompleteSkipReview(true),
+		WithReportCompleteSensitivePaths([]string{"auth/"}),
+		WithReportCompleteGitExecutor(gitExec))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.NoError(t, err)
+	require.True(t, result.Data.(*ReportCompleteResult).ReviewSkipped)
+}
+
+func TestReportCompleteHandler_UnreadableStatusForcesReview(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	newImplementingTask(processRepo, taskRepo)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetStatus().Return(nil, errors.New("not a git repository")).Once()
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
+		WithReportCompleteBDExecutor(bdExecutor),
+		WithReportCompleteSkipReview(true),
+		WithReportCompleteSensitivePaths([]string{"auth/"}),
+		WithReportCompleteGitExecutor(gitExec))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.NoError(t, err)
+	require.False(t, result.Data.(*ReportCompleteResult).ReviewSkipped)
+}
+
+func TestMatchesSensitivePath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		file    string
+		want    bool
+	}{
+		{"auth/", "auth/login.go", true},
+		{"auth/", "auth/oauth/token.go", true},
+		{"auth/", "internal/auth/login.go", false},
+		{"auth/", "authz/login.go", false},
+		{"billing/**", "billing/invoice.go", true},
+		{"services/*/billing/", "services/api/billing/charge.go", true},
+		{"services/*/billing/", "services/api/charge.go", false},
+		{"*.sql", "db/migrations/001_init.sql", true},
+		{"db/*.sql", "db/schema.sql", true},
+		{"db/*.sql", "db/migrations/001_init.sql", false},
+		{"go.mod", "go.mod", true},
+		{"", "go.mod", false},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, MatchesSensitivePath(tt.pattern, tt.file), "%q vs %q", tt.pattern, tt.file)
+	}
+}
+
+func TestReportCompleteHandler_WarnsAboutUnaddressedFeedback(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	newImplementingTask(processRepo, taskRepo)
+	task, _ := taskRepo.Get("perles-abc1.2")
+	task.FeedbackItems = repository.NewFeedbackItems([]string{"Handle the nil case", "Add a regression test"})
+	task.FeedbackItems[0].Addressed = true
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo, WithReportCompleteBDExecutor(bdExecutor))
+	result, err := handler.Handle(context.Background(),
+		command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""))
+
+	// Completion still goes through; the unaddressed item is reported
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	reportResult := result.Data.(*ReportCompleteResult)
+	require.Equal(t, []string{"2. Add a regression test"}, reportResult.UnaddressedFeedbackItems())
+
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, repository.TaskInReview, updatedTask.Status)
+}
+
+func TestReportCompleteHandler_ReviewRequiredNeedsVerdictBeforeCommit(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", mock.Anything, mock.Anything).Return(nil)
+	newImplementingTask(processRepo, taskRepo)
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseReviewing),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+
+	result, err := NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
+		WithReportCompleteBDExecutor(bdExecutor)).Handle(context.Background(),
+		command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""))
+	require.NoError(t, err)
+	require.False(t, result.Data.(*ReportCompleteResult).ReviewSkipped)
+
+	approveCommit := NewApproveCommitHandler(processRepo, taskRepo, queueRepo)
+	_, err = approveCommit.Handle(context.Background(),
+		command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2"))
+	require.ErrorIs(t, err, types.ErrTaskNotApproved, "commit must wait for a verdict")
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	task.Reviewer = "worker-2"
+	require.NoError(t, taskRepo.Save(task))
+	_, err = NewReportVerdictHandler(processRepo, taskRepo, queueRepo,
+		WithReportVerdictBDExecutor(bdExecutor)).Handle(context.Background(),
+		command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictApproved, "LGTM"))
+	require.NoError(t, err)
+
+	_, err = approveCommit.Handle(context.Background(),
+		command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2"))
+	require.NoError(t, err)
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseCommitting, *updated.Phase)
 }
 
 func TestReportCompleteHandler_FailsIfNotImplementingPhase(t *testing.T) {
@@ -493,6 +756,7 @@ func TestReportVerdictHandler_ApprovedTransitionsCorrectly(t *testing.T) {
 	// Verify task was approved
 	updatedTask, _ := taskRepo.Get("perles-abc1.2")
 	require.Equal(t, repository.TaskApproved, updatedTask.Status)
+	require.Equal(t, 1, updatedTask.ReviewRounds, "verdict should count a review round")
 
 	// Verify reviewer went idle
 	updatedReviewer, _ := processRepo.Get("worker-2")
@@ -660,6 +924,123 @@ func TestReportVerdictHandler_EmitsEventsForBothWorkers(t *testing.T) {
 	require.True(t, foundReviewer, "expected reviewer event")
 }
 
+// ===========================================================================
+// RequestRetirementHandler Tests
+// ===========================================================================
+
+func setupRequestRetirement(t *testing.T) (*repository.MemoryProcessRepository, *repository.MemoryQueueRepository) {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0) // 0 = unlimited
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusReady,
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseImplementing),
+		TaskID: "perles-abc1.2",
+	})
+
+	return processRepo, queueRepo
+}
+
+func TestRequestRetirementHandler_MarksWorkerAndNotifiesCoordinator(t *testing.T) {
+	processRepo, queueRepo := setupRequestRetirement(t)
+	handler := NewRequestRetirementHandler(processRepo, queueRepo)
+
+	cmd := command.NewRequestRetirementCommand(command.SourceMCPTool, "worker-1", "context is corrupted")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Equal(t, &RequestRetirementResult{
+		WorkerID: "worker-1",
+		TaskID:   "perles-abc1.2",
+		Reason:   "context is corrupted",
+	}, result.Data)
+
+	// Worker keeps running its turn but is marked for retirement
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, "context is corrupted", updated.RetirementReason)
+	require.Equal(t, repository.StatusWorking, updated.Status)
+
+	// Coordinator is told to reassign the task
+	queue := queueRepo.GetOrCreate(repository.CoordinatorID)
+	require.Equal(t, 1, queue.Size())
+	entry, _ := queue.Dequeue()
+	require.Contains(t, entry.Content, "[WORKER RETIREMENT REQUESTED]")
+	require.Contains(t, entry.Content, "perles-abc1.2")
+
+	require.Len(t, result.FollowUp, 1)
+	followUp, ok := result.FollowUp[0].(*command.DeliverProcessQueuedCommand)
+	require.True(t, ok, "expected DeliverProcessQueuedCommand, got: %T", result.FollowUp[0])
+	require.Equal(t, repository.CoordinatorID, followUp.ProcessID)
+}
+
+func TestRequestRetirementHandler_RepeatedRequestIsNoOp(t *testing.T) {
+	processRepo, queueRepo := setupRequestRetirement(t)
+	handler := NewRequestRetirementHandler(processRepo, queueRepo)
+
+	_, err := handler.Handle(context.Background(),
+		command.NewRequestRetirementCommand(command.SourceMCPTool, "worker-1", "context is corrupted"))
+	require.NoError(t, err)
+
+	result, err := handler.Handle(context.Background(),
+		command.NewRequestRetirementCommand(command.SourceMCPTool, "worker-1", "still corrupted"))
+
+	require.NoError(t, err)
+	require.True(t, result.Data.(*RequestRetirementResult).AlreadyRequested)
+	require.Empty(t, result.FollowUp)
+
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, "context is corrupted", updated.RetirementReason, "first reason is kept")
+	require.Equal(t, 1, queueRepo.GetOrCreate(repository.CoordinatorID).Size(), "coordinator notified once")
+}
+
+func TestRequestRetirementHandler_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		process *repository.Process
+		wantErr error
+	}{
+		{
+			name:    "unknown worker",
+			wantErr: ErrProcessNotFound,
+		},
+		{
+			name:    "coordinator",
+			process: &repository.Process{ID: "worker-1", Role: repository.RoleCoordinator, Status: repository.StatusWorking},
+			wantErr: types.ErrProcessNotWorker,
+		},
+		{
+			name:    "retired worker",
+			process: &repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusRetired},
+			wantErr: types.ErrProcessRetired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processRepo := repository.NewMemoryProcessRepository()
+			queueRepo := repository.NewMemoryQueueRepository(0)
+			if tt.process != nil {
+				processRepo.AddProcess(tt.process)
+			}
+			handler := NewRequestRetirementHandler(processRepo, queueRepo)
+
+			_, err := handler.Handle(context.Background(),
+				command.NewRequestRetirementCommand(command.SourceMCPTool, "worker-1", "context is corrupted"))
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 // ===========================================================================
 // TransitionPhaseHandler Tests
 // ===========================================================================
@@ -2324,3 +2705,99 @@ func TestIntegration_ApproveCommitFailsWhenTaskDenied(t *testing.T) {
 	t.Logf("approve_commit correctly failed: %v", err)
 	require.ErrorIs(t, err, types.ErrTaskNotApproved)
 }
+
+// setupVerdictWithGit seeds an implementer, reviewer, and in-review task for verdict tests.
+func setupVerdictWithGit(t *testing.T, checkpoint *repository.DiffCheckpoint) (*repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseAwaitingReview),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseReviewing),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:         "perles-abc1.2",
+		Implementer:    "worker-1",
+		Reviewer:       "worker-2",
+		Status:         repository.TaskInReview,
+		StartedAt:      time.Now(),
+		DiffCheckpoint: checkpoint,
+	})
+	return processRepo, taskRepo
+}
+
+func TestReportVerdictHandler_CapturesDiffCheckpoint(t *testing.T) {
+	processRepo, taskRepo := setupVerdictWithGit(t, nil)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return("diff --git a/x.go b/x.go\n+v1\n", nil).Once()
+
+	handler := NewReportVerdictHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0),
+		WithReportVerdictBDExecutor(bdExecutor),
+		WithReportVerdictGitExecutor(gitExec))
+
+	cmd := command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictDenied, "Needs tests")
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.NotNil(t, task.DiffCheckpoint)
+	require.Equal(t, 1, task.DiffCheckpoint.Round)
+	require.Equal(t, "diff --git a/x.go b/x.go\n+v1\n", task.DiffCheckpoint.Diff)
+	require.Equal(t, repository.HashDiff(task.DiffCheckpoint.Diff), task.DiffCheckpoint.Hash)
+}
+
+func TestReportVerdictHandler_IncrementsDiffCheckpointRound(t *testing.T) {
+	processRepo, taskRepo := setupVerdictWithGit(t, repository.NewDiffCheckpoint("old", 1))
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return("new", nil).Once()
+
+	handler := NewReportVerdictHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0),
+		WithReportVerdictBDExecutor(bdExecutor),
+		WithReportVerdictGitExecutor(gitExec))
+
+	cmd := command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictApproved, "LGTM")
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, 2, task.DiffCheckpoint.Round)
+	require.Equal(t, "new", task.DiffCheckpoint.Diff)
+}
+
+func TestReportVerdictHandler_GitErrorDoesNotFailVerdict(t *testing.T) {
+	previous := repository.NewDiffCheckpoint("old", 1)
+	processRepo, taskRepo := setupVerdictWithGit(t, previous)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetWorkingDirDiff().Return("", errors.New("not a git repository")).Once()
+
+	handler := NewReportVerdictHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0),
+		WithReportVerdictBDExecutor(bdExecutor),
+		WithReportVerdictGitExecutor(gitExec))
+
+	cmd := command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictApproved, "LGTM")
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Same(t, previous, task.DiffCheckpoint, "checkpoint unchanged on git error")
+}
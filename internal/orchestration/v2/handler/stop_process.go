@@ -10,9 +10,11 @@ import (
 
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/osproc"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
 
 // GracefulStopTimeout is the maximum time to wait for graceful termination
@@ -35,6 +37,7 @@ type StopWorkerHandler struct {
 	queueRepo          repository.QueueRepository
 	registry           *process.ProcessRegistry
 	fabricUnsubscriber FabricUnsubscriber
+	clock              types.Clock
 }
 
 // StopWorkerHandlerOption configures StopWorkerHandler.
@@ -48,6 +51,15 @@ func WithFabricUnsubscriber(unsubscriber FabricUnsubscriber) StopWorkerHandlerOp
 	}
 }
 
+// WithStopWorkerClock sets the clock used to stamp the phase a stopped worker's task returns to.
+func WithStopWorkerClock(clock types.Clock) StopWorkerHandlerOption {
+	return func(h *StopWorkerHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
 // NewStopWorkerHandler creates a new StopWorkerHandler.
 func NewStopWorkerHandler(
 	processRepo repository.ProcessRepository,
@@ -61,6 +73,7 @@ func NewStopWorkerHandler(
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
 		registry:    registry,
+		clock:       types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -152,7 +165,7 @@ func (h *StopWorkerHandler) forceStop(proc *repository.Process, liveProcess *pro
 	pid := liveProcess.PID()
 	if pid > 0 {
 		// Force kill the process (platform-specific implementation)
-		_ = killProcess(pid)
+		_ = osproc.Kill(pid)
 	}
 
 	return h.finishStop(proc, liveProcess, false)
@@ -196,11 +209,13 @@ func (h *StopWorkerHandler) updateRepositoryAndCleanupWithResult(proc *repositor
 				// Clear implementer since the worker is being stopped
 				task.Implementer = ""
 				task.Status = repository.TaskImplementing
+				task.EnterPhase(repository.TaskPhaseImplementing, h.clock.Now())
 				_ = h.taskRepo.Save(task)
 			} else if task.Reviewer == proc.ID {
 				// Clear reviewer since the worker is being stopped
 				task.Reviewer = ""
 				// Keep task in review status, waiting for new reviewer
+				task.EnterPhase(repository.TaskPhaseAwaitingReview, h.clock.Now())
 				_ = h.taskRepo.Save(task)
 			}
 		}
@@ -1,5 +1,7 @@
 // Package handler provides command handlers for the v2 orchestration architecture.
-// This file contains handlers for task assignment commands: AssignTask, AssignReview, and ApproveCommit.
+// This file contains handlers for task assignment commands: AssignTask, AssignTasksBatch,
+// GetEligibleWorkers, AssignReview, ApproveCommit, AssignReviewFeedback, TransferTask,
+// DrainWorker, ReportTestResults, MarkFeedbackAddressed and Checkpoint.
 // These handlers use the unified ProcessRepository for process state management.
 package handler
 
@@ -7,6 +9,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -16,10 +20,14 @@ import (
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
@@ -37,6 +45,12 @@ type AssignTaskHandler struct {
 	queueRepo   repository.QueueRepository
 	bdExecutor  appbeads.IssueExecutor
 	tracer      trace.Tracer
+	promptLimit prompt.PromptLimit
+	selector    WorkerSelector
+	providers   *WorkerProviders
+	gitExecutor appgit.GitExecutor
+	toolHints   []prompt.ToolHint
+	clock       types.Clock
 }
 
 // AssignTaskHandlerOption configures AssignTaskHandler.
@@ -67,6 +81,55 @@ func WithAssignTaskTracer(tracer trace.Tracer) AssignTaskHandlerOption {
 	}
 }
 
+// WithAssignTaskPromptLimit bounds the size of the task assignment prompt.
+// A zero-value limit (the default) leaves the prompt unbounded.
+func WithAssignTaskPromptLimit(limit prompt.PromptLimit) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		h.promptLimit = limit
+	}
+}
+
+// WithAssignTaskToolReminder appends a reminder of the worker's tools to every task
+// assignment prompt. When tools is empty (the default), no reminder is added.
+func WithAssignTaskToolReminder(tools []prompt.ToolHint) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		h.toolHints = tools
+	}
+}
+
+// WithAssignTaskWorkerSelector sets the policy used to pick a worker when the command
+// does not name one. Defaults to OldestReadyFirst.
+func WithAssignTaskWorkerSelector(selector WorkerSelector) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		h.selector = selector
+	}
+}
+
+// WithAssignTaskWorkerProviders resolves each worker's provider when checking it can take
+// a task, so the workflow's worker_providers policy decides which workers implement.
+func WithAssignTaskWorkerProviders(providers WorkerProviders) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		h.providers = &providers
+	}
+}
+
+// WithAssignTaskGitExecutor records the worktree state on each task as it is assigned, so
+// later checks can tell the task's changes from those made before it started.
+func WithAssignTaskGitExecutor(gitExecutor appgit.GitExecutor) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		h.gitExecutor = gitExecutor
+	}
+}
+
+// WithAssignTaskClock sets the clock used for assignment timestamps and ready grace checks.
+func WithAssignTaskClock(clock types.Clock) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
 // NewAssignTaskHandler creates a new AssignTaskHandler.
 // Panics if bdExecutor or queueRepo is not provided.
 func NewAssignTaskHandler(
@@ -78,6 +141,8 @@ func NewAssignTaskHandler(
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		tracer:      noop.NewTracerProvider().Tracer("noop"),
+		selector:    OldestReadyFirst,
+		clock:       types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -93,11 +158,27 @@ func NewAssignTaskHandler(
 
 // Handle processes an AssignTaskCommand.
 // It validates the process state, creates a task assignment, and updates both repositories.
+// If the command names no worker, one is chosen from the ready workers by the handler's selector.
 // Phase transition: Idle -> Implementing
 // Status transition: Ready -> Working
 func (h *AssignTaskHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	assignCmd := cmd.(*command.AssignTaskCommand)
 
+	// Resolve the worker up front so the span and result record the one chosen
+	if assignCmd.WorkerID == "" {
+		var candidates []*repository.Process
+		for _, p := range readyCandidates(h.processRepo, h.clock.Now()) {
+			if checkImplementerPolicy(p, h.providers) == nil {
+				candidates = append(candidates, p)
+			}
+		}
+		proc, err := selectFrom(candidates, h.selector)
+		if err != nil {
+			return nil, err
+		}
+		assignCmd.WorkerID = proc.ID
+	}
+
 	// Create child span for handler-specific tracing
 	var span trace.Span
 	ctx, span = h.tracer.Start(ctx, tracing.SpanPrefixHandler+"assign_task",
@@ -131,45 +212,10 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 		)
 	}
 
-	// 1. Get process from repository
-	proc, err := h.processRepo.Get(assignCmd.WorkerID)
-	if err != nil {
-		if errors.Is(err, repository.ErrProcessNotFound) {
-			return nil, ErrProcessNotFound
-		}
-		return nil, fmt.Errorf("failed to get process: %w", err)
-	}
-
-	// 2. Validate process.Status == StatusReady
-	if proc.Status != repository.StatusReady {
-		return nil, types.ErrProcessNotReady
-	}
-
-	// 3. Validate process.Phase == PhaseIdle (nil or Idle)
-	if proc.Phase != nil && *proc.Phase != events.ProcessPhaseIdle {
-		return nil, types.ErrProcessNotIdle
-	}
-
-	// 4. Validate no existing task assigned to process
-	if proc.TaskID != "" {
-		return nil, types.ErrProcessAlreadyAssigned
-	}
-
-	issue, err := h.bdExecutor.ShowIssue(assignCmd.TaskID)
+	// 1-4. Validate the process can take the task and the bd issue exists
+	proc, issue, err := h.validateTaskAssignment(assignCmd.WorkerID, assignCmd.TaskID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get bd issue: %w. did you mean to use send_to_worker", err)
-	}
-	if issue == nil {
-		return nil, fmt.Errorf("bd issue not found: %s. did you mean to use send_to_worker", proc.TaskID)
-	}
-
-	// Also check task repo for any task where this process is implementer
-	existingTasks, err := h.taskRepo.GetByImplementer(assignCmd.WorkerID)
-	if err != nil && !errors.Is(err, repository.ErrTaskNotFound) {
-		return nil, fmt.Errorf("failed to check existing tasks: %w", err)
-	}
-	if len(existingTasks) > 0 {
-		return nil, types.ErrProcessAlreadyAssigned
+		return nil, err
 	}
 
 	// Record task validated event
@@ -179,13 +225,35 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 		)
 	}
 
+	// Build the prompt before mutating state so an oversized prompt rejects the assignment cleanly.
+	// The focus and global instructions are resolved now, so later changes do not affect this assignment.
+	focus, global := h.standingInstructions()
+	instructions := prompt.CoordinatorInstructions(focus, global, assignCmd.Summary)
+	taskPrompt, err := prompt.BuildTaskAssignmentPrompt(assignCmd.TaskID, assignCmd.TaskID, instructions, assignCmd.ThreadID, h.promptLimit,
+		prompt.WithToolReminder(h.toolHints))
+	if err != nil {
+		return nil, err
+	}
+
 	// 5. Create TaskAssignment with Implementer = workerID
+	now := h.clock.Now()
 	task := &repository.TaskAssignment{
-		TaskID:      assignCmd.TaskID,
-		Implementer: assignCmd.WorkerID,
-		Status:      repository.TaskImplementing,
-		StartedAt:   time.Now(),
-		ThreadID:    assignCmd.ThreadID,
+		TaskID:          assignCmd.TaskID,
+		Implementer:     assignCmd.WorkerID,
+		Status:          repository.TaskImplementing,
+		StartedAt:       now,
+		EstimateMinutes: taskEstimate(issue, assignCmd.EstimateMinutes),
+		ThreadID:        assignCmd.ThreadID,
+		Instructions:    instructions,
+	}
+	task.EnterPhase(repository.TaskPhaseImplementing, now)
+	if h.gitExecutor != nil {
+		if tree, err := h.gitExecutor.WorktreeTree(); err != nil {
+			log.Debug(log.CatOrch, "Failed to record task base tree",
+				"taskID", task.TaskID, "error", err)
+		} else {
+			task.BaseTree = tree
+		}
 	}
 
 	// 6. Update process: Phase = PhaseImplementing, TaskID = taskID
@@ -223,7 +291,6 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 
 	// 9. Queue TaskAssignmentPrompt to the worker
 	// The worker will receive instructions to work on the task (from coordinator)
-	taskPrompt := prompt.TaskAssignmentPrompt(assignCmd.TaskID, assignCmd.TaskID, assignCmd.Summary, assignCmd.ThreadID)
 	queue := h.queueRepo.GetOrCreate(assignCmd.WorkerID)
 	if err := queue.Enqueue(taskPrompt, repository.SenderCoordinator); err != nil {
 		return nil, fmt.Errorf("failed to queue task prompt: %w", err)
@@ -251,6 +318,117 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 	return SuccessWithEventsAndFollowUp(result, []any{event}, []command.Command{deliverCmd}), nil
 }
 
+// standingInstructions returns the coordinator's focus area and global instructions,
+// or nothing if there is no coordinator.
+func (h *AssignTaskHandler) standingInstructions() (string, []string) {
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return "", nil
+	}
+	return coord.Focus, coord.GlobalInstructions
+}
+
+// checkNotHeld returns ErrTaskHeld if the coordinator has put taskID on hold.
+func (h *AssignTaskHandler) checkNotHeld(taskID string) error {
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil
+	}
+	reason, held := coord.HeldTasks[taskID]
+	if !held {
+		return nil
+	}
+	if reason != "" {
+		return fmt.Errorf("%w: %s (%s); release_task it before assigning", types.ErrTaskHeld, taskID, reason)
+	}
+	return fmt.Errorf("%w: %s; release_task it before assigning", types.ErrTaskHeld, taskID)
+}
+
+// validateTaskAssignment checks that workerID can be assigned taskID and returns the process
+// and the bd issue. The task must not be on hold, the process must be Ready and Idle with no
+// existing task, and the bd issue must exist.
+func (h *AssignTaskHandler) validateTaskAssignment(workerID, taskID string) (*repository.Process, *beads.Issue, error) {
+	if err := h.checkNotHeld(taskID); err != nil {
+		return nil, nil, err
+	}
+
+	// 1. Get process from repository
+	proc, err := h.processRepo.Get(workerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, nil, ErrProcessNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get process: %w", err)
+	}
+
+	// 2-4. Validate the process is free to take a task
+	if err := h.checkWorkerAssignable(proc); err != nil {
+		return nil, nil, err
+	}
+
+	issue, err := h.showTask(taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proc, issue, nil
+}
+
+// checkWorkerAssignable returns an error if proc cannot take a new task: it must be Ready
+// and Idle, past its post-ready grace period, not implementing any task, and allowed to
+// implement by the workflow's role and provider policy.
+func (h *AssignTaskHandler) checkWorkerAssignable(proc *repository.Process) error {
+	// Validate process.Status == StatusReady
+	if proc.Status != repository.StatusReady {
+		return types.ErrProcessNotReady
+	}
+
+	// Validate process.Phase == PhaseIdle (nil or Idle)
+	if proc.Phase != nil && *proc.Phase != events.ProcessPhaseIdle {
+		return types.ErrProcessNotIdle
+	}
+
+	// Validate no existing task assigned to process
+	if proc.TaskID != "" {
+		return types.ErrProcessAlreadyAssigned
+	}
+
+	if err := checkReadyGrace(proc, h.clock.Now()); err != nil {
+		return err
+	}
+
+	// Also check task repo for any task where this process is implementer
+	existingTasks, err := h.taskRepo.GetByImplementer(proc.ID)
+	if err != nil && !errors.Is(err, repository.ErrTaskNotFound) {
+		return fmt.Errorf("failed to check existing tasks: %w", err)
+	}
+	if len(existingTasks) > 0 {
+		return types.ErrProcessAlreadyAssigned
+	}
+	return checkImplementerPolicy(proc, h.providers)
+}
+
+// showTask returns the bd issue for taskID, or an error if it does not exist.
+func (h *AssignTaskHandler) showTask(taskID string) (*beads.Issue, error) {
+	issue, err := h.bdExecutor.ShowIssue(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bd issue: %w. did you mean to use send_to_worker", err)
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("bd issue not found: %s. did you mean to use send_to_worker", taskID)
+	}
+	return issue, nil
+}
+
+// taskEstimate returns the effort estimate for a task in minutes. An estimate recorded
+// on the bd issue takes precedence over the one given with the assignment.
+func taskEstimate(issue *beads.Issue, fallbackMinutes int) int {
+	if issue != nil && issue.EstimatedMinutes > 0 {
+		return issue.EstimatedMinutes
+	}
+	return fallbackMinutes
+}
+
 // AssignTaskResult contains the result of assigning a task to a worker.
 type AssignTaskResult struct {
 	WorkerID string
@@ -258,6 +436,224 @@ type AssignTaskResult struct {
 	Summary  string
 }
 
+// GetProcessID returns the ID of the worker the task was assigned to.
+func (r *AssignTaskResult) GetProcessID() string {
+	return r.WorkerID
+}
+
+// ===========================================================================
+// AssignTasksBatchHandler
+// ===========================================================================
+
+// AssignTasksBatchHandler handles CmdAssignTasksBatch commands.
+// It assigns each worker/task pair best-effort using the same validation and
+// state updates as AssignTaskHandler, reporting a result for every item.
+// Items that reuse a worker or task already claimed earlier in the batch fail.
+type AssignTasksBatchHandler struct {
+	assigner *AssignTaskHandler
+	progress ProgressReporter
+}
+
+// AssignTasksBatchHandlerOption configures AssignTasksBatchHandler.
+type AssignTasksBatchHandlerOption func(*AssignTasksBatchHandler)
+
+// WithAssignTasksBatchProgress sets the reporter that receives an update as each
+// item in the batch is processed.
+func WithAssignTasksBatchProgress(reporter ProgressReporter) AssignTasksBatchHandlerOption {
+	return func(h *AssignTasksBatchHandler) {
+		h.progress = reporter
+	}
+}
+
+// NewAssignTasksBatchHandler creates a new AssignTasksBatchHandler that delegates
+// each assignment to the given AssignTaskHandler.
+func NewAssignTasksBatchHandler(assigner *AssignTaskHandler, opts ...AssignTasksBatchHandlerOption) *AssignTasksBatchHandler {
+	h := &AssignTasksBatchHandler{assigner: assigner}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes an AssignTasksBatchCommand.
+// The command always succeeds; per-item failures are reported in the result.
+// Events and follow-up deliveries from successful items are aggregated.
+func (h *AssignTasksBatchHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	batchCmd := cmd.(*command.AssignTasksBatchCommand)
+
+	result := &AssignTasksBatchResult{
+		Results: make([]AssignTasksBatchItemResult, 0, len(batchCmd.Assignments)),
+	}
+	var resultEvents []any
+	var followUps []command.Command
+
+	seenTasks := make(map[string]bool)
+	seenWorkers := make(map[string]bool)
+
+	total := len(batchCmd.Assignments)
+	reportProgress(h.progress, fmt.Sprintf("Assigning %d tasks", total))
+
+	for i, item := range batchCmd.Assignments {
+		itemResult := AssignTasksBatchItemResult{
+			WorkerID: item.WorkerID,
+			TaskID:   item.TaskID,
+		}
+
+		switch {
+		case seenTasks[item.TaskID]:
+			itemResult.Error = fmt.Sprintf("task %s appears more than once in batch", item.TaskID)
+		case seenWorkers[item.WorkerID]:
+			itemResult.Error = fmt.Sprintf("worker %s appears more than once in batch", item.WorkerID)
+		default:
+			seenTasks[item.TaskID] = true
+			seenWorkers[item.WorkerID] = true
+
+			assignCmd := command.NewAssignTaskCommand(batchCmd.Source(), item.WorkerID, item.TaskID, item.Summary, item.ThreadID)
+			if batchCmd.TraceID() != "" {
+				assignCmd.SetTraceID(batchCmd.TraceID())
+			}
+
+			assignResult, err := h.assigner.Handle(ctx, assignCmd)
+			if err != nil {
+				itemResult.Error = err.Error()
+				break
+			}
+
+			itemResult.Success = true
+			result.Assigned++
+			resultEvents = append(resultEvents, assignResult.Events...)
+			followUps = append(followUps, assignResult.FollowUp...)
+		}
+
+		if itemResult.Success {
+			reportProgress(h.progress, fmt.Sprintf("[%d/%d] Assigned %s to %s", i+1, total, item.TaskID, item.WorkerID))
+		} else {
+			result.Failed++
+			reportProgress(h.progress, fmt.Sprintf("[%d/%d] Failed to assign %s to %s: %s", i+1, total, item.TaskID, item.WorkerID, itemResult.Error))
+		}
+		result.Results = append(result.Results, itemResult)
+	}
+
+	return SuccessWithEventsAndFollowUp(result, resultEvents, followUps), nil
+}
+
+// AssignTasksBatchItemResult is the outcome of a single assignment within a batch.
+type AssignTasksBatchItemResult struct {
+	WorkerID string `json:"worker_id"`
+	TaskID   string `json:"task_id"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// AssignTasksBatchResult contains the per-item results of a batch assignment.
+type AssignTasksBatchResult struct {
+	Results  []AssignTasksBatchItemResult `json:"results"`
+	Assigned int                          `json:"assigned"`
+	Failed   int                          `json:"failed"`
+}
+
+// ===========================================================================
+// GetEligibleWorkersHandler
+// ===========================================================================
+
+// GetEligibleWorkersHandler handles CmdGetEligibleWorkers commands.
+// It runs the AssignTaskHandler's validation against every active worker, so the
+// coordinator can see who could take a task, and why the rest cannot, before assigning.
+// Read-only: no process, task or BD state is changed.
+type GetEligibleWorkersHandler struct {
+	assigner *AssignTaskHandler
+}
+
+// NewGetEligibleWorkersHandler creates a new GetEligibleWorkersHandler that checks
+// eligibility with the given AssignTaskHandler.
+func NewGetEligibleWorkersHandler(assigner *AssignTaskHandler) *GetEligibleWorkersHandler {
+	return &GetEligibleWorkersHandler{assigner: assigner}
+}
+
+// Handle processes a GetEligibleWorkersCommand.
+// Fails if the task itself cannot be assigned (it is on hold or the bd issue does not exist).
+// Retired and failed workers are not reported.
+func (h *GetEligibleWorkersHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	eligibleCmd := cmd.(*command.GetEligibleWorkersCommand)
+
+	if err := h.assigner.checkNotHeld(eligibleCmd.TaskID); err != nil {
+		return nil, err
+	}
+	if _, err := h.assigner.showTask(eligibleCmd.TaskID); err != nil {
+		return nil, err
+	}
+
+	workers := h.assigner.processRepo.ActiveWorkers()
+	slices.SortFunc(workers, func(a, b *repository.Process) int {
+		return compareWorkerIDs(a.ID, b.ID)
+	})
+
+	result := &GetEligibleWorkersResult{
+		TaskID:     eligibleCmd.TaskID,
+		Eligible:   []string{},
+		Ineligible: []IneligibleWorker{},
+	}
+	// Checked against the same role and provider policy as assign_task
+	for _, proc := range workers {
+		if err := h.assigner.checkWorkerAssignable(proc); err != nil {
+			ineligible := IneligibleWorker{
+				WorkerID: proc.ID,
+				Status:   string(proc.Status),
+				Reason:   err.Error(),
+			}
+			if proc.Phase != nil {
+				ineligible.Phase = string(*proc.Phase)
+			}
+			result.Ineligible = append(result.Ineligible, ineligible)
+			continue
+		}
+		result.Eligible = append(result.Eligible, proc.ID)
+	}
+
+	return SuccessResult(result), nil
+}
+
+// IneligibleWorker is a worker that cannot currently be assigned a task, and why.
+type IneligibleWorker struct {
+	WorkerID string
+	Status   string
+	Phase    string
+	Reason   string
+}
+
+// GetEligibleWorkersResult lists the workers that could take a task and those that cannot.
+// Both lists are in worker ID order.
+type GetEligibleWorkersResult struct {
+	TaskID     string
+	Eligible   []string
+	Ineligible []IneligibleWorker
+}
+
+// EligibleWorkerIDs returns the workers that could take the task.
+func (r *GetEligibleWorkersResult) EligibleWorkerIDs() []string {
+	return r.Eligible
+}
+
+// IneligibleWorkerIDs returns the workers that cannot take the task.
+func (r *GetEligibleWorkersResult) IneligibleWorkerIDs() []string {
+	ids := make([]string, len(r.Ineligible))
+	for i, w := range r.Ineligible {
+		ids[i] = w.WorkerID
+	}
+	return ids
+}
+
+// IneligibilityOf returns the status, phase and reason of an ineligible worker,
+// or empty strings if workerID is not ineligible.
+func (r *GetEligibleWorkersResult) IneligibilityOf(workerID string) (status, phase, reason string) {
+	for _, w := range r.Ineligible {
+		if w.WorkerID == workerID {
+			return w.Status, w.Phase, w.Reason
+		}
+	}
+	return "", "", ""
+}
+
 // ===========================================================================
 // AssignReviewHandler
 // ===========================================================================
@@ -269,6 +665,46 @@ type AssignReviewHandler struct {
 	processRepo repository.ProcessRepository
 	taskRepo    repository.TaskRepository
 	queueRepo   repository.QueueRepository
+	selector    WorkerSelector
+	providers   *WorkerProviders
+	maxReviews  int
+	clock       types.Clock
+}
+
+// AssignReviewHandlerOption configures AssignReviewHandler.
+type AssignReviewHandlerOption func(*AssignReviewHandler)
+
+// WithAssignReviewWorkerSelector sets the policy used to pick a reviewer when the command
+// does not name one. Defaults to OldestReadyFirst.
+func WithAssignReviewWorkerSelector(selector WorkerSelector) AssignReviewHandlerOption {
+	return func(h *AssignReviewHandler) {
+		h.selector = selector
+	}
+}
+
+// WithAssignReviewWorkerProviders resolves each worker's provider when picking a reviewer,
+// so a workflow that gives reviewers their own provider only gets reviewers running on it.
+func WithAssignReviewWorkerProviders(providers WorkerProviders) AssignReviewHandlerOption {
+	return func(h *AssignReviewHandler) {
+		h.providers = &providers
+	}
+}
+
+// WithMaxConcurrentReviews caps how many tasks may be in review at once, so reviews
+// cannot occupy every worker. Zero or negative means no limit.
+func WithMaxConcurrentReviews(n int) AssignReviewHandlerOption {
+	return func(h *AssignReviewHandler) {
+		h.maxReviews = n
+	}
+}
+
+// WithAssignReviewClock sets the clock used for review timestamps and ready grace checks.
+func WithAssignReviewClock(clock types.Clock) AssignReviewHandlerOption {
+	return func(h *AssignReviewHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
 }
 
 // NewAssignReviewHandler creates a new AssignReviewHandler.
@@ -277,24 +713,53 @@ func NewAssignReviewHandler(
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	opts ...AssignReviewHandlerOption,
 ) *AssignReviewHandler {
 	if queueRepo == nil {
 		panic("queueRepo is required for AssignReviewHandler")
 	}
-	return &AssignReviewHandler{
+	h := &AssignReviewHandler{
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
+		selector:    OldestReadyFirst,
+		clock:       types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // Handle processes an AssignReviewCommand.
 // It validates the reviewer state and updates the task with the reviewer assignment.
+// If the command names no reviewer (or AutoReviewer), one is chosen from the ready generic and
+// reviewer workers by the handler's selector, never the implementer, preferring workers spawned
+// as reviewers and honoring the workflow's reviewer provider; if none is ready the error asks to
+// retry later.
 // Phase transition for reviewer: Idle -> Reviewing
 // Phase transition for implementer: Implementing -> AwaitingReview (already happened)
 func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	reviewCmd := cmd.(*command.AssignReviewCommand)
 
+	// Refuse before picking a reviewer if the review cap is already reached
+	if h.maxReviews > 0 && countActiveReviews(h.taskRepo, reviewCmd.TaskID) >= h.maxReviews {
+		return nil, fmt.Errorf("%w (limit %d)", types.ErrReviewCapacityReached, h.maxReviews)
+	}
+
+	// Select a reviewer other than the implementer if none was named
+	if reviewCmd.ReviewerID == "" || reviewCmd.ReviewerID == command.AutoReviewer {
+		reviewer, err := selectReviewer(h.processRepo, h.selector, h.providers, h.clock.Now(), reviewCmd.ImplementerID)
+		if errors.Is(err, types.ErrNoReadyWorker) {
+			return nil, fmt.Errorf("%w to review %s other than implementer %s; retry once a worker finishes or spawn one",
+				err, reviewCmd.TaskID, reviewCmd.ImplementerID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		reviewCmd.ReviewerID = reviewer.ID
+	}
+
 	// 1. Validate reviewer != implementer
 	if reviewCmd.ReviewerID == reviewCmd.ImplementerID {
 		return nil, types.ErrReviewerIsImplementer
@@ -317,6 +782,10 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 		return nil, types.ErrProcessNotIdle
 	}
 
+	if err := checkReadyGrace(reviewer, h.clock.Now()); err != nil {
+		return nil, err
+	}
+
 	// 3. Get existing TaskAssignment
 	task, err := h.taskRepo.Get(reviewCmd.TaskID)
 	if err != nil {
@@ -334,7 +803,8 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 	// 4. Update task with Reviewer = reviewerID
 	task.Reviewer = reviewCmd.ReviewerID
 	task.Status = repository.TaskInReview
-	task.ReviewStartedAt = time.Now()
+	task.ReviewStartedAt = h.clock.Now()
+	task.EnterPhase(repository.TaskPhaseReviewing, task.ReviewStartedAt)
 
 	// 5. Update reviewer: Phase = PhaseReviewing, TaskID
 	// NOTE: We do NOT set StatusWorking here - that happens in DeliverProcessQueuedHandler
@@ -352,17 +822,24 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 		task.Reviewer = ""
 		task.Status = repository.TaskImplementing
 		task.ReviewStartedAt = time.Time{}
+		task.RevertLastPhase()
 		_ = h.taskRepo.Save(task)
 		return nil, fmt.Errorf("failed to save reviewer: %w", err)
 	}
 
-	// 7. Queue the appropriate review prompt based on review type
-	// Note: Summary is not stored in TaskAssignment yet, so we use a placeholder
+	// 7. Queue the review prompt: the reviewer role's override if registered,
+	// otherwise the default prompt for the review type
+	summary, diffHint := task.CompletionSummary, reviewDiffHint(task)
 	var reviewPrompt string
-	if reviewCmd.ReviewType == command.ReviewTypeSimple {
-		reviewPrompt = prompt.ReviewAssignmentPromptSimple(reviewCmd.TaskID, reviewCmd.ImplementerID)
+	if custom := roles.GetPrompts(reviewer.AgentType).ReviewAssignmentPrompt; custom != nil {
+		reviewPrompt = custom(reviewCmd.TaskID, reviewCmd.ImplementerID, summary, diffHint)
+	} else if reviewCmd.ReviewType == command.ReviewTypeSimple {
+		reviewPrompt = prompt.ReviewAssignmentPromptSimple(reviewCmd.TaskID, reviewCmd.ImplementerID, summary, diffHint)
 	} else {
-		reviewPrompt = prompt.ReviewAssignmentPrompt(reviewCmd.TaskID, reviewCmd.ImplementerID)
+		reviewPrompt = prompt.ReviewAssignmentPrompt(reviewCmd.TaskID, reviewCmd.ImplementerID, summary, diffHint)
+	}
+	if tr := task.TestResults; tr != nil {
+		reviewPrompt += prompt.ReviewTestResultsSection(tr.Passed, tr.Failed, tr.Output)
 	}
 	queue := h.queueRepo.GetOrCreate(reviewCmd.ReviewerID)
 	if err := queue.Enqueue(reviewPrompt, repository.SenderCoordinator); err != nil {
@@ -385,16 +862,49 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 		ReviewerID:    reviewer.ID,
 		TaskID:        reviewCmd.TaskID,
 		ImplementerID: reviewCmd.ImplementerID,
+		ThreadID:      task.ThreadID,
 	}
 
 	return SuccessWithEventsAndFollowUp(result, []any{event}, []command.Command{deliverCmd}), nil
 }
 
+// reviewDiffHint tells the reviewer which changes to inspect. Re-reviews point at
+// get_diff_since_last_review so only the delta since the last verdict is read.
+func reviewDiffHint(task *repository.TaskAssignment) string {
+	if task.DiffCheckpoint == nil {
+		return ""
+	}
+	return fmt.Sprintf("This is review round %d. Call get_diff_since_last_review to see only what changed since the last verdict, then check the full diff where needed.", task.DiffCheckpoint.Round+1)
+}
+
 // AssignReviewResult contains the result of assigning a reviewer to a task.
 type AssignReviewResult struct {
 	ReviewerID    string
 	TaskID        string
 	ImplementerID string
+	ThreadID      string // Fabric thread of the task, empty if it has none
+}
+
+// GetThreadID returns the Fabric thread of the reviewed task.
+func (r *AssignReviewResult) GetThreadID() string {
+	return r.ThreadID
+}
+
+// GetProcessID returns the ID of the worker assigned as reviewer.
+func (r *AssignReviewResult) GetProcessID() string {
+	return r.ReviewerID
+}
+
+// countActiveReviews returns how many tasks other than excludeTaskID are in review.
+// A review is released as soon as its verdict moves the task out of review.
+func countActiveReviews(taskRepo repository.TaskRepository, excludeTaskID string) int {
+	count := 0
+	for _, task := range taskRepo.All() {
+		if task.TaskID != excludeTaskID && task.Status == repository.TaskInReview {
+			count++
+		}
+	}
+	return count
 }
 
 // ===========================================================================
@@ -405,9 +915,31 @@ type AssignReviewResult struct {
 // It transitions the implementer to the committing phase after approval.
 // After updating state, it queues a CommitApprovalPrompt message to the implementer.
 type ApproveCommitHandler struct {
-	processRepo repository.ProcessRepository
-	taskRepo    repository.TaskRepository
-	queueRepo   repository.QueueRepository
+	processRepo         repository.ProcessRepository
+	taskRepo            repository.TaskRepository
+	queueRepo           repository.QueueRepository
+	requirePassingTests bool
+	clock               types.Clock
+}
+
+// ApproveCommitHandlerOption configures ApproveCommitHandler.
+type ApproveCommitHandlerOption func(*ApproveCommitHandler)
+
+// WithRequirePassingTests refuses approval when the task's reported test results
+// include failures, unless the command sets AllowFailingTests.
+func WithRequirePassingTests(require bool) ApproveCommitHandlerOption {
+	return func(h *ApproveCommitHandler) {
+		h.requirePassingTests = require
+	}
+}
+
+// WithApproveCommitClock sets the clock used to stamp the committing phase.
+func WithApproveCommitClock(clock types.Clock) ApproveCommitHandlerOption {
+	return func(h *ApproveCommitHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
 }
 
 // NewApproveCommitHandler creates a new ApproveCommitHandler.
@@ -416,15 +948,21 @@ func NewApproveCommitHandler(
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	opts ...ApproveCommitHandlerOption,
 ) *ApproveCommitHandler {
 	if queueRepo == nil {
 		panic("queueRepo is required for ApproveCommitHandler")
 	}
-	return &ApproveCommitHandler{
+	h := &ApproveCommitHandler{
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
+		clock:       types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // Handle processes an ApproveCommitCommand.
@@ -451,6 +989,11 @@ func (h *ApproveCommitHandler) Handle(ctx context.Context, cmd command.Command)
 		return nil, types.ErrProcessNotImplementer
 	}
 
+	// Gate on reported test failures when the policy is enabled
+	if h.requirePassingTests && task.TestResults.HasFailures() && !approveCmd.AllowFailingTests {
+		return nil, fmt.Errorf("%w: %d failed (set allow_failing_tests to override)", types.ErrTestsFailing, task.TestResults.Failed)
+	}
+
 	// 2. Get implementer and validate in AwaitingReview phase
 	implementer, err := h.processRepo.Get(approveCmd.ImplementerID)
 	if err != nil {
@@ -470,6 +1013,7 @@ func (h *ApproveCommitHandler) Handle(ctx context.Context, cmd command.Command)
 
 	// 4. Update task: Status = TaskCommitting
 	task.Status = repository.TaskCommitting
+	task.EnterPhase(repository.TaskPhaseCommitting, h.clock.Now())
 
 	// 5. Save to repositories
 	if err := h.taskRepo.Save(task); err != nil {
@@ -479,6 +1023,7 @@ func (h *ApproveCommitHandler) Handle(ctx context.Context, cmd command.Command)
 	if err := h.processRepo.Save(implementer); err != nil {
 		// Revert task changes on failure
 		task.Status = repository.TaskApproved
+		task.RevertLastPhase()
 		_ = h.taskRepo.Save(task)
 		return nil, fmt.Errorf("failed to save implementer: %w", err)
 	}
@@ -526,6 +1071,19 @@ type AssignReviewFeedbackHandler struct {
 	processRepo repository.ProcessRepository
 	taskRepo    repository.TaskRepository
 	queueRepo   repository.QueueRepository
+	clock       types.Clock
+}
+
+// AssignReviewFeedbackHandlerOption configures AssignReviewFeedbackHandler.
+type AssignReviewFeedbackHandlerOption func(*AssignReviewFeedbackHandler)
+
+// WithAssignReviewFeedbackClock sets the clock used to stamp the implementing phase.
+func WithAssignReviewFeedbackClock(clock types.Clock) AssignReviewFeedbackHandlerOption {
+	return func(h *AssignReviewFeedbackHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
 }
 
 // NewAssignReviewFeedbackHandler creates a new AssignReviewFeedbackHandler.
@@ -534,15 +1092,21 @@ func NewAssignReviewFeedbackHandler(
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	opts ...AssignReviewFeedbackHandlerOption,
 ) *AssignReviewFeedbackHandler {
 	if queueRepo == nil {
 		panic("queueRepo is required for AssignReviewFeedbackHandler")
 	}
-	return &AssignReviewFeedbackHandler{
+	h := &AssignReviewFeedbackHandler{
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
+		clock:       types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // Handle processes an AssignReviewFeedbackCommand.
@@ -586,8 +1150,12 @@ func (h *AssignReviewFeedbackHandler) Handle(ctx context.Context, cmd command.Co
 	addressing := events.ProcessPhaseAddressingFeedback
 	implementer.Phase = &addressing
 
-	// 4. Update task: Status = TaskImplementing (back to implementing to address feedback)
+	// 4. Update task: Status = TaskImplementing (back to implementing to address feedback),
+	// replacing the previous round's feedback items
+	previousItems := task.FeedbackItems
 	task.Status = repository.TaskImplementing
+	task.FeedbackItems = repository.NewFeedbackItems(feedbackCmd.Items)
+	task.EnterPhase(repository.TaskPhaseImplementing, h.clock.Now())
 
 	// 5. Save to repositories
 	if err := h.taskRepo.Save(task); err != nil {
@@ -597,12 +1165,14 @@ func (h *AssignReviewFeedbackHandler) Handle(ctx context.Context, cmd command.Co
 	if err := h.processRepo.Save(implementer); err != nil {
 		// Revert task changes on failure
 		task.Status = repository.TaskDenied
+		task.FeedbackItems = previousItems
+		task.RevertLastPhase()
 		_ = h.taskRepo.Save(task)
 		return nil, fmt.Errorf("failed to save implementer: %w", err)
 	}
 
 	// 6. Queue ReviewFeedbackPrompt to the implementer (from coordinator)
-	feedbackPrompt := prompt.ReviewFeedbackPrompt(feedbackCmd.TaskID, feedbackCmd.Feedback)
+	feedbackPrompt := prompt.ReviewFeedbackPrompt(feedbackCmd.TaskID, feedbackCmd.Feedback, feedbackCmd.Items)
 	queue := h.queueRepo.GetOrCreate(feedbackCmd.ImplementerID)
 	if err := queue.Enqueue(feedbackPrompt, repository.SenderCoordinator); err != nil {
 		return nil, fmt.Errorf("failed to queue feedback prompt: %w", err)
@@ -622,6 +1192,7 @@ func (h *AssignReviewFeedbackHandler) Handle(ctx context.Context, cmd command.Co
 	result := &AssignReviewFeedbackResult{
 		ImplementerID: implementer.ID,
 		TaskID:        feedbackCmd.TaskID,
+		ItemCount:     len(task.FeedbackItems),
 	}
 
 	return SuccessWithEventsAndFollowUp(result, []any{event}, []command.Command{deliverCmd}), nil
@@ -631,4 +1202,687 @@ func (h *AssignReviewFeedbackHandler) Handle(ctx context.Context, cmd command.Co
 type AssignReviewFeedbackResult struct {
 	ImplementerID string
 	TaskID        string
+	ItemCount     int // Number of discrete feedback items recorded on the task
+}
+
+// ===========================================================================
+// TransferTaskHandler
+// ===========================================================================
+
+// TransferTaskHandler handles CmdTransferTask commands.
+// It moves an in-flight task from one worker to a ready worker in a single command,
+// so the task is never left without an implementer.
+// After updating state, it queues a TaskTransferPrompt to the receiving worker
+// and a stop notice to the previous worker.
+type TransferTaskHandler struct {
+	processRepo repository.ProcessRepository
+	taskRepo    repository.TaskRepository
+	queueRepo   repository.QueueRepository
+	clock       types.Clock
+}
+
+// TransferTaskHandlerOption configures TransferTaskHandler.
+type TransferTaskHandlerOption func(*TransferTaskHandler)
+
+// WithTransferTaskClock sets the clock used for ready grace checks when picking the receiving worker.
+func WithTransferTaskClock(clock types.Clock) TransferTaskHandlerOption {
+	return func(h *TransferTaskHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
+// NewTransferTaskHandler creates a new TransferTaskHandler.
+// Panics if queueRepo is nil.
+func NewTransferTaskHandler(
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+	queueRepo repository.QueueRepository,
+	opts ...TransferTaskHandlerOption,
+) *TransferTaskHandler {
+	if queueRepo == nil {
+		panic("queueRepo is required for TransferTaskHandler")
+	}
+	h := &TransferTaskHandler{
+		processRepo: processRepo,
+		taskRepo:    taskRepo,
+		queueRepo:   queueRepo,
+		clock:       types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes a TransferTaskCommand.
+// The receiving worker inherits the previous worker's phase; the previous worker returns to Idle.
+func (h *TransferTaskHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	transferCmd := cmd.(*command.TransferTaskCommand)
+
+	// 1. Get task and validate it is assigned to the sending worker
+	task, err := h.taskRepo.Get(transferCmd.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			return nil, fmt.Errorf("task not found: %s", transferCmd.TaskID)
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed {
+		return nil, fmt.Errorf("task %s is %s and cannot be transferred", task.TaskID, task.Status)
+	}
+
+	if task.Implementer != transferCmd.FromWorkerID {
+		return nil, types.ErrProcessNotImplementer
+	}
+
+	// 2. Get the sending worker and validate it holds the task
+	from, err := h.processRepo.Get(transferCmd.FromWorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get from_worker: %w", err)
+	}
+
+	if from.TaskID != transferCmd.TaskID {
+		return nil, types.ErrProcessNotImplementer
+	}
+
+	// 3. Get the receiving worker and validate it is Ready, Idle, and unassigned
+	to, err := h.processRepo.Get(transferCmd.ToWorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get to_worker: %w", err)
+	}
+
+	if to.Status != repository.StatusReady {
+		return nil, types.ErrProcessNotReady
+	}
+	if to.Phase != nil && *to.Phase != events.ProcessPhaseIdle {
+		return nil, types.ErrProcessNotIdle
+	}
+	if to.TaskID != "" {
+		return nil, types.ErrProcessAlreadyAssigned
+	}
+	if err := checkReadyGrace(to, h.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 4. Move the task: receiving worker inherits the phase, sending worker returns to Idle
+	phase := events.ProcessPhaseImplementing
+	if from.Phase != nil {
+		phase = *from.Phase
+	}
+	idle := events.ProcessPhaseIdle
+	prevTo := *to
+
+	to.Phase = &phase
+	to.TaskID = transferCmd.TaskID
+	from.Phase = &idle
+	from.TaskID = ""
+
+	prevTask := *task
+	task.Implementer = transferCmd.ToWorkerID
+	task.TransferredFrom = transferCmd.FromWorkerID
+	task.TransferNote = transferCmd.Note
+
+	// 5. Save all three, reverting earlier saves on failure
+	if err := h.taskRepo.Save(task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	if err := h.processRepo.Save(to); err != nil {
+		_ = h.taskRepo.Save(&prevTask)
+		return nil, fmt.Errorf("failed to save to_worker: %w", err)
+	}
+
+	if err := h.processRepo.Save(from); err != nil {
+		_ = h.taskRepo.Save(&prevTask)
+		_ = h.processRepo.Save(&prevTo)
+		return nil, fmt.Errorf("failed to save from_worker: %w", err)
+	}
+
+	// 6. Queue the handoff to the receiving worker and a stop notice to the previous worker
+	transferPrompt := prompt.TaskTransferPrompt(transferCmd.TaskID, transferCmd.FromWorkerID, string(phase), task.ThreadID, transferCmd.Note)
+	if err := h.queueRepo.GetOrCreate(transferCmd.ToWorkerID).Enqueue(transferPrompt, repository.SenderCoordinator); err != nil {
+		return nil, fmt.Errorf("failed to queue transfer prompt: %w", err)
+	}
+
+	noticePrompt := prompt.TaskTransferredAwayPrompt(transferCmd.TaskID, transferCmd.ToWorkerID)
+	if err := h.queueRepo.GetOrCreate(transferCmd.FromWorkerID).Enqueue(noticePrompt, repository.SenderCoordinator); err != nil {
+		return nil, fmt.Errorf("failed to queue transfer notice: %w", err)
+	}
+
+	// 7. Create follow-up commands to deliver the queued messages
+	followUps := []command.Command{
+		command.NewDeliverProcessQueuedCommand(command.SourceInternal, transferCmd.ToWorkerID),
+		command.NewDeliverProcessQueuedCommand(command.SourceInternal, transferCmd.FromWorkerID),
+	}
+
+	// 8. Return with ProcessEvents for both workers and follow-ups
+	toEvent := events.NewProcessEvent(events.ProcessStatusChange, to.ID, to.Role).
+		WithTaskID(transferCmd.TaskID).
+		WithStatus(to.Status).
+		WithPhase(phase)
+	fromEvent := events.NewProcessEvent(events.ProcessStatusChange, from.ID, from.Role).
+		WithStatus(from.Status).
+		WithPhase(idle)
+
+	result := &TransferTaskResult{
+		TaskID:       transferCmd.TaskID,
+		FromWorkerID: transferCmd.FromWorkerID,
+		ToWorkerID:   transferCmd.ToWorkerID,
+		Phase:        phase,
+	}
+
+	return SuccessWithEventsAndFollowUp(result, []any{toEvent, fromEvent}, followUps), nil
+}
+
+// TransferTaskResult contains the result of transferring a task between workers.
+type TransferTaskResult struct {
+	TaskID       string
+	FromWorkerID string
+	ToWorkerID   string
+	Phase        events.ProcessPhase
+}
+
+// ===========================================================================
+// DrainWorkerHandler
+// ===========================================================================
+
+// Per-task outcomes reported by DrainWorkerHandler.
+const (
+	// DrainOutcomeTransferred means the task moved to a ready worker.
+	DrainOutcomeTransferred = "transferred"
+	// DrainOutcomeOrphaned means the task stayed with the retired worker and must be reassigned.
+	DrainOutcomeOrphaned = "orphaned"
+)
+
+// DrainWorkerHandler handles CmdDrainWorker commands.
+// It moves each of a worker's in-flight tasks to a ready worker through the
+// TransferTaskHandler, then retires the worker with a follow-up RetireProcess command.
+// Tasks that cannot be transferred (no worker is ready, or the drained worker is
+// reviewing rather than implementing them) stay with the retired worker, where
+// list_orphaned_tasks reports them.
+type DrainWorkerHandler struct {
+	transferrer *TransferTaskHandler
+	selector    WorkerSelector
+}
+
+// NewDrainWorkerHandler creates a new DrainWorkerHandler that delegates each
+// transfer to the given TransferTaskHandler.
+func NewDrainWorkerHandler(transferrer *TransferTaskHandler) *DrainWorkerHandler {
+	return &DrainWorkerHandler{
+		transferrer: transferrer,
+		selector:    OldestReadyFirst,
+	}
+}
+
+// Handle processes a DrainWorkerCommand.
+// The command succeeds whenever the worker exists; per-task outcomes are reported in the result.
+// Events and follow-up deliveries from successful transfers are aggregated.
+func (h *DrainWorkerHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	drainCmd := cmd.(*command.DrainWorkerCommand)
+	processRepo := h.transferrer.processRepo
+
+	worker, err := processRepo.Get(drainCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get worker: %w", err)
+	}
+	if worker.Role != repository.RoleWorker {
+		return nil, fmt.Errorf("%s is not a worker and cannot be drained", worker.ID)
+	}
+
+	note := fmt.Sprintf("%s is being drained", worker.ID)
+	if drainCmd.Reason != "" {
+		note = fmt.Sprintf("%s: %s", note, drainCmd.Reason)
+	}
+
+	result := &DrainWorkerResult{
+		WorkerID: worker.ID,
+		Tasks:    make([]DrainedTask, 0),
+	}
+	var resultEvents []any
+	var followUps []command.Command
+
+	for _, task := range h.inFlightTasks(worker.ID) {
+		item := DrainedTask{TaskID: task.TaskID, Outcome: DrainOutcomeOrphaned}
+
+		if task.Implementer != worker.ID {
+			item.Reason = "worker was reviewing this task; reassign the review with assign_task_review"
+		} else if to, err := selectReadyWorker(processRepo, h.selector, h.transferrer.clock.Now(), worker.ID); err != nil {
+			item.Reason = "no ready worker to take over the task"
+		} else {
+			transferCmd := command.NewTransferTaskCommand(drainCmd.Source(), task.TaskID, worker.ID, to.ID, note)
+			if drainCmd.TraceID() != "" {
+				transferCmd.SetTraceID(drainCmd.TraceID())
+			}
+
+			transferResult, err := h.transferrer.Handle(ctx, transferCmd)
+			if err != nil {
+				item.Reason = err.Error()
+			} else {
+				item.Outcome = DrainOutcomeTransferred
+				item.ToWorkerID = to.ID
+				resultEvents = append(resultEvents, transferResult.Events...)
+				followUps = append(followUps, transferResult.FollowUp...)
+			}
+		}
+
+		if item.Outcome == DrainOutcomeTransferred {
+			result.Transferred++
+		} else {
+			result.Orphaned++
+		}
+		result.Tasks = append(result.Tasks, item)
+	}
+
+	// The drained worker is retired rather than woken for the transfer notices queued to it
+	h.transferrer.queueRepo.Delete(worker.ID)
+	followUps = slices.DeleteFunc(followUps, func(c command.Command) bool {
+		deliver, ok := c.(*command.DeliverProcessQueuedCommand)
+		return ok && deliver.ProcessID == worker.ID
+	})
+
+	retireReason := "drained"
+	if drainCmd.Reason != "" {
+		retireReason = drainCmd.Reason
+	}
+	followUps = append(followUps, command.NewRetireProcessCommand(command.SourceInternal, worker.ID, retireReason))
+
+	return SuccessWithEventsAndFollowUp(result, resultEvents, followUps), nil
+}
+
+// inFlightTasks returns the unfinished tasks workerID implements or reviews, sorted by task ID.
+func (h *DrainWorkerHandler) inFlightTasks(workerID string) []*repository.TaskAssignment {
+	var tasks []*repository.TaskAssignment
+	for _, task := range h.transferrer.taskRepo.All() {
+		if task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed {
+			continue
+		}
+		if task.Implementer == workerID || task.Reviewer == workerID {
+			tasks = append(tasks, task)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+	return tasks
+}
+
+// DrainedTask is the outcome of draining a single task off a worker.
+type DrainedTask struct {
+	TaskID     string `json:"task_id"`
+	Outcome    string `json:"outcome"`
+	ToWorkerID string `json:"to_worker,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// DrainWorkerResult contains the per-task outcomes of draining a worker.
+type DrainWorkerResult struct {
+	WorkerID    string        `json:"worker_id"`
+	Tasks       []DrainedTask `json:"tasks"`
+	Transferred int           `json:"transferred"`
+	Orphaned    int           `json:"orphaned"`
+}
+
+// ===========================================================================
+// ReportTestResultsHandler
+// ===========================================================================
+
+// ReportTestResultsHandler handles CmdReportTestResults commands.
+// It records a worker's test run results on the task currently assigned to it,
+// replacing any previously reported results. No phase transition occurs.
+type ReportTestResultsHandler struct {
+	processRepo repository.ProcessRepository
+	taskRepo    repository.TaskRepository
+	clock       types.Clock
+}
+
+// ReportTestResultsHandlerOption configures ReportTestResultsHandler.
+type ReportTestResultsHandlerOption func(*ReportTestResultsHandler)
+
+// WithReportTestResultsClock sets the clock used to stamp reported results.
+func WithReportTestResultsClock(clock types.Clock) ReportTestResultsHandlerOption {
+	return func(h *ReportTestResultsHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
+// NewReportTestResultsHandler creates a new ReportTestResultsHandler.
+func NewReportTestResultsHandler(
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+	opts ...ReportTestResultsHandlerOption,
+) *ReportTestResultsHandler {
+	h := &ReportTestResultsHandler{
+		processRepo: processRepo,
+		taskRepo:    taskRepo,
+		clock:       types.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes a ReportTestResultsCommand.
+func (h *ReportTestResultsHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	reportCmd := cmd.(*command.ReportTestResultsCommand)
+
+	// 1. Get process and its assigned task
+	proc, err := h.processRepo.Get(reportCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+
+	if proc.Status == repository.StatusRetired {
+		return nil, types.ErrProcessRetired
+	}
+
+	if proc.TaskID == "" {
+		return nil, types.ErrNoTaskAssigned
+	}
+
+	task, err := h.taskRepo.Get(proc.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			return nil, fmt.Errorf("task not found: %s", proc.TaskID)
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	// 2. Attach results to the task
+	task.TestResults = &repository.TestResults{
+		Passed:     reportCmd.Passed,
+		Failed:     reportCmd.Failed,
+		Output:     reportCmd.Output,
+		ReportedAt: h.clock.Now(),
+	}
+
+	if err := h.taskRepo.Save(task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	result := &ReportTestResultsResult{
+		WorkerID: reportCmd.WorkerID,
+		TaskID:   task.TaskID,
+		Passed:   reportCmd.Passed,
+		Failed:   reportCmd.Failed,
+	}
+
+	return SuccessResult(result), nil
+}
+
+// ReportTestResultsResult contains the result of reporting test results.
+type ReportTestResultsResult struct {
+	WorkerID string
+	TaskID   string
+	Passed   int
+	Failed   int
+}
+
+// ===========================================================================
+// MarkFeedbackAddressedHandler
+// ===========================================================================
+
+// MarkFeedbackAddressedHandler handles CmdMarkFeedbackAddressed commands.
+// It marks review feedback items on the task the worker is implementing as addressed.
+// Marking an item that is already addressed is a no-op. No phase transition occurs.
+type MarkFeedbackAddressedHandler struct {
+	processRepo repository.ProcessRepository
+	taskRepo    repository.TaskRepository
+}
+
+// NewMarkFeedbackAddressedHandler creates a new MarkFeedbackAddressedHandler.
+func NewMarkFeedbackAddressedHandler(
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+) *MarkFeedbackAddressedHandler {
+	return &MarkFeedbackAddressedHandler{
+		processRepo: processRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+// Handle processes a MarkFeedbackAddressedCommand.
+// All item IDs are checked before any is marked, so an unknown ID leaves the task unchanged.
+func (h *MarkFeedbackAddressedHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	markCmd := cmd.(*command.MarkFeedbackAddressedCommand)
+
+	// 1. Get process and the task it is implementing
+	proc, err := h.processRepo.Get(markCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+
+	if proc.Status == repository.StatusRetired {
+		return nil, types.ErrProcessRetired
+	}
+
+	if proc.TaskID == "" {
+		return nil, types.ErrNoTaskAssigned
+	}
+
+	task, err := h.taskRepo.Get(proc.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			return nil, fmt.Errorf("task not found: %s", proc.TaskID)
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if task.Implementer != markCmd.WorkerID {
+		return nil, types.ErrProcessNotImplementer
+	}
+
+	if len(task.FeedbackItems) == 0 {
+		return nil, fmt.Errorf("task %s has no feedback items to mark addressed", task.TaskID)
+	}
+
+	// 2. Validate every item ID, then mark them addressed
+	for _, id := range markCmd.ItemIDs {
+		if id < 1 || id > len(task.FeedbackItems) {
+			return nil, fmt.Errorf("feedback item %d not found on task %s (items 1-%d)", id, task.TaskID, len(task.FeedbackItems))
+		}
+	}
+	for _, id := range markCmd.ItemIDs {
+		task.FeedbackItems[id-1].Addressed = true
+	}
+
+	if err := h.taskRepo.Save(task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	result := &MarkFeedbackAddressedResult{
+		WorkerID:    markCmd.WorkerID,
+		TaskID:      task.TaskID,
+		Unaddressed: task.UnaddressedFeedback(),
+	}
+
+	return SuccessResult(result), nil
+}
+
+// MarkFeedbackAddressedResult contains the result of marking feedback items addressed.
+type MarkFeedbackAddressedResult struct {
+	WorkerID    string
+	TaskID      string
+	Unaddressed []repository.FeedbackItem // Items still to be addressed, in order
+}
+
+// UnaddressedFeedbackItems returns the text of each feedback item still to be addressed.
+func (r *MarkFeedbackAddressedResult) UnaddressedFeedbackItems() []string {
+	return feedbackTexts(r.Unaddressed)
+}
+
+// feedbackTexts formats feedback items as "<id>. <text>" for reporting to workers.
+func feedbackTexts(items []repository.FeedbackItem) []string {
+	if len(items) == 0 {
+		return nil
+	}
+	texts := make([]string, len(items))
+	for i, item := range items {
+		texts[i] = fmt.Sprintf("%d. %s", item.ID, item.Text)
+	}
+	return texts
+}
+
+// ===========================================================================
+// CheckpointHandler
+// ===========================================================================
+
+// ErrCheckpointsDisabled is returned by CheckpointHandler when the workflow has no
+// worktree to commit checkpoints to.
+var ErrCheckpointsDisabled = errors.New("checkpoints require a worktree-enabled workflow")
+
+// CheckpointHandler handles CmdCheckpoint commands.
+// It snapshots the worktree's uncommitted work as a [WIP] commit stored under
+// refs/perles/checkpoints/<task>/<n> and records the hash on the task, so a replacement
+// worker can restore it. HEAD is not moved: the work stays uncommitted, so diff-based
+// review checks still see it. No phase transition occurs.
+type CheckpointHandler struct {
+	processRepo repository.ProcessRepository
+	taskRepo    repository.TaskRepository
+	gitExecutor appgit.GitExecutor
+	author      domaingit.Author
+}
+
+// CheckpointHandlerOption configures CheckpointHandler.
+type CheckpointHandlerOption func(*CheckpointHandler)
+
+// WithCheckpointCommitAuthor attributes checkpoint commits to author, in "Name <email>" format.
+// An empty or malformed author leaves attribution to git's configuration.
+func WithCheckpointCommitAuthor(author string) CheckpointHandlerOption {
+	return func(h *CheckpointHandler) {
+		if parsed, err := domaingit.ParseAuthor(author); err == nil {
+			h.author = parsed
+		}
+	}
+}
+
+// NewCheckpointHandler creates a new CheckpointHandler snapshotting through gitExecutor.
+// A nil gitExecutor disables checkpoints: every command fails with ErrCheckpointsDisabled.
+func NewCheckpointHandler(
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+	gitExecutor appgit.GitExecutor,
+	opts ...CheckpointHandlerOption,
+) *CheckpointHandler {
+	h := &CheckpointHandler{
+		processRepo: processRepo,
+		taskRepo:    taskRepo,
+		gitExecutor: gitExecutor,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes a CheckpointCommand.
+func (h *CheckpointHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	checkpointCmd := cmd.(*command.CheckpointCommand)
+
+	if h.gitExecutor == nil {
+		return nil, ErrCheckpointsDisabled
+	}
+
+	// 1. Get process and the task it is implementing
+	proc, err := h.processRepo.Get(checkpointCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+
+	if proc.Status == repository.StatusRetired {
+		return nil, types.ErrProcessRetired
+	}
+
+	if proc.TaskID == "" {
+		return nil, types.ErrNoTaskAssigned
+	}
+
+	task, err := h.taskRepo.Get(proc.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			return nil, fmt.Errorf("task not found: %s", proc.TaskID)
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if task.Implementer != checkpointCmd.WorkerID {
+		return nil, types.ErrProcessNotImplementer
+	}
+
+	result := &CheckpointResult{
+		WorkerID: checkpointCmd.WorkerID,
+		TaskID:   task.TaskID,
+	}
+
+	// 2. Snapshot the work in progress, if there is any
+	dirty, err := h.gitExecutor.HasUncommittedChanges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read worktree status: %w", err)
+	}
+	if !dirty {
+		return SuccessResult(result), nil
+	}
+
+	ref := checkpointRef(task.TaskID, len(task.Checkpoints)+1)
+	hash, err := h.gitExecutor.SnapshotWorktree(ref, checkpointMessage(task.TaskID, checkpointCmd.Message), h.author)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot checkpoint: %w", err)
+	}
+	result.Hash = hash
+	result.Ref = ref
+
+	// 3. Record the checkpoint on the task
+	task.Checkpoints = append(task.Checkpoints, hash)
+	if err := h.taskRepo.Save(task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	return SuccessResult(result), nil
+}
+
+// checkpointRef returns the ref the n-th checkpoint of taskID is stored under.
+func checkpointRef(taskID string, n int) string {
+	return fmt.Sprintf("refs/perles/checkpoints/%s/%d", taskID, n)
+}
+
+// checkpointMessage returns the commit message of a checkpoint of taskID.
+func checkpointMessage(taskID, note string) string {
+	if note == "" {
+		return fmt.Sprintf("[WIP] %s: checkpoint", taskID)
+	}
+	return fmt.Sprintf("[WIP] %s: %s", taskID, note)
+}
+
+// CheckpointResult contains the result of a checkpoint.
+type CheckpointResult struct {
+	WorkerID string
+	TaskID   string
+	Hash     string // Empty if there was nothing to snapshot
+	Ref      string // Ref the checkpoint is stored under, empty with Hash
+}
+
+// CheckpointHash returns the checkpoint commit hash, or "" if there was nothing to snapshot.
+func (r *CheckpointResult) CheckpointHash() string {
+	return r.Hash
 }
@@ -10,9 +10,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
@@ -79,6 +82,33 @@ func TestAssignTaskHandler_AssignsToReadyWorker(t *testing.T) {
 	require.NoError(t, err, "task not found")
 	require.Equal(t, "worker-1", task.Implementer)
 	require.Equal(t, repository.TaskImplementing, task.Status)
+	require.Equal(t, []repository.PhaseTransition{
+		{Phase: repository.TaskPhaseImplementing, At: task.StartedAt},
+	}, task.PhaseHistory)
+}
+
+func TestAssignTaskHandler_RecordsBaseTree(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.1").Return(&beads.Issue{ID: "perles-abc1.1", Status: beads.StatusOpen}, nil)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.1", beads.StatusInProgress).Return(nil)
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady,
+		Phase: phasePtr(events.ProcessPhaseIdle), CreatedAt: time.Now(),
+	})
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().WorktreeTree().Return("base-tree", nil).Once()
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor),
+		WithQueueRepository(repository.NewMemoryQueueRepository(0)), WithAssignTaskGitExecutor(gitExec))
+
+	_, err := handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "", ""))
+	require.NoError(t, err)
+
+	task, err := taskRepo.Get("perles-abc1.1")
+	require.NoError(t, err)
+	require.Equal(t, "base-tree", task.BaseTree)
 }
 
 func TestAssignTaskHandler_FailsIfWorkerNotReady(t *testing.T) {
@@ -270,6 +300,50 @@ func TestAssignTaskHandler_CreatesTaskAssignment(t *testing.T) {
 	require.False(t, task.StartedAt.IsZero(), "expected StartedAt to be set")
 }
 
+func TestAssignTaskHandler_RecordsEstimate(t *testing.T) {
+	tests := []struct {
+		name          string
+		issueEstimate int
+		argEstimate   int
+		want          int
+	}{
+		{name: "from argument", argEstimate: 45, want: 45},
+		{name: "bd issue estimate wins", issueEstimate: 120, argEstimate: 45, want: 120},
+		{name: "no estimate", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processRepo := repository.NewMemoryProcessRepository()
+			taskRepo := repository.NewMemoryTaskRepository()
+			bdExecutor := mocks.NewMockIssueExecutor(t)
+			bdExecutor.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{
+				ID: "perles-abc1.2", Status: beads.StatusOpen, EstimatedMinutes: tt.issueEstimate,
+			}, nil)
+			bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+
+			processRepo.AddProcess(&repository.Process{
+				ID:        "worker-1",
+				Role:      repository.RoleWorker,
+				Status:    repository.StatusReady,
+				Phase:     phasePtr(events.ProcessPhaseIdle),
+				CreatedAt: time.Now(),
+			})
+
+			handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(repository.NewMemoryQueueRepository(0)))
+
+			cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "", "")
+			cmd.EstimateMinutes = tt.argEstimate
+			_, err := handler.Handle(context.Background(), cmd)
+			require.NoError(t, err)
+
+			task, err := taskRepo.Get("perles-abc1.2")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, task.EstimateMinutes)
+		})
+	}
+}
+
 func TestAssignTaskHandler_EmitsStatusChangeEvent(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -356,6 +430,378 @@ func TestAssignTaskHandler_ReturnsAssignTaskResult(t *testing.T) {
 	require.Equal(t, "Implement feature X", assignResult.Summary)
 }
 
+// ===========================================================================
+// AssignTasksBatchHandler Tests
+// ===========================================================================
+
+func TestAssignTaskHandler_StoresInstructionsOnTask(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo))
+
+	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "Implement feature", "")
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	task, err := taskRepo.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Equal(t, "Implement feature", task.Instructions)
+}
+
+func TestAssignTaskHandler_TruncatesOversizedPrompt(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	limit := prompt.PromptLimit{MaxBytes: 3000, Strategy: prompt.DropGuidelines}
+	handler := NewAssignTaskHandler(processRepo, taskRepo,
+		WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo), WithAssignTaskPromptLimit(limit))
+
+	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "Implement feature", "")
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	msg, ok := queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.True(t, ok)
+	require.LessOrEqual(t, len(msg.Content), limit.MaxBytes)
+	require.Contains(t, msg.Content, "`fetch_context`")
+}
+
+func TestAssignTaskHandler_RejectsOversizedPromptWithoutStateChange(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignTaskHandler(processRepo, taskRepo,
+		WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo),
+		WithAssignTaskPromptLimit(prompt.PromptLimit{MaxBytes: 100, Strategy: prompt.ErrorOnOverflow}))
+
+	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "", "")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, prompt.ErrPromptTooLarge)
+	_, err = taskRepo.Get("perles-abc1.2")
+	require.ErrorIs(t, err, repository.ErrTaskNotFound, "task should not be created")
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseIdle, *updated.Phase)
+	require.Equal(t, 0, queueRepo.GetOrCreate("worker-1").Size())
+}
+
+// newBatchTestHandler creates an AssignTasksBatchHandler backed by in-memory repositories
+// with the given ready, idle workers.
+func newBatchTestHandler(t *testing.T, workerIDs ...string) (*AssignTasksBatchHandler, *repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).RunAndReturn(func(id string) (*beads.Issue, error) {
+		return &beads.Issue{ID: id, Status: beads.StatusOpen}, nil
+	}).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	for _, id := range workerIDs {
+		processRepo.AddProcess(&repository.Process{
+			ID:        id,
+			Role:      repository.RoleWorker,
+			Status:    repository.StatusReady,
+			Phase:     phasePtr(events.ProcessPhaseIdle),
+			CreatedAt: time.Now(),
+		})
+	}
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	assigner := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo))
+	return NewAssignTasksBatchHandler(assigner), processRepo, taskRepo
+}
+
+func TestAssignTasksBatchHandler_AssignsAll(t *testing.T) {
+	handler, processRepo, taskRepo := newBatchTestHandler(t, "worker-1", "worker-2")
+
+	cmd := command.NewAssignTasksBatchCommand(command.SourceMCPTool, []command.TaskAssignmentItem{
+		{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+		{WorkerID: "worker-2", TaskID: "perles-abc1.2"},
+	})
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	batchResult, ok := result.Data.(*AssignTasksBatchResult)
+	require.True(t, ok, "expected AssignTasksBatchResult, got: %T", result.Data)
+	require.Equal(t, 2, batchResult.Assigned)
+	require.Equal(t, 0, batchResult.Failed)
+	require.Len(t, batchResult.Results, 2)
+	for _, item := range batchResult.Results {
+		require.True(t, item.Success, "expected success for %s: %s", item.TaskID, item.Error)
+	}
+
+	// Each assignment produces its own delivery follow-up
+	require.Len(t, result.FollowUp, 2)
+
+	for _, pair := range [][2]string{{"worker-1", "perles-abc1.1"}, {"worker-2", "perles-abc1.2"}} {
+		proc, _ := processRepo.Get(pair[0])
+		require.Equal(t, pair[1], proc.TaskID)
+		require.Equal(t, events.ProcessPhaseImplementing, *proc.Phase)
+
+		task, err := taskRepo.Get(pair[1])
+		require.NoError(t, err)
+		require.Equal(t, pair[0], task.Implementer)
+	}
+}
+
+func TestAssignTasksBatchHandler_PartialFailure(t *testing.T) {
+	handler, processRepo, taskRepo := newBatchTestHandler(t, "worker-1")
+
+	// worker-2 is busy and cannot take a task
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseImplementing),
+		TaskID:    "perles-xyz9.1",
+		CreatedAt: time.Now(),
+	})
+
+	cmd := command.NewAssignTasksBatchCommand(command.SourceMCPTool, []command.TaskAssignmentItem{
+		{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+		{WorkerID: "worker-2", TaskID: "perles-abc1.2"},
+	})
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	batchResult := result.Data.(*AssignTasksBatchResult)
+	require.Equal(t, 1, batchResult.Assigned)
+	require.Equal(t, 1, batchResult.Failed)
+	require.True(t, batchResult.Results[0].Success)
+	require.False(t, batchResult.Results[1].Success)
+	require.NotEmpty(t, batchResult.Results[1].Error)
+
+	// Only the successful assignment is delivered
+	require.Len(t, result.FollowUp, 1)
+
+	// The failed item leaves no task behind and does not disturb the busy worker
+	_, err = taskRepo.Get("perles-abc1.2")
+	require.Error(t, err)
+	busy, _ := processRepo.Get("worker-2")
+	require.Equal(t, "perles-xyz9.1", busy.TaskID)
+}
+
+func TestAssignTasksBatchHandler_RejectsDuplicateTaskInBatch(t *testing.T) {
+	handler, processRepo, _ := newBatchTestHandler(t, "worker-1", "worker-2")
+
+	cmd := command.NewAssignTasksBatchCommand(command.SourceMCPTool, []command.TaskAssignmentItem{
+		{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+		{WorkerID: "worker-2", TaskID: "perles-abc1.1"},
+	})
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+
+	batchResult := result.Data.(*AssignTasksBatchResult)
+	require.Equal(t, 1, batchResult.Assigned)
+	require.Equal(t, 1, batchResult.Failed)
+	require.True(t, batchResult.Results[0].Success)
+	require.False(t, batchResult.Results[1].Success)
+	require.Contains(t, batchResult.Results[1].Error, "more than once")
+
+	// The second worker stays idle
+	proc, _ := processRepo.Get("worker-2")
+	require.Empty(t, proc.TaskID)
+	require.Equal(t, events.ProcessPhaseIdle, *proc.Phase)
+}
+
+func TestAssignTasksBatchHandler_RejectsDuplicateWorkerInBatch(t *testing.T) {
+	handler, _, _ := newBatchTestHandler(t, "worker-1")
+
+	cmd := command.NewAssignTasksBatchCommand(command.SourceMCPTool, []command.TaskAssignmentItem{
+		{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+		{WorkerID: "worker-1", TaskID: "perles-abc1.2"},
+	})
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+
+	batchResult := result.Data.(*AssignTasksBatchResult)
+	require.Equal(t, 1, batchResult.Assigned)
+	require.Equal(t, 1, batchResult.Failed)
+	require.Contains(t, batchResult.Results[1].Error, "worker worker-1 appears more than once")
+}
+
+func TestAssignTasksBatchHandler_ReportsProgressPerItem(t *testing.T) {
+	base, _, _ := newBatchTestHandler(t, "worker-1", "worker-2")
+	var updates []string
+	handler := NewAssignTasksBatchHandler(base.assigner,
+		WithAssignTasksBatchProgress(ProgressReporterFunc(func(content string) {
+			updates = append(updates, content)
+		})))
+
+	cmd := command.NewAssignTasksBatchCommand(command.SourceMCPTool, []command.TaskAssignmentItem{
+		{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+		{WorkerID: "worker-1", TaskID: "perles-abc1.2"},
+		{WorkerID: "worker-2", TaskID: "perles-abc1.3"},
+	})
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Assigning 3 tasks",
+		"[1/3] Assigned perles-abc1.1 to worker-1",
+		"[2/3] Failed to assign perles-abc1.2 to worker-1: worker worker-1 appears more than once in batch",
+		"[3/3] Assigned perles-abc1.3 to worker-2",
+	}, updates)
+
+	// The final result still carries every item
+	batchResult := result.Data.(*AssignTasksBatchResult)
+	require.Equal(t, 2, batchResult.Assigned)
+	require.Equal(t, 1, batchResult.Failed)
+	require.Len(t, batchResult.Results, 3)
+}
+
+// ===========================================================================
+// GetEligibleWorkersHandler Tests
+// ===========================================================================
+
+func TestGetEligibleWorkersHandler_MixOfEligibleAndIneligible(t *testing.T) {
+	batch, processRepo, taskRepo := newBatchTestHandler(t, "worker-1", "worker-10")
+	handler := NewGetEligibleWorkersHandler(batch.assigner)
+
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		Phase: phasePtr(events.ProcessPhaseImplementing), TaskID: "perles-xyz9.1", CreatedAt: time.Now(),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusReady,
+		Phase: phasePtr(events.ProcessPhaseIdle), AssignableAt: time.Now().Add(time.Minute), CreatedAt: time.Now(),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusReady,
+		Phase: phasePtr(events.ProcessPhaseIdle), CreatedAt: time.Now(),
+	})
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-xyz9.2", Implementer: "worker-4", Status: repository.TaskImplementing,
+	}))
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-5", Role: repository.RoleWorker, Status: repository.StatusRetired, CreatedAt: time.Now(),
+	})
+
+	result, err := handler.Handle(context.Background(), command.NewGetEligibleWorkersCommand(command.SourceMCPTool, "perles-abc1.1"))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	eligible := result.Data.(*GetEligibleWorkersResult)
+	require.Equal(t, "perles-abc1.1", eligible.TaskID)
+	require.Equal(t, []string{"worker-1", "worker-10"}, eligible.Eligible)
+	require.Len(t, eligible.Ineligible, 3, "retired workers are not reported")
+
+	require.Equal(t, "worker-2", eligible.Ineligible[0].WorkerID)
+	require.Equal(t, string(repository.StatusWorking), eligible.Ineligible[0].Status)
+	require.Equal(t, string(events.ProcessPhaseImplementing), eligible.Ineligible[0].Phase)
+	require.Equal(t, types.ErrProcessNotReady.Error(), eligible.Ineligible[0].Reason)
+	require.Equal(t, "worker-3", eligible.Ineligible[1].WorkerID)
+	require.Contains(t, eligible.Ineligible[1].Reason, types.ErrProcessInReadyGrace.Error())
+	require.Equal(t, "worker-4", eligible.Ineligible[2].WorkerID)
+	require.Equal(t, types.ErrProcessAlreadyAssigned.Error(), eligible.Ineligible[2].Reason)
+
+	// Nothing was assigned
+	_, err = taskRepo.Get("perles-abc1.1")
+	require.Error(t, err)
+}
+
+func TestGetEligibleWorkersHandler_HeldTaskFails(t *testing.T) {
+	batch, processRepo, _ := newBatchTestHandler(t, "worker-1")
+	handler := NewGetEligibleWorkersHandler(batch.assigner)
+	processRepo.AddProcess(&repository.Process{
+		ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady,
+		HeldTasks: map[string]string{"perles-abc1.1": "waiting on product"},
+	})
+
+	_, err := handler.Handle(context.Background(), command.NewGetEligibleWorkersCommand(command.SourceMCPTool, "perles-abc1.1"))
+	require.ErrorIs(t, err, types.ErrTaskHeld)
+}
+
+func TestGetEligibleWorkersHandler_MissingIssueFails(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.1").Return(nil, nil)
+	assigner := NewAssignTaskHandler(processRepo, repository.NewMemoryTaskRepository(),
+		WithBDExecutor(bdExecutor), WithQueueRepository(repository.NewMemoryQueueRepository(0)))
+
+	_, err := NewGetEligibleWorkersHandler(assigner).Handle(context.Background(),
+		command.NewGetEligibleWorkersCommand(command.SourceMCPTool, "perles-abc1.1"))
+	require.ErrorContains(t, err, "bd issue not found")
+}
+
+func TestGetEligibleWorkersHandler_ReviewersAreIneligible(t *testing.T) {
+	batch, processRepo, _ := newBatchTestHandler(t, "worker-1")
+	handler := NewGetEligibleWorkersHandler(batch.assigner)
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady, AgentType: roles.AgentTypeReviewer,
+		Phase: phasePtr(events.ProcessPhaseIdle), CreatedAt: time.Now(),
+	})
+
+	result, err := handler.Handle(context.Background(), command.NewGetEligibleWorkersCommand(command.SourceMCPTool, "perles-abc1.1"))
+	require.NoError(t, err)
+
+	eligible := result.Data.(*GetEligibleWorkersResult)
+	require.Equal(t, []string{"worker-1"}, eligible.EligibleWorkerIDs())
+	require.Equal(t, []string{"worker-2"}, eligible.IneligibleWorkerIDs())
+	_, _, reason := eligible.IneligibilityOf("worker-2")
+	require.Contains(t, reason, types.ErrWorkerRoleMismatch.Error())
+}
+
+func TestAssignTaskHandler_AutoPickSkipsReviewers(t *testing.T) {
+	batch, processRepo, _ := newBatchTestHandler(t)
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, AgentType: roles.AgentTypeReviewer,
+		Phase: phasePtr(events.ProcessPhaseIdle), CreatedAt: time.Now().Add(-time.Hour),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady, AgentType: roles.AgentTypeImplementer,
+		Phase: phasePtr(events.ProcessPhaseIdle), CreatedAt: time.Now(),
+	})
+
+	result, err := batch.assigner.Handle(context.Background(), command.NewAssignTaskCommand(command.SourceMCPTool, "", "perles-abc1.1", "", ""))
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", result.Data.(*AssignTaskResult).WorkerID, "the older reviewer is never picked to implement")
+}
+
 // ===========================================================================
 // AssignReviewHandler Tests
 // ===========================================================================
@@ -391,6 +837,7 @@ func TestAssignReviewHandler_AssignsReviewer(t *testing.T) {
 		Implementer: "worker-1",
 		Status:      repository.TaskImplementing,
 		StartedAt:   time.Now(),
+		ThreadID:    "thread-1",
 	}
 	_ = taskRepo.Save(task)
 
@@ -402,6 +849,7 @@ func TestAssignReviewHandler_AssignsReviewer(t *testing.T) {
 
 	require.NoError(t, err)
 	require.True(t, result.Success, "expected success, got failure: %v", result.Error)
+	require.Equal(t, "thread-1", result.Data.(*AssignReviewResult).ThreadID)
 
 	// Verify reviewer was updated - Status stays Ready until delivery
 	updated, _ := processRepo.Get("worker-2")
@@ -426,6 +874,78 @@ func TestAssignReviewHandler_AssignsReviewer(t *testing.T) {
 	require.Equal(t, repository.TaskInReview, updatedTask.Status)
 }
 
+func TestAssignReviewHandler_EnforcesMaxConcurrentReviews(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	// worker-1 is reviewing task-a; worker-3 awaits review of task-b
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		Phase: phasePtr(events.ProcessPhaseReviewing), TaskID: "task-a", CreatedAt: time.Now(),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		Phase: phasePtr(events.ProcessPhaseAwaitingReview), TaskID: "task-b", CreatedAt: time.Now(),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusReady,
+		Phase: phasePtr(events.ProcessPhaseIdle), CreatedAt: time.Now(),
+	})
+	taskA := &repository.TaskAssignment{TaskID: "task-a", Implementer: "worker-2", Reviewer: "worker-1", Status: repository.TaskInReview}
+	_ = taskRepo.Save(taskA)
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "task-b", Implementer: "worker-3", Status: repository.TaskImplementing})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignReviewHandler(processRepo, taskRepo, queueRepo, WithMaxConcurrentReviews(1))
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-4", "task-b", "worker-3", command.ReviewTypeComplex)
+
+	// The cap is reached while task-a is in review
+	_, err := handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, types.ErrReviewCapacityReached)
+	require.Contains(t, err.Error(), "retry")
+
+	reviewer, _ := processRepo.Get("worker-4")
+	require.Equal(t, events.ProcessPhaseIdle, *reviewer.Phase, "reviewer should not be assigned when refused")
+	taskB, _ := taskRepo.Get("task-b")
+	require.Equal(t, repository.TaskImplementing, taskB.Status)
+	require.Equal(t, 0, queueRepo.GetOrCreate("worker-4").Size())
+
+	// Once task-a's review completes the slot is released
+	taskA.Status = repository.TaskApproved
+	_ = taskRepo.Save(taskA)
+
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	taskB, _ = taskRepo.Get("task-b")
+	require.Equal(t, repository.TaskInReview, taskB.Status)
+	require.Equal(t, "worker-4", taskB.Reviewer)
+}
+
+func TestAssignReviewHandler_MaxConcurrentReviewsZeroIsUnlimited(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		Phase: phasePtr(events.ProcessPhaseAwaitingReview), TaskID: "task-c", CreatedAt: time.Now(),
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusReady,
+		Phase: phasePtr(events.ProcessPhaseIdle), CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "task-a", Implementer: "worker-1", Status: repository.TaskInReview})
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "task-b", Implementer: "worker-2", Status: repository.TaskInReview})
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "task-c", Implementer: "worker-3", Status: repository.TaskImplementing})
+
+	handler := NewAssignReviewHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0), WithMaxConcurrentReviews(0))
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-4", "task-c", "worker-3", command.ReviewTypeComplex)
+
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
 func TestAssignReviewHandler_FailsIfReviewerIsImplementer(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -706,6 +1226,117 @@ func TestAssignReviewHandler_UsesSimplePromptForSimpleReviewType(t *testing.T) {
 	require.Contains(t, msg.Content, "CRITICAL: Run the tests", "expected mandatory test execution language")
 }
 
+func TestAssignReviewHandler_IncludesReportedTestResultsInPrompt(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	reviewer := &repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	}
+	processRepo.AddProcess(reviewer)
+
+	task := &repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+		TestResults: &repository.TestResults{Passed: 41, Failed: 1, Output: "FAIL TestParse"},
+	}
+	_ = taskRepo.Save(task)
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignReviewHandler(processRepo, taskRepo, queueRepo)
+
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "worker-1", command.ReviewTypeSimple)
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	msg, _ := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.Contains(t, msg.Content, "Reported Test Results")
+	require.Contains(t, msg.Content, "41 passed")
+	require.Contains(t, msg.Content, "1 failed")
+	require.Contains(t, msg.Content, "FAIL TestParse")
+}
+
+// assignReviewPrompt assigns worker-2 to review task and returns the queued review prompt.
+func assignReviewPrompt(t *testing.T, reviewer *repository.Process, task *repository.TaskAssignment) string {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	processRepo.AddProcess(reviewer)
+	require.NoError(t, taskRepo.Save(task))
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignReviewHandler(processRepo, taskRepo, queueRepo)
+
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, reviewer.ID, task.TaskID, task.Implementer, command.ReviewTypeComplex)
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	msg, _ := queueRepo.GetOrCreate(reviewer.ID).Dequeue()
+	return msg.Content
+}
+
+func TestAssignReviewHandler_IncludesCompletionSummaryAndDiffHint(t *testing.T) {
+	reviewer := &repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	}
+	task := &repository.TaskAssignment{
+		TaskID:            "perles-abc1.2",
+		Implementer:       "worker-1",
+		Status:            repository.TaskDenied,
+		StartedAt:         time.Now(),
+		CompletionSummary: "Fixed the nil check flagged in review",
+		DiffCheckpoint:    &repository.DiffCheckpoint{Round: 1},
+	}
+
+	content := assignReviewPrompt(t, reviewer, task)
+
+	require.Contains(t, content, "Fixed the nil check flagged in review")
+	require.Contains(t, content, "review round 2")
+	require.Contains(t, content, "get_diff_since_last_review")
+	require.Contains(t, content, "report_review_verdict(")
+}
+
+func TestAssignReviewHandler_UsesRegisteredRoleOverride(t *testing.T) {
+	original := roles.Registry[roles.AgentTypeReviewer]
+	t.Cleanup(func() { roles.Registry[roles.AgentTypeReviewer] = original })
+
+	override := original
+	override.ReviewAssignmentPrompt = func(taskID, implementerID, summary, diffHint string) string {
+		return "custom review of " + taskID + " by " + implementerID + ": " + summary
+	}
+	roles.Registry[roles.AgentTypeReviewer] = override
+
+	reviewer := &repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		AgentType: roles.AgentTypeReviewer,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	}
+	task := &repository.TaskAssignment{
+		TaskID:            "perles-abc1.2",
+		Implementer:       "worker-1",
+		Status:            repository.TaskImplementing,
+		StartedAt:         time.Now(),
+		CompletionSummary: "Added the parser",
+	}
+
+	content := assignReviewPrompt(t, reviewer, task)
+
+	require.Equal(t, "custom review of perles-abc1.2 by worker-1: Added the parser", content)
+}
+
 func TestAssignReviewHandler_UsesComplexPromptForComplexReviewType(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -833,22 +1464,104 @@ func TestApproveCommitHandler_TransitionsToCommitting(t *testing.T) {
 	require.Equal(t, repository.TaskCommitting, updatedTask.Status)
 }
 
-func TestApproveCommitHandler_FailsIfNotApproved(t *testing.T) {
+// setupApproveWithTestResults seeds an approved task with the given test results
+// and an implementer awaiting review.
+func setupApproveWithTestResults(t *testing.T, results *repository.TestResults) (*repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
 
-	implementer := &repository.Process{
+	processRepo.AddProcess(&repository.Process{
 		ID:        "worker-1",
 		Role:      repository.RoleWorker,
 		Status:    repository.StatusWorking,
 		Phase:     phasePtr(events.ProcessPhaseAwaitingReview),
 		TaskID:    "perles-abc1.2",
 		CreatedAt: time.Now(),
-	}
-	processRepo.AddProcess(implementer)
-
-	// Task is in review, not approved
-	task := &repository.TaskAssignment{
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-2",
+		Status:      repository.TaskApproved,
+		StartedAt:   time.Now(),
+		TestResults: results,
+	})
+	return processRepo, taskRepo
+}
+
+func TestApproveCommitHandler_RequirePassingTests_RejectsFailures(t *testing.T) {
+	processRepo, taskRepo := setupApproveWithTestResults(t, &repository.TestResults{Passed: 10, Failed: 2})
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewApproveCommitHandler(processRepo, taskRepo, queueRepo, WithRequirePassingTests(true))
+
+	cmd := command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrTestsFailing)
+
+	// State unchanged
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, repository.TaskApproved, task.Status)
+	require.Equal(t, 0, queueRepo.GetOrCreate("worker-1").Size())
+}
+
+func TestApproveCommitHandler_RequirePassingTests_AllowsOverride(t *testing.T) {
+	processRepo, taskRepo := setupApproveWithTestResults(t, &repository.TestResults{Passed: 10, Failed: 2})
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewApproveCommitHandler(processRepo, taskRepo, queueRepo, WithRequirePassingTests(true))
+
+	cmd := command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2")
+	cmd.AllowFailingTests = true
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
+func TestApproveCommitHandler_RequirePassingTests_AllowsPassingAndUnreported(t *testing.T) {
+	for name, results := range map[string]*repository.TestResults{
+		"passing":    {Passed: 10, Failed: 0},
+		"unreported": nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			processRepo, taskRepo := setupApproveWithTestResults(t, results)
+			handler := NewApproveCommitHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0), WithRequirePassingTests(true))
+
+			cmd := command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2")
+			_, err := handler.Handle(context.Background(), cmd)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApproveCommitHandler_IgnoresFailuresWhenPolicyOff(t *testing.T) {
+	processRepo, taskRepo := setupApproveWithTestResults(t, &repository.TestResults{Passed: 10, Failed: 2})
+	handler := NewApproveCommitHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0))
+
+	cmd := command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+}
+
+func TestApproveCommitHandler_FailsIfNotApproved(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	implementer := &repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseAwaitingReview),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	}
+	processRepo.AddProcess(implementer)
+
+	// Task is in review, not approved
+	task := &repository.TaskAssignment{
 		TaskID:      "perles-abc1.2",
 		Implementer: "worker-1",
 		Reviewer:    "worker-2",
@@ -1260,6 +1973,77 @@ func TestAssignReviewFeedbackHandler_TransitionsToAddressingFeedback(t *testing.
 	require.Equal(t, 1, queue.Size())
 }
 
+// newDeniedTask stores worker-1 awaiting review on perles-abc1.2, denied by worker-2.
+func newDeniedTask(processRepo *repository.MemoryProcessRepository, taskRepo repository.TaskRepository) {
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseAwaitingReview),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-2",
+		Status:      repository.TaskDenied,
+		StartedAt:   time.Now(),
+	})
+}
+
+func TestAssignReviewFeedbackHandler_RecordsFeedbackItems(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	newDeniedTask(processRepo, taskRepo)
+
+	// Items from an earlier round are replaced
+	task, _ := taskRepo.Get("perles-abc1.2")
+	task.FeedbackItems = repository.NewFeedbackItems([]string{"Old item"})
+
+	cmd := command.NewAssignReviewFeedbackCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "")
+	cmd.Items = []string{"Handle the nil case", "Add a regression test"}
+	result, err := NewAssignReviewFeedbackHandler(processRepo, taskRepo, queueRepo).Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Data.(*AssignReviewFeedbackResult).ItemCount)
+
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, []repository.FeedbackItem{
+		{ID: 1, Text: "Handle the nil case"},
+		{ID: 2, Text: "Add a regression test"},
+	}, updatedTask.FeedbackItems)
+
+	// The prompt lists the items as a numbered checklist
+	entry, ok := queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "1. Handle the nil case")
+	require.Contains(t, entry.Content, "2. Add a regression test")
+	require.Contains(t, entry.Content, "mark_feedback_addressed")
+}
+
+func TestAssignReviewFeedbackHandler_FreeTextClearsFeedbackItems(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	newDeniedTask(processRepo, taskRepo)
+	task, _ := taskRepo.Get("perles-abc1.2")
+	task.FeedbackItems = repository.NewFeedbackItems([]string{"Old item"})
+
+	_, err := NewAssignReviewFeedbackHandler(processRepo, taskRepo, queueRepo).Handle(context.Background(),
+		command.NewAssignReviewFeedbackCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "Please fix the error handling"))
+
+	require.NoError(t, err)
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Nil(t, updatedTask.FeedbackItems)
+
+	entry, ok := queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "Please fix the error handling")
+	require.NotContains(t, entry.Content, "mark_feedback_addressed")
+}
+
 func TestAssignReviewFeedbackHandler_FailsIfNotDenied(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -1336,3 +2120,571 @@ func TestAssignReviewFeedbackHandler_PanicsIfQueueRepoNil(t *testing.T) {
 		NewAssignReviewFeedbackHandler(processRepo, taskRepo, nil)
 	}, "expected panic when queueRepo is nil")
 }
+
+// ===========================================================================
+// TransferTaskHandler Tests
+// ===========================================================================
+
+// setupTransferTask seeds worker-1 addressing feedback on perles-abc1.2 and returns the repos.
+func setupTransferTask(t *testing.T) (*repository.MemoryProcessRepository, *repository.MemoryTaskRepository, *repository.MemoryQueueRepository) {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseAddressingFeedback),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-3",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+		ThreadID:    "thread-42",
+	}))
+
+	return processRepo, taskRepo, queueRepo
+}
+
+func TestTransferTaskHandler_MovesTaskToReadyWorker(t *testing.T) {
+	processRepo, taskRepo, queueRepo := setupTransferTask(t)
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+
+	handler := NewTransferTaskHandler(processRepo, taskRepo, queueRepo)
+	cmd := command.NewTransferTaskCommand(command.SourceMCPTool, "perles-abc1.2", "worker-1", "worker-2", "Tests in pkg/foo still fail")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Len(t, result.FollowUp, 2)
+
+	// Receiving worker inherits the task and phase
+	to, _ := processRepo.Get("worker-2")
+	require.Equal(t, "perles-abc1.2", to.TaskID)
+	require.Equal(t, events.ProcessPhaseAddressingFeedback, *to.Phase)
+
+	// Previous worker is released
+	from, _ := processRepo.Get("worker-1")
+	require.Empty(t, from.TaskID)
+	require.Equal(t, events.ProcessPhaseIdle, *from.Phase)
+
+	// Task records the new implementer and transfer note; review state is kept
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, "worker-2", task.Implementer)
+	require.Equal(t, "worker-3", task.Reviewer)
+	require.Equal(t, "worker-1", task.TransferredFrom)
+	require.Equal(t, "Tests in pkg/foo still fail", task.TransferNote)
+	require.Equal(t, repository.TaskImplementing, task.Status)
+
+	// Handoff prompt queued for the receiving worker with context
+	entry, ok := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "[TASK TRANSFER]")
+	require.Contains(t, entry.Content, "thread-42")
+	require.Contains(t, entry.Content, "Tests in pkg/foo still fail")
+
+	// Stop notice queued for the previous worker
+	entry, ok = queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "[TASK TRANSFERRED]")
+}
+
+func TestTransferTaskHandler_RejectsBusyTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  *repository.Process
+		wantErr error
+	}{
+		{
+			name: "working",
+			target: &repository.Process{
+				ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking,
+				Phase: phasePtr(events.ProcessPhaseIdle),
+			},
+			wantErr: types.ErrProcessNotReady,
+		},
+		{
+			name: "reviewing",
+			target: &repository.Process{
+				ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady,
+				Phase: phasePtr(events.ProcessPhaseReviewing),
+			},
+			wantErr: types.ErrProcessNotIdle,
+		},
+		{
+			name: "has task",
+			target: &repository.Process{
+				ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady,
+				TaskID: "perles-abc1.9",
+			},
+			wantErr: types.ErrProcessAlreadyAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processRepo, taskRepo, queueRepo := setupTransferTask(t)
+			processRepo.AddProcess(tt.target)
+
+			handler := NewTransferTaskHandler(processRepo, taskRepo, queueRepo)
+			cmd := command.NewTransferTaskCommand(command.SourceMCPTool, "perles-abc1.2", "worker-1", "worker-2", "")
+			_, err := handler.Handle(context.Background(), cmd)
+
+			require.ErrorIs(t, err, tt.wantErr)
+
+			// Nothing moved
+			task, _ := taskRepo.Get("perles-abc1.2")
+			require.Equal(t, "worker-1", task.Implementer)
+			from, _ := processRepo.Get("worker-1")
+			require.Equal(t, "perles-abc1.2", from.TaskID)
+			require.Equal(t, 0, queueRepo.GetOrCreate("worker-2").Size())
+		})
+	}
+}
+
+func TestTransferTaskHandler_RejectsWrongFromWorker(t *testing.T) {
+	processRepo, taskRepo, queueRepo := setupTransferTask(t)
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady,
+	})
+
+	handler := NewTransferTaskHandler(processRepo, taskRepo, queueRepo)
+	cmd := command.NewTransferTaskCommand(command.SourceMCPTool, "perles-abc1.2", "worker-2", "worker-1", "")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrProcessNotImplementer)
+}
+
+func TestTransferTaskHandler_RejectsCompletedTask(t *testing.T) {
+	processRepo, taskRepo, queueRepo := setupTransferTask(t)
+	task, _ := taskRepo.Get("perles-abc1.2")
+	task.Status = repository.TaskCompleted
+	require.NoError(t, taskRepo.Save(task))
+
+	handler := NewTransferTaskHandler(processRepo, taskRepo, queueRepo)
+	cmd := command.NewTransferTaskCommand(command.SourceMCPTool, "perles-abc1.2", "worker-1", "worker-2", "")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorContains(t, err, "cannot be transferred")
+}
+
+// ===========================================================================
+// DrainWorkerHandler Tests
+// ===========================================================================
+
+// retireFollowUp returns the RetireProcess follow-up in result, failing if there is none.
+func retireFollowUp(t *testing.T, result *command.CommandResult) *command.RetireProcessCommand {
+	t.Helper()
+	for _, followUp := range result.FollowUp {
+		if retire, ok := followUp.(*command.RetireProcessCommand); ok {
+			return retire
+		}
+	}
+	require.Fail(t, "expected a RetireProcess follow-up")
+	return nil
+}
+
+func TestDrainWorkerHandler_TransfersTasksAndRetires(t *testing.T) {
+	processRepo, taskRepo, queueRepo := setupTransferTask(t)
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+	// worker-1 is also reviewing another task, and implemented one that is finished
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc1.3", Implementer: "worker-4", Reviewer: "worker-1", Status: repository.TaskInReview,
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc1.1", Implementer: "worker-1", Status: repository.TaskCompleted,
+	}))
+
+	handler := NewDrainWorkerHandler(NewTransferTaskHandler(processRepo, taskRepo, queueRepo))
+	result, err := handler.Handle(context.Background(), command.NewDrainWorkerCommand(command.SourceMCPTool, "worker-1", "scaling down"))
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	drained := result.Data.(*DrainWorkerResult)
+	require.Equal(t, "worker-1", drained.WorkerID)
+	require.Equal(t, 1, drained.Transferred)
+	require.Equal(t, 1, drained.Orphaned)
+	require.Equal(t, []DrainedTask{
+		{TaskID: "perles-abc1.2", Outcome: DrainOutcomeTransferred, ToWorkerID: "worker-2"},
+		{TaskID: "perles-abc1.3", Outcome: DrainOutcomeOrphaned, Reason: "worker was reviewing this task; reassign the review with assign_task_review"},
+	}, drained.Tasks)
+
+	// The task moved to the ready worker with the drain reason as its handoff note
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, "worker-2", task.Implementer)
+	require.Equal(t, "worker-1 is being drained: scaling down", task.TransferNote)
+	entry, ok := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "[TASK TRANSFER]")
+
+	// The drained worker is retired, not woken for the transfer notice
+	require.Zero(t, queueRepo.Size("worker-1"))
+	for _, followUp := range result.FollowUp {
+		if deliver, ok := followUp.(*command.DeliverProcessQueuedCommand); ok {
+			require.NotEqual(t, "worker-1", deliver.ProcessID)
+		}
+	}
+	retire := retireFollowUp(t, result)
+	require.Equal(t, "worker-1", retire.ProcessID)
+	require.Equal(t, "scaling down", retire.Reason)
+}
+
+func TestDrainWorkerHandler_NoReadyWorkerLeavesTaskOrphaned(t *testing.T) {
+	processRepo, taskRepo, queueRepo := setupTransferTask(t)
+	// A ready worker still in its grace period cannot take over
+	processRepo.AddProcess(&repository.Process{
+		ID:           "worker-2",
+		Role:         repository.RoleWorker,
+		Status:       repository.StatusReady,
+		Phase:        phasePtr(events.ProcessPhaseIdle),
+		AssignableAt: time.Now().Add(time.Hour),
+	})
+
+	handler := NewDrainWorkerHandler(NewTransferTaskHandler(processRepo, taskRepo, queueRepo))
+	result, err := handler.Handle(context.Background(), command.NewDrainWorkerCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.NoError(t, err)
+	drained := result.Data.(*DrainWorkerResult)
+	require.Equal(t, 0, drained.Transferred)
+	require.Equal(t, []DrainedTask{
+		{TaskID: "perles-abc1.2", Outcome: DrainOutcomeOrphaned, Reason: "no ready worker to take over the task"},
+	}, drained.Tasks)
+
+	// The task stays with the drained worker for list_orphaned_tasks to surface
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, "worker-1", task.Implementer)
+
+	retire := retireFollowUp(t, result)
+	require.Equal(t, "worker-1", retire.ProcessID)
+	require.Equal(t, "drained", retire.Reason)
+}
+
+func TestDrainWorkerHandler_RejectsNonWorker(t *testing.T) {
+	processRepo, taskRepo, queueRepo := setupTransferTask(t)
+	processRepo.AddProcess(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady})
+	handler := NewDrainWorkerHandler(NewTransferTaskHandler(processRepo, taskRepo, queueRepo))
+
+	_, err := handler.Handle(context.Background(), command.NewDrainWorkerCommand(command.SourceMCPTool, repository.CoordinatorID, ""))
+	require.ErrorContains(t, err, "not a worker")
+
+	_, err = handler.Handle(context.Background(), command.NewDrainWorkerCommand(command.SourceMCPTool, "worker-9", ""))
+	require.ErrorIs(t, err, ErrProcessNotFound)
+}
+
+// ===========================================================================
+// ReportTestResultsHandler Tests
+// ===========================================================================
+
+func TestReportTestResultsHandler_AttachesResultsToTask(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseImplementing),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+	})
+
+	handler := NewReportTestResultsHandler(processRepo, taskRepo)
+	cmd := command.NewReportTestResultsCommand(command.SourceMCPTool, "worker-1", 12, 3, "FAIL TestFoo")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	res := result.Data.(*ReportTestResultsResult)
+	require.Equal(t, "perles-abc1.2", res.TaskID)
+	require.Equal(t, 3, res.Failed)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.NotNil(t, task.TestResults)
+	require.Equal(t, 12, task.TestResults.Passed)
+	require.Equal(t, 3, task.TestResults.Failed)
+	require.Equal(t, "FAIL TestFoo", task.TestResults.Output)
+	require.False(t, task.TestResults.ReportedAt.IsZero())
+}
+
+func TestReportTestResultsHandler_ReplacesPreviousResults(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		TaskID: "perles-abc1.2",
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		TestResults: &repository.TestResults{Passed: 5, Failed: 5, Output: "old"},
+	})
+
+	handler := NewReportTestResultsHandler(processRepo, taskRepo)
+	_, err := handler.Handle(context.Background(),
+		command.NewReportTestResultsCommand(command.SourceMCPTool, "worker-1", 10, 0, ""))
+	require.NoError(t, err)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, 10, task.TestResults.Passed)
+	require.Equal(t, 0, task.TestResults.Failed)
+	require.Empty(t, task.TestResults.Output)
+}
+
+func TestReportTestResultsHandler_FailsWithoutTask(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+	})
+
+	handler := NewReportTestResultsHandler(processRepo, taskRepo)
+	_, err := handler.Handle(context.Background(),
+		command.NewReportTestResultsCommand(command.SourceMCPTool, "worker-1", 1, 0, ""))
+
+	require.ErrorIs(t, err, types.ErrNoTaskAssigned)
+}
+
+func TestReportTestResultsHandler_FailsForUnknownProcess(t *testing.T) {
+	handler := NewReportTestResultsHandler(repository.NewMemoryProcessRepository(), repository.NewMemoryTaskRepository())
+	_, err := handler.Handle(context.Background(),
+		command.NewReportTestResultsCommand(command.SourceMCPTool, "worker-99", 1, 0, ""))
+
+	require.ErrorIs(t, err, ErrProcessNotFound)
+}
+
+// ===========================================================================
+// MarkFeedbackAddressedHandler Tests
+// ===========================================================================
+
+// newTaskWithFeedbackItems stores worker-1 addressing three feedback items on perles-abc1.2.
+func newTaskWithFeedbackItems(processRepo *repository.MemoryProcessRepository, taskRepo repository.TaskRepository) {
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseAddressingFeedback),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:        "perles-abc1.2",
+		Implementer:   "worker-1",
+		Reviewer:      "worker-2",
+		Status:        repository.TaskImplementing,
+		FeedbackItems: repository.NewFeedbackItems([]string{"Handle the nil case", "Add a regression test", "Rename the helper"}),
+	})
+}
+
+func TestMarkFeedbackAddressedHandler_MarksItems(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+	handler := NewMarkFeedbackAddressedHandler(processRepo, taskRepo)
+
+	result, err := handler.Handle(context.Background(),
+		command.NewMarkFeedbackAddressedCommand(command.SourceMCPTool, "worker-1", []int{1, 3}))
+
+	require.NoError(t, err)
+	markResult := result.Data.(*MarkFeedbackAddressedResult)
+	require.Equal(t, []string{"2. Add a regression test"}, markResult.UnaddressedFeedbackItems())
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.True(t, task.FeedbackItems[0].Addressed)
+	require.False(t, task.FeedbackItems[1].Addressed)
+	require.True(t, task.FeedbackItems[2].Addressed)
+
+	// Marking an already addressed item again is a no-op
+	result, err = handler.Handle(context.Background(),
+		command.NewMarkFeedbackAddressedCommand(command.SourceMCPTool, "worker-1", []int{1, 2}))
+	require.NoError(t, err)
+	require.Empty(t, result.Data.(*MarkFeedbackAddressedResult).Unaddressed)
+}
+
+func TestMarkFeedbackAddressedHandler_UnknownItemLeavesTaskUnchanged(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+
+	_, err := NewMarkFeedbackAddressedHandler(processRepo, taskRepo).Handle(context.Background(),
+		command.NewMarkFeedbackAddressedCommand(command.SourceMCPTool, "worker-1", []int{1, 4}))
+
+	require.ErrorContains(t, err, "feedback item 4 not found on task perles-abc1.2")
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Len(t, task.UnaddressedFeedback(), 3)
+}
+
+func TestMarkFeedbackAddressedHandler_FailsWithoutFeedbackItems(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+	task, _ := taskRepo.Get("perles-abc1.2")
+	task.FeedbackItems = nil
+
+	_, err := NewMarkFeedbackAddressedHandler(processRepo, taskRepo).Handle(context.Background(),
+		command.NewMarkFeedbackAddressedCommand(command.SourceMCPTool, "worker-1", []int{1}))
+
+	require.ErrorContains(t, err, "has no feedback items")
+}
+
+func TestMarkFeedbackAddressedHandler_FailsForReviewer(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseReviewing),
+		TaskID: "perles-abc1.2",
+	})
+
+	_, err := NewMarkFeedbackAddressedHandler(processRepo, taskRepo).Handle(context.Background(),
+		command.NewMarkFeedbackAddressedCommand(command.SourceMCPTool, "worker-2", []int{1}))
+
+	require.ErrorIs(t, err, types.ErrProcessNotImplementer)
+}
+
+func TestAssignTaskHandler_AppendsToolReminder(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo),
+		WithAssignTaskToolReminder([]prompt.ToolHint{{Name: "fabric_inbox", Usage: "Get unread messages."}}))
+
+	_, err := handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "Implement feature", ""))
+	require.NoError(t, err)
+
+	entry, _ := queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.Contains(t, entry.Content, "## Your Tools")
+	require.Contains(t, entry.Content, "- `fabric_inbox`: Get unread messages.")
+}
+
+// ===========================================================================
+// CheckpointHandler Tests
+// ===========================================================================
+
+func TestCheckpointHandler_SnapshotsWIPAndRecordsHash(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().HasUncommittedChanges().Return(true, nil).Twice()
+	author := domaingit.Author{Name: "perles-worker", Email: "workflow@bot"}
+	gitExec.EXPECT().SnapshotWorktree("refs/perles/checkpoints/perles-abc1.2/1", "[WIP] perles-abc1.2: parser done, tests next", author).Return("abc123", nil).Once()
+	gitExec.EXPECT().SnapshotWorktree("refs/perles/checkpoints/perles-abc1.2/2", "[WIP] perles-abc1.2: checkpoint", author).Return("def456", nil).Once()
+	handler := NewCheckpointHandler(processRepo, taskRepo, gitExec, WithCheckpointCommitAuthor("perles-worker <workflow@bot>"))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewCheckpointCommand(command.SourceMCPTool, "worker-1", "parser done, tests next"))
+	require.NoError(t, err)
+	require.Equal(t, &CheckpointResult{WorkerID: "worker-1", TaskID: "perles-abc1.2", Hash: "abc123", Ref: "refs/perles/checkpoints/perles-abc1.2/1"}, result.Data)
+
+	_, err = handler.Handle(context.Background(), command.NewCheckpointCommand(command.SourceMCPTool, "worker-1", ""))
+	require.NoError(t, err)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, []string{"abc123", "def456"}, task.Checkpoints)
+}
+
+func TestCheckpointHandler_CleanWorktreeRecordsNothing(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().HasUncommittedChanges().Return(false, nil).Once()
+
+	result, err := NewCheckpointHandler(processRepo, taskRepo, gitExec).Handle(context.Background(),
+		command.NewCheckpointCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.NoError(t, err)
+	require.Empty(t, result.Data.(*CheckpointResult).Hash)
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Empty(t, task.Checkpoints)
+}
+
+func TestCheckpointHandler_SnapshotFailureRecordsNothing(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().HasUncommittedChanges().Return(true, nil).Once()
+	gitExec.EXPECT().SnapshotWorktree(mock.Anything, mock.Anything, domaingit.Author{}).Return("", errors.New("index.lock exists")).Once()
+
+	_, err := NewCheckpointHandler(processRepo, taskRepo, gitExec).Handle(context.Background(),
+		command.NewCheckpointCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.ErrorContains(t, err, "failed to snapshot checkpoint: index.lock exists")
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Empty(t, task.Checkpoints)
+}
+
+func TestCheckpointHandler_FailsWithoutWorktree(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+
+	_, err := NewCheckpointHandler(processRepo, taskRepo, nil).Handle(context.Background(),
+		command.NewCheckpointCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.ErrorIs(t, err, ErrCheckpointsDisabled)
+}
+
+func TestCheckpointHandler_FailsForReviewer(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	newTaskWithFeedbackItems(processRepo, taskRepo)
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseReviewing),
+		TaskID: "perles-abc1.2",
+	})
+
+	_, err := NewCheckpointHandler(processRepo, taskRepo, mocks.NewMockGitExecutor(t)).Handle(context.Background(),
+		command.NewCheckpointCommand(command.SourceMCPTool, "worker-2", ""))
+
+	require.ErrorIs(t, err, types.ErrProcessNotImplementer)
+}
@@ -0,0 +1,98 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains handlers for task hold commands: HoldTask and ReleaseTask.
+package handler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// TaskHoldResult contains the tasks on hold after a hold or release.
+type TaskHoldResult struct {
+	TaskID    string
+	HeldTasks map[string]string
+}
+
+// GetHeldTasks returns the held task IDs mapped to their reasons after the change.
+func (r *TaskHoldResult) GetHeldTasks() map[string]string {
+	return r.HeldTasks
+}
+
+// ===========================================================================
+// HoldTaskHandler
+// ===========================================================================
+
+// HoldTaskHandler handles CmdHoldTask commands.
+// It records the hold on the coordinator process so AssignTaskHandler refuses to assign
+// the task until it is released.
+type HoldTaskHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewHoldTaskHandler creates a new HoldTaskHandler.
+func NewHoldTaskHandler(processRepo repository.ProcessRepository) *HoldTaskHandler {
+	return &HoldTaskHandler{processRepo: processRepo}
+}
+
+// Handle processes a HoldTaskCommand.
+// Holding an already held task replaces its reason.
+func (h *HoldTaskHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	holdCmd := cmd.(*command.HoldTaskCommand)
+
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coordinator: %w", err)
+	}
+
+	if coord.HeldTasks == nil {
+		coord.HeldTasks = make(map[string]string)
+	}
+	coord.HeldTasks[holdCmd.TaskID] = strings.TrimSpace(holdCmd.Reason)
+
+	if err := h.processRepo.Save(coord); err != nil {
+		return nil, fmt.Errorf("failed to save coordinator: %w", err)
+	}
+
+	return SuccessResult(&TaskHoldResult{TaskID: holdCmd.TaskID, HeldTasks: maps.Clone(coord.HeldTasks)}), nil
+}
+
+// ===========================================================================
+// ReleaseTaskHandler
+// ===========================================================================
+
+// ReleaseTaskHandler handles CmdReleaseTask commands.
+type ReleaseTaskHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewReleaseTaskHandler creates a new ReleaseTaskHandler.
+func NewReleaseTaskHandler(processRepo repository.ProcessRepository) *ReleaseTaskHandler {
+	return &ReleaseTaskHandler{processRepo: processRepo}
+}
+
+// Handle processes a ReleaseTaskCommand.
+// Returns an error if the task is not on hold.
+func (h *ReleaseTaskHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	releaseCmd := cmd.(*command.ReleaseTaskCommand)
+
+	coord, err := h.processRepo.GetCoordinator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coordinator: %w", err)
+	}
+
+	if _, held := coord.HeldTasks[releaseCmd.TaskID]; !held {
+		return nil, fmt.Errorf("task %s is not on hold", releaseCmd.TaskID)
+	}
+	delete(coord.HeldTasks, releaseCmd.TaskID)
+
+	if err := h.processRepo.Save(coord); err != nil {
+		return nil, fmt.Errorf("failed to save coordinator: %w", err)
+	}
+
+	return SuccessResult(&TaskHoldResult{TaskID: releaseCmd.TaskID, HeldTasks: maps.Clone(coord.HeldTasks)}), nil
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ===========================================================================
+// Task Hold Handler Tests
+// ===========================================================================
+
+func holdTask(t *testing.T, processRepo repository.ProcessRepository, taskID, reason string) *TaskHoldResult {
+	t.Helper()
+	result, err := NewHoldTaskHandler(processRepo).Handle(context.Background(),
+		command.NewHoldTaskCommand(command.SourceMCPTool, taskID, reason))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	return result.Data.(*TaskHoldResult)
+}
+
+func TestHoldTaskHandler_RecordsHoldOnCoordinator(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+
+	holdTask(t, processRepo, "perles-abc1.1", "  waiting on product  ")
+	got := holdTask(t, processRepo, "perles-abc1.2", "")
+	require.Equal(t, map[string]string{"perles-abc1.1": "waiting on product", "perles-abc1.2": ""}, got.GetHeldTasks())
+
+	coord, err := processRepo.GetCoordinator()
+	require.NoError(t, err)
+	require.Equal(t, got.HeldTasks, coord.HeldTasks)
+}
+
+func TestReleaseTaskHandler_RemovesHold(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+	holdTask(t, processRepo, "perles-abc1.1", "waiting on product")
+
+	result, err := NewReleaseTaskHandler(processRepo).Handle(context.Background(),
+		command.NewReleaseTaskCommand(command.SourceMCPTool, "perles-abc1.1"))
+	require.NoError(t, err)
+	require.Empty(t, result.Data.(*TaskHoldResult).HeldTasks)
+
+	_, err = NewReleaseTaskHandler(processRepo).Handle(context.Background(),
+		command.NewReleaseTaskCommand(command.SourceMCPTool, "perles-abc1.1"))
+	require.ErrorContains(t, err, "perles-abc1.1 is not on hold")
+}
+
+func TestAssignTaskHandler_SkipsHeldTaskUntilReleased(t *testing.T) {
+	processRepo := newGlobalInstructionRepo()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+	assigner := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo))
+
+	holdTask(t, processRepo, "perles-abc1.1", "pending design decision")
+
+	// Neither an explicit nor an auto-selected assignment picks up the held task
+	_, err := assigner.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "Implement feature", ""))
+	require.ErrorIs(t, err, types.ErrTaskHeld)
+	require.ErrorContains(t, err, "pending design decision")
+	_, err = assigner.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "", "perles-abc1.1", "Implement feature", ""))
+	require.ErrorIs(t, err, types.ErrTaskHeld)
+
+	batch, err := NewAssignTasksBatchHandler(assigner).Handle(context.Background(),
+		command.NewAssignTasksBatchCommand(command.SourceMCPTool, []command.TaskAssignmentItem{
+			{WorkerID: "worker-1", TaskID: "perles-abc1.1"},
+		}))
+	require.NoError(t, err)
+	require.Equal(t, 1, batch.Data.(*AssignTasksBatchResult).Failed)
+
+	proc, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Empty(t, proc.TaskID, "the worker stays free for other tasks")
+
+	// Releasing the hold restores eligibility
+	_, err = NewReleaseTaskHandler(processRepo).Handle(context.Background(),
+		command.NewReleaseTaskCommand(command.SourceMCPTool, "perles-abc1.1"))
+	require.NoError(t, err)
+
+	result, err := assigner.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "Implement feature", ""))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
@@ -22,6 +22,10 @@ func (m *mockHeadlessClient) Type() client.ClientType {
 	return m.clientType
 }
 
+func (m *mockHeadlessClient) Capabilities() client.Capabilities {
+	return client.Capabilities{SupportsResume: true}
+}
+
 func (m *mockHeadlessClient) Spawn(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
 	if m.spawnErr != nil {
 		return nil, m.spawnErr
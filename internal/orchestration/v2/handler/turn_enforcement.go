@@ -45,7 +45,7 @@ type TurnCompletionEnforcer interface {
 
 	// ResetTurn clears tracking state for a new turn.
 	// Called when a turn starts (message delivery to worker).
-	// Clears the tool call set, retry count, and newly spawned flag.
+	// Clears the tool call set, retry count, newly spawned and keepalive flags.
 	ResetTurn(processID string)
 
 	// MarkAsNewlySpawned marks a process as newly spawned.
@@ -62,6 +62,14 @@ type TurnCompletionEnforcer interface {
 	// First turns are exempt from enforcement (workers call fabric_join).
 	IsNewlySpawned(processID string) bool
 
+	// MarkAsKeepalive marks the process's current turn as a keepalive turn.
+	// Keepalive turns are exempt from enforcement.
+	// Called from DeliverProcessQueuedHandler when it delivers a keepalive prompt.
+	MarkAsKeepalive(processID string)
+
+	// IsKeepalive returns true if the process's current turn is a keepalive turn.
+	IsKeepalive(processID string) bool
+
 	// ShouldRetry returns true if enforcement retry is allowed.
 	// Returns false if max retries exceeded (prevents infinite loops).
 	ShouldRetry(processID string) bool
@@ -101,6 +109,10 @@ type TurnCompletionTracker struct {
 	// True if this is the process's first turn after spawn.
 	newlySpawned map[string]bool
 
+	// keepalive maps processID → keepalive turn flag.
+	// True if the current turn was started by a keepalive prompt.
+	keepalive map[string]bool
+
 	// mu protects all map operations.
 	mu sync.RWMutex
 
@@ -115,6 +127,7 @@ func NewTurnCompletionTracker() *TurnCompletionTracker {
 		callsThisTurn: make(map[string]map[string]bool),
 		retryCount:    make(map[string]int),
 		newlySpawned:  make(map[string]bool),
+		keepalive:     make(map[string]bool),
 	}
 }
 
@@ -161,6 +174,9 @@ func (t *TurnCompletionTracker) ResetTurn(processID string) {
 
 	// Clear newly spawned flag after first turn
 	delete(t.newlySpawned, processID)
+
+	// Clear keepalive flag; the caller marks the new turn if it is a keepalive
+	delete(t.keepalive, processID)
 }
 
 // MarkAsNewlySpawned marks a process as newly spawned.
@@ -205,6 +221,22 @@ func (t *TurnCompletionTracker) IsNewlySpawned(processID string) bool {
 	return t.newlySpawned[processID]
 }
 
+// MarkAsKeepalive marks the process's current turn as a keepalive turn.
+func (t *TurnCompletionTracker) MarkAsKeepalive(processID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.keepalive[processID] = true
+}
+
+// IsKeepalive returns true if the process's current turn is a keepalive turn.
+func (t *TurnCompletionTracker) IsKeepalive(processID string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.keepalive[processID]
+}
+
 // ShouldRetry returns true if enforcement retry is allowed.
 func (t *TurnCompletionTracker) ShouldRetry(processID string) bool {
 	t.mu.RLock()
@@ -251,6 +283,7 @@ func (t *TurnCompletionTracker) CleanupProcess(processID string) {
 	delete(t.callsThisTurn, processID)
 	delete(t.retryCount, processID)
 	delete(t.newlySpawned, processID)
+	delete(t.keepalive, processID)
 }
 
 // Ensure TurnCompletionTracker implements TurnCompletionEnforcer.
@@ -179,6 +179,31 @@ func TestIsNewlySpawned_ReturnsFalseAfterReset(t *testing.T) {
 	assert.False(t, tracker.IsNewlySpawned("worker-1"))
 }
 
+// ===========================================================================
+// IsKeepalive Tests
+// ===========================================================================
+
+func TestIsKeepalive_ReturnsTrueForMarkedTurn(t *testing.T) {
+	tracker := handler.NewTurnCompletionTracker()
+
+	tracker.MarkAsKeepalive("worker-1")
+
+	assert.True(t, tracker.IsKeepalive("worker-1"))
+	assert.False(t, tracker.IsKeepalive("worker-2"))
+}
+
+func TestIsKeepalive_ReturnsFalseAfterResetAndCleanup(t *testing.T) {
+	tracker := handler.NewTurnCompletionTracker()
+
+	tracker.MarkAsKeepalive("worker-1")
+	tracker.ResetTurn("worker-1")
+	assert.False(t, tracker.IsKeepalive("worker-1"))
+
+	tracker.MarkAsKeepalive("worker-1")
+	tracker.CleanupProcess("worker-1")
+	assert.False(t, tracker.IsKeepalive("worker-1"))
+}
+
 // ===========================================================================
 // CheckTurnCompletion Tests
 // ===========================================================================
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/mcp"
@@ -46,16 +47,89 @@ type SpawnOptions struct {
 type UnifiedProcessSpawnerImpl struct {
 	coordinatorClient     client.HeadlessClient
 	workerClient          client.HeadlessClient
+	workerAlternateClient client.HeadlessClient
 	observerClient        client.HeadlessClient
 	coordinatorExtensions map[string]any
 	workerExtensions      map[string]any
+	workerAltExtensions   map[string]any
 	observerExtensions    map[string]any
+	agentTypeWorkers      map[roles.AgentType]AgentTypeWorker
+	rateLimitCooldown     time.Duration
+	cooldowns             *providerCooldowns
 	workDir               string
 	port                  int
 	submitter             process.CommandSubmitter
 	eventBus              *pubsub.Broker[any]
 	beadsDir              string
+	commitAuthor          string
 	sessionDir            string
+	streamBufferSize      int
+}
+
+// AgentTypeWorker is the client workers of a particular agent type are spawned with,
+// in place of the default worker client.
+type AgentTypeWorker struct {
+	Client     client.HeadlessClient
+	Extensions map[string]any
+}
+
+// WorkerProviders resolves the client a worker's session lives on, so every turn after
+// the spawn resumes it on the provider it was spawned with.
+type WorkerProviders struct {
+	// Default is the workflow's worker client.
+	Default AgentTypeWorker
+	// Alternate is the client rate-limited worker spawns rotate to (nil Client if none).
+	Alternate AgentTypeWorker
+	// AgentTypes are the per-agent-type worker clients, as in UnifiedSpawnerConfig.AgentTypeWorkers.
+	AgentTypes map[roles.AgentType]AgentTypeWorker
+}
+
+// For returns the client and extensions for the worker proc. Workers of an agent type
+// with its own client use that client, as at spawn; others use the provider recorded
+// on them at spawn. Workers with no recorded provider use Default.
+func (w WorkerProviders) For(proc *repository.Process) AgentTypeWorker {
+	if proc == nil {
+		return w.Default
+	}
+	if agentTypeWorker, ok := w.AgentTypes[proc.AgentType]; ok && agentTypeWorker.Client != nil {
+		return agentTypeWorker
+	}
+	if proc.Provider == "" {
+		return w.Default
+	}
+	if w.Default.Client != nil && w.Default.Client.Type() == proc.Provider {
+		return w.Default
+	}
+	if w.Alternate.Client != nil && w.Alternate.Client.Type() == proc.Provider {
+		return w.Alternate
+	}
+	return w.Default
+}
+
+// ProviderOf returns the provider the worker proc's session runs on.
+func (w WorkerProviders) ProviderOf(proc *repository.Process) client.ClientType {
+	if c := w.For(proc).Client; c != nil {
+		return c.Type()
+	}
+	return proc.Provider
+}
+
+// ImplementerProvider returns the provider workers spawned as implementers run on, or ""
+// when implementers use the default worker provider.
+func (w WorkerProviders) ImplementerProvider() client.ClientType {
+	if c := w.AgentTypes[roles.AgentTypeImplementer].Client; c != nil {
+		return c.Type()
+	}
+	return ""
+}
+
+// ReviewerProvider returns the provider workers spawned as reviewers run on, or ""
+// when reviewers use the default worker provider.
+func (w WorkerProviders) ReviewerProvider() client.ClientType {
+	if c := w.AgentTypes[roles.AgentTypeReviewer].Client; c != nil {
+		return c.Type()
+	}
+	return ""
 }
 
 // UnifiedSpawnerConfig holds configuration for creating a UnifiedProcessSpawnerImpl.
@@ -65,6 +139,9 @@ type UnifiedSpawnerConfig struct {
 	// WorkerClient is the AI client for spawning workers.
 	// If nil, uses CoordinatorClient for workers as well.
 	WorkerClient client.HeadlessClient
+	// WorkerAlternateClient is the AI client a worker spawn rotates to when WorkerClient
+	// is rate limited. If nil, rate-limited worker spawns fail.
+	WorkerAlternateClient client.HeadlessClient
 	// ObserverClient is the AI client for spawning the observer.
 	// If nil, uses WorkerClient (or CoordinatorClient) as fallback.
 	ObserverClient client.HeadlessClient
@@ -72,18 +149,33 @@ type UnifiedSpawnerConfig struct {
 	CoordinatorExtensions map[string]any
 	// WorkerExtensions holds provider-specific config for workers.
 	WorkerExtensions map[string]any
+	// WorkerAlternateExtensions holds provider-specific config for the alternate worker client.
+	WorkerAlternateExtensions map[string]any
 	// ObserverExtensions holds provider-specific config for observer.
 	ObserverExtensions map[string]any
-	WorkDir            string
-	Port               int
-	Submitter          process.CommandSubmitter
-	EventBus           *pubsub.Broker[any]
+	// AgentTypeWorkers overrides the worker client for workers spawned as a given agent
+	// type, e.g. reviewers on a different provider than implementers. Agent types not
+	// listed use WorkerClient. Overridden spawns do not rotate on rate limits.
+	AgentTypeWorkers map[roles.AgentType]AgentTypeWorker
+	// RateLimitCooldown is how long a rate-limited worker provider is skipped before it is
+	// tried first again. Zero uses DefaultRateLimitCooldown.
+	RateLimitCooldown time.Duration
+	WorkDir           string
+	Port              int
+	Submitter         process.CommandSubmitter
+	EventBus          *pubsub.Broker[any]
 	// BeadsDir is the path to the beads database directory.
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
+	// CommitAuthor attributes git commits made by workers, in "Name <email>" format.
+	// Only applied to workers; coordinator and observer keep the user's identity.
+	CommitAuthor string
 	// SessionDir is the path to the session directory.
 	// Used for template replacement in Observer prompts ({{SESSION_DIR}}).
 	SessionDir string
+	// StreamBufferSize is the max line size in bytes for parsing spawned process output.
+	// Zero uses client.DefaultStreamBufferSize.
+	StreamBufferSize int
 }
 
 // NewUnifiedProcessSpawner creates a new UnifiedProcessSpawnerImpl.
@@ -108,19 +200,31 @@ func NewUnifiedProcessSpawner(cfg UnifiedSpawnerConfig) *UnifiedProcessSpawnerIm
 		observerExtensions = workerExtensions
 	}
 
+	rateLimitCooldown := cfg.RateLimitCooldown
+	if rateLimitCooldown <= 0 {
+		rateLimitCooldown = DefaultRateLimitCooldown
+	}
+
 	return &UnifiedProcessSpawnerImpl{
 		coordinatorClient:     cfg.CoordinatorClient,
 		workerClient:          workerClient,
+		workerAlternateClient: cfg.WorkerAlternateClient,
 		observerClient:        observerClient,
 		coordinatorExtensions: cfg.CoordinatorExtensions,
 		workerExtensions:      workerExtensions,
+		workerAltExtensions:   cfg.WorkerAlternateExtensions,
 		observerExtensions:    observerExtensions,
+		agentTypeWorkers:      cfg.AgentTypeWorkers,
+		rateLimitCooldown:     rateLimitCooldown,
+		cooldowns:             newProviderCooldowns(),
 		workDir:               cfg.WorkDir,
 		port:                  cfg.Port,
 		submitter:             cfg.Submitter,
 		eventBus:              cfg.EventBus,
 		beadsDir:              cfg.BeadsDir,
+		commitAuthor:          cfg.CommitAuthor,
 		sessionDir:            cfg.SessionDir,
+		streamBufferSize:      cfg.StreamBufferSize,
 	}
 }
 
@@ -145,6 +249,13 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		extensions = s.workerExtensions
 	}
 
+	// The workflow may put workers of this agent type on their own provider
+	agentTypeWorker, hasAgentTypeWorker := s.agentTypeWorkers[opts.AgentType]
+	if role == repository.RoleWorker && hasAgentTypeWorker {
+		aiClient = agentTypeWorker.Client
+		extensions = agentTypeWorker.Extensions
+	}
+
 	if aiClient == nil {
 		return nil, fmt.Errorf("client is nil for role %s", role)
 	}
@@ -230,7 +341,7 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		}
 	default:
 		// Worker uses role-specific prompts based on AgentType
-		mcpConfig, err := s.generateWorkerMCPConfig(id)
+		mcpConfig, err := s.generateWorkerMCPConfigFor(aiClient, id)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate MCP config: %w", err)
 		}
@@ -245,6 +356,7 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		cfg = client.Config{
 			WorkDir:         s.workDir,
 			BeadsDir:        s.beadsDir,
+			CommitAuthor:    s.commitAuthor,
 			Prompt:          initialPrompt,
 			SystemPrompt:    systemPrompt,
 			MCPConfig:       mcpConfig,
@@ -253,15 +365,25 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 			Extensions:      extensions,
 		}
 	}
+	cfg.ProcessID = id
+	cfg.StreamBufferSize = s.streamBufferSize
 
-	// Spawn the underlying AI process
-	headlessProc, err := aiClient.Spawn(ctx, cfg)
+	// Spawn the underlying AI process, rotating worker spawns away from rate-limited providers
+	var headlessProc client.HeadlessProcess
+	var err error
+	provider := aiClient.Type()
+	if role == repository.RoleWorker && !hasAgentTypeWorker {
+		headlessProc, provider, err = s.spawnWorker(ctx, id, cfg)
+	} else {
+		headlessProc, err = aiClient.Spawn(ctx, cfg)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to spawn AI process: %w", err)
 	}
 
 	// Create process.Process wrapper that manages event loop
 	proc := process.New(id, role, headlessProc, s.submitter, s.eventBus)
+	proc.Provider = provider
 
 	// Start the event loop
 	proc.Start()
@@ -292,10 +414,15 @@ func (s *UnifiedProcessSpawnerImpl) generateCoordinatorMCPConfig() (string, erro
 
 // generateWorkerMCPConfig returns the appropriate MCP config format for workers based on client type.
 func (s *UnifiedProcessSpawnerImpl) generateWorkerMCPConfig(processID string) (string, error) {
-	if s.workerClient == nil {
+	return s.generateWorkerMCPConfigFor(s.workerClient, processID)
+}
+
+// generateWorkerMCPConfigFor returns the MCP config format for a worker spawned with workerClient.
+func (s *UnifiedProcessSpawnerImpl) generateWorkerMCPConfigFor(workerClient client.HeadlessClient, processID string) (string, error) {
+	if workerClient == nil {
 		return mcp.GenerateWorkerConfigHTTP(s.port, processID)
 	}
-	switch s.workerClient.Type() {
+	switch workerClient.Type() {
 	case client.ClientAmp:
 		return mcp.GenerateWorkerConfigAmp(s.port, processID)
 	case client.ClientCodex:
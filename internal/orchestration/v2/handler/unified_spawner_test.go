@@ -143,6 +143,126 @@ func TestUnifiedProcessSpawner_SpawnProcess_WithAgentType(t *testing.T) {
 	}
 }
 
+func TestUnifiedProcessSpawner_SpawnWorker_UsesAgentTypeProvider(t *testing.T) {
+	var defaultConfigs []client.Config
+	defaultClient := mock.NewClient()
+	defaultClient.SpawnFunc = countingSpawn(&defaultConfigs)
+
+	var reviewerConfigs []client.Config
+	reviewerClient := &openCodeMockClient{Client: mock.NewClient()}
+	reviewerClient.SpawnFunc = countingSpawn(&reviewerConfigs)
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: defaultClient,
+		WorkerClient:      defaultClient,
+		WorkerExtensions:  map[string]any{"provider": "default"},
+		AgentTypeWorkers: map[roles.AgentType]AgentTypeWorker{
+			roles.AgentTypeReviewer: {Client: reviewerClient, Extensions: map[string]any{"provider": "reviewer"}},
+		},
+		WorkDir:   "/test/workdir",
+		Port:      8080,
+		Submitter: &mockCommandSubmitter{},
+		EventBus:  pubsub.NewBroker[any](),
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{AgentType: roles.AgentTypeReviewer})
+	require.NoError(t, err)
+	proc.Stop()
+
+	require.Empty(t, defaultConfigs, "reviewer should not be spawned with the default worker client")
+	require.Len(t, reviewerConfigs, 1)
+	require.Equal(t, "reviewer", reviewerConfigs[0].Extensions["provider"])
+	require.Contains(t, reviewerConfigs[0].MCPConfig, `"mcp"`, "reviewer uses its provider's MCP config format")
+
+	// Agent types without a mapping fall back to the default worker client
+	proc, err = spawner.SpawnProcess(context.Background(), "worker-2", repository.RoleWorker, SpawnOptions{AgentType: roles.AgentTypeImplementer})
+	require.NoError(t, err)
+	proc.Stop()
+
+	require.Len(t, defaultConfigs, 1)
+	require.Equal(t, "default", defaultConfigs[0].Extensions["provider"])
+	require.Len(t, reviewerConfigs, 1)
+}
+
+func TestWorkerProviders_For(t *testing.T) {
+	defaultClient := mock.NewClient()
+	alternate := &openCodeMockClient{Client: mock.NewClient()}
+	reviewer := &openCodeMockClient{Client: mock.NewClient()}
+	providers := WorkerProviders{
+		Default:   AgentTypeWorker{Client: defaultClient, Extensions: map[string]any{"provider": "default"}},
+		Alternate: AgentTypeWorker{Client: alternate, Extensions: map[string]any{"provider": "alternate"}},
+		AgentTypes: map[roles.AgentType]AgentTypeWorker{
+			roles.AgentTypeReviewer: {Client: reviewer, Extensions: map[string]any{"provider": "reviewer"}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		proc *repository.Process
+		want string
+	}{
+		{"no recorded provider", &repository.Process{ID: "worker-1"}, "default"},
+		{"spawned on default", &repository.Process{ID: "worker-1", Provider: defaultClient.Type()}, "default"},
+		{"rotated to alternate", &repository.Process{ID: "worker-1", Provider: client.ClientOpenCode}, "alternate"},
+		{"unknown provider", &repository.Process{ID: "worker-1", Provider: client.ClientGemini}, "default"},
+		{"agent type override", &repository.Process{ID: "worker-1", AgentType: roles.AgentTypeReviewer, Provider: client.ClientOpenCode}, "reviewer"},
+		{"agent type without override", &repository.Process{ID: "worker-1", AgentType: roles.AgentTypeImplementer}, "default"},
+		{"nil process", nil, "default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, providers.For(tt.proc).Extensions["provider"])
+		})
+	}
+}
+
+func TestUnifiedProcessSpawner_SpawnWorker_RecordsAgentTypeProvider(t *testing.T) {
+	reviewerClient := &openCodeMockClient{Client: mock.NewClient()}
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: mock.NewClient(),
+		AgentTypeWorkers: map[roles.AgentType]AgentTypeWorker{
+			roles.AgentTypeReviewer: {Client: reviewerClient},
+		},
+		WorkDir:   "/test/workdir",
+		Port:      8080,
+		Submitter: &mockCommandSubmitter{},
+		EventBus:  pubsub.NewBroker[any](),
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{AgentType: roles.AgentTypeReviewer})
+	require.NoError(t, err)
+	proc.Stop()
+	require.Equal(t, client.ClientOpenCode, proc.Provider)
+}
+
+func TestUnifiedProcessSpawner_SpawnCoordinator_IgnoresAgentTypeProvider(t *testing.T) {
+	var coordinatorConfigs []client.Config
+	coordinatorClient := mock.NewClient()
+	coordinatorClient.SpawnFunc = countingSpawn(&coordinatorConfigs)
+
+	var reviewerConfigs []client.Config
+	reviewerClient := &openCodeMockClient{Client: mock.NewClient()}
+	reviewerClient.SpawnFunc = countingSpawn(&reviewerConfigs)
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: coordinatorClient,
+		AgentTypeWorkers: map[roles.AgentType]AgentTypeWorker{
+			roles.AgentTypeReviewer: {Client: reviewerClient},
+		},
+		WorkDir:   "/test/workdir",
+		Port:      8080,
+		Submitter: &mockCommandSubmitter{},
+		EventBus:  pubsub.NewBroker[any](),
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), "coordinator", repository.RoleCoordinator, SpawnOptions{AgentType: roles.AgentTypeReviewer})
+	require.NoError(t, err)
+	proc.Stop()
+
+	require.Len(t, coordinatorConfigs, 1)
+	require.Empty(t, reviewerConfigs)
+}
+
 func TestUnifiedProcessSpawner_GenerateMCPConfig_HTTP(t *testing.T) {
 	mockClient := mock.NewClient()
 	spawner := &UnifiedProcessSpawnerImpl{
@@ -384,6 +504,94 @@ func TestUnifiedProcessSpawner_SpawnWorker_PassesBeadsDir(t *testing.T) {
 	proc.Stop()
 }
 
+func TestUnifiedProcessSpawner_SpawnWorker_PassesCommitAuthor(t *testing.T) {
+	var capturedConfig client.Config
+	mockClient := mock.NewClient()
+	mockClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		capturedConfig = cfg
+		return mock.NewProcess(), nil
+	}
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: mockClient,
+		WorkerClient:      mockClient,
+		WorkDir:           "/test/workdir",
+		Port:              8080,
+		Submitter:         &mockCommandSubmitter{},
+		EventBus:          pubsub.NewBroker[any](),
+		CommitAuthor:      "perles-worker <workflow@bot>",
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, proc)
+
+	// Verify the worker commits with the configured author
+	assert.Equal(t, "perles-worker <workflow@bot>", capturedConfig.CommitAuthor)
+	assert.Contains(t, client.BuildEnvVars(capturedConfig), "GIT_AUTHOR_NAME=perles-worker")
+	assert.Contains(t, client.BuildEnvVars(capturedConfig), "GIT_AUTHOR_EMAIL=workflow@bot")
+
+	proc.Stop()
+}
+
+func TestUnifiedProcessSpawner_PassesStreamBufferSize(t *testing.T) {
+	var captured []client.Config
+	mockClient := mock.NewClient()
+	mockClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		captured = append(captured, cfg)
+		return mock.NewProcess(), nil
+	}
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: mockClient,
+		WorkerClient:      mockClient,
+		WorkDir:           "/test/workdir",
+		Port:              8080,
+		Submitter:         &mockCommandSubmitter{},
+		EventBus:          pubsub.NewBroker[any](),
+		StreamBufferSize:  1 << 20,
+	})
+
+	coord, err := spawner.SpawnProcess(context.Background(), repository.CoordinatorID, repository.RoleCoordinator, SpawnOptions{})
+	require.NoError(t, err)
+	defer coord.Stop()
+	worker, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	defer worker.Stop()
+
+	require.Len(t, captured, 2)
+	for _, cfg := range captured {
+		assert.Equal(t, 1<<20, cfg.StreamBufferSize)
+	}
+}
+
+func TestUnifiedProcessSpawner_SpawnCoordinator_OmitsCommitAuthor(t *testing.T) {
+	var capturedConfig client.Config
+	mockClient := mock.NewClient()
+	mockClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		capturedConfig = cfg
+		return mock.NewProcess(), nil
+	}
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: mockClient,
+		WorkDir:           "/test/workdir",
+		Port:              8080,
+		Submitter:         &mockCommandSubmitter{},
+		EventBus:          pubsub.NewBroker[any](),
+		CommitAuthor:      "perles-worker <workflow@bot>",
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), repository.CoordinatorID, repository.RoleCoordinator, SpawnOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, proc)
+
+	// Coordinator doesn't commit, so it keeps the user's identity
+	assert.Empty(t, capturedConfig.CommitAuthor)
+
+	proc.Stop()
+}
+
 func TestUnifiedProcessSpawner_SpawnCoordinator_UsesWorkflowConfigSystemPromptOverride(t *testing.T) {
 	var capturedConfig client.Config
 	mockClient := mock.NewClient()
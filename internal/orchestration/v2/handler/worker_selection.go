@@ -0,0 +1,182 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the ready-worker selection policy used when a command leaves
+// the target worker for the handler to choose.
+package handler
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// WorkerSelector picks one worker from a set of ready candidates.
+// Candidates are never empty and are passed in no particular order; implementations
+// must be deterministic for the same input so assignment is predictable. Selection
+// deliberately avoids randomness, so a replayed session makes the same assignments
+// without needing a seed.
+type WorkerSelector interface {
+	Select(candidates []*repository.Process) *repository.Process
+}
+
+// WorkerSelectorFunc adapts an ordinary function to the WorkerSelector interface.
+type WorkerSelectorFunc func(candidates []*repository.Process) *repository.Process
+
+// Select calls f(candidates).
+func (f WorkerSelectorFunc) Select(candidates []*repository.Process) *repository.Process {
+	return f(candidates)
+}
+
+// OldestReadyFirst is the default WorkerSelector. It picks the worker that has been
+// ready the longest, measured from its last completed turn (or spawn, if it has not
+// completed one). Ties are broken by worker ID in natural order, so worker-2 is
+// chosen before worker-10.
+var OldestReadyFirst WorkerSelector = WorkerSelectorFunc(func(candidates []*repository.Process) *repository.Process {
+	return slices.MinFunc(candidates, func(a, b *repository.Process) int {
+		if c := readySince(a).Compare(readySince(b)); c != 0 {
+			return c
+		}
+		return compareWorkerIDs(a.ID, b.ID)
+	})
+})
+
+// readySince approximates when a ready worker became available.
+func readySince(p *repository.Process) time.Time {
+	if !p.LastActivityAt.IsZero() {
+		return p.LastActivityAt
+	}
+	return p.CreatedAt
+}
+
+// compareWorkerIDs orders IDs of the form "<prefix>-<n>" numerically by n when the
+// prefixes match, falling back to lexical order otherwise.
+func compareWorkerIDs(a, b string) int {
+	aPrefix, aNum, aOK := splitWorkerID(a)
+	bPrefix, bNum, bOK := splitWorkerID(b)
+	if aOK && bOK && aPrefix == bPrefix && aNum != bNum {
+		if aNum < bNum {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// splitWorkerID splits "worker-12" into ("worker", 12, true).
+func splitWorkerID(id string) (string, int, bool) {
+	i := strings.LastIndexByte(id, '-')
+	if i < 0 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(id[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+	return id[:i], n, true
+}
+
+// selectReadyWorker returns the worker chosen by selector among ready, idle workers with
+// no task, skipping any IDs in exclude and workers still in their post-ready grace period as of now.
+// A nil selector uses OldestReadyFirst. Returns types.ErrNoReadyWorker if there are no candidates.
+func selectReadyWorker(processRepo repository.ProcessRepository, selector WorkerSelector, now time.Time, exclude ...string) (*repository.Process, error) {
+	return selectFrom(readyCandidates(processRepo, now, exclude...), selector)
+}
+
+// selectReviewer returns the worker chosen by selector to review a task implemented by
+// implementerID. Only generic workers and workers spawned as reviewers qualify, and
+// workers spawned as reviewers are preferred. When providers gives reviewers their own
+// provider (cross-provider review), only workers running on it qualify; a nil providers
+// skips the provider check. Returns types.ErrNoReadyWorker if no worker qualifies.
+func selectReviewer(processRepo repository.ProcessRepository, selector WorkerSelector, providers *WorkerProviders, now time.Time, implementerID string) (*repository.Process, error) {
+	var reviewerProvider client.ClientType
+	if providers != nil {
+		reviewerProvider = providers.ReviewerProvider()
+	}
+
+	var reviewers, generic []*repository.Process
+	for _, p := range readyCandidates(processRepo, now, implementerID) {
+		if reviewerProvider != "" && providers.ProviderOf(p) != reviewerProvider {
+			continue
+		}
+		switch p.AgentType {
+		case roles.AgentTypeReviewer:
+			reviewers = append(reviewers, p)
+		case roles.AgentTypeGeneric:
+			generic = append(generic, p)
+		}
+	}
+	if len(reviewers) > 0 {
+		return selectFrom(reviewers, selector)
+	}
+	return selectFrom(generic, selector)
+}
+
+// checkImplementerPolicy returns an error if proc may not implement tasks under the
+// workflow's role and provider policy. Workers spawned as reviewers never implement.
+// When providers gives implementers their own provider, only workers running on it
+// qualify; otherwise, when reviewers have their own provider (cross-provider review),
+// workers running on it do not. A nil providers skips the provider checks.
+func checkImplementerPolicy(proc *repository.Process, providers *WorkerProviders) error {
+	if proc.AgentType == roles.AgentTypeReviewer {
+		return fmt.Errorf("%w: %s was spawned as a reviewer", types.ErrWorkerRoleMismatch, proc.ID)
+	}
+	if providers == nil {
+		return nil
+	}
+
+	provider := providers.ProviderOf(proc)
+	if implementerProvider := providers.ImplementerProvider(); implementerProvider != "" {
+		if provider != implementerProvider {
+			return fmt.Errorf("%w: %s runs on %s, implementers run on %s",
+				types.ErrWorkerProviderMismatch, proc.ID, provider, implementerProvider)
+		}
+		return nil
+	}
+	reviewerProvider := providers.ReviewerProvider()
+	if reviewerProvider != "" && provider == reviewerProvider && providers.ProviderOf(nil) != reviewerProvider {
+		return fmt.Errorf("%w: %s runs on %s, which is reserved for reviewers",
+			types.ErrWorkerProviderMismatch, proc.ID, provider)
+	}
+	return nil
+}
+
+// readyCandidates returns the ready, idle workers with no task, skipping any IDs in exclude
+// and workers still in their post-ready grace period as of now.
+func readyCandidates(processRepo repository.ProcessRepository, now time.Time, exclude ...string) []*repository.Process {
+	candidates := make([]*repository.Process, 0)
+	for _, p := range processRepo.ReadyWorkers() {
+		if p.TaskID != "" || slices.Contains(exclude, p.ID) || p.InReadyGrace(now) {
+			continue
+		}
+		candidates = append(candidates, p)
+	}
+	return candidates
+}
+
+// selectFrom returns the worker selector picks from candidates, using OldestReadyFirst
+// for a nil selector. Returns types.ErrNoReadyWorker if candidates is empty.
+func selectFrom(candidates []*repository.Process, selector WorkerSelector) (*repository.Process, error) {
+	if len(candidates) == 0 {
+		return nil, types.ErrNoReadyWorker
+	}
+	if selector == nil {
+		selector = OldestReadyFirst
+	}
+	return selector.Select(candidates), nil
+}
+
+// checkReadyGrace returns types.ErrProcessInReadyGrace if p cannot be assigned yet
+// because it is still within its post-ready grace period as of now.
+func checkReadyGrace(p *repository.Process, now time.Time) error {
+	if !p.InReadyGrace(now) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s is assignable in %s", types.ErrProcessInReadyGrace,
+		p.ID, p.AssignableAt.Sub(now).Round(time.Millisecond))
+}
@@ -0,0 +1,471 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	mockclient "github.com/zjrosen/perles/internal/orchestration/mock"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ===========================================================================
+// Worker Selection Tests
+// ===========================================================================
+
+// addReadyWorker stores an idle, ready worker last active at lastActivity.
+func addReadyWorker(processRepo *repository.MemoryProcessRepository, id string, lastActivity time.Time) {
+	processRepo.AddProcess(&repository.Process{
+		ID:             id,
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusReady,
+		Phase:          phasePtr(events.ProcessPhaseIdle),
+		CreatedAt:      lastActivity.Add(-time.Hour),
+		LastActivityAt: lastActivity,
+	})
+}
+
+func TestOldestReadyFirst_PicksLongestIdle(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", base.Add(2*time.Minute))
+	addReadyWorker(processRepo, "worker-2", base)
+	addReadyWorker(processRepo, "worker-3", base.Add(time.Minute))
+
+	proc, err := selectReadyWorker(processRepo, nil, time.Now())
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", proc.ID)
+}
+
+func TestOldestReadyFirst_FallsBackToCreatedAt(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", base)
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		CreatedAt: base.Add(-time.Minute),
+	})
+
+	proc, err := selectReadyWorker(processRepo, OldestReadyFirst, time.Now())
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", proc.ID, "a never-active worker is ready since it was spawned")
+}
+
+func TestOldestReadyFirst_TieBreaksByNaturalWorkerID(t *testing.T) {
+	same := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	for _, id := range []string{"worker-10", "worker-2", "worker-9"} {
+		addReadyWorker(processRepo, id, same)
+	}
+
+	// Map iteration order varies, so repeat to catch nondeterminism
+	for range 20 {
+		proc, err := selectReadyWorker(processRepo, nil, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, "worker-2", proc.ID)
+	}
+}
+
+func TestSelectReadyWorker_SkipsBusyAndExcludedWorkers(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", base)
+	addReadyWorker(processRepo, "worker-2", base.Add(time.Minute))
+	addReadyWorker(processRepo, "worker-3", base.Add(2*time.Minute))
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-4",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseImplementing),
+		CreatedAt: base.Add(-time.Hour),
+	})
+	busy, _ := processRepo.Get("worker-2")
+	busy.TaskID = "perles-abc1.1"
+	require.NoError(t, processRepo.Save(busy))
+
+	proc, err := selectReadyWorker(processRepo, nil, time.Now(), "worker-1")
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-3", proc.ID)
+}
+
+func TestSelectReadyWorker_NoneReady(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", time.Now())
+
+	_, err := selectReadyWorker(processRepo, nil, time.Now(), "worker-1")
+
+	require.ErrorIs(t, err, types.ErrNoReadyWorker)
+}
+
+func TestSelectReadyWorker_UsesCustomSelector(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", time.Now().Add(-time.Hour))
+	addReadyWorker(processRepo, "worker-2", time.Now())
+	newest := WorkerSelectorFunc(func(candidates []*repository.Process) *repository.Process {
+		pick := candidates[0]
+		for _, p := range candidates[1:] {
+			if p.LastActivityAt.After(pick.LastActivityAt) {
+				pick = p
+			}
+		}
+		return pick
+	})
+
+	proc, err := selectReadyWorker(processRepo, newest, time.Now())
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", proc.ID)
+}
+
+func TestSelectReadyWorker_SkipsWorkerInReadyGrace(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", clock.Now())
+	proc, _ := processRepo.Get("worker-1")
+	proc.AssignableAt = clock.Now().Add(50 * time.Millisecond)
+	require.NoError(t, processRepo.Save(proc))
+
+	_, err := selectReadyWorker(processRepo, nil, clock.Now())
+	require.ErrorIs(t, err, types.ErrNoReadyWorker, "worker should not be selectable during its grace period")
+
+	clock.Advance(50 * time.Millisecond)
+	selected, err := selectReadyWorker(processRepo, nil, clock.Now())
+	require.NoError(t, err, "worker should be selectable once the grace period elapses")
+	require.Equal(t, "worker-1", selected.ID)
+}
+
+func TestSelectReadyWorker_GraceDoesNotBlockOtherWorkers(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", base)
+	addReadyWorker(processRepo, "worker-2", base.Add(time.Minute))
+	initializing, _ := processRepo.Get("worker-1")
+	initializing.AssignableAt = time.Now().Add(time.Hour)
+	require.NoError(t, processRepo.Save(initializing))
+
+	proc, err := selectReadyWorker(processRepo, nil, time.Now())
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", proc.ID)
+}
+
+func TestAssignTaskHandler_RejectsExplicitWorkerInReadyGrace(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", time.Now())
+	proc, _ := processRepo.Get("worker-1")
+	proc.AssignableAt = time.Now().Add(time.Hour)
+	require.NoError(t, processRepo.Save(proc))
+	handler := NewAssignTaskHandler(processRepo, repository.NewMemoryTaskRepository(),
+		WithBDExecutor(mocks.NewMockIssueExecutor(t)), WithQueueRepository(repository.NewMemoryQueueRepository(0)))
+
+	_, err := handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "", ""))
+
+	require.ErrorIs(t, err, types.ErrProcessInReadyGrace)
+	require.Contains(t, err.Error(), "worker-1 is assignable in")
+}
+
+func TestAssignTaskHandler_UsesClock(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", clock.Now())
+	proc, _ := processRepo.Get("worker-1")
+	proc.AssignableAt = clock.Now().Add(time.Minute)
+	require.NoError(t, processRepo.Save(proc))
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	taskRepo := repository.NewMemoryTaskRepository()
+	handler := NewAssignTaskHandler(processRepo, taskRepo,
+		WithBDExecutor(bdExecutor), WithQueueRepository(repository.NewMemoryQueueRepository(0)),
+		WithAssignTaskClock(clock))
+
+	_, err := handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "", ""))
+	require.ErrorIs(t, err, types.ErrProcessInReadyGrace)
+	require.Contains(t, err.Error(), "worker-1 is assignable in 1m0s")
+
+	clock.Advance(time.Minute)
+	_, err = handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.1", "", ""))
+	require.NoError(t, err)
+
+	task, err := taskRepo.Get("perles-abc1.1")
+	require.NoError(t, err)
+	require.Equal(t, clock.Now(), task.StartedAt)
+	require.Equal(t, clock.Now(), task.PhaseHistory[0].At)
+}
+
+func TestAssignTaskHandler_SelectsWorkersInDefaultOrder(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-3", base)
+	addReadyWorker(processRepo, "worker-1", base.Add(time.Minute))
+	addReadyWorker(processRepo, "worker-2", base.Add(time.Minute))
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{Status: beads.StatusOpen}, nil).Maybe()
+	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	handler := NewAssignTaskHandler(processRepo, repository.NewMemoryTaskRepository(),
+		WithBDExecutor(bdExecutor), WithQueueRepository(repository.NewMemoryQueueRepository(0)))
+
+	var assigned []string
+	for _, taskID := range []string{"perles-abc1.1", "perles-abc1.2", "perles-abc1.3"} {
+		result, err := handler.Handle(context.Background(),
+			command.NewAssignTaskCommand(command.SourceMCPTool, "", taskID, "", ""))
+		require.NoError(t, err)
+		assigned = append(assigned, result.Data.(*AssignTaskResult).WorkerID)
+	}
+
+	require.Equal(t, []string{"worker-3", "worker-1", "worker-2"}, assigned)
+
+	_, err := handler.Handle(context.Background(),
+		command.NewAssignTaskCommand(command.SourceMCPTool, "", "perles-abc1.4", "", ""))
+	require.ErrorIs(t, err, types.ErrNoReadyWorker)
+}
+
+func TestAssignTaskHandler_SelectionIsReproducible(t *testing.T) {
+	// Selection uses no randomness: coordinators with the same workers make the same
+	// choices however the workers were stored, so sessions replay identically.
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	workers := []struct {
+		id string
+		at time.Time
+	}{
+		{"worker-10", base},
+		{"worker-2", base},
+		{"worker-7", base.Add(time.Minute)},
+		{"worker-1", base.Add(time.Minute)},
+		{"worker-4", base.Add(2 * time.Minute)},
+	}
+
+	selections := func(order []int) []string {
+		processRepo := repository.NewMemoryProcessRepository()
+		for _, i := range order {
+			addReadyWorker(processRepo, workers[i].id, workers[i].at)
+		}
+		bdExecutor := mocks.NewMockIssueExecutor(t)
+		bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{Status: beads.StatusOpen}, nil).Maybe()
+		bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+		handler := NewAssignTaskHandler(processRepo, repository.NewMemoryTaskRepository(),
+			WithBDExecutor(bdExecutor), WithQueueRepository(repository.NewMemoryQueueRepository(0)))
+
+		var assigned []string
+		for _, taskID := range []string{"perles-abc1.1", "perles-abc1.2", "perles-abc1.3", "perles-abc1.4", "perles-abc1.5"} {
+			result, err := handler.Handle(context.Background(),
+				command.NewAssignTaskCommand(command.SourceMCPTool, "", taskID, "", ""))
+			require.NoError(t, err)
+			assigned = append(assigned, result.Data.(*AssignTaskResult).WorkerID)
+		}
+		return assigned
+	}
+
+	first := selections([]int{0, 1, 2, 3, 4})
+	require.Equal(t, []string{"worker-2", "worker-10", "worker-1", "worker-7", "worker-4"}, first)
+	require.Equal(t, first, selections([]int{4, 3, 2, 1, 0}))
+	require.Equal(t, first, selections([]int{2, 0, 4, 1, 3}))
+}
+
+func TestAssignReviewHandler_SelectsReviewerOtherThanImplementer(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", base)
+	addReadyWorker(processRepo, "worker-2", base.Add(time.Minute))
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.1",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	}))
+	handler := NewAssignReviewHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewAssignReviewCommand(command.SourceMCPTool, "", "perles-abc1.1", "worker-1", command.ReviewTypeSimple))
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", result.Data.(*AssignReviewResult).ReviewerID,
+		"the implementer is never selected even if it has been ready longest")
+}
+
+func TestAssignReviewHandler_AutoReviewerExcludesImplementer(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", base)
+	addReadyWorker(processRepo, "worker-3", base.Add(2*time.Minute))
+	addReadyWorker(processRepo, "worker-2", base.Add(time.Minute))
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.1",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	}))
+	handler := NewAssignReviewHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewAssignReviewCommand(command.SourceMCPTool, command.AutoReviewer, "perles-abc1.1", "worker-1", command.ReviewTypeSimple))
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", result.Data.(*AssignReviewResult).ReviewerID)
+	task, err := taskRepo.Get("perles-abc1.1")
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", task.Reviewer)
+}
+
+func TestAssignReviewHandler_AutoReviewerNoneAvailable(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorker(processRepo, "worker-1", time.Now())
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.1",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	}))
+	handler := NewAssignReviewHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0))
+
+	_, err := handler.Handle(context.Background(),
+		command.NewAssignReviewCommand(command.SourceMCPTool, command.AutoReviewer, "perles-abc1.1", "worker-1", command.ReviewTypeSimple))
+
+	require.ErrorIs(t, err, types.ErrNoReadyWorker)
+	require.EqualError(t, err, "no ready worker available to review perles-abc1.1 other than implementer worker-1; retry once a worker finishes or spawn one")
+	task, err := taskRepo.Get("perles-abc1.1")
+	require.NoError(t, err)
+	require.Empty(t, task.Reviewer)
+}
+
+// addReadyWorkerAs stores an idle, ready worker of agentType spawned on provider.
+func addReadyWorkerAs(processRepo *repository.MemoryProcessRepository, id string, lastActivity time.Time, agentType roles.AgentType, provider client.ClientType) {
+	addReadyWorker(processRepo, id, lastActivity)
+	proc, _ := processRepo.Get(id)
+	proc.AgentType = agentType
+	proc.Provider = provider
+	_ = processRepo.Save(proc)
+}
+
+// markBusy gives worker id a task so it is no longer a candidate.
+func markBusy(processRepo *repository.MemoryProcessRepository, id string) {
+	proc, _ := processRepo.Get(id)
+	proc.TaskID = "perles-other.1"
+	_ = processRepo.Save(proc)
+}
+
+func TestSelectReviewer_PrefersReviewersAndSkipsOtherSpecializations(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorkerAs(processRepo, "worker-1", base, roles.AgentTypeImplementer, "")
+	addReadyWorkerAs(processRepo, "worker-2", base.Add(time.Minute), roles.AgentTypeGeneric, "")
+	addReadyWorkerAs(processRepo, "worker-3", base.Add(2*time.Minute), roles.AgentTypeReviewer, "")
+	addReadyWorkerAs(processRepo, "worker-4", base.Add(3*time.Minute), roles.AgentTypeResearcher, "")
+
+	reviewer, err := selectReviewer(processRepo, nil, nil, base.Add(time.Hour), "worker-9")
+	require.NoError(t, err)
+	require.Equal(t, "worker-3", reviewer.ID, "a worker spawned as reviewer wins over an older generic worker")
+
+	reviewer, err = selectReviewer(processRepo, nil, nil, base.Add(time.Hour), "worker-3")
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", reviewer.ID, "generic workers review when no reviewer is ready")
+
+	markBusy(processRepo, "worker-2")
+	_, err = selectReviewer(processRepo, nil, nil, base.Add(time.Hour), "worker-3")
+	require.ErrorIs(t, err, types.ErrNoReadyWorker, "implementers and researchers are never picked to review")
+}
+
+func TestSelectReviewer_HonorsReviewerProvider(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	openCode := &openCodeMockClient{Client: mockclient.NewClient()}
+	providers := &WorkerProviders{
+		Default:    AgentTypeWorker{Client: mockclient.NewClient()},
+		Alternate:  AgentTypeWorker{Client: openCode},
+		AgentTypes: map[roles.AgentType]AgentTypeWorker{roles.AgentTypeReviewer: {Client: openCode}},
+	}
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorkerAs(processRepo, "worker-2", base, roles.AgentTypeGeneric, "")
+	addReadyWorkerAs(processRepo, "worker-3", base.Add(time.Minute), roles.AgentTypeGeneric, client.ClientOpenCode)
+
+	reviewer, err := selectReviewer(processRepo, nil, providers, base.Add(time.Hour), "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, "worker-3", reviewer.ID, "only workers on the reviewer provider qualify")
+
+	markBusy(processRepo, "worker-3")
+	_, err = selectReviewer(processRepo, nil, providers, base.Add(time.Hour), "worker-1")
+	require.ErrorIs(t, err, types.ErrNoReadyWorker)
+}
+
+func TestAssignReviewHandler_AutoReviewerUsesWorkerProviders(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	openCode := &openCodeMockClient{Client: mockclient.NewClient()}
+	processRepo := repository.NewMemoryProcessRepository()
+	addReadyWorkerAs(processRepo, "worker-1", base, roles.AgentTypeImplementer, "")
+	addReadyWorkerAs(processRepo, "worker-2", base, roles.AgentTypeGeneric, "")
+	addReadyWorkerAs(processRepo, "worker-3", base.Add(time.Minute), roles.AgentTypeReviewer, client.ClientOpenCode)
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.1",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	}))
+	handler := NewAssignReviewHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0),
+		WithAssignReviewWorkerProviders(WorkerProviders{
+			Default:    AgentTypeWorker{Client: mockclient.NewClient()},
+			AgentTypes: map[roles.AgentType]AgentTypeWorker{roles.AgentTypeReviewer: {Client: openCode}},
+		}))
+
+	result, err := handler.Handle(context.Background(),
+		command.NewAssignReviewCommand(command.SourceMCPTool, command.AutoReviewer, "perles-abc1.1", "worker-1", command.ReviewTypeSimple))
+
+	require.NoError(t, err)
+	require.Equal(t, "worker-3", result.Data.(*AssignReviewResult).ReviewerID)
+}
+
+func TestCheckImplementerPolicy(t *testing.T) {
+	openCode := &openCodeMockClient{Client: mockclient.NewClient()}
+	crossReview := &WorkerProviders{
+		Default:    AgentTypeWorker{Client: mockclient.NewClient()},
+		Alternate:  AgentTypeWorker{Client: openCode},
+		AgentTypes: map[roles.AgentType]AgentTypeWorker{roles.AgentTypeReviewer: {Client: openCode}},
+	}
+	implementersOnOpenCode := &WorkerProviders{
+		Default:    AgentTypeWorker{Client: mockclient.NewClient()},
+		AgentTypes: map[roles.AgentType]AgentTypeWorker{roles.AgentTypeImplementer: {Client: openCode}},
+	}
+
+	tests := []struct {
+		name      string
+		agentType roles.AgentType
+		provider  client.ClientType
+		providers *WorkerProviders
+		wantErr   error
+	}{
+		{name: "generic worker", agentType: roles.AgentTypeGeneric},
+		{name: "reviewer never implements", agentType: roles.AgentTypeReviewer, wantErr: types.ErrWorkerRoleMismatch},
+		{name: "reviewer never implements with providers", agentType: roles.AgentTypeReviewer, providers: crossReview, wantErr: types.ErrWorkerRoleMismatch},
+		{name: "default provider with cross-provider review", agentType: roles.AgentTypeGeneric, providers: crossReview},
+		{name: "reviewer provider is reserved", agentType: roles.AgentTypeGeneric, provider: client.ClientOpenCode, providers: crossReview, wantErr: types.ErrWorkerProviderMismatch},
+		{name: "implementer provider required", agentType: roles.AgentTypeGeneric, providers: implementersOnOpenCode, wantErr: types.ErrWorkerProviderMismatch},
+		{name: "implementer provider matches", agentType: roles.AgentTypeImplementer, provider: client.ClientOpenCode, providers: implementersOnOpenCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proc := &repository.Process{ID: "worker-1", Role: repository.RoleWorker, AgentType: tt.agentType, Provider: tt.provider}
+			err := checkImplementerPolicy(proc, tt.providers)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
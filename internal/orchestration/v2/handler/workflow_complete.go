@@ -9,6 +9,7 @@ import (
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/sound"
 )
 
@@ -37,6 +38,7 @@ type SessionMetadataProvider interface {
 type SignalWorkflowCompleteHandler struct {
 	sessionProvider SessionMetadataProvider
 	soundService    sound.SoundService
+	clock           types.Clock
 }
 
 // SignalWorkflowCompleteHandlerOption configures SignalWorkflowCompleteHandler.
@@ -59,10 +61,20 @@ func WithWorkflowSoundService(svc sound.SoundService) SignalWorkflowCompleteHand
 	}
 }
 
+// WithWorkflowCompleteClock sets the clock used to stamp workflow completion.
+func WithWorkflowCompleteClock(clock types.Clock) SignalWorkflowCompleteHandlerOption {
+	return func(h *SignalWorkflowCompleteHandler) {
+		if clock != nil {
+			h.clock = clock
+		}
+	}
+}
+
 // NewSignalWorkflowCompleteHandler creates a new SignalWorkflowCompleteHandler.
 func NewSignalWorkflowCompleteHandler(opts ...SignalWorkflowCompleteHandlerOption) *SignalWorkflowCompleteHandler {
 	h := &SignalWorkflowCompleteHandler{
 		soundService: sound.NoopSoundService{},
+		clock:        types.RealClock{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -95,7 +107,7 @@ func (h *SignalWorkflowCompleteHandler) Handle(ctx context.Context, cmd command.
 			isFirstCall = false
 		} else {
 			// First call - set new timestamp
-			completedAt = time.Now()
+			completedAt = h.clock.Now()
 		}
 
 		if err := h.sessionProvider.UpdateWorkflowCompletion(
@@ -107,7 +119,7 @@ func (h *SignalWorkflowCompleteHandler) Handle(ctx context.Context, cmd command.
 		}
 	} else {
 		// No session provider - use current time for event
-		completedAt = time.Now()
+		completedAt = h.clock.Now()
 	}
 
 	// 3. Build ProcessWorkflowComplete event
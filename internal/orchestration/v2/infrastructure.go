@@ -12,6 +12,9 @@ import (
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
@@ -22,7 +25,10 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/integration"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/pubsub"
 	"github.com/zjrosen/perles/internal/sound"
 )
@@ -57,16 +63,26 @@ type InfrastructureConfig struct {
 	// AgentProviders maps roles to their AI client providers.
 	// Must contain at least RoleCoordinator. RoleWorker falls back to coordinator if not set.
 	AgentProviders client.AgentProviders
+	// WorkerProviders overrides the worker provider for workers spawned as a given agent
+	// type, e.g. reviewers on claude while implementers use cursor.
+	// Optional - agent types not listed use AgentProviders' worker provider.
+	WorkerProviders map[roles.AgentType]client.AgentProvider
 	// WorkDir is the working directory for the orchestration session.
 	WorkDir string
 	// BeadsDir is the path to the beads database directory.
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
+	// CommitAuthor attributes git commits made by workers, in "Name <email>" format.
+	// Optional - if empty, workers commit with the user's git identity.
+	CommitAuthor string
 	// SessionID is the session identifier for accountability summary generation.
 	SessionID string
 	// SessionDir is the directory where session files are stored.
 	// For centralized storage: ~/.perles/sessions/{app}/{date}/{id}/
 	SessionDir string
+	// TransitionLogger receives an audit record for every worker phase transition (optional).
+	// If nil, transitions are not logged.
+	TransitionLogger processor.TransitionLogger
 	// Tracer is the OpenTelemetry tracer for distributed tracing (optional).
 	// When provided, TracingMiddleware will be registered in the command processor.
 	Tracer trace.Tracer
@@ -80,12 +96,97 @@ type InfrastructureConfig struct {
 	// SessionMetadataProvider provides access to session metadata for workflow completion.
 	// Optional - if nil, workflow completion status is not persisted to session metadata.
 	SessionMetadataProvider handler.SessionMetadataProvider
+	// MessageContentStore holds the full content of messages truncated in the message log,
+	// read by get_message_content. Optional - if nil, get_message_content returns an error.
+	MessageContentStore adapter.MessageContentStore
+	// MessageContentLimit caps the bytes of each message's content returned to agents by
+	// the fabric tools. Optional - zero returns messages whole.
+	MessageContentLimit int
 	// WorkflowStateProvider provides workflow state for coordinator replacement.
 	// Optional - if nil, auto-refresh uses standard replace prompt instead of workflow continuation.
 	WorkflowStateProvider handler.WorkflowStateProvider
 	// CommandPersistenceProvider returns the current CommandWriter for persisting commands.
 	// Optional - if nil, commands are not persisted to commands.jsonl.
 	CommandPersistenceProvider func() processor.CommandWriter
+	// HandoffThreshold is the coordinator context size (in tokens) at which a
+	// handoff summary is automatically posted. Optional - zero disables it.
+	HandoffThreshold int
+	// RequirePassingTests refuses approve_commit when the task's reported test
+	// results include failures, unless the call explicitly overrides it.
+	RequirePassingTests bool
+	// SkipReview sends completed tasks straight to committing instead of review.
+	// Set for workflows whose require_review is false.
+	SkipReview bool
+	// SensitivePaths are globs (e.g. "auth/", "billing/**") whose changes still need
+	// review when SkipReview is set; checked against git status via GitExecutor.
+	// Optional - if empty, SkipReview applies to every task.
+	SensitivePaths []string
+	// SyncBeadsStatus marks a task in_progress in beads when a worker starts implementing
+	// it by a path other than assign_task, e.g. a transfer. Failed writes are logged.
+	SyncBeadsStatus bool
+	// MaxConcurrentReviews caps how many tasks may be in review at once;
+	// assign_task_review refuses further reviews until one completes.
+	// Optional - zero means no limit.
+	MaxConcurrentReviews int
+	// TaskPromptLimit bounds the size of task assignment prompts sent to workers.
+	// Optional - zero MaxBytes leaves prompts unbounded.
+	TaskPromptLimit prompt.PromptLimit
+	// WorkerToolHints are listed at the end of every task assignment prompt to remind
+	// workers of their tools. Optional - if empty, no reminder is added.
+	WorkerToolHints []prompt.ToolHint
+	// GitExecutor reads the worktree diff for review checkpoints and
+	// get_diff_since_last_review, and records the worktree state each task starts from.
+	// Optional - if nil, diff checkpoints are disabled.
+	GitExecutor appgit.GitExecutor
+	// CheckpointsEnabled lets workers commit [WIP] checkpoints through GitExecutor with the
+	// checkpoint tool. Set only when the workflow runs in its own worktree.
+	CheckpointsEnabled bool
+	// Tracker runs BQL queries against beads, used by complete_epic_tasks, get_critical_path
+	// and get_dependency_graph to find an epic's subtasks. Optional - if nil, they return an error.
+	Tracker bql.BQLExecutor
+	// WorkerCapacity limits spawn_worker against a worker pool shared with other
+	// workflows. Optional - if nil, worker spawns are not limited.
+	WorkerCapacity adapter.WorkerCapacity
+	// WorkflowLister lists the workflows running alongside this one for list_workflows.
+	// Optional - if nil, list_workflows returns an error.
+	WorkflowLister adapter.WorkflowLister
+	// ConfirmDestructiveActions requires destructive tools such as complete_epic_tasks to be
+	// called twice: once to get a confirmation token describing the impact, then again with it.
+	// Optional - if false, destructive tools run on the first call.
+	ConfirmDestructiveActions bool
+	// ReconcileInterval is how often orphaned tasks and stuck workers are checked.
+	// Optional - zero uses DefaultReconcileInterval, negative disables the loop.
+	ReconcileInterval time.Duration
+	// ReadyTimeout is how long a spawned worker may take to signal ready before the
+	// reconcile loop retires it. Optional - zero uses DefaultReadyTimeout.
+	ReadyTimeout time.Duration
+	// RespawnUnreadyWorkers replaces workers retired for never signaling ready with a
+	// fresh worker instead of only retiring them.
+	RespawnUnreadyWorkers bool
+	// MinReadyWorkers is the number of workers the reconcile loop keeps ready or starting
+	// up ahead of demand, bounded by WorkerCapacity. Optional - zero disables it.
+	MinReadyWorkers int
+	// WorkerKeepaliveInterval is how long a ready worker may sit idle without a task before
+	// the reconcile loop sends it a no-op prompt to keep its session warm. Optional - zero
+	// disables keepalive.
+	WorkerKeepaliveInterval time.Duration
+	// WorkerKeepaliveMax caps the keepalive prompts an idle worker receives between tasks,
+	// bounding their token cost. Zero means DefaultMaxWorkerKeepalives.
+	WorkerKeepaliveMax int
+	// ReconcilePolicy is invoked after each reconcile pass to take recovery action.
+	// Optional - if nil, ReconcilePolicyName selects a built-in policy.
+	ReconcilePolicy ReconcilePolicy
+	// ReconcilePolicyName selects a built-in policy when ReconcilePolicy is nil:
+	// ReconcilePolicyLog (the default) only logs findings and publishes them on the event
+	// bus; ReconcilePolicyNotifyCoordinator also messages the coordinator about them.
+	ReconcilePolicyName string
+	// StreamBufferSize is the max line size in bytes for parsing agent CLI output, applied
+	// to every spawned and resumed process. Optional - zero uses the provider's default.
+	StreamBufferSize int
+	// Clock stamps task assignments, phase changes and process activity, and is read by
+	// timing tools and the reconcile loop.
+	// Optional - if nil, the real clock is used.
+	Clock types.Clock
 }
 
 // Validate checks that all required configuration is provided.
@@ -102,6 +203,33 @@ func (c *InfrastructureConfig) Validate() error {
 	if c.WorkDir == "" {
 		return fmt.Errorf("work directory is required")
 	}
+	if err := c.TaskPromptLimit.Validate(); err != nil {
+		return fmt.Errorf("task prompt limit: %w", err)
+	}
+	if c.MinReadyWorkers < 0 {
+		return fmt.Errorf("MinReadyWorkers must not be negative")
+	}
+	if c.MaxConcurrentReviews < 0 {
+		return fmt.Errorf("MaxConcurrentReviews must not be negative")
+	}
+	if c.MinReadyWorkers > 0 && c.ReconcileInterval < 0 {
+		return fmt.Errorf("MinReadyWorkers requires the reconcile loop (ReconcileInterval must not be negative)")
+	}
+	if c.WorkerKeepaliveInterval < 0 || c.WorkerKeepaliveMax < 0 {
+		return fmt.Errorf("WorkerKeepaliveInterval and WorkerKeepaliveMax must not be negative")
+	}
+	if c.WorkerKeepaliveInterval > 0 && c.ReconcileInterval < 0 {
+		return fmt.Errorf("WorkerKeepaliveInterval requires the reconcile loop (ReconcileInterval must not be negative)")
+	}
+	if c.ReadyTimeout < 0 {
+		return fmt.Errorf("ReadyTimeout must not be negative")
+	}
+	if err := ValidateReconcilePolicyName(c.ReconcilePolicyName); err != nil {
+		return err
+	}
+	if c.StreamBufferSize < 0 {
+		return fmt.Errorf("StreamBufferSize must not be negative")
+	}
 	return nil
 }
 
@@ -151,6 +279,13 @@ type InternalComponents struct {
 	ProcessRegistry *process.ProcessRegistry
 	// TurnEnforcer tracks MCP tool calls during worker turns for enforcement.
 	TurnEnforcer handler.TurnCompletionEnforcer
+	// ReconcileLoop periodically detects orphaned tasks, stuck workers, and workers
+	// that never signal ready.
+	// Nil when disabled via a negative ReconcileInterval.
+	ReconcileLoop *ReconcileLoop
+	// BeadsStatusSync writes beads statuses for worker phase transitions.
+	// Nil unless SyncBeadsStatus is set.
+	BeadsStatusSync *BeadsStatusSync
 }
 
 // NewInfrastructure creates all v2 orchestration infrastructure components.
@@ -179,6 +314,27 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	}
 	workerExtensions := cfg.AgentProviders.Worker().Extensions()
 
+	// Get the alternate worker client used while the worker provider is rate limited (optional)
+	var workerAlternateClient client.HeadlessClient
+	var workerAlternateExtensions map[string]any
+	if alt, ok := cfg.AgentProviders.WorkerAlternate(); ok {
+		workerAlternateClient, err = alt.Client()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get alternate worker client: %w", err)
+		}
+		workerAlternateExtensions = alt.Extensions()
+	}
+
+	// Get the clients for agent types the workflow puts on their own provider (optional)
+	agentTypeWorkers := make(map[roles.AgentType]handler.AgentTypeWorker, len(cfg.WorkerProviders))
+	for agentType, provider := range cfg.WorkerProviders {
+		c, err := provider.Client()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s worker client: %w", agentType, err)
+		}
+		agentTypeWorkers[agentType] = handler.AgentTypeWorker{Client: c, Extensions: provider.Extensions()}
+	}
+
 	// Get observer client and extensions (Observer() falls back to worker if not set)
 	observerClient, err := cfg.AgentProviders.Observer().Client()
 	if err != nil {
@@ -201,6 +357,7 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	// Wire participant repo to ack repo for @here inbox expansion
 	fabricAcks.SetParticipantRepository(fabricParticipants)
 	fabricService := fabric.NewService(fabricThreads, fabricDeps, fabricSubs, fabricAcks, fabricParticipants)
+	fabricService.SetContentLimit(cfg.MessageContentLimit)
 
 	// Create event bus for v2 command events (TUI subscribes via GetV2EventBus())
 	eventBus := pubsub.NewBroker[any]()
@@ -219,6 +376,21 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	tracingMiddleware := tracing.NewTracingMiddleware(tracing.TracingMiddlewareConfig{
 		Tracer: cfg.Tracer,
 	})
+	// Create BDTaskExecutor for syncing v2 state changes to BD tracker
+	beadsExec := infrabeads.NewBDExecutor(cfg.WorkDir, cfg.BeadsDir)
+
+	// Worker time-in-phase feeds get_utilization; beads status sync is opt-in
+	utilization := processor.NewUtilizationTracker()
+	var beadsSync *BeadsStatusSync
+	transitionLoggers := []processor.TransitionLogger{cfg.TransitionLogger, utilization}
+	if cfg.SyncBeadsStatus {
+		beadsSync = NewBeadsStatusSync(beadsExec)
+		transitionLoggers = append(transitionLoggers, beadsSync)
+	}
+	transitionLogMiddleware := processor.NewTransitionLogMiddleware(processor.TransitionLogMiddlewareConfig{
+		ProcessRepo: processRepo,
+		Logger:      processor.TransitionLoggers(transitionLoggers...),
+	})
 
 	// Create command processor with event bus for TUI event propagation
 	cmdProcessor := processor.NewCommandProcessor(
@@ -226,7 +398,7 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		processor.WithTaskRepository(taskRepo),
 		processor.WithQueueRepository(queueRepo),
 		processor.WithEventBus(eventBus),
-		processor.WithMiddleware(tracingMiddleware, loggingMiddleware, commandLogMiddleware, commandPersistenceMiddleware, timeoutMiddleware),
+		processor.WithMiddleware(tracingMiddleware, loggingMiddleware, commandLogMiddleware, commandPersistenceMiddleware, transitionLogMiddleware, timeoutMiddleware),
 	)
 
 	// Create unified ProcessRegistry for coordinator and workers
@@ -235,9 +407,6 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	// Create turn completion enforcer for tracking worker tool calls
 	turnEnforcer := handler.NewTurnCompletionTracker()
 
-	// Create BDTaskExecutor for syncing v2 state changes to BD tracker
-	beadsExec := infrabeads.NewBDExecutor(cfg.WorkDir, cfg.BeadsDir)
-
 	// Register all command handlers
 	registerHandlers(
 		cmdProcessor,
@@ -248,37 +417,90 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		turnEnforcer,
 		coordinatorClient,
 		workerClient,
+		workerAlternateClient,
 		observerClient,
 		coordinatorExtensions,
 		workerExtensions,
+		workerAlternateExtensions,
 		observerExtensions,
+		agentTypeWorkers,
 		beadsExec,
 		cfg.Port,
 		eventBus,
 		cfg.WorkDir,
 		cfg.BeadsDir,
+		cfg.CommitAuthor,
 		cfg.SessionDir,
 		cfg.Tracer,
 		cfg.SessionRefNotifier,
 		cfg.SoundService,
 		cfg.SessionMetadataProvider,
 		cfg.WorkflowStateProvider,
+		cfg.HandoffThreshold,
+		cfg.RequirePassingTests,
+		cfg.SkipReview,
+		cfg.SensitivePaths,
+		cfg.MaxConcurrentReviews,
+		cfg.TaskPromptLimit,
+		cfg.WorkerToolHints,
+		cfg.GitExecutor,
+		cfg.CheckpointsEnabled,
+		cfg.Tracker,
 		fabricService,
+		cfg.WorkerCapacity,
+		cfg.StreamBufferSize,
+		cfg.Clock,
 	)
 
 	// Create command submitter adapter
 	cmdSubmitter := handler.NewProcessorSubmitterAdapter(cmdProcessor)
 
+	// Destructive tools need a second, confirmed call when enabled
+	var confirmGate *adapter.ConfirmationGate
+	if cfg.ConfirmDestructiveActions {
+		confirmGate = adapter.NewConfirmationGate(adapter.DefaultConfirmationTTL)
+	}
+
 	// Create V2Adapter with repositories for read-only operations
 	v2Adapter := adapter.NewV2Adapter(cmdProcessor,
 		adapter.WithProcessRepository(processRepo),
 		adapter.WithTaskRepository(taskRepo),
 		adapter.WithQueueRepository(queueRepo),
 		adapter.WithSessionID(cfg.SessionID, cfg.WorkDir, cfg.SessionDir),
+		adapter.WithGitExecutor(cfg.GitExecutor),
+		adapter.WithWorkerCapacity(cfg.WorkerCapacity),
+		adapter.WithProcessProber(process.NewRegistryProber(processRegistry)),
+		adapter.WithProcessOutputReader(processRegistry),
+		adapter.WithWorkflowLister(cfg.WorkflowLister),
+		adapter.WithConfirmationGate(confirmGate),
+		adapter.WithModelCatalog(client.NewModelCatalog(cfg.AgentProviders.Worker(), client.DefaultModelCacheTTL)),
+		adapter.WithUtilizationSource(utilization),
+		adapter.WithMessageInbox(fabricService),
+		adapter.WithMessageContentStore(cfg.MessageContentStore),
+		adapter.WithClock(cfg.Clock),
 	)
 
 	// NOTE: CoordinatorNudger removed - FabricBroker handles @mention notifications
 
+	reconcilePolicy := cfg.ReconcilePolicy
+	if reconcilePolicy == nil && cfg.ReconcilePolicyName == ReconcilePolicyNotifyCoordinator {
+		reconcilePolicy = NewNotifyCoordinatorPolicy(cmdSubmitter)
+	}
+
+	var reconcileLoop *ReconcileLoop
+	if cfg.ReconcileInterval >= 0 {
+		reconcileLoop = NewReconcileLoop(v2Adapter,
+			WithReconcileInterval(cfg.ReconcileInterval),
+			WithReconcileEventBus(eventBus),
+			WithReconcilePolicy(reconcilePolicy),
+			WithReadyTimeout(cfg.ReadyTimeout),
+			WithUnreadyWorkerRecovery(cmdSubmitter, cfg.RespawnUnreadyWorkers),
+			WithMinReadyWorkers(cfg.MinReadyWorkers),
+			WithWorkerKeepalive(cmdSubmitter, cfg.WorkerKeepaliveInterval, cfg.WorkerKeepaliveMax),
+			WithReconcileClock(NewReconcileClock(cfg.Clock)),
+		)
+	}
+
 	return &Infrastructure{
 		Core: CoreComponents{
 			Processor:     cmdProcessor,
@@ -295,6 +517,8 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		Internal: InternalComponents{
 			ProcessRegistry: processRegistry,
 			TurnEnforcer:    turnEnforcer,
+			ReconcileLoop:   reconcileLoop,
+			BeadsStatusSync: beadsSync,
 		},
 		config: cfg,
 	}, nil
@@ -322,6 +546,16 @@ func (i *Infrastructure) Start(ctx context.Context) error {
 
 	// NOTE: CoordinatorNudger.Start() removed - FabricBroker.Start() is called by Supervisor
 
+	// Reconcile loop stops with ctx
+	if loop := i.Internal.ReconcileLoop; loop != nil {
+		log.SafeGo("v2.reconcileLoop", func() { loop.Run(ctx) })
+	}
+
+	// Beads status writes run off the processor goroutine and stop with ctx
+	if beadsSync := i.Internal.BeadsStatusSync; beadsSync != nil {
+		log.SafeGo("v2.beadsStatusSync", func() { beadsSync.Run(ctx) })
+	}
+
 	return nil
 }
 
@@ -349,11 +583,13 @@ func (i *Infrastructure) Shutdown() {
 // This includes task assignment, state transition, BD task status, and process handlers.
 //
 // Handler groups:
-//   - Task Assignment (4): AssignTask, AssignReview, ApproveCommit, AssignReviewFeedback
+//   - Task Assignment (6): AssignTask, AssignReview, ApproveCommit, AssignReviewFeedback, TransferTask,
+//     DrainWorker
 //   - State Transition (4): ReportComplete, ReportVerdict, TransitionPhase, ProcessTurnComplete
-//   - BD Task Status (2): MarkTaskComplete, MarkTaskFailed
+//   - BD Task Status (7): MarkTaskComplete, MarkTaskFailed, AddTaskBlocker, CompleteEpicTasks,
+//     SyncTaskStatus, GetCriticalPath, GetDependencyGraph
 //   - Process Management (7): SpawnProcess, SendToProcess, DeliverProcessQueued,
-//     RetireProcess, StopProcess, ReplaceProcess
+//     RetireProcess, StopProcess, ReplaceProcess, LabelWorker
 func registerHandlers(
 	cmdProcessor *processor.CommandProcessor,
 	processRepo repository.ProcessRepository,
@@ -363,22 +599,39 @@ func registerHandlers(
 	turnEnforcer handler.TurnCompletionEnforcer,
 	coordinatorClient client.HeadlessClient,
 	workerClient client.HeadlessClient,
+	workerAlternateClient client.HeadlessClient,
 	observerClient client.HeadlessClient,
 	coordinatorExtensions map[string]any,
 	workerExtensions map[string]any,
+	workerAlternateExtensions map[string]any,
 	observerExtensions map[string]any,
+	agentTypeWorkers map[roles.AgentType]handler.AgentTypeWorker,
 	beadsExec appbeads.IssueExecutor,
 	port int,
 	eventBus *pubsub.Broker[any],
 	workDir string,
 	beadsDir string,
+	commitAuthor string,
 	sessionDir string,
 	tracer trace.Tracer,
 	sessionRefNotifier handler.SessionRefNotifier,
 	soundService sound.SoundService,
 	sessionMetadataProvider handler.SessionMetadataProvider,
 	workflowStateProvider handler.WorkflowStateProvider,
+	handoffThreshold int,
+	requirePassingTests bool,
+	skipReview bool,
+	sensitivePaths []string,
+	maxConcurrentReviews int,
+	taskPromptLimit prompt.PromptLimit,
+	workerToolHints []prompt.ToolHint,
+	gitExecutor appgit.GitExecutor,
+	checkpointsEnabled bool,
+	tracker bql.BQLExecutor,
 	fabricService *fabric.Service,
+	workerCapacity adapter.WorkerCapacity,
+	streamBufferSize int,
+	clock types.Clock,
 ) {
 	// Create shared infrastructure components
 	cmdSubmitter := handler.NewProcessorSubmitterAdapter(cmdProcessor)
@@ -388,72 +641,168 @@ func registerHandlers(
 		soundService = sound.NoopSoundService{}
 	}
 
+	// Resolves the provider each worker's session runs on, for reviewer selection and resume
+	workerProviders := handler.WorkerProviders{
+		Default:    handler.AgentTypeWorker{Client: workerClient, Extensions: workerExtensions},
+		Alternate:  handler.AgentTypeWorker{Client: workerAlternateClient, Extensions: workerAlternateExtensions},
+		AgentTypes: agentTypeWorkers,
+	}
+
+	// Long-running handlers post progress updates on the event bus as they work
+	var progress handler.ProgressReporter
+	if eventBus != nil {
+		progress = handler.NewEventBusProgressReporter(eventBus, clock)
+	}
+
 	// ============================================================
-	// Task Assignment handlers (4)
+	// Task Assignment handlers (5)
 	// ============================================================
-	cmdProcessor.RegisterHandler(command.CmdAssignTask,
-		handler.NewAssignTaskHandler(processRepo, taskRepo,
-			handler.WithBDExecutor(beadsExec),
-			handler.WithQueueRepository(queueRepo),
-			handler.WithAssignTaskTracer(tracer)))
+	assignTaskHandler := handler.NewAssignTaskHandler(processRepo, taskRepo,
+		handler.WithBDExecutor(beadsExec),
+		handler.WithQueueRepository(queueRepo),
+		handler.WithAssignTaskTracer(tracer),
+		handler.WithAssignTaskPromptLimit(taskPromptLimit),
+		handler.WithAssignTaskToolReminder(workerToolHints),
+		handler.WithAssignTaskWorkerProviders(workerProviders),
+		handler.WithAssignTaskGitExecutor(gitExecutor),
+		handler.WithAssignTaskClock(clock))
+	cmdProcessor.RegisterHandler(command.CmdAssignTask, assignTaskHandler)
+	cmdProcessor.RegisterHandler(command.CmdAssignTasksBatch,
+		handler.NewAssignTasksBatchHandler(assignTaskHandler,
+			handler.WithAssignTasksBatchProgress(progress)))
+	cmdProcessor.RegisterHandler(command.CmdGetEligibleWorkers,
+		handler.NewGetEligibleWorkersHandler(assignTaskHandler))
 	cmdProcessor.RegisterHandler(command.CmdAssignReview,
-		handler.NewAssignReviewHandler(processRepo, taskRepo, queueRepo))
+		handler.NewAssignReviewHandler(processRepo, taskRepo, queueRepo,
+			handler.WithMaxConcurrentReviews(maxConcurrentReviews),
+			handler.WithAssignReviewWorkerProviders(workerProviders),
+			handler.WithAssignReviewClock(clock)))
 	cmdProcessor.RegisterHandler(command.CmdApproveCommit,
-		handler.NewApproveCommitHandler(processRepo, taskRepo, queueRepo))
+		handler.NewApproveCommitHandler(processRepo, taskRepo, queueRepo,
+			handler.WithRequirePassingTests(requirePassingTests),
+			handler.WithApproveCommitClock(clock)))
 	cmdProcessor.RegisterHandler(command.CmdAssignReviewFeedback,
-		handler.NewAssignReviewFeedbackHandler(processRepo, taskRepo, queueRepo))
+		handler.NewAssignReviewFeedbackHandler(processRepo, taskRepo, queueRepo,
+			handler.WithAssignReviewFeedbackClock(clock)))
+	transferTaskHandler := handler.NewTransferTaskHandler(processRepo, taskRepo, queueRepo,
+		handler.WithTransferTaskClock(clock))
+	cmdProcessor.RegisterHandler(command.CmdTransferTask, transferTaskHandler)
+	cmdProcessor.RegisterHandler(command.CmdDrainWorker,
+		handler.NewDrainWorkerHandler(transferTaskHandler))
 
 	// ============================================================
-	// State Transition handlers (4)
+	// State Transition handlers (8)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdReportComplete,
 		handler.NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
-			handler.WithReportCompleteBDExecutor(beadsExec)))
+			handler.WithReportCompleteBDExecutor(beadsExec),
+			handler.WithReportCompleteSkipReview(skipReview),
+			handler.WithReportCompleteSensitivePaths(sensitivePaths),
+			handler.WithReportCompleteGitExecutor(gitExecutor),
+			handler.WithReportCompleteClock(clock)))
 
 	cmdProcessor.RegisterHandler(command.CmdReportVerdict,
 		handler.NewReportVerdictHandler(processRepo, taskRepo, queueRepo,
 			handler.WithReportVerdictBDExecutor(beadsExec),
 			handler.WithReportVerdictTracer(tracer),
-			handler.WithReportVerdictSoundService(soundService)))
+			handler.WithReportVerdictSoundService(soundService),
+			handler.WithReportVerdictGitExecutor(gitExecutor)))
+	cmdProcessor.RegisterHandler(command.CmdReportTestResults,
+		handler.NewReportTestResultsHandler(processRepo, taskRepo,
+			handler.WithReportTestResultsClock(clock)))
+	cmdProcessor.RegisterHandler(command.CmdMarkFeedbackAddressed,
+		handler.NewMarkFeedbackAddressedHandler(processRepo, taskRepo))
+	var checkpointGit appgit.GitExecutor
+	if checkpointsEnabled {
+		checkpointGit = gitExecutor
+	}
+	cmdProcessor.RegisterHandler(command.CmdCheckpoint,
+		handler.NewCheckpointHandler(processRepo, taskRepo, checkpointGit,
+			handler.WithCheckpointCommitAuthor(commitAuthor)))
+	cmdProcessor.RegisterHandler(command.CmdRequestRetirement,
+		handler.NewRequestRetirementHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdTransitionPhase,
 		handler.NewTransitionPhaseHandler(processRepo, queueRepo))
+
+	// Create process spawner with separate coordinator/worker clients.
+	// The turn-complete handler rotates rate-limited workers through it.
+	processSpawner := handler.NewUnifiedProcessSpawner(handler.UnifiedSpawnerConfig{
+		CoordinatorClient:         coordinatorClient,
+		WorkerClient:              workerClient,
+		WorkerAlternateClient:     workerAlternateClient,
+		CoordinatorExtensions:     coordinatorExtensions,
+		WorkerExtensions:          workerExtensions,
+		WorkerAlternateExtensions: workerAlternateExtensions,
+		AgentTypeWorkers:          agentTypeWorkers,
+		WorkDir:                   workDir,
+		Port:                      port,
+		Submitter:                 cmdSubmitter,
+		EventBus:                  eventBus,
+		BeadsDir:                  beadsDir,
+		CommitAuthor:              commitAuthor,
+		SessionDir:                sessionDir,
+		StreamBufferSize:          streamBufferSize,
+	})
+
+	turnCompleteOpts := []handler.ProcessTurnCompleteHandlerOption{
+		handler.WithProcessTurnEnforcer(turnEnforcer),
+		handler.WithTurnCompleteProcessRegistry(processRegistry),
+		handler.WithSessionRefNotifier(sessionRefNotifier),
+		handler.WithProcessTurnSoundService(soundService),
+		handler.WithHandoffThreshold(handoffThreshold),
+		handler.WithReadyGracePeriod(workerClient.Capabilities().ReadyGracePeriod),
+		handler.WithProcessTurnWorkerCapacity(workerCapacity),
+		handler.WithProcessTurnProviderRotator(processSpawner),
+		handler.WithProcessTurnClock(clock),
+	}
+	// Workers on the alternate or an agent type's provider wait out that provider's grace period
+	if workerAlternateClient != nil {
+		turnCompleteOpts = append(turnCompleteOpts, handler.WithProviderReadyGracePeriod(
+			workerAlternateClient.Type(), workerAlternateClient.Capabilities().ReadyGracePeriod))
+	}
+	for _, w := range agentTypeWorkers {
+		turnCompleteOpts = append(turnCompleteOpts, handler.WithProviderReadyGracePeriod(
+			w.Client.Type(), w.Client.Capabilities().ReadyGracePeriod))
+	}
 	cmdProcessor.RegisterHandler(command.CmdProcessTurnComplete,
-		handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
-			handler.WithProcessTurnEnforcer(turnEnforcer),
-			handler.WithTurnCompleteProcessRegistry(processRegistry),
-			handler.WithSessionRefNotifier(sessionRefNotifier),
-			handler.WithProcessTurnSoundService(soundService)))
+		handler.NewProcessTurnCompleteHandler(processRepo, queueRepo, turnCompleteOpts...))
 
 	// ============================================================
-	// BD Task Status handlers (2)
+	// BD Task Status handlers (6)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdMarkTaskComplete,
 		handler.NewMarkTaskCompleteHandler(beadsExec, taskRepo,
-			handler.WithMarkTaskCompleteProcessRepo(processRepo)))
+			handler.WithMarkTaskCompleteProcessRepo(processRepo),
+			handler.WithMarkTaskCompleteClock(clock)))
 	cmdProcessor.RegisterHandler(command.CmdMarkTaskFailed,
-		handler.NewMarkTaskFailedHandler(beadsExec))
+		handler.NewMarkTaskFailedHandler(beadsExec,
+			handler.WithMarkTaskFailedTaskRepo(taskRepo),
+			handler.WithMarkTaskFailedClock(clock)))
+	cmdProcessor.RegisterHandler(command.CmdAddTaskBlocker,
+		handler.NewAddTaskBlockerHandler(beadsExec,
+			handler.WithAddTaskBlockerTaskRepo(taskRepo)))
+	cmdProcessor.RegisterHandler(command.CmdCompleteEpicTasks,
+		handler.NewCompleteEpicTasksHandler(beadsExec, tracker,
+			handler.WithCompleteEpicTasksTaskRepo(taskRepo),
+			handler.WithCompleteEpicTasksProgress(progress),
+			handler.WithCompleteEpicTasksClock(clock)))
+	cmdProcessor.RegisterHandler(command.CmdSyncTaskStatus,
+		handler.NewSyncTaskStatusHandler(beadsExec, taskRepo,
+			handler.WithSyncTaskStatusProcessRepo(processRepo),
+			handler.WithSyncTaskStatusClock(clock)))
+	cmdProcessor.RegisterHandler(command.CmdGetCriticalPath,
+		handler.NewGetCriticalPathHandler(tracker))
+	cmdProcessor.RegisterHandler(command.CmdGetDependencyGraph,
+		handler.NewGetDependencyGraphHandler(tracker))
 
 	// ============================================================
 	// Process Management handlers (7)
 	// ============================================================
 
-	// Create process spawner with separate coordinator/worker clients
-	processSpawner := handler.NewUnifiedProcessSpawner(handler.UnifiedSpawnerConfig{
-		CoordinatorClient:     coordinatorClient,
-		WorkerClient:          workerClient,
-		CoordinatorExtensions: coordinatorExtensions,
-		WorkerExtensions:      workerExtensions,
-		WorkDir:               workDir,
-		Port:                  port,
-		Submitter:             cmdSubmitter,
-		EventBus:              eventBus,
-		BeadsDir:              beadsDir,
-		SessionDir:            sessionDir,
-	})
-
 	// MessageDeliverer for delivering messages to processes via session resume
 	// Uses role-based client selection (coordinator vs worker vs observer)
-	sessionProvider := handler.NewProcessRegistrySessionProvider(processRegistry, coordinatorClient, workerClient, observerClient, workDir, port)
+	sessionProvider := handler.NewProcessRegistrySessionProvider(processRegistry, coordinatorClient, workerClient, observerClient, workDir, port,
+		handler.WithSessionProcessRepository(processRepo))
 
 	messageDeliverer := integration.NewProcessSessionDeliverer(
 		sessionProvider,
@@ -465,13 +814,17 @@ func registerHandlers(
 		workerExtensions,
 		observerExtensions,
 		integration.WithBeadsDir(beadsDir),
+		integration.WithCommitAuthor(commitAuthor),
+		integration.WithStreamBufferSize(streamBufferSize),
+		integration.WithWorkerProviders(processRepo, workerProviders),
 	)
 
 	cmdProcessor.RegisterHandler(command.CmdSpawnProcess,
 		handler.NewSpawnProcessHandler(processRepo, processRegistry,
 			handler.WithUnifiedSpawner(processSpawner),
 			handler.WithTurnEnforcer(turnEnforcer),
-			handler.WithSpawnProcessTracer(tracer)))
+			handler.WithSpawnProcessTracer(tracer),
+			handler.WithSpawnProcessClock(clock)))
 	cmdProcessor.RegisterHandler(command.CmdSendToProcess,
 		handler.NewSendToProcessHandler(processRepo, queueRepo,
 			handler.WithSendToProcessTracer(tracer)))
@@ -481,20 +834,29 @@ func registerHandlers(
 			handler.WithDeliverTurnEnforcer(turnEnforcer)))
 	cmdProcessor.RegisterHandler(command.CmdRetireProcess,
 		handler.NewRetireProcessHandler(processRepo, processRegistry,
-			handler.WithRetireTurnEnforcer(turnEnforcer)))
+			handler.WithRetireTurnEnforcer(turnEnforcer),
+			handler.WithRetireWorkerCapacity(workerCapacity),
+			handler.WithRetireProcessClock(clock)))
 	cmdProcessor.RegisterHandler(command.CmdStopProcess,
 		handler.NewStopWorkerHandler(processRepo, taskRepo, queueRepo, processRegistry,
-			handler.WithFabricUnsubscriber(fabricService)))
+			handler.WithFabricUnsubscriber(fabricService),
+			handler.WithStopWorkerClock(clock)))
 	cmdProcessor.RegisterHandler(command.CmdReplaceProcess,
 		handler.NewReplaceProcessHandler(processRepo, processRegistry,
 			handler.WithReplaceSpawner(processSpawner),
 			handler.WithWorkflowStateProvider(workflowStateProvider),
-			handler.WithSessionDirProvider(&sessionDirProvider{sessionDir: sessionDir})))
+			handler.WithSessionDirProvider(&sessionDirProvider{sessionDir: sessionDir}),
+			handler.WithReplaceTaskReassignment(taskRepo, queueRepo),
+			handler.WithReplaceGitExecutor(gitExecutor),
+			handler.WithReplaceProcessClock(clock)))
 	cmdProcessor.RegisterHandler(command.CmdPauseProcess,
 		handler.NewPauseProcessHandler(processRepo,
 			handler.WithPauseRegistry(processRegistry)))
 	cmdProcessor.RegisterHandler(command.CmdResumeProcess,
-		handler.NewResumeProcessHandler(processRepo, queueRepo))
+		handler.NewResumeProcessHandler(processRepo, queueRepo,
+			handler.WithResumeProcessClock(clock)))
+	cmdProcessor.RegisterHandler(command.CmdLabelWorker,
+		handler.NewLabelWorkerHandler(processRepo))
 
 	// ============================================================
 	// Aggregation handlers (1)
@@ -508,7 +870,8 @@ func registerHandlers(
 	cmdProcessor.RegisterHandler(command.CmdSignalWorkflowComplete,
 		handler.NewSignalWorkflowCompleteHandler(
 			handler.WithSessionMetadataProvider(sessionMetadataProvider),
-			handler.WithWorkflowSoundService(soundService)))
+			handler.WithWorkflowSoundService(soundService),
+			handler.WithWorkflowCompleteClock(clock)))
 
 	// ============================================================
 	// User Interaction handlers (1)
@@ -516,4 +879,20 @@ func registerHandlers(
 	cmdProcessor.RegisterHandler(command.CmdNotifyUser,
 		handler.NewNotifyUserHandler(
 			handler.WithNotifyUserSoundService(soundService)))
+
+	// ============================================================
+	// Coordinator State handlers (3)
+	// ============================================================
+	cmdProcessor.RegisterHandler(command.CmdSetGlobalInstruction,
+		handler.NewSetGlobalInstructionHandler(processRepo))
+	cmdProcessor.RegisterHandler(command.CmdClearGlobalInstruction,
+		handler.NewClearGlobalInstructionHandler(processRepo))
+	cmdProcessor.RegisterHandler(command.CmdSetFocus,
+		handler.NewSetFocusHandler(processRepo))
+	cmdProcessor.RegisterHandler(command.CmdHoldTask,
+		handler.NewHoldTaskHandler(processRepo))
+	cmdProcessor.RegisterHandler(command.CmdReleaseTask,
+		handler.NewReleaseTaskHandler(processRepo))
+	cmdProcessor.RegisterHandler(command.CmdImportState,
+		handler.NewImportStateHandler(processRepo, taskRepo))
 }
@@ -11,6 +11,10 @@ import (
 
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 )
 
@@ -18,6 +22,7 @@ import (
 func createTestAgentProvider(t *testing.T) client.AgentProvider {
 	mockClient := mocks.NewMockHeadlessClient(t)
 	mockClient.EXPECT().Type().Return(client.ClientClaude).Maybe()
+	mockClient.EXPECT().Capabilities().Return(client.Capabilities{}).Maybe()
 
 	mockProvider := mocks.NewMockAgentProvider(t)
 	mockProvider.EXPECT().Client().Return(mockClient, nil).Maybe()
@@ -79,6 +84,50 @@ func TestInfrastructureConfig_Validate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "work directory is required")
 	})
+
+	t.Run("MinReadyWorkers without reconcile loop returns error", func(t *testing.T) {
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:           "/tmp/test",
+			MinReadyWorkers:   2,
+			ReconcileInterval: -1,
+		}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "MinReadyWorkers requires the reconcile loop")
+	})
+
+	t.Run("WorkerKeepaliveInterval without reconcile loop returns error", func(t *testing.T) {
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:                 "/tmp/test",
+			WorkerKeepaliveInterval: time.Minute,
+			ReconcileInterval:       -1,
+		}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "WorkerKeepaliveInterval requires the reconcile loop")
+	})
+
+	t.Run("negative MaxConcurrentReviews returns error", func(t *testing.T) {
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:              "/tmp/test",
+			MaxConcurrentReviews: -1,
+		}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "MaxConcurrentReviews must not be negative")
+	})
 }
 
 // ===========================================================================
@@ -114,6 +163,21 @@ func TestNewInfrastructure(t *testing.T) {
 		assert.NotNil(t, infra.Internal.ProcessRegistry)
 	})
 
+	t.Run("applies message content limit to fabric tools", func(t *testing.T) {
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:             "/tmp/test",
+			MessageContentLimit: 4096,
+		}
+
+		infra, err := NewInfrastructure(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, 4096, infra.Core.FabricService.ContentLimit())
+	})
+
 	t.Run("returns error for invalid config", func(t *testing.T) {
 		cfg := InfrastructureConfig{} // All fields empty - invalid
 
@@ -136,6 +200,27 @@ func TestNewInfrastructure(t *testing.T) {
 		assert.Contains(t, err.Error(), "AgentProviders is required")
 	})
 
+	t.Run("returns error when a worker provider's client cannot be created", func(t *testing.T) {
+		reviewerProvider := mocks.NewMockAgentProvider(t)
+		reviewerProvider.EXPECT().Client().Return(nil, client.ErrUnknownClientType)
+
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkerProviders: map[roles.AgentType]client.AgentProvider{
+				roles.AgentTypeReviewer: reviewerProvider,
+			},
+			WorkDir: "/tmp/test",
+		}
+
+		infra, err := NewInfrastructure(cfg)
+		assert.Error(t, err)
+		assert.Nil(t, infra)
+		assert.Contains(t, err.Error(), "failed to get reviewer worker client")
+	})
+
 	t.Run("returns error for zero Port", func(t *testing.T) {
 		cfg := InfrastructureConfig{
 			Port: 0,
@@ -362,10 +447,75 @@ func TestAllHandlersRegistered(t *testing.T) {
 // Integration Tests
 // ===========================================================================
 
+// createTestAgentProviderWithCapabilities creates an AgentProvider mock of the given type
+// whose client reports caps.
+func createTestAgentProviderWithCapabilities(t *testing.T, clientType client.ClientType, caps client.Capabilities) client.AgentProvider {
+	mockClient := mocks.NewMockHeadlessClient(t)
+	mockClient.EXPECT().Type().Return(clientType).Maybe()
+	mockClient.EXPECT().Capabilities().Return(caps).Maybe()
+
+	mockProvider := mocks.NewMockAgentProvider(t)
+	mockProvider.EXPECT().Client().Return(mockClient, nil).Maybe()
+	mockProvider.EXPECT().Extensions().Return(map[string]any{}).Maybe()
+	mockProvider.EXPECT().Type().Return(clientType).Maybe()
+	return mockProvider
+}
+
+func TestInfrastructure_ReadyGracePeriodFollowsWorkerProvider(t *testing.T) {
+	clock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	cfg := InfrastructureConfig{
+		Port: 8080,
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: createTestAgentProvider(t),
+			client.RoleWorker: createTestAgentProviderWithCapabilities(t, client.ClientGemini,
+				client.Capabilities{ReadyGracePeriod: client.MCPStartupGracePeriod}),
+		},
+		WorkerProviders: map[roles.AgentType]client.AgentProvider{
+			roles.AgentTypeReviewer: createTestAgentProviderWithCapabilities(t, client.ClientCodex, client.Capabilities{}),
+		},
+		WorkDir:           "/tmp/test",
+		Clock:             clock,
+		ReconcileInterval: -1,
+	}
+
+	infra, err := NewInfrastructure(cfg)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, infra.Start(ctx))
+	defer infra.Drain()
+
+	processRepo := infra.Repositories.ProcessRepo
+	for id, provider := range map[string]client.ClientType{"worker-1": client.ClientGemini, "worker-2": client.ClientCodex} {
+		require.NoError(t, processRepo.Save(&repository.Process{
+			ID:       id,
+			Role:     repository.RoleWorker,
+			Status:   repository.StatusWorking,
+			Provider: provider,
+		}))
+		infra.Internal.TurnEnforcer.MarkAsNewlySpawned(id) // Startup turn
+		_, err := infra.Core.Processor.SubmitAndWait(ctx, command.NewProcessTurnCompleteCommand(id, true, nil, nil))
+		require.NoError(t, err)
+	}
+
+	gemini, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, clock.Now().Add(client.MCPStartupGracePeriod), gemini.AssignableAt)
+	require.True(t, gemini.InReadyGrace(clock.Now()))
+
+	codex, err := processRepo.Get("worker-2")
+	require.NoError(t, err)
+	require.False(t, codex.InReadyGrace(clock.Now()), "the reviewer provider has no grace period")
+
+	clock.Advance(client.MCPStartupGracePeriod)
+	require.False(t, gemini.InReadyGrace(clock.Now()))
+}
+
 func TestInfrastructure_Integration(t *testing.T) {
 	t.Run("full lifecycle: create, start, drain", func(t *testing.T) {
 		mockClient := mocks.NewMockHeadlessClient(t)
 		mockClient.EXPECT().Type().Return(client.ClientClaude).Maybe()
+		mockClient.EXPECT().Capabilities().Return(client.Capabilities{}).Maybe()
 		// Allow Spawn to be called if needed during tests
 		mockClient.On("Spawn", mock.Anything, mock.Anything).
 			Return(nil, nil).
@@ -407,6 +557,7 @@ func TestInfrastructure_Integration(t *testing.T) {
 	t.Run("creates infrastructure with WorkflowStateProvider", func(t *testing.T) {
 		mockClient := mocks.NewMockHeadlessClient(t)
 		mockClient.EXPECT().Type().Return(client.ClientClaude).Maybe()
+		mockClient.EXPECT().Capabilities().Return(client.Capabilities{}).Maybe()
 
 		mockProvider := mocks.NewMockAgentProvider(t)
 		mockProvider.EXPECT().Client().Return(mockClient, nil).Maybe()
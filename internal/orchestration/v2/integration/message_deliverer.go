@@ -57,6 +57,10 @@ type ProcessSessionDeliverer struct {
 	workerExtensions      map[string]any
 	observerExtensions    map[string]any
 	beadsDir              string
+	commitAuthor          string
+	streamBufferSize      int
+	processRepo           repository.ProcessRepository
+	workerProviders       *handler.WorkerProviders
 }
 
 // ProcessSessionDelivererOption configures ProcessSessionDeliverer.
@@ -76,6 +80,29 @@ func WithBeadsDir(beadsDir string) ProcessSessionDelivererOption {
 	}
 }
 
+// WithCommitAuthor sets the git commit author for resumed worker processes.
+func WithCommitAuthor(author string) ProcessSessionDelivererOption {
+	return func(d *ProcessSessionDeliverer) {
+		d.commitAuthor = author
+	}
+}
+
+// WithStreamBufferSize sets the max line size in bytes for parsing resumed process output.
+func WithStreamBufferSize(size int) ProcessSessionDelivererOption {
+	return func(d *ProcessSessionDeliverer) {
+		d.streamBufferSize = size
+	}
+}
+
+// WithWorkerProviders resumes each worker on the provider recorded on its Process at spawn,
+// which may differ from the default worker client (e.g. after rate-limit rotation).
+func WithWorkerProviders(processRepo repository.ProcessRepository, providers handler.WorkerProviders) ProcessSessionDelivererOption {
+	return func(d *ProcessSessionDeliverer) {
+		d.processRepo = processRepo
+		d.workerProviders = &providers
+	}
+}
+
 // NewProcessSessionDeliverer creates a new ProcessSessionDeliverer.
 //
 // Parameters:
@@ -188,6 +215,7 @@ func (d *ProcessSessionDeliverer) Deliver(ctx context.Context, processID, conten
 	// 3. Select client and extensions based on process role
 	var aiClient client.HeadlessClient
 	var extensions map[string]any
+	var commitAuthor string
 
 	switch processID {
 	case repository.CoordinatorID:
@@ -200,8 +228,8 @@ func (d *ProcessSessionDeliverer) Deliver(ctx context.Context, processID, conten
 		extensions = d.observerExtensions
 	default:
 		log.Debug(log.CatOrch, "selecting worker client", "processId", processID)
-		aiClient = d.workerClient
-		extensions = d.workerExtensions
+		aiClient, extensions = d.workerClientFor(processID)
+		commitAuthor = d.commitAuthor
 	}
 
 	// 4. Spawn/resume the session with the message as prompt
@@ -209,14 +237,16 @@ func (d *ProcessSessionDeliverer) Deliver(ctx context.Context, processID, conten
 	// is managed by the Process struct, not by this function's context.
 	// If we used the parent context, the process would be killed when Deliver() returns.
 	proc, err := aiClient.Spawn(context.Background(), client.Config{
-		WorkDir:         d.sessionProvider.GetWorkDir(),
-		BeadsDir:        d.beadsDir,
-		SessionID:       sessionID,
-		Prompt:          content,
-		MCPConfig:       mcpConfig,
-		SkipPermissions: true,
-		DisallowedTools: []string{"AskUserQuestion"},
-		Extensions:      extensions,
+		WorkDir:          d.sessionProvider.GetWorkDir(),
+		BeadsDir:         d.beadsDir,
+		CommitAuthor:     commitAuthor,
+		SessionID:        sessionID,
+		Prompt:           content,
+		MCPConfig:        mcpConfig,
+		SkipPermissions:  true,
+		DisallowedTools:  []string{"AskUserQuestion"},
+		Extensions:       extensions,
+		StreamBufferSize: d.streamBufferSize,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to resume session for process %s: %w", processID, err)
@@ -231,3 +261,20 @@ func (d *ProcessSessionDeliverer) Deliver(ctx context.Context, processID, conten
 
 	return nil
 }
+
+// workerClientFor returns the client and extensions to resume a worker with: the provider
+// it was spawned with when known, otherwise the default worker client.
+func (d *ProcessSessionDeliverer) workerClientFor(processID string) (client.HeadlessClient, map[string]any) {
+	if d.workerProviders == nil || d.processRepo == nil {
+		return d.workerClient, d.workerExtensions
+	}
+	proc, err := d.processRepo.Get(processID)
+	if err != nil {
+		return d.workerClient, d.workerExtensions
+	}
+	provider := d.workerProviders.For(proc)
+	if provider.Client == nil {
+		return d.workerClient, d.workerExtensions
+	}
+	return provider.Client, provider.Extensions
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
 // mockSessionProvider implements SessionProvider for testing.
@@ -58,12 +61,20 @@ func (m *slowMockSessionProvider) GetWorkDir() string {
 // mockHeadlessClient implements client.HeadlessClient for testing.
 type mockHeadlessClient struct {
 	mock.Mock
+	clientType client.ClientType // ClientMock if empty
 }
 
 func (m *mockHeadlessClient) Type() client.ClientType {
+	if m.clientType != "" {
+		return m.clientType
+	}
 	return client.ClientMock
 }
 
+func (m *mockHeadlessClient) Capabilities() client.Capabilities {
+	return client.Capabilities{SupportsResume: true}
+}
+
 func (m *mockHeadlessClient) Spawn(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
 	args := m.Called(ctx, cfg)
 	proc := args.Get(0)
@@ -176,6 +187,76 @@ func TestProcessSessionDeliverer_Deliver_Success(t *testing.T) {
 	mockResumer.AssertExpectations(t)
 }
 
+func TestProcessSessionDeliverer_Deliver_WorkerResumesOnRecordedProvider(t *testing.T) {
+	sessionProvider := &mockSessionProvider{sessionID: "session-123", workDir: "/test/workdir"}
+	primary := &mockHeadlessClient{clientType: client.ClientClaude}
+	alternate := &mockHeadlessClient{clientType: client.ClientCursor}
+	mockProc := &mockHeadlessProcess{}
+	mockResumer := &mockProcessResumer{}
+	mockResumer.On("ResumeProcess", mock.Anything, mockProc).Return(nil)
+
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Provider: client.ClientCursor})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Provider: client.ClientClaude})
+
+	// worker-1 was rotated to the alternate at spawn and must stay there
+	alternate.On("Spawn", mock.Anything, mock.MatchedBy(func(cfg client.Config) bool {
+		return cfg.SessionID == "session-123" && cfg.Extensions["cursor.model"] == "composer-1"
+	})).Return(mockProc, nil).Once()
+	primary.On("Spawn", mock.Anything, mock.MatchedBy(func(cfg client.Config) bool {
+		return cfg.Extensions["claude.model"] == "opus"
+	})).Return(mockProc, nil).Once()
+
+	deliverer := NewProcessSessionDeliverer(
+		sessionProvider, primary, primary, primary, mockResumer,
+		nil, map[string]any{"claude.model": "opus"}, nil,
+		WithWorkerProviders(processRepo, handler.WorkerProviders{
+			Default:   handler.AgentTypeWorker{Client: primary, Extensions: map[string]any{"claude.model": "opus"}},
+			Alternate: handler.AgentTypeWorker{Client: alternate, Extensions: map[string]any{"cursor.model": "composer-1"}},
+		}),
+	)
+
+	require.NoError(t, deliverer.Deliver(context.Background(), "worker-1", "next turn"))
+	require.NoError(t, deliverer.Deliver(context.Background(), "worker-2", "next turn"))
+	alternate.AssertExpectations(t)
+	primary.AssertExpectations(t)
+}
+
+func TestProcessSessionDeliverer_Deliver_AgentTypeWorkerResumesOnItsProvider(t *testing.T) {
+	sessionProvider := &mockSessionProvider{sessionID: "session-123", workDir: "/test/workdir"}
+	defaultClient := &mockHeadlessClient{clientType: client.ClientClaude}
+	reviewerClient := &mockHeadlessClient{clientType: client.ClientClaude}
+	mockProc := &mockHeadlessProcess{}
+	mockResumer := &mockProcessResumer{}
+	mockResumer.On("ResumeProcess", "worker-1", mockProc).Return(nil)
+
+	// The reviewer runs on the same client type as the default but with its own model,
+	// so its agent type, not its provider, decides which client it resumes on
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, AgentType: roles.AgentTypeReviewer, Provider: client.ClientClaude,
+	})
+	reviewerClient.On("Spawn", mock.Anything, mock.MatchedBy(func(cfg client.Config) bool {
+		return cfg.Prompt == "[TASK ASSIGNMENT] review perles-abc.1" && cfg.Extensions["claude.model"] == "haiku"
+	})).Return(mockProc, nil).Once()
+
+	deliverer := NewProcessSessionDeliverer(
+		sessionProvider, defaultClient, defaultClient, defaultClient, mockResumer,
+		nil, map[string]any{"claude.model": "opus"}, nil,
+		WithWorkerProviders(processRepo, handler.WorkerProviders{
+			Default: handler.AgentTypeWorker{Client: defaultClient, Extensions: map[string]any{"claude.model": "opus"}},
+			AgentTypes: map[roles.AgentType]handler.AgentTypeWorker{
+				roles.AgentTypeReviewer: {Client: reviewerClient, Extensions: map[string]any{"claude.model": "haiku"}},
+			},
+		}),
+	)
+
+	require.NoError(t, deliverer.Deliver(context.Background(), "worker-1", "[TASK ASSIGNMENT] review perles-abc.1"))
+	reviewerClient.AssertExpectations(t)
+	defaultClient.AssertNotCalled(t, "Spawn", mock.Anything, mock.Anything)
+	mockResumer.AssertExpectations(t)
+}
+
 func TestProcessSessionDeliverer_Deliver_SessionNotFound(t *testing.T) {
 	// Setup
 	sessionProvider := &mockSessionProvider{
@@ -176,6 +176,10 @@ func (s *testV2Stack) registerHandlers(
 		handler.NewMarkTaskCompleteHandler(bdExecutor, taskRepo))
 	cmdProcessor.RegisterHandler(command.CmdMarkTaskFailed,
 		handler.NewMarkTaskFailedHandler(bdExecutor))
+
+	// Coordinator State handlers
+	cmdProcessor.RegisterHandler(command.CmdImportState,
+		handler.NewImportStateHandler(processRepo, taskRepo))
 }
 
 // cleanup stops the processor and releases resources.
@@ -1769,12 +1773,115 @@ func TestIntegration_StopWorker_PhaseWarning(t *testing.T) {
 	t.Logf("Stop worker phase warning test passed: warning issued, force override worked")
 }
 
+// ===========================================================================
+// Integration Test: Export/Import State
+// ===========================================================================
+
+// exportStackState calls export_state on the stack's adapter and decodes the result.
+func exportStackState(t *testing.T, stack *testV2Stack) adapter.StateExport {
+	t.Helper()
+	result, err := stack.adapter.HandleExportState(stack.ctx, nil)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var export adapter.StateExport
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &export))
+	return export
+}
+
+// TestV2Integration_ExportImportStateRoundTrip exports a coordinator's state and imports it
+// into a fresh coordinator with the same workers.
+func TestV2Integration_ExportImportStateRoundTrip(t *testing.T) {
+	src := newTestV2Stack(t)
+	defer src.cleanup()
+	implementerID := src.spawnWorkerAndWaitReady(t)
+	src.spawnWorkerAndWaitReady(t)
+
+	assignArgs, _ := json.Marshal(map[string]string{
+		"worker_id": implementerID,
+		"task_id":   "test-tk001",
+		"summary":   "Round-trip task",
+	})
+	result, err := src.adapter.HandleAssignTask(src.ctx, assignArgs)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "assign should succeed: %s", result.Content)
+
+	task, err := src.taskRepo.Get("test-tk001")
+	require.NoError(t, err)
+	task.ReviewRounds = 1
+	task.TransferNote = "picked up after a crash"
+	require.NoError(t, src.taskRepo.Save(task))
+
+	exported := exportStackState(t, src)
+	require.Len(t, exported.WorkerAssignments, 1)
+	require.Len(t, exported.TaskAssignments, 1)
+
+	// Serialize as the coordinator would when saving the output for later
+	saved, err := json.Marshal(map[string]any{"state": exported})
+	require.NoError(t, err)
+
+	dst := newTestV2Stack(t)
+	defer dst.cleanup()
+	dst.spawnWorkerAndWaitReady(t)
+	dst.spawnWorkerAndWaitReady(t)
+
+	result, err = dst.adapter.HandleImportState(dst.ctx, saved)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "import should succeed: %s", result.Content)
+
+	restored, err := dst.taskRepo.Get("test-tk001")
+	require.NoError(t, err)
+	assert.Equal(t, implementerID, restored.Implementer)
+	assert.Equal(t, repository.TaskImplementing, restored.Status)
+	assert.Equal(t, "picked up after a crash", restored.TransferNote)
+
+	worker, _ := dst.processRepo.Get(implementerID)
+	assert.Equal(t, events.ProcessPhaseImplementing, *worker.Phase)
+	assert.Equal(t, "test-tk001", worker.TaskID)
+
+	reexported := exportStackState(t, dst)
+	exported.ExportedAt, reexported.ExportedAt = time.Time{}, time.Time{}
+	exported.WorkerAssignments[0].Status = string(repository.StatusReady) // import keeps the live worker's status
+	assert.Equal(t, exported, reexported, "re-export should match the original state")
+}
+
+// TestV2Integration_ImportStateRejectsMissingWorkers verifies import_state leaves a
+// coordinator untouched when the state references workers it does not have.
+func TestV2Integration_ImportStateRejectsMissingWorkers(t *testing.T) {
+	src := newTestV2Stack(t)
+	defer src.cleanup()
+	src.spawnWorkerAndWaitReady(t)
+	implementerID := src.spawnWorkerAndWaitReady(t)
+
+	assignArgs, _ := json.Marshal(map[string]string{
+		"worker_id": implementerID,
+		"task_id":   "test-tk001",
+		"summary":   "Task held by the second worker",
+	})
+	result, err := src.adapter.HandleAssignTask(src.ctx, assignArgs)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	saved, err := json.Marshal(map[string]any{"state": exportStackState(t, src)})
+	require.NoError(t, err)
+
+	dst := newTestV2Stack(t)
+	defer dst.cleanup()
+	dst.spawnWorkerAndWaitReady(t)
+
+	result, err = dst.adapter.HandleImportState(dst.ctx, saved)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "worker not in pool: "+implementerID)
+	assert.Empty(t, dst.taskRepo.All(), "no tasks should be restored")
+}
+
 // ===========================================================================
 // Integration Test: MarkTaskComplete Removes Task from QueryWorkerState
 // ===========================================================================
 
 // TestIntegration_MarkTaskComplete_RemovesFromQueryWorkerState verifies that after
-// mark_task_complete is called, the task is deleted from the in-memory TaskRepository
+// mark_task_complete is called, the task is marked completed in the in-memory TaskRepository
 // and no longer appears in query_worker_state response.
 //
 // This test validates the fix for the bug where task status remained stuck in "in_review"
@@ -1829,9 +1936,10 @@ func TestIntegration_MarkTaskComplete_RemovesFromQueryWorkerState(t *testing.T)
 	assert.Equal(t, workerID, task.Implementer)
 	assert.Equal(t, repository.TaskImplementing, task.Status)
 
-	// Step 5: Call mark_task_complete
-	completeArgs, _ := json.Marshal(map[string]string{
+	// Step 5: Call mark_task_complete, forcing it since the task skipped review
+	completeArgs, _ := json.Marshal(map[string]any{
 		"task_id": taskID,
+		"force":   true,
 	})
 
 	result, err = stack.adapter.HandleMarkTaskComplete(stack.ctx, completeArgs)
@@ -1849,12 +1957,12 @@ func TestIntegration_MarkTaskComplete_RemovesFromQueryWorkerState(t *testing.T)
 		return false
 	}, time.Second, 10*time.Millisecond, "BD task status should be updated to closed")
 
-	// Step 6: Verify task is removed from in-memory TaskRepository
+	// Step 6: Verify task is marked completed in the in-memory TaskRepository
 	// Wait for async command to complete
 	require.Eventually(t, func() bool {
-		_, err := stack.taskRepo.Get(taskID)
-		return err == repository.ErrTaskNotFound
-	}, time.Second, 10*time.Millisecond, "task should be deleted from in-memory repo after mark_task_complete")
+		task, err := stack.taskRepo.Get(taskID)
+		return err == nil && task.Status == repository.TaskCompleted
+	}, time.Second, 10*time.Millisecond, "task should be marked completed in in-memory repo after mark_task_complete")
 
 	// Step 7: Verify task no longer appears in query_worker_state response
 	// Use a fresh struct to avoid json.Unmarshal merging with existing map
@@ -5,14 +5,26 @@
 package process
 
 import (
+	"slices"
 	"strings"
 	"sync"
 )
 
+// OutputKind classifies a line stored in an OutputBuffer.
+type OutputKind int
+
+const (
+	// OutputOther covers tool calls, tool results, and status lines.
+	OutputOther OutputKind = iota
+	// OutputText is human-readable assistant text: the agent's reasoning and replies.
+	OutputText
+)
+
 // OutputBuffer is a thread-safe ring buffer for storing recent output lines.
 // It maintains a bounded memory footprint by discarding older lines when capacity is reached.
 type OutputBuffer struct {
 	lines    []string
+	kinds    []OutputKind
 	capacity int
 	start    int // Index of oldest line
 	count    int // Number of lines stored
@@ -27,23 +39,37 @@ func NewOutputBuffer(capacity int) *OutputBuffer {
 	}
 	return &OutputBuffer{
 		lines:    make([]string, capacity),
+		kinds:    make([]OutputKind, capacity),
 		capacity: capacity,
 	}
 }
 
-// Append adds a line to the buffer.
+// Append adds a line to the buffer as OutputOther.
 // If the buffer is full, the oldest line is overwritten.
 func (b *OutputBuffer) Append(line string) {
+	b.AppendKind(OutputOther, line)
+}
+
+// AppendText adds a line of assistant text to the buffer.
+func (b *OutputBuffer) AppendText(line string) {
+	b.AppendKind(OutputText, line)
+}
+
+// AppendKind adds a line of the given kind to the buffer.
+// If the buffer is full, the oldest line is overwritten.
+func (b *OutputBuffer) AppendKind(kind OutputKind, line string) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	if b.count < b.capacity {
 		// Buffer not full, append
 		b.lines[b.count] = line
+		b.kinds[b.count] = kind
 		b.count++
 	} else {
 		// Buffer full, overwrite oldest
 		b.lines[b.start] = line
+		b.kinds[b.start] = kind
 		b.start = (b.start + 1) % b.capacity
 	}
 }
@@ -84,6 +110,28 @@ func (b *OutputBuffer) Last(n int) []string {
 	return result
 }
 
+// LastText returns the last n lines of assistant text, skipping tool calls, tool
+// results, and status lines. Returns fewer lines if the buffer holds less text.
+func (b *OutputBuffer) LastText(n int) []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if n <= 0 {
+		return nil
+	}
+
+	// Walk backwards from the newest line, then restore chronological order
+	var result []string
+	for i := b.count - 1; i >= 0 && len(result) < n; i-- {
+		idx := (b.start + i) % b.capacity
+		if b.kinds[idx] == OutputText {
+			result = append(result, b.lines[idx])
+		}
+	}
+	slices.Reverse(result)
+	return result
+}
+
 // Clear removes all lines from the buffer.
 func (b *OutputBuffer) Clear() {
 	b.mu.Lock()
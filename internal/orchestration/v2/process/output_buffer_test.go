@@ -219,3 +219,42 @@ func TestOutputBuffer_Len_CapsAtCapacity(t *testing.T) {
 
 	assert.Equal(t, 3, buf.Len())
 }
+
+func TestOutputBuffer_LastText_ReturnsOnlyText(t *testing.T) {
+	buf := NewOutputBuffer(10)
+
+	buf.AppendText("Reading the handler first.")
+	buf.Append("🔧 Read: handler.go")
+	buf.Append("[Read] package handler")
+	buf.AppendText("The bug is in the retry loop.")
+	buf.Append("⚠️ Error: rate limited")
+	buf.AppendText("Fixing it now.")
+
+	assert.Equal(t, []string{"The bug is in the retry loop.", "Fixing it now."}, buf.LastText(2))
+	assert.Equal(t, []string{
+		"Reading the handler first.",
+		"The bug is in the retry loop.",
+		"Fixing it now.",
+	}, buf.LastText(10))
+	assert.Equal(t, 6, buf.Len(), "all lines are still stored")
+}
+
+func TestOutputBuffer_LastText_WorksAfterWrapAround(t *testing.T) {
+	buf := NewOutputBuffer(3)
+
+	buf.AppendText("old text")
+	buf.Append("tool 1")
+	buf.AppendText("kept text")
+	buf.Append("tool 2") // Overwrites "old text"
+	buf.AppendText("newest text")
+
+	assert.Equal(t, []string{"kept text", "newest text"}, buf.LastText(5))
+}
+
+func TestOutputBuffer_LastText_ReturnsNilWithoutText(t *testing.T) {
+	buf := NewOutputBuffer(5)
+	buf.Append("tool call")
+
+	assert.Empty(t, buf.LastText(3))
+	assert.Nil(t, buf.LastText(0))
+}
@@ -0,0 +1,55 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/osproc"
+)
+
+// ErrProcessNotLive is returned by Probe when no live process is registered for the ID.
+var ErrProcessNotLive = errors.New("no live process")
+
+// RegistryProber checks that processes in a ProcessRegistry are responsive.
+type RegistryProber struct {
+	registry *ProcessRegistry
+	alive    func(pid int) bool
+}
+
+// NewRegistryProber creates a RegistryProber backed by the given registry.
+func NewRegistryProber(registry *ProcessRegistry) *RegistryProber {
+	return &RegistryProber{registry: registry, alive: osproc.IsAlive}
+}
+
+// Probe sends a no-op signal to the OS process running the given process's current turn
+// and returns its PID. A PID of 0 with no error means the process is registered but
+// between turns, so there is no OS process to signal.
+// Returns ErrProcessNotLive if the process is not registered or has been retired,
+// and an error if the OS process is gone or does not respond before ctx is done.
+func (p *RegistryProber) Probe(ctx context.Context, processID string) (int, error) {
+	proc := p.registry.Get(processID)
+	if proc == nil || proc.IsRetired() {
+		return 0, ErrProcessNotLive
+	}
+
+	pid := proc.PID()
+	if pid == 0 || !proc.IsRunning() {
+		return 0, nil
+	}
+
+	done := make(chan bool, 1)
+	go func() {
+		done <- p.alive(pid)
+	}()
+
+	select {
+	case ok := <-done:
+		if !ok {
+			return pid, fmt.Errorf("process %d is not running", pid)
+		}
+		return pid, nil
+	case <-ctx.Done():
+		return pid, fmt.Errorf("process %d did not respond: %w", pid, ctx.Err())
+	}
+}
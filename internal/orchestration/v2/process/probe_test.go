@@ -0,0 +1,88 @@
+package process
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// newProbeRegistry registers worker-1 backed by a headless process with the given PID and status.
+func newProbeRegistry(t *testing.T, pid int, status client.ProcessStatus) *ProcessRegistry {
+	t.Helper()
+	headless := mocks.NewMockHeadlessProcess(t)
+	headless.EXPECT().PID().Return(pid).Maybe()
+	headless.EXPECT().IsRunning().Return(status == client.StatusRunning).Maybe()
+
+	registry := NewProcessRegistry()
+	registry.Register(New("worker-1", repository.RoleWorker, headless, nil, nil))
+	return registry
+}
+
+func TestRegistryProber_RunningProcessResponds(t *testing.T) {
+	prober := NewRegistryProber(newProbeRegistry(t, os.Getpid(), client.StatusRunning))
+
+	pid, err := prober.Probe(context.Background(), "worker-1")
+
+	require.NoError(t, err)
+	require.Equal(t, os.Getpid(), pid)
+}
+
+func TestRegistryProber_BetweenTurns(t *testing.T) {
+	prober := NewRegistryProber(newProbeRegistry(t, 0, client.StatusCompleted))
+
+	pid, err := prober.Probe(context.Background(), "worker-1")
+
+	require.NoError(t, err)
+	require.Zero(t, pid)
+}
+
+func TestRegistryProber_NotRegistered(t *testing.T) {
+	prober := NewRegistryProber(NewProcessRegistry())
+
+	_, err := prober.Probe(context.Background(), "worker-1")
+
+	require.ErrorIs(t, err, ErrProcessNotLive)
+}
+
+func TestRegistryProber_Retired(t *testing.T) {
+	registry := newProbeRegistry(t, os.Getpid(), client.StatusRunning)
+	registry.Get("worker-1").SetRetired(true)
+
+	_, err := NewRegistryProber(registry).Probe(context.Background(), "worker-1")
+
+	require.ErrorIs(t, err, ErrProcessNotLive)
+}
+
+func TestRegistryProber_DeadProcess(t *testing.T) {
+	prober := NewRegistryProber(newProbeRegistry(t, 4242, client.StatusRunning))
+	prober.alive = func(int) bool { return false }
+
+	pid, err := prober.Probe(context.Background(), "worker-1")
+
+	require.Error(t, err)
+	require.Equal(t, 4242, pid)
+	require.Contains(t, err.Error(), "not running")
+}
+
+func TestRegistryProber_TimesOut(t *testing.T) {
+	prober := NewRegistryProber(newProbeRegistry(t, 4242, client.StatusRunning))
+	release := make(chan struct{})
+	defer close(release)
+	prober.alive = func(int) bool {
+		<-release
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := prober.Probe(ctx, "worker-1")
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -36,6 +36,9 @@ type Process struct {
 	ID string
 	// Role identifies whether this is coordinator or worker.
 	Role repository.ProcessRole
+	// Provider is the client type the process was spawned with. Empty when unknown.
+	// Set by the spawner before the process is registered.
+	Provider client.ClientType
 
 	proc         client.HeadlessProcess
 	output       *OutputBuffer
@@ -262,6 +265,27 @@ func (p *Process) handleOutputEvent(event *client.OutputEvent) {
 		return
 	}
 
+	// Claude reports rejected credentials as an assistant message with an error code,
+	// with the provider's explanation as the message text
+	if event.IsAssistant() && event.Error.IsAuthFailed() {
+		detail := event.GetErrorMessage()
+		if event.Message != nil && event.Message.GetText() != "" {
+			detail = event.Message.GetText()
+		}
+		p.handleAuthFailed(detail)
+		return
+	}
+
+	// Claude reports a hit rate limit the same way
+	if event.IsAssistant() && event.Error.IsRateLimited() {
+		detail := event.GetErrorMessage()
+		if event.Message != nil && event.Message.GetText() != "" {
+			detail = event.Message.GetText()
+		}
+		p.handleRateLimited(detail)
+		return
+	}
+
 	// Handle error events (e.g., turn.failed, error from Codex, context exceeded from OpenCode)
 	if event.Type == client.EventError {
 		errMsg := event.GetErrorMessage()
@@ -274,6 +298,16 @@ func (p *Process) handleOutputEvent(event *client.OutputEvent) {
 			return
 		}
 
+		if event.Error.IsAuthFailed() {
+			p.handleAuthFailed(errMsg)
+			return
+		}
+
+		if event.Error.IsRateLimited() {
+			p.handleRateLimited(errMsg)
+			return
+		}
+
 		p.handleInFlightError(fmt.Errorf("process error: %s", errMsg))
 		return
 	}
@@ -292,6 +326,16 @@ func (p *Process) handleOutputEvent(event *client.OutputEvent) {
 				return
 			}
 
+			if event.Error.IsAuthFailed() {
+				p.handleAuthFailed(errMsg)
+				return
+			}
+
+			if event.Error.IsRateLimited() {
+				p.handleRateLimited(errMsg)
+				return
+			}
+
 			// Publish immediately for real-time TUI visibility
 			p.handleInFlightError(fmt.Errorf("process error: %s", errMsg))
 			return
@@ -302,7 +346,7 @@ func (p *Process) handleOutputEvent(event *client.OutputEvent) {
 	if event.IsAssistant() && event.Message != nil {
 		text := event.Message.GetText()
 		if text != "" {
-			p.output.Append(text)
+			p.output.AppendText(text)
 			p.publishOutputEvent(text, event.Raw, event.Delta)
 		}
 
@@ -373,6 +417,20 @@ func (p *Process) handleInFlightError(err error) {
 	p.publishErrorEvent(err)
 }
 
+// handleAuthFailed records that the provider rejected its credentials.
+// The error wraps client.ErrProviderAuth so the handler can halt instead of retrying.
+func (p *Process) handleAuthFailed(errMsg string) {
+	p.output.Append("⚠️ Authentication failed, check credentials")
+	p.handleInFlightError(fmt.Errorf("%w: %s", client.ErrProviderAuth, errMsg))
+}
+
+// handleRateLimited records that the provider's rate limit was hit mid-turn.
+// The error wraps client.ErrRateLimited so the handler can move a worker to another provider.
+func (p *Process) handleRateLimited(errMsg string) {
+	p.output.Append("⚠️ Rate limited by provider")
+	p.handleInFlightError(fmt.Errorf("%w: %s", client.ErrRateLimited, errMsg))
+}
+
 // handleProcessComplete is called when the AI process finishes a turn.
 // It submits a ProcessTurnCompleteCommand for the handler to update repository.
 func (p *Process) handleProcessComplete() {
@@ -382,6 +382,36 @@ func TestHandleOutputEvent_BuffersOutputLines(t *testing.T) {
 	<-p.eventDone
 }
 
+func TestHandleOutputEvent_TagsAssistantTextForLastText(t *testing.T) {
+	proc := newMockHeadlessProcess()
+	p := New("worker-1", repository.RoleWorker, proc, nil, nil)
+	p.Start()
+
+	proc.events <- client.OutputEvent{
+		Type:    client.EventAssistant,
+		Delta:   true,
+		Message: &client.MessageContent{Content: []client.ContentBlock{{Type: "text", Text: "Checking the tests."}}},
+	}
+	proc.events <- client.OutputEvent{
+		Type: client.EventAssistant,
+		Message: &client.MessageContent{Content: []client.ContentBlock{
+			{Type: "tool_use", Name: "Bash", Input: []byte(`{"command":"go test ./..."}`)},
+		}},
+	}
+	proc.events <- client.OutputEvent{
+		Type: client.EventToolResult,
+		Tool: &client.ToolContent{Name: "Bash", Output: "ok"},
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	assert.Len(t, p.Output().Lines(), 3)
+	assert.Equal(t, []string{"Checking the tests."}, p.Output().LastText(5))
+
+	proc.Complete()
+	<-p.eventDone
+}
+
 func TestHandleProcessComplete_SubmitsProcessTurnCompleteCommand(t *testing.T) {
 	proc := newMockHeadlessProcess()
 	proc.status = client.StatusCompleted
@@ -1725,6 +1755,130 @@ func TestHandleOutputEvent_DetectsContextExceededInEventError(t *testing.T) {
 	assert.True(t, errors.As(turnCmd.Error, &contextErr), "error should be ContextExceededError")
 }
 
+// ===========================================================================
+// Provider Authentication Detection Tests
+// ===========================================================================
+
+func TestHandleOutputEvent_DetectsAuthFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      client.OutputEvent
+		wantDetail string
+	}{
+		{
+			name: "assistant message with error code (Claude)",
+			event: client.OutputEvent{
+				Type: client.EventAssistant,
+				Message: &client.MessageContent{Role: "assistant", Content: []client.ContentBlock{
+					{Type: "text", Text: "Invalid API key · Please run /login"},
+				}},
+				Error: &client.ErrorInfo{Code: "authentication_failed", Reason: client.ErrReasonAuthFailed},
+			},
+			wantDetail: "Invalid API key · Please run /login",
+		},
+		{
+			name: "error event",
+			event: client.OutputEvent{
+				Type:  client.EventError,
+				Error: &client.ErrorInfo{Message: "OAuth token has expired", Reason: client.ErrReasonAuthFailed},
+			},
+			wantDetail: "OAuth token has expired",
+		},
+		{
+			name: "error result",
+			event: client.OutputEvent{
+				Type:          client.EventResult,
+				IsErrorResult: true,
+				Result:        "Invalid API key · Please run /login",
+				Error:         &client.ErrorInfo{Message: "Invalid API key · Please run /login", Reason: client.ErrReasonAuthFailed},
+			},
+			wantDetail: "Invalid API key · Please run /login",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proc := newMockHeadlessProcess()
+			submitter := &mockCommandSubmitter{}
+			p := New("worker-1", repository.RoleWorker, proc, submitter, nil)
+			p.Start()
+
+			proc.events <- tt.event
+			proc.Complete()
+			<-p.eventDone
+
+			require.Contains(t, strings.Join(p.Output().Lines(), "\n"), "⚠️ Authentication failed, check credentials")
+
+			submitted := submitter.getSubmitted()
+			require.Len(t, submitted, 1)
+			turnCmd := submitted[0].(*command.ProcessTurnCompleteCommand)
+			require.False(t, turnCmd.Succeeded)
+			require.ErrorIs(t, turnCmd.Error, client.ErrProviderAuth)
+			require.Contains(t, turnCmd.Error.Error(), tt.wantDetail)
+		})
+	}
+}
+
+func TestHandleOutputEvent_DetectsRateLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      client.OutputEvent
+		wantDetail string
+	}{
+		{
+			name: "assistant message with error code (Claude)",
+			event: client.OutputEvent{
+				Type: client.EventAssistant,
+				Message: &client.MessageContent{Role: "assistant", Content: []client.ContentBlock{
+					{Type: "text", Text: "API Error: Rate limit reached"},
+				}},
+				Error: &client.ErrorInfo{Code: "rate_limit_exceeded", Reason: client.ErrReasonRateLimited},
+			},
+			wantDetail: "API Error: Rate limit reached",
+		},
+		{
+			name: "error event",
+			event: client.OutputEvent{
+				Type:  client.EventError,
+				Error: &client.ErrorInfo{Message: "429 Too Many Requests", Reason: client.ErrReasonRateLimited},
+			},
+			wantDetail: "429 Too Many Requests",
+		},
+		{
+			name: "error result",
+			event: client.OutputEvent{
+				Type:          client.EventResult,
+				IsErrorResult: true,
+				Result:        "usage limit reached",
+				Error:         &client.ErrorInfo{Message: "usage limit reached", Reason: client.ErrReasonRateLimited},
+			},
+			wantDetail: "usage limit reached",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proc := newMockHeadlessProcess()
+			submitter := &mockCommandSubmitter{}
+			p := New("worker-1", repository.RoleWorker, proc, submitter, nil)
+			p.Start()
+
+			proc.events <- tt.event
+			proc.Complete()
+			<-p.eventDone
+
+			require.Contains(t, strings.Join(p.Output().Lines(), "\n"), "⚠️ Rate limited by provider")
+
+			submitted := submitter.getSubmitted()
+			require.Len(t, submitted, 1)
+			turnCmd := submitted[0].(*command.ProcessTurnCompleteCommand)
+			require.False(t, turnCmd.Succeeded)
+			require.ErrorIs(t, turnCmd.Error, client.ErrRateLimited)
+			require.Contains(t, turnCmd.Error.Error(), tt.wantDetail)
+		})
+	}
+}
+
 // ===========================================================================
 // Error Preservation Tests
 // ===========================================================================
@@ -53,6 +53,17 @@ func (r *ProcessRegistry) Get(id string) *Process {
 	return r.processes[id]
 }
 
+// LastText returns the last n lines of assistant text produced by the given process,
+// excluding tool calls and tool output.
+// Returns ErrProcessNotLive if no process is registered for the ID.
+func (r *ProcessRegistry) LastText(id string, n int) ([]string, error) {
+	proc := r.Get(id)
+	if proc == nil {
+		return nil, ErrProcessNotLive
+	}
+	return proc.Output().LastText(n), nil
+}
+
 // GetCoordinator returns the coordinator process, or nil if not registered.
 func (r *ProcessRegistry) GetCoordinator() *Process {
 	return r.Get(repository.CoordinatorID)
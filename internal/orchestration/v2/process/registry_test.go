@@ -78,6 +78,21 @@ func TestProcessRegistry_Get_ReturnsNilForUnknownID(t *testing.T) {
 	assert.Nil(t, got)
 }
 
+func TestProcessRegistry_LastText_ReturnsProcessText(t *testing.T) {
+	reg := NewProcessRegistry()
+	proc := &Process{ID: "worker-1", Role: repository.RoleWorker, output: NewOutputBuffer(10)}
+	proc.output.AppendText("thinking")
+	proc.output.Append("[Read] file contents")
+	reg.Register(proc)
+
+	lines, err := reg.LastText("worker-1", 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"thinking"}, lines)
+
+	_, err = reg.LastText("nonexistent", 5)
+	assert.ErrorIs(t, err, ErrProcessNotLive)
+}
+
 func TestProcessRegistry_GetCoordinator_ReturnsCoordinatorProcess(t *testing.T) {
 	reg := NewProcessRegistry()
 	coord := &Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator}
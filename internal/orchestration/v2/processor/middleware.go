@@ -306,6 +306,7 @@ func (m *DeduplicationMiddleware) computeContentHash(cmd command.Command) string
 		h.Write([]byte(c.Content))
 	case *command.BroadcastCommand:
 		h.Write([]byte(c.Content))
+		h.Write([]byte(c.Recipient))
 		for _, w := range c.ExcludeWorkers {
 			h.Write([]byte(w))
 		}
@@ -0,0 +1,165 @@
+package processor
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ===========================================================================
+// Transition Log Middleware
+// ===========================================================================
+
+// PhaseTransition records a single process phase change caused by a command.
+type PhaseTransition struct {
+	WorkerID  string
+	TaskID    string              // Task assigned after the transition (or before, if it was cleared)
+	FromPhase events.ProcessPhase // Empty if the process had no phase
+	ToPhase   events.ProcessPhase // Empty if the process no longer has a phase
+	Trigger   command.CommandType // Command that caused the transition (e.g. assign_task, report_complete)
+	Source    command.CommandSource
+	CommandID string
+	TraceID   string
+	Timestamp time.Time
+}
+
+// TransitionLogger receives phase transitions for auditing the worker state machine.
+// Implementations must be safe for use from the processor goroutine.
+type TransitionLogger interface {
+	LogTransition(t PhaseTransition)
+}
+
+// SlogTransitionLogger writes each transition as a structured log record.
+type SlogTransitionLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogTransitionLogger creates a TransitionLogger that writes to the given slog logger.
+func NewSlogTransitionLogger(logger *slog.Logger) *SlogTransitionLogger {
+	return &SlogTransitionLogger{logger: logger}
+}
+
+// LogTransition implements TransitionLogger.
+func (l *SlogTransitionLogger) LogTransition(t PhaseTransition) {
+	l.logger.LogAttrs(context.Background(), slog.LevelInfo, "phase transition",
+		slog.String("worker_id", t.WorkerID),
+		slog.String("task_id", t.TaskID),
+		slog.String("from_phase", string(t.FromPhase)),
+		slog.String("to_phase", string(t.ToPhase)),
+		slog.String("trigger", t.Trigger.String()),
+		slog.String("source", string(t.Source)),
+		slog.String("command_id", t.CommandID),
+		slog.String("trace_id", t.TraceID),
+	)
+}
+
+// TransitionLogMiddlewareConfig configures the transition log middleware.
+type TransitionLogMiddlewareConfig struct {
+	// ProcessRepo is read before and after each command to detect phase changes.
+	ProcessRepo repository.ProcessRepository
+	// Logger receives detected transitions.
+	// If nil (or ProcessRepo is nil), the middleware is a no-op.
+	Logger TransitionLogger
+	// Clock returns the transition timestamp. Defaults to time.Now.
+	Clock func() time.Time
+}
+
+// phaseState is the part of a process compared across a command.
+type phaseState struct {
+	phase  events.ProcessPhase
+	taskID string
+}
+
+// NewTransitionLogMiddleware creates a middleware that logs every process phase change
+// made while handling a command, with the command as the trigger. Comparing repository
+// state around each handler covers all transition paths (task assignment, state transition
+// handlers, and TransitionPhase enforcement) without instrumenting each one.
+func NewTransitionLogMiddleware(cfg TransitionLogMiddlewareConfig) Middleware {
+	clock := cfg.Clock
+	if clock == nil {
+		clock = time.Now
+	}
+
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			if cfg.Logger == nil || cfg.ProcessRepo == nil {
+				return next.Handle(ctx, cmd)
+			}
+
+			before := snapshotPhases(cfg.ProcessRepo)
+			result, err := next.Handle(ctx, cmd)
+			after := snapshotPhases(cfg.ProcessRepo)
+
+			var source command.CommandSource
+			if hasSource, ok := cmd.(interface{ Source() command.CommandSource }); ok {
+				source = hasSource.Source()
+			}
+			var traceID string
+			if hasTraceID, ok := cmd.(interface{ TraceID() string }); ok {
+				traceID = hasTraceID.TraceID()
+			}
+
+			now := clock()
+			for _, id := range changedPhases(before, after) {
+				from, to := before[id], after[id]
+				taskID := to.taskID
+				if taskID == "" {
+					taskID = from.taskID
+				}
+				cfg.Logger.LogTransition(PhaseTransition{
+					WorkerID:  id,
+					TaskID:    taskID,
+					FromPhase: from.phase,
+					ToPhase:   to.phase,
+					Trigger:   cmd.Type(),
+					Source:    source,
+					CommandID: cmd.ID(),
+					TraceID:   traceID,
+					Timestamp: now,
+				})
+			}
+
+			return result, err
+		})
+	}
+}
+
+// snapshotPhases captures the phase and task of every process that has a phase.
+// Stopped, retired and failed processes keep their last phase in the repository but
+// are treated as having none, so leaving the pool is logged as a transition too.
+func snapshotPhases(repo repository.ProcessRepository) map[string]phaseState {
+	states := make(map[string]phaseState)
+	for _, p := range repo.List() {
+		if p.Phase == nil {
+			continue
+		}
+		switch p.Status {
+		case repository.StatusStopped, repository.StatusRetired, repository.StatusFailed:
+			continue
+		}
+		states[p.ID] = phaseState{phase: *p.Phase, taskID: p.TaskID}
+	}
+	return states
+}
+
+// changedPhases returns the IDs of processes whose phase differs between snapshots, sorted.
+func changedPhases(before, after map[string]phaseState) []string {
+	var ids []string
+	for id, b := range before {
+		if after[id].phase != b.phase {
+			ids = append(ids, id)
+		}
+	}
+	for id, a := range after {
+		if _, ok := before[id]; !ok && a.phase != "" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
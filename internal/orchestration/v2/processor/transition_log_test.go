@@ -0,0 +1,169 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// recordingTransitionLogger captures transitions for assertions.
+type recordingTransitionLogger struct {
+	transitions []PhaseTransition
+}
+
+func (l *recordingTransitionLogger) LogTransition(t PhaseTransition) {
+	l.transitions = append(l.transitions, t)
+}
+
+// setPhaseHandler returns a handler that moves the given processes to new phases and tasks.
+func setPhaseHandler(repo *repository.MemoryProcessRepository, changes map[string]phaseState) CommandHandler {
+	return HandlerFunc(func(_ context.Context, _ command.Command) (*command.CommandResult, error) {
+		for id, change := range changes {
+			proc, err := repo.Get(id)
+			if err != nil {
+				return nil, err
+			}
+			phase := change.phase
+			proc.Phase = &phase
+			proc.TaskID = change.taskID
+			if err := repo.Save(proc); err != nil {
+				return nil, err
+			}
+		}
+		return &command.CommandResult{Success: true}, nil
+	})
+}
+
+func newTransitionLogRepo(t *testing.T) *repository.MemoryProcessRepository {
+	t.Helper()
+	repo := repository.NewMemoryProcessRepository()
+	for _, id := range []string{"worker-1", "worker-2"} {
+		idle := events.ProcessPhaseIdle
+		require.NoError(t, repo.Save(&repository.Process{ID: id, Role: repository.RoleWorker, Phase: &idle}))
+	}
+	require.NoError(t, repo.Save(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator}))
+	return repo
+}
+
+func TestTransitionLogMiddleware_LogsImplementReviewSequence(t *testing.T) {
+	repo := newTransitionLogRepo(t)
+	var buf bytes.Buffer
+	logger := NewSlogTransitionLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+	mw := NewTransitionLogMiddleware(TransitionLogMiddlewareConfig{ProcessRepo: repo, Logger: logger})
+
+	steps := []struct {
+		cmd     command.Command
+		changes map[string]phaseState
+	}{
+		{
+			cmd: command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc.1", "", ""),
+			changes: map[string]phaseState{
+				"worker-1": {phase: events.ProcessPhaseImplementing, taskID: "perles-abc.1"},
+			},
+		},
+		{
+			cmd: command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", ""),
+			changes: map[string]phaseState{
+				"worker-1": {phase: events.ProcessPhaseAwaitingReview, taskID: "perles-abc.1"},
+			},
+		},
+		{
+			cmd: command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc.1", "worker-1", command.ReviewTypeComplex),
+			changes: map[string]phaseState{
+				"worker-2": {phase: events.ProcessPhaseReviewing, taskID: "perles-abc.1"},
+			},
+		},
+		{
+			cmd: command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictApproved, "LGTM"),
+			changes: map[string]phaseState{
+				"worker-1": {phase: events.ProcessPhaseCommitting, taskID: "perles-abc.1"},
+				"worker-2": {phase: events.ProcessPhaseIdle},
+			},
+		},
+		{
+			// A command that does not change any phase is not logged
+			cmd: command.NewReportTestResultsCommand(command.SourceMCPTool, "worker-1", 3, 0, ""),
+		},
+	}
+
+	for _, step := range steps {
+		result, err := mw(setPhaseHandler(repo, step.changes)).Handle(context.Background(), step.cmd)
+		require.NoError(t, err)
+		require.True(t, result.Success)
+	}
+
+	type record struct {
+		Msg       string `json:"msg"`
+		WorkerID  string `json:"worker_id"`
+		TaskID    string `json:"task_id"`
+		FromPhase string `json:"from_phase"`
+		ToPhase   string `json:"to_phase"`
+		Trigger   string `json:"trigger"`
+		Source    string `json:"source"`
+	}
+	var got []record
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var r record
+		require.NoError(t, dec.Decode(&r))
+		got = append(got, r)
+	}
+
+	require.Equal(t, []record{
+		{"phase transition", "worker-1", "perles-abc.1", "idle", "implementing", "assign_task", "mcp_tool"},
+		{"phase transition", "worker-1", "perles-abc.1", "implementing", "awaiting_review", "report_complete", "mcp_tool"},
+		{"phase transition", "worker-2", "perles-abc.1", "idle", "reviewing", "assign_review", "mcp_tool"},
+		{"phase transition", "worker-1", "perles-abc.1", "awaiting_review", "committing", "report_verdict", "mcp_tool"},
+		{"phase transition", "worker-2", "perles-abc.1", "reviewing", "idle", "report_verdict", "mcp_tool"},
+	}, got)
+}
+
+func TestTransitionLogMiddleware_RecordsCommandMetadata(t *testing.T) {
+	repo := newTransitionLogRepo(t)
+	logger := &recordingTransitionLogger{}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	mw := NewTransitionLogMiddleware(TransitionLogMiddlewareConfig{
+		ProcessRepo: repo,
+		Logger:      logger,
+		Clock:       func() time.Time { return now },
+	})
+
+	cmd := command.NewTransitionPhaseCommand(command.SourceInternal, "worker-1", events.ProcessPhaseImplementing)
+	cmd.SetTraceID("trace-1")
+	_, err := mw(setPhaseHandler(repo, map[string]phaseState{
+		"worker-1": {phase: events.ProcessPhaseImplementing},
+	})).Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	require.Equal(t, []PhaseTransition{{
+		WorkerID:  "worker-1",
+		FromPhase: events.ProcessPhaseIdle,
+		ToPhase:   events.ProcessPhaseImplementing,
+		Trigger:   command.CmdTransitionPhase,
+		Source:    command.SourceInternal,
+		CommandID: cmd.ID(),
+		TraceID:   "trace-1",
+		Timestamp: now,
+	}}, logger.transitions)
+}
+
+func TestTransitionLogMiddleware_NoopWithoutLogger(t *testing.T) {
+	repo := newTransitionLogRepo(t)
+	mw := NewTransitionLogMiddleware(TransitionLogMiddlewareConfig{ProcessRepo: repo})
+
+	result, err := mw(setPhaseHandler(repo, map[string]phaseState{
+		"worker-1": {phase: events.ProcessPhaseImplementing},
+	})).Handle(context.Background(), command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc.1", "", ""))
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
@@ -0,0 +1,105 @@
+package processor
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+)
+
+// ===========================================================================
+// Utilization Tracking
+// ===========================================================================
+
+// UtilizationTracker is a TransitionLogger that accumulates how long each process spends
+// in each phase. A process is tracked from its first observed transition; its clock stops
+// when it leaves its last phase (e.g. when it is retired).
+type UtilizationTracker struct {
+	mu     sync.Mutex
+	clock  func() time.Time
+	clocks map[string]*phaseClock
+}
+
+// phaseClock is the time-in-phase record of one process.
+type phaseClock struct {
+	phase     events.ProcessPhase // Empty while the process has no phase
+	since     time.Time
+	durations map[events.ProcessPhase]time.Duration
+}
+
+// NewUtilizationTracker creates an empty UtilizationTracker.
+func NewUtilizationTracker() *UtilizationTracker {
+	return &UtilizationTracker{
+		clock:  time.Now,
+		clocks: make(map[string]*phaseClock),
+	}
+}
+
+// LogTransition implements TransitionLogger. The time since the process's previous
+// transition is credited to the phase it is leaving.
+func (u *UtilizationTracker) LogTransition(t PhaseTransition) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	c, ok := u.clocks[t.WorkerID]
+	if !ok {
+		c = &phaseClock{durations: make(map[events.ProcessPhase]time.Duration)}
+		u.clocks[t.WorkerID] = c
+	}
+	if c.phase != "" {
+		if d := t.Timestamp.Sub(c.since); d > 0 {
+			c.durations[c.phase] += d
+		}
+	}
+	c.phase = t.ToPhase
+	c.since = t.Timestamp
+}
+
+// PhaseDurations returns the accumulated time each process has spent in each phase,
+// keyed by process ID. A process's current phase counts up to now.
+func (u *UtilizationTracker) PhaseDurations() map[string]map[events.ProcessPhase]time.Duration {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	now := u.clock()
+	result := make(map[string]map[events.ProcessPhase]time.Duration, len(u.clocks))
+	for id, c := range u.clocks {
+		durations := maps.Clone(c.durations)
+		if c.phase != "" {
+			if d := now.Sub(c.since); d > 0 {
+				durations[c.phase] += d
+			}
+		}
+		result[id] = durations
+	}
+	return result
+}
+
+// multiTransitionLogger fans each transition out to several loggers.
+type multiTransitionLogger []TransitionLogger
+
+// LogTransition implements TransitionLogger.
+func (m multiTransitionLogger) LogTransition(t PhaseTransition) {
+	for _, l := range m {
+		l.LogTransition(t)
+	}
+}
+
+// TransitionLoggers combines loggers into one that passes every transition to each of
+// them in order. Nil loggers are skipped; if none remain, TransitionLoggers returns nil.
+func TransitionLoggers(loggers ...TransitionLogger) TransitionLogger {
+	var combined multiTransitionLogger
+	for _, l := range loggers {
+		if l != nil {
+			combined = append(combined, l)
+		}
+	}
+	switch len(combined) {
+	case 0:
+		return nil
+	case 1:
+		return combined[0]
+	}
+	return combined
+}
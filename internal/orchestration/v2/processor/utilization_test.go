@@ -0,0 +1,78 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func TestUtilizationTracker_AccumulatesTimeInPhase(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewUtilizationTracker()
+	tracker.clock = func() time.Time { return start.Add(60 * time.Minute) }
+
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	for _, tr := range []PhaseTransition{
+		{WorkerID: "worker-1", ToPhase: events.ProcessPhaseIdle, Timestamp: at(0)},
+		{WorkerID: "worker-1", FromPhase: events.ProcessPhaseIdle, ToPhase: events.ProcessPhaseImplementing, Timestamp: at(10)},
+		{WorkerID: "worker-1", FromPhase: events.ProcessPhaseImplementing, ToPhase: events.ProcessPhaseAwaitingReview, Timestamp: at(40)},
+		{WorkerID: "worker-1", FromPhase: events.ProcessPhaseAwaitingReview, ToPhase: events.ProcessPhaseIdle, Timestamp: at(50)},
+		{WorkerID: "worker-2", ToPhase: events.ProcessPhaseIdle, Timestamp: at(0)},
+		{WorkerID: "worker-2", FromPhase: events.ProcessPhaseIdle, ToPhase: events.ProcessPhaseReviewing, Timestamp: at(40)},
+		{WorkerID: "worker-2", FromPhase: events.ProcessPhaseReviewing, Timestamp: at(50)}, // retired
+	} {
+		tracker.LogTransition(tr)
+	}
+
+	durations := tracker.PhaseDurations()
+
+	require.Equal(t, map[events.ProcessPhase]time.Duration{
+		events.ProcessPhaseIdle:           20 * time.Minute, // 10 before the task, 10 up to now
+		events.ProcessPhaseImplementing:   30 * time.Minute,
+		events.ProcessPhaseAwaitingReview: 10 * time.Minute,
+	}, durations["worker-1"])
+	require.Equal(t, map[events.ProcessPhase]time.Duration{
+		events.ProcessPhaseIdle:      40 * time.Minute,
+		events.ProcessPhaseReviewing: 10 * time.Minute,
+	}, durations["worker-2"], "a retired worker stops accumulating")
+}
+
+func TestTransitionLogMiddleware_LogsRetirementAsLeavingPhase(t *testing.T) {
+	repo := newTransitionLogRepo(t)
+	logger := &recordingTransitionLogger{}
+	mw := NewTransitionLogMiddleware(TransitionLogMiddlewareConfig{ProcessRepo: repo, Logger: logger})
+
+	retire := HandlerFunc(func(_ context.Context, _ command.Command) (*command.CommandResult, error) {
+		proc, err := repo.Get("worker-1")
+		if err != nil {
+			return nil, err
+		}
+		proc.Status = repository.StatusRetired
+		return &command.CommandResult{Success: true}, repo.Save(proc)
+	})
+
+	_, err := mw(retire).Handle(context.Background(), command.NewRetireProcessCommand(command.SourceMCPTool, "worker-1", "done"))
+	require.NoError(t, err)
+
+	require.Len(t, logger.transitions, 1)
+	require.Equal(t, "worker-1", logger.transitions[0].WorkerID)
+	require.Equal(t, events.ProcessPhaseIdle, logger.transitions[0].FromPhase)
+	require.Empty(t, logger.transitions[0].ToPhase)
+}
+
+func TestTransitionLoggers_FansOutAndSkipsNil(t *testing.T) {
+	require.Nil(t, TransitionLoggers(nil, nil))
+
+	first, second := &recordingTransitionLogger{}, &recordingTransitionLogger{}
+	require.Same(t, first, TransitionLoggers(nil, first))
+
+	TransitionLoggers(first, nil, second).LogTransition(PhaseTransition{WorkerID: "worker-1"})
+	require.Len(t, first.transitions, 1)
+	require.Len(t, second.transitions, 1)
+}
@@ -27,10 +27,31 @@ var systemPromptTemplate = template.Must(template.New("prompt-mode").Parse(`
 
 ## Your Tools (MCP)
 - query_worker_state: view worker status (use ONLY when user asks, NEVER to poll)
-- assign_task: assign a bd task to exactly ONE ready worker
-- assign_task_review: assign a review task to exactly ONE ready worker
-- assign_review_feedback: assign feedback incorporation to exactly ONE ready worker
+- ping_worker: confirm one worker is still alive (probe=true also checks its process responds)
+- peek_worker: read the last few lines of a worker's reasoning (no tool output) to see what it is thinking
+- label_worker: give a worker a readable label (e.g., "auth-impl") shown in query_worker_state; keep addressing it by ID
+- list_workflows: list the other workflows running alongside yours, with their epics and task progress
+- list_models: list the model names the worker provider accepts (workers run on the configured model)
+- assign_task: assign a bd task to exactly ONE ready worker (omit worker_id to pick the ready worker idle longest; set estimate_minutes for long tasks so they are not flagged stuck early)
+- assign_tasks_batch: assign several independent bd tasks to ready workers in one call (one task per worker)
+- get_eligible_workers: list the workers that could take a given bd task right now, and why each other worker cannot
+- assign_task_review: assign a review task to exactly ONE ready worker (pass reviewer_id "auto" to let the system pick one other than the implementer)
+- assign_review_feedback: assign feedback incorporation to exactly ONE ready worker (pass items to track each required change)
+- transfer_task: move an in-flight task from a struggling worker to a ready worker, keeping its phase
+- drain_worker: scale down by moving all of a worker's tasks to ready workers and retiring it; any task reported orphaned still needs reassigning
+- get_diff_since_last_review: show only what changed in a task since its last review verdict
+- get_changed_files: list the files a task has changed in its worktree, without the diff
+- list_orphaned_tasks: list active tasks whose implementer or reviewer is retired, failed, or missing so you can reassign them
+- get_task_timings: see how long a task spent implementing, awaiting review, reviewing and committing
+- get_worker_backlog / clear_worker_backlog: see how many messages each worker has left unread; clear a worker's backlog before replacing it
+- get_message_content: read the full content of a message the log truncated (its marker names the message_id)
+- get_utilization: see what fraction of worker time went to working, reviewing and sitting ready, to judge whether you spawned too many or too few workers
+- get_headroom: see how many tasks you can assign right now (ready workers minus those reserved for pending reviews) and how many more workers the pool allows
 - approve_commit: approve and instruct a worker to commit its output
+- set_global_instruction / clear_global_instruction: add or remove a constraint (e.g., "the DB migration is frozen") included in every future task assignment
+- set_focus / get_focus: steer every future task assignment toward a focus area (e.g., "the internal/parser module"); set a blank focus to clear it
+- hold_task / release_task: keep a task from being assigned (e.g., pending a human decision) without failing or blocking it; held tasks are flagged in query_worker_state
+- export_state / import_state: save worker and task assignments as JSON and restore them later (import requires the same workers to be active)
 - fabric_send: send a message to a channel with @mentions (e.g., "@worker-1 please clarify...")
 - fabric_reply: reply to an existing thread
 - fabric_react: add/remove emoji reaction to a message (e.g., 👍 to acknowledge, ✅ for approval)
@@ -38,8 +59,13 @@ var systemPromptTemplate = template.Must(template.New("prompt-mode").Parse(`
 - fabric_inbox: check for unread messages across channels (use ONLY after context refresh, NEVER to poll)
 - fabric_history: read channel message history
 - get_task_status / mark_task_complete / mark_task_failed: bd task tracking
+- add_task_blocker: record in bd that a task is blocked by another issue
+- sync_task_status: re-read a task's bd status and correct the coordinator's record if it was changed directly in bd
+- complete_epic_tasks: close all open subtasks of an epic at once (subtasks still assigned to a worker are skipped; if it returns a confirm_token, review the impact and call again with the token)
+- get_critical_path: find the longest dependency chain through an epic's open subtasks, to decide what to start first
+- get_dependency_graph: export an epic's subtasks and their blocked_by/parent relationships as JSON nodes and edges
 - spawn_worker: starts a new worker, **YOU MUST** wait for "ready" message before delegating work
-- replace_worker: replace a worker with a new worker
+- replace_worker: replace a worker with a new worker (reassign=true hands its in-progress task, progress summary and changed files to the replacement)
 - retire_worker: retires a worker that is no longer needed
 - stop_worker: stops a worker from working
 
@@ -212,6 +238,93 @@ func BuildWorkerOutOfContextPrompt(workerID string, taskID string) string {
 	return prompt.String()
 }
 
+// BuildWorkerAuthFailedPrompt creates the message posted to the coordinator when a worker's
+// provider rejects its credentials. Every spawn fails the same way until the user fixes the
+// credentials, so the coordinator is told to halt rather than replace the worker.
+func BuildWorkerAuthFailedPrompt(workerID, taskID, detail string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("[PROVIDER AUTHENTICATION FAILED]\n\n")
+	prompt.WriteString(fmt.Sprintf("Worker `%s` stopped because its AI provider rejected the credentials: authentication failed, check credentials.\n", workerID))
+	if detail != "" {
+		prompt.WriteString(fmt.Sprintf("Provider error: %s\n", detail))
+	}
+	if taskID != "" {
+		prompt.WriteString(fmt.Sprintf("The worker was working on task `%s`.\n", taskID))
+	}
+
+	prompt.WriteString("\nREQUIRED ACTION:\n")
+	prompt.WriteString("1. Do NOT spawn, replace or retry workers - they will fail the same way until the credentials are fixed\n")
+	prompt.WriteString("2. Use `notify_user` to tell the user authentication failed and they need to check their provider credentials\n")
+	prompt.WriteString("3. Use `signal_workflow_complete` with status `aborted` to halt the workflow\n")
+
+	return prompt.String()
+}
+
+// BuildWorkerRetirementRequestedPrompt creates the message posted to the coordinator when a
+// worker asks to be retired. The worker is replaced automatically once its current turn ends.
+func BuildWorkerRetirementRequestedPrompt(workerID, taskID, reason string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("[WORKER RETIREMENT REQUESTED]\n\n")
+	prompt.WriteString(fmt.Sprintf("Worker `%s` has asked to be retired: %s\n\n", workerID, reason))
+	prompt.WriteString(fmt.Sprintf("`%s` will be replaced with a fresh worker automatically when its current turn ends.\n\n", workerID))
+
+	prompt.WriteString("REQUIRED ACTION:\n")
+	prompt.WriteString("1. Wait for the replacement worker to send a \"ready\" message\n")
+	if taskID != "" {
+		prompt.WriteString(fmt.Sprintf("2. The retiring worker was working on task `%s`. Use `assign_task` to assign this task to the replacement worker and include in the summary they need to check for existing work since they are taking over from a previous worker.\n", taskID))
+	} else {
+		prompt.WriteString("2. The retiring worker had no assigned task. No reassignment is needed.\n")
+	}
+
+	prompt.WriteString(fmt.Sprintf("\nDo NOT send new work to `%s`.\n", workerID))
+
+	return prompt.String()
+}
+
+// HandoffWorkerSummary describes a single worker in an automatic handoff summary.
+type HandoffWorkerSummary struct {
+	WorkerID string
+	Status   string
+	Phase    string
+	TaskID   string
+}
+
+// BuildHandoffSummaryPrompt creates the message posted to the coordinator when its
+// context size crosses the configured handoff threshold. It includes a snapshot of
+// worker state and asks the coordinator to post a handoff message before its
+// context is exhausted.
+func BuildHandoffSummaryPrompt(contextTokens, threshold int, workers []HandoffWorkerSummary) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("[CONTEXT HANDOFF - PREPARE NOW]\n\n")
+	prompt.WriteString(fmt.Sprintf("Your context has grown to %dk tokens, past the %dk handoff threshold.\n", contextTokens/1000, threshold/1000))
+	prompt.WriteString("You may be replaced with a fresh session soon.\n\n")
+
+	prompt.WriteString("CURRENT WORKER STATE:\n")
+	if len(workers) == 0 {
+		prompt.WriteString("- No active workers\n")
+	}
+	for _, w := range workers {
+		line := fmt.Sprintf("- %s: %s", w.WorkerID, w.Status)
+		if w.Phase != "" {
+			line += fmt.Sprintf(" (%s)", w.Phase)
+		}
+		if w.TaskID != "" {
+			line += fmt.Sprintf(" on task `%s`", w.TaskID)
+		}
+		prompt.WriteString(line + "\n")
+	}
+
+	prompt.WriteString("\nREQUIRED ACTION:\n")
+	prompt.WriteString("1. Post a handoff message to the message log summarizing the work in progress, decisions made, and next steps\n")
+	prompt.WriteString("2. Include anything the worker state above does not capture (pending reviews, user instructions, open questions)\n")
+	prompt.WriteString("3. Continue coordinating as normal after posting the handoff\n")
+
+	return prompt.String()
+}
+
 // BuildReplacePrompt creates a comprehensive prompt for a replacement coordinator.
 // Since the new session has fresh context, we need to provide enough information
 // for the coordinator to understand the current state and continue orchestrating.
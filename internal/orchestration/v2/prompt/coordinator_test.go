@@ -9,6 +9,54 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 )
 
+// ============================================================================
+// BuildHandoffSummaryPrompt Tests
+// ============================================================================
+
+// TestBuildHandoffSummaryPrompt_IncludesWorkerState verifies the prompt lists each worker.
+func TestBuildHandoffSummaryPrompt_IncludesWorkerState(t *testing.T) {
+	prompt := BuildHandoffSummaryPrompt(150000, 140000, []HandoffWorkerSummary{
+		{WorkerID: "worker-1", Status: "working", Phase: "implementing", TaskID: "perles-abc.1"},
+		{WorkerID: "worker-2", Status: "ready"},
+	})
+
+	require.Contains(t, prompt, "[CONTEXT HANDOFF - PREPARE NOW]")
+	require.Contains(t, prompt, "150k tokens")
+	require.Contains(t, prompt, "140k handoff threshold")
+	require.Contains(t, prompt, "- worker-1: working (implementing) on task `perles-abc.1`")
+	require.Contains(t, prompt, "- worker-2: ready\n")
+}
+
+// TestBuildHandoffSummaryPrompt_NoWorkers verifies the prompt handles an empty worker list.
+func TestBuildHandoffSummaryPrompt_NoWorkers(t *testing.T) {
+	prompt := BuildHandoffSummaryPrompt(150000, 140000, nil)
+
+	require.Contains(t, prompt, "- No active workers")
+}
+
+// ============================================================================
+// BuildWorkerRetirementRequestedPrompt Tests
+// ============================================================================
+
+// TestBuildWorkerRetirementRequestedPrompt_WithTask verifies the prompt asks for task reassignment.
+func TestBuildWorkerRetirementRequestedPrompt_WithTask(t *testing.T) {
+	prompt := BuildWorkerRetirementRequestedPrompt("worker-1", "perles-abc.1", "context is corrupted")
+
+	require.Contains(t, prompt, "[WORKER RETIREMENT REQUESTED]")
+	require.Contains(t, prompt, "Worker `worker-1` has asked to be retired: context is corrupted")
+	require.Contains(t, prompt, "replaced with a fresh worker automatically")
+	require.Contains(t, prompt, "Use `assign_task` to assign this task")
+	require.Contains(t, prompt, "`perles-abc.1`")
+}
+
+// TestBuildWorkerRetirementRequestedPrompt_NoTask verifies the prompt handles a worker without a task.
+func TestBuildWorkerRetirementRequestedPrompt_NoTask(t *testing.T) {
+	prompt := BuildWorkerRetirementRequestedPrompt("worker-1", "", "context is corrupted")
+
+	require.Contains(t, prompt, "No reassignment is needed")
+	require.NotContains(t, prompt, "assign_task")
+}
+
 // ============================================================================
 // BuildWorkflowContinuationPrompt Tests
 // ============================================================================
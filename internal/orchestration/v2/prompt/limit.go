@@ -0,0 +1,176 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrPromptTooLarge is returned when a prompt exceeds its size limit and the
+// truncation strategy cannot (or is not allowed to) bring it under the limit.
+var ErrPromptTooLarge = errors.New("prompt exceeds maximum size")
+
+// TruncationStrategy selects how an oversized task assignment prompt is reduced.
+type TruncationStrategy string
+
+const (
+	// TruncateDescription cuts the coordinator instructions to fit the limit.
+	TruncateDescription TruncationStrategy = "truncate_description"
+	// DropGuidelines omits the implementation workflow guidelines.
+	DropGuidelines TruncationStrategy = "drop_guidelines"
+	// ErrorOnOverflow rejects the prompt with ErrPromptTooLarge.
+	ErrorOnOverflow TruncationStrategy = "error"
+)
+
+// Context sections that can be elided from a task assignment prompt and fetched
+// later by the worker via the fetch_context tool.
+const (
+	ContextSectionInstructions = "instructions"
+	ContextSectionGuidelines   = "guidelines"
+)
+
+// truncationMarker is appended to coordinator instructions that were cut short.
+const truncationMarker = "\n\n[... truncated]"
+
+// PromptLimit bounds the size of a task assignment prompt.
+// A zero-value PromptLimit (MaxBytes <= 0) disables the limit.
+type PromptLimit struct {
+	MaxBytes int
+	Strategy TruncationStrategy
+}
+
+// Enabled reports whether the limit should be enforced.
+func (l PromptLimit) Enabled() bool {
+	return l.MaxBytes > 0
+}
+
+// Validate checks that the strategy is known when the limit is enabled.
+func (l PromptLimit) Validate() error {
+	if !l.Enabled() {
+		return nil
+	}
+	switch l.Strategy {
+	case TruncateDescription, DropGuidelines, ErrorOnOverflow:
+		return nil
+	default:
+		return fmt.Errorf("invalid prompt truncation strategy: %q", l.Strategy)
+	}
+}
+
+// BuildTaskAssignmentPrompt generates the task assignment prompt and applies the limit.
+// When the prompt is too large it is reduced according to limit.Strategy and annotated
+// so the worker knows which section was elided and can fetch it via fetch_context.
+// Returns ErrPromptTooLarge if the prompt cannot be brought under the limit.
+func BuildTaskAssignmentPrompt(taskID, title, summary, threadID string, limit PromptLimit, opts ...TaskPromptOption) (string, error) {
+	sections := newTaskAssignmentSections(taskID, title, summary, threadID, opts...)
+	full := sections.render("")
+	if !limit.Enabled() || len(full) <= limit.MaxBytes {
+		return full, nil
+	}
+
+	switch limit.Strategy {
+	case TruncateDescription:
+		return sections.truncateInstructions(limit.MaxBytes)
+	case DropGuidelines:
+		note := elisionNote(ContextSectionGuidelines, "omitted", limit.MaxBytes)
+		sections.guidelines = ""
+		prompt := sections.render(note)
+		if len(prompt) > limit.MaxBytes {
+			return "", fmt.Errorf("%w: %d bytes without guidelines (limit %d)", ErrPromptTooLarge, len(prompt), limit.MaxBytes)
+		}
+		return prompt, nil
+	default:
+		return "", fmt.Errorf("%w: %d bytes (limit %d)", ErrPromptTooLarge, len(full), limit.MaxBytes)
+	}
+}
+
+// TaskAssignmentContext returns a section of the task assignment prompt in full.
+// Used by fetch_context to recover content elided by BuildTaskAssignmentPrompt.
+func TaskAssignmentContext(section, taskID, summary string) (string, error) {
+	switch section {
+	case ContextSectionInstructions:
+		return summary, nil
+	case ContextSectionGuidelines:
+		return taskAssignmentGuidelines(taskID), nil
+	default:
+		return "", fmt.Errorf("unknown context section: %q (expected %q or %q)",
+			section, ContextSectionInstructions, ContextSectionGuidelines)
+	}
+}
+
+// taskAssignmentSections holds the rendered parts of a task assignment prompt.
+type taskAssignmentSections struct {
+	header       string
+	guidelines   string
+	report       string
+	instructions string
+	tools        string // Optional tool reminder, rendered last
+}
+
+func newTaskAssignmentSections(taskID, title, summary, threadID string, opts ...TaskPromptOption) taskAssignmentSections {
+	s := taskAssignmentSections{
+		header:       taskAssignmentHeader(taskID, title, threadID),
+		guidelines:   taskAssignmentGuidelines(taskID),
+		report:       taskAssignmentReport(threadID),
+		instructions: summary,
+	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s
+}
+
+// render assembles the prompt, placing the optional elision note right after the header.
+func (s taskAssignmentSections) render(note string) string {
+	var b strings.Builder
+	b.WriteString(s.header)
+	if note != "" {
+		b.WriteString(note)
+		b.WriteString("\n\n")
+	}
+	b.WriteString(s.guidelines)
+	b.WriteString(s.report)
+	if s.instructions != "" {
+		b.WriteString(coordinatorInstructionsHeading)
+		b.WriteString(s.instructions)
+	}
+	b.WriteString(s.tools)
+	return b.String()
+}
+
+// coordinatorInstructionsHeading precedes the coordinator instructions in the prompt.
+const coordinatorInstructionsHeading = `
+
+---
+
+## Coordinator Instructions
+
+`
+
+// truncateInstructions cuts the coordinator instructions so the annotated prompt fits maxBytes.
+func (s taskAssignmentSections) truncateInstructions(maxBytes int) (string, error) {
+	note := elisionNote(ContextSectionInstructions, "truncated", maxBytes)
+
+	rest := s
+	rest.instructions = ""
+	fixed := len(rest.render(note)) + len(coordinatorInstructionsHeading) + len(truncationMarker)
+	budget := maxBytes - fixed
+	if s.instructions == "" || budget <= 0 {
+		return "", fmt.Errorf("%w: %d bytes without coordinator instructions (limit %d)",
+			ErrPromptTooLarge, len(rest.render("")), maxBytes)
+	}
+
+	// Back off to a rune boundary so multi-byte characters are not split
+	for budget > 0 && !utf8.RuneStart(s.instructions[budget]) {
+		budget--
+	}
+	s.instructions = s.instructions[:budget] + truncationMarker
+	return s.render(note), nil
+}
+
+// elisionNote tells the worker which section was cut and how to fetch it.
+func elisionNote(section, action string, maxBytes int) string {
+	return fmt.Sprintf("**NOTE:** This prompt exceeded the %d-byte limit, so the %s section was %s. "+
+		"Call `fetch_context` with section=%q to read it in full before you begin.", maxBytes, section, action, section)
+}
@@ -0,0 +1,143 @@
+package prompt
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// BuildTaskAssignmentPrompt Tests
+// ============================================================================
+
+// TestBuildTaskAssignmentPrompt_UnderLimitUnchanged verifies prompts within the limit are not annotated.
+func TestBuildTaskAssignmentPrompt_UnderLimitUnchanged(t *testing.T) {
+	full := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "Focus on the parser", "thread-1")
+
+	for _, limit := range []PromptLimit{
+		{},
+		{MaxBytes: len(full), Strategy: ErrorOnOverflow},
+	} {
+		got, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "Focus on the parser", "thread-1", limit)
+		require.NoError(t, err)
+		require.Equal(t, full, got)
+	}
+}
+
+// TestBuildTaskAssignmentPrompt_TruncateDescription verifies the coordinator instructions are cut and annotated.
+func TestBuildTaskAssignmentPrompt_TruncateDescription(t *testing.T) {
+	summary := strings.Repeat("Implement the widget. ", 500)
+	full := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1")
+	limit := PromptLimit{MaxBytes: len(full) - 5000, Strategy: TruncateDescription}
+
+	got, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1", limit)
+
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(got), limit.MaxBytes)
+	require.Contains(t, got, "the instructions section was truncated")
+	require.Contains(t, got, "`fetch_context` with section=\"instructions\"")
+	require.Contains(t, got, "## Implementation Workflow", "guidelines should be kept")
+	require.Contains(t, got, "## Coordinator Instructions\n\nImplement the widget.")
+	require.True(t, strings.HasSuffix(got, truncationMarker))
+}
+
+// TestBuildTaskAssignmentPrompt_TruncateDescriptionKeepsRunes verifies truncation never splits a multi-byte character.
+func TestBuildTaskAssignmentPrompt_TruncateDescriptionKeepsRunes(t *testing.T) {
+	summary := strings.Repeat("✅", 2000)
+	full := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1")
+
+	for offset := 0; offset < 3; offset++ {
+		limit := PromptLimit{MaxBytes: len(full) - 1000 - offset, Strategy: TruncateDescription}
+		got, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1", limit)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(got), limit.MaxBytes)
+		require.True(t, utf8.ValidString(got), "truncated prompt must be valid UTF-8")
+	}
+}
+
+// TestBuildTaskAssignmentPrompt_TruncateDescriptionTooSmall verifies an error when only the instructions can shrink.
+func TestBuildTaskAssignmentPrompt_TruncateDescriptionTooSmall(t *testing.T) {
+	limit := PromptLimit{MaxBytes: 100, Strategy: TruncateDescription}
+
+	_, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "Focus on the parser", "thread-1", limit)
+
+	require.ErrorIs(t, err, ErrPromptTooLarge)
+}
+
+// TestBuildTaskAssignmentPrompt_DropGuidelines verifies the workflow guidelines are omitted and annotated.
+func TestBuildTaskAssignmentPrompt_DropGuidelines(t *testing.T) {
+	summary := "Focus on the parser"
+	full := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1")
+	limit := PromptLimit{MaxBytes: len(full) - 1, Strategy: DropGuidelines}
+
+	got, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1", limit)
+
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(got), limit.MaxBytes)
+	require.NotContains(t, got, "## Implementation Workflow")
+	require.Contains(t, got, "the guidelines section was omitted")
+	require.Contains(t, got, "`fetch_context` with section=\"guidelines\"")
+	require.Contains(t, got, "### Phase 6: Report Completion", "completion reporting should be kept")
+	require.True(t, strings.HasSuffix(got, summary), "coordinator instructions should be kept in full")
+}
+
+// TestBuildTaskAssignmentPrompt_DropGuidelinesStillTooLarge verifies an error when dropping guidelines is not enough.
+func TestBuildTaskAssignmentPrompt_DropGuidelinesStillTooLarge(t *testing.T) {
+	summary := strings.Repeat("x", 20000)
+	limit := PromptLimit{MaxBytes: 10000, Strategy: DropGuidelines}
+
+	_, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1", limit)
+
+	require.ErrorIs(t, err, ErrPromptTooLarge)
+}
+
+// TestBuildTaskAssignmentPrompt_ErrorOnOverflow verifies the error strategy rejects oversized prompts.
+func TestBuildTaskAssignmentPrompt_ErrorOnOverflow(t *testing.T) {
+	full := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "", "thread-1")
+	limit := PromptLimit{MaxBytes: len(full) - 1, Strategy: ErrorOnOverflow}
+
+	_, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "", "thread-1", limit)
+
+	require.ErrorIs(t, err, ErrPromptTooLarge)
+}
+
+// TestPromptLimit_Validate verifies strategy validation only applies to enabled limits.
+func TestPromptLimit_Validate(t *testing.T) {
+	require.NoError(t, PromptLimit{}.Validate())
+	require.NoError(t, PromptLimit{MaxBytes: 1000, Strategy: DropGuidelines}.Validate())
+	require.Error(t, PromptLimit{MaxBytes: 1000}.Validate())
+	require.Error(t, PromptLimit{MaxBytes: 1000, Strategy: "shrink"}.Validate())
+}
+
+// TestTaskAssignmentContext_ReturnsSections verifies elided sections can be recovered in full.
+func TestTaskAssignmentContext_ReturnsSections(t *testing.T) {
+	got, err := TaskAssignmentContext(ContextSectionInstructions, "perles-abc.1", "Focus on the parser")
+	require.NoError(t, err)
+	require.Equal(t, "Focus on the parser", got)
+
+	got, err = TaskAssignmentContext(ContextSectionGuidelines, "perles-abc.1", "")
+	require.NoError(t, err)
+	require.Contains(t, got, "## Implementation Workflow")
+	require.Contains(t, got, "bd show perles-abc.1")
+
+	_, err = TaskAssignmentContext("everything", "perles-abc.1", "")
+	require.Error(t, err)
+}
+
+// TestBuildTaskAssignmentPrompt_TruncateDescriptionKeepsToolReminder verifies the tool reminder
+// counts toward the limit and survives truncation of the instructions.
+func TestBuildTaskAssignmentPrompt_TruncateDescriptionKeepsToolReminder(t *testing.T) {
+	summary := strings.Repeat("Implement the widget. ", 500)
+	tools := WithToolReminder([]ToolHint{{Name: "fabric_inbox", Usage: "Get unread messages."}})
+	full := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1", tools)
+	limit := PromptLimit{MaxBytes: len(full) - 5000, Strategy: TruncateDescription}
+
+	got, err := BuildTaskAssignmentPrompt("perles-abc.1", "perles-abc.1", summary, "thread-1", limit, tools)
+
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(got), limit.MaxBytes)
+	require.Contains(t, got, truncationMarker)
+	require.True(t, strings.HasSuffix(got, "- `fabric_inbox`: Get unread messages."))
+}
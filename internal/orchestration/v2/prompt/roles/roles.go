@@ -66,6 +66,14 @@ type RolePrompts struct {
 	// InitialPrompt returns the initial user prompt for the agent.
 	// The workerID parameter identifies the worker instance.
 	InitialPrompt func(workerID string) string
+
+	// ReviewAssignmentPrompt optionally replaces the default review assignment prompt
+	// sent when a worker of this type is assigned a review. Nil uses the default.
+	ReviewAssignmentPrompt func(taskID, implementerID, summary, diffHint string) string
+
+	// ReplacementContinuityPrompt optionally replaces the default prompt sent to the
+	// replacement when a worker of this type is replaced mid-task. Nil uses the default.
+	ReplacementContinuityPrompt func(taskID, previousWorkerID, phase, threadID, summary string, changedFiles []string) string
 }
 
 // Registry maps agent types to their prompt templates.
@@ -1,6 +1,9 @@
 package prompt
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // WorkerMCPInstructions generates the MCP server instructions for a worker agent.
 // This is a brief description of available tools sent during MCP initialization.
@@ -14,7 +17,14 @@ Available tools:
 - fabric_reply: Reply to an EXISTING message thread (use the message_id from the message you're responding to)
 - fabric_react: Add/remove emoji reaction to a message (e.g., 👀 when starting work, ✅ when done)
 - report_implementation_complete: Report bd task completion with summary
+- report_test_results: Record test run results (passed/failed counts) on your current task
+- checkpoint: Snapshot work-in-progress (without committing it) so a replacement can restore it
+- mark_feedback_addressed: Check off numbered review feedback items as you address them
 - report_review_verdict: Report code review verdict (APPROVED/DENIED)
+- get_diff_since_last_review: On re-review, show only what changed since the last verdict
+- list_changed_files: List the files you have changed in the worktree, without the diff
+- fetch_context: Read a task prompt section that was truncated or omitted to fit the size limit
+- request_retirement: Ask to be replaced by a fresh worker after this turn (e.g. corrupted context)
 - post_accountability_summary: Save accountability summary for session tracking
 
 **IMPORTANT: fabric_send vs fabric_reply:**
@@ -33,8 +43,92 @@ Workers receive tasks via messages and must report completion:
 // TaskAssignmentPrompt generates the prompt sent to a worker when assigning a task.
 // The summary parameter is optional and provides additional instructions/context from the coordinator.
 // The threadID parameter is the Fabric thread ID for task updates - workers should use fabric_reply to this thread.
-func TaskAssignmentPrompt(taskID, title, summary, threadID string) string {
-	prompt := fmt.Sprintf(`[TASK ASSIGNMENT]
+func TaskAssignmentPrompt(taskID, title, summary, threadID string, opts ...TaskPromptOption) string {
+	return newTaskAssignmentSections(taskID, title, summary, threadID, opts...).render("")
+}
+
+// ToolHint names a worker tool and says briefly when to use it.
+type ToolHint struct {
+	Name  string
+	Usage string
+}
+
+// TaskPromptOption adds an optional section to a task assignment prompt.
+type TaskPromptOption func(*taskAssignmentSections)
+
+// WithToolReminder appends a short list of the worker's tools to the prompt, so workers
+// that forget a tool exists are reminded of it with every task. No section is added
+// when tools is empty.
+func WithToolReminder(tools []ToolHint) TaskPromptOption {
+	return func(s *taskAssignmentSections) {
+		s.tools = toolReminder(tools)
+	}
+}
+
+// toolReminder renders the tool reminder section, one line per tool.
+func toolReminder(tools []ToolHint) string {
+	if len(tools) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(toolReminderHeading)
+	for _, tool := range tools {
+		fmt.Fprintf(&b, "- `%s`", tool.Name)
+		if tool.Usage != "" {
+			b.WriteString(": ")
+			b.WriteString(tool.Usage)
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// toolReminderHeading precedes the tool reminder in the prompt.
+const toolReminderHeading = `
+
+---
+
+## Your Tools
+
+Use these MCP tools instead of working around them:
+`
+
+// CoordinatorInstructions combines the coordinator's focus area and global instructions with
+// the per-task summary for the Coordinator Instructions section of a task assignment prompt.
+// The focus and global instructions come first so they survive truncation of a long summary.
+// Returns summary unchanged when there is no focus and there are no global instructions.
+func CoordinatorInstructions(focus string, global []string, summary string) string {
+	if focus == "" && len(global) == 0 {
+		return summary
+	}
+	var b strings.Builder
+	if focus != "" {
+		b.WriteString("**Focus area (concentrate your work here):** ")
+		b.WriteString(focus)
+		b.WriteString("\n")
+		if len(global) > 0 {
+			b.WriteString("\n")
+		}
+	}
+	if len(global) > 0 {
+		b.WriteString("**Standing instructions (apply to every task):**\n")
+		for _, instruction := range global {
+			b.WriteString("- ")
+			b.WriteString(instruction)
+			b.WriteString("\n")
+		}
+	}
+	if summary == "" {
+		return strings.TrimSuffix(b.String(), "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(summary)
+	return b.String()
+}
+
+// taskAssignmentHeader is the opening section of the task assignment prompt.
+func taskAssignmentHeader(taskID, title, threadID string) string {
+	return fmt.Sprintf(`[TASK ASSIGNMENT]
 
 **Task ID:** %s
 **Title:** %s
@@ -42,7 +136,12 @@ func TaskAssignmentPrompt(taskID, title, summary, threadID string) string {
 
 **IMPORTANT:** React 👀 to this message immediately using `+"`fabric_react`"+`, then begin work.
 
-## Implementation Workflow
+`, taskID, title, threadID)
+}
+
+// taskAssignmentGuidelines is the implementation workflow (phases 1-5) of the task assignment prompt.
+func taskAssignmentGuidelines(taskID string) string {
+	return fmt.Sprintf(`## Implementation Workflow
 
 Follow these phases in order. Use sub-agents for parallel exploration and verification.
 
@@ -176,7 +275,12 @@ verification commands. Return: JSON with each criterion, status, and evidence."
 
 ---
 
-### Phase 6: Report Completion
+`, taskID)
+}
+
+// taskAssignmentReport is the completion reporting section (phase 6) of the task assignment prompt.
+func taskAssignmentReport(threadID string) string {
+	return fmt.Sprintf(`### Phase 6: Report Completion
 
 **Goal:** Signal completion with a summary of what was done.
 
@@ -208,29 +312,19 @@ If report_implementation_complete fails (e.g., "process is not in implementing p
 `+"```"+`
 fabric_reply(message_id="%s", content="Implementation complete: [summary]")
 `+"```"+`
-Never silently fail - always report completion somehow.`, taskID, title, threadID, taskID, threadID)
-
-	if summary != "" {
-		prompt += fmt.Sprintf(`
-
----
-
-## Coordinator Instructions
-
-%s`, summary)
-	}
-
-	return prompt
+Never silently fail - always report completion somehow.`, threadID)
 }
 
 // ReviewAssignmentPrompt generates the prompt sent to a reviewer when assigning a code review.
-func ReviewAssignmentPrompt(taskID, implementerID string) string {
+// The summary parameter is the implementer's completion summary and diffHint points the
+// reviewer at the changes to inspect; both are optional and omitted when empty.
+func ReviewAssignmentPrompt(taskID, implementerID, summary, diffHint string) string {
 	return fmt.Sprintf(`[REVIEW ASSIGNMENT]
 
 **IMPORTANT:** React 👀 to this message immediately using `+"`fabric_react`"+`, then begin review.
 
 You are being assigned to **review** the work completed by %s on task **%s**.
-
+%s
 ## Your Review Process
 
 ### Step 1: Gather Context
@@ -409,19 +503,20 @@ Use report_review_verdict with structured comments:
 report_review_verdict(
     verdict="APPROVED|DENIED",
     comments="## Summary\n[1-2 sentence overview]\n\n## Sub-Reviewer Results\n| Reviewer | Verdict | Confidence | Summary |\n|----------|---------|------------|----------|\n| Correctness | PASS | 0.85 | ... |\n| Tests | PASS | 0.90 | ... |\n| Dead Code | PASS | 0.80 | ... |\n| Acceptance | PASS | 0.95 | 6/6 met |\n\n## Aggregate Findings\nBlockers: 0 | Majors: 0 | Minors: 2 | Info: 3\n\n## Issues (if any)\n[List issues by severity with location and fix]\n\n## Required Changes (if DENIED)\n1. [specific actionable feedback]\n2. [specific actionable feedback]"
-)`, implementerID, taskID, taskID)
+)`, implementerID, taskID, reviewContextSection(summary, diffHint), taskID)
 }
 
 // ReviewAssignmentPromptSimple generates a streamlined review prompt for simple changes.
 // Unlike ReviewAssignmentPrompt, this does NOT instruct the reviewer to spawn sub-agents.
 // The reviewer performs all quality checks directly in a single pass.
-func ReviewAssignmentPromptSimple(taskID, implementerID string) string {
+// summary and diffHint are handled as in ReviewAssignmentPrompt.
+func ReviewAssignmentPromptSimple(taskID, implementerID, summary, diffHint string) string {
 	return fmt.Sprintf(`[REVIEW ASSIGNMENT]
 
 **IMPORTANT:** React 👀 to this message immediately using `+"`fabric_react`"+`, then begin review.
 
 You are being assigned to review the work completed by %s on task **%s**.
-
+%s
 ---
 
 ## Step 1: Gather Context
@@ -486,11 +581,76 @@ report_review_verdict(
     verdict="APPROVED|DENIED",
     comments="Quick review: [1-2 sentence summary]. Tests: PASS/FAIL. Acceptance: X/X met. [If DENIED: specific issues to fix]"
 )
-`+"```"+``, implementerID, taskID, taskID)
+`+"```"+``, implementerID, taskID, reviewContextSection(summary, diffHint), taskID)
+}
+
+// reviewContextSection renders the implementer's summary and the diff hint for a review
+// assignment. Returns an empty string when neither is provided.
+func reviewContextSection(summary, diffHint string) string {
+	if summary == "" && diffHint == "" {
+		return ""
+	}
+	var b strings.Builder
+	if summary != "" {
+		b.WriteString("\n## Implementer's Summary\n\n")
+		b.WriteString(summary)
+		b.WriteString("\n")
+	}
+	if diffHint != "" {
+		b.WriteString("\n## Where to Look\n\n")
+		b.WriteString(diffHint)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ReviewTestResultsSection generates the section appended to a review assignment
+// when the implementer reported test results for the task.
+func ReviewTestResultsSection(passed, failed int, output string) string {
+	section := fmt.Sprintf(`
+
+---
+
+## Reported Test Results
+
+The implementer reported **%d passed**, **%d failed**.`, passed, failed)
+
+	if failed > 0 {
+		section += `
+
+⚠️ Failing tests were reported. Verify whether the failures are related to this change before approving.`
+	}
+
+	if output != "" {
+		section += fmt.Sprintf(`
+
+Test output:
+`+"```"+`
+%s
+`+"```", output)
+	}
+
+	return section
 }
 
 // ReviewFeedbackPrompt generates the prompt sent to an implementer when their code was denied.
-func ReviewFeedbackPrompt(taskID, feedback string) string {
+// Items, when given, are listed as a numbered checklist the implementer marks off with
+// mark_feedback_addressed; feedback is optional free text shown before them.
+func ReviewFeedbackPrompt(taskID, feedback string, items []string) string {
+	var changes strings.Builder
+	if feedback != "" {
+		changes.WriteString(feedback)
+	}
+	if len(items) > 0 {
+		if feedback != "" {
+			changes.WriteString("\n\n")
+		}
+		for i, item := range items {
+			fmt.Fprintf(&changes, "%d. %s\n", i+1, item)
+		}
+		changes.WriteString("\nAs you address each numbered item, mark it done with `mark_feedback_addressed` (item_ids=[...]).")
+	}
+
 	return fmt.Sprintf(`[REVIEW FEEDBACK]
 
 Your implementation of task **%s** was **DENIED** during code review.
@@ -500,7 +660,93 @@ Your implementation of task **%s** was **DENIED** during code review.
 
 Please address the feedback above and make the necessary changes.
 
-When you have addressed all feedback, report via fabric_reply(content="Ready for re-review on task %s").`, taskID, feedback, taskID)
+When you have addressed all feedback, report via fabric_reply(content="Ready for re-review on task %s").`, taskID, changes.String(), taskID)
+}
+
+// TaskTransferPrompt generates the prompt sent to a worker taking over a task from another worker.
+// The phase is the workflow phase the task was in when transferred; note is optional handoff context.
+func TaskTransferPrompt(taskID, fromWorkerID, phase, threadID, note string) string {
+	prompt := fmt.Sprintf(`[TASK TRANSFER]
+
+You are taking over task **%s** from **%s**, continuing in the **%s** phase.
+
+**Fabric Thread ID:** %s
+
+**IMPORTANT:** React 👀 to this message immediately using `+"`fabric_react`"+`, then continue the work.
+
+1. Read the task: `+"`bd show %s`"+`
+2. Review the work already done with `+"`git status`"+` and `+"`git diff`"+` before making changes.
+3. Read the task thread with `+"`fabric_history`"+` for prior discussion and review feedback.
+
+When you finish, report via report_implementation_complete as usual.`, taskID, fromWorkerID, phase, threadID, taskID)
+
+	if note != "" {
+		prompt += fmt.Sprintf(`
+
+## Handoff Notes:
+%s`, note)
+	}
+
+	return prompt
+}
+
+// maxContinuityFiles caps the changed files listed in a replacement continuity prompt.
+const maxContinuityFiles = 50
+
+// ReplacementContinuityPrompt generates the prompt sent to a fresh worker that replaces a
+// retired worker mid-task. The summary is the retired worker's last progress or handoff
+// summary and changedFiles the worktree paths it had changed; both are optional.
+func ReplacementContinuityPrompt(taskID, previousWorkerID, phase, threadID, summary string, changedFiles []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `[TASK CONTINUATION]
+
+You are replacing **%s**, which was retired while working on task **%s** in the **%s** phase. Continue from where it left off instead of starting over.
+
+**Fabric Thread ID:** %s
+
+**IMPORTANT:** React 👀 to this message immediately using `+"`fabric_react`"+`, then continue the work.
+
+1. Read the task: `+"`bd show %s`"+`
+2. Review the work already done with `+"`git status`"+` and `+"`git diff`"+` before making changes.
+3. Read the task thread with `+"`fabric_history`"+` for prior discussion and review feedback.
+
+When you finish, report via report_implementation_complete as usual.`, previousWorkerID, taskID, phase, threadID, taskID)
+
+	if summary != "" {
+		fmt.Fprintf(&b, "\n\n## Progress So Far:\n%s", summary)
+	}
+
+	if len(changedFiles) > 0 {
+		b.WriteString("\n\n## Files Already Changed:\n")
+		for _, path := range changedFiles[:min(len(changedFiles), maxContinuityFiles)] {
+			fmt.Fprintf(&b, "- %s\n", path)
+		}
+		if extra := len(changedFiles) - maxContinuityFiles; extra > 0 {
+			fmt.Fprintf(&b, "- ... and %d more (run `git status` for the full list)\n", extra)
+		}
+	}
+
+	return b.String()
+}
+
+// ReplacementCheckpointNote generates the note appended to a continuity prompt when the
+// previous worker checkpointed its work, or "" if it made no checkpoints.
+func ReplacementCheckpointNote(checkpoints []string) string {
+	if len(checkpoints) == 0 {
+		return ""
+	}
+	latest := checkpoints[len(checkpoints)-1]
+	return fmt.Sprintf("\n\n## Checkpoints:\nThe previous worker saved its progress in %d [WIP] checkpoint snapshot(s); the latest is `%s`. Its changes should still be uncommitted in the worktree; if any are missing, restore them with `git restore --source=%s --worktree -- .` rather than redoing the work.",
+		len(checkpoints), latest, latest)
+}
+
+// TaskTransferredAwayPrompt generates the notice sent to a worker whose task was transferred to another worker.
+func TaskTransferredAwayPrompt(taskID, toWorkerID string) string {
+	return fmt.Sprintf(`[TASK TRANSFERRED]
+
+Task **%s** has been transferred to **%s**. Stop working on it immediately.
+
+Do not commit or report completion for this task. Leave your changes in place for %s to pick up.`, taskID, toWorkerID, toWorkerID)
 }
 
 // CommitApprovalPrompt generates the prompt sent to an implementer when their code is approved.
@@ -518,7 +764,24 @@ Suggested commit message:
 %s`, commitMessage)
 	}
 
-	prompt += fmt.Sprintf(`
+	return prompt + afterCommittingInstructions(taskID)
+}
+
+// CommitWithoutReviewPrompt generates the prompt sent to an implementer when their
+// workflow does not require review, so a completed implementation is committed directly.
+func CommitWithoutReviewPrompt(taskID string) string {
+	prompt := fmt.Sprintf(`[COMMIT]
+
+Your implementation of task **%s** is complete. This workflow does not require review.
+
+Please create a git commit for your changes.`, taskID)
+
+	return prompt + afterCommittingInstructions(taskID)
+}
+
+// afterCommittingInstructions is the accountability section shared by the commit prompts.
+func afterCommittingInstructions(taskID string) string {
+	return fmt.Sprintf(`
 
 ## After Committing
 
@@ -548,8 +811,6 @@ post_accountability_summary(
 )
 
 Then report via fabric_reply(content="Committed: [hash]").`, taskID)
-
-	return prompt
 }
 
 // AggregationWorkerPrompt generates the prompt for a worker assigned to aggregate
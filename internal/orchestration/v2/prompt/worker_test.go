@@ -1,6 +1,7 @@
 package prompt
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -13,7 +14,7 @@ import (
 
 // TestReviewAssignmentPromptSimple_ReturnsNonEmpty verifies the function returns non-empty string.
 func TestReviewAssignmentPromptSimple_ReturnsNonEmpty(t *testing.T) {
-	prompt := ReviewAssignmentPromptSimple("perles-abc.1", "worker-1")
+	prompt := ReviewAssignmentPromptSimple("perles-abc.1", "worker-1", "", "")
 	require.NotEmpty(t, prompt, "Prompt should not be empty")
 }
 
@@ -21,7 +22,7 @@ func TestReviewAssignmentPromptSimple_ReturnsNonEmpty(t *testing.T) {
 func TestReviewAssignmentPromptSimple_ContainsTaskIDAndImplementerID(t *testing.T) {
 	taskID := "perles-xyz.42"
 	implementerID := "worker-7"
-	prompt := ReviewAssignmentPromptSimple(taskID, implementerID)
+	prompt := ReviewAssignmentPromptSimple(taskID, implementerID, "", "")
 
 	require.Contains(t, prompt, taskID, "Prompt should contain taskID")
 	require.Contains(t, prompt, implementerID, "Prompt should contain implementerID")
@@ -29,7 +30,7 @@ func TestReviewAssignmentPromptSimple_ContainsTaskIDAndImplementerID(t *testing.
 
 // TestReviewAssignmentPromptSimple_ContainsCriticalTestExecution verifies mandatory test language.
 func TestReviewAssignmentPromptSimple_ContainsCriticalTestExecution(t *testing.T) {
-	prompt := ReviewAssignmentPromptSimple("task-1", "worker-1")
+	prompt := ReviewAssignmentPromptSimple("task-1", "worker-1", "", "")
 
 	require.Contains(t, prompt, "CRITICAL: Run the tests",
 		"Prompt MUST contain mandatory test execution language")
@@ -41,7 +42,7 @@ func TestReviewAssignmentPromptSimple_ContainsCriticalTestExecution(t *testing.T
 
 // TestReviewAssignmentPromptSimple_ContainsReportReviewVerdict verifies verdict call format.
 func TestReviewAssignmentPromptSimple_ContainsReportReviewVerdict(t *testing.T) {
-	prompt := ReviewAssignmentPromptSimple("task-1", "worker-1")
+	prompt := ReviewAssignmentPromptSimple("task-1", "worker-1", "", "")
 
 	require.Contains(t, prompt, "report_review_verdict",
 		"Prompt should include report_review_verdict call")
@@ -49,7 +50,7 @@ func TestReviewAssignmentPromptSimple_ContainsReportReviewVerdict(t *testing.T)
 
 // TestReviewAssignmentPromptSimple_CoversAllFourDimensions verifies all review dimensions.
 func TestReviewAssignmentPromptSimple_CoversAllFourDimensions(t *testing.T) {
-	prompt := ReviewAssignmentPromptSimple("task-1", "worker-1")
+	prompt := ReviewAssignmentPromptSimple("task-1", "worker-1", "", "")
 
 	dimensions := []string{
 		"Correctness & Logic",
@@ -66,8 +67,8 @@ func TestReviewAssignmentPromptSimple_CoversAllFourDimensions(t *testing.T) {
 
 // TestReviewAssignmentPromptSimple_IsShorterThanComplex verifies the simple prompt is shorter.
 func TestReviewAssignmentPromptSimple_IsShorterThanComplex(t *testing.T) {
-	simplePrompt := ReviewAssignmentPromptSimple("task-1", "worker-1")
-	complexPrompt := ReviewAssignmentPrompt("task-1", "worker-1")
+	simplePrompt := ReviewAssignmentPromptSimple("task-1", "worker-1", "", "")
+	complexPrompt := ReviewAssignmentPrompt("task-1", "worker-1", "", "")
 
 	simpleLines := len(strings.Split(simplePrompt, "\n"))
 	complexLines := len(strings.Split(complexPrompt, "\n"))
@@ -84,6 +85,55 @@ func TestReviewAssignmentPromptSimple_IsShorterThanComplex(t *testing.T) {
 		"Simple prompt should have at most 90 lines, got %d", simpleLines)
 }
 
+// ============================================================================
+// ReviewAssignmentPrompt Tests
+// ============================================================================
+
+// TestReviewAssignmentPrompt_ContainsVerdictInstructions verifies both review variants tell
+// the reviewer how to report its verdict.
+func TestReviewAssignmentPrompt_ContainsVerdictInstructions(t *testing.T) {
+	for name, prompt := range map[string]string{
+		"complex": ReviewAssignmentPrompt("perles-abc.1", "worker-1", "", ""),
+		"simple":  ReviewAssignmentPromptSimple("perles-abc.1", "worker-1", "", ""),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Contains(t, prompt, "report_review_verdict(")
+			require.Contains(t, prompt, "APPROVED|DENIED")
+		})
+	}
+}
+
+// TestReviewAssignmentPrompt_IncludesSummaryAndDiffHint verifies the implementer's summary
+// and diff hint are included in both review variants.
+func TestReviewAssignmentPrompt_IncludesSummaryAndDiffHint(t *testing.T) {
+	summary := "Added retry with backoff to the sync client. Tests: 12 passing."
+	diffHint := "Call get_diff_since_last_review to see only what changed."
+
+	for name, prompt := range map[string]string{
+		"complex": ReviewAssignmentPrompt("perles-abc.1", "worker-1", summary, diffHint),
+		"simple":  ReviewAssignmentPromptSimple("perles-abc.1", "worker-1", summary, diffHint),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Contains(t, prompt, "## Implementer's Summary")
+			require.Contains(t, prompt, summary)
+			require.Contains(t, prompt, "## Where to Look")
+			require.Contains(t, prompt, diffHint)
+		})
+	}
+}
+
+// TestReviewAssignmentPrompt_OmitsEmptyContext verifies no context headings are rendered
+// without a summary or diff hint.
+func TestReviewAssignmentPrompt_OmitsEmptyContext(t *testing.T) {
+	prompt := ReviewAssignmentPrompt("perles-abc.1", "worker-1", "", "")
+	require.NotContains(t, prompt, "## Implementer's Summary")
+	require.NotContains(t, prompt, "## Where to Look")
+
+	prompt = ReviewAssignmentPrompt("perles-abc.1", "worker-1", "Did the thing", "")
+	require.Contains(t, prompt, "Did the thing")
+	require.NotContains(t, prompt, "## Where to Look")
+}
+
 // ============================================================================
 // CommitApprovalPrompt Tests (Updated for post_accountability_summary)
 // ============================================================================
@@ -130,7 +180,87 @@ func TestCommitApprovalPrompt_IncludesRetroStructure(t *testing.T) {
 	require.Contains(t, prompt, "patterns", "Prompt should show patterns in retro")
 }
 
+// TestCommitWithoutReviewPrompt_AsksForCommitWithoutApproval verifies the no-review commit prompt.
+func TestCommitWithoutReviewPrompt_AsksForCommitWithoutApproval(t *testing.T) {
+	prompt := CommitWithoutReviewPrompt("perles-abc.1")
+
+	require.Contains(t, prompt, "[COMMIT]")
+	require.Contains(t, prompt, "perles-abc.1")
+	require.Contains(t, prompt, "does not require review")
+	require.NotContains(t, prompt, "APPROVED")
+	require.Contains(t, prompt, `post_accountability_summary(
+    task_id="perles-abc.1"`)
+}
+
 // TestWorkerMCPInstructions_ContainsToolDescriptions verifies MCP instructions list available tools.
+func TestTaskTransferPrompt_IncludesHandoffContext(t *testing.T) {
+	prompt := TaskTransferPrompt("perles-abc.1", "worker-1", "addressing_feedback", "thread-42", "Tests in pkg/foo still fail")
+
+	require.Contains(t, prompt, "[TASK TRANSFER]")
+	require.Contains(t, prompt, "perles-abc.1")
+	require.Contains(t, prompt, "worker-1")
+	require.Contains(t, prompt, "addressing_feedback")
+	require.Contains(t, prompt, "thread-42")
+	require.Contains(t, prompt, "Tests in pkg/foo still fail")
+}
+
+func TestTaskTransferPrompt_OmitsEmptyNote(t *testing.T) {
+	prompt := TaskTransferPrompt("perles-abc.1", "worker-1", "implementing", "", "")
+
+	require.NotContains(t, prompt, "Handoff Notes")
+}
+
+func TestReplacementContinuityPrompt_IncludesProgressAndFiles(t *testing.T) {
+	prompt := ReplacementContinuityPrompt("perles-abc.1", "worker-1", "implementing", "thread-42",
+		"Parser done, lexer pending", []string{"parser.go", "lexer.go"})
+
+	require.Contains(t, prompt, "[TASK CONTINUATION]")
+	require.Contains(t, prompt, "replacing **worker-1**")
+	require.Contains(t, prompt, "thread-42")
+	require.Contains(t, prompt, "Parser done, lexer pending")
+	require.Contains(t, prompt, "- parser.go\n- lexer.go\n")
+}
+
+func TestReplacementContinuityPrompt_CapsChangedFiles(t *testing.T) {
+	files := make([]string, maxContinuityFiles+3)
+	for i := range files {
+		files[i] = fmt.Sprintf("file%d.go", i)
+	}
+
+	prompt := ReplacementContinuityPrompt("perles-abc.1", "worker-1", "implementing", "", "", files)
+
+	require.Contains(t, prompt, fmt.Sprintf("- file%d.go", maxContinuityFiles-1))
+	require.NotContains(t, prompt, fmt.Sprintf("- file%d.go", maxContinuityFiles))
+	require.Contains(t, prompt, "and 3 more")
+	require.NotContains(t, prompt, "Progress So Far")
+}
+
+func TestReplacementCheckpointNote(t *testing.T) {
+	require.Empty(t, ReplacementCheckpointNote(nil))
+
+	note := ReplacementCheckpointNote([]string{"abc123", "def456"})
+	require.Contains(t, note, "## Checkpoints:")
+	require.Contains(t, note, "2 [WIP] checkpoint snapshot(s); the latest is `def456`")
+	require.Contains(t, note, "git restore --source=def456 --worktree -- .")
+}
+
+func TestReviewTestResultsSection_IncludesCounts(t *testing.T) {
+	section := ReviewTestResultsSection(12, 0, "")
+
+	require.Contains(t, section, "12 passed")
+	require.Contains(t, section, "0 failed")
+	require.NotContains(t, section, "Failing tests were reported")
+	require.NotContains(t, section, "Test output:")
+}
+
+func TestReviewTestResultsSection_FlagsFailuresAndIncludesOutput(t *testing.T) {
+	section := ReviewTestResultsSection(10, 2, "FAIL TestFoo")
+
+	require.Contains(t, section, "2 failed")
+	require.Contains(t, section, "Failing tests were reported")
+	require.Contains(t, section, "FAIL TestFoo")
+}
+
 func TestWorkerMCPInstructions_ContainsToolDescriptions(t *testing.T) {
 	instructions := WorkerMCPInstructions("worker-1")
 
@@ -143,3 +273,72 @@ func TestWorkerMCPInstructions_ContainsToolDescriptions(t *testing.T) {
 	require.Contains(t, instructions, "report_implementation_complete",
 		"Instructions should mention report_implementation_complete tool")
 }
+
+// ============================================================================
+// CoordinatorInstructions Tests
+// ============================================================================
+
+// TestCoordinatorInstructions_NoGlobalUnchanged verifies the summary is untouched without global instructions.
+func TestCoordinatorInstructions_NoGlobalUnchanged(t *testing.T) {
+	require.Equal(t, "Focus on the parser", CoordinatorInstructions("", nil, "Focus on the parser"))
+	require.Empty(t, CoordinatorInstructions("", nil, ""))
+}
+
+// TestCoordinatorInstructions_GlobalPrecedesSummary verifies global instructions are listed before the summary.
+func TestCoordinatorInstructions_GlobalPrecedesSummary(t *testing.T) {
+	got := CoordinatorInstructions("", []string{"The DB migration is frozen", "Do not bump dependencies"}, "Focus on the parser")
+
+	require.Equal(t, "**Standing instructions (apply to every task):**\n"+
+		"- The DB migration is frozen\n"+
+		"- Do not bump dependencies\n"+
+		"\n"+
+		"Focus on the parser", got)
+}
+
+// TestCoordinatorInstructions_GlobalOnly verifies global instructions alone fill the section.
+func TestCoordinatorInstructions_GlobalOnly(t *testing.T) {
+	got := CoordinatorInstructions("", []string{"The DB migration is frozen"}, "")
+
+	require.Equal(t, "**Standing instructions (apply to every task):**\n- The DB migration is frozen", got)
+	require.Contains(t, TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", got, "thread-1"),
+		"## Coordinator Instructions\n\n**Standing instructions")
+}
+
+// TestCoordinatorInstructions_FocusPrecedesGlobal verifies the focus area is listed first.
+func TestCoordinatorInstructions_FocusPrecedesGlobal(t *testing.T) {
+	got := CoordinatorInstructions("the parser", []string{"The DB migration is frozen"}, "Fix the bug")
+
+	require.Equal(t, "**Focus area (concentrate your work here):** the parser\n"+
+		"\n"+
+		"**Standing instructions (apply to every task):**\n"+
+		"- The DB migration is frozen\n"+
+		"\n"+
+		"Fix the bug", got)
+	require.Equal(t, "**Focus area (concentrate your work here):** the parser", CoordinatorInstructions("the parser", nil, ""))
+}
+
+// ============================================================================
+// TaskAssignmentPrompt Tool Reminder Tests
+// ============================================================================
+
+func TestTaskAssignmentPrompt_ToolReminderListsTools(t *testing.T) {
+	prompt := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "Focus on the parser", "thread-1",
+		WithToolReminder([]ToolHint{
+			{Name: "fabric_inbox", Usage: "Get unread messages for the current agent."},
+			{Name: "fetch_context"},
+		}))
+
+	require.True(t, strings.HasSuffix(prompt,
+		"## Your Tools\n\nUse these MCP tools instead of working around them:\n"+
+			"- `fabric_inbox`: Get unread messages for the current agent.\n"+
+			"- `fetch_context`"), "reminder should be appended after the coordinator instructions")
+	require.Less(t, strings.Index(prompt, "Focus on the parser"), strings.Index(prompt, "## Your Tools"))
+}
+
+func TestTaskAssignmentPrompt_ToolReminderOmittedWhenDisabled(t *testing.T) {
+	plain := TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "Focus on the parser", "thread-1")
+
+	require.NotContains(t, plain, "## Your Tools")
+	require.Equal(t, plain, TaskAssignmentPrompt("perles-abc.1", "perles-abc.1", "Focus on the parser", "thread-1",
+		WithToolReminder(nil)))
+}
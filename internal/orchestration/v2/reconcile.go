@@ -0,0 +1,196 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/validation"
+)
+
+// inProgressQuery selects every task beads considers actively worked on.
+const inProgressQuery = "status = in_progress"
+
+// TrackerDivergence describes one task whose beads status disagrees with coordinator state.
+type TrackerDivergence struct {
+	// TaskID is the bd task ID.
+	TaskID string
+	// TrackerStatus is the status beads reports (empty if the task was not found).
+	TrackerStatus beads.Status
+	// AssignmentStatus is the coordinator's task assignment status (empty if untracked).
+	AssignmentStatus repository.TaskStatus
+}
+
+// ReconciliationReport summarizes divergence between coordinator state and beads.
+type ReconciliationReport struct {
+	// Untracked lists tasks beads marks in_progress that the coordinator has no assignment for.
+	Untracked []TrackerDivergence
+	// Stale lists active coordinator assignments whose beads task is not in_progress.
+	Stale []TrackerDivergence
+	// Corrected lists task IDs whose beads status was reset to open by auto-correction.
+	Corrected []string
+}
+
+// HasDivergence returns true if any task disagrees between the coordinator and beads.
+func (r *ReconciliationReport) HasDivergence() bool {
+	return len(r.Untracked) > 0 || len(r.Stale) > 0
+}
+
+// Reconciler compares coordinator task assignments against the beads tracker.
+// It is intended to run on startup, after restoring session state, so that
+// tasks orphaned by a crash are surfaced instead of silently blocking work.
+type Reconciler struct {
+	taskRepo repository.TaskRepository
+	tracker  bql.BQLExecutor
+	writer   appbeads.IssueWriter
+	prefix   string
+}
+
+// ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithAutoCorrect resets untracked in_progress beads tasks back to open.
+// When not set, the reconciler only reports divergence.
+func WithAutoCorrect(writer appbeads.IssueWriter) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.writer = writer
+	}
+}
+
+// WithReconcileTaskIDPrefix limits reconciliation to tasks in a workflow's beads prefix,
+// so in_progress tasks owned by other workflows sharing the database are not flagged
+// or reset. An empty prefix reconciles every task.
+func WithReconcileTaskIDPrefix(prefix string) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.prefix = prefix
+	}
+}
+
+// NewReconciler creates a new Reconciler.
+// Panics if taskRepo or tracker is nil.
+func NewReconciler(taskRepo repository.TaskRepository, tracker bql.BQLExecutor, opts ...ReconcilerOption) *Reconciler {
+	if taskRepo == nil {
+		panic("taskRepo is required for Reconciler")
+	}
+	if tracker == nil {
+		panic("tracker is required for Reconciler")
+	}
+	r := &Reconciler{
+		taskRepo: taskRepo,
+		tracker:  tracker,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ReconcileWithTracker reads in_progress tasks from beads and compares them with
+// the coordinator's active task assignments. Tasks beads thinks are active but the
+// coordinator isn't tracking are reported as Untracked; active assignments whose
+// beads task is not in_progress are reported as Stale. With WithAutoCorrect,
+// untracked tasks are reset to open so they can be reassigned.
+func (r *Reconciler) ReconcileWithTracker(ctx context.Context) (*ReconciliationReport, error) {
+	inProgress, err := r.tracker.Execute(r.inProgressQuery())
+	if err != nil {
+		return nil, fmt.Errorf("querying in_progress tasks: %w", err)
+	}
+
+	active := make(map[string]*repository.TaskAssignment)
+	for _, task := range r.taskRepo.All() {
+		if task.Status == repository.TaskCompleted || task.Status == repository.TaskFailed || !r.inScope(task.TaskID) {
+			continue
+		}
+		active[task.TaskID] = task
+	}
+
+	report := &ReconciliationReport{}
+	trackerActive := make(map[string]bool, len(inProgress))
+	for _, issue := range inProgress {
+		if !r.inScope(issue.ID) {
+			continue
+		}
+		trackerActive[issue.ID] = true
+		if _, ok := active[issue.ID]; !ok {
+			report.Untracked = append(report.Untracked, TrackerDivergence{
+				TaskID:        issue.ID,
+				TrackerStatus: issue.Status,
+			})
+		}
+	}
+
+	var staleIDs []string
+	for id := range active {
+		if !trackerActive[id] {
+			staleIDs = append(staleIDs, id)
+		}
+	}
+	sort.Strings(staleIDs)
+
+	if len(staleIDs) > 0 {
+		statuses, err := r.trackerStatuses(staleIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range staleIDs {
+			report.Stale = append(report.Stale, TrackerDivergence{
+				TaskID:           id,
+				TrackerStatus:    statuses[id],
+				AssignmentStatus: active[id].Status,
+			})
+		}
+	}
+
+	if r.writer != nil {
+		for _, d := range report.Untracked {
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+			if err := r.writer.UpdateStatus(d.TaskID, beads.StatusOpen); err != nil {
+				log.Warn(log.CatOrch, "Failed to reset untracked task", "taskID", d.TaskID, "error", err)
+				continue
+			}
+			report.Corrected = append(report.Corrected, d.TaskID)
+		}
+	}
+
+	return report, nil
+}
+
+// inProgressQuery returns the BQL query for in_progress tasks, narrowed to the prefix
+// when one is set. The id match is a substring match, so results are re-checked with inScope.
+func (r *Reconciler) inProgressQuery() string {
+	if r.prefix == "" {
+		return inProgressQuery
+	}
+	return fmt.Sprintf("%s and id ~ %q", inProgressQuery, r.prefix+"-")
+}
+
+// inScope reports whether taskID belongs to the reconciled prefix.
+func (r *Reconciler) inScope(taskID string) bool {
+	return r.prefix == "" || validation.IsValidTaskIDWithPrefix(taskID, r.prefix)
+}
+
+// trackerStatuses looks up the current beads status for the given task IDs.
+// Tasks missing from beads are omitted from the result.
+func (r *Reconciler) trackerStatuses(ids []string) (map[string]beads.Status, error) {
+	quoted := make([]string, len(ids))
+	for i, id := range ids {
+		quoted[i] = fmt.Sprintf("%q", id)
+	}
+	issues, err := r.tracker.Execute(fmt.Sprintf("id in (%s)", strings.Join(quoted, ", ")))
+	if err != nil {
+		return nil, fmt.Errorf("querying tracked task statuses: %w", err)
+	}
+	statuses := make(map[string]beads.Status, len(issues))
+	for _, issue := range issues {
+		statuses[issue.ID] = issue.Status
+	}
+	return statuses, nil
+}
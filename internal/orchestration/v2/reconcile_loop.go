@@ -0,0 +1,440 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+// DefaultReconcileInterval is how often the reconcile loop checks for orphaned
+// tasks and stuck workers when no interval is configured.
+const DefaultReconcileInterval = 30 * time.Second
+
+// DefaultStuckWorkerTimeout is how long a worker may work without completing a
+// turn before the reconcile loop reports it as stuck.
+const DefaultStuckWorkerTimeout = 15 * time.Minute
+
+// DefaultReadyTimeout is how long a spawned worker may take to signal ready before
+// the reconcile loop reports it as never ready.
+const DefaultReadyTimeout = 5 * time.Minute
+
+// DefaultMaxWorkerKeepalives is how many keepalive prompts an idle ready worker receives
+// before the reconcile loop stops pinging it, bounding the token cost of keeping it warm.
+const DefaultMaxWorkerKeepalives = 6
+
+// WorkerKeepalivePrompt is the no-op prompt sent to idle ready workers to keep their
+// sessions alive. Keepalive turns are exempt from turn enforcement, so the cheapest
+// answer is to end the turn without calling any tools.
+const WorkerKeepalivePrompt = "[KEEPALIVE] No task yet. Do not start any work or call any tools. Reply \"ok\" and end your turn."
+
+// ReconcileResult holds the findings from a single reconcile pass.
+type ReconcileResult struct {
+	// CheckedAt is when the pass ran.
+	CheckedAt time.Time
+	// OrphanedTasks lists active tasks whose worker can no longer progress them.
+	OrphanedTasks []adapter.OrphanedTask
+	// StuckWorkers lists workers working longer than the stuck timeout.
+	StuckWorkers []adapter.StuckWorker
+	// UnreadyWorkers lists spawned workers that have not signaled ready within the ready timeout.
+	UnreadyWorkers []adapter.UnreadyWorker
+	// RecoveredWorkers lists unready workers retired (or replaced, when respawning) by this pass.
+	RecoveredWorkers []string
+	// SpawnedWorkers lists workers spawned by this pass to keep the minimum ready pool.
+	SpawnedWorkers []string
+	// KeepaliveWorkers lists idle ready workers sent a keepalive prompt by this pass.
+	KeepaliveWorkers []string
+	// UnresponsiveWorkers lists idle workers found by this pass to have left their last
+	// keepalive unanswered for a full keepalive interval. They are not pinged again.
+	UnresponsiveWorkers []string
+}
+
+// HasFindings returns true if the pass found orphaned tasks, stuck workers, unready
+// workers, or workers whose sessions stopped answering keepalives.
+func (r ReconcileResult) HasFindings() bool {
+	return len(r.OrphanedTasks) > 0 || len(r.StuckWorkers) > 0 || len(r.UnreadyWorkers) > 0 ||
+		len(r.UnresponsiveWorkers) > 0
+}
+
+// ReconcileEvent is published on the event bus when a reconcile pass has findings.
+type ReconcileEvent struct {
+	Result ReconcileResult
+}
+
+// ReconcilePolicy is invoked after every reconcile pass and may take recovery action,
+// such as notifying the coordinator or reassigning orphaned tasks.
+type ReconcilePolicy func(ctx context.Context, result ReconcileResult)
+
+// ReconcileTicker delivers ticks for the reconcile loop.
+type ReconcileTicker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// ReconcileClock provides time operations for the reconcile loop (allows testing).
+type ReconcileClock interface {
+	types.Clock
+	NewTicker(d time.Duration) ReconcileTicker
+}
+
+// NewReconcileClock returns a ReconcileClock that reads the time from clock and ticks
+// in real time, so findings are stamped by the same clock as the handlers.
+// A nil clock reads the wall clock.
+func NewReconcileClock(clock types.Clock) ReconcileClock {
+	if clock == nil {
+		clock = types.RealClock{}
+	}
+	return realReconcileClock{Clock: clock}
+}
+
+// realReconcileClock implements ReconcileClock with a real ticker.
+type realReconcileClock struct {
+	types.Clock
+}
+
+func (realReconcileClock) NewTicker(d time.Duration) ReconcileTicker {
+	return &realReconcileTicker{ticker: time.NewTicker(d)}
+}
+
+type realReconcileTicker struct {
+	ticker *time.Ticker
+}
+
+func (t *realReconcileTicker) C() <-chan time.Time { return t.ticker.C }
+func (t *realReconcileTicker) Stop()               { t.ticker.Stop() }
+
+// ReconcileLoop periodically detects orphaned tasks, stuck workers, and workers that
+// never signal ready, publishes findings, and hands them to an optional recovery policy.
+// It consolidates background polling into a single configurable cadence.
+type ReconcileLoop struct {
+	adapter      *adapter.V2Adapter
+	interval     time.Duration
+	stuckTimeout time.Duration
+	readyTimeout time.Duration
+	clock        ReconcileClock
+	eventBus     *pubsub.Broker[any]
+	policy       ReconcilePolicy
+
+	// Unready worker recovery (disabled when submitter is nil)
+	submitter  process.CommandSubmitter
+	respawn    bool
+	recoveryMu sync.Mutex
+	recovered  map[string]bool // Workers a retire/replace was already submitted for
+
+	// Ready pool floor (disabled when zero)
+	minReady int
+
+	// Idle worker keepalive (disabled when keepaliveSubmitter is nil)
+	keepaliveSubmitter process.CommandSubmitter
+	keepaliveInterval  time.Duration
+	maxKeepalives      int
+	keepaliveMu        sync.Mutex
+	keepalives         map[string]*workerKeepalive // Unassigned workers pinged so far
+}
+
+// workerKeepalive tracks the keepalive prompts sent to one idle ready worker and
+// whether its session is still answering them.
+type workerKeepalive struct {
+	lastSent     time.Time
+	sent         int
+	aliveAt      time.Time // Last turn completed at or after a keepalive was sent
+	unresponsive bool      // Last keepalive went unanswered for a full interval
+}
+
+// ReconcileLoopOption configures a ReconcileLoop.
+type ReconcileLoopOption func(*ReconcileLoop)
+
+// WithReconcileInterval sets how often the loop runs. Values <= 0 are ignored.
+func WithReconcileInterval(interval time.Duration) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		if interval > 0 {
+			l.interval = interval
+		}
+	}
+}
+
+// WithStuckWorkerTimeout sets how long a worker may work before it is reported stuck.
+// Values <= 0 are ignored.
+func WithStuckWorkerTimeout(timeout time.Duration) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		if timeout > 0 {
+			l.stuckTimeout = timeout
+		}
+	}
+}
+
+// WithReadyTimeout sets how long a spawned worker may take to signal ready before it is
+// reported as unready. Values <= 0 are ignored.
+func WithReadyTimeout(timeout time.Duration) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		if timeout > 0 {
+			l.readyTimeout = timeout
+		}
+	}
+}
+
+// WithUnreadyWorkerRecovery retires workers that never signal ready by submitting
+// commands through submitter. When respawn is true each one is replaced with a fresh
+// worker instead. Without this option unready workers are only reported.
+func WithUnreadyWorkerRecovery(submitter process.CommandSubmitter, respawn bool) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		l.submitter = submitter
+		l.respawn = respawn
+	}
+}
+
+// WithMinReadyWorkers keeps at least n workers ready or starting up, spawning
+// replacements on each pass while the shared worker pool has free slots. The floor wins
+// over retirement: workers retired below it are replaced on the next pass.
+// Values <= 0 disable the floor.
+func WithMinReadyWorkers(n int) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		l.minReady = max(n, 0)
+	}
+}
+
+// WithWorkerKeepalive sends WorkerKeepalivePrompt through submitter to each ready worker
+// that has been idle without a task for interval, so its session stays warm instead of
+// being re-spawned. Each worker gets at most maxPings prompts (DefaultMaxWorkerKeepalives
+// when <= 0) until it is next assigned a task, which also stops the pings. Keepalives
+// are only sent on reconcile passes, so the effective interval is rounded up to the
+// reconcile interval. An interval <= 0 disables keepalive.
+func WithWorkerKeepalive(submitter process.CommandSubmitter, interval time.Duration, maxPings int) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		if submitter == nil || interval <= 0 {
+			return
+		}
+		if maxPings <= 0 {
+			maxPings = DefaultMaxWorkerKeepalives
+		}
+		l.keepaliveSubmitter = submitter
+		l.keepaliveInterval = interval
+		l.maxKeepalives = maxPings
+	}
+}
+
+// WithReconcileClock sets the clock used for ticks and timestamps.
+func WithReconcileClock(clock ReconcileClock) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		l.clock = clock
+	}
+}
+
+// WithReconcileEventBus sets the event bus ReconcileEvents are published to.
+func WithReconcileEventBus(eventBus *pubsub.Broker[any]) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		l.eventBus = eventBus
+	}
+}
+
+// WithReconcilePolicy sets the recovery policy invoked after each pass.
+func WithReconcilePolicy(policy ReconcilePolicy) ReconcileLoopOption {
+	return func(l *ReconcileLoop) {
+		l.policy = policy
+	}
+}
+
+// NewReconcileLoop creates a new ReconcileLoop reading state through the given adapter.
+// Panics if adapter is nil.
+func NewReconcileLoop(a *adapter.V2Adapter, opts ...ReconcileLoopOption) *ReconcileLoop {
+	if a == nil {
+		panic("adapter is required for ReconcileLoop")
+	}
+	l := &ReconcileLoop{
+		adapter:      a,
+		interval:     DefaultReconcileInterval,
+		stuckTimeout: DefaultStuckWorkerTimeout,
+		readyTimeout: DefaultReadyTimeout,
+		clock:        NewReconcileClock(nil),
+		recovered:    make(map[string]bool),
+		keepalives:   make(map[string]*workerKeepalive),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Interval returns the configured reconcile interval.
+func (l *ReconcileLoop) Interval() time.Duration {
+	return l.interval
+}
+
+// Run executes a reconcile pass on every tick until ctx is cancelled.
+func (l *ReconcileLoop) Run(ctx context.Context) {
+	ticker := l.clock.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			l.RunOnce(ctx)
+		}
+	}
+}
+
+// RunOnce executes a single reconcile pass and returns its findings.
+func (l *ReconcileLoop) RunOnce(ctx context.Context) ReconcileResult {
+	now := l.clock.Now()
+	result := ReconcileResult{
+		CheckedAt:      now,
+		OrphanedTasks:  l.adapter.DetectOrphanedTasks(),
+		StuckWorkers:   l.adapter.CheckStuckWorkers(now, l.stuckTimeout),
+		UnreadyWorkers: l.adapter.CheckUnreadyWorkers(now, l.readyTimeout),
+	}
+	result.RecoveredWorkers = l.recoverUnreadyWorkers(result.UnreadyWorkers)
+	result.SpawnedWorkers = l.fillReadyPool(ctx)
+	result.KeepaliveWorkers, result.UnresponsiveWorkers = l.keepAliveIdleWorkers(now)
+
+	if result.HasFindings() {
+		log.Warn(log.CatOrch, "Reconcile found problems", "subsystem", "reconcile",
+			"orphanedTasks", len(result.OrphanedTasks), "stuckWorkers", len(result.StuckWorkers),
+			"unreadyWorkers", len(result.UnreadyWorkers), "recoveredWorkers", len(result.RecoveredWorkers),
+			"unresponsiveWorkers", len(result.UnresponsiveWorkers))
+		if l.eventBus != nil {
+			l.eventBus.Publish(pubsub.UpdatedEvent, ReconcileEvent{Result: result})
+		}
+	}
+
+	if l.policy != nil {
+		l.policy(ctx, result)
+	}
+
+	return result
+}
+
+// recoverUnreadyWorkers submits a retire (or replace, when respawning) command for each
+// unready worker not already handled by an earlier pass. A retired worker's slot is
+// returned to the shared pool. Returns the worker IDs acted on.
+func (l *ReconcileLoop) recoverUnreadyWorkers(unready []adapter.UnreadyWorker) []string {
+	if l.submitter == nil || len(unready) == 0 {
+		return nil
+	}
+
+	l.recoveryMu.Lock()
+	defer l.recoveryMu.Unlock()
+
+	var recovered []string
+	for _, w := range unready {
+		if l.recovered[w.WorkerID] {
+			continue
+		}
+		reason := fmt.Sprintf("worker did not signal ready within %s of spawning", l.readyTimeout)
+		if l.respawn {
+			// The replacement takes over the retired worker's slot
+			l.submitter.Submit(command.NewReplaceProcessCommand(command.SourceInternal, w.WorkerID, reason))
+		} else {
+			// The retire handler returns the worker's slot to the shared pool
+			l.submitter.Submit(command.NewRetireProcessCommand(command.SourceInternal, w.WorkerID, reason))
+		}
+		l.recovered[w.WorkerID] = true
+		recovered = append(recovered, w.WorkerID)
+
+		log.Warn(log.CatOrch, "Recovering worker that never signaled ready", "subsystem", "reconcile",
+			"workerID", w.WorkerID, "waiting", w.Waiting, "respawn", l.respawn)
+	}
+	return recovered
+}
+
+// fillReadyPool spawns workers until the minimum ready pool is met or the shared pool
+// is full. Returns the worker IDs spawned.
+func (l *ReconcileLoop) fillReadyPool(ctx context.Context) []string {
+	if l.minReady == 0 {
+		return nil
+	}
+
+	spawned, err := l.adapter.EnsureReadyWorkers(ctx, l.minReady)
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to fill ready worker pool", "subsystem", "reconcile",
+			"minReady", l.minReady, "spawned", len(spawned), "error", err)
+	}
+	if len(spawned) > 0 {
+		log.Info(log.CatOrch, "Spawned workers to keep the ready pool", "subsystem", "reconcile",
+			"minReady", l.minReady, "spawned", spawned)
+	}
+	return spawned
+}
+
+// keepAliveIdleWorkers sends a keepalive prompt to each idle ready worker whose last turn
+// (or last keepalive) is at least the keepalive interval old and whose budget is not spent.
+// A keepalive is answered once the worker completes a turn after it was sent; a worker
+// that leaves one unanswered for a full interval is reported unresponsive and not pinged
+// again. Workers that take a task or leave the pool are forgotten, so a worker gets a
+// fresh budget each time it becomes unassigned. Returns the worker IDs pinged and the
+// worker IDs newly found unresponsive.
+func (l *ReconcileLoop) keepAliveIdleWorkers(now time.Time) (pinged, unresponsive []string) {
+	if l.keepaliveSubmitter == nil {
+		return nil, nil
+	}
+
+	unassigned := l.adapter.UnassignedWorkers(now)
+
+	l.keepaliveMu.Lock()
+	defer l.keepaliveMu.Unlock()
+
+	stillUnassigned := make(map[string]bool, len(unassigned))
+	for _, w := range unassigned {
+		stillUnassigned[w.WorkerID] = true
+	}
+	for id := range l.keepalives {
+		if !stillUnassigned[id] {
+			delete(l.keepalives, id)
+		}
+	}
+
+	for _, w := range unassigned {
+		ka := l.keepalives[w.WorkerID]
+		if ka == nil {
+			if !w.Ready {
+				continue
+			}
+			ka = &workerKeepalive{}
+			l.keepalives[w.WorkerID] = ka
+		}
+		if ka.unresponsive {
+			continue
+		}
+
+		lastTurn := now.Add(-w.Idle)
+		if !ka.lastSent.IsZero() {
+			if !lastTurn.Before(ka.lastSent) {
+				ka.aliveAt = lastTurn
+			} else if now.Sub(ka.lastSent) >= l.keepaliveInterval {
+				ka.unresponsive = true
+				unresponsive = append(unresponsive, w.WorkerID)
+				log.Warn(log.CatOrch, "Idle worker did not answer keepalive", "subsystem", "reconcile",
+					"workerID", w.WorkerID, "sentAt", ka.lastSent, "aliveAt", ka.aliveAt)
+				continue
+			}
+		}
+
+		if !w.Ready || ka.sent >= l.maxKeepalives {
+			continue
+		}
+		sinceActive := w.Idle
+		if !ka.lastSent.IsZero() {
+			sinceActive = min(sinceActive, now.Sub(ka.lastSent))
+		}
+		if sinceActive < l.keepaliveInterval {
+			continue
+		}
+
+		l.keepaliveSubmitter.Submit(command.NewSendKeepaliveCommand(command.SourceInternal, w.WorkerID, WorkerKeepalivePrompt))
+		ka.lastSent = now
+		ka.sent++
+		pinged = append(pinged, w.WorkerID)
+
+		log.Debug(log.CatOrch, "Sent keepalive to idle worker", "subsystem", "reconcile",
+			"workerID", w.WorkerID, "idle", w.Idle, "sent", ka.sent, "max", l.maxKeepalives)
+	}
+	return pinged, unresponsive
+}
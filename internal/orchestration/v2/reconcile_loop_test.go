@@ -0,0 +1,655 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+// fakeReconcileClock is a controllable clock whose ticker fires only when Tick is called.
+type fakeReconcileClock struct {
+	mu       sync.Mutex
+	now      time.Time
+	ticks    chan time.Time
+	interval time.Duration
+}
+
+func newFakeReconcileClock(now time.Time) *fakeReconcileClock {
+	return &fakeReconcileClock{now: now, ticks: make(chan time.Time)}
+}
+
+func (c *fakeReconcileClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeReconcileClock) NewTicker(d time.Duration) ReconcileTicker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interval = d
+	return fakeReconcileTicker{c: c.ticks}
+}
+
+// Tick advances the clock by one interval and blocks until the loop receives the tick.
+func (c *fakeReconcileClock) Tick() {
+	c.mu.Lock()
+	c.now = c.now.Add(c.interval)
+	now := c.now
+	c.mu.Unlock()
+	c.ticks <- now
+}
+
+type fakeReconcileTicker struct {
+	c chan time.Time
+}
+
+func (t fakeReconcileTicker) C() <-chan time.Time { return t.c }
+func (t fakeReconcileTicker) Stop()               {}
+
+func newReconcileTestAdapter(t *testing.T, opts ...adapter.Option) (*adapter.V2Adapter, *repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	a := adapter.NewV2Adapter(nil, append([]adapter.Option{
+		adapter.WithProcessRepository(processRepo),
+		adapter.WithTaskRepository(taskRepo),
+	}, opts...)...)
+	return a, processRepo, taskRepo
+}
+
+func TestReconcileLoop_FiresOncePerTick(t *testing.T) {
+	a, _, _ := newReconcileTestAdapter(t)
+	clock := newFakeReconcileClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	passes := make(chan ReconcileResult, 10)
+	loop := NewReconcileLoop(a,
+		WithReconcileInterval(50*time.Millisecond),
+		WithReconcileClock(clock),
+		WithReconcilePolicy(func(_ context.Context, result ReconcileResult) {
+			passes <- result
+		}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		loop.Run(ctx)
+		close(done)
+	}()
+
+	for range 3 {
+		clock.Tick()
+	}
+	for i := range 3 {
+		select {
+		case <-passes:
+		case <-time.After(time.Second):
+			t.Fatalf("reconcile pass %d did not run", i+1)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("reconcile loop did not stop after context cancellation")
+	}
+
+	require.Equal(t, 50*time.Millisecond, clock.interval)
+	require.Empty(t, passes, "loop should run exactly once per tick")
+}
+
+func TestNewReconcileClock_ReadsCoordinatorClock(t *testing.T) {
+	coordinatorClock := types.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	clock := NewReconcileClock(coordinatorClock)
+	require.Equal(t, coordinatorClock.Now(), clock.Now())
+
+	coordinatorClock.Advance(time.Hour)
+	require.Equal(t, coordinatorClock.Now(), clock.Now())
+
+	require.WithinDuration(t, time.Now(), NewReconcileClock(nil).Now(), time.Second)
+}
+
+func TestReconcileLoop_RunOnceReportsFindings(t *testing.T) {
+	a, processRepo, taskRepo := newReconcileTestAdapter(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := newFakeReconcileClock(now)
+
+	// worker-1 has been working for 20 minutes without completing a turn
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:             "worker-1",
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusWorking,
+		TaskID:         "perles-abc.1",
+		LastActivityAt: now.Add(-20 * time.Minute),
+	}))
+	// worker-2 is working but recently active
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:             "worker-2",
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusWorking,
+		LastActivityAt: now.Add(-time.Minute),
+	}))
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc.2",
+		Implementer: "worker-gone",
+		Status:      repository.TaskImplementing,
+	}))
+
+	eventBus := pubsub.NewBroker[any]()
+	defer eventBus.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := eventBus.Subscribe(ctx)
+
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(clock),
+		WithStuckWorkerTimeout(10*time.Minute),
+		WithReconcileEventBus(eventBus),
+	)
+
+	result := loop.RunOnce(ctx)
+
+	require.Equal(t, now, result.CheckedAt)
+	require.Len(t, result.OrphanedTasks, 1)
+	require.Equal(t, "perles-abc.2", result.OrphanedTasks[0].TaskID)
+	require.Equal(t, []adapter.StuckWorker{
+		{WorkerID: "worker-1", TaskID: "perles-abc.1", Idle: 20 * time.Minute},
+	}, result.StuckWorkers)
+
+	select {
+	case ev := <-sub:
+		event, ok := ev.Payload.(ReconcileEvent)
+		require.True(t, ok, "expected ReconcileEvent, got %T", ev.Payload)
+		require.Equal(t, result, event.Result)
+	case <-time.After(time.Second):
+		t.Fatal("expected ReconcileEvent to be published")
+	}
+}
+
+func TestReconcileLoop_NoEventWithoutFindings(t *testing.T) {
+	a, _, _ := newReconcileTestAdapter(t)
+
+	eventBus := pubsub.NewBroker[any]()
+	defer eventBus.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := eventBus.Subscribe(ctx)
+
+	result := NewReconcileLoop(a, WithReconcileEventBus(eventBus)).RunOnce(ctx)
+
+	require.False(t, result.HasFindings())
+	select {
+	case ev := <-sub:
+		t.Fatalf("unexpected event: %+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// recordingSubmitter records submitted commands.
+type recordingSubmitter struct {
+	mu   sync.Mutex
+	cmds []command.Command
+}
+
+func (s *recordingSubmitter) Submit(cmd command.Command) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cmds = append(s.cmds, cmd)
+}
+
+// saveNeverReadyWorker stores a worker spawned at spawnedAt that is still running its first turn.
+func saveNeverReadyWorker(t *testing.T, processRepo *repository.MemoryProcessRepository, id string, spawnedAt time.Time) {
+	t.Helper()
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:             id,
+		Role:           repository.RoleWorker,
+		Status:         repository.StatusWorking,
+		CreatedAt:      spawnedAt,
+		LastActivityAt: spawnedAt,
+	}))
+}
+
+func TestReconcileLoop_RetiresWorkerThatNeverSignalsReady(t *testing.T) {
+	capacity := &fakeWorkerCapacity{limit: 2, inUse: 2}
+	a, processRepo, _ := newReconcileTestAdapter(t, adapter.WithWorkerCapacity(capacity))
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	saveNeverReadyWorker(t, processRepo, "worker-1", now.Add(-3*time.Minute))
+	saveNeverReadyWorker(t, processRepo, "worker-2", now.Add(-30*time.Second))
+
+	eventBus := pubsub.NewBroker[any]()
+	defer eventBus.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := eventBus.Subscribe(ctx)
+
+	submitter := &recordingSubmitter{}
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(newFakeReconcileClock(now)),
+		WithReconcileEventBus(eventBus),
+		WithReadyTimeout(2*time.Minute),
+		WithUnreadyWorkerRecovery(submitter, false),
+	)
+
+	result := loop.RunOnce(ctx)
+
+	require.Equal(t, []adapter.UnreadyWorker{{WorkerID: "worker-1", Waiting: 3 * time.Minute}}, result.UnreadyWorkers)
+	require.Equal(t, []string{"worker-1"}, result.RecoveredWorkers)
+	require.Len(t, submitter.cmds, 1)
+	retireCmd, ok := submitter.cmds[0].(*command.RetireProcessCommand)
+	require.True(t, ok, "expected RetireProcessCommand, got %T", submitter.cmds[0])
+	require.Equal(t, "worker-1", retireCmd.ProcessID)
+	require.Contains(t, retireCmd.Reason, "did not signal ready")
+	require.Equal(t, 2, capacity.inUse, "the retire handler, not the loop, releases the slot")
+
+	select {
+	case ev := <-sub:
+		reconcileEvent, ok := ev.Payload.(ReconcileEvent)
+		require.True(t, ok)
+		require.Len(t, reconcileEvent.Result.UnreadyWorkers, 1)
+	case <-time.After(time.Second):
+		t.Fatal("expected a ReconcileEvent")
+	}
+
+	// The retire command is processed asynchronously; later passes must not resubmit it
+	result = loop.RunOnce(ctx)
+	require.Len(t, result.UnreadyWorkers, 1)
+	require.Empty(t, result.RecoveredWorkers)
+	require.Len(t, submitter.cmds, 1)
+	require.Equal(t, 2, capacity.inUse)
+}
+
+func TestReconcileLoop_RespawnsWorkerThatNeverSignalsReady(t *testing.T) {
+	capacity := &fakeWorkerCapacity{limit: 1, inUse: 1}
+	a, processRepo, _ := newReconcileTestAdapter(t, adapter.WithWorkerCapacity(capacity))
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	saveNeverReadyWorker(t, processRepo, "worker-1", now.Add(-10*time.Minute))
+
+	submitter := &recordingSubmitter{}
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(newFakeReconcileClock(now)),
+		WithUnreadyWorkerRecovery(submitter, true),
+	)
+
+	result := loop.RunOnce(context.Background())
+
+	require.Equal(t, []string{"worker-1"}, result.RecoveredWorkers)
+	require.Equal(t, 1, capacity.inUse, "the replacement keeps the retired worker's slot")
+	require.Len(t, submitter.cmds, 1)
+	replaceCmd, ok := submitter.cmds[0].(*command.ReplaceProcessCommand)
+	require.True(t, ok, "expected ReplaceProcessCommand, got %T", submitter.cmds[0])
+	require.Equal(t, "worker-1", replaceCmd.ProcessID)
+}
+
+func TestReconcileLoop_ReportsUnreadyWorkersWithoutRecovery(t *testing.T) {
+	a, processRepo, _ := newReconcileTestAdapter(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	saveNeverReadyWorker(t, processRepo, "worker-1", now.Add(-10*time.Minute))
+
+	result := NewReconcileLoop(a, WithReconcileClock(newFakeReconcileClock(now))).RunOnce(context.Background())
+
+	require.True(t, result.HasFindings())
+	require.Len(t, result.UnreadyWorkers, 1)
+	require.Empty(t, result.RecoveredWorkers)
+}
+
+// fakeWorkerCapacity grants up to limit worker slots without ever blocking.
+type fakeWorkerCapacity struct {
+	mu    sync.Mutex
+	limit int
+	inUse int
+}
+
+func (c *fakeWorkerCapacity) Acquire(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.inUse >= c.limit {
+		return context.Canceled
+	}
+	c.inUse++
+	return nil
+}
+
+func (c *fakeWorkerCapacity) Release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inUse--
+}
+
+// newReadyPoolTestAdapter creates an adapter backed by a running processor whose spawn
+// handler stores each new worker as starting.
+func newReadyPoolTestAdapter(t *testing.T, opts ...adapter.Option) (*adapter.V2Adapter, *repository.MemoryProcessRepository) {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	p := processor.NewCommandProcessor()
+	spawns := 0
+	p.RegisterHandler(command.CmdSpawnProcess, processor.HandlerFunc(
+		func(_ context.Context, _ command.Command) (*command.CommandResult, error) {
+			spawns++
+			id := fmt.Sprintf("worker-%d", spawns)
+			if err := processRepo.Save(&repository.Process{
+				ID:     id,
+				Role:   repository.RoleWorker,
+				Status: repository.StatusStarting,
+			}); err != nil {
+				return nil, err
+			}
+			return &command.CommandResult{Success: true, Data: id}, nil
+		}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		p.Stop()
+	})
+	go p.Run(ctx)
+	require.NoError(t, p.WaitForReady(ctx))
+
+	opts = append([]adapter.Option{adapter.WithProcessRepository(processRepo)}, opts...)
+	return adapter.NewV2Adapter(p, opts...), processRepo
+}
+
+func TestReconcileLoop_FillsReadyPoolUpToMinimum(t *testing.T) {
+	a, processRepo := newReadyPoolTestAdapter(t)
+	idle := events.ProcessPhaseIdle
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID: "worker-ready", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle, HasCompletedTurn: true,
+	}))
+
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(newFakeReconcileClock(time.Now())),
+		WithMinReadyWorkers(3),
+	)
+
+	result := loop.RunOnce(context.Background())
+	require.Equal(t, []string{"worker-1", "worker-2"}, result.SpawnedWorkers)
+	require.False(t, result.HasFindings(), "filling the ready pool is not a problem")
+	require.Equal(t, 3, a.WarmWorkers())
+
+	// Once the minimum is met, later passes stop spawning
+	result = loop.RunOnce(context.Background())
+	require.Empty(t, result.SpawnedWorkers)
+	require.Len(t, processRepo.Workers(), 3)
+}
+
+func TestReconcileLoop_ReplacesWorkersBelowMinimum(t *testing.T) {
+	a, processRepo := newReadyPoolTestAdapter(t)
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(newFakeReconcileClock(time.Now())),
+		WithMinReadyWorkers(2),
+	)
+	require.Len(t, loop.RunOnce(context.Background()).SpawnedWorkers, 2)
+
+	// One worker picks up a task and another is retired: the floor wins
+	working := events.ProcessPhaseImplementing
+	busy, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	busy.Status, busy.Phase, busy.HasCompletedTurn = repository.StatusWorking, &working, true
+	require.NoError(t, processRepo.Save(busy))
+	retired, err := processRepo.Get("worker-2")
+	require.NoError(t, err)
+	retired.Status = repository.StatusRetired
+	require.NoError(t, processRepo.Save(retired))
+
+	result := loop.RunOnce(context.Background())
+	require.Equal(t, []string{"worker-3", "worker-4"}, result.SpawnedWorkers)
+}
+
+func TestReconcileLoop_ReadyPoolRespectsWorkerCapacity(t *testing.T) {
+	capacity := &fakeWorkerCapacity{limit: 2}
+	a, processRepo := newReadyPoolTestAdapter(t, adapter.WithWorkerCapacity(capacity))
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(newFakeReconcileClock(time.Now())),
+		WithMinReadyWorkers(4),
+	)
+
+	result := loop.RunOnce(context.Background())
+	require.Equal(t, []string{"worker-1", "worker-2"}, result.SpawnedWorkers)
+	require.Equal(t, 2, capacity.inUse)
+
+	// The pool stays full, so later passes do not spawn or wait for a slot
+	result = loop.RunOnce(context.Background())
+	require.Empty(t, result.SpawnedWorkers)
+	require.Len(t, processRepo.Workers(), 2)
+}
+
+func TestReconcileLoop_ReadyPoolDisabledByDefault(t *testing.T) {
+	a, processRepo := newReadyPoolTestAdapter(t)
+
+	result := NewReconcileLoop(a, WithReconcileClock(newFakeReconcileClock(time.Now()))).RunOnce(context.Background())
+
+	require.Empty(t, result.SpawnedWorkers)
+	require.Empty(t, processRepo.Workers())
+}
+
+// saveIdleWorker stores a ready worker with no task whose last turn completed at lastTurn.
+func saveIdleWorker(t *testing.T, processRepo *repository.MemoryProcessRepository, id string, lastTurn time.Time) {
+	t.Helper()
+	idle := events.ProcessPhaseIdle
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID:               id,
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusReady,
+		Phase:            &idle,
+		HasCompletedTurn: true,
+		CreatedAt:        lastTurn,
+		LastActivityAt:   lastTurn,
+	}))
+}
+
+// answerKeepalive records a completed turn for an idle worker at the given time.
+func answerKeepalive(t *testing.T, processRepo *repository.MemoryProcessRepository, id string, at time.Time) {
+	t.Helper()
+	proc, err := processRepo.Get(id)
+	require.NoError(t, err)
+	proc.LastActivityAt = at
+	require.NoError(t, processRepo.Save(proc))
+}
+
+// keepaliveTargets returns the worker IDs sent keepalive prompts, in order.
+func keepaliveTargets(t *testing.T, submitter *recordingSubmitter) []string {
+	t.Helper()
+	submitter.mu.Lock()
+	defer submitter.mu.Unlock()
+	var ids []string
+	for _, cmd := range submitter.cmds {
+		sendCmd, ok := cmd.(*command.SendToProcessCommand)
+		require.True(t, ok, "expected SendToProcessCommand, got %T", cmd)
+		require.Equal(t, WorkerKeepalivePrompt, sendCmd.Content)
+		require.True(t, sendCmd.Keepalive)
+		ids = append(ids, sendCmd.ProcessID)
+	}
+	return ids
+}
+
+func TestReconcileLoop_KeepalivePingsIdleWorkerAtInterval(t *testing.T) {
+	a, processRepo, _ := newReconcileTestAdapter(t)
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := newFakeReconcileClock(start)
+	saveIdleWorker(t, processRepo, "worker-1", start)
+
+	submitter := &recordingSubmitter{}
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(clock),
+		WithWorkerKeepalive(submitter, 3*time.Minute, 10),
+	)
+
+	var pinged []time.Duration
+	for range 9 {
+		clock.now = clock.now.Add(time.Minute)
+		if result := loop.RunOnce(context.Background()); len(result.KeepaliveWorkers) > 0 {
+			require.Equal(t, []string{"worker-1"}, result.KeepaliveWorkers)
+			pinged = append(pinged, clock.now.Sub(start))
+			answerKeepalive(t, processRepo, "worker-1", clock.now)
+		}
+	}
+
+	require.Equal(t, []time.Duration{3 * time.Minute, 6 * time.Minute, 9 * time.Minute}, pinged)
+	require.Equal(t, []string{"worker-1", "worker-1", "worker-1"}, keepaliveTargets(t, submitter))
+}
+
+func TestReconcileLoop_KeepaliveWaitsForWorkerActivity(t *testing.T) {
+	a, processRepo, _ := newReconcileTestAdapter(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	saveIdleWorker(t, processRepo, "worker-1", now.Add(-2*time.Minute))
+
+	submitter := &recordingSubmitter{}
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(newFakeReconcileClock(now)),
+		WithWorkerKeepalive(submitter, 3*time.Minute, 10),
+	)
+
+	require.Empty(t, loop.RunOnce(context.Background()).KeepaliveWorkers, "worker completed a turn within the interval")
+	require.Empty(t, submitter.cmds)
+}
+
+func TestReconcileLoop_KeepaliveStopsOnceWorkerIsAssigned(t *testing.T) {
+	a, processRepo, _ := newReconcileTestAdapter(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := newFakeReconcileClock(now)
+	saveIdleWorker(t, processRepo, "worker-1", now.Add(-10*time.Minute))
+
+	submitter := &recordingSubmitter{}
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(clock),
+		WithWorkerKeepalive(submitter, 3*time.Minute, 10),
+	)
+	require.Equal(t, []string{"worker-1"}, loop.RunOnce(context.Background()).KeepaliveWorkers)
+
+	proc, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	proc.TaskID = "perles-abc.1"
+	require.NoError(t, processRepo.Save(proc))
+
+	for range 3 {
+		clock.now = clock.now.Add(5 * time.Minute)
+		require.Empty(t, loop.RunOnce(context.Background()).KeepaliveWorkers)
+	}
+	require.Len(t, submitter.cmds, 1)
+	require.Empty(t, loop.keepalives, "assigned workers are forgotten")
+}
+
+func TestReconcileLoop_KeepaliveStopsAtMaxPings(t *testing.T) {
+	a, processRepo, _ := newReconcileTestAdapter(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := newFakeReconcileClock(now)
+	saveIdleWorker(t, processRepo, "worker-1", now)
+
+	submitter := &recordingSubmitter{}
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(clock),
+		WithWorkerKeepalive(submitter, time.Minute, 2),
+	)
+	for range 5 {
+		clock.now = clock.now.Add(time.Minute)
+		loop.RunOnce(context.Background())
+		answerKeepalive(t, processRepo, "worker-1", clock.now)
+	}
+	require.Len(t, submitter.cmds, 2)
+
+	// A keepalive turn in progress does not reset the budget
+	proc, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	proc.Status = repository.StatusWorking
+	require.NoError(t, processRepo.Save(proc))
+	loop.RunOnce(context.Background())
+	proc.Status = repository.StatusReady
+	require.NoError(t, processRepo.Save(proc))
+	clock.now = clock.now.Add(time.Hour)
+	require.Empty(t, loop.RunOnce(context.Background()).KeepaliveWorkers)
+	require.Len(t, submitter.cmds, 2)
+}
+
+func TestReconcileLoop_KeepaliveTracksSessionLiveness(t *testing.T) {
+	a, processRepo, _ := newReconcileTestAdapter(t)
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := newFakeReconcileClock(start)
+	saveIdleWorker(t, processRepo, "worker-1", start)
+	saveIdleWorker(t, processRepo, "worker-2", start)
+
+	submitter := &recordingSubmitter{}
+	loop := NewReconcileLoop(a,
+		WithReconcileClock(clock),
+		WithWorkerKeepalive(submitter, 3*time.Minute, 10),
+	)
+
+	clock.now = start.Add(3 * time.Minute)
+	require.Equal(t, []string{"worker-1", "worker-2"}, loop.RunOnce(context.Background()).KeepaliveWorkers)
+
+	// Only worker-1 answers; worker-2's session stays silent
+	answerKeepalive(t, processRepo, "worker-1", start.Add(4*time.Minute))
+	clock.now = start.Add(6 * time.Minute)
+	result := loop.RunOnce(context.Background())
+	require.Empty(t, result.KeepaliveWorkers)
+	require.Equal(t, []string{"worker-2"}, result.UnresponsiveWorkers)
+	require.True(t, result.HasFindings())
+	require.Equal(t, start.Add(4*time.Minute), loop.keepalives["worker-1"].aliveAt)
+
+	// Unresponsive workers are reported once and not pinged again
+	clock.now = start.Add(7 * time.Minute)
+	result = loop.RunOnce(context.Background())
+	require.Equal(t, []string{"worker-1"}, result.KeepaliveWorkers)
+	require.Empty(t, result.UnresponsiveWorkers)
+	require.Equal(t, []string{"worker-1", "worker-2", "worker-1"}, keepaliveTargets(t, submitter))
+}
+
+func TestReconcileLoop_KeepaliveDisabledByDefault(t *testing.T) {
+	a, processRepo, _ := newReconcileTestAdapter(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	saveIdleWorker(t, processRepo, "worker-1", now.Add(-time.Hour))
+
+	result := NewReconcileLoop(a, WithReconcileClock(newFakeReconcileClock(now))).RunOnce(context.Background())
+	require.Empty(t, result.KeepaliveWorkers)
+
+	loop := NewReconcileLoop(a, WithWorkerKeepalive(&recordingSubmitter{}, time.Minute, 0))
+	require.Equal(t, DefaultMaxWorkerKeepalives, loop.maxKeepalives)
+}
+
+func TestNewReconcileLoop_Defaults(t *testing.T) {
+	a, _, _ := newReconcileTestAdapter(t)
+
+	loop := NewReconcileLoop(a, WithReconcileInterval(0), WithStuckWorkerTimeout(-time.Second), WithReadyTimeout(0))
+
+	require.Equal(t, DefaultReconcileInterval, loop.Interval())
+	require.Equal(t, DefaultStuckWorkerTimeout, loop.stuckTimeout)
+	require.Equal(t, DefaultReadyTimeout, loop.readyTimeout)
+}
+
+func TestNewInfrastructure_ReconcileInterval(t *testing.T) {
+	newCfg := func(interval time.Duration) InfrastructureConfig {
+		return InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:           "/tmp/test",
+			ReconcileInterval: interval,
+		}
+	}
+
+	infra, err := NewInfrastructure(newCfg(0))
+	require.NoError(t, err)
+	require.Equal(t, DefaultReconcileInterval, infra.Internal.ReconcileLoop.Interval())
+
+	infra, err = NewInfrastructure(newCfg(5 * time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, infra.Internal.ReconcileLoop.Interval())
+
+	infra, err = NewInfrastructure(newCfg(-1))
+	require.NoError(t, err)
+	require.Nil(t, infra.Internal.ReconcileLoop, "negative interval disables the loop")
+}
@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// Named reconcile policies selectable through InfrastructureConfig.ReconcilePolicyName.
+const (
+	// ReconcilePolicyLog only logs findings and publishes them on the event bus.
+	ReconcilePolicyLog = "log"
+	// ReconcilePolicyNotifyCoordinator also sends the coordinator a message listing new findings.
+	ReconcilePolicyNotifyCoordinator = "notify_coordinator"
+)
+
+// ValidateReconcilePolicyName returns an error if name is not a known reconcile policy.
+// The empty name selects ReconcilePolicyLog.
+func ValidateReconcilePolicyName(name string) error {
+	switch name {
+	case "", ReconcilePolicyLog, ReconcilePolicyNotifyCoordinator:
+		return nil
+	}
+	return fmt.Errorf("unknown reconcile policy %q (expected %q or %q)",
+		name, ReconcilePolicyLog, ReconcilePolicyNotifyCoordinator)
+}
+
+// NewNotifyCoordinatorPolicy returns a ReconcilePolicy that sends the coordinator a message
+// through submitter listing orphaned tasks, stuck, unready and unresponsive workers.
+// Each finding is reported once while it persists, so a worker stuck across several
+// passes does not produce a message every interval.
+func NewNotifyCoordinatorPolicy(submitter process.CommandSubmitter) ReconcilePolicy {
+	var mu sync.Mutex
+	reported := make(map[string]bool)
+
+	return func(_ context.Context, result ReconcileResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		current := make(map[string]bool)
+		var lines []string
+		report := func(key, line string) {
+			current[key] = true
+			if !reported[key] {
+				lines = append(lines, line)
+			}
+		}
+
+		for _, t := range result.OrphanedTasks {
+			report("orphaned:"+t.TaskID, fmt.Sprintf("- Task %s (%s) is orphaned: %s", t.TaskID, t.Status, t.Reason))
+		}
+		for _, w := range result.StuckWorkers {
+			line := fmt.Sprintf("- %s has not completed a turn for %s", w.WorkerID, w.Idle.Round(time.Second))
+			if w.TaskID != "" {
+				line += fmt.Sprintf(" while working on %s", w.TaskID)
+			}
+			report("stuck:"+w.WorkerID, line)
+		}
+		for _, w := range result.UnreadyWorkers {
+			report("unready:"+w.WorkerID, fmt.Sprintf("- %s has not signaled ready after %s", w.WorkerID, w.Waiting.Round(time.Second)))
+		}
+		for _, id := range result.UnresponsiveWorkers {
+			report("unresponsive:"+id, fmt.Sprintf("- %s did not answer its last keepalive", id))
+		}
+
+		reported = current
+		if len(lines) == 0 {
+			return
+		}
+
+		content := "[RECONCILE] The reconcile loop found problems that may need your attention:\n" +
+			strings.Join(lines, "\n")
+		submitter.Submit(command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, content))
+	}
+}
@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func TestValidateReconcilePolicyName(t *testing.T) {
+	require.NoError(t, ValidateReconcilePolicyName(""))
+	require.NoError(t, ValidateReconcilePolicyName(ReconcilePolicyLog))
+	require.NoError(t, ValidateReconcilePolicyName(ReconcilePolicyNotifyCoordinator))
+	require.ErrorContains(t, ValidateReconcilePolicyName("reassign"), "unknown reconcile policy")
+}
+
+func TestNotifyCoordinatorPolicy_ReportsEachFindingOnce(t *testing.T) {
+	submitter := &recordingSubmitter{}
+	policy := NewNotifyCoordinatorPolicy(submitter)
+	ctx := context.Background()
+
+	stuck := ReconcileResult{
+		StuckWorkers: []adapter.StuckWorker{{WorkerID: "worker-1", TaskID: "perles-abc", Idle: 20 * time.Minute}},
+	}
+	policy(ctx, stuck)
+	require.Len(t, submitter.cmds, 1)
+	send, ok := submitter.cmds[0].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, repository.CoordinatorID, send.ProcessID)
+	require.Contains(t, send.Content, "worker-1 has not completed a turn for 20m0s while working on perles-abc")
+
+	// The same finding on the next pass is not reported again
+	policy(ctx, stuck)
+	require.Len(t, submitter.cmds, 1)
+
+	// A new finding is reported on its own
+	stuck.OrphanedTasks = []adapter.OrphanedTask{{TaskID: "perles-def", Status: "implementing", Reason: "implementer retired"}}
+	policy(ctx, stuck)
+	require.Len(t, submitter.cmds, 2)
+	send = submitter.cmds[1].(*command.SendToProcessCommand)
+	require.Contains(t, send.Content, "Task perles-def (implementing) is orphaned: implementer retired")
+	require.NotContains(t, send.Content, "worker-1")
+
+	// A finding that clears and comes back is reported again
+	policy(ctx, ReconcileResult{})
+	policy(ctx, stuck)
+	require.Len(t, submitter.cmds, 3)
+	require.Contains(t, submitter.cmds[2].(*command.SendToProcessCommand).Content, "worker-1")
+}
@@ -0,0 +1,159 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// seedTaskRepo stores task assignments with the given statuses.
+func seedTaskRepo(t *testing.T, statuses map[string]repository.TaskStatus) *repository.MemoryTaskRepository {
+	t.Helper()
+	taskRepo := repository.NewMemoryTaskRepository()
+	for id, status := range statuses {
+		require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+			TaskID:      id,
+			Implementer: "worker-1",
+			Status:      status,
+		}))
+	}
+	return taskRepo
+}
+
+func TestReconcileWithTracker_InSync(t *testing.T) {
+	taskRepo := seedTaskRepo(t, map[string]repository.TaskStatus{
+		"perles-abc.1": repository.TaskImplementing,
+		"perles-abc.2": repository.TaskInReview,
+	})
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(inProgressQuery).Return([]beads.Issue{
+		{ID: "perles-abc.1", Status: beads.StatusInProgress},
+		{ID: "perles-abc.2", Status: beads.StatusInProgress},
+	}, nil).Once()
+
+	report, err := NewReconciler(taskRepo, tracker).ReconcileWithTracker(context.Background())
+
+	require.NoError(t, err)
+	require.False(t, report.HasDivergence())
+	require.Empty(t, report.Corrected)
+}
+
+func TestReconcileWithTracker_FlagsUntrackedInProgressTasks(t *testing.T) {
+	taskRepo := seedTaskRepo(t, map[string]repository.TaskStatus{
+		"perles-abc.1": repository.TaskImplementing,
+	})
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(inProgressQuery).Return([]beads.Issue{
+		{ID: "perles-abc.1", Status: beads.StatusInProgress},
+		{ID: "perles-abc.9", Status: beads.StatusInProgress},
+	}, nil).Once()
+
+	report, err := NewReconciler(taskRepo, tracker).ReconcileWithTracker(context.Background())
+
+	require.NoError(t, err)
+	require.True(t, report.HasDivergence())
+	require.Equal(t, []TrackerDivergence{
+		{TaskID: "perles-abc.9", TrackerStatus: beads.StatusInProgress},
+	}, report.Untracked)
+	require.Empty(t, report.Stale)
+	require.Empty(t, report.Corrected, "report-only mode must not modify beads")
+}
+
+func TestReconcileWithTracker_FlagsStaleAssignments(t *testing.T) {
+	taskRepo := seedTaskRepo(t, map[string]repository.TaskStatus{
+		"perles-abc.1": repository.TaskInReview,
+		"perles-abc.2": repository.TaskCommitting,
+		"perles-abc.3": repository.TaskImplementing,
+		"perles-abc.4": repository.TaskCompleted, // finished assignments are ignored
+	})
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(inProgressQuery).Return([]beads.Issue{
+		{ID: "perles-abc.3", Status: beads.StatusInProgress},
+	}, nil).Once()
+	tracker.EXPECT().Execute(`id in ("perles-abc.1", "perles-abc.2")`).Return([]beads.Issue{
+		{ID: "perles-abc.1", Status: beads.StatusClosed},
+	}, nil).Once()
+
+	report, err := NewReconciler(taskRepo, tracker).ReconcileWithTracker(context.Background())
+
+	require.NoError(t, err)
+	require.Empty(t, report.Untracked)
+	require.Equal(t, []TrackerDivergence{
+		{TaskID: "perles-abc.1", TrackerStatus: beads.StatusClosed, AssignmentStatus: repository.TaskInReview},
+		{TaskID: "perles-abc.2", AssignmentStatus: repository.TaskCommitting},
+	}, report.Stale)
+}
+
+func TestReconcileWithTracker_AutoCorrectResetsUntrackedTasks(t *testing.T) {
+	taskRepo := seedTaskRepo(t, nil)
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(inProgressQuery).Return([]beads.Issue{
+		{ID: "perles-abc.1", Status: beads.StatusInProgress},
+		{ID: "perles-abc.2", Status: beads.StatusInProgress},
+	}, nil).Once()
+	writer := mocks.NewMockIssueWriter(t)
+	writer.EXPECT().UpdateStatus("perles-abc.1", beads.StatusOpen).Return(nil).Once()
+	writer.EXPECT().UpdateStatus("perles-abc.2", beads.StatusOpen).Return(errors.New("bd failed")).Once()
+
+	report, err := NewReconciler(taskRepo, tracker, WithAutoCorrect(writer)).ReconcileWithTracker(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, report.Untracked, 2)
+	require.Equal(t, []string{"perles-abc.1"}, report.Corrected, "failed resets are not reported as corrected")
+}
+
+func TestReconcileWithTracker_QueryError(t *testing.T) {
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(inProgressQuery).Return(nil, errors.New("database locked")).Once()
+
+	report, err := NewReconciler(seedTaskRepo(t, nil), tracker).ReconcileWithTracker(context.Background())
+
+	require.ErrorContains(t, err, "querying in_progress tasks")
+	require.Nil(t, report)
+}
+
+func TestNewReconciler_PanicsWithoutDependencies(t *testing.T) {
+	require.Panics(t, func() { NewReconciler(nil, mocks.NewMockBQLExecutor(t)) })
+	require.Panics(t, func() { NewReconciler(repository.NewMemoryTaskRepository(), nil) })
+}
+
+func TestReconcileWithTracker_ScopedToTaskIDPrefix(t *testing.T) {
+	taskRepo := seedTaskRepo(t, map[string]repository.TaskStatus{
+		"feat1-abc.1":  repository.TaskImplementing,
+		"feat2-xyz.1":  repository.TaskImplementing,
+		"feat1-abc.2":  repository.TaskImplementing,
+		"feat1-abc.3":  repository.TaskCompleted,
+		"feat10-def.1": repository.TaskImplementing,
+	})
+	tracker := mocks.NewMockBQLExecutor(t)
+	tracker.EXPECT().Execute(`status = in_progress and id ~ "feat1-"`).Return([]beads.Issue{
+		{ID: "feat1-abc.1", Status: beads.StatusInProgress},
+		{ID: "feat1-abc.9", Status: beads.StatusInProgress},
+		// The id filter is a substring match, so another workflow's task can slip through
+		{ID: "xfeat1-ghi.1", Status: beads.StatusInProgress},
+	}, nil).Once()
+	tracker.EXPECT().Execute(`id in ("feat1-abc.2")`).Return([]beads.Issue{
+		{ID: "feat1-abc.2", Status: beads.StatusOpen},
+	}, nil).Once()
+	writer := mocks.NewMockIssueWriter(t)
+	writer.EXPECT().UpdateStatus("feat1-abc.9", beads.StatusOpen).Return(nil).Once()
+
+	report, err := NewReconciler(taskRepo, tracker,
+		WithReconcileTaskIDPrefix("feat1"),
+		WithAutoCorrect(writer)).ReconcileWithTracker(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, []TrackerDivergence{{TaskID: "feat1-abc.9", TrackerStatus: beads.StatusInProgress}}, report.Untracked)
+	require.Equal(t, []TrackerDivergence{{
+		TaskID:           "feat1-abc.2",
+		TrackerStatus:    beads.StatusOpen,
+		AssignmentStatus: repository.TaskImplementing,
+	}}, report.Stale)
+	require.Equal(t, []string{"feat1-abc.9"}, report.Corrected)
+}
@@ -4,9 +4,12 @@
 package repository
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"time"
 
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
@@ -83,6 +86,8 @@ type Process struct {
 	SessionID string
 	// Metrics contains token usage and cost data.
 	Metrics *metrics.TokenMetrics
+	// Usage is the cumulative token usage across all completed turns.
+	Usage metrics.WorkerUsage
 	// CreatedAt is when this process was spawned.
 	CreatedAt time.Time
 	// LastActivityAt is when the process last completed a turn.
@@ -100,9 +105,37 @@ type Process struct {
 	TaskID string
 	// RetiredAt is when this process was retired (zero if still active).
 	RetiredAt time.Time
+	// Label is a human-readable name for the worker set with label_worker (e.g. "auth-impl").
+	// Cosmetic only: the worker is still addressed by ID. Empty means unlabeled.
+	Label string
 	// AgentType is the worker's specialization (generic, implementer, reviewer, researcher).
 	// Empty string represents generic (default). Only relevant for workers.
 	AgentType roles.AgentType
+	// Provider is the client type the process was spawned with. A worker may run on a
+	// different provider than the workflow default (rate-limit rotation), and every later
+	// turn must resume its session on the same one. Empty means the role's default client.
+	Provider client.ClientType
+	// RetirementReason is set when the worker asked to be retired via request_retirement.
+	// Non-empty means the worker is replaced once its current turn completes.
+	RetirementReason string
+	// AssignableAt is when a newly ready worker may first be given a task, set from the
+	// provider's ready grace period. Zero means the worker is assignable as soon as it is ready.
+	AssignableAt time.Time
+	// WorkerSlotReleased is set once the worker's slot in the shared worker pool has been
+	// returned, so retiring a worker that was already failed does not release it twice.
+	WorkerSlotReleased bool
+
+	// Coordinator-specific fields (empty for workers)
+
+	// GlobalInstructions are coordinator-wide instructions included in every task
+	// assignment made after they were set. Earlier assignments are not affected.
+	GlobalInstructions []string
+	// Focus is the workflow-wide focus area set with set_focus, included in every task
+	// assignment made while it is set. Empty means no focus.
+	Focus string
+	// HeldTasks maps task IDs put on hold with hold_task to the reason given (possibly empty).
+	// Held tasks are not assigned until released; they are neither failed nor blocked.
+	HeldTasks map[string]string
 }
 
 // IsCoordinator returns true if this is the coordinator process.
@@ -120,6 +153,21 @@ func (p *Process) IsObserver() bool {
 	return p.Role == RoleObserver
 }
 
+// ContextTokens returns the process's current context size in tokens,
+// as reported by the most recent turn's metrics. Returns 0 if no metrics
+// have been recorded yet.
+func (p *Process) ContextTokens() int {
+	if p.Metrics == nil {
+		return 0
+	}
+	return p.Metrics.TokensUsed
+}
+
+// InReadyGrace returns true if the process is still within its post-ready grace period at now.
+func (p *Process) InReadyGrace(now time.Time) bool {
+	return now.Before(p.AssignableAt)
+}
+
 // IsActive returns true if the process can receive messages.
 // Only Ready and Working processes are active.
 func (p *Process) IsActive() bool {
@@ -142,8 +190,60 @@ const (
 	TaskCommitting TaskStatus = "committing"
 	// TaskCompleted means the task is complete.
 	TaskCompleted TaskStatus = "completed"
+	// TaskFailed means the coordinator marked the task as failed or blocked.
+	TaskFailed TaskStatus = "failed"
 )
 
+// FailureCategory classifies why a task failed, enabling aggregation across tasks.
+type FailureCategory string
+
+const (
+	// FailureBlocked means the task cannot proceed due to an external dependency.
+	FailureBlocked FailureCategory = "blocked"
+	// FailureTestFailure means tests could not be made to pass.
+	FailureTestFailure FailureCategory = "test_failure"
+	// FailureTimeout means the task ran out of time or turns.
+	FailureTimeout FailureCategory = "timeout"
+	// FailureToolingError means a tool, build, or environment problem prevented progress.
+	FailureToolingError FailureCategory = "tooling_error"
+	// FailureUnclearRequirements means the task description was ambiguous or incomplete.
+	FailureUnclearRequirements FailureCategory = "unclear_requirements"
+	// FailureAbandoned means the task was intentionally dropped.
+	FailureAbandoned FailureCategory = "abandoned"
+)
+
+// FailureCategories lists all valid failure categories in display order.
+var FailureCategories = []FailureCategory{
+	FailureBlocked,
+	FailureTestFailure,
+	FailureTimeout,
+	FailureToolingError,
+	FailureUnclearRequirements,
+	FailureAbandoned,
+}
+
+// IsValid returns true if c is one of FailureCategories.
+func (c FailureCategory) IsValid() bool {
+	for _, valid := range FailureCategories {
+		if c == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// CountFailuresByCategory tallies failed tasks by category.
+// Failures recorded without a category are counted under the empty category.
+func CountFailuresByCategory(tasks []*TaskAssignment) map[FailureCategory]int {
+	counts := make(map[FailureCategory]int)
+	for _, task := range tasks {
+		if task.Status == TaskFailed {
+			counts[task.FailureCategory]++
+		}
+	}
+	return counts
+}
+
 // TaskAssignment represents a task assigned to workers for implementation and review.
 // This is the aggregate root for the Task bounded context.
 type TaskAssignment struct {
@@ -157,11 +257,208 @@ type TaskAssignment struct {
 	Status TaskStatus
 	// StartedAt is when implementation began.
 	StartedAt time.Time
+	// EstimateMinutes is the expected implementation effort (zero if no estimate was given).
+	// Stuck detection allows longer-running work for tasks with larger estimates.
+	EstimateMinutes int
 	// ReviewStartedAt is when review began (zero if not yet in review).
 	ReviewStartedAt time.Time
 	// ThreadID is the Fabric thread ID for this task's conversation.
 	// All task-related messages should reply to this thread.
 	ThreadID string
+	// FailureCategory classifies the failure when Status is TaskFailed (empty if uncategorized).
+	FailureCategory FailureCategory
+	// FailureReason is the free-text reason given when the task was marked failed.
+	FailureReason string
+	// CompletionSummary is the implementer's summary from its latest report_implementation_complete.
+	CompletionSummary string
+	// TestResults holds the most recent test run reported for this task (nil if none reported).
+	TestResults *TestResults
+	// DiffCheckpoint is the worktree diff captured at the last review verdict (nil before first review).
+	DiffCheckpoint *DiffCheckpoint
+	// ReviewRounds is the number of review verdicts reported for this task.
+	ReviewRounds int
+	// TransferredFrom is the previous implementer if the task was transferred (empty otherwise).
+	TransferredFrom string
+	// TransferNote is the handoff context given when the task was last transferred.
+	TransferNote string
+	// HandoffFiles lists the worktree paths changed when the previous implementer was
+	// replaced mid-task, passed on to its replacement (nil otherwise).
+	HandoffFiles []string
+	// Checkpoints lists the hashes of the [WIP] commits made by the implementer's
+	// checkpoint calls, oldest first.
+	Checkpoints []string
+	// BaseTree is the hash of the worktree, uncommitted changes included, recorded as a git
+	// tree when the task was assigned (empty if it could not be recorded). Changes since it
+	// exclude edits made in the shared worktree before the task started.
+	BaseTree string
+	// Instructions are the coordinator instructions given at assignment, kept in full
+	// so the worker can fetch them if they were truncated from the prompt.
+	Instructions string
+	// BlockedBy lists the bd issue IDs recorded as blocking this task via add_task_blocker.
+	BlockedBy []string
+	// FeedbackItems holds the discrete review feedback items from the latest
+	// assign_review_feedback, replaced each review round (nil when feedback was free text).
+	FeedbackItems []FeedbackItem
+	// PhaseHistory records when the task entered each timing phase, oldest first.
+	PhaseHistory []PhaseTransition
+}
+
+// EnterPhase records that the task entered phase at the given time.
+func (t *TaskAssignment) EnterPhase(phase TaskPhase, at time.Time) {
+	t.PhaseHistory = append(t.PhaseHistory, PhaseTransition{Phase: phase, At: at})
+}
+
+// RevertLastPhase drops the most recent phase transition, for handlers rolling back a
+// transition after a failed save.
+func (t *TaskAssignment) RevertLastPhase() {
+	if n := len(t.PhaseHistory); n > 0 {
+		t.PhaseHistory = t.PhaseHistory[:n-1]
+	}
+}
+
+// UnaddressedFeedback returns the feedback items the implementer has not yet marked addressed.
+func (t *TaskAssignment) UnaddressedFeedback() []FeedbackItem {
+	var unaddressed []FeedbackItem
+	for _, item := range t.FeedbackItems {
+		if !item.Addressed {
+			unaddressed = append(unaddressed, item)
+		}
+	}
+	return unaddressed
+}
+
+// FeedbackItem is a single review feedback item the implementer must address.
+type FeedbackItem struct {
+	// ID is the item's 1-based position in the feedback list.
+	ID int
+	// Text describes the required change.
+	Text string
+	// Addressed is set once the implementer marks the item addressed.
+	Addressed bool
+}
+
+// NewFeedbackItems numbers the given feedback texts as unaddressed items.
+func NewFeedbackItems(texts []string) []FeedbackItem {
+	if len(texts) == 0 {
+		return nil
+	}
+	items := make([]FeedbackItem, len(texts))
+	for i, text := range texts {
+		items[i] = FeedbackItem{ID: i + 1, Text: text}
+	}
+	return items
+}
+
+// DiffCheckpoint records the worktree diff at the time a review verdict was reported,
+// so later reviews can be limited to what changed since.
+type DiffCheckpoint struct {
+	// Hash is the SHA-256 of Diff, used to detect unchanged worktrees cheaply.
+	Hash string
+	// Diff is the full unified diff at checkpoint time.
+	Diff string
+	// Round is the 1-based review round that produced this checkpoint.
+	Round int
+	// CapturedAt is when the checkpoint was taken.
+	CapturedAt time.Time
+}
+
+// NewDiffCheckpoint creates a DiffCheckpoint for the given diff and review round.
+func NewDiffCheckpoint(diff string, round int) *DiffCheckpoint {
+	return &DiffCheckpoint{
+		Hash:       HashDiff(diff),
+		Diff:       diff,
+		Round:      round,
+		CapturedAt: time.Now(),
+	}
+}
+
+// HashDiff returns the hex-encoded SHA-256 of a diff.
+func HashDiff(diff string) string {
+	sum := sha256.Sum256([]byte(diff))
+	return hex.EncodeToString(sum[:])
+}
+
+// TaskPhase is a stage of a task's lifecycle used to measure where time is spent.
+type TaskPhase string
+
+const (
+	// TaskPhaseImplementing means an implementer is working on the task or addressing feedback.
+	TaskPhaseImplementing TaskPhase = "implementing"
+	// TaskPhaseAwaitingReview means implementation is done and no reviewer is assigned yet.
+	TaskPhaseAwaitingReview TaskPhase = "awaiting_review"
+	// TaskPhaseReviewing means a reviewer is assigned, up to the coordinator's next step.
+	TaskPhaseReviewing TaskPhase = "reviewing"
+	// TaskPhaseCommitting means the implementer is committing the approved change.
+	TaskPhaseCommitting TaskPhase = "committing"
+	// TaskPhaseFinished marks the end of the task's timeline; no time accrues after it.
+	TaskPhaseFinished TaskPhase = "finished"
+)
+
+// TimedTaskPhases lists the phases reported by task timings, in lifecycle order.
+var TimedTaskPhases = []TaskPhase{
+	TaskPhaseImplementing,
+	TaskPhaseAwaitingReview,
+	TaskPhaseReviewing,
+	TaskPhaseCommitting,
+}
+
+// PhaseTransition records when a task entered a phase.
+type PhaseTransition struct {
+	Phase TaskPhase
+	At    time.Time
+}
+
+// TaskTimings is the time a task spent in each phase.
+type TaskTimings struct {
+	// Phases maps each phase in TimedTaskPhases to its accumulated duration.
+	Phases map[TaskPhase]time.Duration
+	// Total is the sum of all phase durations.
+	Total time.Duration
+	// Finished is true if the history ends with TaskPhaseFinished.
+	Finished bool
+}
+
+// ComputeTaskTimings sums the time spent in each phase of history. Each transition's phase
+// lasts until the next transition; the last one lasts until now unless it is
+// TaskPhaseFinished. Phases re-entered after a denied review accumulate.
+func ComputeTaskTimings(history []PhaseTransition, now time.Time) TaskTimings {
+	timings := TaskTimings{Phases: make(map[TaskPhase]time.Duration, len(TimedTaskPhases))}
+	for _, phase := range TimedTaskPhases {
+		timings.Phases[phase] = 0
+	}
+
+	for i, tr := range history {
+		if tr.Phase == TaskPhaseFinished {
+			timings.Finished = i == len(history)-1
+			continue
+		}
+		end := now
+		if i+1 < len(history) {
+			end = history[i+1].At
+		}
+		if d := end.Sub(tr.At); d > 0 {
+			timings.Phases[tr.Phase] += d
+			timings.Total += d
+		}
+	}
+	return timings
+}
+
+// TestResults records the outcome of a test run reported by a worker.
+type TestResults struct {
+	// Passed is the number of passing tests.
+	Passed int
+	// Failed is the number of failing tests.
+	Failed int
+	// Output is optional raw or summarized test output.
+	Output string
+	// ReportedAt is when the results were reported.
+	ReportedAt time.Time
+}
+
+// HasFailures returns true if any tests failed.
+func (r *TestResults) HasFailures() bool {
+	return r != nil && r.Failed > 0
 }
 
 // SenderType identifies who sent a message.
@@ -174,6 +471,8 @@ const (
 	SenderCoordinator SenderType = "coordinator"
 	// SenderSystem indicates the message came from the orchestration system (e.g., enforcement reminders).
 	SenderSystem SenderType = "system"
+	// SenderKeepalive indicates a keepalive prompt for an idle worker; its turn is exempt from turn enforcement.
+	SenderKeepalive SenderType = "keepalive"
 )
 
 // QueueEntry represents a single message in a worker's message queue.
@@ -275,10 +574,11 @@ type TaskRepository interface {
 	Save(task *TaskAssignment) error
 
 	// GetByWorker retrieves the task currently assigned to a worker (as implementer or reviewer).
-	// Returns ErrTaskNotFound if no task is assigned to the worker.
+	// Completed tasks are skipped. Returns ErrTaskNotFound if no task is assigned to the worker.
 	GetByWorker(workerID string) (*TaskAssignment, error)
 
 	// GetByImplementer retrieves all tasks where the worker is the implementer.
+	// Completed tasks are skipped.
 	GetByImplementer(workerID string) ([]*TaskAssignment, error)
 
 	// All returns all task assignments in the repository.
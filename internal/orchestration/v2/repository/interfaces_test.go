@@ -46,6 +46,7 @@ func TestTaskAssignment_StatusValues(t *testing.T) {
 		{"Denied", TaskDenied, "denied"},
 		{"Committing", TaskCommitting, "committing"},
 		{"Completed", TaskCompleted, "completed"},
+		{"Failed", TaskFailed, "failed"},
 	}
 
 	for _, tt := range tests {
@@ -57,6 +58,101 @@ func TestTaskAssignment_StatusValues(t *testing.T) {
 	}
 }
 
+func TestFailureCategory_IsValid(t *testing.T) {
+	for _, category := range FailureCategories {
+		assert.True(t, category.IsValid(), "%s should be valid", category)
+	}
+	assert.False(t, FailureCategory("").IsValid())
+	assert.False(t, FailureCategory("flaky").IsValid())
+}
+
+func TestCountFailuresByCategory(t *testing.T) {
+	tasks := []*TaskAssignment{
+		{TaskID: "perles-abc.1", Status: TaskFailed, FailureCategory: FailureTestFailure},
+		{TaskID: "perles-abc.2", Status: TaskFailed, FailureCategory: FailureTestFailure},
+		{TaskID: "perles-abc.3", Status: TaskFailed, FailureCategory: FailureBlocked},
+		{TaskID: "perles-abc.4", Status: TaskFailed},
+		{TaskID: "perles-abc.5", Status: TaskImplementing},
+	}
+
+	counts := CountFailuresByCategory(tasks)
+
+	assert.Equal(t, map[FailureCategory]int{
+		FailureTestFailure: 2,
+		FailureBlocked:     1,
+		"":                 1,
+	}, counts)
+}
+
+func TestComputeTaskTimings_SumsPhases(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	task := &TaskAssignment{TaskID: "perles-abc.1"}
+	task.EnterPhase(TaskPhaseImplementing, start)
+	task.EnterPhase(TaskPhaseAwaitingReview, start.Add(30*time.Minute))
+	task.EnterPhase(TaskPhaseReviewing, start.Add(35*time.Minute))
+	// Denied: back to implementing, then a second review round
+	task.EnterPhase(TaskPhaseImplementing, start.Add(50*time.Minute))
+	task.EnterPhase(TaskPhaseAwaitingReview, start.Add(60*time.Minute))
+	task.EnterPhase(TaskPhaseReviewing, start.Add(62*time.Minute))
+	task.EnterPhase(TaskPhaseCommitting, start.Add(70*time.Minute))
+	task.EnterPhase(TaskPhaseFinished, start.Add(73*time.Minute))
+
+	// now is well past the end; nothing accrues after TaskPhaseFinished
+	timings := ComputeTaskTimings(task.PhaseHistory, start.Add(5*time.Hour))
+
+	assert.True(t, timings.Finished)
+	assert.Equal(t, map[TaskPhase]time.Duration{
+		TaskPhaseImplementing:   40 * time.Minute,
+		TaskPhaseAwaitingReview: 7 * time.Minute,
+		TaskPhaseReviewing:      23 * time.Minute,
+		TaskPhaseCommitting:     3 * time.Minute,
+	}, timings.Phases)
+	assert.Equal(t, 73*time.Minute, timings.Total)
+
+	var sum time.Duration
+	for _, d := range timings.Phases {
+		sum += d
+	}
+	assert.Equal(t, timings.Total, sum)
+}
+
+func TestComputeTaskTimings_CurrentPhaseCountsUntilNow(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	history := []PhaseTransition{
+		{Phase: TaskPhaseImplementing, At: start},
+		{Phase: TaskPhaseAwaitingReview, At: start.Add(10 * time.Minute)},
+	}
+
+	timings := ComputeTaskTimings(history, start.Add(25*time.Minute))
+
+	assert.False(t, timings.Finished)
+	assert.Equal(t, 10*time.Minute, timings.Phases[TaskPhaseImplementing])
+	assert.Equal(t, 15*time.Minute, timings.Phases[TaskPhaseAwaitingReview])
+	assert.Zero(t, timings.Phases[TaskPhaseReviewing])
+	assert.Zero(t, timings.Phases[TaskPhaseCommitting])
+	assert.Equal(t, 25*time.Minute, timings.Total)
+}
+
+func TestComputeTaskTimings_EmptyHistory(t *testing.T) {
+	timings := ComputeTaskTimings(nil, time.Now())
+
+	assert.Len(t, timings.Phases, len(TimedTaskPhases))
+	assert.Zero(t, timings.Total)
+	assert.False(t, timings.Finished)
+}
+
+func TestTaskAssignment_RevertLastPhase(t *testing.T) {
+	task := &TaskAssignment{}
+	task.RevertLastPhase() // no-op on empty history
+
+	now := time.Now()
+	task.EnterPhase(TaskPhaseImplementing, now)
+	task.EnterPhase(TaskPhaseCommitting, now.Add(time.Minute))
+	task.RevertLastPhase()
+
+	require.Equal(t, []PhaseTransition{{Phase: TaskPhaseImplementing, At: now}}, task.PhaseHistory)
+}
+
 // ===========================================================================
 // QueueEntry Tests
 // ===========================================================================
@@ -52,12 +52,15 @@ func (r *MemoryTaskRepository) Save(task *TaskAssignment) error {
 }
 
 // GetByWorker retrieves the task currently assigned to a worker (as implementer or reviewer).
-// Returns ErrTaskNotFound if no task is assigned to the worker.
+// Completed tasks are skipped. Returns ErrTaskNotFound if no task is assigned to the worker.
 func (r *MemoryTaskRepository) GetByWorker(workerID string) (*TaskAssignment, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	for _, task := range r.tasks {
+		if task.Status == TaskCompleted {
+			continue
+		}
 		if task.Implementer == workerID || task.Reviewer == workerID {
 			return task, nil
 		}
@@ -66,12 +69,16 @@ func (r *MemoryTaskRepository) GetByWorker(workerID string) (*TaskAssignment, er
 }
 
 // GetByImplementer retrieves all tasks where the worker is the implementer.
+// Completed tasks are skipped.
 func (r *MemoryTaskRepository) GetByImplementer(workerID string) ([]*TaskAssignment, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	result := make([]*TaskAssignment, 0)
 	for _, task := range r.tasks {
+		if task.Status == TaskCompleted {
+			continue
+		}
 		if task.Implementer == workerID {
 			result = append(result, task)
 		}
@@ -304,6 +304,38 @@ func TestTurnEnforcement_FailedTurnExempt(t *testing.T) {
 	assert.Equal(t, 0, depth, "Failed turn should be exempt from enforcement")
 }
 
+// TestTurnEnforcement_KeepaliveTurnExempt verifies that a turn started by a keepalive
+// prompt is exempt from enforcement, so idle workers need not post to a channel.
+func TestTurnEnforcement_KeepaliveTurnExempt(t *testing.T) {
+	stack := newEnforcementTestStack(t)
+	defer stack.shutdown(t)
+
+	workerID := "WORKER.9"
+	stack.createWorker(workerID, repository.StatusReady)
+
+	// Deliver a keepalive prompt (starts the turn and marks it as a keepalive)
+	stack.processor.Submit(command.NewSendKeepaliveCommand(command.SourceInternal, workerID, "[KEEPALIVE]"))
+	require.Eventually(t, func() bool {
+		return stack.enforcer.IsKeepalive(workerID)
+	}, time.Second, 10*time.Millisecond, "keepalive turn should be marked")
+
+	// Turn completes without calling required tools
+	stack.updateStatus(workerID, repository.StatusWorking)
+	stack.processor.Submit(command.NewProcessTurnCompleteCommand(workerID, true, nil, nil))
+	time.Sleep(50 * time.Millisecond)
+
+	proc, err := stack.processRepo.Get(workerID)
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusReady, proc.Status, "No enforcement reminder should be delivered")
+	assert.Equal(t, 0, stack.getQueueSize(workerID))
+
+	// The next regular turn is enforced again
+	stack.processor.Submit(command.NewSendToProcessCommand(command.SourceUser, workerID, "task"))
+	require.Eventually(t, func() bool {
+		return !stack.enforcer.IsKeepalive(workerID)
+	}, time.Second, 10*time.Millisecond, "regular turn should clear the keepalive flag")
+}
+
 // TestTurnEnforcement_MaxRetriesLimit verifies that after 2 enforcement retries,
 // the turn completes without further reminders.
 func TestTurnEnforcement_MaxRetriesLimit(t *testing.T) {
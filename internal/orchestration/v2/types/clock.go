@@ -0,0 +1,50 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock provides the current time for coordinator timing: task assignment and phase
+// timestamps, worker activity, ready grace periods, and stuck detection.
+// Injecting it lets tests and replays control time instead of waiting on it.
+// Components default to RealClock, and their clock options ignore a nil Clock.
+type Clock interface {
+	Now() time.Time
+}
+
+// RealClock implements Clock using the standard time package.
+type RealClock struct{}
+
+// Now returns the current wall-clock time, which carries a monotonic reading.
+func (RealClock) Now() time.Time { return time.Now() }
+
+// FakeClock is a Clock that only moves when told to, for deterministic tests.
+// It never goes backwards, so durations computed from it are never negative.
+// Safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a FakeClock reading start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the fake clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d. Negative durations are ignored.
+func (c *FakeClock) Advance(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
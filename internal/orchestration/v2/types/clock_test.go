@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClock_AdvancesOnlyForward(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	require.Equal(t, start, clock.Now())
+
+	clock.Advance(time.Minute)
+	require.Equal(t, start.Add(time.Minute), clock.Now())
+
+	clock.Advance(-time.Hour)
+	require.Equal(t, start.Add(time.Minute), clock.Now(), "negative durations are ignored")
+}
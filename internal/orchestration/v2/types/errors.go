@@ -31,6 +31,9 @@ var ErrNotSpawning = errors.New("process is not in spawning state")
 // ErrAlreadyRetired is returned when trying to retire an already retired process.
 var ErrAlreadyRetired = errors.New("process is already retired")
 
+// ErrProcessNotWorker is returned when a worker-only operation targets a coordinator or observer.
+var ErrProcessNotWorker = errors.New("process is not a worker")
+
 // ===========================================================================
 // Queue Errors
 // ===========================================================================
@@ -51,9 +54,22 @@ var ErrQueueEmpty = errors.New("message queue is empty")
 // ErrTaskNotApproved is returned when trying to commit a task that hasn't been approved.
 var ErrTaskNotApproved = errors.New("task has not been approved")
 
+// ErrTaskNotReadyToComplete is returned when marking a task complete before it reaches
+// a completable status (committing, by default).
+var ErrTaskNotReadyToComplete = errors.New("task is not ready to complete")
+
+// ErrTestsFailing is returned when approving a commit whose reported test results include failures.
+var ErrTestsFailing = errors.New("task has failing tests")
+
 // ErrNoTaskAssigned is returned when trying to transition a process with no assigned task.
 var ErrNoTaskAssigned = errors.New("process has no task assigned")
 
+// ErrTaskHeld is returned when assigning a task that the coordinator put on hold with hold_task.
+var ErrTaskHeld = errors.New("task is on hold")
+
+// ErrDependencyCycle is returned when adding a blocker would make a task transitively block itself.
+var ErrDependencyCycle = errors.New("dependency would create a cycle")
+
 // ===========================================================================
 // Process State Errors
 // ===========================================================================
@@ -64,6 +80,17 @@ var ErrProcessNotReady = errors.New("process is not ready")
 // ErrProcessNotIdle is returned when a process is not in idle phase.
 var ErrProcessNotIdle = errors.New("process is not in idle phase")
 
+// ErrProcessInReadyGrace is returned when a worker is ready but still within its
+// post-ready grace period and cannot be assigned yet.
+var ErrProcessInReadyGrace = errors.New("process is still initializing after becoming ready")
+
+// ErrNoReadyWorker is returned when a worker must be selected but none is ready and idle.
+var ErrNoReadyWorker = errors.New("no ready worker available")
+
+// ErrReviewCapacityReached is returned when assigning a review would exceed the
+// configured maximum of concurrent reviews. Retry once a review completes.
+var ErrReviewCapacityReached = errors.New("maximum concurrent reviews reached, retry after a review completes")
+
 // ErrProcessAlreadyAssigned is returned when a process already has a task assigned.
 var ErrProcessAlreadyAssigned = errors.New("process already has a task assigned")
 
@@ -79,6 +106,18 @@ var ErrProcessNotAwaitingReview = errors.New("process is not awaiting review")
 // ErrProcessNotImplementer is returned when a process is not the implementer of the task.
 var ErrProcessNotImplementer = errors.New("process is not the implementer of the task")
 
+// ErrWorkerRoleMismatch is returned when assigning a task to a worker whose agent type
+// does not implement tasks, such as a worker spawned as a reviewer.
+var ErrWorkerRoleMismatch = errors.New("worker's agent type does not implement tasks")
+
+// ErrWorkerProviderMismatch is returned when assigning a task to a worker running on a
+// provider the workflow's worker_providers policy does not use for implementation.
+var ErrWorkerProviderMismatch = errors.New("worker's provider is not used for implementation")
+
+// ErrWorkerNotInPool is returned when imported state references workers that are not
+// active in the current pool.
+var ErrWorkerNotInPool = errors.New("worker not in pool")
+
 // ===========================================================================
 // Validation Errors
 // ===========================================================================
@@ -1,15 +1,37 @@
 // Package validation provides shared validation functions for the orchestration layer.
 package validation
 
-import "regexp"
+import (
+	"regexp"
+	"strings"
+)
 
 // taskIDPattern validates bd task IDs to prevent command injection.
 // Valid formats: "prefix-xxxx" or "prefix-xxxx.N" (for subtasks)
 // Examples: "perles-abc1", "perles-abc1.2", "ms-e52", "pe-perles-xyz9.10"
 var taskIDPattern = regexp.MustCompile(`^[a-z0-9]{2,}(-[a-z0-9]{2,})+(\.[0-9]+)*$`)
 
+// taskIDPrefixPattern validates bd ID prefixes.
+// Examples: "perles", "feat1", "pe-perles"
+var taskIDPrefixPattern = regexp.MustCompile(`^[a-z0-9]{2,}(-[a-z0-9]{2,})*$`)
+
 // IsValidTaskID validates that a task ID matches the expected format.
 // Valid formats: "prefix-xxxx" or "prefix-xxxx.N" (for subtasks)
 func IsValidTaskID(taskID string) bool {
 	return taskIDPattern.MatchString(taskID)
 }
+
+// IsValidTaskIDPrefix validates that a bd ID prefix can be used to namespace task IDs.
+func IsValidTaskIDPrefix(prefix string) bool {
+	return taskIDPrefixPattern.MatchString(prefix)
+}
+
+// IsValidTaskIDWithPrefix validates that a task ID matches the expected format and
+// belongs to the given prefix's namespace ("feat1-abc" but not "feat10-abc" for "feat1").
+// An empty prefix accepts any valid task ID.
+func IsValidTaskIDWithPrefix(taskID, prefix string) bool {
+	if !IsValidTaskID(taskID) {
+		return false
+	}
+	return prefix == "" || strings.HasPrefix(taskID, prefix+"-")
+}
@@ -29,6 +29,12 @@
 //
 // Example: workflow::planning-standard::v1::research
 //
+// # Template Includes
+//
+// Templates can share instructions through partials: {{include "shared.md"}} is replaced
+// with the partial's content before the template is rendered. Partials may include further
+// partials; include cycles and missing partials are reported when the registry is loaded.
+//
 // # YAML Configuration
 //
 // Registrations are loaded from template.yaml embedded in the templates filesystem.
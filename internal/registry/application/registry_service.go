@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"os/exec"
+	"slices"
 	"strings"
 	"text/template"
 
@@ -43,20 +45,59 @@ type RegistryService struct {
 	templateFS fs.FS            // Primary FS (embedded templates from internal/templates)
 	userFS     fs.FS            // User FS (may be nil if no user workflows)
 	regToFS    map[regKey]fs.FS // Per-registration FS tracking for template resolution
+	overrides  []Override       // Registrations that shadowed an earlier one, in load order
+}
+
+// Override records a registration that replaced an earlier registration
+// with the same namespace and key during loading.
+type Override struct {
+	Namespace       string
+	Key             string
+	Version         string          // Version of the winning registration
+	Source          registry.Source // Source of the winning registration
+	Dir             string          // Directory the winning registration was loaded from (empty for embedded)
+	ShadowedVersion string          // Version of the replaced registration
+	ShadowedSource  registry.Source // Source of the replaced registration
+}
+
+// RegistryServiceOption configures optional RegistryService behavior.
+type RegistryServiceOption func(*registryServiceOptions)
+
+type registryServiceOptions struct {
+	overlayDir string
+}
+
+// WithOverlayDir merges workflows from an additional templates directory over the
+// built-in and community workflows, so teams can customize without forking.
+// dir uses the same layout as ~/.perles (a "workflows" subdirectory of template.yaml files).
+// Workflows in the user directory still take precedence over the overlay.
+func WithOverlayDir(dir string) RegistryServiceOption {
+	return func(o *registryServiceOptions) {
+		o.overlayDir = dir
+	}
 }
 
 // NewRegistryService creates a registry service loading built-in, community,
-// and user-defined workflows. Each phase can shadow the previous:
-// built-in -> community -> user (user wins).
+// overlay, and user-defined workflows. Each phase can shadow the previous:
+// built-in -> community -> overlay -> user (user wins).
 //
 // Parameters:
 //   - embeddedFS: The embedded filesystem containing built-in workflows
 //   - communitySource: Community workflow source (nil = no community workflows loaded)
 //   - userBaseDir: The base directory for user workflows (e.g., ~/.perles).
 //     If empty or the directory doesn't exist, only built-in workflows are loaded.
-func NewRegistryService(embeddedFS fs.FS, communitySource *CommunitySource, userBaseDir string) (*RegistryService, error) {
-	reg := registry.NewRegistry()
-	regToFS := make(map[regKey]fs.FS)
+//   - opts: Optional configuration (e.g., WithOverlayDir)
+func NewRegistryService(embeddedFS fs.FS, communitySource *CommunitySource, userBaseDir string, opts ...RegistryServiceOption) (*RegistryService, error) {
+	var options registryServiceOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	svc := &RegistryService{
+		registry:   registry.NewRegistry(),
+		templateFS: embeddedFS,
+		regToFS:    make(map[regKey]fs.FS),
+	}
 
 	// Phase 1: Load built-in workflows with SourceBuiltIn
 	builtins, err := LoadRegistryFromYAMLWithSource(embeddedFS, registry.SourceBuiltIn)
@@ -64,8 +105,8 @@ func NewRegistryService(embeddedFS fs.FS, communitySource *CommunitySource, user
 		return nil, fmt.Errorf("load built-in registrations: %w", err)
 	}
 	for _, r := range builtins {
-		_ = reg.Add(r)
-		regToFS[regKey{namespace: r.Namespace(), key: r.Key()}] = embeddedFS
+		_ = svc.registry.Add(r)
+		svc.regToFS[regKey{namespace: r.Namespace(), key: r.Key()}] = embeddedFS
 	}
 
 	// Phase 1.5: Load community workflows (if source provided)
@@ -74,21 +115,27 @@ func NewRegistryService(embeddedFS fs.FS, communitySource *CommunitySource, user
 		return nil, fmt.Errorf("load community registrations: %w", err)
 	}
 	for _, r := range communityRegs {
-		replaced := reg.AddOrReplace(r)
-		if replaced != nil {
+		if svc.addOrReplace(r, communityFS, "") {
 			log.Warn(log.CatConfig, "community workflow shadowing built-in",
 				"namespace", r.Namespace(), "key", r.Key())
 		}
-		regToFS[regKey{namespace: r.Namespace(), key: r.Key()}] = communityFS
 	}
 
-	svc := &RegistryService{
-		registry:   reg,
-		templateFS: embeddedFS,
-		regToFS:    regToFS,
+	// Phase 2: Load overlay workflows (if configured and directory exists)
+	if options.overlayDir != "" {
+		overlayRegs, overlayFS, err := LoadUserRegistryFromDir(options.overlayDir)
+		if err != nil {
+			return nil, fmt.Errorf("load overlay registrations: %w", err)
+		}
+		for _, r := range overlayRegs {
+			if svc.addOrReplace(r, overlayFS, options.overlayDir) {
+				log.Info(log.CatConfig, "overlay workflow shadowing existing",
+					"namespace", r.Namespace(), "key", r.Key(), "dir", options.overlayDir)
+			}
+		}
 	}
 
-	// Phase 2: Load user workflows (if directory exists)
+	// Phase 3: Load user workflows (if directory exists)
 	if userBaseDir != "" {
 		userRegs, userFS, err := LoadUserRegistryFromDir(userBaseDir)
 		if err != nil {
@@ -97,12 +144,10 @@ func NewRegistryService(embeddedFS fs.FS, communitySource *CommunitySource, user
 		if userFS != nil {
 			svc.userFS = userFS
 			for _, r := range userRegs {
-				replaced := reg.AddOrReplace(r)
-				if replaced != nil {
+				if svc.addOrReplace(r, userFS, userBaseDir) {
 					log.Info(log.CatConfig, "user workflow shadowing existing",
 						"namespace", r.Namespace(), "key", r.Key())
 				}
-				regToFS[regKey{namespace: r.Namespace(), key: r.Key()}] = userFS
 			}
 		}
 	}
@@ -110,6 +155,32 @@ func NewRegistryService(embeddedFS fs.FS, communitySource *CommunitySource, user
 	return svc, nil
 }
 
+// addOrReplace adds a registration loaded from regFS, replacing any existing registration
+// with the same namespace and key. Returns true and records an Override if one was replaced.
+func (s *RegistryService) addOrReplace(r *registry.Registration, regFS fs.FS, dir string) bool {
+	replaced := s.registry.AddOrReplace(r)
+	s.regToFS[regKey{namespace: r.Namespace(), key: r.Key()}] = regFS
+	if replaced == nil {
+		return false
+	}
+	s.overrides = append(s.overrides, Override{
+		Namespace:       r.Namespace(),
+		Key:             r.Key(),
+		Version:         r.Version(),
+		Source:          r.Source(),
+		Dir:             dir,
+		ShadowedVersion: replaced.Version(),
+		ShadowedSource:  replaced.Source(),
+	})
+	return true
+}
+
+// Overrides returns the registrations that shadowed an earlier registration
+// during loading, in load order. A key overridden twice appears twice.
+func (s *RegistryService) Overrides() []Override {
+	return slices.Clone(s.overrides)
+}
+
 // getRegistrationFS returns the filesystem to use for a given registration.
 // User workflows use their source FS, built-in workflows use the embedded FS.
 func (s *RegistryService) getRegistrationFS(reg *registry.Registration) fs.FS {
@@ -143,6 +214,23 @@ func (s *RegistryService) GetByLabels(labels ...string) []*registry.Registration
 	return s.registry.GetByLabels(labels...)
 }
 
+// CheckRequirements returns the executables required by the workflow template with the
+// given key that cannot be found on PATH, in the order the template lists them.
+// Returns nil if all are present, the template declares none, or the key is unknown.
+func (s *RegistryService) CheckRequirements(key string) []string {
+	reg, err := s.registry.GetByKey("workflow", key)
+	if err != nil {
+		return nil
+	}
+	var missing []string
+	for _, tool := range reg.Requires() {
+		if _, err := exec.LookPath(tool); err != nil {
+			missing = append(missing, tool)
+		}
+	}
+	return missing
+}
+
 // findRegistrationAndNode parses an identifier and returns both the matching registration and node.
 // This is used when template resolution needs the registration to determine the correct FS.
 func (s *RegistryService) findRegistrationAndNode(identifier string) (*registry.Registration, *registry.Node, error) {
@@ -189,7 +277,7 @@ func (s *RegistryService) GetTemplate(identifier string) (string, error) {
 		return "", fmt.Errorf("read template %s: %w", node.Template(), ErrTemplateNotFound)
 	}
 
-	return string(content), nil
+	return expandIncludes(regFS, node.Template(), string(content))
 }
 
 // RenderTemplate renders a template with the given context.
@@ -211,6 +299,10 @@ func (s *RegistryService) RenderTemplate(identifier string, ctx TemplateContext)
 	if err != nil {
 		return "", fmt.Errorf("read template %s: %w", node.Template(), ErrTemplateNotFound)
 	}
+	expanded, err := expandIncludes(regFS, node.Template(), string(content))
+	if err != nil {
+		return "", err
+	}
 
 	// Build artifact paths from node inputs/outputs
 	// Note: ctx.Inputs/Outputs are populated after this, so template rendering
@@ -220,7 +312,7 @@ func (s *RegistryService) RenderTemplate(identifier string, ctx TemplateContext)
 	ctx.Outputs = buildArtifactPaths(node.Outputs(), artifactPath, ctx)
 
 	// Execute template
-	tmpl, err := template.New("").Parse(string(content))
+	tmpl, err := template.New("").Parse(expanded)
 	if err != nil {
 		return "", fmt.Errorf("parse template: %w", err)
 	}
@@ -258,9 +350,13 @@ func (s *RegistryService) RenderEpicTemplate(reg *registry.Registration, ctx Tem
 	if err != nil {
 		return "", fmt.Errorf("read epic template %s: %w", templateFile, ErrTemplateNotFound)
 	}
+	expanded, err := expandIncludes(regFS, templateFile, string(content))
+	if err != nil {
+		return "", err
+	}
 
 	// Execute template
-	tmpl, err := template.New("").Parse(string(content))
+	tmpl, err := template.New("").Parse(expanded)
 	if err != nil {
 		return "", fmt.Errorf("parse epic template: %w", err)
 	}
@@ -334,5 +430,5 @@ func (s *RegistryService) GetSystemPromptTemplate(reg *registry.Registration) (s
 		return "", fmt.Errorf("read system_prompt template %q: %w", reg.SystemPrompt(), err)
 	}
 
-	return string(content), nil
+	return expandIncludes(regFS, reg.SystemPrompt(), string(content))
 }
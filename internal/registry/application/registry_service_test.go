@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/fs"
 	"os"
+	"path/filepath"
 	"testing"
 	"testing/fstest"
 	"text/template"
@@ -1181,6 +1182,105 @@ func TestRegistryService_ShadowingBehavior(t *testing.T) {
 	require.Equal(t, registry.SourceUser, reg.Source())
 }
 
+// writeShadowWorkflow writes a workflow that shadows "shadow-target" under baseDir/workflows.
+func writeShadowWorkflow(t *testing.T, baseDir, name, content string) {
+	t.Helper()
+	dir := filepath.Join(baseDir, "workflows", "shadow-target")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "template.yaml"), []byte(`registry:
+  - namespace: "workflow"
+    key: "shadow-target"
+    version: "v2"
+    name: "`+name+`"
+    description: "Workflow that shadows built-in"
+    nodes:
+      - key: "step1"
+        name: "Step"
+        template: "step.md"
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "step.md"), []byte(content), 0644))
+}
+
+func shadowTargetBuiltinFS() fstest.MapFS {
+	return fstest.MapFS{
+		"workflows/shadow-target/template.yaml": &fstest.MapFile{
+			Data: []byte(`registry:
+  - namespace: "workflow"
+    key: "shadow-target"
+    version: "v1"
+    name: "Original Built-in"
+    description: "Original workflow to be shadowed"
+    nodes:
+      - key: "step1"
+        name: "Original Step"
+        template: "original-step.md"
+`),
+		},
+		"workflows/shadow-target/original-step.md": &fstest.MapFile{Data: []byte("# Original Content")},
+	}
+}
+
+func TestRegistryService_OverlayDirOverridesBuiltin(t *testing.T) {
+	overlayDir := t.TempDir()
+	writeShadowWorkflow(t, overlayDir, "Team Overlay", "# Overlay Content")
+
+	svc, err := NewRegistryService(shadowTargetBuiltinFS(), nil, "", WithOverlayDir(overlayDir))
+	require.NoError(t, err)
+
+	require.Len(t, svc.List(), 1)
+	reg, err := svc.GetByKey("workflow", "shadow-target")
+	require.NoError(t, err)
+	require.Equal(t, "Team Overlay", reg.Name())
+
+	content, err := svc.GetTemplate("workflow::shadow-target::v2::step1")
+	require.NoError(t, err)
+	require.Contains(t, content, "Overlay Content")
+
+	require.Equal(t, []Override{{
+		Namespace:       "workflow",
+		Key:             "shadow-target",
+		Version:         "v2",
+		Source:          registry.SourceUser,
+		Dir:             overlayDir,
+		ShadowedVersion: "v1",
+		ShadowedSource:  registry.SourceBuiltIn,
+	}}, svc.Overrides())
+}
+
+func TestRegistryService_UserDirOverridesOverlay(t *testing.T) {
+	overlayDir := t.TempDir()
+	userDir := t.TempDir()
+	writeShadowWorkflow(t, overlayDir, "Team Overlay", "# Overlay Content")
+	writeShadowWorkflow(t, userDir, "User Shadowed", "# User Content")
+
+	svc, err := NewRegistryService(shadowTargetBuiltinFS(), nil, userDir, WithOverlayDir(overlayDir))
+	require.NoError(t, err)
+
+	reg, err := svc.GetByKey("workflow", "shadow-target")
+	require.NoError(t, err)
+	require.Equal(t, "User Shadowed", reg.Name())
+
+	content, err := svc.GetTemplate("workflow::shadow-target::v2::step1")
+	require.NoError(t, err)
+	require.Contains(t, content, "User Content")
+
+	overrides := svc.Overrides()
+	require.Len(t, overrides, 2)
+	require.Equal(t, overlayDir, overrides[0].Dir)
+	require.Equal(t, userDir, overrides[1].Dir)
+}
+
+func TestRegistryService_OverlayDirMissing(t *testing.T) {
+	svc, err := NewRegistryService(shadowTargetBuiltinFS(), nil, "",
+		WithOverlayDir(filepath.Join(t.TempDir(), "does-not-exist")))
+	require.NoError(t, err)
+
+	reg, err := svc.GetByKey("workflow", "shadow-target")
+	require.NoError(t, err)
+	require.Equal(t, "Original Built-in", reg.Name())
+	require.Empty(t, svc.Overrides())
+}
+
 func TestRegistryService_TemplateResolution(t *testing.T) {
 	// Create a built-in FS with a workflow
 	builtinFS := fstest.MapFS{
@@ -1845,3 +1945,48 @@ func TestCommunityRegistryBuiltinCollision(t *testing.T) {
 			"community workflow %q collides with built-in workflow; community workflows must use unique keys", id)
 	}
 }
+
+func TestRegistryService_CheckRequirements(t *testing.T) {
+	binDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "present-tool"), []byte("#!/bin/sh\n"), 0o755))
+	t.Setenv("PATH", binDir)
+
+	fsys := fstest.MapFS{
+		"workflows/needs-tools/template.yaml": &fstest.MapFile{
+			Data: []byte(`
+registry:
+  - namespace: "workflow"
+    key: "needs-tools"
+    version: "v1"
+    name: "Needs Tools"
+    description: "Requires one present and one absent binary"
+    requires: [present-tool, absent-tool]
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+  - namespace: "workflow"
+    key: "no-requirements"
+    version: "v1"
+    name: "No Requirements"
+    description: "Declares no required binaries"
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+`),
+		},
+		"workflows/needs-tools/step1.md": &fstest.MapFile{Data: []byte("# Step 1")},
+	}
+
+	svc, err := NewRegistryService(fsys, nil, "")
+	require.NoError(t, err)
+
+	reg, err := svc.GetByKey("workflow", "needs-tools")
+	require.NoError(t, err)
+	require.Equal(t, []string{"present-tool", "absent-tool"}, reg.Requires())
+
+	require.Equal(t, []string{"absent-tool"}, svc.CheckRequirements("needs-tools"))
+	require.Empty(t, svc.CheckRequirements("no-requirements"))
+	require.Empty(t, svc.CheckRequirements("unknown"))
+}
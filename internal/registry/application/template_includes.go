@@ -0,0 +1,76 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	stdpath "path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrIncludeCycle is returned when template includes form a cycle.
+var ErrIncludeCycle = errors.New("template include cycle")
+
+// includeDirective matches {{include "partial.md"}}, with optional spaces inside the braces.
+var includeDirective = regexp.MustCompile(`\{\{\s*include\s+"([^"]+)"\s*\}\}`)
+
+// expandIncludes replaces each {{include "partial"}} directive in content, the template at
+// name in fsys, with the partial's content, so shared instructions can live in one file.
+// Partials may include further partials. A partial named without a "/" is looked up next
+// to the including template first, then in workflows/, like node templates.
+//
+// Includes are expanded before the template is parsed, so a partial sees the same
+// template context as the template including it.
+func expandIncludes(fsys fs.FS, name, content string) (string, error) {
+	return expandIncludesFrom(fsys, name, content, []string{name})
+}
+
+// expandIncludesFrom expands the includes of the template at name. stack holds the chain
+// of templates being expanded, ending with name, to detect cycles.
+func expandIncludesFrom(fsys fs.FS, name, content string, stack []string) (string, error) {
+	var expandErr error
+	expanded := includeDirective.ReplaceAllStringFunc(content, func(directive string) string {
+		if expandErr != nil {
+			return directive
+		}
+		partial := includeDirective.FindStringSubmatch(directive)[1]
+		if err := validateTemplatePath(partial); err != nil {
+			expandErr = fmt.Errorf("include in %s: %w", name, err)
+			return directive
+		}
+
+		path := resolveTemplatePath(partial, stdpath.Dir(name), fsys)
+		if i := slices.Index(stack, path); i >= 0 {
+			chain := append(slices.Clone(stack[i:]), path)
+			expandErr = fmt.Errorf("%w: %s", ErrIncludeCycle, strings.Join(chain, " -> "))
+			return directive
+		}
+
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			expandErr = fmt.Errorf("include %q in %s: %w", partial, name, err)
+			return directive
+		}
+		result, err := expandIncludesFrom(fsys, path, string(data), append(slices.Clone(stack), path))
+		if err != nil {
+			expandErr = err
+			return directive
+		}
+		return result
+	})
+	if expandErr != nil {
+		return "", expandErr
+	}
+	return expanded, nil
+}
+
+// readTemplate reads the template at name from fsys with its includes expanded.
+func readTemplate(fsys fs.FS, name string) (string, error) {
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return "", err
+	}
+	return expandIncludes(fsys, name, string(content))
+}
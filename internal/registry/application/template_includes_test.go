@@ -0,0 +1,87 @@
+package registry
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+const includeWorkflowYAML = `
+registry:
+  - namespace: "workflow"
+    key: "with-includes"
+    version: "v1"
+    name: "With Includes"
+    description: "Node templates sharing partials"
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+        assignee: "worker-1"
+`
+
+func TestRenderTemplate_ExpandsIncludes(t *testing.T) {
+	fsys := createWorkflowFS(includeWorkflowYAML, "v1-epic-instructions.md")
+	fsys["workflows/test/step1.md"] = &fstest.MapFile{Data: []byte("# Step 1 for {{.Slug}}\n{{include \"local.md\"}}\n{{ include \"shared.md\" }}")}
+	fsys["workflows/test/local.md"] = &fstest.MapFile{Data: []byte("Local partial")}
+	fsys["workflows/shared.md"] = &fstest.MapFile{Data: []byte("Shared rules for {{.Slug}}\n{{include \"nested/footer.md\"}}")}
+	fsys["nested/footer.md"] = &fstest.MapFile{Data: []byte("Footer")}
+
+	svc, err := NewRegistryService(fsys, nil, "")
+	require.NoError(t, err)
+
+	result, err := svc.RenderTemplate("workflow::with-includes::v1::step1", TemplateContext{Slug: "my-feature"})
+	require.NoError(t, err)
+	require.Equal(t, "# Step 1 for my-feature\nLocal partial\nShared rules for my-feature\nFooter", result,
+		"partials are found next to the template, then in workflows/, and see the template context")
+
+	raw, err := svc.GetTemplate("workflow::with-includes::v1::step1")
+	require.NoError(t, err)
+	require.Contains(t, raw, "Shared rules for {{.Slug}}", "GetTemplate expands includes without rendering")
+}
+
+func TestLoadRegistryFromYAML_IncludeCycle(t *testing.T) {
+	fsys := createWorkflowFS(includeWorkflowYAML, "v1-epic-instructions.md")
+	fsys["workflows/test/step1.md"] = &fstest.MapFile{Data: []byte(`{{include "a.md"}}`)}
+	fsys["workflows/test/a.md"] = &fstest.MapFile{Data: []byte(`{{include "b.md"}}`)}
+	fsys["workflows/test/b.md"] = &fstest.MapFile{Data: []byte(`{{include "a.md"}}`)}
+
+	_, err := LoadRegistryFromYAML(fsys)
+	require.ErrorIs(t, err, ErrIncludeCycle)
+	require.ErrorContains(t, err, "workflows/test/a.md -> workflows/test/b.md -> workflows/test/a.md")
+}
+
+func TestLoadRegistryFromYAML_SelfInclude(t *testing.T) {
+	fsys := createWorkflowFS(includeWorkflowYAML, "v1-epic-instructions.md")
+	fsys["workflows/test/step1.md"] = &fstest.MapFile{Data: []byte(`{{include "step1.md"}}`)}
+
+	_, err := LoadRegistryFromYAML(fsys)
+	require.ErrorIs(t, err, ErrIncludeCycle)
+}
+
+func TestLoadRegistryFromYAML_MissingInclude(t *testing.T) {
+	fsys := createWorkflowFS(includeWorkflowYAML, "v1-epic-instructions.md")
+	fsys["workflows/test/step1.md"] = &fstest.MapFile{Data: []byte(`{{include "missing.md"}}`)}
+
+	_, err := LoadRegistryFromYAML(fsys)
+	require.ErrorContains(t, err, `include "missing.md" in workflows/test/step1.md`)
+}
+
+func TestLoadRegistryFromYAML_IncludePathTraversal(t *testing.T) {
+	fsys := createWorkflowFS(includeWorkflowYAML, "v1-epic-instructions.md")
+	fsys["workflows/test/step1.md"] = &fstest.MapFile{Data: []byte(`{{include "../../secret.md"}}`)}
+
+	_, err := LoadRegistryFromYAML(fsys)
+	require.ErrorContains(t, err, "path traversal")
+}
+
+func TestExpandIncludes_SamePartialTwiceIsNotACycle(t *testing.T) {
+	fsys := fstest.MapFS{
+		"workflows/shared.md": &fstest.MapFile{Data: []byte("shared")},
+	}
+
+	result, err := expandIncludes(fsys, "main.md", `{{include "shared.md"}} and {{include "shared.md"}}`)
+	require.NoError(t, err)
+	require.Equal(t, "shared and shared", result)
+}
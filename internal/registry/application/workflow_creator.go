@@ -6,6 +6,7 @@ import (
 	"time"
 
 	beads "github.com/zjrosen/perles/internal/beads/application"
+	beadsdomain "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/registry/domain"
 )
@@ -65,6 +66,14 @@ func (c *WorkflowCreator) Create(feature, workflowKey string) (*WorkflowResultDT
 // The args parameter contains user-provided argument values for template rendering.
 // Returns a WorkflowResultDTO with the created epic, tasks, and resolved dependency IDs.
 func (c *WorkflowCreator) CreateWithArgs(feature, workflowKey string, args map[string]string) (*WorkflowResultDTO, error) {
+	return c.CreateWithPrefix(feature, workflowKey, "", args)
+}
+
+// CreateWithPrefix is CreateWithArgs with the epic created under the given bd ID prefix
+// (e.g. "feat1"), so the workflow's tasks are namespaced as "feat1-abc.1", "feat1-abc.2", ...
+// and cannot be confused with tasks of other workflows sharing the tracker.
+// An empty prefix uses the tracker's default prefix.
+func (c *WorkflowCreator) CreateWithPrefix(feature, workflowKey, prefix string, args map[string]string) (*WorkflowResultDTO, error) {
 	// 1. Get workflow registration
 	reg, err := c.registry.GetByKey("workflow", workflowKey)
 	if err != nil {
@@ -97,10 +106,18 @@ func (c *WorkflowCreator) CreateWithArgs(feature, workflowKey string, args map[s
 		epicDescription = fmt.Sprintf("Workflow: %s\nFeature: %s", workflowKey, feature)
 	}
 
-	epicResult, err := c.executor.CreateEpic(epicTitle, epicDescription, epicLabels)
+	var epicResult beadsdomain.CreateResult
+	if prefix == "" {
+		epicResult, err = c.executor.CreateEpic(epicTitle, epicDescription, epicLabels)
+	} else {
+		epicResult, err = c.executor.CreateEpicWithPrefix(prefix, epicTitle, epicDescription, epicLabels)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("create epic: %w", err)
 	}
+	if prefix != "" && !strings.HasPrefix(epicResult.ID, prefix+"-") {
+		return nil, fmt.Errorf("create epic: bd created %s outside prefix %q", epicResult.ID, prefix)
+	}
 
 	// 4. Create tasks and build ID mapping
 	dag := reg.DAG()
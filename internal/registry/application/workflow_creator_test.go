@@ -231,6 +231,52 @@ func TestWorkflowCreator_CreateWithArgs_ConfigCustom(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWorkflowCreator_CreateWithPrefix(t *testing.T) {
+	registrySvc, err := createRegistryServiceWithFS(createWorkflowCreatorConfigFS("Task body"))
+	require.NoError(t, err)
+
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().CreateEpicWithPrefix(
+		"feat1",
+		"Config Workflow: Test Feature",
+		mock.AnythingOfType("string"),
+		[]string{"feature:test-feature", "workflow:config-workflow"},
+	).Return(beads.CreateResult{ID: "feat1-abc", Title: "Config Workflow: Test Feature"}, nil)
+	mockExecutor.EXPECT().CreateTask(
+		"Task",
+		mock.AnythingOfType("string"),
+		"feat1-abc",
+		mock.AnythingOfType("string"),
+		[]string{"spec:plan"},
+	).Return(beads.CreateResult{ID: "feat1-abc.1", Title: "Task"}, nil)
+
+	creator := NewWorkflowCreator(registrySvc, mockExecutor, config.TemplatesConfig{})
+
+	result, err := creator.CreateWithPrefix("test-feature", "config-workflow", "feat1", nil)
+	require.NoError(t, err)
+	require.Equal(t, "feat1-abc", result.Epic.ID)
+	require.Equal(t, "feat1-abc.1", result.Tasks[0].ID)
+}
+
+func TestWorkflowCreator_CreateWithPrefix_RejectsEpicOutsidePrefix(t *testing.T) {
+	registrySvc, err := createRegistryServiceWithFS(createWorkflowCreatorConfigFS("Task body"))
+	require.NoError(t, err)
+
+	// "feat10-abc" shares the leading characters of "feat1" but belongs to another namespace
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().CreateEpicWithPrefix(
+		"feat1",
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("[]string"),
+	).Return(beads.CreateResult{ID: "feat10-abc"}, nil)
+
+	creator := NewWorkflowCreator(registrySvc, mockExecutor, config.TemplatesConfig{})
+
+	_, err = creator.CreateWithPrefix("test-feature", "config-workflow", "feat1", nil)
+	require.ErrorContains(t, err, `outside prefix "feat1"`)
+}
+
 func TestToTitleCase(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -37,6 +37,15 @@ type WorkflowDef struct {
 	Labels       []string      `yaml:"labels"`        // Optional labels for filtering
 	Arguments    []ArgumentDef `yaml:"arguments"`     // User-configurable parameters
 	Nodes        []NodeDef     `yaml:"nodes"`         // Workflow nodes (chain)
+	// RequireReview controls whether completed tasks must be reviewed before committing.
+	// Optional - omitted means true.
+	RequireReview *bool `yaml:"require_review"`
+	// WorkerProviders maps agent types (implementer, reviewer, researcher) to the provider
+	// their workers use, e.g. reviewer: claude. Optional - unlisted types use the default.
+	WorkerProviders map[string]string `yaml:"worker_providers"`
+	// Requires lists executables the workflow needs on PATH, e.g. [docker, make].
+	// Optional - checked before a workflow is created from the template.
+	Requires []string `yaml:"requires"`
 }
 
 // ArgumentDef defines a user-configurable parameter in YAML
@@ -231,8 +240,9 @@ func LoadRegistryFromYAMLWithSource(fsys fs.FS, source registry.Source) ([]*regi
 	return allRegistrations, nil
 }
 
-// validateTemplateExists checks that all template files referenced in a workflow definition exist.
-// This catches missing templates at load time rather than at render time.
+// validateTemplateExists checks that all template files referenced in a workflow definition exist,
+// along with the partials they include. This catches missing templates and include cycles at
+// load time rather than at render time.
 func validateTemplateExists(fsys fs.FS, def WorkflowDef) error {
 	// Check initial prompt template (may be empty for orchestration-only workflows)
 	if def.EpicTemplate != "" {
@@ -257,6 +267,20 @@ func validateTemplateExists(fsys fs.FS, def WorkflowDef) error {
 		}
 	}
 
+	// Check that every include resolves and none form a cycle
+	templates := []string{def.EpicTemplate, def.SystemPrompt}
+	for _, node := range def.Nodes {
+		templates = append(templates, node.Template)
+	}
+	for _, t := range templates {
+		if t == "" {
+			continue
+		}
+		if _, err := readTemplate(fsys, t); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -352,6 +376,18 @@ func buildRegistrationFromDefWithSource(def WorkflowDef, source registry.Source)
 		builder = builder.Arguments(arguments...)
 	}
 
+	if def.RequireReview != nil {
+		builder = builder.RequireReview(*def.RequireReview)
+	}
+
+	if len(def.WorkerProviders) > 0 {
+		builder = builder.WorkerProviders(def.WorkerProviders)
+	}
+
+	if len(def.Requires) > 0 {
+		builder = builder.Requires(def.Requires...)
+	}
+
 	return builder.Build()
 }
 
@@ -624,6 +624,74 @@ registry:
 	require.Equal(t, "workflows/test/coordinator_template.md", reg.SystemPrompt(), "SystemPrompt() should return resolved path")
 }
 
+func TestYAMLLoader_ParsesRequireReviewField(t *testing.T) {
+	yamlContent := `
+registry:
+  - namespace: "workflow"
+    key: "docs"
+    version: "v1"
+    name: "Docs"
+    description: "Documentation changes"
+    require_review: false
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+  - namespace: "workflow"
+    key: "default-review"
+    version: "v1"
+    name: "Default Review"
+    description: "Review not configured"
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+`
+	fs := createWorkflowFS(yamlContent, "step1.md")
+
+	registrations, err := LoadRegistryFromYAML(fs)
+	require.NoError(t, err)
+	require.Len(t, registrations, 2)
+
+	require.False(t, registrations[0].RequireReview(), "require_review: false should opt out of review")
+	require.True(t, registrations[1].RequireReview(), "review should be required when require_review is omitted")
+}
+
+func TestYAMLLoader_ParsesWorkerProvidersField(t *testing.T) {
+	yamlContent := `
+registry:
+  - namespace: "workflow"
+    key: "cross-review"
+    version: "v1"
+    name: "Cross Review"
+    description: "Implement with one provider, review with another"
+    worker_providers:
+      implementer: cursor
+      reviewer: claude
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+  - namespace: "workflow"
+    key: "default-providers"
+    version: "v1"
+    name: "Default Providers"
+    description: "Worker providers not configured"
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+`
+	fs := createWorkflowFS(yamlContent, "step1.md")
+
+	registrations, err := LoadRegistryFromYAML(fs)
+	require.NoError(t, err)
+	require.Len(t, registrations, 2)
+
+	require.Equal(t, map[string]string{"implementer": "cursor", "reviewer": "claude"}, registrations[0].WorkerProviders())
+	require.Empty(t, registrations[1].WorkerProviders(), "workers use the default provider when worker_providers is omitted")
+}
+
 func TestYAMLLoader_EmptySystemPrompt_AllowedForNonWorkflow(t *testing.T) {
 	// Non-orchestration workflow (no assignee fields) should not require system_prompt
 	yamlContent := `
@@ -1,6 +1,10 @@
 package registry
 
-import "errors"
+import (
+	"errors"
+	"maps"
+	"slices"
+)
 
 // Builder errors
 var (
@@ -12,18 +16,21 @@ var (
 
 // Builder provides a fluent API for creating registrations
 type Builder struct {
-	namespace    string
-	key          string
-	version      string
-	name         string
-	description  string
-	epicTemplate string
-	systemPrompt string
-	artifactPath string
-	dag          *Chain
-	labels       []string
-	arguments    []*Argument
-	source       Source
+	namespace       string
+	key             string
+	version         string
+	name            string
+	description     string
+	epicTemplate    string
+	systemPrompt    string
+	artifactPath    string
+	dag             *Chain
+	labels          []string
+	arguments       []*Argument
+	source          Source
+	skipReview      bool
+	workerProviders map[string]string
+	requires        []string
 }
 
 // NewBuilder creates a new registration builder
@@ -100,6 +107,26 @@ func (b *Builder) Source(s Source) *Builder {
 	return b
 }
 
+// RequireReview sets whether completed tasks must be reviewed before they are committed.
+// If not called, review is required.
+func (b *Builder) RequireReview(require bool) *Builder {
+	b.skipReview = !require
+	return b
+}
+
+// WorkerProviders sets the provider workers of each agent type are spawned with,
+// e.g. {"implementer": "cursor", "reviewer": "claude"}.
+func (b *Builder) WorkerProviders(providers map[string]string) *Builder {
+	b.workerProviders = maps.Clone(providers)
+	return b
+}
+
+// Requires sets the executables the workflow needs on PATH, e.g. "docker", "make".
+func (b *Builder) Requires(tools ...string) *Builder {
+	b.requires = slices.Clone(tools)
+	return b
+}
+
 // Build creates the registration, validating required fields.
 // Note: dag can be nil for epic-driven workflows where the DAG comes from an external source.
 func (b *Builder) Build() (*Registration, error) {
@@ -114,5 +141,9 @@ func (b *Builder) Build() (*Registration, error) {
 	}
 	// Note: b.dag may be nil for epic-driven workflows
 
-	return newRegistration(b.namespace, b.key, b.version, b.name, b.description, b.epicTemplate, b.systemPrompt, b.artifactPath, b.dag, b.labels, b.arguments, b.source), nil
+	reg := newRegistration(b.namespace, b.key, b.version, b.name, b.description, b.epicTemplate, b.systemPrompt, b.artifactPath, b.dag, b.labels, b.arguments, b.source)
+	reg.skipReview = b.skipReview
+	reg.workerProviders = b.workerProviders
+	reg.requires = b.requires
+	return reg, nil
 }
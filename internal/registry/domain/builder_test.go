@@ -132,6 +132,48 @@ func TestBuilder_Build_NilChain_Allowed(t *testing.T) {
 	require.Nil(t, reg.DAG())
 }
 
+func TestBuilder_RequireReview(t *testing.T) {
+	reg, err := NewBuilder("workflow").Key("key").Version("v1").Build()
+	require.NoError(t, err)
+	require.True(t, reg.RequireReview(), "review is required by default")
+
+	reg, err = NewBuilder("workflow").Key("key").Version("v1").RequireReview(false).Build()
+	require.NoError(t, err)
+	require.False(t, reg.RequireReview())
+}
+
+func TestBuilder_WorkerProviders(t *testing.T) {
+	reg, err := NewBuilder("workflow").Key("key").Version("v1").Build()
+	require.NoError(t, err)
+	require.Empty(t, reg.WorkerProviders(), "no worker providers by default")
+
+	providers := map[string]string{"implementer": "cursor", "reviewer": "claude"}
+	reg, err = NewBuilder("workflow").Key("key").Version("v1").WorkerProviders(providers).Build()
+	require.NoError(t, err)
+	require.Equal(t, providers, reg.WorkerProviders())
+
+	// Neither the builder input nor the returned map aliases the registration's copy
+	providers["reviewer"] = "codex"
+	reg.WorkerProviders()["implementer"] = "amp"
+	require.Equal(t, map[string]string{"implementer": "cursor", "reviewer": "claude"}, reg.WorkerProviders())
+}
+
+func TestBuilder_Requires(t *testing.T) {
+	reg, err := NewBuilder("workflow").Key("key").Version("v1").Build()
+	require.NoError(t, err)
+	require.Empty(t, reg.Requires(), "no required tools by default")
+
+	tools := []string{"docker", "make"}
+	reg, err = NewBuilder("workflow").Key("key").Version("v1").Requires(tools...).Build()
+	require.NoError(t, err)
+	require.Equal(t, []string{"docker", "make"}, reg.Requires())
+
+	// Neither the builder input nor the returned slice aliases the registration's copy
+	tools[0] = "podman"
+	reg.Requires()[1] = "just"
+	require.Equal(t, []string{"docker", "make"}, reg.Requires())
+}
+
 func TestBuilder_FluentChaining(t *testing.T) {
 	chain := testChain(t, "step", "Step", "step.md")
 
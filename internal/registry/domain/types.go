@@ -1,5 +1,10 @@
 package registry
 
+import (
+	"maps"
+	"slices"
+)
+
 // Source indicates where a registration originated from.
 type Source int
 
@@ -28,18 +33,21 @@ func (s Source) String() string {
 
 // Registration represents a registered workflow namespace+version
 type Registration struct {
-	namespace    string      // e.g., "workflow"
-	key          string      // e.g., "planning-standard"
-	version      string      // e.g., "v1"
-	name         string      // e.g., "Standard Planning Workflow"
-	description  string      // e.g., "Three-phase workflow: Research, Propose, Plan"
-	epicTemplate string      // template filename for epic content (e.g., "v1-research-proposal-epic.md")
-	systemPrompt string      // template filename for system prompt content (e.g., "epic_driven.md")
-	artifactPath string      // path prefix for artifacts (default: ".spec")
-	dag          *Chain      // DAG-based workflow chain (replaces flat chain)
-	labels       []string    // e.g., ["lang:go", "category:workflow"]
-	arguments    []*Argument // user-configurable parameters for workflow
-	source       Source      // origin of registration (built-in or user)
+	namespace       string            // e.g., "workflow"
+	key             string            // e.g., "planning-standard"
+	version         string            // e.g., "v1"
+	name            string            // e.g., "Standard Planning Workflow"
+	description     string            // e.g., "Three-phase workflow: Research, Propose, Plan"
+	epicTemplate    string            // template filename for epic content (e.g., "v1-research-proposal-epic.md")
+	systemPrompt    string            // template filename for system prompt content (e.g., "epic_driven.md")
+	artifactPath    string            // path prefix for artifacts (default: ".spec")
+	dag             *Chain            // DAG-based workflow chain (replaces flat chain)
+	labels          []string          // e.g., ["lang:go", "category:workflow"]
+	arguments       []*Argument       // user-configurable parameters for workflow
+	source          Source            // origin of registration (built-in or user)
+	skipReview      bool              // true when completed tasks are committed without review
+	workerProviders map[string]string // agent type -> provider for workers of that type
+	requires        []string          // executables that must be on PATH, e.g. ["docker", "make"]
 }
 
 // newRegistration creates a registration (used by builder)
@@ -121,6 +129,23 @@ func (r *Registration) Source() Source {
 	return r.source
 }
 
+// RequireReview returns whether completed tasks must be reviewed before they are committed.
+// Defaults to true; workflows opt out with require_review: false.
+func (r *Registration) RequireReview() bool {
+	return !r.skipReview
+}
+
+// WorkerProviders returns the provider workers of each agent type are spawned with,
+// keyed by agent type. Agent types not listed use the workflow's worker provider.
+func (r *Registration) WorkerProviders() map[string]string {
+	return maps.Clone(r.workerProviders)
+}
+
+// Requires returns the executables the workflow needs on PATH (e.g. "docker", "make").
+func (r *Registration) Requires() []string {
+	return slices.Clone(r.requires)
+}
+
 // IsEpicDriven returns true if this workflow uses an existing epic from the tracker
 // rather than creating one. An epic-driven workflow has a single "epic_id" argument
 // and no DAG nodes (tasks come from the BD tracker).
@@ -49,6 +49,20 @@ func (s SessionState) IsValid() bool {
 	}
 }
 
+// WorkflowSettings holds the workflow options a session was created with that must
+// survive a restart for the workflow to resume with the same behavior.
+type WorkflowSettings struct {
+	BeadsPrefix         string
+	MaxWorkflowDuration time.Duration
+	MaxWorkers          int
+	Priority            int
+	CommitAuthor        string
+	DirtyWorktreePolicy string
+	WorktreeStashRef    string
+	SkipReview          bool
+	WorkerProviders     map[string]string // agent type -> provider
+}
+
 // Session represents a domain entity for orchestration sessions.
 // All fields are unexported to enforce encapsulation; use the constructor
 // and getter methods to access data.
@@ -76,6 +90,9 @@ type Session struct {
 	// Session storage path (for file-based session logs in ~/.perles/sessions/)
 	sessionDir string
 
+	// Workflow options the session was created with
+	settings WorkflowSettings
+
 	// Ownership for crash recovery
 	ownerCreatedPID *int
 	ownerCurrentPID *int
@@ -149,6 +166,7 @@ func ReconstituteSession(
 	worktreeBaseBranch, worktreeBranchName string,
 	worktreePath, worktreeBranch string,
 	sessionDir string,
+	settings WorkflowSettings,
 	ownerCreatedPID, ownerCurrentPID *int,
 	tokensUsed int64,
 	activeWorkers int,
@@ -175,6 +193,7 @@ func ReconstituteSession(
 		worktreePath:       worktreePath,
 		worktreeBranch:     worktreeBranch,
 		sessionDir:         sessionDir,
+		settings:           settings,
 		ownerCreatedPID:    ownerCreatedPID,
 		ownerCurrentPID:    ownerCurrentPID,
 		tokensUsed:         tokensUsed,
@@ -273,6 +292,11 @@ func (s *Session) SessionDir() string {
 	return s.sessionDir
 }
 
+// Settings returns the workflow options the session was created with.
+func (s *Session) Settings() WorkflowSettings {
+	return s.settings
+}
+
 // OwnerCreatedPID returns the PID of the process that created this session, if set.
 func (s *Session) OwnerCreatedPID() *int {
 	return s.ownerCreatedPID
@@ -390,6 +414,12 @@ func (s *Session) SetSessionDir(dir string) {
 	s.updatedAt = time.Now()
 }
 
+// SetSettings sets the workflow options the session was created with.
+func (s *Session) SetSettings(settings WorkflowSettings) {
+	s.settings = settings
+	s.updatedAt = time.Now()
+}
+
 // SetOwnerCreatedPID sets the PID of the process that created this session.
 func (s *Session) SetOwnerCreatedPID(pid *int) {
 	s.ownerCreatedPID = pid
@@ -98,6 +98,7 @@ func TestReconstituteSession(t *testing.T) {
 	deletedAt := time.Date(2026, 1, 20, 9, 0, 0, 0, time.UTC)
 	ownerCreatedPID := 12345
 	ownerCurrentPID := 67890
+	settings := WorkflowSettings{Priority: 3, SkipReview: true, WorkerProviders: map[string]string{"reviewer": "codex"}}
 
 	session := ReconstituteSession(
 		42,
@@ -115,6 +116,7 @@ func TestReconstituteSession(t *testing.T) {
 		"/path/to/worktree",
 		"feature/branch",
 		"", // sessionDir
+		settings,
 		&ownerCreatedPID,
 		&ownerCurrentPID,
 		0,
@@ -140,6 +142,7 @@ func TestReconstituteSession(t *testing.T) {
 	require.Equal(t, "/path/to/workdir", session.WorkDir())
 	require.Equal(t, "/path/to/worktree", session.WorktreePath())
 	require.Equal(t, "feature/branch", session.WorktreeBranch())
+	require.Equal(t, settings, session.Settings())
 	require.NotNil(t, session.OwnerCreatedPID())
 	require.Equal(t, 12345, *session.OwnerCreatedPID())
 	require.NotNil(t, session.OwnerCurrentPID())
@@ -175,6 +178,7 @@ func TestReconstituteSession_NilDeletedAt(t *testing.T) {
 		"", "",
 		"", "",
 		"", // sessionDir
+		WorkflowSettings{},
 		nil, nil,
 		0,
 		0,
@@ -283,6 +287,7 @@ func TestSession_IsDeleted(t *testing.T) {
 			nil, false, "",
 			"", "", "", "",
 			"", // sessionDir
+			WorkflowSettings{},
 			nil, nil, 0, 0, nil, nil,
 			time.Now(), nil, nil, nil, time.Now(), nil, &deletedAt,
 		)
@@ -322,6 +327,7 @@ func TestSession_Getters(t *testing.T) {
 		"/worktree/path",
 		"main",
 		"", // sessionDir
+		WorkflowSettings{},
 		nil, nil,
 		0,
 		0,
@@ -362,6 +368,7 @@ func TestSession_WorktreeMode_RoundTrip(t *testing.T) {
 		"main", "feature/test",
 		"/worktree/path", "feature/test",
 		"", // sessionDir
+		WorkflowSettings{},
 		nil, nil, 0, 0, nil, nil,
 		createdAt, nil, nil, nil, updatedAt, nil, nil,
 	)
@@ -397,6 +404,7 @@ func TestSession_WorktreeEnabled_BackwardCompat(t *testing.T) {
 		"main", "feature/test",
 		"/worktree/path", "feature/test",
 		"", // sessionDir
+		WorkflowSettings{},
 		nil, nil, 0, 0, nil, nil,
 		createdAt, nil, nil, nil, updatedAt, nil, nil,
 	)
@@ -236,8 +236,14 @@ func (c TreeColumn) HandleLoaded(msg tea.Msg) BoardColumn {
 		return c
 	}
 
-	// Initialize tree model with down direction and current mode
+	// Initialize tree model with down direction and current mode,
+	// carrying over collapsed fan-out groups from the previous load
+	var collapsedGroups []string
+	if c.tree != nil {
+		collapsedGroups = c.tree.CollapsedGroups()
+	}
 	c.tree = tree.New(loadedMsg.RootID, loadedMsg.IssueMap, tree.DirectionDown, c.mode, c.clock)
+	c.tree.SetCollapsedGroups(collapsedGroups)
 
 	// Set column index for zone ID construction (enables mouse click zones)
 	c.tree.SetColumnIndex(c.columnIndex)
@@ -267,7 +273,7 @@ func (c TreeColumn) SelectedIssue() *beads.Issue {
 	return &node.Issue
 }
 
-// Update handles messages (j/k navigation, m toggle).
+// Update handles messages (j/k navigation, m toggle, f fan-out toggle).
 func (c TreeColumn) Update(msg tea.Msg) (BoardColumn, tea.Cmd) {
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
 		switch keyMsg.String() {
@@ -286,6 +292,10 @@ func (c TreeColumn) Update(msg tea.Msg) (BoardColumn, tea.Cmd) {
 				c.mode = c.tree.Mode() // Sync our mode field
 				_ = c.tree.Rebuild()   // Rebuild with new mode
 			}
+		case "f":
+			if c.tree != nil {
+				c.tree.ToggleFanOut()
+			}
 		}
 	}
 
@@ -505,6 +505,7 @@ func (m Model) renderTreeContent() string {
 	actionsCol.WriteString(renderKeyDesc("U", "go to original root"))
 	actionsCol.WriteString(renderKeyDesc("d", "toggle direction"))
 	actionsCol.WriteString(renderKeyDesc("m", "toggle mode (deps/children)"))
+	actionsCol.WriteString(renderKeyDesc("f", "expand/collapse"))
 	actionsCol.WriteString(renderKeyDesc("y", "copy issue ID"))
 
 	// General column
@@ -566,6 +567,7 @@ func (m Model) renderDashboardContent() string {
 	actionsCol.WriteString("\n")
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Start))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Stop))
+	actionsCol.WriteString(renderBinding(keys.Dashboard.Kill))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.New))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Help))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Quit))
@@ -575,10 +577,12 @@ func (m Model) renderDashboardContent() string {
 	treeCol.WriteString(sectionStyle.Render("Epic Tree"))
 	treeCol.WriteString("\n")
 	treeCol.WriteString(renderBinding(keys.Component.EditAction))
+	treeCol.WriteString(renderBinding(keys.Dashboard.NewChildIssue))
 	treeCol.WriteString(renderKeyDesc("y", "copy ID/description"))
 	treeCol.WriteString(renderKeyDesc("h/l", "tree ↔ details"))
 	treeCol.WriteString(renderKeyDesc("d", "toggle direction"))
 	treeCol.WriteString(renderKeyDesc("m", "toggle mode"))
+	treeCol.WriteString(renderKeyDesc("f", "expand/collapse"))
 
 	// Join columns horizontally, aligned at top
 	columns := lipgloss.JoinHorizontal(
@@ -1,12 +1,13 @@
 // Package issueeditor provides a unified modal for editing issue properties.
 //
-// This modal combines priority, status, and labels editing into a single form,
+// This modal combines priority, status, assignee, and labels editing into a single form,
 // replacing the previous three-modal architecture with a streamlined interface.
 package issueeditor
 
 import (
 	"slices"
 	"strconv"
+	"strings"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/mode/shared"
@@ -30,6 +31,7 @@ type SaveMsg struct {
 	Notes       string
 	Priority    beads.Priority
 	Status      beads.Status
+	Assignee    string
 	Labels      []string
 }
 
@@ -49,6 +51,7 @@ func (m SaveMsg) BuildUpdateOptions(original *beads.Issue) beads.UpdateIssueOpti
 		opts.Priority = &p
 		s := m.Status
 		opts.Status = &s
+		opts.Assignee = &m.Assignee
 		labels := m.Labels
 		opts.Labels = &labels
 		return opts
@@ -70,6 +73,9 @@ func (m SaveMsg) BuildUpdateOptions(original *beads.Issue) beads.UpdateIssueOpti
 		s := m.Status
 		opts.Status = &s
 	}
+	if m.AssigneeChanged(original) {
+		opts.Assignee = &m.Assignee
+	}
 	if !slices.Equal(m.Labels, original.Labels) {
 		labels := m.Labels
 		opts.Labels = &labels
@@ -77,6 +83,12 @@ func (m SaveMsg) BuildUpdateOptions(original *beads.Issue) beads.UpdateIssueOpti
 	return opts
 }
 
+// AssigneeChanged reports whether the saved assignee differs from the original issue.
+// Returns true if original is nil.
+func (m SaveMsg) AssigneeChanged(original *beads.Issue) bool {
+	return original == nil || m.Assignee != original.Assignee
+}
+
 // New creates a new issue editor with the given issue.
 func New(issue beads.Issue) Model {
 	m := Model{issue: issue}
@@ -90,7 +102,7 @@ func New(issue beads.Issue) Model {
 		Columns: []formmodal.ColumnConfig{{}, {}},
 		// ColumnGap and MinMultiColumnWidth use defaults (3 and 100)
 		Fields: []formmodal.FieldConfig{
-			// Column 0 (left/metadata): title, priority, status, labels
+			// Column 0 (left/metadata): title, priority, status, assignee, labels
 			{
 				Key:          "title",
 				Type:         formmodal.FieldTypeText,
@@ -116,6 +128,16 @@ func New(issue beads.Issue) Model {
 				Options: statusListOptions(issue.Status),
 				Column:  0,
 			},
+			{
+				Key:          "assignee",
+				Type:         formmodal.FieldTypeText,
+				Label:        "Assignee",
+				Hint:         "empty to unassign",
+				Placeholder:  "Unassigned",
+				InitialValue: issue.Assignee,
+				MaxLength:    100,
+				Column:       0,
+			},
 			{
 				Key:              "labels",
 				Type:             formmodal.FieldTypeEditableList,
@@ -161,6 +183,7 @@ func New(issue beads.Issue) Model {
 				Notes:       values["notes"].(string),
 				Priority:    parsePriority(values["priority"].(string)),
 				Status:      beads.Status(values["status"].(string)),
+				Assignee:    strings.TrimSpace(values["assignee"].(string)),
 				Labels:      values["labels"].([]string),
 			}
 		},
@@ -212,6 +212,37 @@ func TestBuildUpdateOptions_ValueTypesUseAddressOfCopy(t *testing.T) {
 }
 
 // testIssue creates a beads.Issue for testing with the given parameters.
+func TestBuildUpdateOptions_AssigneeChanged(t *testing.T) {
+	original := &beads.Issue{Assignee: "alice", Labels: []string{}}
+	msg := SaveMsg{Assignee: "bob", Labels: []string{}}
+
+	opts := msg.BuildUpdateOptions(original)
+
+	require.True(t, msg.AssigneeChanged(original))
+	require.NotNil(t, opts.Assignee)
+	require.Equal(t, "bob", *opts.Assignee)
+}
+
+func TestBuildUpdateOptions_AssigneeCleared(t *testing.T) {
+	original := &beads.Issue{Assignee: "alice", Labels: []string{}}
+	msg := SaveMsg{Assignee: "", Labels: []string{}}
+
+	opts := msg.BuildUpdateOptions(original)
+
+	require.NotNil(t, opts.Assignee, "clearing the assignee is a change")
+	require.Equal(t, "", *opts.Assignee)
+}
+
+func TestBuildUpdateOptions_AssigneeUnchanged(t *testing.T) {
+	original := &beads.Issue{Assignee: "alice", Labels: []string{}}
+	msg := SaveMsg{Assignee: "alice", Labels: []string{}}
+
+	opts := msg.BuildUpdateOptions(original)
+
+	require.False(t, msg.AssigneeChanged(original))
+	require.Nil(t, opts.Assignee)
+}
+
 func testIssue(id string, labels []string, priority beads.Priority, status beads.Status) beads.Issue {
 	return beads.Issue{
 		ID:        id,
@@ -262,6 +293,7 @@ func TestNew_InitializesFormModalWithCorrectFields(t *testing.T) {
 	require.Contains(t, view, "Edit Issue", "expected title")
 	require.Contains(t, view, "Priority", "expected Priority field")
 	require.Contains(t, view, "Status", "expected Status field")
+	require.Contains(t, view, "Assignee", "expected Assignee field")
 	require.Contains(t, view, "Labels", "expected Labels field")
 }
 
@@ -387,9 +419,10 @@ func TestSaveMsg_ContainsCorrectParsedValues(t *testing.T) {
 	m := New(issue)
 
 	// Navigate to submit button and press Enter
-	// Tab through Title -> Priority -> Status -> Labels -> Add Label input -> Description -> Notes -> Submit button
+	// Tab through Title -> Priority -> Status -> Assignee -> Labels -> Add Label input -> Description -> Notes -> Submit button
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Add Label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Description
@@ -534,7 +567,8 @@ func TestSaveMsg_PriorityChange(t *testing.T) {
 	// Press Space to confirm selection
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
 
-	// Tab to Status -> Labels -> Add Label input -> Description -> Notes -> Submit
+	// Tab to Status -> Assignee -> Labels -> Add Label input -> Description -> Notes -> Submit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -566,7 +600,8 @@ func TestSaveMsg_StatusChange(t *testing.T) {
 	// Press Space to confirm selection
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
 
-	// Tab to Labels -> Add Label input -> Description -> Notes -> Submit
+	// Tab to Assignee -> Labels -> Add Label input -> Description -> Notes -> Submit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -588,9 +623,10 @@ func TestSaveMsg_LabelsToggle(t *testing.T) {
 	issue := testIssue("test-123", labels, beads.PriorityMedium, beads.StatusOpen)
 	m := New(issue)
 
-	// Tab to Labels (Title -> Priority -> Status -> Labels)
+	// Tab to Labels (Title -> Priority -> Status -> Assignee -> Labels)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 
 	// Toggle off "bug" (first label) with space
@@ -618,9 +654,10 @@ func TestSaveMsg_AddNewLabel(t *testing.T) {
 	issue := testIssue("test-123", []string{"existing"}, beads.PriorityMedium, beads.StatusOpen)
 	m := New(issue)
 
-	// Tab to Add Label input (Title -> Priority -> Status -> Labels -> Add Label input)
+	// Tab to Add Label input (Title -> Priority -> Status -> Assignee -> Labels -> Add Label input)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
 
@@ -673,9 +710,10 @@ func TestSaveMsg_ContainsTitleValue(t *testing.T) {
 	m := New(issue)
 
 	// Tab through all fields to Submit button
-	// Title -> Priority -> Status -> Labels -> Add Label -> Description -> Notes -> Submit
+	// Title -> Priority -> Status -> Assignee -> Labels -> Add Label -> Description -> Notes -> Submit
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
@@ -696,9 +734,10 @@ func TestSaveMsg_ContainsDescriptionValue(t *testing.T) {
 	m := New(issue)
 
 	// Tab through all fields to Submit button
-	// Title -> Priority -> Status -> Labels -> Add Label -> Description -> Notes -> Submit
+	// Title -> Priority -> Status -> Assignee -> Labels -> Add Label -> Description -> Notes -> Submit
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
@@ -721,17 +760,19 @@ func TestView_FieldOrder(t *testing.T) {
 
 	view := m.View()
 
-	// Verify field order: Title -> Priority -> Status -> Labels -> Description -> Notes
+	// Verify field order: Title -> Priority -> Status -> Assignee -> Labels -> Description -> Notes
 	titleIdx := len(view) - len(view[findIndex(view, "Title"):])
 	priorityIdx := len(view) - len(view[findIndex(view, "Priority"):])
 	statusIdx := len(view) - len(view[findIndex(view, "Status"):])
+	assigneeIdx := len(view) - len(view[findIndex(view, "Assignee"):])
 	labelsIdx := len(view) - len(view[findIndex(view, "Labels"):])
 	descriptionIdx := len(view) - len(view[findIndex(view, "Description"):])
 	notesIdx := len(view) - len(view[findIndex(view, "Notes"):])
 
 	require.Less(t, titleIdx, priorityIdx, "Title should come before Priority")
 	require.Less(t, priorityIdx, statusIdx, "Priority should come before Status")
-	require.Less(t, statusIdx, labelsIdx, "Status should come before Labels")
+	require.Less(t, statusIdx, assigneeIdx, "Status should come before Assignee")
+	require.Less(t, assigneeIdx, labelsIdx, "Assignee should come before Labels")
 	require.Less(t, labelsIdx, descriptionIdx, "Labels should come before Description")
 	require.Less(t, descriptionIdx, notesIdx, "Description should come before Notes")
 }
@@ -788,9 +829,10 @@ func TestSaveMsg_ContainsNotesValue(t *testing.T) {
 	m := New(issue)
 
 	// Tab through all fields to Submit button
-	// Title -> Priority -> Status -> Labels -> Add Label input -> Description -> Notes -> Submit
+	// Title -> Priority -> Status -> Assignee -> Labels -> Add Label input -> Description -> Notes -> Submit
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
@@ -813,6 +855,7 @@ func TestIssueeditor_SaveMsg_IncludesNotes(t *testing.T) {
 	// Tab through all fields to Submit button
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
@@ -833,9 +876,10 @@ func TestIssueeditor_NotesField_VimEnabled(t *testing.T) {
 	issue := testIssueWithNotes("test-123", "Title", "Desc", "", []string{}, beads.PriorityMedium, beads.StatusOpen)
 	m := New(issue)
 
-	// Tab to Notes field (Title -> Priority -> Status -> Labels -> Add Label input -> Description -> Notes)
+	// Tab to Notes field (Title -> Priority -> Status -> Assignee -> Labels -> Add Label input -> Description -> Notes)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
@@ -862,6 +906,41 @@ func TestIssueeditor_NotesField_VimEnabled(t *testing.T) {
 	require.Equal(t, "vim mode works", saveMsg.Notes, "vim mode should allow typing in notes field")
 }
 
+func TestSaveMsg_AssigneeChange(t *testing.T) {
+	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
+	issue.Assignee = "alice"
+	m := New(issue)
+
+	// Tab to Assignee (Title -> Priority -> Status -> Assignee)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Assignee
+
+	// Replace "alice" with "bob"
+	for range "alice" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	for _, r := range "bob" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	// Tab to Labels -> Add Label input -> Description -> Notes -> Submit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.NotNil(t, cmd, "expected command")
+	msg := cmd()
+	saveMsg, ok := msg.(SaveMsg)
+	require.True(t, ok, "expected SaveMsg, got %T", msg)
+	require.Equal(t, "bob", saveMsg.Assignee)
+	require.True(t, saveMsg.AssigneeChanged(&issue))
+}
+
 func TestIssueeditor_EmptyNotes_DisplaysPlaceholder(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
 	m := New(issue)
@@ -933,7 +1012,7 @@ func TestIssueEditor_SingleColumn_80x40_Golden(t *testing.T) {
 // Tab order tests verify that Tab/Shift-Tab traverse fields in array order regardless of column
 
 func TestTabOrder_TraversesFieldsInArrayOrder(t *testing.T) {
-	// Tab order should be: title -> priority -> status -> labels -> add-label-input -> description -> notes -> submit
+	// Tab order should be: title -> priority -> status -> assignee -> labels -> add-label-input -> description -> notes -> submit
 	issue := testIssueWithNotes("test-tab", "Tab Order Test", "Description", "Notes", []string{"label1"}, beads.PriorityMedium, beads.StatusOpen)
 	m := New(issue)
 	m = m.SetSize(120, 40) // Two-column mode
@@ -944,6 +1023,8 @@ func TestTabOrder_TraversesFieldsInArrayOrder(t *testing.T) {
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	// Tab to status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	// Tab to assignee
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	// Tab to labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	// Tab to add label input
@@ -970,15 +1051,16 @@ func TestShiftTabOrder_ReversesCorrectly(t *testing.T) {
 	m = m.SetSize(120, 40) // Two-column mode
 
 	// Navigate to submit button first
-	for i := 0; i < 7; i++ {
+	for i := 0; i < 8; i++ {
 		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	}
 
-	// Now Shift-Tab should go back: notes -> description -> add-label -> labels -> status -> priority -> title
+	// Now Shift-Tab should go back: notes -> description -> add-label -> labels -> assignee -> status -> priority -> title
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to notes
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to add-label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to labels
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to assignee
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to title
@@ -989,7 +1071,7 @@ func TestShiftTabOrder_ReversesCorrectly(t *testing.T) {
 	}
 
 	// Tab forward to submit and save
-	for i := 0; i < 7; i++ {
+	for i := 0; i < 8; i++ {
 		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	}
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -1014,8 +1096,8 @@ func TestTabOrder_ConsistentBetweenSingleAndTwoColumn(t *testing.T) {
 	mWide = mWide.SetSize(120, 40)
 
 	// Both should take the same number of tabs to reach submit
-	// title -> priority -> status -> labels -> add-label-input -> description -> notes -> submit
-	tabsToSubmit := 7
+	// title -> priority -> status -> assignee -> labels -> add-label-input -> description -> notes -> submit
+	tabsToSubmit := 8
 
 	// Navigate narrow version to submit
 	for i := 0; i < tabsToSubmit; i++ {
@@ -882,6 +882,91 @@ func (m Model) SetError(text string) Model {
 	return m
 }
 
+// SetFieldOptions replaces the options of the list, select, or search-select
+// field identified by key. Selected values are preserved when they still exist
+// in the new options; otherwise the new options' Selected flags apply.
+// Unknown keys and fields of other types are ignored.
+func (m Model) SetFieldOptions(key string, options []ListOption) Model {
+	for i := range m.fields {
+		fs := &m.fields[i]
+		if fs.config.Key != key {
+			continue
+		}
+		switch fs.config.Type {
+		case FieldTypeList, FieldTypeSelect, FieldTypeSearchSelect:
+		default:
+			return m
+		}
+
+		// Remember the current selection so it survives the rebuild
+		previous := make(map[string]bool)
+		for _, item := range fs.listItems {
+			if item.selected {
+				previous[item.value] = true
+			}
+		}
+		preserve := false
+		for _, opt := range options {
+			if previous[opt.Value] {
+				preserve = true
+				break
+			}
+		}
+
+		fs.config.Options = options
+		fs.listItems = make([]listItem, len(options))
+		fs.listCursor = 0
+		fs.scrollOffset = 0
+		for j, opt := range options {
+			selected := opt.Selected
+			if preserve {
+				selected = previous[opt.Value]
+			}
+			fs.listItems[j] = listItem{
+				label:    opt.Label,
+				subtext:  opt.Subtext,
+				value:    opt.Value,
+				selected: selected,
+				color:    opt.Color,
+			}
+			// Single-select fields keep the cursor on the selected option
+			if selected && fs.config.Type != FieldTypeList {
+				fs.listCursor = j
+			}
+		}
+
+		if fs.config.Type == FieldTypeSearchSelect {
+			fs.searchInput.SetValue("")
+			m = m.updateSearchFilter(fs)
+			m = m.ensureSearchCursorVisible(fs)
+		}
+		return m
+	}
+	return m
+}
+
+// FocusedFieldType returns the type of the currently focused field.
+// The second return value is false when focus is on the buttons.
+func (m Model) FocusedFieldType() (FieldType, bool) {
+	if m.focusedIndex < 0 || m.focusedIndex >= len(m.fields) {
+		return 0, false
+	}
+	return m.fields[m.focusedIndex].config.Type, true
+}
+
+// EnsureFocusVisible moves focus to the first visible field if the focused
+// field has become hidden (e.g., after state used by VisibleWhen changed
+// outside of the form's own key handling).
+func (m Model) EnsureFocusVisible() Model {
+	if m.focusedIndex < 0 || m.isFieldVisible(m.focusedIndex) {
+		return m
+	}
+	m.blurCurrentField()
+	m.focusedIndex = m.firstVisibleFieldIndex()
+	m.focusField(m.focusedIndex)
+	return m
+}
+
 // listContains checks if the editable list already contains a value.
 // Used for duplicate detection when AllowDuplicates is false.
 func (m Model) listContains(fs *fieldState, value string) bool {
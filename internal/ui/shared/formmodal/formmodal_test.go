@@ -4114,6 +4114,102 @@ func TestGolden_VisibleWhen_VisibleField(t *testing.T) {
 
 // --- SetError Tests ---
 
+func TestSetFieldOptions_PreservesSelectionWhenStillPresent(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:  "branch",
+				Type: FieldTypeSearchSelect,
+				Options: []ListOption{
+					{Label: "main", Value: "main"},
+					{Label: "develop", Value: "develop", Selected: true},
+				},
+			},
+		},
+	}
+	m := New(cfg)
+
+	m = m.SetFieldOptions("branch", []ListOption{
+		{Label: "feature/a", Value: "feature/a", Selected: true},
+		{Label: "main", Value: "main"},
+		{Label: "develop", Value: "develop"},
+	})
+
+	require.Len(t, m.fields[0].listItems, 3)
+	require.Equal(t, "develop", m.fields[0].value())
+	require.Equal(t, 2, m.fields[0].listCursor)
+	require.Len(t, m.fields[0].searchFiltered, 3)
+}
+
+func TestSetFieldOptions_FallsBackToNewDefaultsWhenSelectionRemoved(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:  "mode",
+				Type: FieldTypeSelect,
+				Options: []ListOption{
+					{Label: "A", Value: "a", Selected: true},
+					{Label: "B", Value: "b"},
+				},
+			},
+		},
+	}
+	m := New(cfg)
+
+	m = m.SetFieldOptions("mode", []ListOption{
+		{Label: "B", Value: "b"},
+		{Label: "C", Value: "c", Selected: true},
+	})
+
+	require.Equal(t, "c", m.fields[0].value())
+	require.Equal(t, 1, m.fields[0].listCursor)
+}
+
+func TestSetFieldOptions_IgnoresUnknownKeyAndNonListFields(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "name", Type: FieldTypeText, Label: "Name", InitialValue: "keep"},
+		},
+	}
+	m := New(cfg)
+
+	m = m.SetFieldOptions("missing", []ListOption{{Label: "X", Value: "x"}})
+	m = m.SetFieldOptions("name", []ListOption{{Label: "X", Value: "x"}})
+
+	require.Equal(t, "keep", m.fields[0].value())
+	require.Empty(t, m.fields[0].listItems)
+}
+
+func TestEnsureFocusVisible_MovesFocusOffHiddenField(t *testing.T) {
+	hidden := false
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "name", Type: FieldTypeText, Label: "Name"},
+			{
+				Key:         "extra",
+				Type:        FieldTypeText,
+				Label:       "Extra",
+				VisibleWhen: func(map[string]any) bool { return !hidden },
+			},
+		},
+	}
+	m := New(cfg)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	require.Equal(t, 1, m.focusedIndex)
+
+	hidden = true
+	m = m.EnsureFocusVisible()
+	require.Equal(t, 0, m.focusedIndex)
+
+	fieldType, ok := m.FocusedFieldType()
+	require.True(t, ok)
+	require.Equal(t, FieldTypeText, fieldType)
+}
+
 func TestSetError_SetsErrorMessage(t *testing.T) {
 	cfg := FormConfig{
 		Title: "Test Form",
@@ -43,6 +43,13 @@ type TreeNode struct {
 	Children []*TreeNode // Child nodes in tree
 	Depth    int         // Nesting level (0 = root)
 	Parent   *TreeNode   // Parent node in tree (nil for root)
+	Hidden   []*TreeNode // Siblings collapsed into this row (fan-out placeholders only)
+}
+
+// IsFanOut returns true if this node is a placeholder row standing in for
+// a collapsed set of siblings rather than a real issue.
+func (n *TreeNode) IsFanOut() bool {
+	return n.Hidden != nil
 }
 
 // BuildTree constructs a TreeNode hierarchy from an issue map.
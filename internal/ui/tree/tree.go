@@ -2,6 +2,7 @@ package tree
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	zone "github.com/lrstanley/bubblezone"
@@ -15,6 +16,10 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
+// DefaultFanOutThreshold is the sibling count above which children can be
+// collapsed into a single fan-out row. Groups start expanded.
+const DefaultFanOutThreshold = 8
+
 // Model holds the tree view state.
 type Model struct {
 	root        *TreeNode               // Current root of the tree
@@ -31,6 +36,9 @@ type Model struct {
 	scrollTop   int    // First visible line index (for viewport scrolling)
 	columnIndex int    // Column index for zone ID construction in tree columns (-1 = standalone)
 	zonePrefix  string // Custom zone prefix for issue zones (overrides columnIndex when set)
+
+	fanOutThreshold int             // Sibling count above which children can collapse (0 = disabled)
+	collapsed       map[string]bool // Issue IDs whose large child sets are collapsed
 }
 
 // New creates a new tree model with default mode (deps).
@@ -43,6 +51,9 @@ func New(rootID string, issueMap map[string]*beads.Issue, dir Direction, mode Tr
 		clock:       clock,
 		cursor:      0,
 		columnIndex: -1, // -1 indicates standalone mode (no zone marking)
+
+		fanOutThreshold: DefaultFanOutThreshold,
+		collapsed:       make(map[string]bool),
 	}
 
 	// Build the tree
@@ -53,7 +64,7 @@ func New(rootID string, issueMap map[string]*beads.Issue, dir Direction, mode Tr
 	}
 
 	m.root = root
-	m.nodes = root.Flatten()
+	m.nodes = m.flatten()
 	return m
 }
 
@@ -123,7 +134,7 @@ func (m *Model) viewportHeight() int {
 // RefreshNodes rebuilds the flattened nodes list after state changes.
 func (m *Model) RefreshNodes() {
 	if m.root != nil {
-		m.nodes = m.root.Flatten()
+		m.nodes = m.flatten()
 		// Clamp cursor to valid range
 		if m.cursor >= len(m.nodes) {
 			m.cursor = len(m.nodes) - 1
@@ -135,20 +146,47 @@ func (m *Model) RefreshNodes() {
 }
 
 // SelectedNode returns the currently selected node.
+// Returns nil when the cursor is on a collapsed fan-out row.
 func (m *Model) SelectedNode() *TreeNode {
 	if m.cursor >= 0 && m.cursor < len(m.nodes) {
-		return m.nodes[m.cursor]
+		if node := m.nodes[m.cursor]; !node.IsFanOut() {
+			return node
+		}
 	}
 	return nil
 }
 
 // SelectByIssueID moves the cursor to the node with the given issue ID.
+// If the issue is hidden inside a collapsed fan-out group, the group is expanded.
 // Returns true if the issue was found and selected, false otherwise.
 // Note: Does not adjust scroll position - caller should ensure size is set
 // and let normal rendering handle scroll adjustment.
 func (m *Model) SelectByIssueID(issueID string) bool {
+	if m.selectVisible(issueID) {
+		return true
+	}
+	if m.root == nil {
+		return false
+	}
+
+	// Expand any collapsed ancestors of the issue and retry
+	for _, node := range m.root.Flatten() {
+		if node.Issue.ID != issueID {
+			continue
+		}
+		for p := node.Parent; p != nil; p = p.Parent {
+			delete(m.collapsed, p.Issue.ID)
+		}
+		m.nodes = m.flatten()
+		return m.selectVisible(issueID)
+	}
+	return false
+}
+
+// selectVisible moves the cursor to a visible node with the given issue ID.
+func (m *Model) selectVisible(issueID string) bool {
 	for i, node := range m.nodes {
-		if node.Issue.ID == issueID {
+		if !node.IsFanOut() && node.Issue.ID == issueID {
 			m.cursor = i
 			return true
 		}
@@ -156,6 +194,105 @@ func (m *Model) SelectByIssueID(issueID string) bool {
 	return false
 }
 
+// SetFanOutThreshold sets the sibling count above which children can be collapsed
+// into a single fan-out row. A value of 0 disables fan-out rendering.
+func (m *Model) SetFanOutThreshold(n int) {
+	m.fanOutThreshold = max(n, 0)
+	m.RefreshNodes()
+}
+
+// ToggleFanOut expands or collapses the fan-out group at the cursor.
+// The group is the one represented by the selected placeholder row, the one
+// owned by the selected node, or the one the selected node belongs to.
+// Returns false if the cursor is not on or within a fan-out group.
+func (m *Model) ToggleFanOut() bool {
+	if m.cursor < 0 || m.cursor >= len(m.nodes) {
+		return false
+	}
+	node := m.nodes[m.cursor]
+
+	var group *TreeNode
+	switch {
+	case node.IsFanOut():
+		group = node.Parent
+	case m.isFanOutGroup(node):
+		group = node
+	case node.Parent != nil && m.isFanOutGroup(node.Parent):
+		group = node.Parent
+	default:
+		return false
+	}
+
+	id := group.Issue.ID
+	if m.collapsed[id] {
+		delete(m.collapsed, id)
+	} else {
+		m.collapsed[id] = true
+	}
+	m.nodes = m.flatten()
+
+	// Keep the cursor on the group: stay on its owner if selected,
+	// otherwise land on its first row (placeholder or first child)
+	for i, n := range m.nodes {
+		if (node == group && n == group) || (node != group && n.Parent == group) {
+			m.cursor = i
+			break
+		}
+	}
+	m.ensureCursorVisible()
+	return true
+}
+
+// CollapsedGroups returns the issue IDs whose fan-out groups are collapsed.
+// Pass the result to SetCollapsedGroups to carry state into a rebuilt model.
+func (m *Model) CollapsedGroups() []string {
+	ids := make([]string, 0, len(m.collapsed))
+	for id := range m.collapsed {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// SetCollapsedGroups marks the given issue IDs' fan-out groups as collapsed.
+func (m *Model) SetCollapsedGroups(ids []string) {
+	for _, id := range ids {
+		m.collapsed[id] = true
+	}
+	m.RefreshNodes()
+}
+
+// isFanOutGroup returns true if node has enough children to be collapsed.
+func (m *Model) isFanOutGroup(node *TreeNode) bool {
+	return m.fanOutThreshold > 0 && len(node.Children) > m.fanOutThreshold
+}
+
+// flatten returns the visible nodes in tree order, replacing collapsed
+// fan-out groups with a single placeholder row.
+func (m *Model) flatten() []*TreeNode {
+	if m.root == nil {
+		return nil
+	}
+	var result []*TreeNode
+	var walk func(n *TreeNode)
+	walk = func(n *TreeNode) {
+		result = append(result, n)
+		if m.isFanOutGroup(n) && m.collapsed[n.Issue.ID] {
+			result = append(result, &TreeNode{
+				Depth:  n.Depth + 1,
+				Parent: n,
+				Hidden: n.Children,
+			})
+			return
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	walk(m.root)
+	return result
+}
+
 // Root returns the tree root.
 func (m *Model) Root() *TreeNode {
 	return m.root
@@ -173,11 +310,38 @@ func (m *Model) VisibleIssueIDs() []string {
 
 	ids := make([]string, 0, endIdx-m.scrollTop)
 	for i := m.scrollTop; i < endIdx; i++ {
+		if m.nodes[i].IsFanOut() {
+			continue
+		}
 		ids = append(ids, m.nodes[i].Issue.ID)
 	}
 	return ids
 }
 
+// Outline returns the whole tree as plain text, one issue per line with the same
+// branch characters as View. Fan-out groups are listed in full and no styling,
+// cursor, or viewport scrolling is applied. Returns "" when no tree is loaded.
+func (m *Model) Outline() string {
+	if m.root == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	var walk func(n *TreeNode)
+	walk = func(n *TreeNode) {
+		sb.WriteString(m.buildPrefix(n, m.isLastChild(n)))
+		if n.Depth > 0 {
+			sb.WriteString(" ")
+		}
+		fmt.Fprintf(&sb, "%s %s [%s]\n", n.Issue.ID, n.Issue.TitleText, n.Issue.Status)
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	walk(m.root)
+	return sb.String()
+}
+
 // Direction returns the current traversal direction.
 func (m *Model) Direction() Direction {
 	return m.direction
@@ -218,7 +382,7 @@ func (m *Model) Rebuild() error {
 		return err
 	}
 	m.root = root
-	m.nodes = root.Flatten()
+	m.nodes = m.flatten()
 	// Try to preserve cursor position, clamp if needed
 	if m.cursor >= len(m.nodes) {
 		m.cursor = max(len(m.nodes)-1, 0)
@@ -240,7 +404,7 @@ func (m *Model) Refocus(newRootID string) error {
 		return err
 	}
 	m.root = root
-	m.nodes = root.Flatten()
+	m.nodes = m.flatten()
 	m.cursor = 0
 	return nil
 }
@@ -276,7 +440,7 @@ func (m *Model) GoBack() (needsRequery bool, requeriedID string) {
 		return false, ""
 	}
 	m.root = root
-	m.nodes = root.Flatten()
+	m.nodes = m.flatten()
 	m.cursor = 0
 	return false, ""
 }
@@ -293,7 +457,7 @@ func (m *Model) GoToOriginal() error {
 		return err
 	}
 	m.root = root
-	m.nodes = root.Flatten()
+	m.nodes = m.flatten()
 	m.cursor = 0
 	return nil
 }
@@ -358,8 +522,8 @@ func (m *Model) View() string {
 
 // isLastChild determines if node is the last child of its parent.
 func (m *Model) isLastChild(node *TreeNode) bool {
-	if node.Parent == nil {
-		return true // Root is always "last"
+	if node.Parent == nil || node.IsFanOut() {
+		return true // Root and fan-out rows are always "last"
 	}
 	children := node.Parent.Children
 	return len(children) > 0 && children[len(children)-1] == node
@@ -367,6 +531,10 @@ func (m *Model) isLastChild(node *TreeNode) bool {
 
 // renderNode renders a single tree node.
 func (m *Model) renderNode(node *TreeNode, isLast bool, isSelected bool) string {
+	if node.IsFanOut() {
+		return m.renderFanOut(node, isSelected)
+	}
+
 	var sb strings.Builder
 
 	// Cursor indicator
@@ -470,6 +638,38 @@ func (m *Model) renderNode(node *TreeNode, isLast bool, isSelected bool) string
 	return line
 }
 
+// renderFanOut renders a collapsed fan-out row summarizing its hidden siblings.
+func (m *Model) renderFanOut(node *TreeNode, isSelected bool) string {
+	var sb strings.Builder
+
+	if isSelected {
+		sb.WriteString(styles.SelectionIndicatorStyle.Render(">"))
+	} else {
+		sb.WriteString(" ")
+	}
+
+	prefix := m.buildPrefix(node, true)
+	if isSelected {
+		prefix = m.addSelectionGuide(prefix)
+	}
+	sb.WriteString(prefix)
+
+	closed := 0
+	for _, n := range node.Hidden {
+		if n.Issue.Status == beads.StatusClosed {
+			closed++
+		}
+	}
+	summary := fmt.Sprintf("▸ %d subtasks (%d/%d closed) · f to expand", len(node.Hidden), closed, len(node.Hidden))
+	if available := m.width - lipgloss.Width(sb.String()); m.width > 0 && lipgloss.Width(summary) > available {
+		summary = styles.TruncateString(summary, max(available, 0))
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	sb.WriteString(mutedStyle.Render(summary))
+	return sb.String()
+}
+
 // buildPrefix builds the tree branch prefix for a node.
 func (m *Model) buildPrefix(node *TreeNode, isLast bool) string {
 	if node.Depth == 0 {
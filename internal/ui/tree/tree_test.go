@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -319,6 +320,45 @@ func TestView_Empty(t *testing.T) {
 	require.Contains(t, view, "No tree data")
 }
 
+func TestOutline_Down(t *testing.T) {
+	issueMap := makeTestIssueMap()
+	m := New("epic-1", issueMap, DirectionDown, ModeDeps, newTestClock(t))
+	m.SetSize(80, 24)
+
+	require.Equal(t, "epic-1 Epic One [open]\n"+
+		"├─ task-1 Task One [closed]\n"+
+		"└─ task-2 Task Two [open]\n"+
+		"    └─ subtask-1 Subtask One [in_progress]\n", m.Outline())
+}
+
+func TestOutline_UpDirection(t *testing.T) {
+	issueMap := makeTestIssueMap()
+	m := New("subtask-1", issueMap, DirectionUp, ModeDeps, newTestClock(t))
+
+	require.Equal(t, "subtask-1 Subtask One [in_progress]\n"+
+		"└─ task-2 Task Two [open]\n"+
+		"    └─ epic-1 Epic One [open]\n", m.Outline())
+}
+
+func TestOutline_IncludesCollapsedFanOut(t *testing.T) {
+	issueMap := makeTestIssueMap()
+	m := New("epic-1", issueMap, DirectionDown, ModeDeps, newTestClock(t))
+	m.SetFanOutThreshold(1)
+	require.True(t, m.ToggleFanOut())
+
+	outline := m.Outline()
+	require.Contains(t, outline, "task-1 Task One")
+	require.Contains(t, outline, "subtask-1 Subtask One")
+	require.NotContains(t, outline, "f to expand")
+}
+
+func TestOutline_Empty(t *testing.T) {
+	issueMap := makeTestIssueMap()
+	m := New("nonexistent", issueMap, DirectionDown, ModeDeps, newTestClock(t))
+
+	require.Empty(t, m.Outline())
+}
+
 // Golden tests for tree UI rendering
 // Run with -update flag to update golden files: go test -update ./internal/ui/tree/...
 
@@ -416,3 +456,182 @@ func TestView_Golden_VeryNarrowWidth(t *testing.T) {
 	view := m.View()
 	teatest.RequireEqualOutput(t, []byte(view))
 }
+
+// makeWideIssueMap creates an epic with n child tasks, the first of which is closed.
+func makeWideIssueMap(n int) map[string]*beads.Issue {
+	epic := &beads.Issue{
+		ID:        "epic-wide",
+		TitleText: "Wide Epic",
+		Status:    beads.StatusOpen,
+		Type:      beads.TypeEpic,
+		Priority:  beads.PriorityHigh,
+		CreatedAt: testCreatedAt,
+	}
+	issueMap := map[string]*beads.Issue{epic.ID: epic}
+	for i := range n {
+		id := fmt.Sprintf("wide-%02d", i)
+		status := beads.StatusOpen
+		if i == 0 {
+			status = beads.StatusClosed
+		}
+		epic.Children = append(epic.Children, id)
+		issueMap[id] = &beads.Issue{
+			ID:        id,
+			TitleText: "Subtask " + id,
+			Status:    status,
+			Type:      beads.TypeTask,
+			Priority:  beads.PriorityMedium,
+			ParentID:  epic.ID,
+			CreatedAt: testCreatedAt,
+		}
+	}
+	return issueMap
+}
+
+func TestFanOut_LargeSiblingSetsStartExpanded(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(DefaultFanOutThreshold+4), DirectionDown, ModeDeps, newTestClock(t))
+
+	require.Len(t, m.nodes, DefaultFanOutThreshold+5)
+	for _, n := range m.nodes {
+		require.False(t, n.IsFanOut())
+	}
+	require.Empty(t, m.CollapsedGroups())
+}
+
+func TestFanOut_CollapsesLargeSiblingSets(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(DefaultFanOutThreshold+4), DirectionDown, ModeDeps, newTestClock(t))
+	m.SetSize(100, 30)
+	require.True(t, m.ToggleFanOut())
+
+	require.Len(t, m.nodes, 2, "root plus a single fan-out row")
+	require.True(t, m.nodes[1].IsFanOut())
+	require.Len(t, m.nodes[1].Hidden, DefaultFanOutThreshold+4)
+
+	view := m.View()
+	require.Contains(t, view, "12 subtasks (1/12 closed)")
+	require.NotContains(t, view, "wide-03")
+}
+
+func TestFanOut_SmallSiblingSetsNotCollapsible(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(DefaultFanOutThreshold), DirectionDown, ModeDeps, newTestClock(t))
+
+	require.False(t, m.ToggleFanOut())
+	require.Len(t, m.nodes, DefaultFanOutThreshold+1)
+}
+
+func TestFanOut_SetFanOutThresholdZeroDisables(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(20), DirectionDown, ModeDeps, newTestClock(t))
+	require.True(t, m.ToggleFanOut())
+	m.SetFanOutThreshold(0)
+
+	require.Len(t, m.nodes, 21)
+	require.False(t, m.ToggleFanOut())
+}
+
+func TestFanOut_PlaceholderIsNotSelectable(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(12), DirectionDown, ModeDeps, newTestClock(t))
+	m.SetSize(100, 30)
+	require.True(t, m.ToggleFanOut())
+	m.MoveCursor(1)
+
+	require.Nil(t, m.SelectedNode(), "fan-out row has no issue")
+	require.Equal(t, []string{"epic-wide"}, m.VisibleIssueIDs())
+}
+
+func TestToggleFanOut_CollapseAndExpand(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(12), DirectionDown, ModeDeps, newTestClock(t))
+	m.SetSize(100, 30)
+
+	// Collapse from a child: cursor lands on the placeholder
+	m.MoveCursor(5)
+	require.True(t, m.ToggleFanOut())
+	require.Len(t, m.nodes, 2)
+	require.Equal(t, 1, m.cursor)
+	require.Nil(t, m.SelectedNode())
+	require.Equal(t, []string{"epic-wide"}, m.CollapsedGroups())
+
+	// Expand from the placeholder row: cursor lands on the first child
+	require.True(t, m.ToggleFanOut())
+	require.Len(t, m.nodes, 13)
+	node := m.SelectedNode()
+	require.NotNil(t, node)
+	require.Equal(t, "wide-00", node.Issue.ID)
+	require.Empty(t, m.CollapsedGroups())
+
+	// Toggle from the group owner keeps the owner selected
+	m.MoveCursor(-1)
+	require.True(t, m.ToggleFanOut())
+	require.Len(t, m.nodes, 2)
+	node = m.SelectedNode()
+	require.NotNil(t, node)
+	require.Equal(t, "epic-wide", node.Issue.ID)
+}
+
+func TestToggleFanOut_NoGroupAtCursor(t *testing.T) {
+	m := New("epic-1", makeTestIssueMap(), DirectionDown, ModeDeps, newTestClock(t))
+
+	require.False(t, m.ToggleFanOut())
+	require.Len(t, m.nodes, 4)
+}
+
+func TestFanOut_CollapsePersistsAcrossRerenders(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(12), DirectionDown, ModeDeps, newTestClock(t))
+	m.SetSize(100, 30)
+	require.True(t, m.ToggleFanOut())
+
+	// Repeated renders don't change state
+	first := m.View()
+	require.Equal(t, first, m.View())
+	require.NotContains(t, first, "wide-11")
+
+	// Rebuild (e.g. after mode toggle) keeps the group collapsed
+	m.ToggleMode()
+	require.NoError(t, m.Rebuild())
+	require.Len(t, m.nodes, 2)
+
+	// Refocus away and back keeps the group collapsed
+	require.NoError(t, m.Refocus("wide-03"))
+	needsRequery, _ := m.GoBack()
+	require.False(t, needsRequery)
+	require.Len(t, m.nodes, 2)
+
+	// RefreshNodes keeps the group collapsed
+	m.RefreshNodes()
+	require.Len(t, m.nodes, 2)
+}
+
+func TestFanOut_SetCollapsedGroupsCarriesStateToNewModel(t *testing.T) {
+	issueMap := makeWideIssueMap(12)
+	old := New("epic-wide", issueMap, DirectionDown, ModeDeps, newTestClock(t))
+	require.True(t, old.ToggleFanOut())
+
+	m := New("epic-wide", issueMap, DirectionDown, ModeDeps, newTestClock(t))
+	require.Len(t, m.nodes, 13)
+
+	m.SetCollapsedGroups(old.CollapsedGroups())
+	require.Len(t, m.nodes, 2)
+}
+
+func TestSelectByIssueID_ExpandsCollapsedGroup(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(12), DirectionDown, ModeDeps, newTestClock(t))
+	require.True(t, m.ToggleFanOut())
+
+	require.True(t, m.SelectByIssueID("wide-07"))
+	node := m.SelectedNode()
+	require.NotNil(t, node)
+	require.Equal(t, "wide-07", node.Issue.ID)
+	require.Empty(t, m.CollapsedGroups())
+
+	require.False(t, m.SelectByIssueID("missing"))
+}
+
+// TestView_Golden_FanOutCollapsed tests rendering of a collapsed fan-out row.
+func TestView_Golden_FanOutCollapsed(t *testing.T) {
+	m := New("epic-wide", makeWideIssueMap(12), DirectionDown, ModeDeps, newTestClock(t))
+	m.SetSize(100, 30)
+	require.True(t, m.ToggleFanOut())
+	m.MoveCursor(1)
+
+	view := m.View()
+	teatest.RequireEqualOutput(t, []byte(view))
+}